@@ -20,8 +20,11 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/devicerepository"
+	mpgrpc "go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpc"
 )
 
 // DefaultWebhookTemplatesConfig is the default configuration for the Webhook templates.
@@ -32,6 +35,9 @@ var DefaultWebhookTemplatesConfig = web.TemplatesConfig{
 // DefaultApplicationServerConfig is the default configuration for the Application Server.
 var DefaultApplicationServerConfig = applicationserver.Config{
 	LinkMode: "all",
+	DeviceRegistry: config.DeviceRegistry{
+		Provider: "redis",
+	},
 	MQTT: config.MQTT{
 		Listen:           ":1883",
 		ListenTLS:        ":8883",
@@ -39,12 +45,48 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 		PublicTLSAddress: fmt.Sprintf("%s:8883", shared.DefaultPublicHost),
 	},
 	Webhooks: applicationserver.WebhooksConfig{
-		Templates: DefaultWebhookTemplatesConfig,
-		Target:    "direct",
-		Timeout:   5 * time.Second,
-		QueueSize: 16,
-		Workers:   16,
-		Downlinks: web.DownlinksConfig{PublicAddress: shared.DefaultPublicURL + "/api/v3"},
+		Templates:  DefaultWebhookTemplatesConfig,
+		Target:     "direct",
+		Timeout:    5 * time.Second,
+		QueueSize:  16,
+		Workers:    16,
+		SecretsTTL: time.Minute,
+		Downlinks:  web.DownlinksConfig{PublicAddress: shared.DefaultPublicURL + "/api/v3"},
+		PersistentQueue: web.PersistentQueueConfig{
+			Retry: web.RetryConfig{
+				MaxAttempts:    16,
+				InitialBackoff: 10 * time.Second,
+				MaxBackoff:     time.Hour,
+			},
+			DeadLetterTTL:  7 * 24 * time.Hour,
+			MaxDeadLetters: 1000,
+		},
+		Health: web.HealthConfig{
+			SuspendAfter: 100,
+			CacheTTL:     10 * time.Second,
+		},
+	},
+	Storage: applicationserver.StorageConfig{
+		Config: storage.Config{
+			Retention: storage.RetentionConfig{
+				TTL:      7 * 24 * time.Hour,
+				Interval: storage.DefaultRetentionInterval,
+			},
+		},
+	},
+	Formatters: applicationserver.FormattersConfig{
+		Repository: devicerepository.Config{
+			CacheSize: 1024,
+			CacheTTL:  time.Hour,
+		},
+		GRPCService: mpgrpc.Config{
+			Timeout:     5 * time.Second,
+			IdleTimeout: 10 * time.Minute,
+			CircuitBreaker: mpgrpc.CircuitBreakerConfig{
+				Threshold: 5,
+				Timeout:   30 * time.Second,
+			},
+		},
 	},
 	EndDeviceFetcher: applicationserver.EndDeviceFetcherConfig{
 		Cache: applicationserver.EndDeviceFetcherCacheConfig{
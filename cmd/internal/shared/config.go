@@ -91,6 +91,18 @@ var DefaultRedisConfig = redis.Config{
 // DefaultEventsConfig is the default config for Events.
 var DefaultEventsConfig = config.Events{
 	Backend: "internal",
+	Kafka: config.KafkaEvents{
+		Topic: "ttn-lw-events",
+	},
+	NATS: config.NATSEvents{
+		Stream:  "TTN_LW_EVENTS",
+		Subject: "ttn.lw.events",
+		MaxAge:  24 * time.Hour,
+	},
+	Store: config.EventsStore{
+		TTL:         time.Hour,
+		EntityCount: 100,
+	},
 }
 
 // DefaultBlobConfig is the default config for the blob store.
@@ -22,6 +22,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/events/cloud"
+	"go.thethings.network/lorawan-stack/v3/pkg/events/kafka"
+	"go.thethings.network/lorawan-stack/v3/pkg/events/nats"
 	"go.thethings.network/lorawan-stack/v3/pkg/events/redis"
 	_ "gocloud.dev/pubsub/awssnssqs" // AWS backend for PubSub.
 	_ "gocloud.dev/pubsub/gcppubsub" // GCP backend for PubSub.
@@ -29,12 +31,35 @@ import (
 
 // InitializeEvents initializes the event system.
 func InitializeEvents(ctx context.Context, taskStarter component.TaskStarter, conf config.ServiceBase) error {
+	// Storing events is supported by the internal and redis backends.
+	store := conf.Events.Store
 	switch conf.Events.Backend {
 	case "internal":
-		return nil // this is the default.
+		if store.Enable {
+			events.SetDefaultPubSub(events.NewMemoryStore(events.DefaultPubSub(), store.TTL, store.EntityCount))
+		}
+		return nil
 	case "redis":
+		if store.Enable {
+			events.SetDefaultPubSub(redis.NewStore(ctx, taskStarter, conf.Events.Redis, store.TTL, store.EntityCount))
+			return nil
+		}
 		events.SetDefaultPubSub(redis.NewPubSub(ctx, taskStarter, conf.Events.Redis))
 		return nil
+	case "kafka":
+		ps, err := kafka.NewPubSub(ctx, taskStarter, conf.Events.Kafka)
+		if err != nil {
+			return err
+		}
+		events.SetDefaultPubSub(ps)
+		return nil
+	case "nats":
+		ps, err := nats.NewPubSub(ctx, taskStarter, conf.Events.NATS)
+		if err != nil {
+			return err
+		}
+		events.SetDefaultPubSub(ps)
+		return nil
 	case "cloud":
 		ps, err := cloud.NewPubSub(ctx, taskStarter, conf.Events.Cloud.PublishURL, conf.Events.Cloud.SubscribeURL)
 		if err != nil {
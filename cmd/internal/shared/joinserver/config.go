@@ -15,12 +15,16 @@
 package joinserver
 
 import (
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/joinserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 )
 
 // DefaultJoinServerConfig is the default configuration for the JoinServer
 var DefaultJoinServerConfig = joinserver.Config{
+	DeviceRegistry: config.DeviceRegistry{
+		Provider: "redis",
+	},
 	JoinEUIPrefixes: []types.EUI64Prefix{
 		{},
 	},
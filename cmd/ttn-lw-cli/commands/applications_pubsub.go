@@ -36,6 +36,7 @@ var (
 	mqttProviderApplicationPubSubFlags   = util.FieldFlags(&ttnpb.ApplicationPubSub_MQTTProvider{}, "mqtt")
 	awsiotProviderApplicationPubSubFlags = util.FieldFlags(&ttnpb.ApplicationPubSub_AWSIoTProvider{}, "aws_iot")
 	awsiotDefaultIntegrationPubSubFlags  = util.FieldFlags(&ttnpb.ApplicationPubSub_AWSIoTProvider_DefaultIntegration{}, "aws_iot", "deployment", "default")
+	kafkaProviderApplicationPubSubFlags  = util.FieldFlags(&ttnpb.ApplicationPubSub_KafkaProvider{}, "kafka")
 
 	selectAllApplicationPubSubFlags = util.SelectAllFlagSet("application pub/sub")
 )
@@ -59,6 +60,13 @@ func applicationPubSubProviderFlags() *pflag.FlagSet {
 	flagSet.Bool("aws-iot", false, "use the AWS IoT provider")
 	flagSet.AddFlagSet(awsiotProviderApplicationPubSubFlags)
 	flagSet.AddFlagSet(awsiotDefaultIntegrationPubSubFlags)
+	flagSet.AddFlagSet(dataFlags("aws-iot.tls-client-cert", ""))
+	flagSet.AddFlagSet(dataFlags("aws-iot.tls-client-key", ""))
+	flagSet.Bool("kafka", false, "use the Kafka provider")
+	flagSet.AddFlagSet(kafkaProviderApplicationPubSubFlags)
+	flagSet.AddFlagSet(dataFlags("kafka.tls-ca", ""))
+	flagSet.AddFlagSet(dataFlags("kafka.tls-client-cert", ""))
+	flagSet.AddFlagSet(dataFlags("kafka.tls-client-key", ""))
 	addDeprecatedProviderFlags(flagSet)
 	return flagSet
 }
@@ -272,6 +280,21 @@ var (
 			}
 
 			if awsiot, _ := cmd.Flags().GetBool("aws-iot"); awsiot {
+				for _, name := range []string{
+					"aws-iot.tls-client-cert",
+					"aws-iot.tls-client-key",
+				} {
+					if !cmd.Flags().Changed(name + "-local-file") {
+						continue
+					}
+					data, err := getDataBytes(name, cmd.Flags())
+					if err != nil {
+						return err
+					}
+					if err = cmd.Flags().Set(name, hex.EncodeToString(data)); err != nil {
+						return err
+					}
+				}
 				if pubsub.GetAWSIoT() == nil {
 					paths = append(paths, "provider")
 					pubsub.Provider = &ttnpb.ApplicationPubSub_AWSIoT{
@@ -299,6 +322,38 @@ var (
 				}
 			}
 
+			if kafka, _ := cmd.Flags().GetBool("kafka"); kafka {
+				for _, name := range []string{
+					"kafka.tls-ca",
+					"kafka.tls-client-cert",
+					"kafka.tls-client-key",
+				} {
+					if !cmd.Flags().Changed(name + "-local-file") {
+						continue
+					}
+					data, err := getDataBytes(name, cmd.Flags())
+					if err != nil {
+						return err
+					}
+					if err = cmd.Flags().Set(name, hex.EncodeToString(data)); err != nil {
+						return err
+					}
+				}
+				if pubsub.GetKafka() == nil {
+					paths = append(paths, "provider")
+					pubsub.Provider = &ttnpb.ApplicationPubSub_Kafka{
+						Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{},
+					}
+				} else {
+					providerPaths := util.UpdateFieldMask(cmd.Flags(), kafkaProviderApplicationPubSubFlags)
+					providerPaths = ttnpb.FieldsWithPrefix("provider", providerPaths...)
+					paths = append(paths, providerPaths...)
+				}
+				if err = util.SetFields(pubsub.GetKafka(), kafkaProviderApplicationPubSubFlags, "kafka"); err != nil {
+					return err
+				}
+			}
+
 			res, err := ttnpb.NewApplicationPubSubRegistryClient(as).Set(ctx, &ttnpb.SetApplicationPubSubRequest{
 				ApplicationPubSub: *pubsub,
 				FieldMask:         types.FieldMask{Paths: paths},
@@ -15,6 +15,7 @@
 package commands
 
 import (
+	"encoding/hex"
 	"os"
 	"strings"
 
@@ -71,6 +72,13 @@ func getApplicationWebhookID(flagSet *pflag.FlagSet, args []string) (*ttnpb.Appl
 	}, nil
 }
 
+func applicationWebhookTLSFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.AddFlagSet(dataFlags("tls-client-cert", ""))
+	flagSet.AddFlagSet(dataFlags("tls-client-key", ""))
+	return flagSet
+}
+
 func headersFlags() *pflag.FlagSet {
 	flagSet := &pflag.FlagSet{}
 	flagSet.StringSlice("headers", nil, "key=value")
@@ -175,7 +183,25 @@ var (
 			if err != nil {
 				return err
 			}
+			for _, name := range []string{
+				"tls-client-cert",
+				"tls-client-key",
+			} {
+				if !cmd.Flags().Changed(name + "-local-file") {
+					continue
+				}
+				data, err := getDataBytes(name, cmd.Flags())
+				if err != nil {
+					return err
+				}
+				if err = cmd.Flags().Set(name, hex.EncodeToString(data)); err != nil {
+					return err
+				}
+			}
 			paths := util.UpdateFieldMask(cmd.Flags(), setApplicationWebhookFlags, headersFlags())
+			if resetHealth, _ := cmd.Flags().GetBool("reset-health"); resetHealth {
+				paths = append(paths, "health")
+			}
 
 			var webhook ttnpb.ApplicationWebhook
 			if err = util.SetFields(&webhook, setApplicationWebhookFlags); err != nil {
@@ -219,6 +245,70 @@ var (
 				return err
 			}
 
+			return nil
+		},
+	}
+	applicationsWebhooksDeadLettersCommand = &cobra.Command{
+		Use:     "dead-letters",
+		Aliases: []string{"dead-letter"},
+		Short:   "Application webhook dead letters commands",
+	}
+	applicationsWebhooksDeadLettersListCommand = &cobra.Command{
+		Use:     "list [application-id] [webhook-id]",
+		Aliases: []string{"ls"},
+		Short:   "List the requests of an application webhook that could not be delivered",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			webhookID, err := getApplicationWebhookID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			res, err := ttnpb.NewApplicationWebhookRegistryClient(as).ListDeadLetters(ctx, &ttnpb.ListApplicationWebhookDeadLettersRequest{
+				ApplicationWebhookIdentifiers: *webhookID, Limit: limit, Page: page,
+			}, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Requests)
+		},
+	}
+	applicationsWebhooksDeadLettersReplayCommand = &cobra.Command{
+		Use:   "replay [application-id] [webhook-id] [request-id]...",
+		Short: "Replay the requests of an application webhook that could not be delivered",
+		Long: `Replay the requests of an application webhook that could not be delivered.
+If no request IDs are given, all dead-lettered requests of the webhook are replayed.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var requestIDs []string
+			if len(args) > 2 {
+				requestIDs = args[2:]
+				args = args[:2]
+			}
+			webhookID, err := getApplicationWebhookID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			ids, _ := cmd.Flags().GetStringSlice("request-ids")
+			requestIDs = append(requestIDs, ids...)
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewApplicationWebhookRegistryClient(as).ReplayDeadLetters(ctx, &ttnpb.ReplayApplicationWebhookDeadLettersRequest{
+				ApplicationWebhookIdentifiers: *webhookID,
+				RequestIDs:                    requestIDs,
+			})
+			if err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
@@ -237,8 +327,17 @@ func init() {
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(setApplicationWebhookFlags)
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(headersFlags())
+	applicationsWebhooksSetCommand.Flags().AddFlagSet(applicationWebhookTLSFlags())
+	applicationsWebhooksSetCommand.Flags().Bool("reset-health", false, "reset the health of the webhook, which enables a suspended webhook again")
 	applicationsWebhooksCommand.AddCommand(applicationsWebhooksSetCommand)
 	applicationsWebhooksDeleteCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
 	applicationsWebhooksCommand.AddCommand(applicationsWebhooksDeleteCommand)
+	applicationsWebhooksDeadLettersListCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
+	applicationsWebhooksDeadLettersListCommand.Flags().AddFlagSet(paginationFlags())
+	applicationsWebhooksDeadLettersCommand.AddCommand(applicationsWebhooksDeadLettersListCommand)
+	applicationsWebhooksDeadLettersReplayCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
+	applicationsWebhooksDeadLettersReplayCommand.Flags().StringSlice("request-ids", nil, "")
+	applicationsWebhooksDeadLettersCommand.AddCommand(applicationsWebhooksDeadLettersReplayCommand)
+	applicationsWebhooksCommand.AddCommand(applicationsWebhooksDeadLettersCommand)
 	applicationsCommand.AddCommand(applicationsWebhooksCommand)
 }
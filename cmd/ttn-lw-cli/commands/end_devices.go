@@ -21,6 +21,7 @@ import (
 	"mime"
 	"os"
 	"path"
+	"sort"
 	"strings"
 
 	stdio "io"
@@ -41,6 +42,7 @@ import (
 var (
 	selectEndDeviceListFlags = &pflag.FlagSet{}
 	selectEndDeviceFlags     = &pflag.FlagSet{}
+	selectNsEndDeviceFlags   = &pflag.FlagSet{}
 	setEndDeviceFlags        = &pflag.FlagSet{}
 	endDeviceFlattenPaths    = []string{"provisioning_data"}
 	endDevicePictureFlags    = &pflag.FlagSet{}
@@ -264,6 +266,37 @@ var (
 			return io.Write(os.Stdout, config.OutputFormat, res.EndDevices)
 		},
 	}
+	endDevicesNsListCommand = &cobra.Command{
+		Use:   "ns-list [application-id]",
+		Short: "List end devices in the Network Server",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID
+			}
+			paths := util.SelectFieldMask(cmd.Flags(), selectNsEndDeviceFlags)
+			paths = ttnpb.AllowedFields(paths, ttnpb.AllowedFieldMaskPathsForRPC["/ttn.lorawan.v3.NsEndDeviceRegistry/List"])
+
+			req, opt, getTotal, err := getNsListEndDevicesRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req.ApplicationIdentifiers = *appID
+			req.FieldMask.Paths = paths
+
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsEndDeviceRegistryClient(ns).List(ctx, req, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res.EndDevices)
+		},
+	}
 	endDevicesGetCommand = &cobra.Command{
 		Use:     "get [application-id] [device-id]",
 		Aliases: []string{"info"},
@@ -1120,6 +1153,96 @@ To generate a QR code for multiple end devices:
 			return err
 		},
 	}
+	endDevicesExportCommand = &cobra.Command{
+		Use:   "export [application-id] [device-id...]",
+		Short: "Export end devices from the Network Server, Application Server and Join Server",
+		Long: `Export end devices from the Network Server, Application Server and Join Server
+
+The end devices are exported including their MAC state, sessions and queues, so
+that they can be imported in another cluster without rejoining. If no device
+IDs are given, all end devices of the application are exported.
+
+The archives are written in batches of up to 1000 end devices. If a KEK label
+is given, the archives are encrypted with a data key that is wrapped using the
+KEK of the exporting component. The importing component must have access to
+the same KEK.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var devIDs []string
+			if len(args) > 0 {
+				args, devIDs = args[:1], args[1:]
+			}
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID
+			}
+			kekLabel, _ := cmd.Flags().GetString("kek-label")
+
+			devs, err := getArchiveEndDevices(ctx, *appID, devIDs...)
+			if err != nil {
+				return err
+			}
+			return exportEndDevices(ctx, *appID, splitArchiveEndDevices(devs...), kekLabel, func(archives endDeviceArchives) error {
+				return io.Write(os.Stdout, config.OutputFormat, archives)
+			})
+		},
+	}
+	endDevicesImportCommand = &cobra.Command{
+		Use:   "import [application-id]",
+		Short: "Import end devices in the Network Server, Application Server and Join Server",
+		Long: `Import end devices in the Network Server, Application Server and Join Server
+
+The end devices are read from archives created with the export command. The end
+devices must be registered in the Identity Server of the target cluster.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID
+			}
+
+			inputDecoder := inputDecoder
+			if inputDecoder == nil {
+				reader, err := getDataReader("", cmd.Flags())
+				if err != nil {
+					return err
+				}
+				inputDecoder, err = getInputDecoder(reader)
+				if err != nil {
+					return err
+				}
+			}
+			for {
+				var archives endDeviceArchives
+				_, err := inputDecoder.Decode(&archives)
+				if err == stdio.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				components := make([]string, 0, len(archives))
+				for component := range archives {
+					components = append(components, component)
+				}
+				sort.Strings(components)
+				for _, component := range components {
+					res, err := importEndDeviceArchive(ctx, component, &ttnpb.ImportEndDevicesRequest{
+						ApplicationIdentifiers: *appID,
+						Archive:                archives[component],
+					})
+					if err != nil {
+						return err
+					}
+					logger.WithFields(log.Fields(
+						"component", component,
+						"count", len(res.EndDevices),
+					)).Info("Imported end devices")
+					if err := io.Write(os.Stdout, config.OutputFormat, res.EndDevices); err != nil {
+						return err
+					}
+				}
+			}
+		},
+	}
 )
 
 func init() {
@@ -1132,6 +1255,9 @@ func init() {
 			ttnpb.ContainsField(flag.Name, getEndDeviceFromJS) {
 			selectEndDeviceFlags.AddFlag(flag)
 		}
+		if ttnpb.ContainsField(flag.Name, getEndDeviceFromNS) {
+			selectNsEndDeviceFlags.AddFlag(flag)
+		}
 	})
 
 	addDeprecatedDeviceFlags(selectEndDeviceListFlags)
@@ -1163,6 +1289,11 @@ func init() {
 	endDevicesSearchCommand.Flags().AddFlagSet(selectApplicationFlags)
 	endDevicesSearchCommand.Flags().AddFlagSet(selectAllEndDeviceFlags)
 	endDevicesCommand.AddCommand(endDevicesSearchCommand)
+	endDevicesNsListCommand.Flags().AddFlagSet(applicationIDFlags())
+	endDevicesNsListCommand.Flags().AddFlagSet(nsListEndDevicesFlags())
+	endDevicesNsListCommand.Flags().AddFlagSet(selectNsEndDeviceFlags)
+	endDevicesNsListCommand.Flags().AddFlagSet(selectAllEndDeviceFlags)
+	endDevicesCommand.AddCommand(endDevicesNsListCommand)
 	endDevicesGetCommand.Flags().AddFlagSet(endDeviceIDFlags())
 	endDevicesGetCommand.Flags().AddFlagSet(selectEndDeviceFlags)
 	endDevicesGetCommand.Flags().AddFlagSet(selectAllEndDeviceFlags)
@@ -1215,6 +1346,12 @@ func init() {
 	endDevicesCommand.AddCommand(endDevicesGenerateQRCommand)
 	endDevicesExternalJSCommand.Flags().AddFlagSet(endDeviceIDFlags())
 	endDevicesCommand.AddCommand(endDevicesExternalJSCommand)
+	endDevicesExportCommand.Flags().AddFlagSet(applicationIDFlags())
+	endDevicesExportCommand.Flags().String("kek-label", "", "KEK label to wrap the archives with")
+	endDevicesCommand.AddCommand(endDevicesExportCommand)
+	endDevicesImportCommand.Flags().AddFlagSet(applicationIDFlags())
+	endDevicesImportCommand.Flags().AddFlagSet(dataFlags("", ""))
+	endDevicesCommand.AddCommand(endDevicesImportCommand)
 
 	endDevicesCommand.AddCommand(applicationsDownlinkCommand)
 
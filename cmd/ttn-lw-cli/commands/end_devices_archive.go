@@ -0,0 +1,205 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"sort"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// endDeviceArchiveBatchSize is the maximum number of end devices per export request.
+const endDeviceArchiveBatchSize = 1000
+
+const (
+	networkServerArchive     = "network_server"
+	applicationServerArchive = "application_server"
+	joinServerArchive        = "join_server"
+)
+
+var errEndDeviceArchiveComponent = errors.DefineInvalidArgument("end_device_archive_component", "unknown end device archive component `{component}`")
+
+// endDeviceArchives contains the end device archives of the Network Server,
+// Application Server and Join Server, keyed by component.
+type endDeviceArchives map[string]*ttnpb.EndDeviceArchive
+
+var endDeviceArchivePaths = []string{
+	"application_server_address",
+	"join_server_address",
+	"network_server_address",
+}
+
+// getArchiveEndDevices returns the end devices to archive from the Identity Server.
+// If no device IDs are given, all end devices of the application are returned.
+func getArchiveEndDevices(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devIDs ...string) ([]*ttnpb.EndDevice, error) {
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return nil, err
+	}
+	client := ttnpb.NewEndDeviceRegistryClient(is)
+	if len(devIDs) > 0 {
+		devs := make([]*ttnpb.EndDevice, 0, len(devIDs))
+		for _, devID := range devIDs {
+			dev, err := client.Get(ctx, &ttnpb.GetEndDeviceRequest{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: appID,
+					DeviceID:               devID,
+				},
+				FieldMask: pbtypes.FieldMask{Paths: endDeviceArchivePaths},
+			})
+			if err != nil {
+				return nil, err
+			}
+			devs = append(devs, dev)
+		}
+		return devs, nil
+	}
+	var devs []*ttnpb.EndDevice
+	for page := uint32(1); ; page++ {
+		res, err := client.List(ctx, &ttnpb.ListEndDevicesRequest{
+			ApplicationIdentifiers: appID,
+			FieldMask:              pbtypes.FieldMask{Paths: endDeviceArchivePaths},
+			Limit:                  endDeviceArchiveBatchSize,
+			Page:                   page,
+		})
+		if err != nil {
+			return nil, err
+		}
+		devs = append(devs, res.EndDevices...)
+		if len(res.EndDevices) < endDeviceArchiveBatchSize {
+			return devs, nil
+		}
+	}
+}
+
+// splitArchiveEndDevices returns the device IDs of the end devices registered in
+// the Network Server, Application Server and Join Server of the CLI configuration.
+func splitArchiveEndDevices(devs ...*ttnpb.EndDevice) map[string][]string {
+	devIDs := make(map[string][]string)
+	for _, dev := range devs {
+		nsMismatch, asMismatch, jsMismatch := compareServerAddressesEndDevice(dev, config)
+		if config.NetworkServerEnabled && dev.NetworkServerAddress != "" && !nsMismatch {
+			devIDs[networkServerArchive] = append(devIDs[networkServerArchive], dev.DeviceID)
+		}
+		if config.ApplicationServerEnabled && dev.ApplicationServerAddress != "" && !asMismatch {
+			devIDs[applicationServerArchive] = append(devIDs[applicationServerArchive], dev.DeviceID)
+		}
+		if config.JoinServerEnabled && dev.JoinServerAddress != "" && !jsMismatch {
+			devIDs[joinServerArchive] = append(devIDs[joinServerArchive], dev.DeviceID)
+		}
+	}
+	return devIDs
+}
+
+// exportEndDevices exports the given end devices from each component in batches.
+// The callback is called for each batch.
+func exportEndDevices(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devIDs map[string][]string, kekLabel string, f func(endDeviceArchives) error) error {
+	components := make([]string, 0, len(devIDs))
+	for component := range devIDs {
+		components = append(components, component)
+	}
+	sort.Strings(components)
+	for offset := 0; ; offset += endDeviceArchiveBatchSize {
+		archives := make(endDeviceArchives)
+		for _, component := range components {
+			ids := devIDs[component]
+			if offset >= len(ids) {
+				continue
+			}
+			end := offset + endDeviceArchiveBatchSize
+			if end > len(ids) {
+				end = len(ids)
+			}
+			archive, err := exportEndDeviceArchive(ctx, component, &ttnpb.ExportEndDevicesRequest{
+				ApplicationIdentifiers: appID,
+				DeviceIDs:              ids[offset:end],
+				KEKLabel:               kekLabel,
+			})
+			if err != nil {
+				return err
+			}
+			archives[component] = archive
+		}
+		if len(archives) == 0 {
+			return nil
+		}
+		if err := f(archives); err != nil {
+			return err
+		}
+	}
+}
+
+func exportEndDeviceArchive(ctx context.Context, component string, req *ttnpb.ExportEndDevicesRequest) (*ttnpb.EndDeviceArchive, error) {
+	switch component {
+	case networkServerArchive:
+		ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+		if err != nil {
+			return nil, err
+		}
+		return ttnpb.NewNsEndDeviceRegistryClient(ns).Export(ctx, req)
+	case applicationServerArchive:
+		as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+		if err != nil {
+			return nil, err
+		}
+		return ttnpb.NewAsEndDeviceRegistryClient(as).Export(ctx, req)
+	case joinServerArchive:
+		js, err := api.Dial(ctx, config.JoinServerGRPCAddress)
+		if err != nil {
+			return nil, err
+		}
+		return ttnpb.NewJsEndDeviceRegistryClient(js).Export(ctx, req)
+	default:
+		return nil, errEndDeviceArchiveComponent.WithAttributes("component", component)
+	}
+}
+
+func importEndDeviceArchive(ctx context.Context, component string, req *ttnpb.ImportEndDevicesRequest) (*ttnpb.EndDevices, error) {
+	switch component {
+	case networkServerArchive:
+		if !config.NetworkServerEnabled {
+			return nil, errNetworkServerDisabled
+		}
+		ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+		if err != nil {
+			return nil, err
+		}
+		return ttnpb.NewNsEndDeviceRegistryClient(ns).Import(ctx, req)
+	case applicationServerArchive:
+		if !config.ApplicationServerEnabled {
+			return nil, errApplicationServerDisabled
+		}
+		as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+		if err != nil {
+			return nil, err
+		}
+		return ttnpb.NewAsEndDeviceRegistryClient(as).Import(ctx, req)
+	case joinServerArchive:
+		if !config.JoinServerEnabled {
+			return nil, errJoinServerDisabled
+		}
+		js, err := api.Dial(ctx, config.JoinServerGRPCAddress)
+		if err != nil {
+			return nil, err
+		}
+		return ttnpb.NewJsEndDeviceRegistryClient(js).Import(ctx, req)
+	default:
+		return nil, errEndDeviceArchiveComponent.WithAttributes("component", component)
+	}
+}
@@ -48,13 +48,19 @@ var eventsCommand = &cobra.Command{
 		}
 
 		ids := getCombinedIdentifiers(cmd.Flags()).GetEntityIdentifiers()
-		if len(ids) == 0 {
+		names, _ := cmd.Flags().GetStringSlice("names")
+		if len(ids) == 0 && len(names) == 0 {
 			return errNoIDs
 		}
 		tail, _ := cmd.Flags().GetUint32("tail")
+		excludeNames, _ := cmd.Flags().GetStringSlice("exclude-names")
+		correlationIDs, _ := cmd.Flags().GetStringSlice("correlation-ids")
 		req := &ttnpb.StreamEventsRequest{
-			Identifiers: ids,
-			Tail:        tail,
+			Identifiers:    ids,
+			Tail:           tail,
+			Names:          names,
+			ExcludeNames:   excludeNames,
+			CorrelationIDs: correlationIDs,
 		}
 
 		events := make(chan *ttnpb.Event)
@@ -103,5 +109,8 @@ var eventsCommand = &cobra.Command{
 func init() {
 	eventsCommand.Flags().AddFlagSet(combinedIdentifiersFlags())
 	eventsCommand.Flags().Uint32("tail", 0, "")
+	eventsCommand.Flags().StringSlice("names", nil, "event names to stream, can contain glob patterns (admin only without identifiers)")
+	eventsCommand.Flags().StringSlice("exclude-names", nil, "event names to exclude, can contain glob patterns")
+	eventsCommand.Flags().StringSlice("correlation-ids", nil, "correlation IDs to filter on")
 	Root.AddCommand(eventsCommand)
 }
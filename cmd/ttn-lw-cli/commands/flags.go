@@ -25,6 +25,7 @@ import (
 	"github.com/spf13/pflag"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"google.golang.org/grpc"
 )
 
@@ -194,6 +195,48 @@ func getSearchEndDevicesRequest(flagSet *pflag.FlagSet) (req *ttnpb.SearchEndDev
 	}, opt, getTotal
 }
 
+func nsListEndDevicesFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("filter-lorawan-version", "", "only list end devices with this LoRaWAN version")
+	flagSet.StringSlice("filter-class", nil, "only list end devices operating in one of these classes")
+	flagSet.String("filter-dev-addr-prefix", "", "only list end devices with a DevAddr matching this prefix (format: '01020304/8')")
+	flagSet.AddFlagSet(timestampFlags("filter-last-seen-after", "only list end devices last seen at or after specified timestamp"))
+	flagSet.AddFlagSet(timestampFlags("filter-last-seen-before", "only list end devices last seen before specified timestamp"))
+	flagSet.AddFlagSet(paginationFlags())
+	return flagSet
+}
+
+func getNsListEndDevicesRequest(flagSet *pflag.FlagSet) (req *ttnpb.ListNsEndDevicesRequest, opt grpc.CallOption, getTotal func() uint64, err error) {
+	req = &ttnpb.ListNsEndDevicesRequest{}
+	if s, _ := flagSet.GetString("filter-lorawan-version"); s != "" {
+		if err := req.LoRaWANVersion.UnmarshalText([]byte(s)); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	classes, _ := flagSet.GetStringSlice("filter-class")
+	for _, s := range classes {
+		var class ttnpb.Class
+		if err := class.UnmarshalText([]byte(s)); err != nil {
+			return nil, nil, nil, err
+		}
+		req.DeviceClasses = append(req.DeviceClasses, class)
+	}
+	if s, _ := flagSet.GetString("filter-dev-addr-prefix"); s != "" {
+		req.DevAddrPrefix = &types.DevAddrPrefix{}
+		if err := req.DevAddrPrefix.UnmarshalText([]byte(s)); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if req.LastSeenAfter, err = getTimestampFlags(flagSet, "filter-last-seen-after"); err != nil {
+		return nil, nil, nil, err
+	}
+	if req.LastSeenBefore, err = getTimestampFlags(flagSet, "filter-last-seen-before"); err != nil {
+		return nil, nil, nil, err
+	}
+	req.Limit, req.Page, opt, getTotal = withPagination(flagSet)
+	return req, opt, getTotal, nil
+}
+
 var errNoIDs = errors.DefineInvalidArgument("no_ids", "no IDs set")
 
 func combinedIdentifiersFlags() *pflag.FlagSet {
@@ -15,7 +15,9 @@
 package commands
 
 import (
+	stdio "io"
 	"os"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
@@ -24,6 +26,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/io"
 	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/util"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	ttntypes "go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -408,6 +411,91 @@ var (
 			return io.Write(os.Stdout, config.OutputFormat, res)
 		},
 	}
+	gatewaysCaptureTraffic = &cobra.Command{
+		Use:   "capture-traffic [gateway-id]",
+		Short: "Capture the traffic of a connected gateway",
+		Long: `Capture the traffic of a connected gateway
+
+The captured records are written to stdout as JSON lines or as pcap file.
+The pcap file contains the raw frontend messages with the private link type 147 (USER0).`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			duration, _ := cmd.Flags().GetDuration("duration")
+			exportFormat, _ := cmd.Flags().GetString("export-format")
+
+			var write func(*ttnpb.GatewayTrafficCaptureRecord) error
+			switch exportFormat {
+			case "jsonl":
+				encoder := jsonpb.TTN().NewEncoder(os.Stdout)
+				write = func(record *ttnpb.GatewayTrafficCaptureRecord) error {
+					return encoder.Encode(record)
+				}
+			case "pcap":
+				pcap, err := io.NewPCAPWriter(os.Stdout, io.PCAPLinkTypeUser0)
+				if err != nil {
+					return err
+				}
+				write = func(record *ttnpb.GatewayTrafficCaptureRecord) error {
+					if len(record.Raw) == 0 {
+						return nil
+					}
+					return pcap.WritePacket(record.Time, record.Raw)
+				}
+			default:
+				return errInvalidExportFormat.WithAttributes("format", exportFormat)
+			}
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			stream, err := ttnpb.NewGsClient(gs).CaptureGatewayTraffic(ctx, &ttnpb.CaptureGatewayTrafficRequest{
+				GatewayIdentifiers: *gtwID,
+				Duration:           duration,
+			})
+			if err != nil {
+				return err
+			}
+			for {
+				record, err := stream.Recv()
+				if err != nil {
+					if err == stdio.EOF {
+						return nil
+					}
+					return err
+				}
+				if err := write(record); err != nil {
+					return err
+				}
+			}
+		},
+	}
+	gatewaysRemoteCommand = &cobra.Command{
+		Use:   "remote-command [gateway-id]",
+		Short: "Run a remote command on a connected gateway",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			command, _ := cmd.Flags().GetString("command")
+			arguments, _ := cmd.Flags().GetStringSlice("arguments")
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewGsClient(gs).RunGatewayRemoteCommand(ctx, &ttnpb.RunGatewayRemoteCommandRequest{
+				GatewayIdentifiers: *gtwID,
+				Command:            command,
+				Arguments:          arguments,
+			})
+			return err
+		},
+	}
 	gatewaysContactInfoCommand = contactInfoCommands("gateway", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
 		gtwID, err := getGatewayID(cmd.Flags(), args, true)
 		if err != nil {
@@ -482,6 +570,14 @@ func init() {
 	gatewaysCommand.AddCommand(gatewaysDeleteCommand)
 	gatewaysConnectionStats.Flags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysConnectionStats)
+	gatewaysCaptureTraffic.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCaptureTraffic.Flags().Duration("duration", time.Minute, "duration of the capture")
+	gatewaysCaptureTraffic.Flags().String("export-format", "jsonl", "export format (jsonl, pcap)")
+	gatewaysCommand.AddCommand(gatewaysCaptureTraffic)
+	gatewaysRemoteCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysRemoteCommand.Flags().String("command", "", "command to run on the gateway")
+	gatewaysRemoteCommand.Flags().StringSlice("arguments", nil, "arguments of the command")
+	gatewaysCommand.AddCommand(gatewaysRemoteCommand)
 	gatewaysContactInfoCommand.PersistentFlags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysContactInfoCommand)
 	gatewaysPurgeCommand.Flags().AddFlagSet(gatewayIDFlags())
@@ -490,6 +586,8 @@ func init() {
 	Root.AddCommand(gatewaysCommand)
 }
 
+var errInvalidExportFormat = errors.DefineInvalidArgument("invalid_export_format", "invalid export format `{format}`")
+
 var errAddressMismatchGateway = errors.DefineAborted("gateway_server_address_mismatch", "gateway server address mismatch")
 
 func compareServerAddressGateway(gateway *ttnpb.Gateway, config *Config) (gsMismatch bool) {
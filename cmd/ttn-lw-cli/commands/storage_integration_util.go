@@ -20,9 +20,12 @@ import (
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/util"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
+var selectApplicationUpFlags = util.FieldMaskFlags(&ttnpb.ApplicationUp{})
+
 func getStoredUpFlags() *pflag.FlagSet {
 	flags := &pflag.FlagSet{}
 
@@ -40,6 +43,8 @@ func getStoredUpFlags() *pflag.FlagSet {
 	}
 	flags.String("type", "", fmt.Sprintf("message type (%s)", strings.Join(types, "|")))
 
+	flags.AddFlagSet(selectApplicationUpFlags)
+
 	return flags
 }
 
@@ -57,6 +62,7 @@ func getStoredUpRequest(flags *pflag.FlagSet) (*ttnpb.GetStoredApplicationUpRequ
 	}
 	req.Order, _ = flags.GetString("order")
 	req.Type, _ = flags.GetString("type")
+	req.FieldMask.Paths = util.SelectFieldMask(flags, selectApplicationUpFlags)
 
 	if flags.Changed("f-port") {
 		fport, _ := flags.GetUint32("f-port")
@@ -0,0 +1,64 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// PCAPLinkTypeUser0 is the first link type reserved for private use.
+const PCAPLinkTypeUser0 = 147
+
+const pcapSnapLen = 65535
+
+// PCAPWriter writes packets in the pcap capture file format.
+type PCAPWriter struct {
+	w io.Writer
+}
+
+// NewPCAPWriter writes the pcap file header with the given link type to w and returns a PCAPWriter.
+func NewPCAPWriter(w io.Writer, linkType uint32) (*PCAPWriter, error) {
+	var hdr [24]byte
+	binary.LittleEndian.PutUint32(hdr[0:], 0xa1b23c4d) // Magic number for nanosecond resolution.
+	binary.LittleEndian.PutUint16(hdr[4:], 2)          // Major version.
+	binary.LittleEndian.PutUint16(hdr[6:], 4)          // Minor version.
+	binary.LittleEndian.PutUint32(hdr[16:], pcapSnapLen)
+	binary.LittleEndian.PutUint32(hdr[20:], linkType)
+	if _, err := w.Write(hdr[:]); err != nil {
+		return nil, err
+	}
+	return &PCAPWriter{w: w}, nil
+}
+
+// WritePacket writes the packet data captured at the given time.
+// Data exceeding the snapshot length is truncated.
+func (p *PCAPWriter) WritePacket(t time.Time, data []byte) error {
+	origLen := len(data)
+	if len(data) > pcapSnapLen {
+		data = data[:pcapSnapLen]
+	}
+	var hdr [16]byte
+	binary.LittleEndian.PutUint32(hdr[0:], uint32(t.Unix()))
+	binary.LittleEndian.PutUint32(hdr[4:], uint32(t.Nanosecond()))
+	binary.LittleEndian.PutUint32(hdr[8:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(hdr[12:], uint32(origLen))
+	if _, err := p.w.Write(hdr[:]); err != nil {
+		return err
+	}
+	_, err := p.w.Write(data)
+	return err
+}
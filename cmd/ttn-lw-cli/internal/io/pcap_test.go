@@ -0,0 +1,54 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestPCAPWriter(t *testing.T) {
+	a := assertions.New(t)
+
+	var buf bytes.Buffer
+	w, err := NewPCAPWriter(&buf, PCAPLinkTypeUser0)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(buf.Bytes(), should.Resemble, []byte{
+		0x4d, 0x3c, 0xb2, 0xa1, // Magic number.
+		0x02, 0x00, 0x04, 0x00, // Version.
+		0x00, 0x00, 0x00, 0x00, // Time zone.
+		0x00, 0x00, 0x00, 0x00, // Accuracy.
+		0xff, 0xff, 0x00, 0x00, // Snapshot length.
+		0x93, 0x00, 0x00, 0x00, // Link type.
+	})
+
+	buf.Reset()
+	err = w.WritePacket(time.Unix(0x5f5e1000, 0x100), []byte{0x01, 0x02, 0x03})
+	a.So(err, should.BeNil)
+	a.So(buf.Bytes(), should.Resemble, []byte{
+		0x00, 0x10, 0x5e, 0x5f, // Seconds.
+		0x00, 0x01, 0x00, 0x00, // Nanoseconds.
+		0x03, 0x00, 0x00, 0x00, // Captured length.
+		0x03, 0x00, 0x00, 0x00, // Original length.
+		0x01, 0x02, 0x03,
+	})
+}
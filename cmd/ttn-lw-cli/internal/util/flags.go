@@ -152,6 +152,7 @@ func isAtomicType(t reflect.Type, maskOnly bool) bool {
 		case
 			"ADRAckDelayExponentValue",
 			"ADRAckLimitExponentValue",
+			"ADRAlgorithmValue",
 			"AggregatedDutyCycleValue",
 			"DataRateIndexValue",
 			"GatewayAntennaIdentifiers",
@@ -174,7 +175,7 @@ func isSelectableField(name string) bool {
 
 func isSettableField(name string) bool {
 	switch name {
-	case "attributes", "contact_info", "password_updated_at", "temporary_password_created_at", "antennas", "profile_picture":
+	case "attributes", "contact_info", "password_updated_at", "temporary_password_created_at", "antennas", "profile_picture", "health":
 		return false
 	}
 	return true
@@ -267,6 +268,7 @@ func addField(fs *pflag.FlagSet, name string, t reflect.Type, maskOnly bool) {
 		case
 			"ADRAckDelayExponentValue",
 			"ADRAckLimitExponentValue",
+			"ADRAlgorithmValue",
 			"AggregatedDutyCycleValue",
 			"DataRateIndexValue",
 			"PingSlotPeriodValue",
@@ -624,6 +626,17 @@ func setField(rv reflect.Value, path []string, v reflect.Value) error {
 							break
 						}
 						return fmt.Errorf(`invalid value "%s" for %s`, v.String(), typeName)
+					case "ADRAlgorithmValue":
+						if enumValue, ok := proto.EnumValueMap(unwrapLoRaWANEnumType(typeName))[v.String()]; ok {
+							field.Set(reflect.ValueOf(ttnpb.ADRAlgorithmValue{Value: ttnpb.ADRAlgorithm(enumValue)}))
+							break
+						}
+						var enum ttnpb.ADRAlgorithm
+						if err := enum.UnmarshalText([]byte(v.String())); err == nil {
+							field.Set(reflect.ValueOf(ttnpb.ADRAlgorithmValue{Value: enum}))
+							break
+						}
+						return fmt.Errorf(`invalid value "%s" for %s`, v.String(), typeName)
 					}
 				case ft.Kind() == reflect.Slice && ft.Elem().Kind() == reflect.Uint8 && vt.Kind() == reflect.String:
 					s := strings.TrimPrefix(v.String(), "0x")
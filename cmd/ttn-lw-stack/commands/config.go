@@ -19,6 +19,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	shared_applicationserver "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/applicationserver"
 	shared_console "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/console"
+	shared_deviceclaimingserver "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/deviceclaimingserver"
 	shared_devicetemplateconverter "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/devicetemplateconverter"
 	shared_gatewayconfigurationserver "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/gatewayconfigurationserver"
 	shared_gatewayserver "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/gatewayserver"
@@ -30,6 +31,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
 	conf "go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/console"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicetemplateconverter"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayconfigurationserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
@@ -52,6 +54,7 @@ type Config struct {
 	GCS              gatewayconfigurationserver.Config `name:"gcs"`
 	DTC              devicetemplateconverter.Config    `name:"dtc"`
 	QRG              qrcodegenerator.Config            `name:"qrg"`
+	DCS              deviceclaimingserver.Config       `name:"dcs"`
 	PBA              packetbrokeragent.Config          `name:"pba"`
 }
 
@@ -67,6 +70,7 @@ var DefaultConfig = Config{
 	GCS:         shared_gatewayconfigurationserver.DefaultGatewayConfigurationServerConfig,
 	DTC:         shared_devicetemplateconverter.DefaultDeviceTemplateConverterConfig,
 	QRG:         shared_qrcodegenerator.DefaultQRCodeGeneratorConfig,
+	DCS:         shared_deviceclaimingserver.DefaultDeviceClaimingServerConfig,
 	PBA:         shared_packetbrokeragent.DefaultPacketBrokerAgentConfig,
 }
 
@@ -0,0 +1,158 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"github.com/spf13/cobra"
+	asiostoragesql "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage/sql"
+	assql "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/sql"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	jssql "go.thethings.network/lorawan-stack/v3/pkg/joinserver/sql"
+	nssql "go.thethings.network/lorawan-stack/v3/pkg/networkserver/sql"
+)
+
+var errNoSQLDatabase = errors.DefineFailedPrecondition("no_sql_database", "no SQL database configured")
+
+// sqlDatabases holds the SQL databases that are opened by the process, by database URI.
+// Components that are configured with the same database URI share the database.
+type sqlDatabases map[string]*gorm.DB
+
+// Open returns the database with the given URI. The database is opened if it is not open yet.
+func (dbs sqlDatabases) Open(ctx context.Context, uri string) (*gorm.DB, error) {
+	if db, ok := dbs[uri]; ok {
+		return db, nil
+	}
+	db, err := store.Open(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	dbs[uri] = db
+	return db, nil
+}
+
+// Close closes the open databases.
+func (dbs sqlDatabases) Close() {
+	for uri, db := range dbs {
+		if err := db.Close(); err != nil {
+			logger.WithError(err).Warn("Failed to close database")
+		}
+		delete(dbs, uri)
+	}
+}
+
+// migrateSQLDatabase connects to the database with the given URI and migrates its database schema.
+func migrateSQLDatabase(uri string, migrate func(*gorm.DB) error) error {
+	db, err := store.Open(ctx, uri)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if dbVersion, ok := db.Get("db:version"); ok {
+		logger.Infof("Detected database %s", dbVersion)
+	}
+
+	logger.Info("Initializing database...")
+	if err := store.Initialize(db); err != nil {
+		return err
+	}
+
+	return store.Transact(ctx, db, func(db *gorm.DB) error {
+		logger.Info("Migrating table structure...")
+		return migrate(db)
+	})
+}
+
+var (
+	nsDBMigrateCommand = &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the Network Server SQL database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.NS.DeviceRegistry.Provider != "sql" {
+				return errNoSQLDatabase.New()
+			}
+
+			logger.Info("Connecting to Network Server device registry database...")
+			if err := migrateSQLDatabase(config.NS.DeviceRegistry.SQL.DatabaseURI, nssql.Migrate); err != nil {
+				return err
+			}
+
+			logger.Info("Successfully migrated")
+			return nil
+		},
+	}
+	asDBCommand = &cobra.Command{
+		Use:   "as-db",
+		Short: "Manage Application Server database",
+	}
+	asDBMigrateCommand = &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the Application Server SQL databases",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.AS.DeviceRegistry.Provider != "sql" && config.AS.Storage.Provider != "sql" {
+				return errNoSQLDatabase.New()
+			}
+
+			if config.AS.DeviceRegistry.Provider == "sql" {
+				logger.Info("Connecting to Application Server device registry database...")
+				if err := migrateSQLDatabase(config.AS.DeviceRegistry.SQL.DatabaseURI, assql.Migrate); err != nil {
+					return err
+				}
+			}
+			if config.AS.Storage.Provider == "sql" {
+				logger.Info("Connecting to Application Server storage database...")
+				if err := migrateSQLDatabase(config.AS.Storage.SQL.DatabaseURI, asiostoragesql.Migrate); err != nil {
+					return err
+				}
+			}
+
+			logger.Info("Successfully migrated")
+			return nil
+		},
+	}
+	jsDBCommand = &cobra.Command{
+		Use:   "js-db",
+		Short: "Manage Join Server database",
+	}
+	jsDBMigrateCommand = &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the Join Server SQL database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.JS.DeviceRegistry.Provider != "sql" {
+				return errNoSQLDatabase.New()
+			}
+
+			logger.Info("Connecting to Join Server device registry database...")
+			if err := migrateSQLDatabase(config.JS.DeviceRegistry.SQL.DatabaseURI, jssql.Migrate); err != nil {
+				return err
+			}
+
+			logger.Info("Successfully migrated")
+			return nil
+		},
+	}
+)
+
+func init() {
+	nsDBCommand.AddCommand(nsDBMigrateCommand)
+	Root.AddCommand(asDBCommand)
+	asDBCommand.AddCommand(asDBMigrateCommand)
+	Root.AddCommand(jsDBCommand)
+	jsDBCommand.AddCommand(jsDBMigrateCommand)
+}
@@ -27,10 +27,15 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
 	asioapredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/redis"
 	asiopsredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/redis"
+	asiostorageredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage/redis"
+	asiostoragesql "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage/sql"
 	asiowebredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/redis"
 	asredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/redis"
+	assql "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/sql"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/console"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver"
+	dcsredis "go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicetemplateconverter"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
@@ -41,8 +46,10 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/joinserver"
 	jsredis "go.thethings.network/lorawan-stack/v3/pkg/joinserver/redis"
+	jssql "go.thethings.network/lorawan-stack/v3/pkg/joinserver/sql"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
 	nsredis "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	nssql "go.thethings.network/lorawan-stack/v3/pkg/networkserver/sql"
 	"go.thethings.network/lorawan-stack/v3/pkg/packetbrokeragent"
 	"go.thethings.network/lorawan-stack/v3/pkg/qrcodegenerator"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
@@ -66,10 +73,13 @@ func NewNetworkServerDownlinkTaskRedis(conf Config) *redis.Client {
 	return redis.New(conf.Redis.WithNamespace("ns", "tasks"))
 }
 
-var errUnknownComponent = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
+var (
+	errUnknownComponent       = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
+	errDeviceRegistryProvider = errors.DefineInvalidArgument("device_registry_provider", "invalid device registry provider `{provider}`")
+)
 
 var startCommand = &cobra.Command{
-	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|pba|all]... [flags]",
+	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|pba|dcs|all]... [flags]",
 	Short: "Start The Things Stack",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var start struct {
@@ -83,6 +93,7 @@ var startCommand = &cobra.Command{
 			DeviceTemplateConverter    bool
 			QRCodeGenerator            bool
 			PacketBrokerAgent          bool
+			DeviceClaimingServer       bool
 		}
 		startDefault := len(args) == 0
 		for _, arg := range args {
@@ -115,6 +126,8 @@ var startCommand = &cobra.Command{
 				start.QRCodeGenerator = true
 			case "pba":
 				start.PacketBrokerAgent = true
+			case "dcs":
+				start.DeviceClaimingServer = true
 			case "all":
 				start.IdentityServer = true
 				start.GatewayServer = true
@@ -126,6 +139,7 @@ var startCommand = &cobra.Command{
 				start.DeviceTemplateConverter = true
 				start.QRCodeGenerator = true
 				start.PacketBrokerAgent = true
+				start.DeviceClaimingServer = true
 			default:
 				return errUnknownComponent.WithAttributes("component", arg)
 			}
@@ -142,6 +156,7 @@ var startCommand = &cobra.Command{
 			start.DeviceTemplateConverter = true
 			start.QRCodeGenerator = true
 			start.PacketBrokerAgent = true
+			start.DeviceClaimingServer = true
 		}
 
 		logger.Info("Setting up core component")
@@ -183,6 +198,9 @@ var startCommand = &cobra.Command{
 
 		redisConsumerID := redis.Key(host, strconv.Itoa(os.Getpid()))
 
+		dbs := sqlDatabases{}
+		defer dbs.Close()
+
 		if start.IdentityServer {
 			logger.Info("Setting up Identity Server")
 			if config.IS.OAuth.UI.TemplateData.SentryDSN == "" {
@@ -231,14 +249,25 @@ var startCommand = &cobra.Command{
 				NewNetworkServerApplicationUplinkQueueRedis(*config),
 				int64(uplinkQueueSize), redisConsumerGroup, redisConsumerID,
 			)
-			devices := &nsredis.DeviceRegistry{
-				Redis:   NewNetworkServerDeviceRegistryRedis(*config),
-				LockTTL: time.Second,
-			}
-			if err := devices.Init(); err != nil {
-				return shared.ErrInitializeNetworkServer.WithCause(err)
+			switch provider := config.NS.DeviceRegistry.Provider; provider {
+			case "redis":
+				devices := &nsredis.DeviceRegistry{
+					Redis:   NewNetworkServerDeviceRegistryRedis(*config),
+					LockTTL: time.Second,
+				}
+				if err := devices.Init(); err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				config.NS.Devices = devices
+			case "sql":
+				db, err := dbs.Open(ctx, config.NS.DeviceRegistry.SQL.DatabaseURI)
+				if err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				config.NS.Devices = &nssql.DeviceRegistry{DB: db}
+			default:
+				return shared.ErrInitializeNetworkServer.WithCause(errDeviceRegistryProvider.WithAttributes("provider", provider))
 			}
-			config.NS.Devices = devices
 			config.NS.UplinkDeduplicator = &nsredis.UplinkDeduplicator{
 				Redis: redis.New(config.Cache.Redis.WithNamespace("ns", "uplink-deduplication")),
 			}
@@ -268,8 +297,19 @@ var startCommand = &cobra.Command{
 			config.AS.Links = &asredis.LinkRegistry{
 				Redis: redis.New(config.Redis.WithNamespace("as", "links")),
 			}
-			config.AS.Devices = &asredis.DeviceRegistry{
-				Redis: NewComponentDeviceRegistryRedis(*config, "as"),
+			switch provider := config.AS.DeviceRegistry.Provider; provider {
+			case "redis":
+				config.AS.Devices = &asredis.DeviceRegistry{
+					Redis: NewComponentDeviceRegistryRedis(*config, "as"),
+				}
+			case "sql":
+				db, err := dbs.Open(ctx, config.AS.DeviceRegistry.SQL.DatabaseURI)
+				if err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				config.AS.Devices = &assql.DeviceRegistry{DB: db}
+			default:
+				return shared.ErrInitializeApplicationServer.WithCause(errDeviceRegistryProvider.WithAttributes("provider", provider))
 			}
 			config.AS.PubSub.Registry = &asiopsredis.PubSubRegistry{
 				Redis: redis.New(config.Redis.WithNamespace("as", "io", "pubsub")),
@@ -277,10 +317,40 @@ var startCommand = &cobra.Command{
 			config.AS.Packages.Registry = &asioapredis.ApplicationPackagesRegistry{
 				Redis: redis.New(config.Redis.WithNamespace("as", "io", "applicationpackages")),
 			}
+			var asWebhookRequests *asiowebredis.RequestQueue
 			if config.AS.Webhooks.Target != "" {
 				config.AS.Webhooks.Registry = &asiowebredis.WebhookRegistry{
-					Redis: redis.New(config.Redis.WithNamespace("as", "io", "webhooks")),
+					Redis:    redis.New(config.Redis.WithNamespace("as", "io", "webhooks")),
+					KeyVault: c.KeyVault,
+					KEKLabel: config.AS.Webhooks.KEKLabel,
+				}
+				if config.AS.Webhooks.PersistentQueue.Enable {
+					asWebhookRequests = asiowebredis.NewRequestQueue(
+						redis.New(config.Redis.WithNamespace("as", "io", "webhooks", "requests")),
+						100000, "as", redisConsumerID,
+					)
+					if err := asWebhookRequests.Init(); err != nil {
+						return shared.ErrInitializeApplicationServer.WithCause(err)
+					}
+					config.AS.Webhooks.PersistentQueue.Queue = asWebhookRequests
+					config.AS.Webhooks.PersistentQueue.DeadLetters = &asiowebredis.DeadLetterRegistry{
+						Redis:  redis.New(config.Redis.WithNamespace("as", "io", "webhooks", "dead-letters")),
+						TTL:    config.AS.Webhooks.PersistentQueue.DeadLetterTTL,
+						MaxLen: config.AS.Webhooks.PersistentQueue.MaxDeadLetters,
+					}
+				}
+			}
+			switch config.AS.Storage.Provider {
+			case "redis":
+				config.AS.Storage.Storage = &asiostorageredis.Storage{
+					Redis: redis.New(config.Redis.WithNamespace("as", "io", "storage")),
 				}
+			case "sql":
+				db, err := dbs.Open(ctx, config.AS.Storage.SQL.DatabaseURI)
+				if err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				config.AS.Storage.Storage = &asiostoragesql.Storage{DB: db}
 			}
 			fetcher, err := config.AS.EndDeviceFetcher.NewFetcher(c)
 			if err != nil {
@@ -291,16 +361,36 @@ var startCommand = &cobra.Command{
 			if err != nil {
 				return shared.ErrInitializeApplicationServer.WithCause(err)
 			}
-			_ = as
+			if asWebhookRequests != nil {
+				as.Component.RegisterTask(&component.TaskConfig{
+					Context: as.Context(),
+					ID:      "queue_webhook_requests",
+					Func:    asWebhookRequests.Run,
+					Restart: component.TaskRestartOnFailure,
+					Backoff: component.DefaultTaskBackoffConfig,
+				})
+			}
 		}
 
 		if start.JoinServer {
 			logger.Info("Setting up Join Server")
-			config.JS.Devices = &jsredis.DeviceRegistry{
-				Redis: NewComponentDeviceRegistryRedis(*config, "js"),
-			}
-			config.JS.Keys = &jsredis.KeyRegistry{
-				Redis: redis.New(config.Redis.WithNamespace("js", "keys")),
+			switch provider := config.JS.DeviceRegistry.Provider; provider {
+			case "redis":
+				config.JS.Devices = &jsredis.DeviceRegistry{
+					Redis: NewComponentDeviceRegistryRedis(*config, "js"),
+				}
+				config.JS.Keys = &jsredis.KeyRegistry{
+					Redis: redis.New(config.Redis.WithNamespace("js", "keys")),
+				}
+			case "sql":
+				db, err := dbs.Open(ctx, config.JS.DeviceRegistry.SQL.DatabaseURI)
+				if err != nil {
+					return shared.ErrInitializeJoinServer.WithCause(err)
+				}
+				config.JS.Devices = &jssql.DeviceRegistry{DB: db}
+				config.JS.Keys = &jssql.KeyRegistry{DB: db}
+			default:
+				return shared.ErrInitializeJoinServer.WithCause(errDeviceRegistryProvider.WithAttributes("provider", provider))
 			}
 			config.JS.ApplicationActivationSettings = &jsredis.ApplicationActivationSettingRegistry{
 				Redis: redis.New(config.Redis.WithNamespace("js", "application-activation-settings")),
@@ -366,6 +456,20 @@ var startCommand = &cobra.Command{
 			_ = pba
 		}
 
+		if start.DeviceClaimingServer {
+			logger.Info("Setting up Device Claiming Server")
+			config.DCS.AuthorizedApplications = &dcsredis.AuthorizedApplicationRegistry{
+				Redis:    redis.New(config.Redis.WithNamespace("dcs", "authorized-applications")),
+				KeyVault: c.KeyVault,
+				KEKLabel: config.DCS.KEKLabel,
+			}
+			dcs, err := deviceclaimingserver.New(c, &config.DCS)
+			if err != nil {
+				return shared.ErrInitializeDeviceClaimingServer.WithCause(err)
+			}
+			_ = dcs
+		}
+
 		if rootRedirect != nil {
 			c.RegisterWeb(rootRedirect)
 		}
@@ -31,8 +31,11 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
-	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt" // The MQTT integration provider
-	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/nats" // The NATS integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/awsiot" // The AWS IoT integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/kafka"  // The Kafka integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt"   // The MQTT integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/nats"   // The NATS integration provider
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
@@ -45,6 +48,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/cayennelpp"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/devicerepository"
+	mpgrpc "go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpc"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/javascript"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
@@ -68,6 +73,7 @@ type ApplicationServer struct {
 	webhookTemplates web.TemplateStore
 	pubsub           *pubsub.PubSub
 	appPackages      packages.Server
+	storage          storage.Server
 
 	links              sync.Map
 	linkErrors         sync.Map
@@ -126,6 +132,11 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		formatters: payloadFormatters(map[ttnpb.PayloadFormatter]messageprocessors.PayloadEncodeDecoder{
 			ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: javascript.New(),
 			ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP: cayennelpp.New(),
+			ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE: mpgrpc.New(ctx, conf.Formatters.GRPCService,
+				mpgrpc.WithTLSConfig(func(ctx context.Context) (*tls.Config, error) {
+					return c.GetTLSClientConfig(ctx)
+				}),
+			),
 		}),
 		interopClient:    interopCl,
 		interopID:        conf.Interop.ID,
@@ -135,6 +146,14 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 	if as.endDeviceFetcher == nil {
 		as.endDeviceFetcher = &NoopEndDeviceFetcher{}
 	}
+
+	deviceRepository, err := baseConf.DeviceRepositoryFetcher(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if deviceRepository != nil {
+		as.formatters[ttnpb.PayloadFormatter_FORMATTER_REPOSITORY] = devicerepository.New(deviceRepository, conf.Formatters.Repository)
+	}
 	retryIO := io.NewRetryServer(as)
 
 	as.grpc.asDevices = asEndDeviceRegistryServer{
@@ -215,6 +234,13 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		c.RegisterGRPC(as.appPackages)
 	}
 
+	if as.storage, err = conf.Storage.NewStorage(c); err != nil {
+		return nil, err
+	} else if as.storage != nil {
+		as.defaultSubscribers = append(as.defaultSubscribers, as.storage.NewSubscription())
+		c.RegisterGRPC(as.storage)
+	}
+
 	c.RegisterGRPC(as)
 	if as.linkMode == LinkAll {
 		c.RegisterTask(&component.TaskConfig{
@@ -234,7 +260,7 @@ func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterAsEndDeviceRegistryServer(s, as.grpc.asDevices)
 	ttnpb.RegisterAppAsServer(s, as.grpc.appAs)
 	if as.webhooks != nil {
-		ttnpb.RegisterApplicationWebhookRegistryServer(s, web.NewWebhookRegistryRPC(as.webhooks.Registry(), as.webhookTemplates))
+		ttnpb.RegisterApplicationWebhookRegistryServer(s, web.NewWebhookRegistryRPC(as.webhooks.Registry(), as.webhookTemplates, as.webhooks.DeadLetters()))
 	}
 	if as.pubsub != nil {
 		ttnpb.RegisterApplicationPubSubRegistryServer(s, as.pubsub)
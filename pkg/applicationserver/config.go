@@ -24,11 +24,14 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	loraclouddevicemanagementv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loradms/v1"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/devicerepository"
+	mpgrpc "go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpc"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
@@ -70,12 +73,15 @@ type EndDeviceFetcherCacheConfig struct {
 type Config struct {
 	LinkMode         string                    `name:"link-mode" description:"Mode to link applications to their Network Server (all, explicit)"`
 	Devices          DeviceRegistry            `name:"-"`
+	DeviceRegistry   config.DeviceRegistry     `name:"device-registry" description:"End device registry configuration"`
 	Links            LinkRegistry              `name:"-"`
 	EndDeviceFetcher EndDeviceFetcherConfig    `name:"fetcher" description:"End Device fetcher configuration"`
 	MQTT             config.MQTT               `name:"mqtt" description:"MQTT configuration"`
 	Webhooks         WebhooksConfig            `name:"webhooks" description:"Webhooks configuration"`
 	PubSub           PubSubConfig              `name:"pubsub" description:"Pub/sub messaging configuration"`
 	Packages         ApplicationPackagesConfig `name:"packages" description:"Application packages configuration"`
+	Storage          StorageConfig             `name:"storage" description:"Storage integration configuration"`
+	Formatters       FormattersConfig          `name:"formatters" description:"Payload formatters configuration"`
 	Interop          InteropConfig             `name:"interop" description:"Interop client configuration"`
 	DeviceKEKLabel   string                    `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
 }
@@ -97,17 +103,22 @@ func (c Config) GetLinkMode() (LinkMode, error) {
 var (
 	errWebhooksRegistry = errors.DefineInvalidArgument("webhooks_registry", "invalid webhooks registry")
 	errWebhooksTarget   = errors.DefineInvalidArgument("webhooks_target", "invalid webhooks target `{target}`")
+	errWebhooksQueue    = errors.DefineInvalidArgument("webhooks_queue", "invalid webhooks persistent queue")
 )
 
 // WebhooksConfig defines the configuration of the webhooks integration.
 type WebhooksConfig struct {
-	Registry  web.WebhookRegistry `name:"-"`
-	Target    string              `name:"target" description:"Target of the integration (direct)"`
-	Timeout   time.Duration       `name:"timeout" description:"Wait timeout of the target to process the request"`
-	QueueSize int                 `name:"queue-size" description:"Number of requests to queue"`
-	Workers   int                 `name:"workers" description:"Number of workers to process requests"`
-	Templates web.TemplatesConfig `name:"templates" description:"The store of the webhook templates"`
-	Downlinks web.DownlinksConfig `name:"downlink" description:"The downlink queue operations configuration"`
+	Registry        web.WebhookRegistry       `name:"-"`
+	Target          string                    `name:"target" description:"Target of the integration (direct)"`
+	Timeout         time.Duration             `name:"timeout" description:"Wait timeout of the target to process the request"`
+	QueueSize       int                       `name:"queue-size" description:"Number of requests to queue"`
+	Workers         int                       `name:"workers" description:"Number of workers to process requests"`
+	KEKLabel        string                    `name:"kek-label" description:"Label of KEK used to encrypt webhook secrets at rest"`
+	SecretsTTL      time.Duration             `name:"secrets-ttl" description:"Time for which the secrets of a webhook are cached"`
+	Templates       web.TemplatesConfig       `name:"templates" description:"The store of the webhook templates"`
+	Downlinks       web.DownlinksConfig       `name:"downlink" description:"The downlink queue operations configuration"`
+	PersistentQueue web.PersistentQueueConfig `name:"persistent-queue" description:"Persistent queue configuration"`
+	Health          web.HealthConfig          `name:"health" description:"Health tracking configuration"`
 }
 
 // PubSubConfig contains go-cloud pub/sub configuration of the Application Server.
@@ -121,6 +132,18 @@ type ApplicationPackagesConfig struct {
 	Registry        packages.Registry `name:"-"`
 }
 
+// StorageConfig contains the storage integration configuration.
+type StorageConfig struct {
+	storage.Config `name:",squash"`
+	Storage        storage.Storage `name:"-"`
+}
+
+// FormattersConfig contains the configuration of the payload formatters.
+type FormattersConfig struct {
+	Repository  devicerepository.Config `name:"repository" description:"Device Repository payload formatter configuration"`
+	GRPCService mpgrpc.Config           `name:"grpc-service" description:"gRPC service payload formatter configuration"`
+}
+
 // NewWebhooks returns a new web.Webhooks based on the configuration.
 // If Target is empty, this method returns nil.
 func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.Webhooks, error) {
@@ -133,6 +156,8 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 			Client: &http.Client{
 				Timeout: c.Timeout,
 			},
+			Registry:   c.Registry,
+			SecretsTTL: c.SecretsTTL,
 		}
 	default:
 		return nil, errWebhooksTarget.WithAttributes("target", c.Target)
@@ -140,7 +165,28 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 	if c.Registry == nil {
 		return nil, errWebhooksRegistry.New()
 	}
-	if c.QueueSize > 0 || c.Workers > 0 {
+	if c.Health.Enable {
+		target = &web.HealthCheckSink{
+			Target:       target,
+			Registry:     c.Registry,
+			SuspendAfter: c.Health.SuspendAfter,
+			CacheTTL:     c.Health.CacheTTL,
+		}
+	}
+	if c.PersistentQueue.Enable {
+		if c.PersistentQueue.Queue == nil || c.PersistentQueue.DeadLetters == nil {
+			return nil, errWebhooksQueue.New()
+		}
+		target = &web.PersistentSink{
+			Target:      target,
+			Registry:    c.Registry,
+			Queue:       c.PersistentQueue.Queue,
+			DeadLetters: c.PersistentQueue.DeadLetters,
+			Workers:     c.Workers,
+			Retry:       c.PersistentQueue.Retry,
+			Timeout:     c.Timeout,
+		}
+	} else if c.QueueSize > 0 || c.Workers > 0 {
 		target = &web.QueuedSink{
 			Target:  target,
 			Queue:   make(chan *http.Request, c.QueueSize),
@@ -181,6 +227,20 @@ func (c ApplicationPackagesConfig) NewApplicationPackages(ctx context.Context, s
 	return packages.New(ctx, server, c.Registry, handlers)
 }
 
+var errStorageProvider = errors.DefineInvalidArgument("storage_provider", "invalid storage provider `{provider}`")
+
+// NewStorage returns a new storage integration frontend based on the configuration.
+// If the provider is empty, it returns nil.
+func (c StorageConfig) NewStorage(comp *component.Component) (storage.Server, error) {
+	if c.Provider == "" {
+		return nil, nil
+	}
+	if c.Storage == nil {
+		return nil, errStorageProvider.WithAttributes("provider", c.Provider)
+	}
+	return storage.New(comp, c.Storage, c.Config)
+}
+
 var (
 	errInvalidTTL = errors.DefineInvalidArgument("invalid_ttl", "Invalid TTL `{ttl}`")
 )
@@ -229,3 +229,110 @@ func (r asEndDeviceRegistryServer) Delete(ctx context.Context, ids *ttnpb.EndDev
 	}
 	return ttnpb.Empty, nil
 }
+
+// archivePaths are the paths of the end device fields stored by the Application Server, which are included in archives.
+var archivePaths = []string{
+	"formatters",
+	"ids",
+	"pending_session",
+	"session",
+	"skip_payload_crypto",
+	"skip_payload_crypto_override",
+	"version_ids",
+}
+
+// archivedAppSKeys returns the AppSKey envelopes of dev, which are stored by the Application Server.
+func archivedAppSKeys(dev *ttnpb.EndDevice) []**ttnpb.KeyEnvelope {
+	var kes []**ttnpb.KeyEnvelope
+	if dev.Session != nil && dev.Session.AppSKey != nil {
+		kes = append(kes, &dev.Session.AppSKey)
+	}
+	if dev.PendingSession != nil && dev.PendingSession.AppSKey != nil {
+		kes = append(kes, &dev.PendingSession.AppSKey)
+	}
+	return kes
+}
+
+// Export implements ttnpb.AsEndDeviceRegistryServer.
+func (r asEndDeviceRegistryServer) Export(ctx context.Context, req *ttnpb.ExportEndDevicesRequest) (*ttnpb.EndDeviceArchive, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	devs := make([]*ttnpb.EndDevice, 0, len(req.DeviceIDs))
+	for _, devID := range req.DeviceIDs {
+		dev, err := r.AS.deviceRegistry.Get(ctx, ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: req.ApplicationIdentifiers,
+			DeviceID:               devID,
+		}, archivePaths)
+		if err != nil {
+			return nil, err
+		}
+		if !dev.SkipPayloadCryptoOverride.GetValue() {
+			for _, ke := range archivedAppSKeys(dev) {
+				if *ke, err = cryptoutil.UnwrapKeyEnvelope(ctx, *ke, r.AS.KeyVault); err != nil {
+					return nil, err
+				}
+			}
+		}
+		devs = append(devs, dev)
+	}
+	return cryptoutil.WrapEndDeviceArchive(ctx, devs, req.KEKLabel, r.AS.KeyVault)
+}
+
+// Import implements ttnpb.AsEndDeviceRegistryServer.
+func (r asEndDeviceRegistryServer) Import(ctx context.Context, req *ttnpb.ImportEndDevicesRequest) (*ttnpb.EndDevices, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	devs, err := cryptoutil.UnwrapEndDeviceArchive(ctx, req.Archive, r.AS.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+	for _, dev := range devs {
+		if dev.ApplicationIdentifiers != req.ApplicationIdentifiers {
+			return nil, errInvalidFieldValue.WithAttributes("field", "ids.application_ids")
+		}
+		if err := dev.ValidateFields(); err != nil {
+			return nil, err
+		}
+		for _, ke := range archivedAppSKeys(dev) {
+			if (*ke).GetKey() == nil {
+				continue
+			}
+			if *ke, err = cryptoutil.WrapAES128Key(ctx, *(*ke).Key, r.kekLabel, r.AS.KeyVault); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	res := &ttnpb.EndDevices{
+		EndDevices: make([]*ttnpb.EndDevice, 0, len(devs)),
+	}
+	for _, dev := range devs {
+		var evt events.Event
+		stored, err := r.AS.deviceRegistry.Set(ctx, dev.EndDeviceIdentifiers, archivePaths, func(stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored != nil {
+				evt = evtUpdateEndDevice.NewWithIdentifiersAndData(ctx, dev.EndDeviceIdentifiers, archivePaths)
+			} else {
+				evt = evtCreateEndDevice.NewWithIdentifiersAndData(ctx, dev.EndDeviceIdentifiers, nil)
+			}
+			return dev, archivePaths, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if evt != nil {
+			events.Publish(evt)
+		}
+		res.EndDevices = append(res.EndDevices, &ttnpb.EndDevice{
+			EndDeviceIdentifiers: stored.EndDeviceIdentifiers,
+		})
+	}
+	return res, nil
+}
@@ -726,3 +726,163 @@ func TestDeviceRegistryDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceRegistryExportImport(t *testing.T) {
+	registeredApplicationID := "foo-application"
+	registeredDeviceID := "foo-device"
+	registeredDevice := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: registeredApplicationID,
+			},
+			DeviceID: registeredDeviceID,
+			DevAddr:  &types.DevAddr{0x42, 0xff, 0xff, 0xff},
+		},
+		Formatters: &ttnpb.MessagePayloadFormatters{
+			UpFormatter:   ttnpb.PayloadFormatter_FORMATTER_REPOSITORY,
+			DownFormatter: ttnpb.PayloadFormatter_FORMATTER_REPOSITORY,
+		},
+		Session: &ttnpb.Session{
+			DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+			SessionKeys: ttnpb.SessionKeys{
+				SessionKeyID: []byte{0x11},
+				AppSKey: &ttnpb.KeyEnvelope{
+					KEKLabel:     "test",
+					EncryptedKey: []byte{0x96, 0x77, 0x8b, 0x25, 0xae, 0x6c, 0xa4, 0x35, 0xf9, 0x2b, 0x5b, 0x97, 0xc0, 0x50, 0xae, 0xd2, 0x46, 0x8a, 0xb8, 0xa1, 0x7a, 0xd8, 0x4e, 0x5d},
+				},
+			},
+			LastAFCntDown: 42,
+		},
+	}
+	registeredKEKs := map[string][]byte{
+		"test": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17},
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		ContextFunc    func(context.Context) context.Context
+		ErrorAssertion func(*testing.T, error) bool
+	}{
+		{
+			Name: "Permission denied",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+						),
+					},
+				})
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			},
+		},
+
+		{
+			Name: "Round trip",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+							ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+						),
+					},
+				})
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var imported *ttnpb.EndDevice
+
+			as := test.Must(New(
+				componenttest.NewComponent(t, &component.Config{
+					ServiceBase: config.ServiceBase{
+						KeyVault: config.KeyVault{
+							Provider: "static",
+							Static:   registeredKEKs,
+						},
+					},
+				}),
+				&Config{
+					LinkMode: "explicit",
+					Devices: &MockDeviceRegistry{
+						GetFunc: func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.EndDevice, error) {
+							a := assertions.New(test.MustTFromContext(ctx))
+							a.So(ids, should.Resemble, ttnpb.EndDeviceIdentifiers{
+								ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID},
+								DeviceID:               registeredDeviceID,
+							})
+							return ttnpb.FilterGetEndDevice(deepcopy.Copy(registeredDevice).(*ttnpb.EndDevice), paths...)
+						},
+						SetFunc: func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+							a := assertions.New(test.MustTFromContext(ctx))
+							a.So(ids, should.Resemble, registeredDevice.EndDeviceIdentifiers)
+							dev, sets, err := cb(nil)
+							if err != nil {
+								return nil, err
+							}
+							imported, err = ttnpb.ApplyEndDeviceFieldMask(&ttnpb.EndDevice{}, dev, sets...)
+							if err != nil {
+								return nil, err
+							}
+							return ttnpb.FilterGetEndDevice(imported, paths...)
+						},
+					},
+				})).(*ApplicationServer)
+
+			as.AddContextFiller(tc.ContextFunc)
+			as.AddContextFiller(func(ctx context.Context) context.Context {
+				ctx, cancel := context.WithDeadline(ctx, time.Now().Add(Timeout))
+				_ = cancel
+				return ctx
+			})
+			as.AddContextFiller(func(ctx context.Context) context.Context {
+				return test.ContextWithTB(ctx, t)
+			})
+			componenttest.StartComponent(t, as.Component)
+			defer as.Close()
+
+			ctx := as.FillContext(test.Context())
+			cl := ttnpb.NewAsEndDeviceRegistryClient(as.LoopbackConn())
+
+			archive, err := cl.Export(ctx, &ttnpb.ExportEndDevicesRequest{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID},
+				DeviceIDs:              []string{registeredDeviceID},
+				KEKLabel:               "test",
+			})
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(t, err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			res, err := cl.Import(ctx, &ttnpb.ImportEndDevicesRequest{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID},
+				Archive:                archive,
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(res.EndDevices, should.Resemble, []*ttnpb.EndDevice{
+				{
+					EndDeviceIdentifiers: registeredDevice.EndDeviceIdentifiers,
+				},
+			})
+
+			expected := deepcopy.Copy(registeredDevice).(*ttnpb.EndDevice)
+			expected.Session.AppSKey = &ttnpb.KeyEnvelope{
+				EncryptedKey: []byte{0x0, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff},
+			}
+			a.So(imported, should.Resemble, expected)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package awsiot implements the AWS IoT provider using the MQTT driver.
+package awsiot
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// signingName is the name of the AWS IoT MQTT service used for signing requests.
+	signingName = "iotdevicegateway"
+	// endpointType is the type of the AWS IoT data endpoint with Amazon Trust Services signed certificates.
+	endpointType = "iot:Data-ATS"
+	// webSocketBufferSize is the size of the WebSocket read and write buffers.
+	webSocketBufferSize = 1 << 12
+)
+
+var (
+	// certificateServerURL returns the MQTT server URL of the endpoint when using X.509 client certificates.
+	certificateServerURL = func(endpoint string) string {
+		return fmt.Sprintf("ssl://%s:8883", endpoint)
+	}
+	// credentialsServerURL returns the MQTT server URL of the endpoint when using AWS credentials.
+	credentialsServerURL = func(endpoint string) string {
+		return fmt.Sprintf("wss://%s:443/mqtt", endpoint)
+	}
+	// rootCAs are the root certificate authorities of the endpoint. If nil, the system roots are used.
+	rootCAs *x509.CertPool
+)
+
+type impl struct {
+}
+
+var (
+	errSession          = errors.DefineUnavailable("session", "create AWS session")
+	errDiscoverEndpoint = errors.DefineUnavailable("discover_endpoint", "discover AWS IoT endpoint")
+	errClientCert       = errors.DefineInvalidArgument("client_cert", "invalid client certificate")
+	errSignRequest      = errors.Define("sign_request", "sign request")
+)
+
+func newSession(settings *ttnpb.ApplicationPubSub_AWSIoTProvider) (*session.Session, error) {
+	config := aws.NewConfig().WithRegion(settings.Region)
+	if accessKey := settings.AccessKey; accessKey != nil {
+		config = config.WithCredentials(credentials.NewStaticCredentials(
+			accessKey.AccessKeyID,
+			accessKey.SecretAccessKey,
+			accessKey.SessionToken,
+		))
+	}
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, errSession.WithCause(err)
+	}
+	if assumeRole := settings.AssumeRole; assumeRole != nil {
+		sess = sess.Copy(aws.NewConfig().WithCredentials(stscreds.NewCredentials(sess, assumeRole.ARN, func(p *stscreds.AssumeRoleProvider) {
+			if assumeRole.ExternalID != "" {
+				p.ExternalID = aws.String(assumeRole.ExternalID)
+			}
+			if assumeRole.SessionDuration != nil {
+				p.Duration = *assumeRole.SessionDuration
+			}
+		})))
+	}
+	return sess, nil
+}
+
+func discoverEndpoint(ctx context.Context, sess *session.Session) (string, error) {
+	res, err := iot.New(sess).DescribeEndpointWithContext(ctx, &iot.DescribeEndpointInput{
+		EndpointType: aws.String(endpointType),
+	})
+	if err != nil {
+		return "", errDiscoverEndpoint.WithCause(err)
+	}
+	return aws.StringValue(res.EndpointAddress), nil
+}
+
+// signedHeaders returns the HTTP headers that authenticate the WebSocket connection to the endpoint with the given
+// credentials, using AWS Signature Version 4.
+func signedHeaders(creds *credentials.Credentials, region, endpoint string, now time.Time) (http.Header, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("https://%s/mqtt", endpoint), nil)
+	if err != nil {
+		return nil, errSignRequest.WithCause(err)
+	}
+	if _, err := v4.NewSigner(creds).Sign(req, nil, signingName, region, now); err != nil {
+		return nil, errSignRequest.WithCause(err)
+	}
+	return req.Header, nil
+}
+
+func clientID(target provider.Target) string {
+	if pb, ok := target.(*ttnpb.ApplicationPubSub); ok {
+		return fmt.Sprintf("%s.%s", pb.ApplicationID, pb.PubSubID)
+	}
+	return random.String(16)
+}
+
+// OpenConnection implements provider.Provider using the MQTT driver.
+func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *provider.Connection, err error) {
+	settings, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_AWSIoT)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	sess, err := newSession(settings.AWSIoT)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := settings.AWSIoT.EndpointAddress
+	if endpoint == "" {
+		if endpoint, err = discoverEndpoint(ctx, sess); err != nil {
+			return nil, err
+		}
+	}
+	mqttSettings := mqtt.Settings{
+		ClientID:     clientID(target),
+		PublishQoS:   1,
+		SubscribeQoS: 1,
+	}
+	if len(settings.AWSIoT.TLSClientCert) > 0 {
+		cert, err := tls.X509KeyPair(settings.AWSIoT.TLSClientCert, settings.AWSIoT.TLSClientKey)
+		if err != nil {
+			return nil, errClientCert.WithCause(err)
+		}
+		mqttSettings.URL = certificateServerURL(endpoint)
+		mqttSettings.TLS = &tls.Config{
+			RootCAs:      rootCAs,
+			Certificates: []tls.Certificate{cert},
+		}
+	} else {
+		region := settings.AWSIoT.Region
+		mqttSettings.URL = credentialsServerURL(endpoint)
+		mqttSettings.TLS = &tls.Config{
+			RootCAs: rootCAs,
+		}
+		mqttSettings.HTTPHeadersProvider = func(ctx context.Context) (http.Header, error) {
+			return signedHeaders(sess.Config.Credentials, region, endpoint, time.Now())
+		}
+		mqttSettings.WebSocketReadBufferSize = webSocketBufferSize
+		mqttSettings.WebSocketWriteBufferSize = webSocketBufferSize
+	}
+	return mqtt.OpenConnection(ctx, mqttSettings, target)
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_AWSIoT{}, impl{})
+}
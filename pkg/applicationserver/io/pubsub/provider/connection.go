@@ -79,11 +79,17 @@ type ProviderConnection interface {
 	Shutdowner
 }
 
+// DeviceIDMetadataKey is the metadata key of the end device ID in upstream messages.
+const DeviceIDMetadataKey = "device_id"
+
 // Connection is a wrapper that wraps the topics and subscriptions with a ProviderConnection.
 type Connection struct {
 	Topics             UplinkTopics
 	Subscriptions      DownlinkSubscriptions
 	ProviderConnection ProviderConnection
+	// DeviceIDMetadata indicates that upstream messages should contain the end device ID as metadata.
+	// The metadata is stored under DeviceIDMetadataKey.
+	DeviceIDMetadata bool
 }
 
 // Shutdown shuts down the topics, subscriptions and the connections if required.
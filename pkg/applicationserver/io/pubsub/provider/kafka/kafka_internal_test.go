@@ -0,0 +1,179 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCombineTopics(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		topic1   string
+		topic2   string
+		expected string
+	}{
+		{
+			name:     "EmptyTopic1",
+			topic1:   "",
+			topic2:   "bar.bar2",
+			expected: "bar.bar2",
+		},
+		{
+			name:     "EmptyTopic2",
+			topic1:   "foo.foo2",
+			topic2:   "",
+			expected: "foo.foo2",
+		},
+		{
+			name:     "BothProvided",
+			topic1:   "foo.foo2",
+			topic2:   "bar.bar2",
+			expected: "foo.foo2.bar.bar2",
+		},
+		{
+			name:     "NoneProvided",
+			topic1:   "",
+			topic2:   "",
+			expected: "",
+		},
+		{
+			name:     "Trailing",
+			topic1:   ".foo.test.",
+			topic2:   ".bar.",
+			expected: "foo.test.bar",
+		},
+		{
+			name:     "Slashes",
+			topic1:   "foo/test/",
+			topic2:   "/bar",
+			expected: "foo.test.bar",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(combineTopics(tc.topic1, tc.topic2), should.Equal, tc.expected)
+		})
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		settings       *ttnpb.ApplicationPubSub_KafkaProvider
+		assertion      func(*assertions.Assertion, *sarama.Config)
+		errorAssertion func(error) bool
+	}{
+		{
+			name: "Plain",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9092"},
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.SASL.Enable, should.BeFalse)
+				a.So(config.Net.TLS.Enable, should.BeFalse)
+			},
+		},
+		{
+			name: "SASLPlain",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9092"},
+				SASL: &ttnpb.ApplicationPubSub_KafkaProvider_SASL{
+					Mechanism: ttnpb.ApplicationPubSub_KafkaProvider_SASL_PLAIN,
+					Username:  "user",
+					Password:  "pass",
+				},
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.SASL.Enable, should.BeTrue)
+				a.So(config.Net.SASL.Mechanism, should.Equal, sarama.SASLMechanism(sarama.SASLTypePlaintext))
+				a.So(config.Net.SASL.User, should.Equal, "user")
+				a.So(config.Net.SASL.Password, should.Equal, "pass")
+			},
+		},
+		{
+			name: "SASLSCRAMSHA512",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9092"},
+				SASL: &ttnpb.ApplicationPubSub_KafkaProvider_SASL{
+					Mechanism: ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_512,
+					Username:  "user",
+					Password:  "pass",
+				},
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.SASL.Enable, should.BeTrue)
+				a.So(config.Net.SASL.Mechanism, should.Equal, sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA512))
+				if a.So(config.Net.SASL.SCRAMClientGeneratorFunc, should.NotBeNil) {
+					client := config.Net.SASL.SCRAMClientGeneratorFunc()
+					a.So(client.Begin("user", "pass", ""), should.BeNil)
+					a.So(client.Done(), should.BeFalse)
+				}
+			},
+		},
+		{
+			name: "SASLUnknownMechanism",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9092"},
+				SASL: &ttnpb.ApplicationPubSub_KafkaProvider_SASL{
+					Mechanism: ttnpb.ApplicationPubSub_KafkaProvider_SASL_Mechanism(42),
+				},
+			},
+			errorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			name: "TLSWithoutClientCertificate",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9092"},
+				UseTLS:  true,
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.TLS.Enable, should.BeTrue)
+				if a.So(config.Net.TLS.Config, should.NotBeNil) {
+					a.So(config.Net.TLS.Config.RootCAs, should.BeNil)
+					a.So(config.Net.TLS.Config.Certificates, should.BeEmpty)
+				}
+			},
+		},
+		{
+			name: "TLSInvalidCA",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9092"},
+				UseTLS:  true,
+				TLSCA:   []byte("invalid"),
+			},
+			errorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			config, err := newConfig(tc.settings)
+			if tc.errorAssertion != nil {
+				a.So(tc.errorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			tc.assertion(a, config)
+		})
+	}
+}
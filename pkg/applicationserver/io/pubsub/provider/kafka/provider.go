@@ -0,0 +1,189 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kafka implements the Kafka provider using the kafkapubsub driver.
+package kafka
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/kafkapubsub"
+)
+
+var (
+	errSASLMechanism = errors.DefineInvalidArgument("sasl_mechanism", "unsupported SASL mechanism `{mechanism}`")
+	errConfig        = errors.DefineInvalidArgument("config", "invalid Kafka configuration")
+)
+
+type impl struct {
+}
+
+// newConfig returns the sarama configuration for the given provider settings.
+func newConfig(settings *ttnpb.ApplicationPubSub_KafkaProvider) (*sarama.Config, error) {
+	config := kafkapubsub.MinimalConfig()
+	config.Version = sarama.V1_0_0_0
+	// Use the device ID message key to partition the messages, such that the message order is preserved per device.
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	if sasl := settings.GetSASL(); sasl != nil {
+		config.Net.SASL.Enable = true
+		config.Net.SASL.Handshake = true
+		config.Net.SASL.User = sasl.Username
+		config.Net.SASL.Password = sasl.Password
+		switch sasl.Mechanism {
+		case ttnpb.ApplicationPubSub_KafkaProvider_SASL_PLAIN:
+			config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		case ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_256:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &scramClient{HashGeneratorFcn: sha256HashGenerator}
+			}
+		case ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_512:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &scramClient{HashGeneratorFcn: sha512HashGenerator}
+			}
+		default:
+			return nil, errSASLMechanism.WithAttributes("mechanism", sasl.Mechanism.String())
+		}
+	}
+	if settings.UseTLS {
+		tlsConfig, err := createTLSConfig(settings.TLSCA, settings.TLSClientCert, settings.TLSClientKey)
+		if err != nil {
+			return nil, err
+		}
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsConfig
+	}
+	if err := config.Validate(); err != nil {
+		return nil, errConfig.WithCause(err)
+	}
+	return config, nil
+}
+
+// OpenConnection implements provider.Provider using the kafkapubsub package.
+func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *provider.Connection, err error) {
+	settings, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_Kafka)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	config, err := newConfig(settings.Kafka)
+	if err != nil {
+		return nil, err
+	}
+	// The Kafka producers and consumers are owned by the topics and subscriptions, so there is no provider connection.
+	pc = &provider.Connection{
+		DeviceIDMetadata: true,
+	}
+	defer func() {
+		if err != nil {
+			pc.Shutdown(ctx)
+		}
+	}()
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &pc.Topics.UplinkMessage,
+			message: target.GetUplinkMessage(),
+		},
+		{
+			topic:   &pc.Topics.JoinAccept,
+			message: target.GetJoinAccept(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkAck,
+			message: target.GetDownlinkAck(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkNack,
+			message: target.GetDownlinkNack(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkSent,
+			message: target.GetDownlinkSent(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkFailed,
+			message: target.GetDownlinkFailed(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueued,
+			message: target.GetDownlinkQueued(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueueInvalidated,
+			message: target.GetDownlinkQueueInvalidated(),
+		},
+		{
+			topic:   &pc.Topics.LocationSolved,
+			message: target.GetLocationSolved(),
+		},
+		{
+			topic:   &pc.Topics.ServiceData,
+			message: target.GetServiceData(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		if *t.topic, err = kafkapubsub.OpenTopic(
+			settings.Kafka.Brokers,
+			config,
+			combineTopics(target.GetBaseTopic(), t.message.GetTopic()),
+			&kafkapubsub.TopicOptions{
+				KeyName: provider.DeviceIDMetadataKey,
+			},
+		); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &pc.Subscriptions.Push,
+			message:      target.GetDownlinkPush(),
+		},
+		{
+			subscription: &pc.Subscriptions.Replace,
+			message:      target.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		if *s.subscription, err = kafkapubsub.OpenSubscription(
+			settings.Kafka.Brokers,
+			config,
+			settings.Kafka.ConsumerGroup,
+			[]string{combineTopics(target.GetBaseTopic(), s.message.GetTopic())},
+			&kafkapubsub.SubscriptionOptions{},
+		); err != nil {
+			return nil, err
+		}
+	}
+	return pc, nil
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_Kafka{}, impl{})
+}
@@ -0,0 +1,34 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"fmt"
+	"strings"
+)
+
+// combineTopics combines the base topic and the message topic to a Kafka topic name.
+// As Kafka does not support hierarchical topics, the separators are normalized to dots.
+func combineTopics(t1, t2 string) string {
+	t1 = strings.Trim(strings.ReplaceAll(t1, "/", "."), ".")
+	t2 = strings.Trim(strings.ReplaceAll(t2, "/", "."), ".")
+	if t1 == "" {
+		return t2
+	}
+	if t2 == "" {
+		return t1
+	}
+	return fmt.Sprintf("%s.%s", t1, t2)
+}
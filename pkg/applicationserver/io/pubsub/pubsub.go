@@ -148,9 +148,15 @@ func (i *integration) handleUp(ctx context.Context) {
 				logger.WithError(err).Warn("Failed to marshal upstream message")
 				continue
 			}
-			err = topic.Send(ctx, &pubsub.Message{
+			msg := &pubsub.Message{
 				Body: buf,
-			})
+			}
+			if i.conn.DeviceIDMetadata {
+				msg.Metadata = map[string]string{
+					provider.DeviceIDMetadataKey: up.DeviceID,
+				}
+			}
+			err = topic.Send(ctx, msg)
 			if err != nil {
 				logger.WithError(err).Warn("Failed to publish upstream message")
 				i.cancel(err)
@@ -0,0 +1,39 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import "time"
+
+// DefaultRetentionInterval is the default interval at which messages outside of the retention are removed.
+const DefaultRetentionInterval = time.Hour
+
+// Config contains configuration options for the storage integration.
+type Config struct {
+	Provider  string          `name:"provider" description:"Storage provider (redis, sql)"`
+	SQL       SQLConfig       `name:"sql" description:"SQL storage configuration"`
+	Retention RetentionConfig `name:"retention" description:"Retention of stored messages"`
+}
+
+// SQLConfig contains configuration options for the SQL storage provider.
+type SQLConfig struct {
+	DatabaseURI string `name:"database-uri" description:"Database connection URI"`
+}
+
+// RetentionConfig defines which stored messages are retained.
+type RetentionConfig struct {
+	TTL      time.Duration `name:"ttl" description:"Duration for which messages are retained (0 is unlimited)"`
+	Limit    int64         `name:"limit" description:"Maximum number of messages retained per application (0 is unlimited)"`
+	Interval time.Duration `name:"interval" description:"Interval at which messages outside of the retention are removed"`
+}
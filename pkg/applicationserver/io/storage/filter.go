@@ -0,0 +1,125 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// MessageType returns the type of the given upstream message, as used in ttnpb.GetStoredApplicationUpRequest.
+func MessageType(up *ttnpb.ApplicationUp) string {
+	switch up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return "uplink_message"
+	case *ttnpb.ApplicationUp_JoinAccept:
+		return "join_accept"
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return "downlink_ack"
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return "downlink_nack"
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return "downlink_sent"
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return "downlink_failed"
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return "downlink_queued"
+	case *ttnpb.ApplicationUp_DownlinkQueueInvalidated:
+		return "downlink_queue_invalidated"
+	case *ttnpb.ApplicationUp_LocationSolved:
+		return "location_solved"
+	case *ttnpb.ApplicationUp_ServiceData:
+		return "service_data"
+	default:
+		return ""
+	}
+}
+
+// Filter selects stored upstream messages.
+type Filter struct {
+	// ApplicationIDs are the identifiers of the application.
+	ApplicationIDs ttnpb.ApplicationIdentifiers
+	// EndDeviceIDs are the identifiers of the end device. If nil, messages of all end devices match.
+	EndDeviceIDs *ttnpb.EndDeviceIdentifiers
+	// Type is the message type, see MessageType. If empty, messages of all types match.
+	Type string
+	// After is the time after which messages are received.
+	After *time.Time
+	// Before is the time before which messages are received.
+	Before *time.Time
+	// FPort is the FPort of uplink messages. If set, only uplink messages match.
+	FPort *uint32
+	// Limit is the maximum number of messages. If zero, there is no limit.
+	Limit uint32
+	// Descending indicates whether messages are ordered by descending reception time.
+	Descending bool
+}
+
+// NewFilter returns the filter of the given request.
+func NewFilter(req *ttnpb.GetStoredApplicationUpRequest) Filter {
+	filter := Filter{
+		Type:       req.Type,
+		After:      req.After,
+		Before:     req.Before,
+		Descending: req.Order == "-received_at",
+	}
+	if req.EndDeviceIDs != nil {
+		filter.ApplicationIDs = req.EndDeviceIDs.ApplicationIdentifiers
+		filter.EndDeviceIDs = req.EndDeviceIDs
+	} else if req.ApplicationIDs != nil {
+		filter.ApplicationIDs = *req.ApplicationIDs
+	}
+	if req.FPort != nil {
+		fPort := req.FPort.Value
+		filter.FPort = &fPort
+	}
+	if req.Limit != nil {
+		filter.Limit = req.Limit.Value
+	}
+	return filter
+}
+
+// Match returns whether the given upstream message matches the filter.
+// The limit and order of the filter are not considered.
+func (f Filter) Match(up *ttnpb.ApplicationUp) bool {
+	if up.ApplicationID != f.ApplicationIDs.ApplicationID {
+		return false
+	}
+	if f.EndDeviceIDs != nil && up.DeviceID != f.EndDeviceIDs.DeviceID {
+		return false
+	}
+	if f.Type != "" && MessageType(up) != f.Type {
+		return false
+	}
+	if f.After != nil || f.Before != nil {
+		if up.ReceivedAt == nil {
+			return false
+		}
+		if f.After != nil && !up.ReceivedAt.After(*f.After) {
+			return false
+		}
+		if f.Before != nil && !up.ReceivedAt.Before(*f.Before) {
+			return false
+		}
+	}
+	if f.FPort != nil {
+		uplink := up.GetUplinkMessage()
+		if uplink == nil || uplink.FPort != *f.FPort {
+			return false
+		}
+	}
+	return true
+}
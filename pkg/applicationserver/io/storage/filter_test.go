@@ -0,0 +1,129 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestFilter(t *testing.T) {
+	now := time.Now().UTC()
+	before, after := now.Add(-time.Minute), now.Add(time.Minute)
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"}
+	devIDs := ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "foo-device"}
+	otherDevIDs := ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "bar-device"}
+
+	uplink := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: devIDs,
+		ReceivedAt:           &now,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{FPort: 42},
+		},
+	}
+	joinAccept := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: otherDevIDs,
+		ReceivedAt:           &now,
+		Up: &ttnpb.ApplicationUp_JoinAccept{
+			JoinAccept: &ttnpb.ApplicationJoinAccept{},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name    string
+		Request *ttnpb.GetStoredApplicationUpRequest
+		Match   []*ttnpb.ApplicationUp
+		NoMatch []*ttnpb.ApplicationUp
+	}{
+		{
+			Name:    "Application",
+			Request: &ttnpb.GetStoredApplicationUpRequest{ApplicationIDs: &appIDs},
+			Match:   []*ttnpb.ApplicationUp{uplink, joinAccept},
+		},
+		{
+			Name:    "EndDevice",
+			Request: &ttnpb.GetStoredApplicationUpRequest{EndDeviceIDs: &devIDs},
+			Match:   []*ttnpb.ApplicationUp{uplink},
+			NoMatch: []*ttnpb.ApplicationUp{joinAccept},
+		},
+		{
+			Name:    "Type",
+			Request: &ttnpb.GetStoredApplicationUpRequest{ApplicationIDs: &appIDs, Type: "join_accept"},
+			Match:   []*ttnpb.ApplicationUp{joinAccept},
+			NoMatch: []*ttnpb.ApplicationUp{uplink},
+		},
+		{
+			Name:    "FPort",
+			Request: &ttnpb.GetStoredApplicationUpRequest{ApplicationIDs: &appIDs, FPort: &pbtypes.UInt32Value{Value: 42}},
+			Match:   []*ttnpb.ApplicationUp{uplink},
+			NoMatch: []*ttnpb.ApplicationUp{joinAccept},
+		},
+		{
+			Name:    "OtherFPort",
+			Request: &ttnpb.GetStoredApplicationUpRequest{ApplicationIDs: &appIDs, FPort: &pbtypes.UInt32Value{Value: 1}},
+			NoMatch: []*ttnpb.ApplicationUp{uplink, joinAccept},
+		},
+		{
+			Name:    "Range",
+			Request: &ttnpb.GetStoredApplicationUpRequest{ApplicationIDs: &appIDs, After: &before, Before: &after},
+			Match:   []*ttnpb.ApplicationUp{uplink, joinAccept},
+		},
+		{
+			Name:    "After",
+			Request: &ttnpb.GetStoredApplicationUpRequest{ApplicationIDs: &appIDs, After: &now},
+			NoMatch: []*ttnpb.ApplicationUp{uplink, joinAccept},
+		},
+		{
+			Name:    "Before",
+			Request: &ttnpb.GetStoredApplicationUpRequest{ApplicationIDs: &appIDs, Before: &before},
+			NoMatch: []*ttnpb.ApplicationUp{uplink, joinAccept},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			filter := storage.NewFilter(tc.Request)
+			for _, up := range tc.Match {
+				a.So(filter.Match(up), should.BeTrue)
+			}
+			for _, up := range tc.NoMatch {
+				a.So(filter.Match(up), should.BeFalse)
+			}
+		})
+	}
+}
+
+func TestNewFilter(t *testing.T) {
+	a := assertions.New(t)
+	devIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		DeviceID:               "foo-device",
+	}
+	filter := storage.NewFilter(&ttnpb.GetStoredApplicationUpRequest{
+		EndDeviceIDs: &devIDs,
+		Limit:        &pbtypes.UInt32Value{Value: 10},
+		Order:        "-received_at",
+	})
+	a.So(filter.ApplicationIDs, should.Resemble, devIDs.ApplicationIdentifiers)
+	a.So(filter.EndDeviceIDs, should.Resemble, &devIDs)
+	a.So(filter.Limit, should.Equal, 10)
+	a.So(filter.Descending, should.BeTrue)
+}
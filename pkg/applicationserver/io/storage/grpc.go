@@ -0,0 +1,69 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errInvalidFieldMask = errors.DefineInvalidArgument("field_mask", "invalid field mask")
+
+// applyFieldMask returns a copy of up with only the given paths set. The end device identifiers are always set.
+// Paths of upstream message types other than the type of up are ignored.
+func applyFieldMask(up *ttnpb.ApplicationUp, paths ...string) (*ttnpb.ApplicationUp, error) {
+	prefix := "up." + MessageType(up)
+	gets := make([]string, 0, 1+len(paths))
+	gets = append(gets, "end_device_ids")
+	for _, path := range paths {
+		if strings.HasPrefix(path, "up.") && path != prefix && !strings.HasPrefix(path, prefix+".") {
+			continue
+		}
+		gets = append(gets, path)
+	}
+	res := &ttnpb.ApplicationUp{}
+	if err := res.SetFields(up, gets...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetStoredApplicationUp implements ttnpb.ApplicationUpStorageServer.
+func (s *server) GetStoredApplicationUp(req *ttnpb.GetStoredApplicationUpRequest, srv ttnpb.ApplicationUpStorage_GetStoredApplicationUpServer) error {
+	ctx := srv.Context()
+	filter := NewFilter(req)
+	if err := rights.RequireApplication(ctx, filter.ApplicationIDs, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return err
+	}
+	paths := req.FieldMask.Paths
+	if !ttnpb.HasOnlyAllowedFields(paths, ttnpb.ApplicationUpFieldPathsNested...) {
+		return errInvalidFieldMask.New()
+	}
+	return s.storage.Range(ctx, filter, func(up *ttnpb.ApplicationUp) (bool, error) {
+		if len(paths) > 0 {
+			var err error
+			if up, err = applyFieldMask(up, paths...); err != nil {
+				return false, err
+			}
+		}
+		if err := srv.Send(up); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+}
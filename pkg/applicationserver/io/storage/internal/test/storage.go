@@ -0,0 +1,219 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package test contains testing utilities usable by all storage implementations.
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func uplink(devID string, fPort uint32, receivedAt time.Time) *ttnpb.ApplicationUp {
+	return &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               devID,
+		},
+		ReceivedAt: &receivedAt,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:      fPort,
+				FCnt:       42,
+				FRMPayload: []byte{0x01, 0x02, 0x03},
+			},
+		},
+	}
+}
+
+func joinAccept(devID string, receivedAt time.Time) *ttnpb.ApplicationUp {
+	return &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               devID,
+		},
+		ReceivedAt: &receivedAt,
+		Up: &ttnpb.ApplicationUp_JoinAccept{
+			JoinAccept: &ttnpb.ApplicationJoinAccept{
+				SessionKeyID: []byte{0x11, 0x22},
+			},
+		},
+	}
+}
+
+// HandleStorageTest runs a storage.Storage test suite on s.
+func HandleStorageTest(t *testing.T, s storage.Storage) {
+	t.Helper()
+
+	ctx := test.Context()
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	now := time.Now().UTC()
+
+	ups := []*ttnpb.ApplicationUp{
+		uplink("test-dev-1", 1, now.Add(-3*time.Second)),
+		joinAccept("test-dev-2", now.Add(-2*time.Second)),
+		uplink("test-dev-2", 2, now.Add(-time.Second)),
+	}
+	other := uplink("test-dev-1", 1, now)
+	other.ApplicationID = "other-app"
+
+	for _, up := range append(ups, other) {
+		if err := s.Store(ctx, up); err != nil {
+			t.Fatalf("Failed to store message: %s", err)
+		}
+	}
+
+	rangeUps := func(t *testing.T, filter storage.Filter) []*ttnpb.ApplicationUp {
+		var res []*ttnpb.ApplicationUp
+		if err := s.Range(ctx, filter, func(up *ttnpb.ApplicationUp) (bool, error) {
+			res = append(res, up)
+			return true, nil
+		}); err != nil {
+			t.Fatalf("Failed to range messages: %s", err)
+		}
+		return res
+	}
+
+	fPort := uint32(2)
+	after := now.Add(-2500 * time.Millisecond)
+	for _, tc := range []struct {
+		Name     string
+		Filter   storage.Filter
+		Expected []*ttnpb.ApplicationUp
+	}{
+		{
+			Name: "All",
+			Filter: storage.Filter{
+				ApplicationIDs: appIDs,
+			},
+			Expected: ups,
+		},
+		{
+			Name: "Descending",
+			Filter: storage.Filter{
+				ApplicationIDs: appIDs,
+				Descending:     true,
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[2], ups[1], ups[0]},
+		},
+		{
+			Name: "EndDevice",
+			Filter: storage.Filter{
+				ApplicationIDs: appIDs,
+				EndDeviceIDs:   &ups[1].EndDeviceIdentifiers,
+			},
+			Expected: ups[1:],
+		},
+		{
+			Name: "Type",
+			Filter: storage.Filter{
+				ApplicationIDs: appIDs,
+				Type:           "join_accept",
+			},
+			Expected: ups[1:2],
+		},
+		{
+			Name: "FPort",
+			Filter: storage.Filter{
+				ApplicationIDs: appIDs,
+				FPort:          &fPort,
+			},
+			Expected: ups[2:],
+		},
+		{
+			Name: "After",
+			Filter: storage.Filter{
+				ApplicationIDs: appIDs,
+				After:          &after,
+			},
+			Expected: ups[1:],
+		},
+		{
+			Name: "Limit",
+			Filter: storage.Filter{
+				ApplicationIDs: appIDs,
+				Limit:          2,
+				Descending:     true,
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[2], ups[1]},
+		},
+		{
+			Name: "OtherApplication",
+			Filter: storage.Filter{
+				ApplicationIDs: other.ApplicationIdentifiers,
+			},
+			Expected: []*ttnpb.ApplicationUp{other},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(rangeUps(t, tc.Filter), should.Resemble, tc.Expected)
+		})
+	}
+
+	t.Run("Stop", func(t *testing.T) {
+		a := assertions.New(t)
+		var n int
+		err := s.Range(ctx, storage.Filter{ApplicationIDs: appIDs}, func(*ttnpb.ApplicationUp) (bool, error) {
+			n++
+			return false, nil
+		})
+		a.So(err, should.BeNil)
+		a.So(n, should.Equal, 1)
+	})
+
+	t.Run("NoReceivedAt", func(t *testing.T) {
+		a := assertions.New(t)
+		start := time.Now().UTC()
+		up := uplink("test-dev-3", 3, start)
+		up.ApplicationID = "no-received-at-app"
+		up.ReceivedAt = nil
+		if err := s.Store(ctx, up); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(up.ReceivedAt, should.BeNil)
+
+		// The time of storage is the reception time of the stored message, so it matches time filters.
+		after, before := start.Add(-time.Second), time.Now().UTC().Add(time.Second)
+		res := rangeUps(t, storage.Filter{
+			ApplicationIDs: up.ApplicationIdentifiers,
+			After:          &after,
+			Before:         &before,
+		})
+		if !a.So(res, should.HaveLength, 1) || !a.So(res[0].ReceivedAt, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(*res[0].ReceivedAt, should.HappenBetween, after, before)
+		res[0].ReceivedAt = nil
+		a.So(res[0], should.Resemble, up)
+	})
+
+	t.Run("Trim", func(t *testing.T) {
+		a := assertions.New(t)
+		if err := s.Trim(ctx, time.Now().Add(time.Second), 0); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(rangeUps(t, storage.Filter{ApplicationIDs: appIDs}), should.BeEmpty)
+		a.So(rangeUps(t, storage.Filter{ApplicationIDs: other.ApplicationIdentifiers}), should.BeEmpty)
+
+		// Trimming an empty storage succeeds.
+		a.So(s.Trim(ctx, time.Now(), 1), should.BeNil)
+	})
+}
@@ -0,0 +1,253 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"runtime/trace"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const (
+	deviceIDField = "device_id"
+	payloadField  = "payload"
+
+	// rangeCount is the number of stream entries that are read at once.
+	rangeCount = 64
+)
+
+var errInvalidStreamID = errors.DefineCorruption("invalid_stream_id", "invalid stream ID `{id}`")
+
+// Storage is a Redis storage of application upstream messages.
+// The messages of each application are stored in a stream, of which the entry IDs reflect the time of storage.
+type Storage struct {
+	Redis *ttnredis.Client
+}
+
+func (s *Storage) applicationsKey() string {
+	return s.Redis.Key("applications")
+}
+
+func (s *Storage) uidKey(uid string) string {
+	return s.Redis.Key("uid", uid)
+}
+
+// Store implements storage.Storage.
+func (s *Storage) Store(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	defer trace.StartRegion(ctx, "store application up").End()
+
+	up = storage.WithReceivedAt(up, time.Now().UTC())
+	payload, err := ttnredis.MarshalProto(up)
+	if err != nil {
+		return err
+	}
+	uid := unique.ID(ctx, up.ApplicationIdentifiers)
+	_, err = s.Redis.TxPipelined(func(p redis.Pipeliner) error {
+		p.XAdd(&redis.XAddArgs{
+			Stream: s.uidKey(uid),
+			Values: map[string]interface{}{
+				deviceIDField: up.DeviceID,
+				payloadField:  payload,
+			},
+		})
+		p.SAdd(s.applicationsKey(), uid)
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+func parseStreamID(id string) (ms, seq uint64, err error) {
+	parts := strings.SplitN(id, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, errInvalidStreamID.WithAttributes("id", id)
+	}
+	if ms, err = strconv.ParseUint(parts[0], 10, 64); err != nil {
+		return 0, 0, errInvalidStreamID.WithCause(err).WithAttributes("id", id)
+	}
+	if seq, err = strconv.ParseUint(parts[1], 10, 64); err != nil {
+		return 0, 0, errInvalidStreamID.WithCause(err).WithAttributes("id", id)
+	}
+	return ms, seq, nil
+}
+
+// nextStreamID returns the smallest stream ID that is larger than id.
+func nextStreamID(id string) (string, error) {
+	ms, seq, err := parseStreamID(id)
+	if err != nil {
+		return "", err
+	}
+	if seq == math.MaxUint64 {
+		return fmt.Sprintf("%d-0", ms+1), nil
+	}
+	return fmt.Sprintf("%d-%d", ms, seq+1), nil
+}
+
+// previousStreamID returns the largest stream ID that is smaller than id.
+// There is no stream ID smaller than 0-0, so an error is returned for it.
+func previousStreamID(id string) (string, error) {
+	ms, seq, err := parseStreamID(id)
+	if err != nil {
+		return "", err
+	}
+	if ms == 0 && seq == 0 {
+		return "", errInvalidStreamID.WithAttributes("id", id)
+	}
+	if seq == 0 {
+		return fmt.Sprintf("%d-%d", ms-1, uint64(math.MaxUint64)), nil
+	}
+	return fmt.Sprintf("%d-%d", ms, seq-1), nil
+}
+
+func milliseconds(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// Range implements storage.Storage.
+func (s *Storage) Range(ctx context.Context, filter storage.Filter, f func(*ttnpb.ApplicationUp) (bool, error)) error {
+	defer trace.StartRegion(ctx, "range application ups").End()
+
+	k := s.uidKey(unique.ID(ctx, filter.ApplicationIDs))
+	// Messages are stored after they are received, so messages received after filter.After are stored after it as well.
+	// Messages received before filter.Before may be stored after it, so the end of the range cannot be narrowed down.
+	start, end := "-", "+"
+	if filter.After != nil {
+		start = strconv.FormatInt(milliseconds(*filter.After), 10)
+	}
+	var n uint32
+	for {
+		var (
+			msgs []redis.XMessage
+			err  error
+		)
+		if filter.Descending {
+			msgs, err = s.Redis.XRevRangeN(k, end, start, rangeCount).Result()
+		} else {
+			msgs, err = s.Redis.XRangeN(k, start, end, rangeCount).Result()
+		}
+		if err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		for _, msg := range msgs {
+			if filter.EndDeviceIDs != nil && msg.Values[deviceIDField] != filter.EndDeviceIDs.DeviceID {
+				continue
+			}
+			payload, ok := msg.Values[payloadField].(string)
+			if !ok {
+				continue
+			}
+			up := &ttnpb.ApplicationUp{}
+			if err := ttnredis.UnmarshalProto(payload, up); err != nil {
+				return err
+			}
+			if !filter.Match(up) {
+				continue
+			}
+			if ok, err := f(up); err != nil || !ok {
+				return err
+			}
+			n++
+			if filter.Limit > 0 && n >= filter.Limit {
+				return nil
+			}
+		}
+		if len(msgs) < rangeCount {
+			return nil
+		}
+		last := msgs[len(msgs)-1].ID
+		if filter.Descending {
+			end, err = previousStreamID(last)
+		} else {
+			start, err = nextStreamID(last)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Trim implements storage.Storage.
+func (s *Storage) Trim(ctx context.Context, before time.Time, limit int64) error {
+	defer trace.StartRegion(ctx, "trim application ups").End()
+
+	uids, err := s.Redis.SMembers(s.applicationsKey()).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	for _, uid := range uids {
+		k := s.uidKey(uid)
+		if limit > 0 {
+			if err := s.Redis.XTrimApprox(k, limit).Err(); err != nil {
+				return ttnredis.ConvertError(err)
+			}
+		}
+		if !before.IsZero() {
+			// Messages are stored after they are received, so messages stored before are received before as well.
+			end := strconv.FormatInt(milliseconds(before)-1, 10)
+			for {
+				msgs, err := s.Redis.XRangeN(k, "-", end, rangeCount).Result()
+				if err != nil {
+					return ttnredis.ConvertError(err)
+				}
+				if len(msgs) == 0 {
+					break
+				}
+				ids := make([]string, 0, len(msgs))
+				for _, msg := range msgs {
+					ids = append(ids, msg.ID)
+				}
+				if err := s.Redis.XDel(k, ids...).Err(); err != nil {
+					return ttnredis.ConvertError(err)
+				}
+				if len(msgs) < rangeCount {
+					break
+				}
+			}
+		}
+		// The stream is watched so that the application is not removed from the index when messages
+		// are stored concurrently.
+		err := s.Redis.Watch(func(tx *redis.Tx) error {
+			n, err := tx.XLen(k).Result()
+			if err != nil {
+				return err
+			}
+			if n > 0 {
+				return nil
+			}
+			_, err = tx.TxPipelined(func(p redis.Pipeliner) error {
+				p.SRem(s.applicationsKey(), uid)
+				return nil
+			})
+			return err
+		}, k)
+		if err != nil && err != redis.TxFailedErr {
+			return ttnredis.ConvertError(err)
+		}
+	}
+	return nil
+}
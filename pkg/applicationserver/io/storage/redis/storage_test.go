@@ -0,0 +1,72 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage/internal/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ storage.Storage = &Storage{}
+
+func TestStreamID(t *testing.T) {
+	a := assertions.New(t)
+
+	for _, tc := range []struct {
+		ID, Next, Previous string
+	}{
+		{
+			ID:       "42-1",
+			Next:     "42-2",
+			Previous: "42-0",
+		},
+		{
+			ID:       "42-0",
+			Next:     "42-1",
+			Previous: "41-18446744073709551615",
+		},
+		{
+			ID:       "42-18446744073709551615",
+			Next:     "43-0",
+			Previous: "42-18446744073709551614",
+		},
+	} {
+		next, err := nextStreamID(tc.ID)
+		a.So(err, should.BeNil)
+		a.So(next, should.Equal, tc.Next)
+		previous, err := previousStreamID(tc.ID)
+		a.So(err, should.BeNil)
+		a.So(previous, should.Equal, tc.Previous)
+	}
+
+	_, err := previousStreamID("0-0")
+	a.So(errors.IsDataLoss(err), should.BeTrue)
+	_, err = nextStreamID("invalid")
+	a.So(errors.IsDataLoss(err), should.BeTrue)
+}
+
+func TestStorage(t *testing.T) {
+	cl, flush := test.NewRedis(t, "storage_test")
+	defer flush()
+	defer cl.Close()
+
+	HandleStorageTest(t, &Storage{Redis: cl})
+}
@@ -0,0 +1,159 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sql implements a SQL storage of application upstream messages.
+package sql
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errDatabase = errors.DefineInternal("database", "database error")
+	errDecode   = errors.DefineCorruption("decode", "decode stored message")
+)
+
+// applicationUp is the database model of a stored upstream message.
+type applicationUp struct {
+	ID            uint64    `gorm:"primary_key;auto_increment"`
+	ApplicationID string    `gorm:"type:VARCHAR(36);not null;index:application_up_application_index"`
+	DeviceID      string    `gorm:"type:VARCHAR(36);not null;index:application_up_device_index"`
+	MessageType   string    `gorm:"type:VARCHAR(32);not null"`
+	FPort         uint32    `gorm:"not null;default:0"`
+	ReceivedAt    time.Time `gorm:"not null;index:application_up_received_at_index"`
+	Payload       []byte    `gorm:"type:BYTEA;not null"`
+}
+
+// TableName implements the gorm tabler interface.
+func (applicationUp) TableName() string { return "application_ups" }
+
+// Migrate migrates the database schema of the storage.
+func Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(&applicationUp{}).Error; err != nil {
+		return errDatabase.WithCause(err)
+	}
+	return nil
+}
+
+// Storage is a SQL storage of application upstream messages.
+type Storage struct {
+	DB *gorm.DB
+}
+
+// Store implements storage.Storage.
+func (s *Storage) Store(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	defer trace.StartRegion(ctx, "store application up").End()
+
+	up = storage.WithReceivedAt(up, time.Now().UTC())
+	payload, err := proto.Marshal(up)
+	if err != nil {
+		return err
+	}
+	model := &applicationUp{
+		ApplicationID: up.ApplicationID,
+		DeviceID:      up.DeviceID,
+		MessageType:   storage.MessageType(up),
+		ReceivedAt:    up.ReceivedAt.UTC(),
+		Payload:       payload,
+	}
+	if uplink := up.GetUplinkMessage(); uplink != nil {
+		model.FPort = uplink.FPort
+	}
+	if err := s.DB.Create(model).Error; err != nil {
+		return errDatabase.WithCause(err)
+	}
+	return nil
+}
+
+// Range implements storage.Storage.
+func (s *Storage) Range(ctx context.Context, filter storage.Filter, f func(*ttnpb.ApplicationUp) (bool, error)) error {
+	defer trace.StartRegion(ctx, "range application ups").End()
+
+	query := s.DB.Model(&applicationUp{}).Where("application_id = ?", filter.ApplicationIDs.ApplicationID)
+	if filter.EndDeviceIDs != nil {
+		query = query.Where("device_id = ?", filter.EndDeviceIDs.DeviceID)
+	}
+	if filter.Type != "" {
+		query = query.Where("message_type = ?", filter.Type)
+	}
+	if filter.FPort != nil {
+		query = query.Where("message_type = ? AND f_port = ?", "uplink_message", *filter.FPort)
+	}
+	if filter.After != nil {
+		query = query.Where("received_at > ?", filter.After.UTC())
+	}
+	if filter.Before != nil {
+		query = query.Where("received_at < ?", filter.Before.UTC())
+	}
+	if filter.Descending {
+		query = query.Order("received_at DESC, id DESC")
+	} else {
+		query = query.Order("received_at, id")
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	rows, err := query.Select("payload").Rows()
+	if err != nil {
+		return errDatabase.WithCause(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var payload []byte
+		if err := rows.Scan(&payload); err != nil {
+			return errDatabase.WithCause(err)
+		}
+		up := &ttnpb.ApplicationUp{}
+		if err := proto.Unmarshal(payload, up); err != nil {
+			return errDecode.WithCause(err)
+		}
+		if ok, err := f(up); err != nil || !ok {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errDatabase.WithCause(err)
+	}
+	return nil
+}
+
+// Trim implements storage.Storage.
+func (s *Storage) Trim(ctx context.Context, before time.Time, limit int64) error {
+	defer trace.StartRegion(ctx, "trim application ups").End()
+
+	if !before.IsZero() {
+		if err := s.DB.Where("received_at < ?", before.UTC()).Delete(&applicationUp{}).Error; err != nil {
+			return errDatabase.WithCause(err)
+		}
+	}
+	if limit > 0 {
+		if err := s.DB.Exec(`DELETE FROM application_ups WHERE id IN (
+	SELECT id FROM (
+		SELECT id, row_number() OVER (PARTITION BY application_id ORDER BY received_at DESC, id DESC) AS n
+		FROM application_ups
+	) AS ranked WHERE n > ?
+)`, limit).Error; err != nil {
+			return errDatabase.WithCause(err)
+		}
+	}
+	return nil
+}
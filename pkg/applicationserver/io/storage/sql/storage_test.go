@@ -0,0 +1,64 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage/internal/test"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/storage/sql"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+var _ storage.Storage = &Storage{}
+
+func TestStorage(t *testing.T) {
+	dbAddress := os.Getenv("SQL_DB_ADDRESS")
+	if dbAddress == "" {
+		dbAddress = "localhost:26257"
+	}
+	dbName := os.Getenv("TEST_DATABASE_NAME")
+	if dbName == "" {
+		dbName = "ttn_lorawan_as_storage_test"
+	}
+	dbAuth := os.Getenv("SQL_DB_AUTH")
+	if dbAuth == "" {
+		dbAuth = "root"
+	}
+	ctx := test.Context()
+	db, err := store.Open(ctx, fmt.Sprintf("postgresql://%s@%s/%s?sslmode=disable", dbAuth, dbAddress, dbName))
+	if err != nil {
+		t.Fatalf("Failed to open database: %s", err)
+	}
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Errorf("Failed to close database: %s", err)
+		}
+	})
+	if err := store.Initialize(db); err != nil {
+		t.Fatalf("Failed to initialize database: %s", err)
+	}
+	if err := db.DropTableIfExists("application_ups").Error; err != nil {
+		t.Fatalf("Failed to drop tables: %s", err)
+	}
+	if err := Migrate(db); err != nil {
+		t.Fatalf("Failed to migrate database: %s", err)
+	}
+	HandleStorageTest(t, &Storage{DB: db})
+}
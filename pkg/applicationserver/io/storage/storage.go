@@ -0,0 +1,142 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storage implements the storage integration frontend, which persists application upstream messages and
+// exposes them through the ApplicationUpStorage service.
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// Storage is a storage of application upstream messages.
+type Storage interface {
+	// Store stores the given upstream message.
+	// If the message has no reception time, the time of storage is stored as its reception time.
+	Store(ctx context.Context, up *ttnpb.ApplicationUp) error
+	// Range calls f for the stored upstream messages that match the filter, in the order specified by the filter.
+	// Range stops when f returns false or an error, or when the limit of the filter is reached.
+	Range(ctx context.Context, filter Filter, f func(*ttnpb.ApplicationUp) (bool, error)) error
+	// Trim removes the upstream messages that were received before the given time, if it is not zero, and the oldest
+	// upstream messages of each application that exceed the given limit, if it is not zero.
+	Trim(ctx context.Context, before time.Time, limit int64) error
+}
+
+// WithReceivedAt returns up if it has a reception time. Otherwise, WithReceivedAt returns a copy of up with reception
+// time now, so that stored messages can always be filtered by reception time.
+func WithReceivedAt(up *ttnpb.ApplicationUp, now time.Time) *ttnpb.ApplicationUp {
+	if up.ReceivedAt != nil {
+		return up
+	}
+	stamped := *up
+	stamped.ReceivedAt = &now
+	return &stamped
+}
+
+type server struct {
+	ctx context.Context
+
+	storage   Storage
+	retention RetentionConfig
+}
+
+// Server is a storage integration frontend.
+type Server interface {
+	rpcserver.Registerer
+	NewSubscription() *io.Subscription
+}
+
+// New returns a new storage integration frontend that persists upstream messages in the given storage.
+func New(c *component.Component, storage Storage, conf Config) (Server, error) {
+	ctx := log.NewContextWithField(c.Context(), "namespace", "applicationserver/io/storage")
+	s := &server{
+		ctx:       ctx,
+		storage:   storage,
+		retention: conf.Retention,
+	}
+	if s.retention.TTL > 0 || s.retention.Limit > 0 {
+		if s.retention.Interval <= 0 {
+			s.retention.Interval = DefaultRetentionInterval
+		}
+		c.RegisterTask(&component.TaskConfig{
+			Context: ctx,
+			ID:      "storage_trim",
+			Func:    s.trim,
+			Restart: component.TaskRestartOnFailure,
+			Backoff: component.DefaultTaskBackoffConfig,
+		})
+	}
+	return s, nil
+}
+
+func (s *server) trim(ctx context.Context) error {
+	ticker := time.NewTicker(s.retention.Interval)
+	defer ticker.Stop()
+	for {
+		var before time.Time
+		if s.retention.TTL > 0 {
+			before = time.Now().Add(-s.retention.TTL)
+		}
+		if err := s.storage.Trim(ctx, before, s.retention.Limit); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Roles implements the rpcserver.Registerer interface.
+func (s *server) Roles() []ttnpb.ClusterRole {
+	return nil
+}
+
+// RegisterServices registers the services of the storage integration.
+func (s *server) RegisterServices(gs *grpc.Server) {
+	ttnpb.RegisterApplicationUpStorageServer(gs, s)
+}
+
+// RegisterHandlers registers the handlers of the storage integration.
+func (s *server) RegisterHandlers(rs *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterApplicationUpStorageHandler(s.ctx, rs, conn)
+}
+
+// NewSubscription creates a new default subscription for storing upstream traffic.
+func (s *server) NewSubscription() *io.Subscription {
+	sub := io.NewSubscription(s.ctx, "storage", nil)
+	go func() {
+		for {
+			select {
+			case <-sub.Context().Done():
+				return
+			case up := <-sub.Up():
+				if err := s.storage.Store(up.Context, up.ApplicationUp); err != nil {
+					log.FromContext(s.ctx).WithError(err).Warn("Failed to store message")
+				}
+			}
+		}
+	}()
+	return sub
+}
@@ -0,0 +1,209 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const (
+	// defaultBatchMaxDelay is the maximum delay of a batch if the webhook does not specify the maximum delay.
+	defaultBatchMaxDelay = time.Second
+	// maxBatchMaxDelay is the upper bound of the maximum delay of a batch.
+	maxBatchMaxDelay = time.Minute
+	// maxBatchMaxMessages is the upper bound of the maximum number of messages in a batch.
+	maxBatchMaxMessages = 1000
+)
+
+// webhookBatch is a batch of messages that is sent in a single request.
+type webhookBatch struct {
+	key    string
+	hook   *ttnpb.ApplicationWebhook
+	url    string
+	ref    string
+	hasRef bool
+	format Format
+	msgs   []*ttnpb.ApplicationUp
+	timer  *time.Timer
+
+	// prev is closed when the previous batch with the same key is processed.
+	prev <-chan struct{}
+	// done is closed when this batch is processed.
+	done chan struct{}
+}
+
+// batcher accumulates messages per webhook and URL.
+// Batches with the same key are processed in order, which preserves the order of the messages of each end device.
+type batcher struct {
+	ctx    context.Context
+	target Sink
+
+	mu sync.Mutex
+	// batches contains the batches that accumulate messages.
+	batches map[string]*webhookBatch
+	// pending contains the last batch per key that is being processed.
+	pending map[string]*webhookBatch
+}
+
+func newBatcher(ctx context.Context, target Sink) *batcher {
+	return &batcher{
+		ctx:     ctx,
+		target:  target,
+		batches: make(map[string]*webhookBatch),
+		pending: make(map[string]*webhookBatch),
+	}
+}
+
+func batchLimits(batching *ttnpb.ApplicationWebhook_Batching) (int, time.Duration) {
+	maxMessages := int(batching.GetMaxMessages())
+	if maxMessages > maxBatchMaxMessages {
+		maxMessages = maxBatchMaxMessages
+	}
+	maxDelay := batching.GetMaxDelay()
+	switch {
+	case maxDelay <= 0:
+		maxDelay = defaultBatchMaxDelay
+	case maxDelay > maxBatchMaxDelay:
+		maxDelay = maxBatchMaxDelay
+	}
+	return maxMessages, maxDelay
+}
+
+// Add adds the message to the batch of the webhook.
+// If the batch is full, it is processed before this method returns.
+func (b *batcher) Add(ctx context.Context, hook *ttnpb.ApplicationWebhook, msg *ttnpb.ApplicationUp) error {
+	cfg := messageConfig(hook, msg)
+	if cfg == nil {
+		return nil
+	}
+	format, ok := formats[hook.Format]
+	if !ok {
+		return errFormatNotFound.WithAttributes("format", hook.Format)
+	}
+	if format.BatchEncoding == nil {
+		return errBatchingNotSupported.WithAttributes("format", format.Name)
+	}
+	finalURL, ref, hasRef, err := messageURL(hook, cfg, msg)
+	if err != nil {
+		return err
+	}
+	maxMessages, maxDelay := batchLimits(hook.Batching)
+	key := unique.ID(ctx, hook.ApplicationWebhookIdentifiers) + " " + finalURL.String()
+
+	b.mu.Lock()
+	batch, ok := b.batches[key]
+	if !ok {
+		batch = &webhookBatch{
+			key:    key,
+			hook:   hook,
+			url:    finalURL.String(),
+			ref:    ref,
+			hasRef: hasRef,
+			format: format,
+			done:   make(chan struct{}),
+		}
+		if prev, ok := b.pending[key]; ok {
+			batch.prev = prev.done
+		}
+		batch.timer = time.AfterFunc(maxDelay, func() { b.flush(batch) })
+		b.batches[key] = batch
+	}
+	batch.msgs = append(batch.msgs, msg)
+	full := len(batch.msgs) >= maxMessages
+	b.mu.Unlock()
+
+	if full {
+		b.flush(batch)
+	}
+	return nil
+}
+
+// flush processes the batch, after the previous batch with the same key is processed.
+// Only the first call to flush for a batch processes the batch.
+func (b *batcher) flush(batch *webhookBatch) {
+	b.mu.Lock()
+	if b.batches[batch.key] != batch {
+		// The batch is already flushed.
+		b.mu.Unlock()
+		return
+	}
+	delete(b.batches, batch.key)
+	b.pending[batch.key] = batch
+	batch.timer.Stop()
+	b.mu.Unlock()
+
+	defer func() {
+		b.mu.Lock()
+		if b.pending[batch.key] == batch {
+			delete(b.pending, batch.key)
+		}
+		b.mu.Unlock()
+		close(batch.done)
+	}()
+	if batch.prev != nil {
+		select {
+		case <-b.ctx.Done():
+			return
+		case <-batch.prev:
+		}
+	}
+
+	logger := log.FromContext(b.ctx).WithFields(log.Fields(
+		"hook", batch.hook.WebhookID,
+		"url", batch.url,
+		"batch_size", len(batch.msgs),
+	))
+	req, err := newBatchRequest(withWebhookID(b.ctx, batch.hook.ApplicationWebhookIdentifiers), batch)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to create batch request")
+		return
+	}
+	logger.Debug("Process batch")
+	if err := b.target.Process(req); err != nil {
+		logger.WithError(err).Warn("Failed to process batch")
+	}
+}
+
+func newBatchRequest(ctx context.Context, batch *webhookBatch) (*http.Request, error) {
+	buf, err := batch.format.FromUps(batch.msgs)
+	if err != nil {
+		return nil, err
+	}
+	if batch.hasRef {
+		ctx = withWebhookURLReference(ctx, batch.ref)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, batch.url, bytes.NewReader(buf))
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range batch.hook.Headers {
+		req.Header.Set(key, value)
+	}
+	// The downlink queue operation URLs are specific to an end device, and are therefore not set for batches.
+	if batch.hook.DownlinkAPIKey != "" {
+		req.Header.Set(downlinkKeyHeader, batch.hook.DownlinkAPIKey)
+	}
+	req.Header.Set("Content-Type", batch.format.ContentType)
+	req.Header.Set("User-Agent", userAgent)
+	return req, nil
+}
@@ -0,0 +1,148 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type recordingSink struct {
+	mu     sync.Mutex
+	bodies [][]byte
+	urls   []string
+	ch     chan struct{}
+}
+
+func (s *recordingSink) Process(req *http.Request) error {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.bodies = append(s.bodies, body)
+	s.urls = append(s.urls, req.URL.String())
+	s.mu.Unlock()
+	s.ch <- struct{}{}
+	return nil
+}
+
+func TestBatchEncoding(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(arrayBatchEncoding([][]byte{[]byte(`{"a":1}`), []byte(`{"b":2}`)}), should.Resemble, []byte(`[{"a":1},{"b":2}]`))
+	a.So(arrayBatchEncoding(nil), should.Resemble, []byte(`[]`))
+
+	buf := lengthDelimitedBatchEncoding([][]byte{{0x01, 0x02}, make([]byte, 200)})
+	n, l := binary.Uvarint(buf)
+	a.So(n, should.Equal, uint64(2))
+	a.So(buf[l:l+2], should.Resemble, []byte{0x01, 0x02})
+	buf = buf[l+2:]
+	n, l = binary.Uvarint(buf)
+	a.So(n, should.Equal, uint64(200))
+	a.So(l, should.Equal, 2)
+	a.So(len(buf[l:]), should.Equal, 200)
+}
+
+func TestBatcher(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	sink := &recordingSink{ch: make(chan struct{}, 10)}
+	b := newBatcher(ctx, sink)
+
+	hook := &ttnpb.ApplicationWebhook{
+		ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+			WebhookID:              "foo-hook",
+		},
+		BaseURL:       "https://example.com",
+		Format:        "json",
+		UplinkMessage: &ttnpb.ApplicationWebhook_Message{Path: "/up"},
+		Batching: &ttnpb.ApplicationWebhook_Batching{
+			MaxMessages: 3,
+			MaxDelay:    (1 << 5) * test.Delay,
+		},
+	}
+	newUp := func(devID string, fCnt uint32) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: hook.ApplicationIdentifiers,
+				DeviceID:               devID,
+			},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{FCnt: fCnt},
+			},
+		}
+	}
+	decode := func(body []byte) []uint32 {
+		var msgs []struct {
+			UplinkMessage struct {
+				FCnt uint32 `json:"f_cnt"`
+			} `json:"uplink_message"`
+		}
+		if err := json.Unmarshal(body, &msgs); err != nil {
+			t.Fatalf("Failed to decode batch: %s", err)
+		}
+		fCnts := make([]uint32, 0, len(msgs))
+		for _, msg := range msgs {
+			fCnts = append(fCnts, msg.UplinkMessage.FCnt)
+		}
+		return fCnts
+	}
+	wait := func() {
+		select {
+		case <-sink.ch:
+		case <-time.After((1 << 8) * test.Delay):
+			t.Fatal("Timed out waiting for batch")
+		}
+	}
+
+	// A full batch is sent immediately, in order.
+	for i := uint32(1); i <= 4; i++ {
+		a.So(b.Add(ctx, hook, newUp("foo-dev", i)), should.BeNil)
+	}
+	wait()
+	a.So(decode(sink.bodies[0]), should.Resemble, []uint32{1, 2, 3})
+	a.So(sink.urls[0], should.Equal, "https://example.com/up")
+
+	// The remaining message is sent after the maximum delay.
+	select {
+	case <-sink.ch:
+		t.Fatal("Expected batch to be delayed")
+	case <-time.After((1 << 3) * test.Delay):
+	}
+	wait()
+	a.So(decode(sink.bodies[1]), should.Resemble, []uint32{4})
+
+	// Messages without a path configured are not batched.
+	a.So(b.Add(ctx, hook, &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: newUp("foo-dev", 0).EndDeviceIdentifiers,
+		Up:                   &ttnpb.ApplicationUp_JoinAccept{JoinAccept: &ttnpb.ApplicationJoinAccept{}},
+	}), should.BeNil)
+	b.mu.Lock()
+	a.So(b.batches, should.BeEmpty)
+	b.mu.Unlock()
+}
@@ -17,6 +17,7 @@ package web
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -72,3 +73,27 @@ type DownlinksConfig struct {
 	PublicAddress    string `name:"public-address" description:"Public address of the HTTP webhooks frontend"`
 	PublicTLSAddress string `name:"public-tls-address" description:"Public address of the HTTPS webhooks frontend"`
 }
+
+// RetryConfig defines the configuration of the delivery retries of persisted webhook requests.
+type RetryConfig struct {
+	MaxAttempts    int           `name:"max-attempts" description:"Maximum number of delivery attempts before the request is dead-lettered"`
+	InitialBackoff time.Duration `name:"initial-backoff" description:"Time to wait before the first retry"`
+	MaxBackoff     time.Duration `name:"max-backoff" description:"Maximum time to wait between retries"`
+}
+
+// PersistentQueueConfig defines the configuration of the persistent queue of webhook requests.
+type PersistentQueueConfig struct {
+	Enable         bool               `name:"enable" description:"Persist webhook requests and retry failed deliveries"`
+	Retry          RetryConfig        `name:"retry" description:"Delivery retry configuration"`
+	DeadLetterTTL  time.Duration      `name:"dead-letter-ttl" description:"Time for which dead-lettered requests are kept (0 is forever)"`
+	MaxDeadLetters int64              `name:"max-dead-letters" description:"Maximum number of dead-lettered requests per webhook (0 is unlimited)"`
+	Queue          RequestQueue       `name:"-"`
+	DeadLetters    DeadLetterRegistry `name:"-"`
+}
+
+// HealthConfig defines the configuration of the webhook health tracking.
+type HealthConfig struct {
+	Enable       bool          `name:"enable" description:"Track the health of webhooks"`
+	SuspendAfter uint64        `name:"suspend-after" description:"Number of consecutive failed requests after which a webhook is suspended (0 is never)"`
+	CacheTTL     time.Duration `name:"cache-ttl" description:"Time to cache the health of webhooks"`
+}
@@ -15,8 +15,12 @@
 package web
 
 import (
+	"bytes"
+	"encoding/binary"
+
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/formatters"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
 // Format is a format to use for web-based frontends.
@@ -24,10 +28,51 @@ type Format struct {
 	formatters.Formatter
 	Name        string
 	ContentType string
+	// BatchEncoding encodes multiple messages formatted by the Formatter in a single body.
+	// If nil, the format does not support batching.
+	BatchEncoding func([][]byte) []byte
 }
 
 var (
 	formats = map[string]Format{}
 
-	errFormatNotFound = errors.DefineNotFound("format_not_found", "format `{format}` not found")
+	errFormatNotFound       = errors.DefineNotFound("format_not_found", "format `{format}` not found")
+	errBatchingNotSupported = errors.DefineInvalidArgument("batching_not_supported", "format `{format}` does not support batching")
 )
+
+// FromUps formats the given messages as a batch.
+func (f Format) FromUps(msgs []*ttnpb.ApplicationUp) ([]byte, error) {
+	if f.BatchEncoding == nil {
+		return nil, errBatchingNotSupported.WithAttributes("format", f.Name)
+	}
+	bufs := make([][]byte, 0, len(msgs))
+	for _, msg := range msgs {
+		buf, err := f.FromUp(msg)
+		if err != nil {
+			return nil, err
+		}
+		bufs = append(bufs, buf)
+	}
+	return f.BatchEncoding(bufs), nil
+}
+
+// arrayBatchEncoding encodes the JSON messages as a JSON array.
+func arrayBatchEncoding(bufs [][]byte) []byte {
+	var b bytes.Buffer
+	b.WriteByte('[')
+	b.Write(bytes.Join(bufs, []byte{','}))
+	b.WriteByte(']')
+	return b.Bytes()
+}
+
+// lengthDelimitedBatchEncoding prefixes each message with its length, encoded as varint.
+func lengthDelimitedBatchEncoding(bufs [][]byte) []byte {
+	var b bytes.Buffer
+	prefix := make([]byte, binary.MaxVarintLen64)
+	for _, buf := range bufs {
+		n := binary.PutUvarint(prefix, uint64(len(buf)))
+		b.Write(prefix[:n])
+		b.Write(buf)
+	}
+	return b.Bytes()
+}
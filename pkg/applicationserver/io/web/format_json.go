@@ -18,8 +18,9 @@ import "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/formatter
 
 func init() {
 	formats["json"] = Format{
-		Formatter:   formatters.JSON,
-		Name:        "JSON",
-		ContentType: "application/json",
+		Formatter:     formatters.JSON,
+		Name:          "JSON",
+		ContentType:   "application/json",
+		BatchEncoding: arrayBatchEncoding,
 	}
 }
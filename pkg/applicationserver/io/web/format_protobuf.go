@@ -18,8 +18,9 @@ import "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/formatter
 
 func init() {
 	formats["protobuf"] = Format{
-		Formatter:   formatters.Protobuf,
-		Name:        "Protocol Buffers",
-		ContentType: "application/octet-stream",
+		Formatter:     formatters.Protobuf,
+		Name:          "Protocol Buffers",
+		ContentType:   "application/octet-stream",
+		BatchEncoding: lengthDelimitedBatchEncoding,
 	}
 }
@@ -16,10 +16,12 @@ package web
 
 import (
 	"context"
+	"crypto/tls"
 	"strconv"
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -37,16 +39,31 @@ func appendImplicitWebhookGetPaths(paths ...string) []string {
 	), paths...)
 }
 
+// secretWebhookPaths are the paths of the ttnpb.ApplicationWebhook fields that are write-only.
+var secretWebhookPaths = []string{
+	"signing_secret",
+	"tls_client_cert",
+	"tls_client_key",
+}
+
+// withoutSecretWebhookPaths returns the paths without the secret webhook paths, which are never returned to callers.
+func withoutSecretWebhookPaths(paths ...string) []string {
+	return ttnpb.ExcludeFields(paths, secretWebhookPaths...)
+}
+
 type webhookRegistryRPC struct {
-	webhooks  WebhookRegistry
-	templates TemplateStore
+	webhooks    WebhookRegistry
+	templates   TemplateStore
+	deadLetters DeadLetterSink
 }
 
 // NewWebhookRegistryRPC returns a new webhook registry gRPC server.
-func NewWebhookRegistryRPC(webhooks WebhookRegistry, templates TemplateStore) ttnpb.ApplicationWebhookRegistryServer {
+// If deadLetters is nil, the dead letter RPCs are unavailable.
+func NewWebhookRegistryRPC(webhooks WebhookRegistry, templates TemplateStore, deadLetters DeadLetterSink) ttnpb.ApplicationWebhookRegistryServer {
 	return &webhookRegistryRPC{
-		webhooks:  webhooks,
-		templates: templates,
+		webhooks:    webhooks,
+		templates:   templates,
+		deadLetters: deadLetters,
 	}
 }
 
@@ -72,14 +89,14 @@ func (s webhookRegistryRPC) Get(ctx context.Context, req *ttnpb.GetApplicationWe
 	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
 		return nil, err
 	}
-	return s.webhooks.Get(ctx, req.ApplicationWebhookIdentifiers, appendImplicitWebhookGetPaths(req.FieldMask.Paths...))
+	return s.webhooks.Get(ctx, req.ApplicationWebhookIdentifiers, appendImplicitWebhookGetPaths(withoutSecretWebhookPaths(req.FieldMask.Paths...)...))
 }
 
 func (s webhookRegistryRPC) List(ctx context.Context, req *ttnpb.ListApplicationWebhooksRequest) (*ttnpb.ApplicationWebhooks, error) {
 	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
 		return nil, err
 	}
-	webhooks, err := s.webhooks.List(ctx, req.ApplicationIdentifiers, appendImplicitWebhookGetPaths(req.FieldMask.Paths...))
+	webhooks, err := s.webhooks.List(ctx, req.ApplicationIdentifiers, appendImplicitWebhookGetPaths(withoutSecretWebhookPaths(req.FieldMask.Paths...)...))
 	if err != nil {
 		return nil, err
 	}
@@ -101,12 +118,24 @@ func (s webhookRegistryRPC) Set(ctx context.Context, req *ttnpb.SetApplicationWe
 	); err != nil {
 		return nil, err
 	}
-	return s.webhooks.Set(ctx, req.ApplicationWebhookIdentifiers, appendImplicitWebhookGetPaths(req.FieldMask.Paths...),
+	paths := req.FieldMask.Paths
+	if withoutHealth := ttnpb.ExcludeFields(paths, "health"); len(withoutHealth) != len(paths) {
+		// The health is managed by the Application Server and can only be reset.
+		paths = append(withoutHealth, "health")
+		req.Health = nil
+	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "tls_client_cert", "tls_client_key") &&
+		(len(req.TLSClientCert) > 0 || len(req.TLSClientKey) > 0) {
+		if _, err := tls.X509KeyPair(req.TLSClientCert, req.TLSClientKey); err != nil {
+			return nil, errTLSClientCertificate.WithCause(err)
+		}
+	}
+	return s.webhooks.Set(ctx, req.ApplicationWebhookIdentifiers, appendImplicitWebhookGetPaths(withoutSecretWebhookPaths(req.FieldMask.Paths...)...),
 		func(webhook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
 			if webhook != nil {
-				return &req.ApplicationWebhook, req.FieldMask.Paths, nil
+				return &req.ApplicationWebhook, paths, nil
 			}
-			return &req.ApplicationWebhook, append(req.FieldMask.Paths,
+			return &req.ApplicationWebhook, append(paths,
 				"ids.application_ids",
 				"ids.webhook_id",
 			), nil
@@ -130,5 +159,49 @@ func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWe
 	if err != nil {
 		return nil, err
 	}
+	if s.deadLetters != nil {
+		if err := s.deadLetters.RemoveWebhook(ctx, *req); err != nil {
+			return nil, err
+		}
+	}
+	return ttnpb.Empty, nil
+}
+
+var errDeadLettersUnavailable = errors.DefineFailedPrecondition("dead_letters_unavailable", "dead letters unavailable")
+
+func (s webhookRegistryRPC) ListDeadLetters(ctx context.Context, req *ttnpb.ListApplicationWebhookDeadLettersRequest) (*ttnpb.ApplicationWebhookDeadLetters, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	if s.deadLetters == nil {
+		return nil, errDeadLettersUnavailable.New()
+	}
+	var offset int64
+	if req.Page > 0 {
+		offset = int64(req.Page-1) * int64(req.Limit)
+	}
+	reqs, total, err := s.deadLetters.ListDeadLetters(ctx, req.ApplicationWebhookIdentifiers, int64(req.Limit), offset)
+	if err != nil {
+		return nil, err
+	}
+	setTotalHeader(ctx, uint64(total))
+	return &ttnpb.ApplicationWebhookDeadLetters{
+		Requests: reqs,
+	}, nil
+}
+
+func (s webhookRegistryRPC) ReplayDeadLetters(ctx context.Context, req *ttnpb.ReplayApplicationWebhookDeadLettersRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	); err != nil {
+		return nil, err
+	}
+	if s.deadLetters == nil {
+		return nil, errDeadLettersUnavailable.New()
+	}
+	if err := s.deadLetters.ReplayDeadLetters(ctx, req.ApplicationWebhookIdentifiers, req.RequestIDs...); err != nil {
+		return nil, err
+	}
 	return ttnpb.Empty, nil
 }
@@ -15,6 +15,7 @@
 package web_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
@@ -27,8 +28,10 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 	"google.golang.org/grpc"
@@ -55,8 +58,14 @@ func TestWebhookRegistryRPC(t *testing.T) {
 	redisClient, flush := test.NewRedis(t, "applicationserver_test")
 	defer flush()
 	defer redisClient.Close()
-	webhookReg := &redis.WebhookRegistry{Redis: redisClient}
-	srv := web.NewWebhookRegistryRPC(webhookReg, nil)
+	webhookReg := &redis.WebhookRegistry{
+		Redis: redisClient,
+		KeyVault: cryptoutil.NewMemKeyVault(map[string][]byte{
+			"test": {0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf},
+		}),
+		KEKLabel: "test",
+	}
+	srv := web.NewWebhookRegistryRPC(webhookReg, nil, nil)
 	c.RegisterGRPC(&mockRegisterer{ctx, srv})
 	componenttest.StartComponent(t, c)
 	defer c.Close()
@@ -137,6 +146,68 @@ func TestWebhookRegistryRPC(t *testing.T) {
 		a.So(res.BaseURL, should.Equal, "http://localhost/test")
 	}
 
+	// Set secrets.
+	{
+		res, err := client.Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+			ApplicationWebhook: ttnpb.ApplicationWebhook{
+				ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+					ApplicationIdentifiers: registeredApplicationID,
+					WebhookID:              registeredWebhookID,
+				},
+				SigningSecret: "test-secret",
+			},
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"signing_secret"},
+			},
+		}, creds)
+		a.So(err, should.BeNil)
+		a.So(res.SigningSecret, should.BeEmpty)
+	}
+
+	// Get; assert secrets are write-only.
+	{
+		res, err := client.Get(ctx, &ttnpb.GetApplicationWebhookRequest{
+			ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+				ApplicationIdentifiers: registeredApplicationID,
+				WebhookID:              registeredWebhookID,
+			},
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"base_url", "signing_secret"},
+			},
+		}, creds)
+		a.So(err, should.BeNil)
+		a.So(res.BaseURL, should.Equal, "http://localhost/test")
+		a.So(res.SigningSecret, should.BeEmpty)
+
+		list, err := client.List(ctx, &ttnpb.ListApplicationWebhooksRequest{
+			ApplicationIdentifiers: registeredApplicationID,
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"signing_secret"},
+			},
+		}, creds)
+		a.So(err, should.BeNil)
+		if a.So(list.Webhooks, should.HaveLength, 1) {
+			a.So(list.Webhooks[0].SigningSecret, should.BeEmpty)
+		}
+	}
+
+	// Assert secrets are encrypted at rest.
+	{
+		ids := ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: registeredApplicationID,
+			WebhookID:              registeredWebhookID,
+		}
+		hook, err := webhookReg.Get(ctx, ids, []string{"base_url", "signing_secret"})
+		a.So(err, should.BeNil)
+		a.So(hook.BaseURL, should.Equal, "http://localhost/test")
+		a.So(hook.SigningSecret, should.Equal, "test-secret")
+
+		fields, err := redisClient.HGetAll(redisClient.Key("uid", unique.ID(ctx, registeredApplicationID), registeredWebhookID, "secrets")).Result()
+		a.So(err, should.BeNil)
+		a.So(fields, should.ContainKey, "data_key")
+		a.So(bytes.Contains([]byte(fields["value"]), []byte("test-secret")), should.BeFalse)
+	}
+
 	// Delete.
 	{
 		_, err := client.Delete(ctx, &ttnpb.ApplicationWebhookIdentifiers{
@@ -235,7 +306,7 @@ description: Bar`),
 			a.So(err, should.BeNil)
 
 			c := componenttest.NewComponent(t, &component.Config{})
-			c.RegisterGRPC(&mockRegisterer{ctx, web.NewWebhookRegistryRPC(nil, store)})
+			c.RegisterGRPC(&mockRegisterer{ctx, web.NewWebhookRegistryRPC(nil, store, nil)})
 			componenttest.StartComponent(t, c)
 			defer c.Close()
 
@@ -0,0 +1,161 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// healthUpdateInterval is the minimum interval between updates of the last successful attempt of a healthy webhook.
+const healthUpdateInterval = time.Minute
+
+var errWebhookSuspended = errors.DefineUnavailable("webhook_suspended", "webhook `{webhook_id}` suspended")
+
+// HealthCheckSink is a Sink that tracks the health of webhooks in the registry.
+// A webhook is suspended after SuspendAfter consecutive failed requests, and requests of suspended webhooks are not processed.
+// If SuspendAfter is 0, webhooks are not suspended.
+// The health of a webhook is cached for CacheTTL. If CacheTTL is zero, the health is retrieved for every request.
+// Changes to the health of a webhook made outside the sink, such as resetting the health, take effect after CacheTTL.
+type HealthCheckSink struct {
+	Target       Sink
+	Registry     WebhookRegistry
+	SuspendAfter uint64
+	CacheTTL     time.Duration
+
+	healthMu sync.Mutex
+	health   map[string]*webhookHealth
+}
+
+type webhookHealth struct {
+	health    *ttnpb.ApplicationWebhookHealth
+	expiresAt time.Time
+}
+
+// webhookHealth returns the health of the webhook.
+// The health is cached by uid for CacheTTL.
+func (s *HealthCheckSink) webhookHealth(ctx context.Context, uid string, ids ttnpb.ApplicationWebhookIdentifiers) (*ttnpb.ApplicationWebhookHealth, error) {
+	s.healthMu.Lock()
+	cached, ok := s.health[uid]
+	s.healthMu.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.health, nil
+	}
+	hook, err := s.Registry.Get(ctx, ids, []string{"health"})
+	if err != nil {
+		return nil, err
+	}
+	s.cacheHealth(uid, hook.Health)
+	return hook.Health, nil
+}
+
+// cacheHealth stores the health of the webhook in the cache for CacheTTL.
+func (s *HealthCheckSink) cacheHealth(uid string, health *ttnpb.ApplicationWebhookHealth) {
+	if s.CacheTTL <= 0 {
+		return
+	}
+	s.healthMu.Lock()
+	if s.health == nil {
+		s.health = make(map[string]*webhookHealth)
+	}
+	s.health[uid] = &webhookHealth{
+		health:    health,
+		expiresAt: time.Now().Add(s.CacheTTL),
+	}
+	s.healthMu.Unlock()
+}
+
+// Process processes the request with the target, unless the webhook is suspended, and updates the health of the webhook.
+func (s *HealthCheckSink) Process(req *http.Request) error {
+	ctx := req.Context()
+	ids, ok := ctx.Value(webhookIDKey).(ttnpb.ApplicationWebhookIdentifiers)
+	if !ok {
+		return s.Target.Process(req)
+	}
+	uid := unique.ID(ctx, ids)
+	health, err := s.webhookHealth(ctx, uid, ids)
+	if err != nil {
+		return err
+	}
+	if health.GetSuspended() {
+		return errWebhookSuspended.WithAttributes("webhook_id", ids.WebhookID)
+	}
+	processErr := s.Target.Process(req)
+	if processErr == nil && health.GetFailedAttempts() == 0 &&
+		health.GetLastSuccessfulAttemptAt() != nil && time.Since(*health.GetLastSuccessfulAttemptAt()) < healthUpdateInterval {
+		return nil
+	}
+	if err := s.updateHealth(ctx, uid, ids, processErr); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to update webhook health")
+	}
+	return processErr
+}
+
+func (s *HealthCheckSink) updateHealth(ctx context.Context, uid string, ids ttnpb.ApplicationWebhookIdentifiers, processErr error) error {
+	var suspended bool
+	hook, err := s.Registry.Set(ctx, ids, []string{"health"}, func(hook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		suspended = false
+		if hook == nil {
+			// The webhook has been deleted.
+			return nil, nil, nil
+		}
+		now := time.Now().UTC()
+		health := hook.Health
+		if health == nil {
+			health = &ttnpb.ApplicationWebhookHealth{}
+		}
+		if processErr == nil {
+			health.FailedAttempts = 0
+			health.LastSuccessfulAttemptAt = &now
+		} else {
+			health.FailedAttempts++
+			health.LastFailedAttemptAt = &now
+			if ttnErr, ok := errors.From(processErr); ok {
+				health.LastFailedAttemptDetails = ttnpb.ErrorDetailsToProto(ttnErr)
+			} else {
+				health.LastFailedAttemptDetails = ttnpb.ErrorDetailsToProto(errDeliver.WithCause(processErr))
+			}
+			if s.SuspendAfter > 0 && health.FailedAttempts >= s.SuspendAfter && !health.Suspended {
+				health.Suspended = true
+				health.SuspendedAt = &now
+				suspended = true
+			}
+		}
+		hook.Health = health
+		return hook, []string{"health"}, nil
+	})
+	if err != nil {
+		return err
+	}
+	if hook != nil {
+		s.cacheHealth(uid, hook.Health)
+	}
+	if processErr != nil {
+		events.Publish(evtWebhookFail.NewWithIdentifiersAndData(ctx, ids.ApplicationIdentifiers, processErr))
+	}
+	if suspended {
+		log.FromContext(ctx).WithField("webhook_id", ids.WebhookID).Warn("Suspend webhook after consecutive failed requests")
+		events.Publish(evtWebhookSuspend.NewWithIdentifiersAndData(ctx, ids.ApplicationIdentifiers, ids))
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type memoryWebhookRegistry struct {
+	mu    sync.Mutex
+	hooks map[ttnpb.ApplicationWebhookIdentifiers]*ttnpb.ApplicationWebhook
+}
+
+func (r *memoryWebhookRegistry) Get(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string) (*ttnpb.ApplicationWebhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	hook, ok := r.hooks[ids]
+	if !ok {
+		return nil, errWebhookNotFound.New()
+	}
+	pb := &ttnpb.ApplicationWebhook{}
+	return pb, pb.SetFields(hook, append(paths, "ids")...)
+}
+
+func (r *memoryWebhookRegistry) List(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.ApplicationWebhook, error) {
+	panic("not implemented")
+}
+
+func (r *memoryWebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string, f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error)) (*ttnpb.ApplicationWebhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var stored *ttnpb.ApplicationWebhook
+	if hook, ok := r.hooks[ids]; ok {
+		stored = &ttnpb.ApplicationWebhook{}
+		if err := stored.SetFields(hook, append(paths, "ids")...); err != nil {
+			return nil, err
+		}
+	}
+	pb, sets, err := f(stored)
+	if err != nil || pb == nil {
+		return nil, err
+	}
+	hook, ok := r.hooks[ids]
+	if !ok {
+		hook = &ttnpb.ApplicationWebhook{ApplicationWebhookIdentifiers: ids}
+		r.hooks[ids] = hook
+	}
+	return hook, hook.SetFields(pb, sets...)
+}
+
+type countingSink struct {
+	mu    sync.Mutex
+	err   error
+	count int
+}
+
+func (s *countingSink) Process(*http.Request) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.count++
+	return s.err
+}
+
+func TestHealthCheckSink(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		WebhookID:              "foo-hook",
+	}
+	registry := &memoryWebhookRegistry{
+		hooks: map[ttnpb.ApplicationWebhookIdentifiers]*ttnpb.ApplicationWebhook{
+			ids: {ApplicationWebhookIdentifiers: ids},
+		},
+	}
+	target := &countingSink{}
+	sink := &HealthCheckSink{
+		Target:       target,
+		Registry:     registry,
+		SuspendAfter: 2,
+	}
+	newRequest := func() *http.Request {
+		req, err := http.NewRequestWithContext(withWebhookID(ctx, ids), http.MethodPost, "https://example.com", nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %s", err)
+		}
+		return req
+	}
+	getHealth := func() *ttnpb.ApplicationWebhookHealth {
+		hook, err := registry.Get(ctx, ids, []string{"health"})
+		if err != nil {
+			t.Fatalf("Failed to get webhook: %s", err)
+		}
+		return hook.Health
+	}
+
+	// A successful request marks the webhook healthy.
+	a.So(sink.Process(newRequest()), should.BeNil)
+	health := getHealth()
+	if a.So(health, should.NotBeNil) {
+		a.So(health.FailedAttempts, should.Equal, uint64(0))
+		a.So(health.LastSuccessfulAttemptAt, should.NotBeNil)
+		a.So(health.Suspended, should.BeFalse)
+	}
+
+	// Failed requests are counted, until the webhook is suspended.
+	target.err = errTestTarget.New()
+	a.So(errors.IsUnavailable(sink.Process(newRequest())), should.BeTrue)
+	health = getHealth()
+	a.So(health.FailedAttempts, should.Equal, uint64(1))
+	a.So(health.LastFailedAttemptDetails, should.NotBeNil)
+	a.So(health.Suspended, should.BeFalse)
+
+	a.So(sink.Process(newRequest()), should.NotBeNil)
+	health = getHealth()
+	a.So(health.FailedAttempts, should.Equal, uint64(2))
+	a.So(health.Suspended, should.BeTrue)
+	a.So(health.SuspendedAt, should.NotBeNil)
+	a.So(target.count, should.Equal, 3)
+
+	// Requests of suspended webhooks are not processed.
+	target.err = nil
+	err := sink.Process(newRequest())
+	a.So(errors.Resemble(err, errWebhookSuspended), should.BeTrue)
+	a.So(target.count, should.Equal, 3)
+
+	// Resetting the health enables the webhook again.
+	_, err = registry.Set(ctx, ids, []string{"health"}, func(hook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		hook.Health = nil
+		return hook, []string{"health"}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(sink.Process(newRequest()), should.BeNil)
+	a.So(target.count, should.Equal, 4)
+	a.So(getHealth().FailedAttempts, should.Equal, uint64(0))
+}
+
+type countingWebhookRegistry struct {
+	WebhookRegistry
+	mu   sync.Mutex
+	gets int
+}
+
+func (r *countingWebhookRegistry) Get(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string) (*ttnpb.ApplicationWebhook, error) {
+	r.mu.Lock()
+	r.gets++
+	r.mu.Unlock()
+	return r.WebhookRegistry.Get(ctx, ids, paths)
+}
+
+func TestHealthCheckSinkCache(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		WebhookID:              "foo-hook",
+	}
+	registry := &countingWebhookRegistry{
+		WebhookRegistry: &memoryWebhookRegistry{
+			hooks: map[ttnpb.ApplicationWebhookIdentifiers]*ttnpb.ApplicationWebhook{
+				ids: {ApplicationWebhookIdentifiers: ids},
+			},
+		},
+	}
+	target := &countingSink{}
+	sink := &HealthCheckSink{
+		Target:       target,
+		Registry:     registry,
+		SuspendAfter: 1,
+		CacheTTL:     test.Delay << 6,
+	}
+	newRequest := func() *http.Request {
+		req, err := http.NewRequestWithContext(withWebhookID(ctx, ids), http.MethodPost, "https://example.com", nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %s", err)
+		}
+		return req
+	}
+
+	// The health is retrieved once and updated in the cache after each request.
+	for i := 0; i < 3; i++ {
+		a.So(sink.Process(newRequest()), should.BeNil)
+	}
+	a.So(registry.gets, should.Equal, 1)
+	a.So(target.count, should.Equal, 3)
+
+	// The suspension is cached, so requests are rejected without retrieving the health.
+	target.err = errTestTarget.New()
+	a.So(sink.Process(newRequest()), should.NotBeNil)
+	err := sink.Process(newRequest())
+	a.So(errors.Resemble(err, errWebhookSuspended), should.BeTrue)
+	a.So(registry.gets, should.Equal, 1)
+	a.So(target.count, should.Equal, 4)
+
+	// Resetting the health takes effect when the cache expires.
+	_, err = registry.Set(ctx, ids, []string{"health"}, func(hook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		hook.Health = nil
+		return hook, []string{"health"}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	target.err = nil
+	err = sink.Process(newRequest())
+	a.So(errors.Resemble(err, errWebhookSuspended), should.BeTrue)
+
+	time.Sleep(test.Delay << 7)
+	a.So(sink.Process(newRequest()), should.BeNil)
+	a.So(registry.gets, should.Equal, 2)
+	a.So(target.count, should.Equal, 5)
+}
@@ -25,13 +25,15 @@ import (
 )
 
 type (
-	deviceIDKeyType  struct{}
-	webhookIDKeyType struct{}
+	deviceIDKeyType            struct{}
+	webhookIDKeyType           struct{}
+	webhookURLReferenceKeyType struct{}
 )
 
 var (
-	deviceIDKey  deviceIDKeyType
-	webhookIDKey webhookIDKeyType
+	deviceIDKey            deviceIDKeyType
+	webhookIDKey           webhookIDKeyType
+	webhookURLReferenceKey webhookURLReferenceKeyType
 )
 
 func withDeviceID(ctx context.Context, id ttnpb.EndDeviceIdentifiers) context.Context {
@@ -58,6 +60,16 @@ func webhookIDFromContext(ctx context.Context) ttnpb.ApplicationWebhookIdentifie
 	return id
 }
 
+// withWebhookURLReference returns a context with the reference of the request URL relative to the webhook base URL.
+func withWebhookURLReference(ctx context.Context, ref string) context.Context {
+	return context.WithValue(ctx, webhookURLReferenceKey, ref)
+}
+
+func webhookURLReferenceFromContext(ctx context.Context) (string, bool) {
+	ref, ok := ctx.Value(webhookURLReferenceKey).(string)
+	return ref, ok
+}
+
 func (w *webhooks) validateAndFillIDs(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -0,0 +1,44 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	evtWebhookFail = events.Define(
+		"as.webhook.fail", "fail webhook",
+		events.WithVisibility(
+			ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+			ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+		),
+		events.WithErrorDataType(),
+	)
+	evtWebhookSuspend = events.Define(
+		"as.webhook.suspend", "suspend webhook",
+		events.WithVisibility(
+			ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+			ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+		),
+		events.WithDataType(&ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "application-id",
+			},
+			WebhookID: "webhook-id",
+		}),
+	)
+)
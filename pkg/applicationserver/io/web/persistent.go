@@ -0,0 +1,371 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// RequestQueue is a persistent queue of webhook requests.
+type RequestQueue interface {
+	// Add stores the request and schedules its delivery at startAt.
+	// If the request is already queued, it is updated and rescheduled.
+	Add(ctx context.Context, req *ttnpb.ApplicationWebhookRequest, startAt time.Time) error
+	// Pop calls f on the earliest request in the queue that is due, if such is available, otherwise it blocks until it is.
+	// If ctx.Deadline() is present, Pop returns at or shortly after it.
+	Pop(ctx context.Context, f func(context.Context, *ttnpb.ApplicationWebhookRequest) error) error
+	// Remove removes the request from the queue.
+	Remove(ctx context.Context, req *ttnpb.ApplicationWebhookRequest) error
+	// RemoveWebhook removes all requests of the webhook from the queue.
+	RemoveWebhook(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) error
+}
+
+// DeadLetterRegistry is a store for webhook requests that could not be delivered.
+type DeadLetterRegistry interface {
+	// Add adds the request to the dead letters of its webhook.
+	Add(ctx context.Context, req *ttnpb.ApplicationWebhookRequest) error
+	// List returns the dead letters of the webhook, most recent first, and the total number of dead letters.
+	// If limit is 0, all dead letters starting at offset are returned.
+	List(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, limit, offset int64) ([]*ttnpb.ApplicationWebhookRequest, int64, error)
+	// Remove removes the dead letters with the given request IDs and returns the removed requests.
+	// If no request IDs are given, all dead letters of the webhook are removed.
+	Remove(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, requestIDs ...string) ([]*ttnpb.ApplicationWebhookRequest, error)
+}
+
+// DeadLetterSink is a Sink that keeps requests which could not be delivered, and that can replay them.
+type DeadLetterSink interface {
+	Sink
+	// ListDeadLetters returns the dead letters of the webhook and the total number of dead letters.
+	ListDeadLetters(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, limit, offset int64) ([]*ttnpb.ApplicationWebhookRequest, int64, error)
+	// ReplayDeadLetters queues the dead letters with the given request IDs for delivery.
+	// If no request IDs are given, all dead letters of the webhook are replayed.
+	ReplayDeadLetters(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, requestIDs ...string) error
+	// RemoveWebhook removes the queued requests and the dead letters of the webhook.
+	RemoveWebhook(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) error
+}
+
+const retryBackoffJitter = 0.1
+
+// Backoff returns the time to wait before the next delivery attempt, given the number of attempts so far.
+// The backoff is doubled with every attempt, until it reaches MaxBackoff.
+func (c RetryConfig) Backoff(attempts int) time.Duration {
+	d := c.InitialBackoff
+	if d <= 0 {
+		d = time.Second
+	}
+	for i := 1; i < attempts && (c.MaxBackoff <= 0 || d < c.MaxBackoff); i++ {
+		d *= 2
+	}
+	if c.MaxBackoff > 0 && d > c.MaxBackoff {
+		d = c.MaxBackoff
+	}
+	return random.Jitter(d, retryBackoffJitter)
+}
+
+// PersistentSink is a ControllableSink that persists requests in a RequestQueue.
+// Requests are delivered to the target by concurrent workers and retried with exponential backoff when delivery fails.
+// Requests that are not delivered after the maximum number of attempts are moved to the dead letters.
+// The webhook is retrieved from the Registry before each attempt, so that requests of deleted webhooks are dropped and
+// requests of updated webhooks use the current base URL and headers. Each attempt times out after Timeout.
+type PersistentSink struct {
+	Target      Sink
+	Registry    WebhookRegistry
+	Queue       RequestQueue
+	DeadLetters DeadLetterRegistry
+	Workers     int
+	Retry       RetryConfig
+	Timeout     time.Duration
+}
+
+// defaultDeliverTimeout is the timeout of a delivery attempt if the sink does not specify the timeout.
+const defaultDeliverTimeout = 30 * time.Second
+
+// persistedHeaders are the headers of the request that are persisted.
+// The headers configured in the webhook, which may contain secrets such as the downlink API key, are not persisted but
+// set from the webhook when the request is delivered.
+var persistedHeaders = []string{
+	"Content-Type",
+	"User-Agent",
+	downlinkPushHeader,
+	downlinkReplaceHeader,
+}
+
+var (
+	errNoWebhookIdentifiers = errors.DefineInvalidArgument("no_webhook_identifiers", "no webhook identifiers in request context")
+	errDeadLettersNotFound  = errors.DefineNotFound("dead_letters_not_found", "dead letters not found")
+	errDeliver              = errors.DefineUnavailable("deliver", "deliver request")
+)
+
+func newRequestID() (string, error) {
+	id, err := ulid.New(ulid.Timestamp(time.Now()), rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+// Process persists the request in the queue.
+// This method returns when the request is persisted, before it is delivered.
+func (s *PersistentSink) Process(req *http.Request) error {
+	ctx := req.Context()
+	ids, ok := ctx.Value(webhookIDKey).(ttnpb.ApplicationWebhookIdentifiers)
+	if !ok {
+		return errNoWebhookIdentifiers.New()
+	}
+	id, err := newRequestID()
+	if err != nil {
+		return err
+	}
+	var body []byte
+	if req.Body != nil {
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return err
+		}
+	}
+	headers := make(map[string]string, len(persistedHeaders))
+	for _, key := range persistedHeaders {
+		if value := req.Header.Get(key); value != "" {
+			headers[key] = value
+		}
+	}
+	reqURL, ok := webhookURLReferenceFromContext(ctx)
+	if !ok {
+		reqURL = req.URL.String()
+	}
+	now := time.Now().UTC()
+	return s.Queue.Add(ctx, &ttnpb.ApplicationWebhookRequest{
+		ApplicationWebhookIdentifiers: ids,
+		ID:                            id,
+		CreatedAt:                     now,
+		URL:                           reqURL,
+		Headers:                       headers,
+		Body:                          body,
+	}, now)
+}
+
+// popTimeout is the maximum time a worker blocks waiting for a request, so that it can respond to cancellation.
+const popTimeout = 5 * time.Second
+
+// Run starts concurrent workers to deliver requests from the queue.
+// If Target is a ControllableSink, this method runs the target.
+// This method blocks until the target (if controllable) and all workers are done.
+func (s *PersistentSink) Run(ctx context.Context) error {
+	if s.Workers < 1 {
+		s.Workers = 1
+	}
+	wg := sync.WaitGroup{}
+	if controllable, ok := s.Target.(ControllableSink); ok {
+		wg.Add(1)
+		go func() {
+			if err := controllable.Run(ctx); err != nil && !errors.IsCanceled(err) {
+				log.FromContext(ctx).WithError(err).Error("Target sink failed")
+			}
+			wg.Done()
+		}()
+	}
+	for i := 0; i < s.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+				// The request is delivered with the worker context, as the pop context only bounds the wait for a request.
+				popCtx, cancel := context.WithTimeout(ctx, popTimeout)
+				err := s.Queue.Pop(popCtx, func(_ context.Context, pb *ttnpb.ApplicationWebhookRequest) error {
+					return s.deliver(ctx, pb)
+				})
+				cancel()
+				if err != nil && !errors.IsCanceled(err) && !errors.IsDeadlineExceeded(err) {
+					log.FromContext(ctx).WithError(err).Warn("Failed to pop request from queue")
+					select {
+					case <-ctx.Done():
+					case <-time.After(random.Jitter(popTimeout, retryBackoffJitter)):
+					}
+				}
+			}
+		}()
+	}
+	<-ctx.Done()
+	wg.Wait()
+	return ctx.Err()
+}
+
+// requestURL returns the URL of the request.
+// If the URL is a reference relative to the base URL of the webhook, it is resolved using the current base URL.
+func requestURL(hook *ttnpb.ApplicationWebhook, rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	if u.IsAbs() {
+		return rawURL, nil
+	}
+	baseURL, err := url.Parse(hook.BaseURL)
+	if err != nil {
+		return "", err
+	}
+	// The end device of the request is unknown, so only the application variables can be expanded.
+	expandVariables(baseURL, &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: hook.ApplicationIdentifiers,
+		},
+	})
+	return resolveMessageURL(baseURL, u).String(), nil
+}
+
+// deliver attempts to deliver the request to the target.
+// Before the attempt is made, the next attempt is scheduled, so that the request is not lost if delivery is interrupted.
+// If the webhook no longer exists, the request is dropped.
+func (s *PersistentSink) deliver(ctx context.Context, pb *ttnpb.ApplicationWebhookRequest) error {
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"application_id", pb.ApplicationID,
+		"webhook_id", pb.WebhookID,
+		"request_id", pb.ID,
+		"attempt", pb.Attempts+1,
+	))
+	now := time.Now().UTC()
+	pb.Attempts++
+	pb.LastAttemptAt = &now
+	if err := s.Queue.Add(ctx, pb, now.Add(s.Retry.Backoff(int(pb.Attempts)))); err != nil {
+		return err
+	}
+
+	hook, err := s.Registry.Get(ctx, pb.ApplicationWebhookIdentifiers, []string{
+		"base_url",
+		"downlink_api_key",
+		"headers",
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if hook == nil {
+		logger.Debug("Webhook not found, drop request")
+		return s.Queue.Remove(ctx, pb)
+	}
+	reqURL, err := requestURL(hook, pb.URL)
+	if err != nil {
+		return err
+	}
+
+	timeout := s.Timeout
+	if timeout <= 0 {
+		timeout = defaultDeliverTimeout
+	}
+	reqCtx, cancel := context.WithTimeout(withWebhookID(ctx, pb.ApplicationWebhookIdentifiers), timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, reqURL, bytes.NewReader(pb.Body))
+	if err != nil {
+		return err
+	}
+	for key, value := range hook.Headers {
+		req.Header.Set(key, value)
+	}
+	for key, value := range pb.Headers {
+		req.Header.Set(key, value)
+	}
+	if hook.DownlinkAPIKey != "" {
+		req.Header.Set(downlinkKeyHeader, hook.DownlinkAPIKey)
+	} else {
+		req.Header.Del(downlinkPushHeader)
+		req.Header.Del(downlinkReplaceHeader)
+	}
+	logger.WithField("url", reqURL).Debug("Deliver request")
+	deliverErr := s.Target.Process(req)
+	if deliverErr == nil {
+		return s.Queue.Remove(ctx, pb)
+	}
+	logger = logger.WithError(deliverErr)
+	if ttnErr, ok := errors.From(deliverErr); ok {
+		pb.LastError = ttnpb.ErrorDetailsToProto(ttnErr)
+	} else {
+		pb.LastError = ttnpb.ErrorDetailsToProto(errDeliver.WithCause(deliverErr))
+	}
+	if s.Retry.MaxAttempts > 0 && int(pb.Attempts) >= s.Retry.MaxAttempts {
+		logger.Warn("Failed to deliver request, move to dead letters")
+		if err := s.DeadLetters.Add(ctx, pb); err != nil {
+			return err
+		}
+		return s.Queue.Remove(ctx, pb)
+	}
+	logger.Debug("Failed to deliver request, retry later")
+	return s.Queue.Add(ctx, pb, now.Add(s.Retry.Backoff(int(pb.Attempts))))
+}
+
+// ListDeadLetters implements DeadLetterSink.
+// The URLs of the dead letters are resolved using the current base URL of the webhook.
+func (s *PersistentSink) ListDeadLetters(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, limit, offset int64) ([]*ttnpb.ApplicationWebhookRequest, int64, error) {
+	reqs, total, err := s.DeadLetters.List(ctx, ids, limit, offset)
+	if err != nil || len(reqs) == 0 {
+		return reqs, total, err
+	}
+	hook, err := s.Registry.Get(ctx, ids, []string{"base_url"})
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, req := range reqs {
+		if req.URL, err = requestURL(hook, req.URL); err != nil {
+			return nil, 0, err
+		}
+	}
+	return reqs, total, nil
+}
+
+// ReplayDeadLetters implements DeadLetterSink.
+// The replayed requests are removed from the dead letters and queued with their attempts reset.
+func (s *PersistentSink) ReplayDeadLetters(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, requestIDs ...string) error {
+	reqs, err := s.DeadLetters.Remove(ctx, ids, requestIDs...)
+	if err != nil {
+		return err
+	}
+	if len(requestIDs) > 0 && len(reqs) == 0 {
+		return errDeadLettersNotFound.New()
+	}
+	now := time.Now().UTC()
+	for _, req := range reqs {
+		req.Attempts = 0
+		req.LastAttemptAt = nil
+		req.LastError = nil
+		if err := s.Queue.Add(ctx, req, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveWebhook implements DeadLetterSink.
+func (s *PersistentSink) RemoveWebhook(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) error {
+	if err := s.Queue.RemoveWebhook(ctx, ids); err != nil {
+		return err
+	}
+	_, err := s.DeadLetters.Remove(ctx, ids)
+	return err
+}
@@ -0,0 +1,458 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type memoryRequestQueue struct {
+	mu       sync.Mutex
+	requests map[string]*ttnpb.ApplicationWebhookRequest
+	startAt  map[string]time.Time
+	notify   chan struct{}
+}
+
+func newMemoryRequestQueue() *memoryRequestQueue {
+	return &memoryRequestQueue{
+		requests: make(map[string]*ttnpb.ApplicationWebhookRequest),
+		startAt:  make(map[string]time.Time),
+		notify:   make(chan struct{}, 1),
+	}
+}
+
+func (q *memoryRequestQueue) Add(ctx context.Context, req *ttnpb.ApplicationWebhookRequest, startAt time.Time) error {
+	q.mu.Lock()
+	q.requests[req.ID] = req
+	q.startAt[req.ID] = startAt
+	q.mu.Unlock()
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (q *memoryRequestQueue) Pop(ctx context.Context, f func(context.Context, *ttnpb.ApplicationWebhookRequest) error) error {
+	for {
+		q.mu.Lock()
+		var due *ttnpb.ApplicationWebhookRequest
+		for id, startAt := range q.startAt {
+			if !startAt.After(time.Now()) {
+				due = q.requests[id]
+				delete(q.startAt, id)
+				break
+			}
+		}
+		q.mu.Unlock()
+		if due != nil {
+			return f(ctx, due)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-q.notify:
+		case <-time.After(test.Delay):
+		}
+	}
+}
+
+func (q *memoryRequestQueue) Remove(ctx context.Context, req *ttnpb.ApplicationWebhookRequest) error {
+	q.mu.Lock()
+	delete(q.requests, req.ID)
+	delete(q.startAt, req.ID)
+	q.mu.Unlock()
+	return nil
+}
+
+func (q *memoryRequestQueue) RemoveWebhook(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) error {
+	q.mu.Lock()
+	for id, req := range q.requests {
+		if req.ApplicationWebhookIdentifiers == ids {
+			delete(q.requests, id)
+			delete(q.startAt, id)
+		}
+	}
+	q.mu.Unlock()
+	return nil
+}
+
+func (q *memoryRequestQueue) Get(id string) *ttnpb.ApplicationWebhookRequest {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.requests[id]
+}
+
+func (q *memoryRequestQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.requests)
+}
+
+type memoryDeadLetterRegistry struct {
+	mu       sync.Mutex
+	requests map[string]*ttnpb.ApplicationWebhookRequest
+}
+
+func (r *memoryDeadLetterRegistry) Add(ctx context.Context, req *ttnpb.ApplicationWebhookRequest) error {
+	r.mu.Lock()
+	r.requests[req.ID] = req
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *memoryDeadLetterRegistry) List(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, limit, offset int64) ([]*ttnpb.ApplicationWebhookRequest, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var reqs []*ttnpb.ApplicationWebhookRequest
+	for _, req := range r.requests {
+		if req.ApplicationWebhookIdentifiers == ids {
+			reqs = append(reqs, req)
+		}
+	}
+	sort.Slice(reqs, func(i, j int) bool { return reqs[i].ID > reqs[j].ID })
+	return reqs, int64(len(reqs)), nil
+}
+
+func (r *memoryDeadLetterRegistry) Remove(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, requestIDs ...string) ([]*ttnpb.ApplicationWebhookRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var reqs []*ttnpb.ApplicationWebhookRequest
+	for id, req := range r.requests {
+		if req.ApplicationWebhookIdentifiers != ids {
+			continue
+		}
+		if len(requestIDs) > 0 {
+			var found bool
+			for _, requestID := range requestIDs {
+				found = found || requestID == id
+			}
+			if !found {
+				continue
+			}
+		}
+		reqs = append(reqs, req)
+		delete(r.requests, id)
+	}
+	return reqs, nil
+}
+
+var errTestTarget = errors.DefineUnavailable("test_target", "test target")
+
+type failingSink struct {
+	mu       sync.Mutex
+	failures int
+	attempts int
+	last     *http.Request
+	ch       chan []byte
+}
+
+func (s *failingSink) Process(req *http.Request) error {
+	s.mu.Lock()
+	s.attempts++
+	s.last = req
+	fail := s.failures > 0
+	if fail {
+		s.failures--
+	}
+	s.mu.Unlock()
+	if fail {
+		return errTestTarget.New()
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	s.ch <- body
+	return nil
+}
+
+func (s *failingSink) Attempts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.attempts
+}
+
+func (s *failingSink) Last() *http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last
+}
+
+func TestRetryConfigBackoff(t *testing.T) {
+	a := assertions.New(t)
+	conf := RetryConfig{
+		InitialBackoff: time.Second,
+		MaxBackoff:     10 * time.Second,
+	}
+	for attempts, expected := range map[int]time.Duration{
+		1: time.Second,
+		2: 2 * time.Second,
+		3: 4 * time.Second,
+		4: 8 * time.Second,
+		5: 10 * time.Second,
+		9: 10 * time.Second,
+	} {
+		d := conf.Backoff(attempts)
+		a.So(d, should.BeBetweenOrEqual, expected-expected/10, expected+expected/10)
+	}
+}
+
+func TestPersistentSink(t *testing.T) {
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		WebhookID:              "foo-hook",
+	}
+
+	newRegistry := func() *memoryWebhookRegistry {
+		return &memoryWebhookRegistry{
+			hooks: map[ttnpb.ApplicationWebhookIdentifiers]*ttnpb.ApplicationWebhook{
+				ids: {
+					ApplicationWebhookIdentifiers: ids,
+					BaseURL:                       "https://example.com",
+					Headers: map[string]string{
+						"Authorization": "Bearer secret",
+					},
+					DownlinkAPIKey: "foo.secret",
+				},
+			},
+		}
+	}
+
+	newRequest := func(ctx context.Context, body []byte) *http.Request {
+		req, err := http.NewRequestWithContext(withWebhookURLReference(withWebhookID(ctx, ids), "up"), http.MethodPost, "https://example.com/up", bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to create request: %s", err)
+		}
+		req.Header.Set("Authorization", "Bearer secret")
+		req.Header.Set(downlinkKeyHeader, "foo.secret")
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	t.Run("NoWebhookIdentifiers", func(t *testing.T) {
+		a := assertions.New(t)
+		sink := &PersistentSink{
+			Target:      &failingSink{ch: make(chan []byte, 1)},
+			Queue:       newMemoryRequestQueue(),
+			DeadLetters: &memoryDeadLetterRegistry{requests: make(map[string]*ttnpb.ApplicationWebhookRequest)},
+		}
+		req, err := http.NewRequest(http.MethodPost, "https://example.com/up", nil)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(errors.IsInvalidArgument(sink.Process(req)), should.BeTrue)
+	})
+
+	t.Run("Retry", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx, cancel := context.WithCancel(test.Context())
+		defer cancel()
+
+		target := &failingSink{failures: 2, ch: make(chan []byte, 1)}
+		queue := newMemoryRequestQueue()
+		sink := &PersistentSink{
+			Target:      target,
+			Registry:    newRegistry(),
+			Queue:       queue,
+			DeadLetters: &memoryDeadLetterRegistry{requests: make(map[string]*ttnpb.ApplicationWebhookRequest)},
+			Retry: RetryConfig{
+				MaxAttempts:    5,
+				InitialBackoff: test.Delay,
+				MaxBackoff:     test.Delay,
+			},
+		}
+		go sink.Run(ctx)
+
+		a.So(sink.Process(newRequest(ctx, []byte("retry"))), should.BeNil)
+		select {
+		case body := <-target.ch:
+			a.So(body, should.Resemble, []byte("retry"))
+		case <-time.After(100 * test.Delay):
+			t.Fatal("Timed out waiting for delivery")
+		}
+		a.So(target.Attempts(), should.Equal, 3)
+		time.Sleep(test.Delay)
+		a.So(queue.Len(), should.Equal, 0)
+	})
+
+	t.Run("DeadLetters", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx, cancel := context.WithCancel(test.Context())
+		defer cancel()
+
+		target := &failingSink{failures: 3, ch: make(chan []byte, 1)}
+		queue := newMemoryRequestQueue()
+		sink := &PersistentSink{
+			Target:      target,
+			Registry:    newRegistry(),
+			Queue:       queue,
+			DeadLetters: &memoryDeadLetterRegistry{requests: make(map[string]*ttnpb.ApplicationWebhookRequest)},
+			Retry: RetryConfig{
+				MaxAttempts:    3,
+				InitialBackoff: test.Delay,
+				MaxBackoff:     test.Delay,
+			},
+		}
+		go sink.Run(ctx)
+
+		a.So(sink.Process(newRequest(ctx, []byte("dead"))), should.BeNil)
+
+		var deadLetters []*ttnpb.ApplicationWebhookRequest
+		for i := 0; i < 100 && len(deadLetters) == 0; i++ {
+			time.Sleep(test.Delay)
+			var err error
+			deadLetters, _, err = sink.ListDeadLetters(ctx, ids, 0, 0)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+		}
+		if !a.So(deadLetters, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		a.So(deadLetters[0].Attempts, should.Equal, uint32(3))
+		a.So(deadLetters[0].Body, should.Resemble, []byte("dead"))
+		a.So(deadLetters[0].URL, should.Equal, "https://example.com/up")
+		a.So(deadLetters[0].Headers, should.Resemble, map[string]string{
+			"Content-Type": "application/json",
+		})
+		a.So(deadLetters[0].LastError, should.NotBeNil)
+		a.So(queue.Len(), should.Equal, 0)
+
+		err := sink.ReplayDeadLetters(ctx, ids, "unknown")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+
+		a.So(sink.ReplayDeadLetters(ctx, ids, deadLetters[0].ID), should.BeNil)
+		select {
+		case body := <-target.ch:
+			a.So(body, should.Resemble, []byte("dead"))
+		case <-time.After(100 * test.Delay):
+			t.Fatal("Timed out waiting for delivery")
+		}
+		deadLetters, total, err := sink.ListDeadLetters(ctx, ids, 0, 0)
+		a.So(err, should.BeNil)
+		a.So(deadLetters, should.BeEmpty)
+		a.So(total, should.Equal, int64(0))
+	})
+	t.Run("Webhook", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx, cancel := context.WithCancel(test.Context())
+		defer cancel()
+
+		target := &failingSink{failures: 1, ch: make(chan []byte, 1)}
+		queue := newMemoryRequestQueue()
+		registry := newRegistry()
+		sink := &PersistentSink{
+			Target:      target,
+			Registry:    registry,
+			Queue:       queue,
+			DeadLetters: &memoryDeadLetterRegistry{requests: make(map[string]*ttnpb.ApplicationWebhookRequest)},
+			Retry: RetryConfig{
+				InitialBackoff: 10 * test.Delay,
+				MaxBackoff:     10 * test.Delay,
+			},
+		}
+
+		a.So(sink.Process(newRequest(ctx, []byte("update"))), should.BeNil)
+		if !a.So(queue.Len(), should.Equal, 1) {
+			t.FailNow()
+		}
+		for id := range queue.requests {
+			pb := queue.Get(id)
+			a.So(pb.URL, should.Equal, "up")
+			a.So(pb.Headers, should.Resemble, map[string]string{
+				"Content-Type": "application/json",
+			})
+		}
+
+		_, err := registry.Set(ctx, ids, nil, func(hook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			return &ttnpb.ApplicationWebhook{
+				BaseURL: "https://example.org/ttn",
+				Headers: map[string]string{
+					"Authorization": "Bearer other",
+				},
+			}, []string{"base_url", "headers"}, nil
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		go sink.Run(ctx)
+		select {
+		case body := <-target.ch:
+			a.So(body, should.Resemble, []byte("update"))
+		case <-time.After(100 * test.Delay):
+			t.Fatal("Timed out waiting for delivery")
+		}
+		req := target.Last()
+		a.So(req.URL.String(), should.Equal, "https://example.org/ttn/up")
+		a.So(req.Header.Get("Authorization"), should.Equal, "Bearer other")
+		a.So(req.Header.Get(downlinkKeyHeader), should.Equal, "foo.secret")
+		a.So(req.Header.Get("Content-Type"), should.Equal, "application/json")
+
+		// Requests of deleted webhooks are dropped.
+		target.mu.Lock()
+		target.failures = 1
+		target.mu.Unlock()
+		a.So(sink.Process(newRequest(ctx, []byte("delete"))), should.BeNil)
+		time.Sleep(test.Delay)
+		registry.mu.Lock()
+		delete(registry.hooks, ids)
+		registry.mu.Unlock()
+		for i := 0; i < 100 && queue.Len() > 0; i++ {
+			time.Sleep(test.Delay)
+		}
+		a.So(queue.Len(), should.Equal, 0)
+		a.So(target.Attempts(), should.Equal, 3)
+	})
+
+	t.Run("RemoveWebhook", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx := test.Context()
+
+		queue := newMemoryRequestQueue()
+		deadLetters := &memoryDeadLetterRegistry{requests: make(map[string]*ttnpb.ApplicationWebhookRequest)}
+		sink := &PersistentSink{
+			Target:      &failingSink{ch: make(chan []byte, 1)},
+			Registry:    newRegistry(),
+			Queue:       queue,
+			DeadLetters: deadLetters,
+		}
+		a.So(sink.Process(newRequest(ctx, []byte("queued"))), should.BeNil)
+		a.So(deadLetters.Add(ctx, &ttnpb.ApplicationWebhookRequest{
+			ApplicationWebhookIdentifiers: ids,
+			ID:                            "dead",
+		}), should.BeNil)
+
+		a.So(sink.RemoveWebhook(ctx, ids), should.BeNil)
+		a.So(queue.Len(), should.Equal, 0)
+		_, total, err := deadLetters.List(ctx, ids, 0, 0)
+		a.So(err, should.BeNil)
+		a.So(total, should.Equal, int64(0))
+	})
+}
@@ -0,0 +1,191 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// DeadLetterRegistry is a Redis registry of webhook requests that could not be delivered.
+// Dead letters expire after TTL, and at most MaxLen dead letters are kept per webhook, removing the oldest ones first.
+// A zero TTL or MaxLen means no limit.
+type DeadLetterRegistry struct {
+	Redis  *ttnredis.Client
+	TTL    time.Duration
+	MaxLen int64
+}
+
+func (r *DeadLetterRegistry) webhookKey(appUID, webhookID string) string {
+	return r.Redis.Key("uid", appUID, webhookID)
+}
+
+func (r *DeadLetterRegistry) idKey(appUID, webhookID, id string) string {
+	return r.Redis.Key("uid", appUID, webhookID, id)
+}
+
+func (r *DeadLetterRegistry) makeIDKeyFunc(appUID, webhookID string) func(id string) string {
+	return func(id string) string {
+		return r.idKey(appUID, webhookID, id)
+	}
+}
+
+// expiredScore returns the maximum score of expired dead letters, or the empty string if dead letters do not expire.
+func (r *DeadLetterRegistry) expiredScore(now time.Time) string {
+	if r.TTL <= 0 {
+		return ""
+	}
+	return "(" + strconv.FormatInt(now.Add(-r.TTL).UnixNano(), 10)
+}
+
+// Add implements web.DeadLetterRegistry.
+// The dead letters of the webhook are stored in a sorted set scored by the time at which they were added.
+func (r *DeadLetterRegistry) Add(ctx context.Context, req *ttnpb.ApplicationWebhookRequest) error {
+	appUID := unique.ID(ctx, req.ApplicationIdentifiers)
+	wk := r.webhookKey(appUID, req.WebhookID)
+	now := time.Now()
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		var evicted []string
+		if r.MaxLen > 0 {
+			var err error
+			// Evict the oldest dead letters, so that the set contains MaxLen dead letters after adding this one.
+			evicted, err = tx.ZRange(wk, 0, -r.MaxLen).Result()
+			if err != nil {
+				return err
+			}
+		}
+		_, err := tx.TxPipelined(func(p redis.Pipeliner) error {
+			if _, err := ttnredis.SetProto(p, r.idKey(appUID, req.WebhookID, req.ID), req, r.TTL); err != nil {
+				return err
+			}
+			p.ZAdd(wk, &redis.Z{
+				Score:  float64(now.UnixNano()),
+				Member: req.ID,
+			})
+			if len(evicted) > 0 {
+				members := make([]interface{}, 0, len(evicted))
+				for _, id := range evicted {
+					p.Del(r.idKey(appUID, req.WebhookID, id))
+					members = append(members, id)
+				}
+				p.ZRem(wk, members...)
+			}
+			if max := r.expiredScore(now); max != "" {
+				p.ZRemRangeByScore(wk, "-inf", max)
+				p.PExpire(wk, r.TTL)
+			}
+			return nil
+		})
+		return err
+	}, wk)
+	return ttnredis.ConvertError(err)
+}
+
+// List implements web.DeadLetterRegistry.
+// The dead letters are returned in reverse order of the time at which they were added, so the most recent first.
+func (r *DeadLetterRegistry) List(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, limit, offset int64) ([]*ttnpb.ApplicationWebhookRequest, int64, error) {
+	appUID := unique.ID(ctx, ids.ApplicationIdentifiers)
+	wk := r.webhookKey(appUID, ids.WebhookID)
+	if max := r.expiredScore(time.Now()); max != "" {
+		if err := r.Redis.ZRemRangeByScore(wk, "-inf", max).Err(); err != nil {
+			return nil, 0, ttnredis.ConvertError(err)
+		}
+	}
+	total, err := r.Redis.ZCard(wk).Result()
+	if err != nil {
+		return nil, 0, ttnredis.ConvertError(err)
+	}
+	stop := int64(-1)
+	if limit > 0 {
+		stop = offset + limit - 1
+	}
+	reqIDs, err := r.Redis.ZRevRange(wk, offset, stop).Result()
+	if err != nil {
+		return nil, 0, ttnredis.ConvertError(err)
+	}
+	cmds := make([]*ttnredis.ProtoCmd, 0, len(reqIDs))
+	if _, err := r.Redis.Pipelined(func(p redis.Pipeliner) error {
+		for _, id := range reqIDs {
+			cmds = append(cmds, ttnredis.GetProto(p, r.idKey(appUID, ids.WebhookID, id)))
+		}
+		return nil
+	}); err != nil && err != redis.Nil {
+		return nil, 0, ttnredis.ConvertError(err)
+	}
+	pbs := make([]*ttnpb.ApplicationWebhookRequest, 0, len(cmds))
+	for _, cmd := range cmds {
+		pb := &ttnpb.ApplicationWebhookRequest{}
+		if err := cmd.ScanProto(pb); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, 0, err
+		}
+		pbs = append(pbs, pb)
+	}
+	return pbs, total, nil
+}
+
+// Remove implements web.DeadLetterRegistry.
+func (r *DeadLetterRegistry) Remove(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, requestIDs ...string) ([]*ttnpb.ApplicationWebhookRequest, error) {
+	appUID := unique.ID(ctx, ids.ApplicationIdentifiers)
+	wk := r.webhookKey(appUID, ids.WebhookID)
+	var pbs []*ttnpb.ApplicationWebhookRequest
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		pbs = nil
+		reqIDs := requestIDs
+		if len(reqIDs) == 0 {
+			var err error
+			reqIDs, err = tx.ZRange(wk, 0, -1).Result()
+			if err != nil {
+				return err
+			}
+		}
+		for _, id := range reqIDs {
+			pb := &ttnpb.ApplicationWebhookRequest{}
+			if err := ttnredis.GetProto(tx, r.idKey(appUID, ids.WebhookID, id)).ScanProto(pb); err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+			pbs = append(pbs, pb)
+		}
+		if len(pbs) == 0 {
+			return nil
+		}
+		_, err := tx.TxPipelined(func(p redis.Pipeliner) error {
+			members := make([]interface{}, 0, len(pbs))
+			for _, pb := range pbs {
+				p.Del(r.idKey(appUID, pb.WebhookID, pb.ID))
+				members = append(members, pb.ID)
+			}
+			p.ZRem(wk, members...)
+			return nil
+		})
+		return err
+	}, wk)
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pbs, nil
+}
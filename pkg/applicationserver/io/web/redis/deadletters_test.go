@@ -0,0 +1,85 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDeadLetterRegistryOrder(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "web_redis_test", "deadletters")
+	defer flush()
+	defer cl.Close()
+
+	registry := &DeadLetterRegistry{
+		Redis:  cl,
+		MaxLen: 4,
+	}
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+		WebhookID:              "test-hook",
+	}
+
+	for i := 0; i < 5; i++ {
+		err := registry.Add(ctx, &ttnpb.ApplicationWebhookRequest{
+			ApplicationWebhookIdentifiers: ids,
+			ID:                            fmt.Sprintf("request-%d", i),
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		// Ensure that the dead letters are added at different times.
+		time.Sleep(time.Millisecond)
+	}
+
+	requestIDs := func(reqs []*ttnpb.ApplicationWebhookRequest) []string {
+		res := make([]string, 0, len(reqs))
+		for _, req := range reqs {
+			res = append(res, req.ID)
+		}
+		return res
+	}
+
+	// The oldest dead letter is evicted, and the most recent dead letter is listed first.
+	reqs, total, err := registry.List(ctx, ids, 0, 0)
+	a.So(err, should.BeNil)
+	a.So(total, should.Equal, int64(4))
+	a.So(requestIDs(reqs), should.Resemble, []string{"request-4", "request-3", "request-2", "request-1"})
+
+	reqs, total, err = registry.List(ctx, ids, 2, 1)
+	a.So(err, should.BeNil)
+	a.So(total, should.Equal, int64(4))
+	a.So(requestIDs(reqs), should.Resemble, []string{"request-3", "request-2"})
+
+	removed, err := registry.Remove(ctx, ids, "request-3")
+	a.So(err, should.BeNil)
+	a.So(requestIDs(removed), should.Resemble, []string{"request-3"})
+
+	reqs, total, err = registry.List(ctx, ids, 2, 0)
+	a.So(err, should.BeNil)
+	a.So(total, should.Equal, int64(3))
+	a.So(requestIDs(reqs), should.Resemble, []string{"request-4", "request-2"})
+}
@@ -0,0 +1,119 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// RequestQueue is an implementation of web.RequestQueue.
+type RequestQueue struct {
+	*ttnredis.TaskQueue
+	client *ttnredis.Client
+}
+
+const (
+	requestQueueKey = "queue"
+	requestKey      = "request"
+	webhookKey      = "webhook"
+)
+
+// NewRequestQueue returns a new webhook request queue.
+func NewRequestQueue(cl *ttnredis.Client, maxLen int64, group, id string) *RequestQueue {
+	return &RequestQueue{
+		TaskQueue: &ttnredis.TaskQueue{
+			Redis:  cl,
+			MaxLen: maxLen,
+			Group:  group,
+			ID:     id,
+			Key:    cl.Key(requestQueueKey),
+		},
+		client: cl,
+	}
+}
+
+func (q *RequestQueue) requestKey(id string) string {
+	return q.client.Key(requestKey, id)
+}
+
+// webhookKey returns the key of the set of request IDs of the webhook.
+func (q *RequestQueue) webhookKey(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) string {
+	return q.client.Key(webhookKey, unique.ID(ctx, ids))
+}
+
+// Add implements web.RequestQueue.
+func (q *RequestQueue) Add(ctx context.Context, req *ttnpb.ApplicationWebhookRequest, startAt time.Time) error {
+	_, err := q.client.TxPipelined(func(p redis.Pipeliner) error {
+		if _, err := ttnredis.SetProto(p, q.requestKey(req.ID), req, 0); err != nil {
+			return err
+		}
+		p.SAdd(q.webhookKey(ctx, req.ApplicationWebhookIdentifiers), req.ID)
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return q.TaskQueue.Add(req.ID, startAt, true)
+}
+
+// Pop implements web.RequestQueue.
+// Tasks of requests that were removed before they were due are skipped.
+func (q *RequestQueue) Pop(ctx context.Context, f func(context.Context, *ttnpb.ApplicationWebhookRequest) error) error {
+	return q.TaskQueue.Pop(ctx, func(id string, _ time.Time) error {
+		pb := &ttnpb.ApplicationWebhookRequest{}
+		if err := ttnredis.GetProto(q.client, q.requestKey(id)).ScanProto(pb); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return f(ctx, pb)
+	})
+}
+
+// Remove implements web.RequestQueue.
+func (q *RequestQueue) Remove(ctx context.Context, req *ttnpb.ApplicationWebhookRequest) error {
+	_, err := q.client.TxPipelined(func(p redis.Pipeliner) error {
+		p.Del(q.requestKey(req.ID))
+		p.SRem(q.webhookKey(ctx, req.ApplicationWebhookIdentifiers), req.ID)
+		return nil
+	})
+	return ttnredis.ConvertError(err)
+}
+
+// RemoveWebhook implements web.RequestQueue.
+// The tasks of the removed requests remain in the queue, but are skipped when they are due.
+func (q *RequestQueue) RemoveWebhook(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) error {
+	wk := q.webhookKey(ctx, ids)
+	reqIDs, err := q.client.SMembers(wk).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	_, err = q.client.TxPipelined(func(p redis.Pipeliner) error {
+		for _, id := range reqIDs {
+			p.Del(q.requestKey(id))
+		}
+		p.Del(wk)
+		return nil
+	})
+	return ttnredis.ConvertError(err)
+}
@@ -20,6 +20,8 @@ import (
 
 	"github.com/go-redis/redis/v7"
 	"github.com/gogo/protobuf/proto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -30,8 +32,20 @@ var (
 	errInvalidFieldmask   = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
 	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
 	errReadOnlyField      = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errDecryptSecrets     = errors.DefineCorruption("decrypt_secrets", "decrypt webhook secrets")
 )
 
+// secretWebhookPaths are the paths of the ttnpb.ApplicationWebhook fields that are stored separately from the webhook.
+var secretWebhookPaths = []string{
+	"signing_secret",
+	"tls_client_cert",
+	"tls_client_key",
+}
+
+func hasSecrets(pb *ttnpb.ApplicationWebhook) bool {
+	return pb.SigningSecret != "" || len(pb.TLSClientCert) > 0 || len(pb.TLSClientKey) > 0
+}
+
 // appendImplicitWebhookGetPaths appends implicit ttnpb.ApplicationWebhook get paths to paths.
 func appendImplicitWebhookGetPaths(paths ...string) []string {
 	return append(append(make([]string, 0, 3+len(paths)),
@@ -49,8 +63,12 @@ func applyWebhookFieldMask(dst, src *ttnpb.ApplicationWebhook, paths ...string)
 }
 
 // WebhookRegistry is a Redis webhook registry.
+// The secrets of the webhooks are stored separately from the webhooks. If KEKLabel is set, the secrets are
+// encrypted at rest with a random data key, which is wrapped using the KeyVault and KEKLabel.
 type WebhookRegistry struct {
-	Redis *ttnredis.Client
+	Redis    *ttnredis.Client
+	KeyVault crypto.KeyVault
+	KEKLabel string
 }
 
 func (r *WebhookRegistry) appKey(uid string) string {
@@ -61,18 +79,89 @@ func (r *WebhookRegistry) idKey(appUID, id string) string {
 	return r.Redis.Key("uid", appUID, id)
 }
 
+func (r *WebhookRegistry) secretsKey(appUID, id string) string {
+	return r.Redis.Key("uid", appUID, id, "secrets")
+}
+
 func (r *WebhookRegistry) makeIDKeyFunc(appUID string) func(id string) string {
 	return func(id string) string {
 		return r.idKey(appUID, id)
 	}
 }
 
+// wrapSecrets returns the hash fields in which the secrets of pb are stored.
+func (r WebhookRegistry) wrapSecrets(ctx context.Context, pb *ttnpb.ApplicationWebhook) ([]interface{}, error) {
+	secrets := &ttnpb.ApplicationWebhook{}
+	if err := secrets.SetFields(pb, secretWebhookPaths...); err != nil {
+		return nil, err
+	}
+	b, err := proto.Marshal(secrets)
+	if err != nil {
+		return nil, err
+	}
+	value, dataKey, err := cryptoutil.WrapBytes(ctx, b, r.KEKLabel, r.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+	if dataKey == nil {
+		return []interface{}{"value", value}, nil
+	}
+	dataKeyBytes, err := proto.Marshal(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{"data_key", dataKeyBytes, "value", value}, nil
+}
+
+// getSecrets returns the secrets stored in the hash at k.
+// If no secrets are stored, getSecrets returns nil.
+func (r WebhookRegistry) getSecrets(ctx context.Context, c redis.Cmdable, k string) (*ttnpb.ApplicationWebhook, error) {
+	fields, err := c.HGetAll(k).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	var dataKey *ttnpb.KeyEnvelope
+	if dataKeyBytes, ok := fields["data_key"]; ok {
+		dataKey = &ttnpb.KeyEnvelope{}
+		if err := proto.Unmarshal([]byte(dataKeyBytes), dataKey); err != nil {
+			return nil, errDecryptSecrets.WithCause(err)
+		}
+	}
+	b, err := cryptoutil.UnwrapBytes(ctx, []byte(fields["value"]), dataKey, r.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+	secrets := &ttnpb.ApplicationWebhook{}
+	if err := proto.Unmarshal(b, secrets); err != nil {
+		return nil, errDecryptSecrets.WithCause(err)
+	}
+	return secrets, nil
+}
+
+// setSecrets sets the secrets stored at the secrets key of pb to pb.
+func (r WebhookRegistry) setSecrets(ctx context.Context, c redis.Cmdable, appUID string, pb *ttnpb.ApplicationWebhook) error {
+	secrets, err := r.getSecrets(ctx, c, r.secretsKey(appUID, pb.WebhookID))
+	if err != nil || secrets == nil {
+		return err
+	}
+	return pb.SetFields(secrets, secretWebhookPaths...)
+}
+
 // Get implements WebhookRegistry.
 func (r WebhookRegistry) Get(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string) (*ttnpb.ApplicationWebhook, error) {
+	appUID := unique.ID(ctx, ids.ApplicationIdentifiers)
 	pb := &ttnpb.ApplicationWebhook{}
-	if err := ttnredis.GetProto(r.Redis, r.idKey(unique.ID(ctx, ids.ApplicationIdentifiers), ids.WebhookID)).ScanProto(pb); err != nil {
+	if err := ttnredis.GetProto(r.Redis, r.idKey(appUID, ids.WebhookID)).ScanProto(pb); err != nil {
 		return nil, err
 	}
+	if ttnpb.HasAnyField(paths, secretWebhookPaths...) {
+		if err := r.setSecrets(ctx, r.Redis, appUID, pb); err != nil {
+			return nil, err
+		}
+	}
 	return applyWebhookFieldMask(nil, pb, appendImplicitWebhookGetPaths(paths...)...)
 }
 
@@ -83,6 +172,11 @@ func (r WebhookRegistry) List(ctx context.Context, ids ttnpb.ApplicationIdentifi
 	err := ttnredis.FindProtos(r.Redis, r.appKey(appUID), r.makeIDKeyFunc(appUID)).Range(func() (proto.Message, func() (bool, error)) {
 		pb := &ttnpb.ApplicationWebhook{}
 		return pb, func() (bool, error) {
+			if ttnpb.HasAnyField(paths, secretWebhookPaths...) {
+				if err := r.setSecrets(ctx, r.Redis, appUID, pb); err != nil {
+					return false, err
+				}
+			}
 			pb, err := applyWebhookFieldMask(nil, pb, appendImplicitWebhookGetPaths(paths...)...)
 			if err != nil {
 				return false, err
@@ -101,12 +195,26 @@ func (r WebhookRegistry) List(ctx context.Context, ids ttnpb.ApplicationIdentifi
 func (r WebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, gets []string, f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error)) (*ttnpb.ApplicationWebhook, error) {
 	appUID := unique.ID(ctx, ids.ApplicationIdentifiers)
 	ik := r.idKey(appUID, ids.WebhookID)
+	sk := r.secretsKey(appUID, ids.WebhookID)
 
 	var pb *ttnpb.ApplicationWebhook
 	err := r.Redis.Watch(func(tx *redis.Tx) error {
 		cmd := ttnredis.GetProto(tx, ik)
+		secrets, err := r.getSecrets(ctx, tx, sk)
+		if err != nil {
+			return err
+		}
+		scan := func(pb *ttnpb.ApplicationWebhook) error {
+			if err := cmd.ScanProto(pb); err != nil {
+				return err
+			}
+			if secrets == nil {
+				return nil
+			}
+			return pb.SetFields(secrets, secretWebhookPaths...)
+		}
 		stored := &ttnpb.ApplicationWebhook{}
-		if err := cmd.ScanProto(stored); errors.IsNotFound(err) {
+		if err := scan(stored); errors.IsNotFound(err) {
 			stored = nil
 		} else if err != nil {
 			return err
@@ -114,10 +222,9 @@ func (r WebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookId
 
 		gets = appendImplicitWebhookGetPaths(gets...)
 
-		var err error
 		if stored != nil {
 			pb = &ttnpb.ApplicationWebhook{}
-			if err := cmd.ScanProto(pb); err != nil {
+			if err := scan(pb); err != nil {
 				return err
 			}
 			pb, err = applyWebhookFieldMask(nil, pb, gets...)
@@ -142,7 +249,7 @@ func (r WebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookId
 		var pipelined func(redis.Pipeliner) error
 		if pb == nil && len(sets) == 0 {
 			pipelined = func(p redis.Pipeliner) error {
-				p.Del(ik)
+				p.Del(ik, sk)
 				p.SRem(r.appKey(appUID), stored.WebhookID)
 				return nil
 			}
@@ -182,7 +289,7 @@ func (r WebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookId
 				if ttnpb.HasAnyField(sets, "ids.webhook_id") && pb.WebhookID != stored.WebhookID {
 					return errReadOnlyField.WithAttributes("field", "ids.webhook_id")
 				}
-				if err := cmd.ScanProto(updated); err != nil {
+				if err := scan(updated); err != nil {
 					return err
 				}
 				updated, err = applyWebhookFieldMask(updated, pb, sets...)
@@ -194,10 +301,25 @@ func (r WebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookId
 				return err
 			}
 
+			withoutSecrets := *updated
+			withoutSecrets.SigningSecret = ""
+			withoutSecrets.TLSClientCert = nil
+			withoutSecrets.TLSClientKey = nil
+			var secretFields []interface{}
+			if hasSecrets(updated) {
+				if secretFields, err = r.wrapSecrets(ctx, updated); err != nil {
+					return err
+				}
+			}
+
 			pipelined = func(p redis.Pipeliner) error {
-				if _, err := ttnredis.SetProto(p, ik, updated, 0); err != nil {
+				if _, err := ttnredis.SetProto(p, ik, &withoutSecrets, 0); err != nil {
 					return err
 				}
+				p.Del(sk)
+				if len(secretFields) > 0 {
+					p.HSet(sk, secretFields...)
+				}
 				p.SAdd(r.appKey(appUID), updated.WebhookID)
 				return nil
 			}
@@ -212,7 +334,7 @@ func (r WebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookId
 			return err
 		}
 		return nil
-	}, ik)
+	}, ik, sk)
 	if err != nil {
 		return nil, ttnredis.ConvertError(err)
 	}
@@ -0,0 +1,213 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	signatureHeader          = "X-Webhook-Signature"
+	signatureTimestampHeader = "X-Webhook-Timestamp"
+
+	signaturePrefix = "sha256="
+)
+
+// computeSignature computes the HMAC-SHA256 of the timestamp, a dot and the body.
+func computeSignature(secret, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return ioutil.ReadAll(rc)
+	}
+	if req.Body == nil {
+		return nil, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// signRequest sets the signature headers of the request, using the secret and the time at which the request is sent.
+func signRequest(req *http.Request, secret string, at time.Time) error {
+	body, err := requestBody(req)
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	req.Header.Set(signatureTimestampHeader, timestamp)
+	req.Header.Set(signatureHeader, signaturePrefix+hex.EncodeToString(computeSignature(secret, timestamp, body)))
+	return nil
+}
+
+var (
+	errNoSignature      = errors.DefineUnauthenticated("no_signature", "no signature")
+	errInvalidSignature = errors.DefineUnauthenticated("invalid_signature", "invalid signature")
+	errSignatureExpired = errors.DefineUnauthenticated("signature_expired", "signature expired")
+)
+
+// VerifySignature verifies the signature headers of a webhook request with the given body.
+// The signature timestamp may differ at most maxAge from the current time. If maxAge is 0, the timestamp is not checked.
+// This can be used by Go receivers of webhooks with a signing secret.
+func VerifySignature(header http.Header, body []byte, secret string, maxAge time.Duration) error {
+	timestamp, signature := header.Get(signatureTimestampHeader), header.Get(signatureHeader)
+	if timestamp == "" || signature == "" {
+		return errNoSignature.New()
+	}
+	if len(signature) <= len(signaturePrefix) || signature[:len(signaturePrefix)] != signaturePrefix {
+		return errInvalidSignature.New()
+	}
+	mac, err := hex.DecodeString(signature[len(signaturePrefix):])
+	if err != nil {
+		return errInvalidSignature.WithCause(err)
+	}
+	if !hmac.Equal(mac, computeSignature(secret, timestamp, body)) {
+		return errInvalidSignature.New()
+	}
+	if maxAge > 0 {
+		sec, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return errInvalidSignature.WithCause(err)
+		}
+		if d := time.Since(time.Unix(sec, 0)); d > maxAge || d < -maxAge {
+			return errSignatureExpired.New()
+		}
+	}
+	return nil
+}
+
+type webhookSecrets struct {
+	signingSecret string
+	tlsClientCert []byte
+	tlsClientKey  []byte
+	expiresAt     time.Time
+}
+
+// webhookSecrets returns the signing secret and TLS client certificate of the webhook.
+// Secrets are cached by uid for SecretsTTL.
+func (s *HTTPClientSink) webhookSecrets(ctx context.Context, uid string, ids ttnpb.ApplicationWebhookIdentifiers) (*webhookSecrets, error) {
+	now := time.Now()
+	s.secretsMu.Lock()
+	secrets, ok := s.secrets[uid]
+	s.secretsMu.Unlock()
+	if ok && now.Before(secrets.expiresAt) {
+		return secrets, nil
+	}
+
+	hook, err := s.Registry.Get(ctx, ids, []string{
+		"signing_secret",
+		"tls_client_cert",
+		"tls_client_key",
+	})
+	if err != nil {
+		return nil, err
+	}
+	secrets = &webhookSecrets{
+		signingSecret: hook.SigningSecret,
+		tlsClientCert: hook.TLSClientCert,
+		tlsClientKey:  hook.TLSClientKey,
+		expiresAt:     now.Add(s.SecretsTTL),
+	}
+	if s.SecretsTTL > 0 {
+		s.secretsMu.Lock()
+		if s.secrets == nil {
+			s.secrets = make(map[string]*webhookSecrets)
+		}
+		s.secrets[uid] = secrets
+		s.secretsMu.Unlock()
+	}
+	return secrets, nil
+}
+
+var (
+	errTLSClientCertificate = errors.DefineInvalidArgument("tls_client_certificate", "invalid TLS client certificate")
+	errTLSTransport         = errors.DefineFailedPrecondition("tls_transport", "TLS client certificates are not supported by the HTTP transport")
+)
+
+type tlsClient struct {
+	fingerprint [sha256.Size]byte
+	client      *http.Client
+}
+
+// tlsClient returns an HTTP client that uses the given TLS client certificate and key.
+// Clients are cached by uid, and recreated when the certificate or key changes.
+func (s *HTTPClientSink) tlsClient(uid string, cert, key []byte) (*http.Client, error) {
+	h := sha256.New()
+	h.Write(cert)
+	h.Write(key)
+	var fingerprint [sha256.Size]byte
+	copy(fingerprint[:], h.Sum(nil))
+
+	s.tlsClientsMu.Lock()
+	defer s.tlsClientsMu.Unlock()
+	if c, ok := s.tlsClients[uid]; ok && c.fingerprint == fingerprint {
+		return c.client, nil
+	}
+
+	certificate, err := tls.X509KeyPair(cert, key)
+	if err != nil {
+		return nil, errTLSClientCertificate.WithCause(err)
+	}
+	var transport *http.Transport
+	switch t := s.Client.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return nil, errTLSTransport.New()
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.Certificates = []tls.Certificate{certificate}
+	client := *s.Client
+	client.Transport = transport
+
+	if s.tlsClients == nil {
+		s.tlsClients = make(map[string]*tlsClient)
+	}
+	s.tlsClients[uid] = &tlsClient{
+		fingerprint: fingerprint,
+		client:      &client,
+	}
+	return &client, nil
+}
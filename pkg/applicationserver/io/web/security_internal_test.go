@@ -0,0 +1,205 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type staticWebhookRegistry struct {
+	WebhookRegistry
+	hook *ttnpb.ApplicationWebhook
+	gets int
+}
+
+func (r *staticWebhookRegistry) Get(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string) (*ttnpb.ApplicationWebhook, error) {
+	r.gets++
+	return r.hook, nil
+}
+
+func TestSignature(t *testing.T) {
+	a := assertions.New(t)
+
+	body := []byte(`{"foo":"bar"}`)
+	req, err := http.NewRequest(http.MethodPost, "https://example.com", bytes.NewReader(body))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	at := time.Unix(1600000000, 0)
+	if !a.So(signRequest(req, "secret", at), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(req.Header.Get(signatureTimestampHeader), should.Equal, "1600000000")
+	a.So(req.Header.Get(signatureHeader), should.Equal, "sha256=bba79144eabf81f0cdac6e6018f5291d522305677a5fd7027cc9a34205b1213e")
+
+	// The body can still be read after signing.
+	b, err := ioutil.ReadAll(req.Body)
+	a.So(err, should.BeNil)
+	a.So(b, should.Resemble, body)
+
+	a.So(VerifySignature(req.Header, body, "secret", 0), should.BeNil)
+	a.So(errors.IsUnauthenticated(VerifySignature(req.Header, body, "other", 0)), should.BeTrue)
+	a.So(errors.IsUnauthenticated(VerifySignature(req.Header, []byte(`{"foo":"baz"}`), "secret", 0)), should.BeTrue)
+	a.So(errors.IsUnauthenticated(VerifySignature(req.Header, body, "secret", time.Minute)), should.BeTrue)
+	a.So(errors.IsUnauthenticated(VerifySignature(http.Header{}, body, "secret", 0)), should.BeTrue)
+
+	if !a.So(signRequest(req, "secret", time.Now()), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(VerifySignature(req.Header, body, "secret", time.Minute), should.BeNil)
+}
+
+func TestHTTPClientSinkSecurity(t *testing.T) {
+	a := assertions.New(t)
+
+	readFile := func(name string) []byte {
+		b, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Fatalf("Failed to read %q: %s", name, err)
+		}
+		return b
+	}
+	ca := readFile("testdata/rootCA.pem")
+	clientCert := readFile("testdata/clientcert.pem")
+	clientKey := readFile("testdata/clientkey.pem")
+	serverCert, err := tls.X509KeyPair(readFile("testdata/servercert.pem"), readFile("testdata/serverkey.pem"))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	pool := x509.NewCertPool()
+	if !a.So(pool.AppendCertsFromPEM(ca), should.BeTrue) {
+		t.FailNow()
+	}
+
+	reqCh := make(chan *http.Request, 1)
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		reqCh <- r
+		w.WriteHeader(http.StatusOK)
+	}))
+	srv.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+	}
+	srv.StartTLS()
+	defer srv.Close()
+	url := strings.Replace(srv.URL, "127.0.0.1", "localhost", 1)
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		WebhookID:              "foo-hook",
+	}
+	registry := &staticWebhookRegistry{
+		hook: &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: ids,
+		},
+	}
+	sink := &HTTPClientSink{
+		Client: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					RootCAs: pool,
+				},
+			},
+			Timeout: 10 * test.Delay,
+		},
+		Registry: registry,
+	}
+	newRequest := func() *http.Request {
+		req, err := http.NewRequestWithContext(withWebhookID(test.Context(), ids), http.MethodPost, url, bytes.NewReader([]byte("test")))
+		if err != nil {
+			t.Fatalf("Failed to create request: %s", err)
+		}
+		return req
+	}
+
+	// Without a client certificate, the server rejects the connection.
+	a.So(sink.Process(newRequest()), should.NotBeNil)
+
+	registry.hook.TLSClientCert = clientCert
+	registry.hook.TLSClientKey = clientKey
+	registry.hook.SigningSecret = "secret"
+	if !a.So(sink.Process(newRequest()), should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case req := <-reqCh:
+		a.So(req.TLS.PeerCertificates, should.HaveLength, 1)
+		body, _ := ioutil.ReadAll(req.Body)
+		a.So(VerifySignature(req.Header, body, "secret", time.Minute), should.BeNil)
+	case <-time.After(10 * test.Delay):
+		t.Fatal("Timed out waiting for request")
+	}
+
+	registry.hook.TLSClientKey = []byte("invalid")
+	a.So(errors.IsInvalidArgument(sink.Process(newRequest())), should.BeTrue)
+}
+
+func TestWebhookSecretsCache(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		WebhookID:              "foo-hook",
+	}
+	registry := &staticWebhookRegistry{
+		hook: &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: ids,
+			SigningSecret:                 "secret",
+		},
+	}
+	sink := &HTTPClientSink{
+		Client:     http.DefaultClient,
+		Registry:   registry,
+		SecretsTTL: 4 * test.Delay,
+	}
+
+	secrets, err := sink.webhookSecrets(ctx, "foo-app.foo-hook", ids)
+	a.So(err, should.BeNil)
+	a.So(secrets.signingSecret, should.Equal, "secret")
+	a.So(registry.gets, should.Equal, 1)
+
+	// The secrets are cached.
+	registry.hook.SigningSecret = "other-secret"
+	secrets, err = sink.webhookSecrets(ctx, "foo-app.foo-hook", ids)
+	a.So(err, should.BeNil)
+	a.So(secrets.signingSecret, should.Equal, "secret")
+	a.So(registry.gets, should.Equal, 1)
+
+	// The secrets are retrieved again when the cache expires.
+	time.Sleep(8 * test.Delay)
+	secrets, err = sink.webhookSecrets(ctx, "foo-app.foo-hook", ids)
+	a.So(err, should.BeNil)
+	a.So(secrets.signingSecret, should.Equal, "other-secret")
+	a.So(registry.gets, should.Equal, 2)
+}
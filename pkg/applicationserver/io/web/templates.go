@@ -248,6 +248,8 @@ type webhookTemplate struct {
 	Fields               []webhookTemplateField `yaml:"fields,omitempty"`
 	CreateDownlinkAPIKey bool                   `yaml:"create-downlink-api-key"`
 	Paths                webhookTemplatePaths   `yaml:"paths,omitempty"`
+	SigningSecret        string                 `yaml:"signing-secret,omitempty"`
+	TLSClientCertificate bool                   `yaml:"tls-client-certificate"`
 }
 
 func (webhookTemplate) pathToMessage(s *string) *ttnpb.ApplicationWebhookTemplate_Message {
@@ -292,5 +294,7 @@ func (t webhookTemplate) toPB() *ttnpb.ApplicationWebhookTemplate {
 		DownlinkQueueInvalidated: t.pathToMessage(t.Paths.DownlinkQueueInvalidated),
 		LocationSolved:           t.pathToMessage(t.Paths.LocationSolved),
 		ServiceData:              t.pathToMessage(t.Paths.ServiceData),
+		SigningSecret:            t.SigningSecret,
+		TLSClientCertificate:     t.TLSClientCertificate,
 	}
 }
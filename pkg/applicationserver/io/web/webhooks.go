@@ -23,6 +23,7 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	stdio "io"
 
@@ -31,6 +32,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"go.thethings.network/lorawan-stack/v3/pkg/version"
 	ttnweb "go.thethings.network/lorawan-stack/v3/pkg/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
@@ -52,15 +54,44 @@ type ControllableSink interface {
 }
 
 // HTTPClientSink contains an HTTP client to make outgoing requests.
+// If Registry is set, requests of webhooks are signed and use the TLS client certificate of the webhook, if configured.
+// The secrets of a webhook are cached for SecretsTTL. If SecretsTTL is zero, the secrets are retrieved for every request.
 type HTTPClientSink struct {
 	*http.Client
+	Registry   WebhookRegistry
+	SecretsTTL time.Duration
+
+	secretsMu sync.Mutex
+	secrets   map[string]*webhookSecrets
+
+	tlsClientsMu sync.Mutex
+	tlsClients   map[string]*tlsClient
 }
 
 var errRequest = errors.DefineUnavailable("request", "request failed with status `{code}`")
 
 // Process uses the HTTP client to perform the request.
 func (s *HTTPClientSink) Process(req *http.Request) error {
-	res, err := s.Do(req)
+	client := s.Client
+	if ids, ok := req.Context().Value(webhookIDKey).(ttnpb.ApplicationWebhookIdentifiers); ok && s.Registry != nil {
+		ctx := req.Context()
+		uid := unique.ID(ctx, ids)
+		secrets, err := s.webhookSecrets(ctx, uid, ids)
+		if err != nil {
+			return err
+		}
+		if len(secrets.tlsClientCert) > 0 {
+			if client, err = s.tlsClient(uid, secrets.tlsClientCert, secrets.tlsClientKey); err != nil {
+				return err
+			}
+		}
+		if secrets.signingSecret != "" {
+			if err := signRequest(req, secrets.signingSecret, time.Now()); err != nil {
+				return err
+			}
+		}
+	}
+	res, err := client.Do(req)
 	if err != nil {
 		return err
 	}
@@ -137,6 +168,9 @@ func (s *QueuedSink) Process(req *http.Request) error {
 type Webhooks interface {
 	ttnweb.Registerer
 	Registry() WebhookRegistry
+	// DeadLetters returns the dead letters of the webhooks.
+	// If the target does not keep dead letters, this method returns nil.
+	DeadLetters() DeadLetterSink
 	// NewSubscription returns a new webhooks integration subscription.
 	NewSubscription() *io.Subscription
 }
@@ -146,6 +180,7 @@ type webhooks struct {
 	server    io.Server
 	registry  WebhookRegistry
 	target    Sink
+	batcher   *batcher
 	downlinks DownlinksConfig
 }
 
@@ -157,12 +192,20 @@ func NewWebhooks(ctx context.Context, server io.Server, registry WebhookRegistry
 		server:    server,
 		registry:  registry,
 		target:    target,
+		batcher:   newBatcher(ctx, target),
 		downlinks: downlinks,
 	}
 }
 
 func (w *webhooks) Registry() WebhookRegistry { return w.registry }
 
+func (w *webhooks) DeadLetters() DeadLetterSink {
+	if sink, ok := w.target.(DeadLetterSink); ok {
+		return sink
+	}
+	return nil
+}
+
 // RegisterRoutes registers the webhooks to the web server to handle downlink requests.
 func (w *webhooks) RegisterRoutes(server *ttnweb.Server) {
 	router := server.Prefix(ttnpb.HTTPAPIPrefix + "/as/applications/{application_id}/webhooks/{webhook_id}/devices/{device_id}/down").Subrouter()
@@ -221,6 +264,7 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 	hooks, err := w.registry.List(ctx, msg.ApplicationIdentifiers,
 		[]string{
 			"base_url",
+			"batching",
 			"downlink_ack",
 			"downlink_api_key",
 			"downlink_failed",
@@ -230,6 +274,7 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 			"downlink_sent",
 			"format",
 			"headers",
+			"health",
 			"join_accept",
 			"location_solved",
 			"service_data",
@@ -243,10 +288,20 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 	for i := range hooks {
 		hook := hooks[i]
 		logger := log.FromContext(ctx).WithField("hook", hook.WebhookID)
+		if hook.Health.GetSuspended() {
+			logger.Debug("Skip suspended webhook")
+			continue
+		}
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			req, err := w.newRequest(ctx, msg, hook)
+			if hook.Batching.GetMaxMessages() > 1 {
+				if err := w.batcher.Add(ctx, hook, msg); err != nil {
+					logger.WithError(err).Warn("Failed to add message to batch")
+				}
+				return
+			}
+			req, err := w.newRequest(withWebhookID(ctx, hook.ApplicationWebhookIdentifiers), msg, hook)
 			if err != nil {
 				logger.WithError(err).Warn("Failed to create request")
 				return
@@ -264,43 +319,52 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 	return nil
 }
 
-func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook) (*http.Request, error) {
-	var cfg *ttnpb.ApplicationWebhook_Message
+func messageConfig(hook *ttnpb.ApplicationWebhook, msg *ttnpb.ApplicationUp) *ttnpb.ApplicationWebhook_Message {
 	switch msg.Up.(type) {
 	case *ttnpb.ApplicationUp_UplinkMessage:
-		cfg = hook.UplinkMessage
+		return hook.UplinkMessage
 	case *ttnpb.ApplicationUp_JoinAccept:
-		cfg = hook.JoinAccept
+		return hook.JoinAccept
 	case *ttnpb.ApplicationUp_DownlinkAck:
-		cfg = hook.DownlinkAck
+		return hook.DownlinkAck
 	case *ttnpb.ApplicationUp_DownlinkNack:
-		cfg = hook.DownlinkNack
+		return hook.DownlinkNack
 	case *ttnpb.ApplicationUp_DownlinkSent:
-		cfg = hook.DownlinkSent
+		return hook.DownlinkSent
 	case *ttnpb.ApplicationUp_DownlinkFailed:
-		cfg = hook.DownlinkFailed
+		return hook.DownlinkFailed
 	case *ttnpb.ApplicationUp_DownlinkQueued:
-		cfg = hook.DownlinkQueued
+		return hook.DownlinkQueued
 	case *ttnpb.ApplicationUp_DownlinkQueueInvalidated:
-		cfg = hook.DownlinkQueueInvalidated
+		return hook.DownlinkQueueInvalidated
 	case *ttnpb.ApplicationUp_LocationSolved:
-		cfg = hook.LocationSolved
+		return hook.LocationSolved
 	case *ttnpb.ApplicationUp_ServiceData:
-		cfg = hook.ServiceData
-	}
-	if cfg == nil {
-		return nil, nil
+		return hook.ServiceData
 	}
+	return nil
+}
+
+// messageURL returns the URL of the message.
+// If the base URL of the webhook does not contain variables, the reference of the URL relative to the base URL is
+// returned as well, so that the URL can be resolved again when the base URL of the webhook changes.
+func messageURL(hook *ttnpb.ApplicationWebhook, cfg *ttnpb.ApplicationWebhook_Message, msg *ttnpb.ApplicationUp) (*url.URL, string, bool, error) {
 	baseURL, err := url.Parse(hook.BaseURL)
 	if err != nil {
-		return nil, err
+		return nil, "", false, err
 	}
 	expandVariables(baseURL, msg)
 	pathURL, err := url.Parse(cfg.Path)
 	if err != nil {
-		return nil, err
+		return nil, "", false, err
 	}
 	expandVariables(pathURL, msg)
+	ref, hasRef := pathURL.String(), !strings.Contains(hook.BaseURL, "{")
+	return resolveMessageURL(baseURL, pathURL), ref, hasRef, nil
+}
+
+// resolveMessageURL resolves the message path URL relative to the base URL.
+func resolveMessageURL(baseURL, pathURL *url.URL) *url.URL {
 	if strings.HasPrefix(pathURL.Path, "/") {
 		// Trim the leading slash, in order to ensure that the path is not
 		// interpreted as relative to the root of the URL.
@@ -316,7 +380,21 @@ func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hoo
 	if pathURL.Path != "" && !strings.HasSuffix(baseURL.Path, "/") {
 		baseURL.Path += "/"
 	}
-	finalURL := baseURL.ResolveReference(pathURL)
+	return baseURL.ResolveReference(pathURL)
+}
+
+func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook) (*http.Request, error) {
+	cfg := messageConfig(hook, msg)
+	if cfg == nil {
+		return nil, nil
+	}
+	finalURL, ref, hasRef, err := messageURL(hook, cfg, msg)
+	if err != nil {
+		return nil, err
+	}
+	if hasRef {
+		ctx = withWebhookURLReference(ctx, ref)
+	}
 	format, ok := formats[hook.Format]
 	if !ok {
 		return nil, errFormatNotFound.WithAttributes("format", hook.Format)
@@ -144,6 +144,13 @@ func TestWebhooks(t *testing.T) {
 				testSink := &mockSink{
 					ch: make(chan *http.Request, 1),
 				}
+				requestQueue := redis.NewRequestQueue(redisClient, 1000, "web_test", "test")
+				if err := requestQueue.Init(); err != nil {
+					t.Fatalf("Failed to initialize request queue: %s", err)
+				}
+				queueCtx, cancelQueue := context.WithCancel(ctx)
+				defer cancelQueue()
+				go requestQueue.Run(queueCtx)
 				for _, sink := range []web.Sink{
 					testSink,
 					&web.QueuedSink{
@@ -160,6 +167,15 @@ func TestWebhooks(t *testing.T) {
 						Queue:   make(chan *http.Request, 4),
 						Workers: 1,
 					},
+					&web.PersistentSink{
+						Target:   testSink,
+						Registry: registry,
+						Queue:    requestQueue,
+						DeadLetters: &redis.DeadLetterRegistry{
+							Redis: redisClient,
+						},
+						Workers: 1,
+					},
 				} {
 					t.Run(fmt.Sprintf("%T", sink), func(t *testing.T) {
 						ctx, cancel := context.WithCancel(ctx)
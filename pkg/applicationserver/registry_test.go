@@ -17,13 +17,17 @@ package applicationserver
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/sql"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
@@ -167,6 +171,38 @@ func handleDeviceRegistryTest(t *testing.T, reg DeviceRegistry) {
 	a.So(ret, should.BeNil)
 }
 
+func newSQLTestDB(t testing.TB) *gorm.DB {
+	dbAddress := os.Getenv("SQL_DB_ADDRESS")
+	if dbAddress == "" {
+		dbAddress = "localhost:26257"
+	}
+	dbName := os.Getenv("TEST_DATABASE_NAME")
+	if dbName == "" {
+		dbName = "ttn_lorawan_as_registry_test"
+	}
+	dbAuth := os.Getenv("SQL_DB_AUTH")
+	if dbAuth == "" {
+		dbAuth = "root"
+	}
+	db, err := store.Open(test.Context(), fmt.Sprintf("postgresql://%s@%s/%s?sslmode=disable", dbAuth, dbAddress, dbName))
+	if err != nil {
+		t.Fatalf("Failed to open database: %s", err)
+	}
+	if err := store.Initialize(db); err != nil {
+		db.Close()
+		t.Fatalf("Failed to initialize database: %s", err)
+	}
+	if err := db.DropTableIfExists("as_end_devices").Error; err != nil {
+		db.Close()
+		t.Fatalf("Failed to drop tables: %s", err)
+	}
+	if err := sql.Migrate(db); err != nil {
+		db.Close()
+		t.Fatalf("Failed to migrate database: %s", err)
+	}
+	return db
+}
+
 func TestDeviceRegistry(t *testing.T) {
 	t.Parallel()
 
@@ -191,6 +227,15 @@ func TestDeviceRegistry(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "SQL",
+			New: func(t testing.TB) (DeviceRegistry, func() error) {
+				db := newSQLTestDB(t)
+				return &sql.DeviceRegistry{DB: db}, db.Close
+			},
+			// The SQL registry tests share the database tables, so they can not run in parallel.
+			N: 1,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
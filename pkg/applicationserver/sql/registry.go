@@ -0,0 +1,287 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sql implements a SQL device registry of the Application Server.
+package sql
+
+import (
+	"context"
+	"regexp"
+	"runtime/trace"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errDatabase               = errors.DefineInternal("database", "database error")
+	errDecode                 = errors.DefineCorruption("decode", "decode stored end device")
+	errConcurrentModification = errors.DefineAborted("concurrent_modification", "end device modified concurrently")
+	errDeviceNotFound         = errors.DefineNotFound("device_not_found", "end device not found")
+	errInvalidFieldmask       = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers     = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errDuplicateIdentifiers   = errors.DefineAlreadyExists("duplicate_identifiers", "duplicate identifiers")
+	errReadOnlyField          = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+)
+
+// endDevice is the database model of a stored end device.
+type endDevice struct {
+	ID            uint64    `gorm:"primary_key;auto_increment"`
+	ApplicationID string    `gorm:"type:VARCHAR(36);not null;unique_index:as_end_device_id_index"`
+	DeviceID      string    `gorm:"type:VARCHAR(36);not null;unique_index:as_end_device_id_index"`
+	JoinEUI       *string   `gorm:"type:VARCHAR(16);unique_index:as_end_device_eui_index"`
+	DevEUI        *string   `gorm:"type:VARCHAR(16);unique_index:as_end_device_eui_index"`
+	Version       uint64    `gorm:"not null;default:0"`
+	Payload       []byte    `gorm:"type:BYTEA;not null"`
+	CreatedAt     time.Time `gorm:"not null"`
+	UpdatedAt     time.Time `gorm:"not null"`
+}
+
+// TableName implements the gorm tabler interface.
+func (endDevice) TableName() string { return "as_end_devices" }
+
+// Migrate migrates the database schema of the device registry.
+func Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(&endDevice{}).Error; err != nil {
+		return errDatabase.WithCause(err)
+	}
+	return nil
+}
+
+var uniqueViolationRegex = regexp.MustCompile(`duplicate key value( .+)? violates unique constraint "([a-z_]+)"`)
+
+func convertError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+		if match := uniqueViolationRegex.FindStringSubmatch(pqErr.Message); match != nil {
+			switch match[2] {
+			case "as_end_device_id_index":
+				return errConcurrentModification.WithCause(err)
+			case "as_end_device_eui_index":
+				return errDuplicateIdentifiers.WithCause(err)
+			}
+		}
+	}
+	return errDatabase.WithCause(err)
+}
+
+func decodeEndDevice(b []byte) (*ttnpb.EndDevice, error) {
+	pb := &ttnpb.EndDevice{}
+	if err := proto.Unmarshal(b, pb); err != nil {
+		return nil, errDecode.WithCause(err)
+	}
+	return pb, nil
+}
+
+func equalEUI64(x, y *types.EUI64) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Equal(*y)
+}
+
+// DeviceRegistry is a SQL device registry.
+type DeviceRegistry struct {
+	DB *gorm.DB
+}
+
+func query(db *gorm.DB, ids ttnpb.EndDeviceIdentifiers) *gorm.DB {
+	return db.Model(&endDevice{}).Where("application_id = ? AND device_id = ?", ids.ApplicationID, ids.DeviceID)
+}
+
+// Get returns the end device by its identifiers.
+func (r *DeviceRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.EndDevice, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get end device").End()
+
+	model := &endDevice{}
+	if err := query(r.DB, ids).Select("payload").First(model).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errDeviceNotFound.New()
+		}
+		return nil, errDatabase.WithCause(err)
+	}
+	pb, err := decodeEndDevice(model.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.FilterGetEndDevice(pb, paths...)
+}
+
+// Set creates, updates or deletes the end device by its identifiers.
+// The stored device is locked until the update is committed, so that concurrent updates of the device are serialized.
+func (r *DeviceRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "set end device").End()
+
+	var (
+		pb     *ttnpb.EndDevice
+		setErr error
+	)
+	if err := r.DB.Transaction(func(tx *gorm.DB) error {
+		pb, setErr = r.set(ctx, tx, ids, gets, f)
+		return setErr
+	}); err != nil {
+		if setErr != nil {
+			return nil, setErr
+		}
+		return nil, convertError(err)
+	}
+	return pb, nil
+}
+
+func (r *DeviceRegistry) set(ctx context.Context, tx *gorm.DB, ids ttnpb.EndDeviceIdentifiers, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+	model := &endDevice{}
+	var stored, pb *ttnpb.EndDevice
+	if err := query(tx, ids).Set("gorm:query_option", "FOR UPDATE").Select("version, payload").First(model).Error; err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			return nil, errDatabase.WithCause(err)
+		}
+	} else {
+		if stored, err = decodeEndDevice(model.Payload); err != nil {
+			return nil, err
+		}
+		if pb, err = decodeEndDevice(model.Payload); err != nil {
+			return nil, err
+		}
+		if pb, err = ttnpb.FilterGetEndDevice(pb, gets...); err != nil {
+			return nil, err
+		}
+	}
+
+	pb, sets, err := f(pb)
+	if err != nil {
+		return nil, err
+	}
+	if err := ttnpb.ProhibitFields(sets,
+		"created_at",
+		"updated_at",
+	); err != nil {
+		return nil, errInvalidFieldmask.WithCause(err)
+	}
+
+	if stored == nil && pb == nil {
+		return nil, nil
+	}
+	if pb != nil && len(sets) == 0 {
+		return ttnpb.FilterGetEndDevice(stored, gets...)
+	}
+	if pb == nil && len(sets) == 0 {
+		res := query(tx, ids).Where("version = ?", model.Version).Delete(&endDevice{})
+		if res.Error != nil {
+			return nil, errDatabase.WithCause(res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return nil, errConcurrentModification.New()
+		}
+		return nil, nil
+	}
+
+	if pb == nil {
+		pb = &ttnpb.EndDevice{}
+	}
+	if pb.ApplicationIdentifiers != ids.ApplicationIdentifiers || pb.DeviceID != ids.DeviceID {
+		return nil, errInvalidIdentifiers.New()
+	}
+
+	pb.UpdatedAt = time.Now().UTC()
+	sets = append(append(sets[:0:0], sets...),
+		"updated_at",
+	)
+
+	var updated *ttnpb.EndDevice
+	if stored == nil {
+		if err := ttnpb.RequireFields(sets,
+			"ids.application_ids",
+			"ids.device_id",
+		); err != nil {
+			return nil, errInvalidFieldmask.WithCause(err)
+		}
+
+		pb.CreatedAt = pb.UpdatedAt
+		sets = append(sets, "created_at")
+
+		updated, err = ttnpb.ApplyEndDeviceFieldMask(&ttnpb.EndDevice{}, pb, sets...)
+		if err != nil {
+			return nil, err
+		}
+		if updated.ApplicationIdentifiers != ids.ApplicationIdentifiers || updated.DeviceID != ids.DeviceID {
+			return nil, errInvalidIdentifiers.New()
+		}
+	} else {
+		if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") && pb.ApplicationID != stored.ApplicationID {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+		}
+		if ttnpb.HasAnyField(sets, "ids.device_id") && pb.DeviceID != stored.DeviceID {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.device_id")
+		}
+		if ttnpb.HasAnyField(sets, "ids.join_eui") && !equalEUI64(pb.JoinEUI, stored.JoinEUI) {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.join_eui")
+		}
+		if ttnpb.HasAnyField(sets, "ids.dev_eui") && !equalEUI64(pb.DevEUI, stored.DevEUI) {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.dev_eui")
+		}
+		updated, err = ttnpb.ApplyEndDeviceFieldMask(stored, pb, sets...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := updated.ValidateFields(sets...); err != nil {
+		return nil, err
+	}
+	payload, err := proto.Marshal(updated)
+	if err != nil {
+		return nil, err
+	}
+
+	if stored == nil {
+		created := &endDevice{
+			ApplicationID: ids.ApplicationID,
+			DeviceID:      ids.DeviceID,
+			Payload:       payload,
+			CreatedAt:     updated.CreatedAt,
+			UpdatedAt:     updated.UpdatedAt,
+		}
+		if updated.JoinEUI != nil && updated.DevEUI != nil {
+			joinEUI, devEUI := updated.JoinEUI.String(), updated.DevEUI.String()
+			created.JoinEUI, created.DevEUI = &joinEUI, &devEUI
+		}
+		if err := tx.Create(created).Error; err != nil {
+			return nil, convertError(err)
+		}
+	} else {
+		res := query(tx, ids).Where("version = ?", model.Version).Updates(map[string]interface{}{
+			"version":    gorm.Expr("version + 1"),
+			"payload":    payload,
+			"updated_at": updated.UpdatedAt,
+		})
+		if res.Error != nil {
+			return nil, convertError(res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return nil, errConcurrentModification.New()
+		}
+	}
+	return ttnpb.FilterGetEndDevice(updated, gets...)
+}
@@ -0,0 +1,78 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rights
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// AuthInfoFetcher is implemented by Fetchers that can fetch the authentication info of the caller.
+type AuthInfoFetcher interface {
+	AuthInfo(context.Context) (*ttnpb.AuthInfoResponse, error)
+}
+
+var (
+	// ErrNotAdmin is returned when the caller is not an admin.
+	ErrNotAdmin = errors.DefinePermissionDenied("not_admin", "caller is not an admin")
+
+	errNoAuthInfoFetcher = errors.DefineUnimplemented("no_auth_info_fetcher", "fetcher does not support fetching auth info")
+)
+
+// RequireIsAdmin checks that the caller in the context is an admin.
+func RequireIsAdmin(ctx context.Context) error {
+	fetcher, ok := fetcherFromContext(ctx)
+	if !ok {
+		panic(errNoFetcher)
+	}
+	authInfoFetcher, ok := fetcher.(AuthInfoFetcher)
+	if !ok {
+		return errNoAuthInfoFetcher.New()
+	}
+	info, err := authInfoFetcher.AuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+	if !info.GetIsAdmin() {
+		return ErrNotAdmin.New()
+	}
+	return nil
+}
+
+// AuthInfo implements AuthInfoFetcher.
+func (f accessFetcher) AuthInfo(ctx context.Context) (*ttnpb.AuthInfoResponse, error) {
+	cc := f.getConn(ctx)
+	if cc == nil {
+		return nil, errNoISConn.New()
+	}
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, f.allowInsecure)
+	if err != nil {
+		return nil, err
+	}
+	ctx = rpcmetadata.WithForwardedRequestID(ctx)
+	return ttnpb.NewEntityAccessClient(cc).AuthInfo(ctx, ttnpb.Empty, callOpt)
+}
+
+// AuthInfo implements AuthInfoFetcher. The auth info is not cached.
+func (f *inMemoryCache) AuthInfo(ctx context.Context) (*ttnpb.AuthInfoResponse, error) {
+	authInfoFetcher, ok := f.Fetcher.(AuthInfoFetcher)
+	if !ok {
+		return nil, errNoAuthInfoFetcher.New()
+	}
+	return authInfoFetcher.AuthInfo(ctx)
+}
@@ -69,6 +69,16 @@ func makeFSKDataRate(bitRate uint32, maximumMACPayloadSize MaxMACPayloadSizeFunc
 	}
 }
 
+func makeLRFHSSDataRate(operatingChannelWidth uint32, codingRate string, maximumMACPayloadSize MaxMACPayloadSizeFunc) DataRate {
+	return DataRate{
+		Rate: (&ttnpb.LRFHSSDataRate{
+			OperatingChannelWidth: operatingChannelWidth,
+			CodingRate:            codingRate,
+		}).DataRate(),
+		MaxMACPayloadSize: maximumMACPayloadSize,
+	}
+}
+
 // Channel abstracts a band's channel properties.
 type Channel struct {
 	// Frequency indicates the frequency of the channel.
@@ -324,6 +334,16 @@ func (b Band) FindUplinkDataRate(dr ttnpb.DataRate) (ttnpb.DataRateIndex, DataRa
 	return 0, DataRate{}, false
 }
 
+// FindDownlinkDataRate returns the data rate with index idx, if it may be used for downlinks.
+// LR-FHSS data rates are uplink-only and are never returned.
+func (b Band) FindDownlinkDataRate(idx ttnpb.DataRateIndex) (DataRate, bool) {
+	dr, ok := b.DataRates[idx]
+	if !ok || dr.Rate.GetLRFHSS() != nil {
+		return DataRate{}, false
+	}
+	return dr, true
+}
+
 func makeBeaconFrequencyFunc(frequencies [8]uint64) func(float64) uint64 {
 	return func(beaconTime float64) uint64 {
 		floor := math.Floor(beaconTime / float64(128))
@@ -57,3 +57,17 @@ func makeAddTxPowerFunc(offset float32) func(Band) Band {
 		return b
 	}
 }
+
+func makeRemoveDataRatesFunc(idxs ...ttnpb.DataRateIndex) func(Band) Band {
+	return func(b Band) Band {
+		drs := make(map[ttnpb.DataRateIndex]DataRate, len(b.DataRates))
+		for idx, dr := range b.DataRates {
+			drs[idx] = dr
+		}
+		for _, idx := range idxs {
+			delete(drs, idx)
+		}
+		b.DataRates = drs
+		return b
+	}
+}
@@ -15,6 +15,7 @@
 package band_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/smartystreets/assertions"
@@ -73,3 +74,38 @@ func TestUnsupportedBand(t *testing.T) {
 		t.Log("LoRaWAN Regional Parameters 1.0 is not supported for the Indian band")
 	}
 }
+
+func TestLRFHSSDataRates(t *testing.T) {
+	for bandID, idxs := range map[string][]ttnpb.DataRateIndex{
+		band.EU_863_870: {ttnpb.DATA_RATE_8, ttnpb.DATA_RATE_9, ttnpb.DATA_RATE_10, ttnpb.DATA_RATE_11},
+		band.US_902_928: {ttnpb.DATA_RATE_5, ttnpb.DATA_RATE_6},
+	} {
+		b, err := band.GetByID(bandID)
+		if err != nil {
+			t.Fatalf("Could not retrieve band %s: %s", bandID, err)
+		}
+		for _, version := range b.Versions() {
+			t.Run(fmt.Sprintf("%s/%s", bandID, version), func(t *testing.T) {
+				a := assertions.New(t)
+
+				vb, err := b.Version(version)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				for _, idx := range idxs {
+					dr, ok := vb.DataRates[idx]
+					if version == ttnpb.PHY_V1_1_REV_B {
+						a.So(ok, should.BeTrue)
+						a.So(dr.Rate.GetLRFHSS(), should.NotBeNil)
+					} else {
+						a.So(ok, should.BeFalse)
+					}
+					_, ok = vb.FindDownlinkDataRate(idx)
+					a.So(ok, should.BeFalse)
+				}
+				_, ok := vb.FindDownlinkDataRate(ttnpb.DATA_RATE_0)
+				a.So(ok, should.BeTrue)
+			})
+		}
+	}
+}
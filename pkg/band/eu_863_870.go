@@ -28,6 +28,9 @@ const EU_863_870 = "EU_863_870"
 //revive:enable:var-naming
 
 func init() {
+	// LR-FHSS data rates were introduced in RP002-1.0.2.
+	removeLRFHSSDataRates := makeRemoveDataRatesFunc(ttnpb.DATA_RATE_8, ttnpb.DATA_RATE_9, ttnpb.DATA_RATE_10, ttnpb.DATA_RATE_11)
+
 	defaultChannels := []Channel{
 		{
 			Frequency:   868100000,
@@ -44,7 +47,7 @@ func init() {
 	}
 	const beaconFrequency = 869525000
 
-	downlinkDRTable := [8][6]ttnpb.DataRateIndex{
+	downlinkDRTable := [12][6]ttnpb.DataRateIndex{
 		{0, 0, 0, 0, 0, 0},
 		{1, 0, 0, 0, 0, 0},
 		{2, 1, 0, 0, 0, 0},
@@ -53,6 +56,10 @@ func init() {
 		{5, 4, 3, 2, 1, 0},
 		{6, 5, 4, 3, 2, 1},
 		{7, 6, 5, 4, 3, 2},
+		{1, 0, 0, 0, 0, 0},
+		{2, 1, 0, 0, 0, 0},
+		{1, 0, 0, 0, 0, 0},
+		{2, 1, 0, 0, 0, 0},
 	}
 
 	eu_863_870 = Band{
@@ -122,6 +129,12 @@ func init() {
 			ttnpb.DATA_RATE_5: makeLoRaDataRate(7, 125000, makeConstMaxMACPayloadSizeFunc(230)),
 			ttnpb.DATA_RATE_6: makeLoRaDataRate(7, 250000, makeConstMaxMACPayloadSizeFunc(230)),
 			ttnpb.DATA_RATE_7: makeFSKDataRate(50000, makeConstMaxMACPayloadSizeFunc(230)),
+
+			// LR-FHSS data rates are uplink-only, see RP002-1.0.2.
+			ttnpb.DATA_RATE_8:  makeLRFHSSDataRate(137000, "1/3", makeConstMaxMACPayloadSizeFunc(58)),
+			ttnpb.DATA_RATE_9:  makeLRFHSSDataRate(137000, "2/3", makeConstMaxMACPayloadSizeFunc(123)),
+			ttnpb.DATA_RATE_10: makeLRFHSSDataRate(336000, "1/3", makeConstMaxMACPayloadSizeFunc(58)),
+			ttnpb.DATA_RATE_11: makeLRFHSSDataRate(336000, "2/3", makeConstMaxMACPayloadSizeFunc(123)),
 		},
 		MaxADRDataRateIndex: ttnpb.DATA_RATE_5,
 
@@ -149,8 +162,8 @@ func init() {
 
 		Rx1Channel: channelIndexIdentity,
 		Rx1DataRate: func(idx ttnpb.DataRateIndex, offset uint32, _ bool) (ttnpb.DataRateIndex, error) {
-			if idx > ttnpb.DATA_RATE_7 {
-				return 0, errDataRateIndexTooHigh.WithAttributes("max", 7)
+			if idx > ttnpb.DATA_RATE_11 {
+				return 0, errDataRateIndexTooHigh.WithAttributes("max", 11)
 			}
 			if offset > 5 {
 				return 0, errDataRateOffsetTooHigh.WithAttributes("max", 5)
@@ -176,14 +189,15 @@ func init() {
 		},
 		PingSlotFrequency: uint64Ptr(beaconFrequency),
 
-		regionalParameters1_0:   bandIdentity,
-		regionalParameters1_0_1: bandIdentity,
+		regionalParameters1_0:   removeLRFHSSDataRates,
+		regionalParameters1_0_1: removeLRFHSSDataRates,
 		regionalParameters1_0_2RevA: composeSwaps(
 			makeSetMaxTxPowerIndexFunc(5),
+			removeLRFHSSDataRates,
 		),
-		regionalParameters1_0_2RevB: bandIdentity,
-		regionalParameters1_0_3RevA: bandIdentity,
-		regionalParameters1_1RevA:   bandIdentity,
+		regionalParameters1_0_2RevB: removeLRFHSSDataRates,
+		regionalParameters1_0_3RevA: removeLRFHSSDataRates,
+		regionalParameters1_1RevA:   removeLRFHSSDataRates,
 	}
 	All[EU_863_870] = eu_863_870
 }
@@ -28,6 +28,9 @@ const US_902_928 = "US_902_928"
 //revive:enable:var-naming
 
 func init() {
+	// LR-FHSS data rates were introduced in RP002-1.0.2.
+	removeLRFHSSDataRates := makeRemoveDataRatesFunc(ttnpb.DATA_RATE_5, ttnpb.DATA_RATE_6)
+
 	uplinkChannels := make([]Channel, 0, 72)
 	for i := 0; i < 64; i++ {
 		uplinkChannels = append(uplinkChannels, Channel{
@@ -52,12 +55,14 @@ func init() {
 		})
 	}
 
-	downlinkDRTable := [5][4]ttnpb.DataRateIndex{
+	downlinkDRTable := [7][4]ttnpb.DataRateIndex{
 		{10, 9, 8, 8},
 		{11, 10, 9, 8},
 		{12, 11, 10, 9},
 		{13, 12, 11, 10},
 		{13, 13, 12, 11},
+		{10, 9, 8, 8},
+		{11, 10, 9, 8},
 	}
 
 	us_902_928 = Band{
@@ -94,6 +99,10 @@ func init() {
 			ttnpb.DATA_RATE_3: makeLoRaDataRate(7, 125000, makeConstMaxMACPayloadSizeFunc(250)),
 			ttnpb.DATA_RATE_4: makeLoRaDataRate(8, 500000, makeConstMaxMACPayloadSizeFunc(250)),
 
+			// LR-FHSS data rates are uplink-only, see RP002-1.0.2.
+			ttnpb.DATA_RATE_5: makeLRFHSSDataRate(1523000, "1/3", makeConstMaxMACPayloadSizeFunc(58)),
+			ttnpb.DATA_RATE_6: makeLRFHSSDataRate(1523000, "2/3", makeConstMaxMACPayloadSizeFunc(133)),
+
 			ttnpb.DATA_RATE_8:  makeLoRaDataRate(12, 500000, makeConstMaxMACPayloadSizeFunc(41)),
 			ttnpb.DATA_RATE_9:  makeLoRaDataRate(11, 500000, makeConstMaxMACPayloadSizeFunc(117)),
 			ttnpb.DATA_RATE_10: makeLoRaDataRate(10, 500000, makeConstMaxMACPayloadSizeFunc(230)),
@@ -134,8 +143,8 @@ func init() {
 
 		Rx1Channel: channelIndexModulo(8),
 		Rx1DataRate: func(idx ttnpb.DataRateIndex, offset uint32, _ bool) (ttnpb.DataRateIndex, error) {
-			if idx > ttnpb.DATA_RATE_4 {
-				return 0, errDataRateIndexTooHigh.WithAttributes("max", 4)
+			if idx > ttnpb.DATA_RATE_6 {
+				return 0, errDataRateIndexTooHigh.WithAttributes("max", 6)
 			}
 			if offset > 3 {
 				return 0, errDataRateOffsetTooHigh.WithAttributes("max", 3)
@@ -160,21 +169,23 @@ func init() {
 			ComputeFrequency: makeBeaconFrequencyFunc(usAuBeaconFrequencies),
 		},
 
-		regionalParameters1_0:   bandIdentity,
-		regionalParameters1_0_1: bandIdentity,
-		regionalParameters1_0_2RevA: func(b Band) Band {
-			b.Beacon.DataRateIndex = ttnpb.DATA_RATE_3
-			return b
-		},
+		regionalParameters1_0:   removeLRFHSSDataRates,
+		regionalParameters1_0_1: removeLRFHSSDataRates,
+		regionalParameters1_0_2RevA: composeSwaps(
+			makeSetBeaconDataRateIndex(ttnpb.DATA_RATE_3),
+			removeLRFHSSDataRates,
+		),
 		regionalParameters1_0_2RevB: composeSwaps(
 			disableCFList,
 			disableChMaskCntl5,
 			makeSetMaxTxPowerIndexFunc(10),
+			removeLRFHSSDataRates,
 		),
 		regionalParameters1_0_3RevA: composeSwaps(
 			makeAddTxPowerFunc(-30),
+			removeLRFHSSDataRates,
 		),
-		regionalParameters1_1RevA: bandIdentity,
+		regionalParameters1_1RevA: removeLRFHSSDataRates,
 	}
 	All[US_902_928] = us_902_928
 }
@@ -16,8 +16,11 @@ package cups
 
 import (
 	"context"
+	"io/ioutil"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
@@ -34,11 +37,45 @@ type ServerConfig struct {
 	Default struct {
 		LNSURI string `name:"lns-uri" description:"The default LNS URI that the gateways should use"`
 	} `name:"default" description:"Default gateway settings"`
-	AllowCUPSURIUpdate bool `name:"allow-cups-uri-update" description:"Allow CUPS URI updates"`
+	AllowCUPSURIUpdate bool           `name:"allow-cups-uri-update" description:"Allow CUPS URI updates"`
+	Firmware           FirmwareConfig `name:"firmware" description:"Firmware updates"`
+}
+
+// FirmwareConfig is the configuration of firmware updates of the CUPS server.
+type FirmwareConfig struct {
+	Source      string                `name:"source" description:"Source of the firmware catalog (directory, url, blob)"`
+	Directory   string                `name:"directory" description:"OS filesystem directory, which contains the firmware catalog"`
+	URL         string                `name:"url" description:"URL, which contains the firmware catalog"`
+	Blob        config.BlobPathConfig `name:"blob"`
+	SigningKeys []string              `name:"signing-keys" description:"Paths to PEM encoded ECDSA private keys to sign firmware updates"`
+}
+
+// catalog returns the firmware catalog defined by the configuration.
+// If no source is set, this method returns nil, nil.
+func (conf FirmwareConfig) catalog(ctx context.Context, blobConf config.BlobConfig) (FirmwareCatalog, error) {
+	var fetcher fetch.Interface
+	switch conf.Source {
+	case "directory":
+		fetcher = fetch.FromFilesystem(conf.Directory)
+	case "url":
+		var err error
+		if fetcher, err = fetch.FromHTTP(conf.URL, true); err != nil {
+			return nil, err
+		}
+	case "blob":
+		b, err := blobConf.Bucket(ctx, conf.Blob.Bucket)
+		if err != nil {
+			return nil, err
+		}
+		fetcher = fetch.FromBucket(ctx, b, conf.Blob.Path)
+	default:
+		return nil, nil
+	}
+	return NewFetchFirmwareCatalog(fetcher), nil
 }
 
 // NewServer returns a new CUPS server from this config on top of the component.
-func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option) *Server {
+func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option) (*Server, error) {
 	opts := []Option{
 		WithExplicitEnable(conf.ExplicitEnable),
 		WithAllowCUPSURIUpdate(conf.AllowCUPSURIUpdate),
@@ -65,7 +102,25 @@ func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option)
 	if tlsConfig, err := c.GetTLSServerConfig(c.Context()); err == nil {
 		opts = append(opts, WithTLSConfig(tlsConfig))
 	}
+	catalog, err := conf.Firmware.catalog(c.Context(), c.GetBaseConfig(c.Context()).Blob)
+	if err != nil {
+		return nil, err
+	}
+	if catalog != nil {
+		opts = append(opts, WithFirmwareCatalog(catalog))
+	}
+	for _, path := range conf.Firmware.SigningKeys {
+		pemBytes, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		keyCRC, signer, err := ParseSigningKey(pemBytes)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithSigner(keyCRC, signer))
+	}
 	s := NewServer(c, append(opts, customOpts...)...)
 	c.RegisterWeb(s)
-	return s
+	return s, nil
 }
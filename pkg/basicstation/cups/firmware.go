@@ -0,0 +1,238 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/pem"
+	"hash/crc32"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"gopkg.in/yaml.v2"
+)
+
+// FirmwareRollout defines to which gateways a firmware update is rolled out.
+type FirmwareRollout struct {
+	// Percentage is the percentage of gateways to which the update is rolled out.
+	// Gateways are selected deterministically based on their identifiers.
+	Percentage uint32 `yaml:"percentage"`
+	// GatewayIDs are the IDs of gateways to which the update is always rolled out.
+	GatewayIDs []string `yaml:"gateway-ids"`
+}
+
+// Firmware is a firmware update in the catalog.
+type Firmware struct {
+	// Model is the model of the gateways, as reported by Basic Station. If empty, any model matches.
+	Model string `yaml:"model"`
+	// Package is the package version of the firmware. Gateways that report an older package version are updated.
+	Package string `yaml:"package"`
+	// Channel is the update channel of the gateways. If empty, any update channel matches.
+	Channel string `yaml:"channel"`
+	// File is the path of the update data, relative to the catalog.
+	File string `yaml:"file"`
+	// Rollout defines to which gateways the update is rolled out.
+	Rollout FirmwareRollout `yaml:"rollout"`
+}
+
+// Matches returns whether the firmware applies to a gateway with the given model and update channel.
+func (f Firmware) Matches(model, channel string) bool {
+	return (f.Model == "" || f.Model == model) && (f.Channel == "" || f.Channel == channel)
+}
+
+// RolledOut returns whether the firmware update is rolled out to the given gateway.
+func (f Firmware) RolledOut(ctx context.Context, ids ttnpb.GatewayIdentifiers) bool {
+	for _, id := range f.Rollout.GatewayIDs {
+		if id == ids.GatewayID {
+			return true
+		}
+	}
+	bucket := crc32.ChecksumIEEE([]byte(unique.ID(ctx, ids)+"/"+f.Package)) % 100
+	return bucket < f.Rollout.Percentage
+}
+
+// CompareVersions compares the version strings a and b, and returns -1 if a < b, 0 if a == b and 1 if a > b.
+// Versions are compared per dot-separated part, numerically if both parts are numbers, lexicographically otherwise.
+func CompareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var ap, bp string
+		if i < len(as) {
+			ap = as[i]
+		}
+		if i < len(bs) {
+			bp = bs[i]
+		}
+		an, aErr := strconv.ParseUint(ap, 10, 64)
+		bn, bErr := strconv.ParseUint(bp, 10, 64)
+		switch {
+		case aErr == nil && bErr == nil && an < bn:
+			return -1
+		case aErr == nil && bErr == nil && an > bn:
+			return 1
+		case (aErr != nil || bErr != nil) && ap < bp:
+			return -1
+		case (aErr != nil || bErr != nil) && ap > bp:
+			return 1
+		}
+	}
+	return 0
+}
+
+// FirmwareCatalog is a catalog of firmware updates for Basic Station gateways.
+type FirmwareCatalog interface {
+	// Lookup returns the firmware update for the gateway, or nil if the gateway is up to date.
+	Lookup(ctx context.Context, ids ttnpb.GatewayIdentifiers, model, pkg, channel string) (*Firmware, error)
+	// UpdateData returns the update data of the firmware.
+	UpdateData(ctx context.Context, firmware *Firmware) ([]byte, error)
+}
+
+const (
+	firmwareIndexFile     = "index.yml"
+	firmwareIndexCacheTTL = time.Minute
+)
+
+var errFirmwareIndex = errors.DefineCorruption("firmware_index", "invalid firmware index")
+
+// NewFetchFirmwareCatalog returns a FirmwareCatalog that fetches the firmware index and update data with the fetcher.
+// The index is a YAML file named index.yml, which contains a list of firmware updates.
+// Fetchers are typically backed by a blob bucket.
+func NewFetchFirmwareCatalog(fetcher fetch.Interface) FirmwareCatalog {
+	return &fetchFirmwareCatalog{
+		fetcher:    fetcher,
+		updateData: make(map[string][]byte),
+	}
+}
+
+type fetchFirmwareCatalog struct {
+	fetcher fetch.Interface
+
+	mu           sync.Mutex
+	index        []Firmware
+	indexFetched time.Time
+	updateData   map[string][]byte
+}
+
+// getIndex returns the cached firmware index, or fetches the index if the cache expired.
+// The index is fetched without holding the lock, so that slow fetches do not block gateways that use the cache.
+func (c *fetchFirmwareCatalog) getIndex() ([]Firmware, error) {
+	c.mu.Lock()
+	index, indexFetched := c.index, c.indexFetched
+	c.mu.Unlock()
+	if index != nil && time.Since(indexFetched) < firmwareIndexCacheTTL {
+		return index, nil
+	}
+	b, err := c.fetcher.File(firmwareIndexFile)
+	if err != nil {
+		return nil, err
+	}
+	var fetched []Firmware
+	if err := yaml.UnmarshalStrict(b, &fetched); err != nil {
+		return nil, errFirmwareIndex.WithCause(err)
+	}
+	if fetched == nil {
+		fetched = []Firmware{}
+	}
+	c.mu.Lock()
+	// Update data may have changed, so it is fetched again.
+	c.index, c.indexFetched, c.updateData = fetched, time.Now(), make(map[string][]byte)
+	c.mu.Unlock()
+	return fetched, nil
+}
+
+// Lookup implements FirmwareCatalog.
+// If multiple firmware updates apply to the gateway, the update with the highest package version is returned.
+func (c *fetchFirmwareCatalog) Lookup(ctx context.Context, ids ttnpb.GatewayIdentifiers, model, pkg, channel string) (*Firmware, error) {
+	index, err := c.getIndex()
+	if err != nil {
+		return nil, err
+	}
+	var res *Firmware
+	for i, firmware := range index {
+		if !firmware.Matches(model, channel) || CompareVersions(pkg, firmware.Package) >= 0 || !firmware.RolledOut(ctx, ids) {
+			continue
+		}
+		if res == nil || CompareVersions(firmware.Package, res.Package) > 0 {
+			res = &index[i]
+		}
+	}
+	return res, nil
+}
+
+// UpdateData implements FirmwareCatalog.
+// The update data is fetched without holding the lock.
+func (c *fetchFirmwareCatalog) UpdateData(ctx context.Context, firmware *Firmware) ([]byte, error) {
+	c.mu.Lock()
+	b, ok := c.updateData[firmware.File]
+	indexFetched := c.indexFetched
+	c.mu.Unlock()
+	if ok {
+		return b, nil
+	}
+	b, err := c.fetcher.File(firmware.File)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	// Do not cache the update data if the index has been fetched again in the meantime.
+	if c.indexFetched.Equal(indexFetched) {
+		c.updateData[firmware.File] = b
+	}
+	c.mu.Unlock()
+	return b, nil
+}
+
+var errSigningKey = errors.DefineInvalidArgument("signing_key", "invalid firmware signing key")
+
+// ParseSigningKey parses the PEM encoded ECDSA private key that is used to sign firmware updates.
+// It returns the CRC of the public key, as used by Basic Station to identify signing keys, and the signer.
+// The CRC is computed over the raw public key, which consists of the X and Y coordinates.
+func ParseSigningKey(pemBytes []byte) (uint32, crypto.Signer, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return 0, nil, errSigningKey.New()
+	}
+	var key *ecdsa.PrivateKey
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		var err error
+		if key, err = x509.ParseECPrivateKey(block.Bytes); err != nil {
+			return 0, nil, errSigningKey.WithCause(err)
+		}
+	default:
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return 0, nil, errSigningKey.WithCause(err)
+		}
+		var ok bool
+		if key, ok = parsed.(*ecdsa.PrivateKey); !ok {
+			return 0, nil, errSigningKey.New()
+		}
+	}
+	size := (key.Curve.Params().BitSize + 7) / 8
+	raw := make([]byte, 2*size)
+	key.X.FillBytes(raw[:size])
+	key.Y.FillBytes(raw[size:])
+	return crc32.ChecksumIEEE(raw), key, nil
+}
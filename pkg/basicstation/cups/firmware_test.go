@@ -0,0 +1,204 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCompareVersions(t *testing.T) {
+	for _, tc := range []struct {
+		A, B     string
+		Expected int
+	}{
+		{A: "1.0.0", B: "1.0.0", Expected: 0},
+		{A: "1.0.0", B: "1.0.1", Expected: -1},
+		{A: "1.10.0", B: "1.9.0", Expected: 1},
+		{A: "1.0", B: "1.0.1", Expected: -1},
+		{A: "", B: "1.0.0", Expected: -1},
+		{A: "2.0.0-rc1", B: "2.0.0-rc2", Expected: -1},
+	} {
+		t.Run(fmt.Sprintf("%s/%s", tc.A, tc.B), func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(CompareVersions(tc.A, tc.B), should.Equal, tc.Expected)
+			a.So(CompareVersions(tc.B, tc.A), should.Equal, -tc.Expected)
+		})
+	}
+}
+
+func TestFirmwareCatalog(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	catalog := NewFetchFirmwareCatalog(fetch.NewMemFetcher(map[string][]byte{
+		"index.yml": []byte(`- model: corecell
+  package: 2.0.0
+  channel: stable
+  file: corecell/2.0.0.bin
+  rollout:
+    percentage: 100
+- model: corecell
+  package: 2.1.0
+  channel: stable
+  file: corecell/2.1.0.bin
+  rollout:
+    gateway-ids:
+    - beta-gtw
+- model: corecell
+  package: 3.0.0
+  channel: beta
+  file: corecell/3.0.0.bin
+  rollout:
+    percentage: 0
+`),
+		"corecell/2.0.0.bin": []byte("v2.0.0"),
+		"corecell/2.1.0.bin": []byte("v2.1.0"),
+	}))
+
+	stableIDs := ttnpb.GatewayIdentifiers{GatewayID: "stable-gtw"}
+	betaIDs := ttnpb.GatewayIdentifiers{GatewayID: "beta-gtw"}
+
+	// Up to date gateways are not updated.
+	firmware, err := catalog.Lookup(ctx, stableIDs, "corecell", "2.0.0", "stable")
+	a.So(err, should.BeNil)
+	a.So(firmware, should.BeNil)
+
+	// Other models are not updated.
+	firmware, err = catalog.Lookup(ctx, stableIDs, "other", "1.0.0", "stable")
+	a.So(err, should.BeNil)
+	a.So(firmware, should.BeNil)
+
+	// Gateways that are behind are updated to the latest rolled out version.
+	firmware, err = catalog.Lookup(ctx, stableIDs, "corecell", "1.0.0", "stable")
+	a.So(err, should.BeNil)
+	if a.So(firmware, should.NotBeNil) {
+		a.So(firmware.Package, should.Equal, "2.0.0")
+		data, err := catalog.UpdateData(ctx, firmware)
+		a.So(err, should.BeNil)
+		a.So(data, should.Resemble, []byte("v2.0.0"))
+	}
+
+	// Gateways in the allow-list get the update.
+	firmware, err = catalog.Lookup(ctx, betaIDs, "corecell", "1.0.0", "stable")
+	a.So(err, should.BeNil)
+	if a.So(firmware, should.NotBeNil) {
+		a.So(firmware.Package, should.Equal, "2.1.0")
+	}
+
+	// Updates that are not rolled out are not delivered.
+	firmware, err = catalog.Lookup(ctx, stableIDs, "corecell", "2.1.0", "beta")
+	a.So(err, should.BeNil)
+	a.So(firmware, should.BeNil)
+}
+
+// blockingFetcher is a fetch.Interface that blocks fetching the given file until unblocked.
+type blockingFetcher struct {
+	fetch.Interface
+	file    string
+	fetched chan struct{}
+	unblock chan struct{}
+}
+
+func (f *blockingFetcher) File(pathElements ...string) ([]byte, error) {
+	if len(pathElements) == 1 && pathElements[0] == f.file {
+		close(f.fetched)
+		<-f.unblock
+	}
+	return f.Interface.File(pathElements...)
+}
+
+func TestFirmwareCatalogConcurrentFetch(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	fetcher := &blockingFetcher{
+		Interface: fetch.NewMemFetcher(map[string][]byte{
+			"index.yml": []byte(`- package: 2.0.0
+  file: 2.0.0.bin
+  rollout:
+    percentage: 100
+`),
+			"2.0.0.bin": []byte("v2.0.0"),
+		}),
+		file:    "2.0.0.bin",
+		fetched: make(chan struct{}),
+		unblock: make(chan struct{}),
+	}
+	catalog := NewFetchFirmwareCatalog(fetcher)
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"}
+
+	firmware, err := catalog.Lookup(ctx, ids, "corecell", "1.0.0", "stable")
+	if !a.So(err, should.BeNil) || !a.So(firmware, should.NotBeNil) {
+		t.FailNow()
+	}
+
+	dataCh := make(chan []byte, 1)
+	go func() {
+		data, err := catalog.UpdateData(ctx, firmware)
+		a.So(err, should.BeNil)
+		dataCh <- data
+	}()
+	<-fetcher.fetched
+
+	// Lookups are not blocked by fetching update data.
+	lookupCh := make(chan *Firmware, 1)
+	go func() {
+		firmware, err := catalog.Lookup(ctx, ids, "corecell", "1.0.0", "stable")
+		a.So(err, should.BeNil)
+		lookupCh <- firmware
+	}()
+	select {
+	case firmware := <-lookupCh:
+		a.So(firmware, should.NotBeNil)
+	case <-time.After(test.Delay << 5):
+		t.Fatal("Lookup blocked by fetching update data")
+	}
+
+	close(fetcher.unblock)
+	a.So(<-dataCh, should.Resemble, []byte("v2.0.0"))
+}
+
+func TestParseSigningKey(t *testing.T) {
+	a := assertions.New(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	keyCRC, signer, err := ParseSigningKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+	a.So(err, should.BeNil)
+	a.So(keyCRC, should.NotEqual, 0)
+	a.So(signer.Public(), should.Resemble, key.Public())
+
+	_, _, err = ParseSigningKey([]byte("invalid"))
+	a.So(err, should.NotBeNil)
+}
@@ -61,6 +61,8 @@ type Server struct {
 	trustCache   map[string]*x509.Certificate
 
 	signers map[uint32]crypto.Signer
+
+	firmwareCatalog FirmwareCatalog
 }
 
 func (s *Server) getServerAuth(ctx context.Context) grpc.CallOption {
@@ -174,6 +176,14 @@ func WithSigner(keyCRC uint32, signer crypto.Signer) Option {
 	}
 }
 
+// WithFirmwareCatalog configures the CUPS server with a firmware catalog.
+// Firmware updates are only sent to gateways that have auto update enabled, and if the update can be signed.
+func WithFirmwareCatalog(catalog FirmwareCatalog) Option {
+	return func(s *Server) {
+		s.firmwareCatalog = catalog
+	}
+}
+
 // WithRegistries overrides the CUPS server's gateway registries.
 func WithRegistries(registry ttnpb.GatewayRegistryClient, access ttnpb.GatewayAccessClient) Option {
 	return func(s *Server) {
@@ -31,6 +31,7 @@ import (
 	echo "github.com/labstack/echo/v4"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -293,31 +294,49 @@ func (s *Server) UpdateInfo(c echo.Context) error {
 		res.LNSCredentials = lnsCredentials
 	}
 
-	if gtw.AutoUpdate {
-		// TODO: Compare the Station, Model, Package, version_ids and update_channel in order to check if any updates are required
-		// (https://github.com/TheThingsNetwork/lorawan-stack/issues/365)
-		var updateData []byte
-		if updateData != nil {
-			var (
-				keyCRC uint32
-				signer crypto.Signer
-			)
-			for _, keyCRC = range req.KeyCRCs {
-				if sig, ok := s.signers[keyCRC]; ok {
-					signer = sig
-					break
-				}
+	if gtw.AutoUpdate && s.firmwareCatalog != nil {
+		firmware, err := s.firmwareCatalog.Lookup(ctx, gtw.GatewayIdentifiers, req.Model, req.Package, gtw.UpdateChannel)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to look up firmware update")
+			firmware = nil
+		}
+		var (
+			keyCRC uint32
+			signer crypto.Signer
+		)
+		for _, crc := range req.KeyCRCs {
+			if sig, ok := s.signers[crc]; ok {
+				keyCRC, signer = crc, sig
+				break
+			}
+		}
+		switch {
+		case firmware == nil:
+		case signer == nil:
+			logger.WithField("package", firmware.Package).Warn("No signing key for firmware update available")
+		default:
+			updateData, err := s.firmwareCatalog.UpdateData(ctx, firmware)
+			if err != nil {
+				logger.WithError(err).WithField("package", firmware.Package).Warn("Failed to get firmware update data")
+				break
 			}
-			if signer != nil {
-				hash := sha512.Sum512(updateData)
-				sig, err := signer.Sign(rand.Reader, hash[:], nil)
-				if err != nil {
-					return err
-				}
-				res.SignatureKeyCRC = keyCRC
-				res.Signature = sig
-				res.UpdateData = updateData
+			hash := sha512.Sum512(updateData)
+			sig, err := signer.Sign(rand.Reader, hash[:], nil)
+			if err != nil {
+				logger.WithError(err).WithField("package", firmware.Package).Warn("Failed to sign firmware update")
+				break
 			}
+			res.SignatureKeyCRC = keyCRC
+			res.Signature = sig
+			res.UpdateData = updateData
+			logger.WithFields(log.Fields(
+				"from_package", req.Package,
+				"to_package", firmware.Package,
+			)).Info("Deliver firmware update")
+			events.Publish(evtDeliverFirmware.NewWithIdentifiersAndData(ctx, gtw.GatewayIdentifiers, &ttnpb.GatewayVersionIdentifiers{
+				ModelID:         req.Model,
+				FirmwareVersion: firmware.Package,
+			}))
 		}
 	}
 
@@ -63,6 +63,7 @@ func (as *asImplementation) Subscribe(id *ttnpb.ApplicationIdentifiers, stream t
 
 type gsImplementation struct {
 	*component.Component
+	ttnpb.UnimplementedGsServer
 }
 
 func (gs *gsImplementation) GetGatewayConnectionStats(ctx context.Context, _ *ttnpb.GatewayIdentifiers) (*ttnpb.GatewayConnectionStats, error) {
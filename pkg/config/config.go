@@ -223,6 +223,7 @@ func (m *Manager) Unmarshal(result interface{}) error {
 			stringToByteArrayHook,
 			stringToTimeDurationPointerHook,
 			stringToADRAckDelayExponentPointerHook,
+			stringToADRAlgorithmPointerHook,
 			stringToADRAckLimitExponentPointerHook,
 			stringToAggregatedDutyCyclePointerHook,
 			stringToRxDelayPointerHook,
@@ -577,6 +578,14 @@ func (m *Manager) setDefaults(prefix string, flags *pflag.FlagSet, config interf
 				}
 				flags.StringP(name, shorthand, def, description)
 
+			case *ttnpb.ADRAlgorithm:
+				var def string
+				if val != nil {
+					def = val.String()
+					m.viper.SetDefault(name, def)
+				}
+				flags.StringP(name, shorthand, def, description)
+
 			case *ttnpb.AggregatedDutyCycle:
 				var def string
 				if val != nil {
@@ -319,6 +319,25 @@ func stringToADRAckLimitExponentPointerHook(f reflect.Type, t reflect.Type, data
 	return data, nil
 }
 
+func stringToADRAlgorithmPointerHook(f reflect.Type, t reflect.Type, data interface{}) (interface{}, error) {
+	if f != nil && f.Kind() != reflect.String {
+		return data, nil
+	}
+	if s, ok := data.(string); ok {
+		var enum ttnpb.ADRAlgorithm
+		if t == reflect.TypeOf(&enum) {
+			if s == "" {
+				return nil, nil
+			}
+			if err := enum.UnmarshalText([]byte(s)); err != nil {
+				return strconv.ParseInt(s, 10, 32)
+			}
+			return enum, nil
+		}
+	}
+	return data, nil
+}
+
 func stringToAggregatedDutyCyclePointerHook(f reflect.Type, t reflect.Type, data interface{}) (interface{}, error) {
 	if f != nil && f.Kind() != reflect.String {
 		return data, nil
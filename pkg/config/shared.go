@@ -122,11 +122,47 @@ type Cache struct {
 	Redis   redis.Config `name:"redis"`
 }
 
+// KafkaEvents represents configuration for the Kafka events backend.
+type KafkaEvents struct {
+	Brokers       []string `name:"brokers" description:"Kafka broker addresses"`
+	Topic         string   `name:"topic" description:"Topic to publish and consume events"`
+	ConsumerGroup string   `name:"consumer-group" description:"Consumer group of this instance (default is the hostname). Each instance should use a different consumer group"`
+	TLS           struct {
+		Enable           bool `name:"enable" description:"Connect to the brokers using TLS"`
+		tlsconfig.Client `name:",squash"`
+	} `name:"tls"`
+	SASL struct {
+		Enable    bool   `name:"enable" description:"Authenticate using SASL"`
+		Mechanism string `name:"mechanism" description:"SASL mechanism (PLAIN, SCRAM-SHA-256, SCRAM-SHA-512)"`
+		Username  string `name:"username" description:"SASL username"`
+		Password  string `name:"password" description:"SASL password"`
+	} `name:"sasl"`
+}
+
+// NATSEvents represents configuration for the NATS JetStream events backend.
+type NATSEvents struct {
+	ServerURL string        `name:"server-url" description:"URL of the NATS server"`
+	Stream    string        `name:"stream" description:"JetStream stream that stores the events"`
+	Subject   string        `name:"subject" description:"Subject prefix of the events"`
+	Consumer  string        `name:"consumer" description:"Durable consumer of this instance (default is the hostname). Each instance should use a different consumer"`
+	MaxAge    time.Duration `name:"max-age" description:"Maximum age of events in the stream, when the stream is created"`
+}
+
+// EventsStore represents configuration for storing events, which are used for historical event streams.
+type EventsStore struct {
+	Enable      bool          `name:"enable" description:"Enable storing events (internal and redis backends)"`
+	TTL         time.Duration `name:"ttl" description:"How long events are stored"`
+	EntityCount int           `name:"entity-count" description:"How many events are stored per entity"`
+}
+
 // Events represents configuration for the events system.
 type Events struct {
-	Backend string       `name:"backend" description:"Backend to use for events (internal, redis, cloud)"`
+	Backend string       `name:"backend" description:"Backend to use for events (internal, redis, kafka, nats, cloud)"`
 	Redis   redis.Config `name:"redis"`
+	Kafka   KafkaEvents  `name:"kafka"`
+	NATS    NATSEvents   `name:"nats"`
 	Cloud   CloudEvents  `name:"cloud"`
+	Store   EventsStore  `name:"store"`
 }
 
 // Rights represents the configuration to apply when fetching entity rights.
@@ -165,6 +201,17 @@ func (v KeyVault) KeyVault() (crypto.KeyVault, error) {
 	return vault, nil
 }
 
+// DeviceRegistrySQL represents configuration for a SQL device registry.
+type DeviceRegistrySQL struct {
+	DatabaseURI string `name:"database-uri" description:"Database connection URI"`
+}
+
+// DeviceRegistry represents configuration for a device registry.
+type DeviceRegistry struct {
+	Provider string            `name:"provider" description:"Device registry provider (redis, sql)"`
+	SQL      DeviceRegistrySQL `name:"sql"`
+}
+
 var (
 	errUnknownBlobProvider = errors.DefineInvalidArgument("unknown_blob_provider", "unknown blob store provider `{provider}`")
 	errMissingBlobConfig   = errors.DefineInvalidArgument("missing_blob_config", "missing blob store configuration")
@@ -0,0 +1,72 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// EndDeviceArchiveVersion is the version of the end device archives created by WrapEndDeviceArchive.
+const EndDeviceArchiveVersion = 1
+
+var (
+	errEndDeviceArchiveVersion = errors.DefineInvalidArgument("end_device_archive_version", "end device archive version `{version}` is not supported")
+	errDecodeEndDeviceArchive  = errors.DefineInvalidArgument("decode_end_device_archive", "decode end device archive")
+)
+
+// WrapEndDeviceArchive creates an archive of the given end devices.
+// If the KEK label is not empty, the end devices are encrypted with a random data key,
+// which is wrapped using the given key vault and KEK label.
+func WrapEndDeviceArchive(ctx context.Context, devs []*ttnpb.EndDevice, kekLabel string, v crypto.KeyVault) (*ttnpb.EndDeviceArchive, error) {
+	b, err := proto.Marshal(&ttnpb.EndDevices{
+		EndDevices: devs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	archive := &ttnpb.EndDeviceArchive{
+		Version:   EndDeviceArchiveVersion,
+		CreatedAt: &now,
+	}
+	archive.EndDevices, archive.DataKey, err = WrapBytes(ctx, b, kekLabel, v)
+	if err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+// UnwrapEndDeviceArchive returns the end devices in the given archive.
+// If the archive is encrypted, the data key is unwrapped using the given key vault.
+func UnwrapEndDeviceArchive(ctx context.Context, archive *ttnpb.EndDeviceArchive, v crypto.KeyVault) ([]*ttnpb.EndDevice, error) {
+	if archive.Version != EndDeviceArchiveVersion {
+		return nil, errEndDeviceArchiveVersion.WithAttributes("version", archive.Version)
+	}
+	b, err := UnwrapBytes(ctx, archive.EndDevices, archive.DataKey, v)
+	if err != nil {
+		return nil, err
+	}
+	devs := &ttnpb.EndDevices{}
+	if err := proto.Unmarshal(b, devs); err != nil {
+		return nil, errDecodeEndDeviceArchive.WithCause(err)
+	}
+	return devs.EndDevices, nil
+}
@@ -0,0 +1,110 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestEndDeviceArchive(t *testing.T) {
+	kek, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	v := NewMemKeyVault(map[string][]byte{
+		"key": kek,
+	})
+
+	devs := []*ttnpb.EndDevice{
+		{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+				DeviceID:               "test-dev",
+				DevAddr:                &types.DevAddr{0x42, 0xff, 0xff, 0xff},
+			},
+			Session: &ttnpb.Session{
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				SessionKeys: ttnpb.SessionKeys{
+					FNwkSIntKey: &ttnpb.KeyEnvelope{
+						Key: &types.AES128Key{0x42, 0x42},
+					},
+				},
+				LastFCntUp: 42,
+			},
+			MACState: &ttnpb.MACState{
+				LoRaWANVersion: ttnpb.MAC_V1_1,
+				DeviceClass:    ttnpb.CLASS_C,
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		KEKLabel string
+	}{
+		{
+			Name: "Plain",
+		},
+		{
+			Name:     "Wrapped",
+			KEKLabel: "key",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := test.Context()
+
+			archive, err := WrapEndDeviceArchive(ctx, devs, tc.KEKLabel, v)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(archive.Version, should.Equal, uint32(EndDeviceArchiveVersion))
+			a.So(archive.CreatedAt, should.NotBeNil)
+			if tc.KEKLabel == "" {
+				a.So(archive.DataKey, should.BeNil)
+			} else if a.So(archive.DataKey, should.NotBeNil) {
+				a.So(archive.DataKey.KEKLabel, should.Equal, tc.KEKLabel)
+			}
+
+			unwrapped, err := UnwrapEndDeviceArchive(ctx, archive, v)
+			if a.So(err, should.BeNil) {
+				a.So(unwrapped, should.Resemble, devs)
+			}
+
+			archive.Version = EndDeviceArchiveVersion + 1
+			_, err = UnwrapEndDeviceArchive(ctx, archive, v)
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		})
+	}
+
+	t.Run("Tampered", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx := test.Context()
+
+		archive, err := WrapEndDeviceArchive(ctx, devs, "key", v)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		archive.EndDevices[len(archive.EndDevices)-1] ^= 0xff
+		_, err = UnwrapEndDeviceArchive(ctx, archive, v)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+}
@@ -16,6 +16,7 @@ package cryptoutil
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
@@ -107,6 +108,47 @@ func UnwrapKeyEnvelope(ctx context.Context, ke *ttnpb.KeyEnvelope, v crypto.KeyV
 	}, nil
 }
 
+// WrapBytes encrypts the given plaintext with a random data key, and wraps the data key using the given key vault and
+// KEK label. WrapBytes returns the ciphertext and the wrapped data key.
+// If the KEK label is empty, the plaintext is returned in the clear and the data key is nil.
+func WrapBytes(ctx context.Context, plaintext []byte, kekLabel string, v crypto.KeyVault) ([]byte, *ttnpb.KeyEnvelope, error) {
+	if kekLabel == "" {
+		return plaintext, nil, nil
+	}
+	var key types.AES128Key
+	if _, err := rand.Read(key[:]); err != nil {
+		return nil, nil, err
+	}
+	dataKey, err := WrapAES128Key(ctx, key, kekLabel, v)
+	if err != nil {
+		return nil, nil, err
+	}
+	ciphertext, err := crypto.Encrypt(key, plaintext)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ciphertext, dataKey, nil
+}
+
+var errDecryptData = errors.DefineInvalidArgument("decrypt_data", "decrypt data")
+
+// UnwrapBytes unwraps the given data key using the given key vault, and decrypts the given ciphertext with it.
+// If the data key is nil, the ciphertext is assumed to be stored in the clear.
+func UnwrapBytes(ctx context.Context, ciphertext []byte, dataKey *ttnpb.KeyEnvelope, v crypto.KeyVault) ([]byte, error) {
+	if dataKey == nil {
+		return ciphertext, nil
+	}
+	key, err := UnwrapAES128Key(ctx, dataKey, v)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := crypto.Decrypt(key, ciphertext)
+	if err != nil {
+		return nil, errDecryptData.WithCause(err)
+	}
+	return plaintext, nil
+}
+
 func pathWithPrefix(prefix, path string) string {
 	if prefix == "" {
 		return path
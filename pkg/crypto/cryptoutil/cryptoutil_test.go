@@ -116,6 +116,56 @@ func TestWrapAES128Key(t *testing.T) {
 	}
 }
 
+func TestWrapBytes(t *testing.T) {
+	kekKey, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	v := NewMemKeyVault(map[string][]byte{
+		"key": kekKey,
+	})
+	plaintext := []byte("plaintext")
+
+	for _, tc := range []struct {
+		Name     string
+		KEKLabel string
+	}{
+		{
+			Name: "WithoutKEK",
+		},
+		{
+			Name:     "WithKEK",
+			KEKLabel: "key",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := test.Context()
+
+			ciphertext, dataKey, err := WrapBytes(ctx, plaintext, tc.KEKLabel, v)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			if tc.KEKLabel == "" {
+				a.So(dataKey, should.BeNil)
+				a.So(ciphertext, should.Resemble, plaintext)
+			} else {
+				if !a.So(dataKey, should.NotBeNil) {
+					t.FailNow()
+				}
+				a.So(dataKey.KEKLabel, should.Equal, tc.KEKLabel)
+				a.So(ciphertext, should.NotResemble, plaintext)
+			}
+
+			unwrapped, err := UnwrapBytes(ctx, ciphertext, dataKey, v)
+			a.So(err, should.BeNil)
+			a.So(unwrapped, should.Resemble, plaintext)
+
+			if dataKey != nil {
+				_, err := UnwrapBytes(ctx, ciphertext, dataKey, NewMemKeyVault(map[string][]byte{}))
+				a.So(errors.IsNotFound(err), should.BeTrue)
+			}
+		})
+	}
+}
+
 func TestUnwrapSelectedSessionKeys(t *testing.T) {
 	var key types.AES128Key
 	test.Must(nil, key.UnmarshalText([]byte("00112233445566778899AABBCCDDEEFF")))
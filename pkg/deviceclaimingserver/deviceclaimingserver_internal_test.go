@@ -0,0 +1,235 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+var (
+	errMock         = errors.DefineUnavailable("mock", "mock")
+	errMockNotFound = errors.DefineNotFound("mock_not_found", "mock not found")
+)
+
+// mockEndDeviceRegistry is an in-memory endDeviceRegistry.
+type mockEndDeviceRegistry struct {
+	devices map[string]*ttnpb.EndDevice
+	// failCreate is the unique ID of the end device of which the creation fails.
+	failCreate string
+}
+
+func (r *mockEndDeviceRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, _ ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+	dev, ok := r.devices[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errMockNotFound.New()
+	}
+	return dev, nil
+}
+
+func (r *mockEndDeviceRegistry) Create(ctx context.Context, dev *ttnpb.EndDevice, _ ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+	if r.failCreate == unique.ID(ctx, dev.EndDeviceIdentifiers) {
+		return nil, errMock.New()
+	}
+	r.devices[unique.ID(ctx, dev.EndDeviceIdentifiers)] = dev
+	return dev, nil
+}
+
+func (r *mockEndDeviceRegistry) Delete(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, _ ...grpc.CallOption) error {
+	delete(r.devices, unique.ID(ctx, ids))
+	return nil
+}
+
+func TestTransfer(t *testing.T) {
+	sourceIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "source-app"},
+		DeviceID:               "source-dev",
+	}
+	targetIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "target-app"},
+		DeviceID:               "target-dev",
+	}
+
+	for _, tc := range []struct {
+		name          string
+		failCreate    string
+		errAssertion  func(error) bool
+		expectSource  bool
+		expectTargets []string
+	}{
+		{
+			name:          "Success",
+			expectSource:  false,
+			expectTargets: []string{"is", "js", "ns", "as"},
+		},
+		{
+			name:         "FailIS",
+			failCreate:   "is",
+			errAssertion: errors.IsAborted,
+			expectSource: true,
+		},
+		{
+			name:         "FailAS",
+			failCreate:   "as",
+			errAssertion: errors.IsAborted,
+			expectSource: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := test.Context()
+
+			registries := make(map[string]*mockEndDeviceRegistry)
+			var steps []*transferStep
+			for _, name := range []string{"is", "js", "ns", "as"} {
+				source := &ttnpb.EndDevice{
+					EndDeviceIdentifiers: sourceIDs,
+					Name:                 fmt.Sprintf("%s-source", name),
+				}
+				registry := &mockEndDeviceRegistry{
+					devices: map[string]*ttnpb.EndDevice{
+						unique.ID(ctx, sourceIDs): source,
+					},
+				}
+				if tc.failCreate == name {
+					registry.failCreate = unique.ID(ctx, targetIDs)
+				}
+				registries[name] = registry
+				steps = append(steps, &transferStep{
+					name:           name,
+					sourceRegistry: registry,
+					targetRegistry: registry,
+					source:         source,
+					target: &ttnpb.EndDevice{
+						EndDeviceIdentifiers: targetIDs,
+						Name:                 fmt.Sprintf("%s-target", name),
+					},
+				})
+			}
+
+			err := (&transfer{steps: steps}).run(ctx, ctx)
+			if tc.errAssertion != nil {
+				a.So(tc.errAssertion(err), should.BeTrue)
+			} else {
+				a.So(err, should.BeNil)
+			}
+
+			for name, registry := range registries {
+				source, err := registry.Get(ctx, sourceIDs)
+				if tc.expectSource {
+					if a.So(err, should.BeNil) {
+						a.So(source.Name, should.Equal, fmt.Sprintf("%s-source", name))
+					}
+				} else {
+					a.So(errors.IsNotFound(err), should.BeTrue)
+				}
+				target, err := registry.Get(ctx, targetIDs)
+				expectTarget := false
+				for _, n := range tc.expectTargets {
+					if n == name {
+						expectTarget = true
+					}
+				}
+				if expectTarget {
+					if a.So(err, should.BeNil) {
+						a.So(target.Name, should.Equal, fmt.Sprintf("%s-target", name))
+					}
+				} else {
+					a.So(errors.IsNotFound(err), should.BeTrue)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateAuthenticationCode(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	before, after := now.Add(-time.Hour), now.Add(time.Hour)
+
+	for _, tc := range []struct {
+		name         string
+		code         *ttnpb.EndDeviceAuthenticationCode
+		value        string
+		errAssertion func(error) bool
+	}{
+		{
+			name:         "NoCode",
+			value:        "ABCD",
+			errAssertion: errors.IsFailedPrecondition,
+		},
+		{
+			name: "Mismatch",
+			code: &ttnpb.EndDeviceAuthenticationCode{
+				Value: "ABCD",
+			},
+			value:        "ABCE",
+			errAssertion: errors.IsPermissionDenied,
+		},
+		{
+			name: "Match",
+			code: &ttnpb.EndDeviceAuthenticationCode{
+				Value: "ABCD",
+			},
+			value: "ABCD",
+		},
+		{
+			name: "MatchInValidity",
+			code: &ttnpb.EndDeviceAuthenticationCode{
+				Value:     "ABCD",
+				ValidFrom: &before,
+				ValidTo:   &after,
+			},
+			value: "ABCD",
+		},
+		{
+			name: "NotYetValid",
+			code: &ttnpb.EndDeviceAuthenticationCode{
+				Value:     "ABCD",
+				ValidFrom: &after,
+			},
+			value:        "ABCD",
+			errAssertion: errors.IsFailedPrecondition,
+		},
+		{
+			name: "Expired",
+			code: &ttnpb.EndDeviceAuthenticationCode{
+				Value:   "ABCD",
+				ValidTo: &before,
+			},
+			value:        "ABCD",
+			errAssertion: errors.IsFailedPrecondition,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			err := validateAuthenticationCode(tc.code, tc.value, now)
+			if tc.errAssertion != nil {
+				a.So(tc.errAssertion(err), should.BeTrue)
+			} else {
+				a.So(err, should.BeNil)
+			}
+		})
+	}
+}
@@ -0,0 +1,375 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/qrcode"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/discover"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// authorizedApplicationRights are the rights that the API key of an authorized application must have.
+// These rights are needed to read the end device, including its keys, and to delete it.
+var authorizedApplicationRights = []ttnpb.Right{
+	ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+	ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+	ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+}
+
+var (
+	errAPIKeyRights               = errors.DefinePermissionDenied("api_key_rights", "API key does not have the rights to claim end devices of application `{application_uid}`")
+	errApplicationNotAuthorized   = errors.DefinePermissionDenied("application_not_authorized", "application `{application_uid}` is not authorized for claiming")
+	errQRCodeData                 = errors.DefineInvalidArgument("qr_code_data", "QR code data does not contain authenticated end device identifiers")
+	errNoAuthenticationCode       = errors.DefineFailedPrecondition("no_authentication_code", "end device has no claim authentication code")
+	errAuthenticationCode         = errors.DefinePermissionDenied("authentication_code", "invalid claim authentication code")
+	errAuthenticationCodeValidity = errors.DefineFailedPrecondition("authentication_code_validity", "claim authentication code is not valid at this time")
+	errSameApplication            = errors.DefineFailedPrecondition("same_application", "end device is already in application `{application_uid}`")
+)
+
+type endDeviceClaimingServer struct {
+	DCS *DeviceClaimingServer
+}
+
+// withAPIKey returns the call option to authenticate with the given API key.
+func (s *endDeviceClaimingServer) withAPIKey(key string) grpc.CallOption {
+	return grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     key,
+		AllowInsecure: s.DCS.AllowInsecureForCredentials(),
+	})
+}
+
+// dialTarget dials the target cluster with the given role at the given address.
+// The target end device is created through this connection when the target address differs from the source address.
+func (s *endDeviceClaimingServer) dialTarget(ctx context.Context, role ttnpb.ClusterRole, address string) (*grpc.ClientConn, error) {
+	target, err := discover.Address(role, address)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := s.DCS.GetTLSClientConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.DialContext(ctx, target, append(rpcclient.DefaultDialOptions(ctx),
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+	)...)
+}
+
+// AuthorizeApplication implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) AuthorizeApplication(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	cc, err := s.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_ACCESS, nil)
+	if err != nil {
+		return nil, err
+	}
+	keyRights, err := ttnpb.NewApplicationAccessClient(cc).ListRights(ctx, &req.ApplicationIdentifiers, s.withAPIKey(req.APIKey))
+	if err != nil {
+		return nil, err
+	}
+	if !keyRights.Implied().IncludesAll(authorizedApplicationRights...) {
+		return nil, errAPIKeyRights.WithAttributes("application_uid", unique.ID(ctx, req.ApplicationIdentifiers))
+	}
+	_, err = s.DCS.authorizedApplications.Set(ctx, req.ApplicationIdentifiers, nil,
+		func(*ttnpb.APIKey) (*ttnpb.APIKey, []string, error) {
+			return &ttnpb.APIKey{
+				Key:    req.APIKey,
+				Rights: keyRights.Rights,
+			}, []string{"key", "rights"}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtAuthorizeApplication.NewWithIdentifiersAndData(ctx, req.ApplicationIdentifiers, nil))
+	return ttnpb.Empty, nil
+}
+
+// UnauthorizeApplication implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) UnauthorizeApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, *ids, ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	_, err := s.DCS.authorizedApplications.Set(ctx, *ids, nil,
+		func(*ttnpb.APIKey) (*ttnpb.APIKey, []string, error) {
+			return nil, nil, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtUnauthorizeApplication.NewWithIdentifiersAndData(ctx, *ids, nil))
+	return ttnpb.Empty, nil
+}
+
+// authenticatedIdentifiers returns the authenticated end device identifiers of the source end device.
+func authenticatedIdentifiers(req *ttnpb.ClaimEndDeviceRequest) (joinEUI, devEUI types.EUI64, authenticationCode string, err error) {
+	if authIDs := req.GetAuthenticatedIdentifiers(); authIDs != nil {
+		return authIDs.JoinEUI, authIDs.DevEUI, authIDs.AuthenticationCode, nil
+	}
+	data, err := qrcode.Parse(req.GetQRCode())
+	if err != nil {
+		return types.EUI64{}, types.EUI64{}, "", err
+	}
+	authData, ok := data.(qrcode.AuthenticatedEndDeviceIdentifiers)
+	if !ok {
+		return types.EUI64{}, types.EUI64{}, "", errQRCodeData.New()
+	}
+	joinEUI, devEUI, authenticationCode = authData.AuthenticatedEndDeviceIdentifiers()
+	return joinEUI, devEUI, authenticationCode, nil
+}
+
+// validateAuthenticationCode validates the given value against the claim authentication code at the given time.
+func validateAuthenticationCode(code *ttnpb.EndDeviceAuthenticationCode, value string, now time.Time) error {
+	if code == nil || code.Value == "" {
+		return errNoAuthenticationCode.New()
+	}
+	if subtle.ConstantTimeCompare([]byte(code.Value), []byte(value)) != 1 {
+		return errAuthenticationCode.New()
+	}
+	if code.ValidFrom != nil && now.Before(*code.ValidFrom) ||
+		code.ValidTo != nil && now.After(*code.ValidTo) {
+		return errAuthenticationCodeValidity.New()
+	}
+	return nil
+}
+
+// getSource returns the source end device from the registry, or nil if it is not registered.
+func getSource(ctx context.Context, registry endDeviceRegistry, ids ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+	dev, err := registry.Get(ctx, ids, opts...)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	return dev, err
+}
+
+// copyEndDevice returns a copy of the given end device with the given paths and identifiers.
+func copyEndDevice(dev *ttnpb.EndDevice, ids ttnpb.EndDeviceIdentifiers, paths ...string) (*ttnpb.EndDevice, error) {
+	res := &ttnpb.EndDevice{}
+	if err := res.SetFields(dev, paths...); err != nil {
+		return nil, err
+	}
+	res.EndDeviceIdentifiers = ids
+	return res, nil
+}
+
+// Claim implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) Claim(ctx context.Context, req *ttnpb.ClaimEndDeviceRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	if err := rights.RequireApplication(ctx, req.TargetApplicationIDs,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	joinEUI, devEUI, authenticationCode, err := authenticatedIdentifiers(req)
+	if err != nil {
+		return nil, err
+	}
+	targetOpt, err := rpcmetadata.WithForwardedAuth(ctx, s.DCS.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+
+	isConn, err := s.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	sourceIDs, err := ttnpb.NewEndDeviceRegistryClient(isConn).GetIdentifiersForEUIs(ctx, &ttnpb.GetEndDeviceIdentifiersForEUIsRequest{
+		JoinEUI: joinEUI,
+		DevEUI:  devEUI,
+	}, targetOpt)
+	if err != nil {
+		return nil, err
+	}
+	if sourceIDs.ApplicationIdentifiers.Equal(req.TargetApplicationIDs) {
+		return nil, errSameApplication.WithAttributes("application_uid", unique.ID(ctx, req.TargetApplicationIDs))
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"source_application_uid", unique.ID(ctx, sourceIDs.ApplicationIdentifiers),
+		"source_device_id", sourceIDs.DeviceID,
+		"target_application_uid", unique.ID(ctx, req.TargetApplicationIDs),
+	))
+	ctx = log.NewContext(ctx, logger)
+
+	apiKey, err := s.DCS.authorizedApplications.Get(ctx, sourceIDs.ApplicationIdentifiers, []string{"key"})
+	if errors.IsNotFound(err) {
+		return nil, errApplicationNotAuthorized.WithAttributes("application_uid", unique.ID(ctx, sourceIDs.ApplicationIdentifiers))
+	} else if err != nil {
+		return nil, err
+	}
+	sourceOpt := s.withAPIKey(apiKey.Key)
+
+	is := isEndDeviceRegistry{client: ttnpb.NewEndDeviceRegistryClient(isConn)}
+	sourceIS, err := is.Get(ctx, *sourceIDs, sourceOpt)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAuthenticationCode(sourceIS.ClaimAuthenticationCode, authenticationCode, time.Now()); err != nil {
+		return nil, err
+	}
+
+	targetIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: req.TargetApplicationIDs,
+		DeviceID:               req.TargetDeviceID,
+		JoinEUI:                sourceIDs.JoinEUI,
+		DevEUI:                 sourceIDs.DevEUI,
+	}
+	if targetIDs.DeviceID == "" {
+		targetIDs.DeviceID = sourceIDs.DeviceID
+	}
+
+	targetIS, err := copyEndDevice(sourceIS, targetIDs, isEndDevicePaths...)
+	if err != nil {
+		return nil, err
+	}
+	targetIS.NetworkServerAddress = req.TargetNetworkServerAddress
+	targetIS.ApplicationServerAddress = req.TargetApplicationServerAddress
+	if req.InvalidateAuthenticationCode {
+		targetIS.ClaimAuthenticationCode = nil
+	}
+	steps := []*transferStep{
+		{
+			name:           "is",
+			sourceRegistry: is,
+			targetRegistry: is,
+			source:         sourceIS,
+			target:         targetIS,
+		},
+	}
+
+	for _, r := range []struct {
+		name          string
+		role          ttnpb.ClusterRole
+		sourceAddress string
+		targetAddress string
+		newClient     func(*grpc.ClientConn) setEndDeviceRegistryClient
+		paths         []string
+		setTarget     func(*ttnpb.EndDevice)
+	}{
+		{
+			name:          "js",
+			role:          ttnpb.ClusterRole_JOIN_SERVER,
+			sourceAddress: sourceIS.JoinServerAddress,
+			targetAddress: sourceIS.JoinServerAddress,
+			newClient: func(cc *grpc.ClientConn) setEndDeviceRegistryClient {
+				return ttnpb.NewJsEndDeviceRegistryClient(cc)
+			},
+			paths: jsEndDevicePaths,
+			setTarget: func(dev *ttnpb.EndDevice) {
+				dev.NetworkServerAddress = req.TargetNetworkServerAddress
+				dev.NetworkServerKEKLabel = req.TargetNetworkServerKEKLabel
+				dev.ApplicationServerAddress = req.TargetApplicationServerAddress
+				dev.ApplicationServerKEKLabel = req.TargetApplicationServerKEKLabel
+				dev.ApplicationServerID = req.TargetApplicationServerID
+				dev.NetID = req.TargetNetID
+			},
+		},
+		{
+			name:          "ns",
+			role:          ttnpb.ClusterRole_NETWORK_SERVER,
+			sourceAddress: sourceIS.NetworkServerAddress,
+			targetAddress: req.TargetNetworkServerAddress,
+			newClient: func(cc *grpc.ClientConn) setEndDeviceRegistryClient {
+				return ttnpb.NewNsEndDeviceRegistryClient(cc)
+			},
+			paths: nsEndDevicePaths,
+		},
+		{
+			name:          "as",
+			role:          ttnpb.ClusterRole_APPLICATION_SERVER,
+			sourceAddress: sourceIS.ApplicationServerAddress,
+			targetAddress: req.TargetApplicationServerAddress,
+			newClient: func(cc *grpc.ClientConn) setEndDeviceRegistryClient {
+				return ttnpb.NewAsEndDeviceRegistryClient(cc)
+			},
+			paths: asEndDevicePaths,
+		},
+	} {
+		if r.sourceAddress == "" {
+			continue
+		}
+		cc, err := s.DCS.GetPeerConn(ctx, r.role, sourceIDs)
+		if err != nil {
+			return nil, err
+		}
+		sourceRegistry := setEndDeviceRegistry{
+			client: r.newClient(cc),
+			paths:  r.paths,
+		}
+		source, err := getSource(ctx, sourceRegistry, *sourceIDs, sourceOpt)
+		if err != nil {
+			return nil, err
+		}
+		if source == nil {
+			continue
+		}
+		step := &transferStep{
+			name:           r.name,
+			sourceRegistry: sourceRegistry,
+			targetRegistry: sourceRegistry,
+			source:         source,
+		}
+		if r.targetAddress != "" {
+			if r.targetAddress != r.sourceAddress {
+				cc, err := s.dialTarget(ctx, r.role, r.targetAddress)
+				if err != nil {
+					return nil, err
+				}
+				defer cc.Close()
+				step.targetRegistry = setEndDeviceRegistry{
+					client: r.newClient(cc),
+					paths:  r.paths,
+				}
+			}
+			if step.target, err = copyEndDevice(source, targetIDs, r.paths...); err != nil {
+				return nil, err
+			}
+			if r.setTarget != nil {
+				r.setTarget(step.target)
+			}
+		}
+		steps = append(steps, step)
+	}
+
+	t := &transfer{
+		steps:      steps,
+		sourceOpts: []grpc.CallOption{sourceOpt},
+		targetOpts: []grpc.CallOption{targetOpt},
+	}
+	if err := t.run(ctx, log.NewContext(s.DCS.Context(), logger)); err != nil {
+		logger.WithError(err).Warn("Failed to transfer claimed end device")
+		return nil, err
+	}
+	logger.WithField("target_device_id", targetIDs.DeviceID).Info("Claimed end device")
+	events.Publish(evtClaimEndDevice.NewWithIdentifiersAndData(ctx, targetIDs, sourceIDs))
+	return &targetIDs, nil
+}
@@ -0,0 +1,179 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	. "go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestClaim(t *testing.T) {
+	var (
+		joinEUI = types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+		devEUI  = types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x43}
+
+		sourceAppIDs = ttnpb.ApplicationIdentifiers{ApplicationID: "source-app"}
+		sourceIDs    = ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: sourceAppIDs,
+			DeviceID:               "source-dev",
+			JoinEUI:                &joinEUI,
+			DevEUI:                 &devEUI,
+		}
+		targetAppIDs = ttnpb.ApplicationIdentifiers{ApplicationID: "target-app"}
+		targetIDs    = ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: targetAppIDs,
+			DeviceID:               "target-dev",
+			JoinEUI:                &joinEUI,
+			DevEUI:                 &devEUI,
+		}
+	)
+
+	for _, tc := range []struct {
+		Name               string
+		AuthenticationCode string
+		RejectTarget       bool
+		ErrorAssertion     func(error) bool
+	}{
+		{
+			Name:               "Success",
+			AuthenticationCode: "ABCD",
+		},
+		{
+			Name:               "InvalidAuthenticationCode",
+			AuthenticationCode: "ABCE",
+			ErrorAssertion:     errors.IsPermissionDenied,
+		},
+		{
+			Name:               "Rollback",
+			AuthenticationCode: "ABCD",
+			RejectTarget:       true,
+			ErrorAssertion:     errors.IsAborted,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+			is, isAddr := startMockIS(ctx)
+			is.addApplication(ctx, sourceAppIDs, "source-key")
+			is.addApplication(ctx, targetAppIDs, "target-key")
+			as, asAddr := startMockAS(ctx)
+			if tc.RejectTarget {
+				as.reject = unique.ID(ctx, targetIDs)
+			}
+
+			is.devices[unique.ID(ctx, sourceIDs)] = &ttnpb.EndDevice{
+				EndDeviceIdentifiers:     sourceIDs,
+				Name:                     "source",
+				ApplicationServerAddress: asAddr,
+				ClaimAuthenticationCode: &ttnpb.EndDeviceAuthenticationCode{
+					Value: "ABCD",
+				},
+			}
+			as.devices[unique.ID(ctx, sourceIDs)] = &ttnpb.EndDevice{
+				EndDeviceIdentifiers: sourceIDs,
+			}
+
+			c := componenttest.NewComponent(t, &component.Config{
+				ServiceBase: config.ServiceBase{
+					GRPC: config.GRPC{
+						Listen:                      ":0",
+						AllowInsecureForCredentials: true,
+					},
+					Cluster: cluster.Config{
+						IdentityServer:    isAddr,
+						ApplicationServer: asAddr,
+					},
+				},
+			})
+			_, err := New(c, &Config{
+				AuthorizedApplications: &authorizedApplicationRegistry{
+					keys: map[string]*ttnpb.APIKey{
+						unique.ID(ctx, sourceAppIDs): {
+							Key: "source-key",
+						},
+					},
+				},
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			componenttest.StartComponent(t, c)
+			defer c.Close()
+
+			mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+			mustHavePeer(ctx, c, ttnpb.ClusterRole_APPLICATION_SERVER)
+
+			client := ttnpb.NewEndDeviceClaimingServerClient(c.LoopbackConn())
+			ids, err := client.Claim(ctx, &ttnpb.ClaimEndDeviceRequest{
+				SourceDevice: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers_{
+					AuthenticatedIdentifiers: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers{
+						JoinEUI:            joinEUI,
+						DevEUI:             devEUI,
+						AuthenticationCode: tc.AuthenticationCode,
+					},
+				},
+				TargetApplicationIDs:           targetAppIDs,
+				TargetDeviceID:                 targetIDs.DeviceID,
+				TargetApplicationServerAddress: asAddr,
+			}, grpc.PerRPCCredentials(rpcmetadata.MD{
+				AuthType:      "Bearer",
+				AuthValue:     "target-key",
+				AllowInsecure: true,
+			}))
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+			} else if a.So(err, should.BeNil) {
+				a.So(*ids, should.Resemble, targetIDs)
+			}
+
+			for name, store := range map[string]*endDeviceStore{
+				"is": &is.endDeviceStore,
+				"as": &as.endDeviceStore,
+			} {
+				_, err := store.get(ctx, sourceIDs)
+				if tc.ErrorAssertion != nil {
+					a.So(err, should.BeNil)
+				} else {
+					a.So(errors.IsNotFound(err), should.BeTrue)
+				}
+				target, err := store.get(ctx, targetIDs)
+				if tc.ErrorAssertion != nil {
+					a.So(errors.IsNotFound(err), should.BeTrue)
+				} else if a.So(err, should.BeNil) {
+					a.So(target.EndDeviceIdentifiers, should.Resemble, targetIDs)
+					if name == "is" {
+						a.So(target.Name, should.Equal, "source")
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the Device Claiming Server registries using Redis.
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/go-redis/redis/v7"
+	"github.com/gogo/protobuf/proto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errNotFound      = errors.DefineNotFound("not_found", "authorized application not found")
+	errDecryptAPIKey = errors.DefineCorruption("decrypt_api_key", "decrypt API key")
+)
+
+func applyAPIKeyFieldMask(dst, src *ttnpb.APIKey, paths ...string) (*ttnpb.APIKey, error) {
+	if dst == nil {
+		dst = &ttnpb.APIKey{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// AuthorizedApplicationRegistry is a store for the API keys of applications that are authorized for claiming.
+// If KEKLabel is set, the API keys are encrypted at rest with a random data key, which is wrapped using the KeyVault
+// and KEKLabel.
+type AuthorizedApplicationRegistry struct {
+	Redis    *ttnredis.Client
+	KeyVault crypto.KeyVault
+	KEKLabel string
+}
+
+func (r *AuthorizedApplicationRegistry) appKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// wrap returns the hash fields in which pb is stored.
+func (r *AuthorizedApplicationRegistry) wrap(ctx context.Context, pb *ttnpb.APIKey) ([]interface{}, error) {
+	b, err := proto.Marshal(pb)
+	if err != nil {
+		return nil, err
+	}
+	value, dataKey, err := cryptoutil.WrapBytes(ctx, b, r.KEKLabel, r.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+	if dataKey == nil {
+		return []interface{}{"value", value}, nil
+	}
+	dataKeyBytes, err := proto.Marshal(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{"data_key", dataKeyBytes, "value", value}, nil
+}
+
+// get returns the API key stored in the hash at k.
+// If no API key is stored, get returns nil.
+func (r *AuthorizedApplicationRegistry) get(ctx context.Context, c redis.Cmdable, k string) (*ttnpb.APIKey, error) {
+	fields, err := c.HGetAll(k).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	var dataKey *ttnpb.KeyEnvelope
+	if dataKeyBytes, ok := fields["data_key"]; ok {
+		dataKey = &ttnpb.KeyEnvelope{}
+		if err := proto.Unmarshal([]byte(dataKeyBytes), dataKey); err != nil {
+			return nil, errDecryptAPIKey.WithCause(err)
+		}
+	}
+	b, err := cryptoutil.UnwrapBytes(ctx, []byte(fields["value"]), dataKey, r.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+	pb := &ttnpb.APIKey{}
+	if err := proto.Unmarshal(b, pb); err != nil {
+		return nil, errDecryptAPIKey.WithCause(err)
+	}
+	return pb, nil
+}
+
+// Get returns the API key of the authorized application.
+func (r *AuthorizedApplicationRegistry) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) (*ttnpb.APIKey, error) {
+	defer trace.StartRegion(ctx, "get authorized application").End()
+
+	pb, err := r.get(ctx, r.Redis, r.appKey(unique.ID(ctx, ids)))
+	if err != nil {
+		return nil, err
+	}
+	if pb == nil {
+		return nil, errNotFound.New()
+	}
+	return applyAPIKeyFieldMask(nil, pb, paths...)
+}
+
+// Set creates, updates or deletes the API key of the authorized application.
+func (r *AuthorizedApplicationRegistry) Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, gets []string, f func(*ttnpb.APIKey) (*ttnpb.APIKey, []string, error)) (*ttnpb.APIKey, error) {
+	defer trace.StartRegion(ctx, "set authorized application").End()
+
+	uk := r.appKey(unique.ID(ctx, ids))
+
+	var pb *ttnpb.APIKey
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		stored, err := r.get(ctx, tx, uk)
+		if err != nil {
+			return err
+		}
+
+		if stored != nil {
+			pb, err = applyAPIKeyFieldMask(nil, stored, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = applyAPIKeyFieldMask(nil, stored, gets...)
+			return err
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if pb == nil && len(sets) == 0 {
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(uk)
+				return nil
+			}
+		} else {
+			if pb == nil {
+				pb = &ttnpb.APIKey{}
+			}
+
+			updated := &ttnpb.APIKey{}
+			if stored != nil {
+				updated = stored
+			}
+			updated, err = applyAPIKeyFieldMask(updated, pb, sets...)
+			if err != nil {
+				return err
+			}
+			if err := updated.ValidateFields(sets...); err != nil {
+				return err
+			}
+			fields, err := r.wrap(ctx, updated)
+			if err != nil {
+				return err
+			}
+
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(uk)
+				p.HSet(uk, fields...)
+				return nil
+			}
+			pb, err = applyAPIKeyFieldMask(nil, updated, gets...)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.TxPipelined(pipelined)
+		return err
+	}, uk)
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
@@ -0,0 +1,113 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestAuthorizedApplicationRegistry(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		KEKLabel string
+	}{
+		{
+			Name: "Plain",
+		},
+		{
+			Name:     "Encrypted",
+			KEKLabel: "test",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := test.Context()
+
+			cl, flush := test.NewRedis(t, "deviceclaimingserver_test", tc.Name)
+			defer flush()
+			defer cl.Close()
+
+			registry := &AuthorizedApplicationRegistry{
+				Redis: cl,
+				KeyVault: cryptoutil.NewMemKeyVault(map[string][]byte{
+					"test": {0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf},
+				}),
+				KEKLabel: tc.KEKLabel,
+			}
+
+			ids := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+			apiKey := &ttnpb.APIKey{
+				Key: "NNSXS.TESTKEY",
+				Rights: []ttnpb.Right{
+					ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+					ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+				},
+			}
+
+			_, err := registry.Get(ctx, ids, []string{"key", "rights"})
+			a.So(errors.IsNotFound(err), should.BeTrue)
+
+			// Setting nothing when there is no stored value does not store anything.
+			ret, err := registry.Set(ctx, ids, []string{"key", "rights"}, func(stored *ttnpb.APIKey) (*ttnpb.APIKey, []string, error) {
+				a.So(stored, should.BeNil)
+				return nil, nil, nil
+			})
+			a.So(err, should.BeNil)
+			a.So(ret, should.BeNil)
+			_, err = registry.Get(ctx, ids, []string{"key", "rights"})
+			a.So(errors.IsNotFound(err), should.BeTrue)
+
+			ret, err = registry.Set(ctx, ids, []string{"key", "rights"}, func(stored *ttnpb.APIKey) (*ttnpb.APIKey, []string, error) {
+				a.So(stored, should.BeNil)
+				return apiKey, []string{"key", "rights"}, nil
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(ret, should.Resemble, apiKey)
+
+			fields, err := cl.HGetAll(registry.appKey(unique.ID(ctx, ids))).Result()
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			_, hasDataKey := fields["data_key"]
+			a.So(hasDataKey, should.Equal, tc.KEKLabel != "")
+			a.So(bytes.Contains([]byte(fields["value"]), []byte(apiKey.Key)), should.Equal, tc.KEKLabel == "")
+
+			ret, err = registry.Get(ctx, ids, []string{"key", "rights"})
+			a.So(err, should.BeNil)
+			a.So(ret, should.Resemble, apiKey)
+
+			ret, err = registry.Set(ctx, ids, []string{"key", "rights"}, func(stored *ttnpb.APIKey) (*ttnpb.APIKey, []string, error) {
+				a.So(stored, should.Resemble, apiKey)
+				return nil, nil, nil
+			})
+			a.So(err, should.BeNil)
+			a.So(ret, should.BeNil)
+
+			_, err = registry.Get(ctx, ids, []string{"key", "rights"})
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// transferPaths returns the top level end device fields that can be read with the get RPC and written with the set RPC.
+func transferPaths(get, set string) []string {
+	return ttnpb.ExcludeFields(
+		ttnpb.AllowedFields(
+			ttnpb.TopLevelFields(ttnpb.AllowedFieldMaskPathsForRPC[get]),
+			ttnpb.AllowedFieldMaskPathsForRPC[set],
+		),
+		"ids",
+		"created_at",
+		"updated_at",
+	)
+}
+
+var (
+	isEndDevicePaths = transferPaths("/ttn.lorawan.v3.EndDeviceRegistry/Get", "/ttn.lorawan.v3.EndDeviceRegistry/Update")
+	nsEndDevicePaths = transferPaths("/ttn.lorawan.v3.NsEndDeviceRegistry/Get", "/ttn.lorawan.v3.NsEndDeviceRegistry/Set")
+	asEndDevicePaths = transferPaths("/ttn.lorawan.v3.AsEndDeviceRegistry/Get", "/ttn.lorawan.v3.AsEndDeviceRegistry/Set")
+	jsEndDevicePaths = transferPaths("/ttn.lorawan.v3.JsEndDeviceRegistry/Get", "/ttn.lorawan.v3.JsEndDeviceRegistry/Set")
+)
+
+// endDeviceRegistry is an end device registry that takes part in the transfer of a claimed end device.
+type endDeviceRegistry interface {
+	Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) (*ttnpb.EndDevice, error)
+	Create(ctx context.Context, dev *ttnpb.EndDevice, opts ...grpc.CallOption) (*ttnpb.EndDevice, error)
+	Delete(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) error
+}
+
+// isEndDeviceRegistry is the end device registry of the Identity Server.
+type isEndDeviceRegistry struct {
+	client ttnpb.EndDeviceRegistryClient
+}
+
+func (r isEndDeviceRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+	return r.client.Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+		FieldMask:            pbtypes.FieldMask{Paths: isEndDevicePaths},
+	}, opts...)
+}
+
+func (r isEndDeviceRegistry) Create(ctx context.Context, dev *ttnpb.EndDevice, opts ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+	return r.client.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+		EndDevice: *dev,
+	}, opts...)
+}
+
+func (r isEndDeviceRegistry) Delete(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) error {
+	_, err := r.client.Delete(ctx, &ids, opts...)
+	return err
+}
+
+// setEndDeviceRegistryClient is the client of the end device registries of the Network Server, Application Server and
+// Join Server.
+type setEndDeviceRegistryClient interface {
+	Get(ctx context.Context, in *ttnpb.GetEndDeviceRequest, opts ...grpc.CallOption) (*ttnpb.EndDevice, error)
+	Set(ctx context.Context, in *ttnpb.SetEndDeviceRequest, opts ...grpc.CallOption) (*ttnpb.EndDevice, error)
+	Delete(ctx context.Context, in *ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) (*pbtypes.Empty, error)
+}
+
+// setEndDeviceRegistry is the end device registry of the Network Server, Application Server or Join Server.
+type setEndDeviceRegistry struct {
+	client setEndDeviceRegistryClient
+	paths  []string
+}
+
+func (r setEndDeviceRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+	return r.client.Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+		FieldMask:            pbtypes.FieldMask{Paths: r.paths},
+	}, opts...)
+}
+
+func (r setEndDeviceRegistry) Create(ctx context.Context, dev *ttnpb.EndDevice, opts ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+	return r.client.Set(ctx, &ttnpb.SetEndDeviceRequest{
+		EndDevice: *dev,
+		FieldMask: pbtypes.FieldMask{Paths: r.paths},
+	}, opts...)
+}
+
+func (r setEndDeviceRegistry) Delete(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) error {
+	_, err := r.client.Delete(ctx, &ids, opts...)
+	return err
+}
+
+// transferStep is the transfer of a claimed end device in a single end device registry.
+type transferStep struct {
+	// name is the name of the registry.
+	name string
+	// sourceRegistry is the registry of the source end device.
+	sourceRegistry endDeviceRegistry
+	// targetRegistry is the registry of the target end device.
+	targetRegistry endDeviceRegistry
+	// source is the source end device. If nil, the source end device is not registered in the registry.
+	source *ttnpb.EndDevice
+	// target is the target end device. If nil, the target end device shall not be registered in the registry.
+	target *ttnpb.EndDevice
+}
+
+// transfer transfers a claimed end device across the end device registries.
+// The steps are ordered in which the end devices are created, i.e. the Identity Server first.
+type transfer struct {
+	steps []*transferStep
+	// sourceOpts are the call options to delete and restore the source end device.
+	sourceOpts []grpc.CallOption
+	// targetOpts are the call options to create and roll back the target end device.
+	targetOpts []grpc.CallOption
+}
+
+var (
+	errDeleteSource = errors.DefineAborted("delete_source", "delete source end device from `{registry}`")
+	errCreateTarget = errors.DefineAborted("create_target", "create target end device in `{registry}`")
+)
+
+// run deletes the source end device from the registries in reverse order, and then creates the target end device in
+// the registries. If any step fails, the completed steps are rolled back using rollbackCtx: the created target end
+// devices are deleted and the deleted source end devices are restored.
+func (t *transfer) run(ctx, rollbackCtx context.Context) (err error) {
+	var deleted, created []*transferStep
+	defer func() {
+		if err == nil {
+			return
+		}
+		logger := log.FromContext(ctx).WithField("cause", err)
+		for i := len(created) - 1; i >= 0; i-- {
+			s := created[i]
+			if err := s.targetRegistry.Delete(rollbackCtx, s.target.EndDeviceIdentifiers, t.targetOpts...); err != nil {
+				logger.WithError(err).WithField("registry", s.name).Error("Failed to roll back creation of target end device")
+			}
+		}
+		for i := len(deleted) - 1; i >= 0; i-- {
+			s := deleted[i]
+			if _, err := s.sourceRegistry.Create(rollbackCtx, s.source, t.sourceOpts...); err != nil {
+				logger.WithError(err).WithField("registry", s.name).Error("Failed to restore source end device")
+			}
+		}
+	}()
+	for i := len(t.steps) - 1; i >= 0; i-- {
+		s := t.steps[i]
+		if s.source == nil {
+			continue
+		}
+		if err := s.sourceRegistry.Delete(ctx, s.source.EndDeviceIdentifiers, t.sourceOpts...); err != nil {
+			return errDeleteSource.WithCause(err).WithAttributes("registry", s.name)
+		}
+		deleted = append(deleted, s)
+	}
+	for _, s := range t.steps {
+		if s.target == nil {
+			continue
+		}
+		if _, err := s.targetRegistry.Create(ctx, s.target, t.targetOpts...); err != nil {
+			return errCreateTarget.WithCause(err).WithAttributes("registry", s.name)
+		}
+		created = append(created, s)
+	}
+	return nil
+}
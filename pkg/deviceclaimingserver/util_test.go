@@ -0,0 +1,229 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	errNotFound = errors.DefineNotFound("not_found", "not found")
+	errRejected = errors.DefineInvalidArgument("rejected", "rejected")
+)
+
+func mustHavePeer(ctx context.Context, c *component.Component, role ttnpb.ClusterRole) {
+	for i := 0; i < 20; i++ {
+		time.Sleep(20 * time.Millisecond)
+		if _, err := c.GetPeer(ctx, role, nil); err == nil {
+			return
+		}
+	}
+	panic("could not connect to peer")
+}
+
+func startServer(ctx context.Context, register func(*rpcserver.Server)) string {
+	srv := rpcserver.New(ctx)
+	register(srv)
+	lis, err := net.Listen("tcp", ":0")
+	if err != nil {
+		panic(err)
+	}
+	go srv.Serve(lis)
+	return lis.Addr().String()
+}
+
+// endDeviceStore is an in-memory store of end devices.
+type endDeviceStore struct {
+	mu      sync.Mutex
+	devices map[string]*ttnpb.EndDevice
+	// reject is the unique ID of the end device of which the creation is rejected.
+	reject string
+}
+
+func (s *endDeviceStore) get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDevice, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	dev, ok := s.devices[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	return dev, nil
+}
+
+func (s *endDeviceStore) create(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	uid := unique.ID(ctx, dev.EndDeviceIdentifiers)
+	if uid == s.reject {
+		return nil, errRejected.New()
+	}
+	s.devices[uid] = dev
+	return dev, nil
+}
+
+func (s *endDeviceStore) delete(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	if _, ok := s.devices[uid]; !ok {
+		return errNotFound.New()
+	}
+	delete(s.devices, uid)
+	return nil
+}
+
+type mockIS struct {
+	ttnpb.ApplicationAccessServer
+	ttnpb.EndDeviceRegistryServer
+	endDeviceStore
+	applicationAuths map[string]string
+}
+
+func startMockIS(ctx context.Context) (*mockIS, string) {
+	is := &mockIS{
+		endDeviceStore: endDeviceStore{
+			devices: make(map[string]*ttnpb.EndDevice),
+		},
+		applicationAuths: make(map[string]string),
+	}
+	return is, startServer(ctx, func(srv *rpcserver.Server) {
+		ttnpb.RegisterApplicationAccessServer(srv.Server, is)
+		ttnpb.RegisterEndDeviceRegistryServer(srv.Server, is)
+	})
+}
+
+func (is *mockIS) addApplication(ctx context.Context, ids ttnpb.ApplicationIdentifiers, key string) {
+	is.applicationAuths[unique.ID(ctx, ids)] = fmt.Sprintf("Bearer %v", key)
+}
+
+func (is *mockIS) ListRights(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*ttnpb.Rights, error) {
+	res := &ttnpb.Rights{}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return res, nil
+	}
+	authorization, ok := md["authorization"]
+	if !ok || len(authorization) == 0 {
+		return res, nil
+	}
+	if is.applicationAuths[unique.ID(ctx, *ids)] == authorization[0] {
+		res.Rights = []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL}
+	}
+	return res, nil
+}
+
+func (is *mockIS) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	return is.get(ctx, req.EndDeviceIdentifiers)
+}
+
+func (is *mockIS) GetIdentifiersForEUIs(ctx context.Context, req *ttnpb.GetEndDeviceIdentifiersForEUIsRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	is.mu.Lock()
+	defer is.mu.Unlock()
+	for _, dev := range is.devices {
+		if dev.JoinEUI != nil && dev.JoinEUI.Equal(req.JoinEUI) && dev.DevEUI != nil && dev.DevEUI.Equal(req.DevEUI) {
+			ids := dev.EndDeviceIdentifiers
+			return &ids, nil
+		}
+	}
+	return nil, errNotFound.New()
+}
+
+func (is *mockIS) Create(ctx context.Context, req *ttnpb.CreateEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	dev := req.EndDevice
+	return is.create(ctx, &dev)
+}
+
+func (is *mockIS) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+	if err := is.delete(ctx, *ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+type mockAS struct {
+	ttnpb.AsEndDeviceRegistryServer
+	endDeviceStore
+}
+
+func startMockAS(ctx context.Context) (*mockAS, string) {
+	as := &mockAS{
+		endDeviceStore: endDeviceStore{
+			devices: make(map[string]*ttnpb.EndDevice),
+		},
+	}
+	return as, startServer(ctx, func(srv *rpcserver.Server) {
+		ttnpb.RegisterAsEndDeviceRegistryServer(srv.Server, as)
+	})
+}
+
+func (as *mockAS) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	return as.get(ctx, req.EndDeviceIdentifiers)
+}
+
+func (as *mockAS) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	dev := req.EndDevice
+	return as.create(ctx, &dev)
+}
+
+func (as *mockAS) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+	if err := as.delete(ctx, *ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// authorizedApplicationRegistry is an in-memory AuthorizedApplicationRegistry.
+type authorizedApplicationRegistry struct {
+	mu   sync.Mutex
+	keys map[string]*ttnpb.APIKey
+}
+
+func (r *authorizedApplicationRegistry) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) (*ttnpb.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key, ok := r.keys[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	return key, nil
+}
+
+func (r *authorizedApplicationRegistry) Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string, f func(*ttnpb.APIKey) (*ttnpb.APIKey, []string, error)) (*ttnpb.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	key, _, err := f(r.keys[uid])
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		delete(r.keys, uid)
+		return nil, nil
+	}
+	r.keys[uid] = key
+	return key, nil
+}
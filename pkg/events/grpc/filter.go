@@ -0,0 +1,99 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"github.com/gobwas/glob"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errInvalidNamePattern = errors.DefineInvalidArgument("invalid_name_pattern", "invalid event name pattern `{pattern}`")
+
+// nameFilter filters events on their name and correlation IDs.
+type nameFilter struct {
+	names          []glob.Glob
+	excludeNames   []glob.Glob
+	correlationIDs map[string]struct{}
+}
+
+func compilePatterns(patterns []string) ([]glob.Glob, error) {
+	globs := make([]glob.Glob, 0, len(patterns))
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern, '.')
+		if err != nil {
+			return nil, errInvalidNamePattern.WithCause(err).WithAttributes("pattern", pattern)
+		}
+		globs = append(globs, g)
+	}
+	return globs, nil
+}
+
+func newNameFilter(req *ttnpb.StreamEventsRequest) (*nameFilter, error) {
+	names, err := compilePatterns(req.Names)
+	if err != nil {
+		return nil, err
+	}
+	excludeNames, err := compilePatterns(req.ExcludeNames)
+	if err != nil {
+		return nil, err
+	}
+	f := &nameFilter{
+		names:        names,
+		excludeNames: excludeNames,
+	}
+	if len(req.CorrelationIDs) > 0 {
+		f.correlationIDs = make(map[string]struct{}, len(req.CorrelationIDs))
+		for _, cid := range req.CorrelationIDs {
+			f.correlationIDs[cid] = struct{}{}
+		}
+	}
+	return f, nil
+}
+
+// IsZero returns whether the filter matches all events.
+func (f *nameFilter) IsZero() bool {
+	return len(f.names) == 0 && len(f.excludeNames) == 0 && len(f.correlationIDs) == 0
+}
+
+func matchAny(globs []glob.Glob, name string) bool {
+	for _, g := range globs {
+		if g.Match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Match returns whether the event matches the filter.
+func (f *nameFilter) Match(evt events.Event) bool {
+	name := evt.Name()
+	if len(f.names) > 0 && !matchAny(f.names, name) {
+		return false
+	}
+	if matchAny(f.excludeNames, name) {
+		return false
+	}
+	if len(f.correlationIDs) == 0 {
+		return true
+	}
+	for _, cid := range evt.CorrelationIDs() {
+		if _, ok := f.correlationIDs[cid]; ok {
+			return true
+		}
+	}
+	return false
+}
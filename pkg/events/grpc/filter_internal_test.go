@@ -0,0 +1,103 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNameFilter(t *testing.T) {
+	ctx := events.ContextWithCorrelationID(test.Context(), "cid:foo")
+
+	upReceive := events.New(ctx, "gs.up.receive", "test")
+	asUpForward := events.New(ctx, "as.up.forward", "test")
+	asUpDrop := events.New(ctx, "as.up.drop", "test")
+	nsMacADR := events.New(context.Background(), "ns.mac.adr.request", "test")
+
+	for _, tc := range []struct {
+		Name    string
+		Request *ttnpb.StreamEventsRequest
+		Matches []events.Event
+		IsZero  bool
+	}{
+		{
+			Name:    "Empty",
+			Request: &ttnpb.StreamEventsRequest{},
+			Matches: []events.Event{upReceive, asUpForward, asUpDrop, nsMacADR},
+			IsZero:  true,
+		},
+		{
+			Name: "Names",
+			Request: &ttnpb.StreamEventsRequest{
+				Names: []string{"as.up.*", "ns.mac.**"},
+			},
+			Matches: []events.Event{asUpForward, asUpDrop, nsMacADR},
+		},
+		{
+			Name: "SingleWildcard",
+			Request: &ttnpb.StreamEventsRequest{
+				Names: []string{"ns.mac.*"},
+			},
+		},
+		{
+			Name: "ExcludeNames",
+			Request: &ttnpb.StreamEventsRequest{
+				Names:        []string{"as.**"},
+				ExcludeNames: []string{"as.up.drop"},
+			},
+			Matches: []events.Event{asUpForward},
+		},
+		{
+			Name: "CorrelationIDs",
+			Request: &ttnpb.StreamEventsRequest{
+				ExcludeNames:   []string{"gs.**"},
+				CorrelationIDs: []string{"cid:foo", "cid:bar"},
+			},
+			Matches: []events.Event{asUpForward, asUpDrop},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			filter, err := newNameFilter(tc.Request)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(filter.IsZero(), should.Equal, tc.IsZero)
+			var matches []events.Event
+			for _, evt := range []events.Event{upReceive, asUpForward, asUpDrop, nsMacADR} {
+				if filter.Match(evt) {
+					matches = append(matches, evt)
+				}
+			}
+			a.So(matches, should.Resemble, tc.Matches)
+		})
+	}
+
+	t.Run("InvalidPattern", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := newNameFilter(&ttnpb.StreamEventsRequest{
+			Names: []string{"as.up.[a"},
+		})
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+}
@@ -98,24 +98,69 @@ var errNoIdentifiers = errors.DefineInvalidArgument("no_identifiers", "no identi
 
 // Stream implements the EventsServer interface.
 func (srv *EventsServer) Stream(req *ttnpb.StreamEventsRequest, stream ttnpb.Events_StreamServer) error {
-	if len(req.Identifiers) == 0 {
-		return errNoIdentifiers
-	}
 	ctx := stream.Context()
 
-	if err := rights.RequireAny(ctx, req.Identifiers...); err != nil {
+	filter, err := newNameFilter(req)
+	if err != nil {
 		return err
 	}
 
-	srv.subscribe()
+	// Without identifiers, the events with the requested names of all entities are streamed to admins.
+	clusterWide := len(req.Identifiers) == 0
+	if clusterWide {
+		if len(req.Names) == 0 {
+			return errNoIdentifiers.New()
+		}
+		if err := rights.RequireIsAdmin(ctx); err != nil {
+			return err
+		}
+	} else if err := rights.RequireAny(ctx, req.Identifiers...); err != nil {
+		return err
+	}
 
 	ch := make(events.Channel, 8)
 	handler := events.ContextHandler(ctx, ch)
-	srv.filter.Subscribe(ctx, req, handler)
-	defer srv.filter.Unsubscribe(ctx, req, handler)
+	if !filter.IsZero() {
+		contextHandler := handler
+		handler = events.HandlerFunc(func(evt events.Event) {
+			if filter.Match(evt) {
+				contextHandler.Notify(evt)
+			}
+		})
+	}
+	if clusterWide {
+		if err := srv.pubsub.Subscribe("**", handler); err != nil {
+			return err
+		}
+		defer srv.pubsub.Unsubscribe("**", handler)
+	} else {
+		srv.subscribe()
+		srv.filter.Subscribe(ctx, req, handler)
+		defer srv.filter.Unsubscribe(ctx, req, handler)
+	}
 
+	var history []events.Event
 	if req.Tail > 0 || req.After != nil {
-		warning.Add(ctx, "Historical events not implemented")
+		if store, ok := srv.pubsub.(events.Store); ok && !clusterWide {
+			tail := int(req.Tail)
+			if !filter.IsZero() {
+				// Apply the tail after filtering the events.
+				tail = 0
+			}
+			history, err = store.FetchHistory(ctx, req.Identifiers, req.After, tail)
+			if err != nil {
+				return err
+			}
+			matched := history[:0]
+			for _, evt := range history {
+				if filter.Match(evt) {
+					matched = append(matched, evt)
+				}
+			}
+			history = events.SortHistory(matched, nil, int(req.Tail))
+		} else {
+			warning.Add(ctx, "Historical events not available")
+		}
 	}
 
 	if err := stream.SendHeader(metadata.MD{}); err != nil {
@@ -137,20 +182,54 @@ func (srv *EventsServer) Stream(req *ttnpb.StreamEventsRequest, stream ttnpb.Eve
 		return err
 	}
 
+	// Historical events may also be received from the live subscription, which is started before fetching the history.
+	sent := make(map[string]struct{}, len(history))
+	visible := func(evt events.Event) (bool, error) {
+		if clusterWide {
+			return true, nil
+		}
+		return rightsutil.EventIsVisible(ctx, evt)
+	}
+	for _, evt := range history {
+		isVisible, err := visible(evt)
+		if err != nil {
+			return err
+		}
+		if !isVisible {
+			continue
+		}
+		proto, err := events.Proto(evt)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(proto); err != nil {
+			return err
+		}
+		sent[evt.UniqueID()] = struct{}{}
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		case evt := <-ch:
-			isVisible, err := rightsutil.EventIsVisible(ctx, evt)
+			if _, ok := sent[evt.UniqueID()]; ok {
+				continue
+			}
+			isVisible, err := visible(evt)
 			if err != nil {
 				return err
 			}
 			if !isVisible {
 				continue
 			}
-			marshaled := evt.(marshaledEvent)
-			if err := stream.Send(marshaled.proto); err != nil {
+			var proto *ttnpb.Event
+			if marshaled, ok := evt.(marshaledEvent); ok {
+				proto = marshaled.proto
+			} else if proto, err = events.Proto(evt); err != nil {
+				return err
+			}
+			if err := stream.Send(proto); err != nil {
 				return err
 			}
 		}
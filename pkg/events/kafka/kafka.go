@@ -0,0 +1,234 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kafka implements an events.PubSub implementation that uses Kafka.
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"os"
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errConfig = errors.DefineInvalidArgument("config", "invalid Kafka configuration")
+
+func newConfig(conf config.KafkaEvents) (*sarama.Config, error) {
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.Version = sarama.V1_0_0_0
+	saramaConfig.ClientID = "ttn-lw-stack"
+	// Use the entity key to partition the events, such that the order of events is preserved per entity.
+	saramaConfig.Producer.Partitioner = sarama.NewHashPartitioner
+	saramaConfig.Producer.Return.Errors = true
+	saramaConfig.Consumer.Return.Errors = true
+	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetNewest
+	if conf.SASL.Enable {
+		if err := configureSASL(saramaConfig, sarama.SASLMechanism(conf.SASL.Mechanism), conf.SASL.Username, conf.SASL.Password); err != nil {
+			return nil, err
+		}
+	}
+	if conf.TLS.Enable {
+		tlsConfig := &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+		if err := conf.TLS.Client.ApplyTo(tlsConfig); err != nil {
+			return nil, err
+		}
+		saramaConfig.Net.TLS.Enable = true
+		saramaConfig.Net.TLS.Config = tlsConfig
+	}
+	if err := saramaConfig.Validate(); err != nil {
+		return nil, errConfig.WithCause(err)
+	}
+	return saramaConfig, nil
+}
+
+// WrapPubSub wraps an existing PubSub and publishes all events received from Kafka to that PubSub.
+// Events are consumed with the configured consumer group, of which the offsets are committed to Kafka.
+// Each instance should use a different consumer group in order to receive all events.
+func WrapPubSub(ctx context.Context, wrapped events.PubSub, taskStarter component.TaskStarter, conf config.KafkaEvents) (ps *PubSub, err error) {
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"namespace", "events/kafka",
+		"topic", conf.Topic,
+	))
+	saramaConfig, err := newConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+	if conf.ConsumerGroup == "" {
+		if conf.ConsumerGroup, err = os.Hostname(); err != nil {
+			return nil, err
+		}
+	}
+	producer, err := sarama.NewAsyncProducer(conf.Brokers, saramaConfig)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	ps = &PubSub{
+		PubSub:       wrapped,
+		taskStarter:  taskStarter,
+		ctx:          ctx,
+		cancel:       cancel,
+		conf:         conf,
+		saramaConfig: saramaConfig,
+		producer:     producer,
+	}
+	go ps.handleProducerErrors()
+	return ps, nil
+}
+
+// NewPubSub creates a new PubSub that publishes and subscribes to Kafka.
+func NewPubSub(ctx context.Context, taskStarter component.TaskStarter, conf config.KafkaEvents) (*PubSub, error) {
+	return WrapPubSub(ctx, events.NewPubSub(events.DefaultBufferSize), taskStarter, conf)
+}
+
+// PubSub with Kafka backend.
+type PubSub struct {
+	events.PubSub
+
+	taskStarter  component.TaskStarter
+	ctx          context.Context
+	cancel       context.CancelFunc
+	conf         config.KafkaEvents
+	saramaConfig *sarama.Config
+	producer     sarama.AsyncProducer
+	subOnce      sync.Once
+}
+
+func (ps *PubSub) handleProducerErrors() {
+	logger := log.FromContext(ps.ctx)
+	for err := range ps.producer.Errors() {
+		logger.WithError(err.Err).Warn("Failed to publish event")
+	}
+}
+
+// Close the Kafka publisher.
+func (ps *PubSub) Close(ctx context.Context) error {
+	ps.cancel()
+	return ps.producer.Close()
+}
+
+// consumerGroupHandler implements sarama.ConsumerGroupHandler.
+type consumerGroupHandler struct {
+	ps *PubSub
+}
+
+func (consumerGroupHandler) Setup(sarama.ConsumerGroupSession) error   { return nil }
+func (consumerGroupHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h consumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	logger := log.FromContext(h.ps.ctx)
+	for msg := range claim.Messages() {
+		session.MarkMessage(msg, "")
+		var pb ttnpb.Event
+		if err := pb.Unmarshal(msg.Value); err != nil {
+			logger.WithError(err).Warn("Failed to unmarshal event from binary")
+			continue
+		}
+		evt, err := events.FromProto(&pb)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to unmarshal event from protobuf")
+			continue
+		}
+		h.ps.PubSub.Publish(evt)
+	}
+	return nil
+}
+
+func (ps *PubSub) subscribeTask(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithField("consumer_group", ps.conf.ConsumerGroup)
+	group, err := sarama.NewConsumerGroup(ps.conf.Brokers, ps.conf.ConsumerGroup, ps.saramaConfig)
+	if err != nil {
+		return err
+	}
+	logger.Info("Subscribed")
+	defer func() {
+		if err := group.Close(); err != nil {
+			logger.WithError(err).Warn("Failed to close Kafka consumer group")
+		} else {
+			logger.Info("Unsubscribed")
+		}
+	}()
+	go func() {
+		for err := range group.Errors() {
+			logger.WithError(err).Warn("Kafka consumer group error")
+		}
+	}()
+	for {
+		// Consume returns when the consumer group is rebalanced, after which the claims are consumed again.
+		if err := group.Consume(ctx, []string{ps.conf.Topic}, consumerGroupHandler{ps: ps}); err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// Subscribe to events from Kafka.
+func (ps *PubSub) Subscribe(name string, hdl events.Handler) error {
+	ps.subOnce.Do(func() {
+		ps.taskStarter.StartTask(&component.TaskConfig{
+			Context: ps.ctx,
+			ID:      "events_kafka_subscribe",
+			Func:    ps.subscribeTask,
+			Restart: component.TaskRestartOnFailure,
+			Backoff: component.DefaultTaskBackoffConfig,
+		})
+	})
+	return ps.PubSub.Subscribe(name, hdl)
+}
+
+// partitionKey returns the key that is used to partition the event.
+// Events are partitioned by their first entity, such that the order of events is preserved per entity.
+func partitionKey(evt events.Event) sarama.Encoder {
+	ids := evt.Identifiers()
+	if len(ids) == 0 {
+		return nil
+	}
+	return sarama.StringEncoder(events.EntityKey(evt.Context(), ids[0]))
+}
+
+// Publish an event to Kafka.
+func (ps *PubSub) Publish(evt events.Event) {
+	logger := log.FromContext(ps.ctx)
+	evtpb, err := events.Proto(evt)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal event to protobuf")
+		return
+	}
+	body, err := evtpb.Marshal()
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal event to binary")
+		return
+	}
+	msg := &sarama.ProducerMessage{
+		Topic: ps.conf.Topic,
+		Key:   partitionKey(evt),
+		Value: sarama.ByteEncoder(body),
+	}
+	select {
+	case <-ps.ctx.Done():
+	case ps.producer.Input() <- msg:
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestPartitionKey(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	devIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		DeviceID:               "foo-dev",
+	}
+	gtwIDs := ttnpb.GatewayIdentifiers{GatewayID: "foo-gtw"}
+
+	a.So(partitionKey(events.New(ctx, "test", "test")), should.BeNil)
+	a.So(partitionKey(events.New(ctx, "test", "test", events.WithIdentifiers(devIDs, gtwIDs))), should.Equal, sarama.StringEncoder("end device:foo-app.foo-dev"))
+	a.So(partitionKey(events.New(ctx, "test", "test", events.WithIdentifiers(gtwIDs))), should.Equal, sarama.StringEncoder("gateway:foo-gtw"))
+}
+
+func TestNewConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		conf           func(*config.KafkaEvents)
+		assertion      func(*assertions.Assertion, *sarama.Config)
+		errorAssertion func(error) bool
+	}{
+		{
+			name: "Plain",
+			conf: func(*config.KafkaEvents) {},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.SASL.Enable, should.BeFalse)
+				a.So(config.Net.TLS.Enable, should.BeFalse)
+			},
+		},
+		{
+			name: "SASLSCRAMSHA256",
+			conf: func(conf *config.KafkaEvents) {
+				conf.SASL.Enable = true
+				conf.SASL.Mechanism = "SCRAM-SHA-256"
+				conf.SASL.Username = "user"
+				conf.SASL.Password = "pass"
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.SASL.Enable, should.BeTrue)
+				a.So(config.Net.SASL.Mechanism, should.Equal, sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA256))
+				a.So(config.Net.SASL.User, should.Equal, "user")
+				a.So(config.Net.SASL.Password, should.Equal, "pass")
+				a.So(config.Net.SASL.SCRAMClientGeneratorFunc, should.NotBeNil)
+			},
+		},
+		{
+			name: "SASLUnknownMechanism",
+			conf: func(conf *config.KafkaEvents) {
+				conf.SASL.Enable = true
+				conf.SASL.Mechanism = "GSSAPI"
+			},
+			errorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			name: "TLS",
+			conf: func(conf *config.KafkaEvents) {
+				conf.TLS.Enable = true
+				conf.TLS.InsecureSkipVerify = true
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.TLS.Enable, should.BeTrue)
+				if a.So(config.Net.TLS.Config, should.NotBeNil) {
+					a.So(config.Net.TLS.Config.InsecureSkipVerify, should.BeTrue)
+				}
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			conf := config.KafkaEvents{
+				Brokers: []string{"localhost:9092"},
+				Topic:   "test",
+			}
+			tc.conf(&conf)
+			saramaConfig, err := newConfig(conf)
+			if tc.errorAssertion != nil {
+				a.So(tc.errorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			tc.assertion(a, saramaConfig)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nats implements an events.PubSub implementation that uses NATS JetStream.
+package nats
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const apiTimeout = 5 * time.Second
+
+// ensureStream creates the stream if it does not exist.
+func (ps *PubSub) ensureStream() error {
+	_, err := ps.js.StreamInfo(ps.conf.Stream)
+	if err != nats.ErrStreamNotFound {
+		return err
+	}
+	_, err = ps.js.AddStream(&nats.StreamConfig{
+		Name:      ps.conf.Stream,
+		Subjects:  []string{ps.conf.Subject + ".>"},
+		Retention: nats.LimitsPolicy,
+		Storage:   nats.FileStorage,
+		MaxAge:    ps.conf.MaxAge,
+	})
+	return err
+}
+
+// WrapPubSub wraps an existing PubSub and publishes all events received from NATS JetStream to that PubSub.
+// Events are consumed with a durable consumer, which continues where it left off after a restart.
+// Each instance should use a different durable consumer in order to receive all events.
+func WrapPubSub(ctx context.Context, wrapped events.PubSub, taskStarter component.TaskStarter, conf config.NATSEvents) (ps *PubSub, err error) {
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"namespace", "events/nats",
+		"stream", conf.Stream,
+	))
+	if conf.Consumer == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		// Durable names can not contain dots.
+		conf.Consumer = strings.Replace(hostname, ".", "-", -1)
+	}
+	conn, err := nats.Connect(conf.ServerURL, nats.Name("ttn-lw-stack"))
+	if err != nil {
+		return nil, err
+	}
+	js, err := conn.JetStream(nats.MaxWait(apiTimeout))
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	ps = &PubSub{
+		PubSub:      wrapped,
+		taskStarter: taskStarter,
+		ctx:         ctx,
+		cancel:      cancel,
+		conf:        conf,
+		conn:        conn,
+		js:          js,
+	}
+	if err := ps.ensureStream(); err != nil {
+		conn.Close()
+		cancel()
+		return nil, err
+	}
+	return ps, nil
+}
+
+// NewPubSub creates a new PubSub that publishes and subscribes to NATS JetStream.
+func NewPubSub(ctx context.Context, taskStarter component.TaskStarter, conf config.NATSEvents) (*PubSub, error) {
+	return WrapPubSub(ctx, events.NewPubSub(events.DefaultBufferSize), taskStarter, conf)
+}
+
+// PubSub with NATS JetStream backend.
+type PubSub struct {
+	events.PubSub
+
+	taskStarter component.TaskStarter
+	ctx         context.Context
+	cancel      context.CancelFunc
+	conf        config.NATSEvents
+	conn        *nats.Conn
+	js          nats.JetStreamContext
+	subOnce     sync.Once
+}
+
+// Close the NATS publisher.
+func (ps *PubSub) Close(ctx context.Context) error {
+	ps.cancel()
+	ps.conn.Close()
+	return nil
+}
+
+var subjectReplacer = strings.NewReplacer(" ", "_", "*", "_", ">", "_")
+
+// subject returns the subject to which the event is published.
+// Events are published to the subject of their first entity, such that the order of events is preserved per entity.
+// The unique ID of the entity may span multiple tokens of the subject.
+func (ps *PubSub) subject(evt events.Event) string {
+	ids := evt.Identifiers()
+	if len(ids) == 0 {
+		return ps.conf.Subject + ".none"
+	}
+	return ps.conf.Subject + "." + subjectReplacer.Replace(events.EntityKey(evt.Context(), ids[0]))
+}
+
+func (ps *PubSub) subscribeTask(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithField("consumer", ps.conf.Consumer)
+	deliverSubject := "_EVENTS_DELIVER." + ps.conf.Stream + "." + ps.conf.Consumer
+	// If the durable consumer already exists, it continues where it left off. The consumer is created here and bound
+	// to below, so that it is not deleted when unsubscribing.
+	if _, err := ps.js.ConsumerInfo(ps.conf.Stream, ps.conf.Consumer); err == nil {
+		logger.Debug("Durable consumer exists")
+	} else if err != nats.ErrConsumerNotFound {
+		return err
+	} else if _, err := ps.js.AddConsumer(ps.conf.Stream, &nats.ConsumerConfig{
+		Durable:        ps.conf.Consumer,
+		DeliverSubject: deliverSubject,
+		DeliverPolicy:  nats.DeliverNewPolicy,
+		AckPolicy:      nats.AckExplicitPolicy,
+		AckWait:        30 * time.Second,
+	}); err != nil {
+		return err
+	}
+	sub, err := ps.js.Subscribe("", func(msg *nats.Msg) {
+		// Acknowledge the message, so that it is not redelivered to the durable consumer.
+		if err := msg.Ack(); err != nil {
+			logger.WithError(err).Warn("Failed to acknowledge message")
+		}
+		var pb ttnpb.Event
+		if err := pb.Unmarshal(msg.Data); err != nil {
+			logger.WithError(err).Warn("Failed to unmarshal event from binary")
+			return
+		}
+		evt, err := events.FromProto(&pb)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to unmarshal event from protobuf")
+			return
+		}
+		ps.PubSub.Publish(evt)
+	}, nats.Bind(ps.conf.Stream, ps.conf.Consumer), nats.ManualAck())
+	if err != nil {
+		return err
+	}
+	logger.Info("Subscribed")
+	defer func() {
+		if err := sub.Unsubscribe(); err != nil {
+			logger.WithError(err).Warn("Failed to unsubscribe from NATS")
+		} else {
+			logger.Info("Unsubscribed")
+		}
+	}()
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// Subscribe to events from NATS JetStream.
+func (ps *PubSub) Subscribe(name string, hdl events.Handler) error {
+	ps.subOnce.Do(func() {
+		ps.taskStarter.StartTask(&component.TaskConfig{
+			Context: ps.ctx,
+			ID:      "events_nats_subscribe",
+			Func:    ps.subscribeTask,
+			Restart: component.TaskRestartOnFailure,
+			Backoff: component.DefaultTaskBackoffConfig,
+		})
+	})
+	return ps.PubSub.Subscribe(name, hdl)
+}
+
+// Publish an event to NATS JetStream.
+func (ps *PubSub) Publish(evt events.Event) {
+	logger := log.FromContext(ps.ctx)
+	evtpb, err := events.Proto(evt)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal event to protobuf")
+		return
+	}
+	body, err := evtpb.Marshal()
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal event to binary")
+		return
+	}
+	if _, err := ps.js.PublishAsync(ps.subject(evt), body); err != nil {
+		logger.WithError(err).Warn("Failed to publish event")
+	}
+}
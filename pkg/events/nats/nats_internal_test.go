@@ -0,0 +1,44 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestSubject(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ps := &PubSub{
+		conf: config.NATSEvents{
+			Subject: "ttn.lw.events",
+		},
+	}
+	devIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		DeviceID:               "foo-dev",
+	}
+
+	a.So(ps.subject(events.New(ctx, "test", "test")), should.Equal, "ttn.lw.events.none")
+	a.So(ps.subject(events.New(ctx, "test", "test", events.WithIdentifiers(devIDs))), should.Equal, "ttn.lw.events.end_device:foo-app.foo-dev")
+}
@@ -0,0 +1,140 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats_test
+
+import (
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	ttnnats "go.thethings.network/lorawan-stack/v3/pkg/events/nats"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var timeout = (1 << 10) * test.Delay
+
+// natsConfig returns a NATS events config for testing with a stream that is unique to the test run.
+// The test is skipped if TEST_NATS is not set.
+func natsConfig(t *testing.T) config.NATSEvents {
+	if os.Getenv("TEST_NATS") != "1" {
+		t.Skip("Set TEST_NATS=1 to run tests against a NATS JetStream server")
+	}
+	serverURL := "nats://localhost:4222"
+	if address := os.Getenv("NATS_ADDRESS"); address != "" {
+		serverURL = address
+	}
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 36)
+	return config.NATSEvents{
+		ServerURL: serverURL,
+		Stream:    "TEST_EVENTS_" + suffix,
+		Subject:   "test.events." + suffix,
+		MaxAge:    time.Hour,
+	}
+}
+
+func deleteStream(t *testing.T, conf config.NATSEvents) {
+	conn, err := nats.Connect(conf.ServerURL)
+	if err != nil {
+		t.Errorf("Failed to connect to NATS: %s", err)
+		return
+	}
+	defer conn.Close()
+	js, err := conn.JetStream()
+	if err != nil {
+		t.Errorf("Failed to get JetStream context: %s", err)
+		return
+	}
+	if err := js.DeleteStream(conf.Stream); err != nil {
+		t.Errorf("Failed to delete stream: %s", err)
+	}
+}
+
+func TestNATSPubSub(t *testing.T) {
+	conf := natsConfig(t)
+	defer deleteStream(t, conf)
+
+	a := assertions.New(t)
+	ctx := test.Context()
+	taskStarter := component.StartTaskFunc(component.DefaultStartTask)
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+
+	subscribe := func(consumer string) (*ttnnats.PubSub, <-chan events.Event) {
+		conf := conf
+		conf.Consumer = consumer
+		ps, err := ttnnats.NewPubSub(ctx, taskStarter, conf)
+		if err != nil {
+			t.Fatalf("Failed to create NATS pub/sub: %s", err)
+		}
+		eventCh := make(chan events.Event, 10)
+		if err := ps.Subscribe("nats.test.**", events.HandlerFunc(func(evt events.Event) {
+			eventCh <- evt
+		})); err != nil {
+			t.Fatalf("Failed to subscribe: %s", err)
+		}
+		time.Sleep(timeout)
+		return ps, eventCh
+	}
+	expectEvent := func(eventCh <-chan events.Event, name string) {
+		select {
+		case evt := <-eventCh:
+			a.So(evt.Name(), should.Equal, name)
+			if a.So(evt.Identifiers(), should.HaveLength, 1) {
+				a.So(evt.Identifiers()[0].GetApplicationIDs(), should.Resemble, &appIDs)
+			}
+		case <-time.After(timeout):
+			t.Fatalf("Did not receive event `%s`", name)
+		}
+	}
+
+	ps, eventCh := subscribe("consumer")
+	ps.Publish(events.New(ctx, "nats.test.evt0", "nats test event 0", events.WithIdentifiers(appIDs)))
+	expectEvent(eventCh, "nats.test.evt0")
+	ps.Close(ctx)
+
+	// Events published while the durable consumer is not subscribed are stored in the stream.
+	publisherConf := conf
+	publisherConf.Consumer = "publisher"
+	publisher, err := ttnnats.NewPubSub(ctx, taskStarter, publisherConf)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer publisher.Close(ctx)
+	publisher.Publish(events.New(ctx, "nats.test.evt1", "nats test event 1", events.WithIdentifiers(appIDs)))
+
+	// The durable consumer continues where it left off.
+	ps, eventCh = subscribe("consumer")
+	defer ps.Close(ctx)
+	expectEvent(eventCh, "nats.test.evt1")
+	select {
+	case evt := <-eventCh:
+		t.Fatalf("Received unexpected event `%s`", evt.Name())
+	case <-time.After(timeout):
+	}
+
+	// A new durable consumer only receives new events.
+	other, otherCh := subscribe("other")
+	defer other.Close(ctx)
+	publisher.Publish(events.New(ctx, "nats.test.evt2", "nats test event 2", events.WithIdentifiers(appIDs)))
+	expectEvent(eventCh, "nats.test.evt2")
+	expectEvent(otherCh, "nats.test.evt2")
+}
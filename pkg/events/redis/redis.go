@@ -43,6 +43,7 @@ func WrapPubSub(ctx context.Context, wrapped events.PubSub, taskStarter componen
 		ctx:          ctx,
 		cancel:       cancel,
 		client:       ttnRedisClient.Client,
+		ttnClient:    ttnRedisClient,
 		eventChannel: eventChannel,
 	}
 }
@@ -61,6 +62,7 @@ type PubSub struct {
 	cancel       context.CancelFunc
 	eventChannel string
 	client       *redis.Client
+	ttnClient    *ttnredis.Client
 	subOnce      sync.Once
 }
 
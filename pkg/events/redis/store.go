@@ -0,0 +1,135 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const historyEventKey = "event"
+
+// WrapStore wraps an existing PubSub like WrapPubSub, and also stores published events in Redis streams.
+// The last entityCount events of each entity are kept for at most ttl.
+func WrapStore(ctx context.Context, wrapped events.PubSub, taskStarter component.TaskStarter, conf ttnredis.Config, ttl time.Duration, entityCount int) *Store {
+	return &Store{
+		PubSub:      WrapPubSub(ctx, wrapped, taskStarter, conf),
+		ttl:         ttl,
+		entityCount: int64(entityCount),
+	}
+}
+
+// NewStore creates a new Store that publishes, subscribes and stores events in Redis.
+func NewStore(ctx context.Context, taskStarter component.TaskStarter, conf ttnredis.Config, ttl time.Duration, entityCount int) *Store {
+	return WrapStore(ctx, events.NewPubSub(events.DefaultBufferSize), taskStarter, conf, ttl, entityCount)
+}
+
+// Store is a PubSub with Redis backend that stores the history of each entity in a Redis stream.
+type Store struct {
+	*PubSub
+	ttl         time.Duration
+	entityCount int64
+}
+
+func (s *Store) historyKey(key string) string {
+	return s.ttnClient.Key("history", key)
+}
+
+// Publish an event to Redis and store it in the history of its entities.
+func (s *Store) Publish(evt events.Event) {
+	logger := log.FromContext(s.ctx)
+	b, err := json.Marshal(evt)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal event to JSON")
+		return
+	}
+	_, err = s.client.Pipelined(func(p redis.Pipeliner) error {
+		for _, ids := range evt.Identifiers() {
+			for _, key := range events.EntityKeys(evt.Context(), ids) {
+				k := s.historyKey(key)
+				p.XAdd(&redis.XAddArgs{
+					Stream:       k,
+					MaxLenApprox: s.entityCount,
+					Values: map[string]interface{}{
+						historyEventKey: b,
+					},
+				})
+				if s.ttl > 0 {
+					p.Expire(k, s.ttl)
+				}
+			}
+		}
+		p.Publish(s.eventChannel, b)
+		return nil
+	})
+	if err != nil {
+		logger.WithError(err).Warn("Failed to publish event")
+	}
+}
+
+// FetchHistory implements events.Store.
+func (s *Store) FetchHistory(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]events.Event, error) {
+	start := "-"
+	if after != nil {
+		// Stream entry IDs start with the Unix time in milliseconds at which the entry is added.
+		start = strconv.FormatInt(after.UnixNano()/int64(time.Millisecond), 10)
+	}
+	// Streams are trimmed approximately, so they may contain more than entityCount events.
+	count := s.entityCount
+	if tail > 0 && (count <= 0 || int64(tail) < count) {
+		count = int64(tail)
+	}
+	var evts []events.Event
+	for _, entityIDs := range ids {
+		k := s.historyKey(events.EntityKey(ctx, entityIDs))
+		var (
+			msgs []redis.XMessage
+			err  error
+		)
+		if count > 0 {
+			msgs, err = s.client.XRevRangeN(k, "+", start, count).Result()
+		} else {
+			msgs, err = s.client.XRevRange(k, "+", start).Result()
+		}
+		if err != nil {
+			return nil, ttnredis.ConvertError(err)
+		}
+		for _, msg := range msgs {
+			v, ok := msg.Values[historyEventKey].(string)
+			if !ok {
+				continue
+			}
+			evt, err := events.UnmarshalJSON([]byte(v))
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to unmarshal event from JSON")
+				continue
+			}
+			if s.ttl > 0 && time.Since(evt.Time()) > s.ttl {
+				continue
+			}
+			evts = append(evts, evt)
+		}
+	}
+	return events.SortHistory(evts, after, tail), nil
+}
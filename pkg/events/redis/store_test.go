@@ -0,0 +1,125 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/events/redis"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// storeRedisConfig returns a redis config for testing with a namespace that is unique to the test run.
+func storeRedisConfig(t *testing.T) ttnredis.Config {
+	config := redisConfig()
+	config.RootNamespace = append(config.RootNamespace, t.Name(), strconv.FormatInt(time.Now().UnixNano(), 36))
+	return config
+}
+
+func uniqueIDs(evts []events.Event) []string {
+	res := make([]string, 0, len(evts))
+	for _, evt := range evts {
+		res = append(res, evt.UniqueID())
+	}
+	return res
+}
+
+func TestRedisStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "foo"}
+	devIDs := ttnpb.EndDeviceIdentifiers{DeviceID: "foo", ApplicationIdentifiers: appIDs}
+	gtwIDs := ttnpb.GatewayIdentifiers{GatewayID: "foo"}
+
+	taskStarter := component.StartTaskFunc(component.DefaultStartTask)
+	store := redis.NewStore(ctx, taskStarter, storeRedisConfig(t), time.Hour, 3)
+	defer store.Close(ctx)
+
+	evtApp := events.New(ctx, "test.app", "test", events.WithIdentifiers(appIDs))
+	store.Publish(evtApp)
+	time.Sleep(test.Delay)
+	evtDev1 := events.New(ctx, "test.dev", "test", events.WithIdentifiers(devIDs))
+	store.Publish(evtDev1)
+	time.Sleep(test.Delay)
+	evtGtw := events.New(ctx, "test.gtw", "test", events.WithIdentifiers(gtwIDs))
+	store.Publish(evtGtw)
+	time.Sleep(test.Delay)
+	evtDev2 := events.New(ctx, "test.dev", "test", events.WithIdentifiers(devIDs, gtwIDs))
+	store.Publish(evtDev2)
+
+	// Events of end devices are returned for their application.
+	evts, err := store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()}, nil, 10)
+	a.So(err, should.BeNil)
+	a.So(uniqueIDs(evts), should.Resemble, uniqueIDs([]events.Event{evtApp, evtDev1, evtDev2}))
+
+	// Events of multiple entities are deduplicated.
+	evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{
+		devIDs.EntityIdentifiers(),
+		gtwIDs.EntityIdentifiers(),
+	}, nil, 10)
+	a.So(err, should.BeNil)
+	a.So(uniqueIDs(evts), should.Resemble, uniqueIDs([]events.Event{evtDev1, evtGtw, evtDev2}))
+
+	// The tail limits the number of events.
+	evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()}, nil, 2)
+	a.So(err, should.BeNil)
+	a.So(uniqueIDs(evts), should.Resemble, uniqueIDs([]events.Event{evtDev1, evtDev2}))
+
+	// Only events after the given time are returned.
+	after := evtDev1.Time()
+	evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()}, &after, 0)
+	a.So(err, should.BeNil)
+	a.So(uniqueIDs(evts), should.Resemble, uniqueIDs([]events.Event{evtDev2}))
+
+	// Only the last events of each entity are returned.
+	time.Sleep(test.Delay)
+	evtApp2 := events.New(ctx, "test.app", "test", events.WithIdentifiers(appIDs))
+	store.Publish(evtApp2)
+	evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()}, nil, 0)
+	a.So(err, should.BeNil)
+	a.So(uniqueIDs(evts), should.Resemble, uniqueIDs([]events.Event{evtDev1, evtDev2, evtApp2}))
+}
+
+func TestRedisStoreTTL(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ttl := (1 << 8) * test.Delay
+	taskStarter := component.StartTaskFunc(component.DefaultStartTask)
+	store := redis.NewStore(ctx, taskStarter, storeRedisConfig(t), ttl, 10)
+	defer store.Close(ctx)
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "foo"}
+	store.Publish(events.New(ctx, "test.app", "test", events.WithIdentifiers(appIDs)))
+
+	evts, err := store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()}, nil, 0)
+	a.So(err, should.BeNil)
+	a.So(evts, should.HaveLength, 1)
+
+	time.Sleep(2 * ttl)
+
+	evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()}, nil, 0)
+	a.So(err, should.BeNil)
+	a.So(evts, should.BeEmpty)
+}
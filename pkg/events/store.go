@@ -0,0 +1,156 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// Store is a PubSub that stores published events, so that historical events can be fetched.
+type Store interface {
+	PubSub
+	// FetchHistory fetches the stored events for the given entity identifiers, in chronological order.
+	// Events of end devices are also returned for the identifiers of their application.
+	// If after is not nil, only events that are published after the given time are returned.
+	// If tail is not 0, only the last tail events are returned.
+	FetchHistory(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]Event, error)
+}
+
+// EntityKeys returns the keys under which the event is stored for the given entity identifiers.
+// Events of end devices are also stored under the key of their application.
+func EntityKeys(ctx context.Context, ids *ttnpb.EntityIdentifiers) []string {
+	keys := []string{EntityKey(ctx, ids)}
+	if devIDs, ok := ids.Identifiers().(*ttnpb.EndDeviceIdentifiers); ok {
+		keys = append(keys, EntityKey(ctx, devIDs.ApplicationIdentifiers.EntityIdentifiers()))
+	}
+	return keys
+}
+
+// EntityKey returns the key of the given entity identifiers.
+func EntityKey(ctx context.Context, ids *ttnpb.EntityIdentifiers) string {
+	return ids.EntityType() + ":" + unique.ID(ctx, ids)
+}
+
+// SortHistory filters the given events on the time and tail, and returns them in chronological order.
+// Duplicate events are removed. This is typically used to combine the events of multiple entities.
+func SortHistory(evts []Event, after *time.Time, tail int) []Event {
+	seen := make(map[string]struct{}, len(evts))
+	filtered := evts[:0]
+	for _, evt := range evts {
+		if after != nil && !evt.Time().After(*after) {
+			continue
+		}
+		if _, ok := seen[evt.UniqueID()]; ok {
+			continue
+		}
+		seen[evt.UniqueID()] = struct{}{}
+		filtered = append(filtered, evt)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Time().Before(filtered[j].Time())
+	})
+	if tail > 0 && len(filtered) > tail {
+		filtered = filtered[len(filtered)-tail:]
+	}
+	return filtered
+}
+
+// NewMemoryStore returns a new Store that keeps the last entityCount events of each entity in memory, for at most ttl.
+// Published events are published to the wrapped PubSub.
+// The memory store is intended for single-instance deployments.
+func NewMemoryStore(wrapped PubSub, ttl time.Duration, entityCount int) Store {
+	return &memoryStore{
+		PubSub:      wrapped,
+		ttl:         ttl,
+		entityCount: entityCount,
+		entities:    make(map[string][]Event),
+		lastCleanup: time.Now(),
+	}
+}
+
+type memoryStore struct {
+	PubSub
+	ttl         time.Duration
+	entityCount int
+
+	mu          sync.RWMutex
+	entities    map[string][]Event
+	lastCleanup time.Time
+}
+
+// expired returns the number of expired events at the start of the given events.
+func (s *memoryStore) expired(evts []Event, now time.Time) int {
+	if s.ttl <= 0 {
+		return 0
+	}
+	return sort.Search(len(evts), func(i int) bool {
+		return now.Sub(evts[i].Time()) < s.ttl
+	})
+}
+
+func (s *memoryStore) cleanup(now time.Time) {
+	for key, evts := range s.entities {
+		evts = evts[s.expired(evts, now):]
+		if len(evts) == 0 {
+			delete(s.entities, key)
+			continue
+		}
+		s.entities[key] = evts
+	}
+	s.lastCleanup = now
+}
+
+func (s *memoryStore) store(evt Event) {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, ids := range evt.Identifiers() {
+		for _, key := range EntityKeys(evt.Context(), ids) {
+			evts := append(s.entities[key], evt)
+			if s.entityCount > 0 && len(evts) > s.entityCount {
+				evts = evts[len(evts)-s.entityCount:]
+			}
+			s.entities[key] = evts
+		}
+	}
+	if s.ttl > 0 && now.Sub(s.lastCleanup) > s.ttl {
+		s.cleanup(now)
+	}
+}
+
+// Publish stores the event and publishes it to the wrapped PubSub.
+func (s *memoryStore) Publish(evt Event) {
+	s.store(evt)
+	s.PubSub.Publish(evt)
+}
+
+// FetchHistory implements Store.
+func (s *memoryStore) FetchHistory(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]Event, error) {
+	now := time.Now()
+	var evts []Event
+	s.mu.RLock()
+	for _, entityIDs := range ids {
+		stored := s.entities[EntityKey(ctx, entityIDs)]
+		evts = append(evts, stored[s.expired(stored, now):]...)
+	}
+	s.mu.RUnlock()
+	return SortHistory(evts, after, tail), nil
+}
@@ -209,6 +209,9 @@ func (lsc *LoRaStandardChannel) ToConcentratorConfig(phy band.Band) (*ttnpb.Conc
 		return nil, errInvalidDataRateIndex.New()
 	}
 	lora := dr.Rate.GetLoRa()
+	if lora == nil {
+		return nil, errInvalidDataRateIndex.New()
+	}
 	return &ttnpb.ConcentratorConfig_LoRaStandardChannel{
 		Frequency:       lsc.Frequency,
 		Radio:           uint32(lsc.Radio),
@@ -48,6 +48,8 @@ type Config struct {
 
 	Forward map[string][]string `name:"forward" description:"Forward the DevAddr prefixes to the specified hosts"`
 
+	AllowRemoteCommands bool `name:"allow-remote-commands" description:"Allow running remote commands on connected gateways that support it"`
+
 	MQTT         config.MQTT        `name:"mqtt"`
 	MQTTV2       config.MQTT        `name:"mqtt-v2"`
 	UDP          UDPConfig          `name:"udp"`
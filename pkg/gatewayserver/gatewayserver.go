@@ -467,6 +467,7 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 				"location_public",
 				"schedule_anytime_delay",
 				"schedule_downlink_late",
+				"udp_allowed_source_cidrs",
 				"update_location_from_status",
 			},
 		},
@@ -1184,6 +1184,7 @@ func TestGatewayServer(t *testing.T) {
 								for _, md := range msg.RxMetadata {
 									a.So(md.UplinkToken, should.NotBeEmpty)
 									md.UplinkToken = nil
+									md.DownlinkLoad = nil
 								}
 								a.So(msg.RxMetadata, should.Resemble, expected.RxMetadata)
 								a.So(msg.RawPayload, should.Resemble, expected.RawPayload)
@@ -17,8 +17,11 @@ package gatewayserver
 import (
 	"context"
 
+	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 )
@@ -48,3 +51,60 @@ func (gs *GatewayServer) GetGatewayConnectionStats(ctx context.Context, ids *ttn
 	}
 	return val.(connectionEntry).Stats(), nil
 }
+
+// CaptureGatewayTraffic captures the traffic of the connected gateway for the requested duration.
+func (gs *GatewayServer) CaptureGatewayTraffic(req *ttnpb.CaptureGatewayTrafficRequest, stream ttnpb.Gs_CaptureGatewayTrafficServer) error {
+	ctx := stream.Context()
+	if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_TRAFFIC_READ, ttnpb.RIGHT_GATEWAY_STATUS_READ); err != nil {
+		return err
+	}
+
+	uid := unique.ID(ctx, req.GatewayIdentifiers)
+	val, ok := gs.connections.Load(uid)
+	if !ok {
+		return errNotConnected.WithAttributes("gateway_uid", uid)
+	}
+
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"gateway_uid", uid,
+		"duration", req.Duration,
+	))
+	logger.Info("Start traffic capture")
+	defer logger.Info("Stop traffic capture")
+
+	ctx, cancel := context.WithTimeout(ctx, req.Duration)
+	defer cancel()
+	for record := range val.(connectionEntry).Capture(ctx) {
+		if err := stream.Send(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var errRemoteCommandsDisabled = errors.DefinePermissionDenied("remote_commands_disabled", "remote commands are disabled")
+
+// RunGatewayRemoteCommand runs a remote command on the connected gateway.
+func (gs *GatewayServer) RunGatewayRemoteCommand(ctx context.Context, req *ttnpb.RunGatewayRemoteCommandRequest) (*pbtypes.Empty, error) {
+	if !gs.config.AllowRemoteCommands {
+		return nil, errRemoteCommandsDisabled.New()
+	}
+	if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC, ttnpb.RIGHT_GATEWAY_LINK); err != nil {
+		return nil, err
+	}
+
+	uid := unique.ID(ctx, req.GatewayIdentifiers)
+	val, ok := gs.connections.Load(uid)
+	if !ok {
+		return nil, errNotConnected.WithAttributes("gateway_uid", uid)
+	}
+	if err := val.(connectionEntry).SendRemoteCommand(req); err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).WithFields(log.Fields(
+		"gateway_uid", uid,
+		"command", req.Command,
+	)).Info("Sent remote command to gateway")
+	events.Publish(evtRunRemoteCommand.NewWithIdentifiersAndData(ctx, req.GatewayIdentifiers, req))
+	return ttnpb.Empty, nil
+}
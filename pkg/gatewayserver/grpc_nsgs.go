@@ -77,6 +77,7 @@ func (gs *GatewayServer) ScheduleDownlink(ctx context.Context, down *ttnpb.Downl
 		delay, err := conn.ScheduleDown(path, down)
 		if err != nil {
 			logger.WithField("gateway_uid", uid).WithError(err).Debug("Failed to schedule on path")
+			registerFailScheduleDownlink(ctx, conn.Gateway(), down, err)
 			pathErrs = append(pathErrs, errSchedulePath.WithCause(err).WithAttributes("gateway_uid", uid))
 			continue
 		}
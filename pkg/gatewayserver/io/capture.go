@@ -0,0 +1,94 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Capture captures the traffic of the connection until the given context is done.
+// The returned channel is closed when the capture ends. Records are dropped if the channel is not drained fast enough.
+func (c *Connection) Capture(ctx context.Context) <-chan *ttnpb.GatewayTrafficCaptureRecord {
+	ch := make(chan *ttnpb.GatewayTrafficCaptureRecord, bufferSize)
+	c.captureMu.Lock()
+	if c.captures == nil {
+		c.captures = make(map[chan *ttnpb.GatewayTrafficCaptureRecord]struct{})
+	}
+	c.captures[ch] = struct{}{}
+	c.captureMu.Unlock()
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-c.ctx.Done():
+		}
+		c.captureMu.Lock()
+		delete(c.captures, ch)
+		close(ch)
+		c.captureMu.Unlock()
+	}()
+	return ch
+}
+
+// IsCapturing returns whether the traffic of the connection is being captured.
+// Frontends can use this to avoid the cost of preparing records that are not captured.
+func (c *Connection) IsCapturing() bool {
+	c.captureMu.RLock()
+	defer c.captureMu.RUnlock()
+	return len(c.captures) > 0
+}
+
+func (c *Connection) capture(record *ttnpb.GatewayTrafficCaptureRecord) {
+	c.captureMu.RLock()
+	defer c.captureMu.RUnlock()
+	for ch := range c.captures {
+		select {
+		case ch <- record:
+		default:
+			log.FromContext(c.ctx).Debug("Traffic capture buffer full, drop record")
+		}
+	}
+}
+
+// CaptureRaw captures the raw frontend message if the traffic of the connection is being captured.
+func (c *Connection) CaptureRaw(direction ttnpb.GatewayTrafficCaptureRecord_Direction, remoteAddr string, raw []byte) {
+	if !c.IsCapturing() {
+		return
+	}
+	c.capture(&ttnpb.GatewayTrafficCaptureRecord{
+		Time:       time.Now(),
+		Protocol:   c.frontend.Protocol(),
+		Direction:  direction,
+		RemoteAddr: remoteAddr,
+		Raw:        append([]byte(nil), raw...),
+	})
+}
+
+// captureScheduling captures the scheduling attempts of the downlink message if the traffic of the connection is being captured.
+func (c *Connection) captureScheduling(msg *ttnpb.DownlinkMessage, attempts []*ttnpb.GatewayTrafficCaptureRecord_SchedulingAttempt) {
+	if !c.IsCapturing() {
+		return
+	}
+	c.capture(&ttnpb.GatewayTrafficCaptureRecord{
+		Time:               time.Now(),
+		Protocol:           c.frontend.Protocol(),
+		Direction:          ttnpb.GatewayTrafficCaptureRecord_DOWNSTREAM,
+		SchedulingAttempts: attempts,
+		CorrelationIDs:     msg.CorrelationIDs,
+	})
+}
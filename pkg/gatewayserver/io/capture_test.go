@@ -0,0 +1,115 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCapture(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gs := mock.NewServer(c)
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "foo-gateway"}
+	gs.RegisterGateway(ctx, ids, &ttnpb.Gateway{
+		GatewayIdentifiers: ids,
+		FrequencyPlanID:    "EU_863_870",
+	})
+
+	gtwCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_LINK),
+		},
+	})
+	if _, err := mock.ConnectFrontend(gtwCtx, ids, gs); err != nil {
+		t.Fatalf("Failed to connect frontend: %v", err)
+	}
+	conn := gs.GetConnection(ctx, ids)
+
+	// Nothing is captured when there are no captures.
+	a.So(conn.IsCapturing(), should.BeFalse)
+	conn.CaptureRaw(ttnpb.GatewayTrafficCaptureRecord_UPSTREAM, "", []byte{0x01})
+
+	captureCtx, cancel := context.WithCancel(ctx)
+	records := conn.Capture(captureCtx)
+	a.So(conn.IsCapturing(), should.BeTrue)
+
+	conn.CaptureRaw(ttnpb.GatewayTrafficCaptureRecord_UPSTREAM, "127.0.0.1:1700", []byte{0x02})
+	select {
+	case record := <-records:
+		a.So(record.Protocol, should.Equal, "mock")
+		a.So(record.Direction, should.Equal, ttnpb.GatewayTrafficCaptureRecord_UPSTREAM)
+		a.So(record.RemoteAddr, should.Equal, "127.0.0.1:1700")
+		a.So(record.Raw, should.Resemble, []byte{0x02})
+	case <-time.After(timeout):
+		t.Fatal("Expected capture record")
+	}
+
+	_, err := conn.ScheduleDown(&ttnpb.DownlinkPath{
+		Path: &ttnpb.DownlinkPath_Fixed{
+			Fixed: &ttnpb.GatewayAntennaIdentifiers{GatewayIdentifiers: ids},
+		},
+	}, &ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x01},
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &ttnpb.TxRequest{
+				Class:    ttnpb.CLASS_C,
+				Priority: ttnpb.TxSchedulePriority_NORMAL,
+			},
+		},
+		CorrelationIDs: []string{"test"},
+	})
+	a.So(err, should.NotBeNil)
+	select {
+	case record := <-records:
+		a.So(record.Direction, should.Equal, ttnpb.GatewayTrafficCaptureRecord_DOWNSTREAM)
+		a.So(record.CorrelationIDs, should.Resemble, []string{"test"})
+		if a.So(record.SchedulingAttempts, should.HaveLength, 2) {
+			for i, attempt := range record.SchedulingAttempts {
+				a.So(attempt.RxWindow, should.Equal, uint32(i+1))
+				a.So(attempt.Error, should.NotBeNil)
+			}
+		}
+	case <-time.After(timeout):
+		t.Fatal("Expected capture record")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-records:
+		a.So(ok, should.BeFalse)
+	case <-time.After(timeout):
+		t.Fatal("Expected capture to end")
+	}
+	a.So(conn.IsCapturing(), should.BeFalse)
+}
@@ -354,6 +354,7 @@ func TestTraffic(t *testing.T) {
 						expected := tc.UplinkMessages[ups]
 						up.ReceivedAt = expected.ReceivedAt
 						up.RxMetadata[0].UplinkToken = expected.RxMetadata[0].UplinkToken
+						up.RxMetadata[0].DownlinkLoad = expected.RxMetadata[0].DownlinkLoad
 						a.So(up.UplinkMessage, should.Resemble, expected)
 						ups++
 					case status := <-conn.Status():
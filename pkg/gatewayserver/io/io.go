@@ -17,6 +17,7 @@ package io
 import (
 	"context"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -46,6 +47,13 @@ type Frontend interface {
 	SupportsDownlinkClaim() bool
 }
 
+// RemoteCommandFrontend is a Frontend that may support running remote commands on gateways.
+type RemoteCommandFrontend interface {
+	Frontend
+	// SupportsRemoteCommands returns true if the frontend can run remote commands on gateways.
+	SupportsRemoteCommands() bool
+}
+
 // Server represents the Gateway Server to gateway frontends.
 type Server interface {
 	// GetBaseConfig returns the component configuration.
@@ -91,6 +99,13 @@ type Connection struct {
 	downCh   chan *ttnpb.DownlinkMessage
 	statusCh chan *ttnpb.GatewayStatus
 	txAckCh  chan *ttnpb.TxAcknowledgment
+	cmdCh    chan *ttnpb.RunGatewayRemoteCommandRequest
+
+	captureMu sync.RWMutex
+	captures  map[chan *ttnpb.GatewayTrafficCaptureRecord]struct{}
+
+	sentMu sync.Mutex
+	sent   []sentDownlink
 
 	statsChangedCh chan struct{}
 	locCh          chan struct{}
@@ -155,6 +170,7 @@ func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gatewa
 		downCh:           make(chan *ttnpb.DownlinkMessage, bufferSize),
 		statusCh:         make(chan *ttnpb.GatewayStatus, bufferSize),
 		txAckCh:          make(chan *ttnpb.TxAcknowledgment, bufferSize),
+		cmdCh:            make(chan *ttnpb.RunGatewayRemoteCommandRequest, bufferSize),
 		locCh:            make(chan struct{}, 1),
 		connectTime:      time.Now().UnixNano(),
 
@@ -196,6 +212,7 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 		)).Debug("Synchronized server absolute time only")
 	}
 
+	load := c.scheduler.DownlinkLoad()
 	for _, md := range up.RxMetadata {
 		if md.AntennaIndex != 0 {
 			// TODO: Support downlink path to multiple antennas (https://github.com/TheThingsNetwork/lorawan-stack/issues/48)
@@ -211,6 +228,7 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 		}
 		md.UplinkToken = buf
 		md.DownlinkPathConstraint = c.gateway.DownlinkPathConstraint
+		md.DownlinkLoad = load
 
 		if c.gateway.LocationPublic && len(c.gateway.Antennas) > int(md.AntennaIndex) {
 			location := c.gateway.Antennas[md.AntennaIndex].Location
@@ -263,7 +281,12 @@ func (c *Connection) HandleStatus(status *ttnpb.GatewayStatus) error {
 }
 
 // HandleTxAck sends the acknowledgment to the status channel.
+// The acknowledgment result is used to keep track of the occupancy of the downlink channel and the downlink load.
 func (c *Connection) HandleTxAck(ack *ttnpb.TxAcknowledgment) error {
+	c.reportChannelOccupancy(ack)
+	if c.scheduler != nil {
+		c.scheduler.ReportTxResult(ack.Result != ttnpb.TxAcknowledgment_SUCCESS)
+	}
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
@@ -325,6 +348,7 @@ func (c *Connection) SendDown(msg *ttnpb.DownlinkMessage) error {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
 	case c.downCh <- msg:
+		c.recordSentDownlink(msg)
 		atomic.AddUint64(&c.downlinks, 1)
 		atomic.StoreInt64(&c.lastDownlinkTime, time.Now().UnixNano())
 
@@ -335,14 +359,47 @@ func (c *Connection) SendDown(msg *ttnpb.DownlinkMessage) error {
 	return nil
 }
 
+var errRemoteCommandsNotSupported = errors.DefineFailedPrecondition("remote_commands_not_supported", "remote commands not supported by frontend `{protocol}`")
+
+// SupportsRemoteCommands returns true if the frontend of the connection supports running remote commands.
+func (c *Connection) SupportsRemoteCommands() bool {
+	frontend, ok := c.frontend.(RemoteCommandFrontend)
+	return ok && frontend.SupportsRemoteCommands()
+}
+
+// SendRemoteCommand sends the remote command on the remote commands channel.
+func (c *Connection) SendRemoteCommand(cmd *ttnpb.RunGatewayRemoteCommandRequest) error {
+	if !c.SupportsRemoteCommands() {
+		return errRemoteCommandsNotSupported.WithAttributes("protocol", c.frontend.Protocol())
+	}
+	select {
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	case c.cmdCh <- cmd:
+	default:
+		return errBufferFull.New()
+	}
+	return nil
+}
+
 var (
 	errFrequencyPlanNotConfigured   = errors.DefineInvalidArgument("frequency_plan_not_configured", "frequency plan `{id}` is not configured for this gateway")
 	errNoFrequencyPlanIDInTxRequest = errors.DefineInvalidArgument("no_frequency_plan_id_in_tx_request", "no frequency plan ID in tx request")
 )
 
+// schedulingTrace returns the scheduling trace from the details of the given scheduler error, if any.
+func schedulingTrace(err error) *ttnpb.DownlinkSchedulingTrace {
+	for _, details := range errors.Details(err) {
+		if trace, ok := details.(*ttnpb.DownlinkSchedulingTrace); ok {
+			return trace
+		}
+	}
+	return nil
+}
+
 // ScheduleDown schedules and sends a downlink message by using the given path and updates the downlink stats.
 // This method returns an error if the downlink message is not a Tx request.
-func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkMessage) (time.Duration, error) {
+func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkMessage) (delay time.Duration, err error) {
 	if c.gateway.DownlinkPathConstraint == ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER {
 		return 0, errNotAllowed.New()
 	}
@@ -350,7 +407,6 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 	if request == nil {
 		return 0, errNotTxRequest.New()
 	}
-	var delay time.Duration
 
 	logger := log.FromContext(c.ctx).WithField("class", request.Class)
 	logger.Debug("Attempt to schedule downlink on gateway")
@@ -387,45 +443,83 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 	if err != nil {
 		return 0, err
 	}
-	var rxErrs []errors.ErrorDetails
-	for i, rx := range []struct {
+	var (
+		rxErrs   []errors.ErrorDetails
+		attempts []*ttnpb.GatewayTrafficCaptureRecord_SchedulingAttempt
+	)
+	defer func() {
+		if n := len(attempts); n > 0 && attempts[n-1].Error == nil && err != nil {
+			if ttnErr, ok := errors.From(err); ok {
+				attempts[n-1].Error = ttnpb.ErrorDetailsToProto(ttnErr)
+			}
+		}
+		c.captureScheduling(msg, attempts)
+	}()
+	type rxWindow struct {
+		window        int
 		dataRateIndex ttnpb.DataRateIndex
 		frequency     uint64
 		delay         time.Duration
-	}{
+	}
+	rxWindows := []rxWindow{
 		{
+			window:        1,
 			dataRateIndex: request.Rx1DataRateIndex,
 			frequency:     request.Rx1Frequency,
 			delay:         0,
 		},
 		{
+			window:        2,
 			dataRateIndex: request.Rx2DataRateIndex,
 			frequency:     request.Rx2Frequency,
 			delay:         time.Second,
 		},
-	} {
+	}
+	preferRx2 := c.preferRx2(request.Rx1Frequency, request.Rx2Frequency)
+	if preferRx2 {
+		logger.WithFields(log.Fields(
+			"rx1_frequency", request.Rx1Frequency,
+			"rx2_frequency", request.Rx2Frequency,
+		)).Debug("Rx1 channel is occupied, prefer Rx2")
+		rxWindows[0], rxWindows[1] = rxWindows[1], rxWindows[0]
+	}
+	for _, rx := range rxWindows {
+		attempt := &ttnpb.GatewayTrafficCaptureRecord_SchedulingAttempt{
+			RxWindow:      uint32(rx.window),
+			Frequency:     rx.frequency,
+			DataRateIndex: rx.dataRateIndex,
+		}
+		attempts = append(attempts, attempt)
 		if rx.frequency == 0 {
 			rxErrs = append(rxErrs, errRxEmpty)
+			attempt.Error = ttnpb.ErrorDetailsToProto(errRxEmpty)
 			continue
 		}
 		logger := logger.WithFields(log.Fields(
-			"rx_window", i+1,
+			"rx_window", rx.window,
 			"frequency", rx.frequency,
 			"data_rate_index", rx.dataRateIndex,
 		))
 		logger.Debug("Attempt to schedule downlink in receive window")
-		dr, ok := phy.DataRates[rx.dataRateIndex]
+		dr, ok := phy.FindDownlinkDataRate(rx.dataRateIndex)
 		if !ok {
 			return 0, errDataRate.WithAttributes("index", rx.dataRateIndex)
 		}
 		// The maximum payload size is MACPayload only; for PHYPayload take MHDR (1 byte) and MIC (4 bytes) into account.
 		maxPHYLength := dr.MaxMACPayloadSize(fp.DwellTime.GetDownlinks()) + 5
 		if len(msg.RawPayload) > int(maxPHYLength) {
-			return 0, errTooLong.WithAttributes(
+			err := errTooLong.WithAttributes(
 				"payload_length", len(msg.RawPayload),
 				"maximum_length", maxPHYLength,
 				"data_rate_index", rx.dataRateIndex,
 			)
+			if preferRx2 && rx.window == 2 {
+				// The payload may still fit in the Rx1 window.
+				rxErrs = append(rxErrs, err)
+				attempt.Error = ttnpb.ErrorDetailsToProto(err)
+				continue
+			}
+			return 0, err
 		}
 		eirp := phy.DefaultMaxEIRP
 		if sb, ok := phy.FindSubBand(rx.frequency); ok {
@@ -467,6 +561,7 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 			}
 			if !c.scheduler.IsGatewayTimeSynced() {
 				rxErrs = append(rxErrs, errNoGPSSync)
+				attempt.Error = ttnpb.ErrorDetailsToProto(errNoGPSSync)
 				continue
 			}
 			f = c.scheduler.ScheduleAt
@@ -490,9 +585,14 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 		})
 		if err != nil {
 			logger.WithError(err).Debug("Failed to schedule downlink in Rx window")
-			rxErrs = append(rxErrs, errRxWindowSchedule.WithCause(err).WithAttributes("window", i+1))
+			rxErr := errRxWindowSchedule.WithCause(err).WithAttributes("window", rx.window)
+			rxErrs = append(rxErrs, rxErr)
+			attempt.Error = ttnpb.ErrorDetailsToProto(rxErr)
+			attempt.Trace = schedulingTrace(err)
 			continue
 		}
+		attempt.Starts = int64(em.Starts())
+		attempt.Duration = em.Duration()
 		if settings.Time == nil || !c.scheduler.IsGatewayTimeSynced() {
 			settings.Time = nil
 			settings.Timestamp = uint32(time.Duration(em.Starts()) / time.Microsecond)
@@ -520,6 +620,7 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 		}
 		return 0, errTxSchedule.WithDetails(&ttnpb.ScheduleDownlinkErrorDetails{
 			PathErrors: protoErrs,
+			Attempts:   attempts,
 		})
 	}
 	err = c.SendDown(msg)
@@ -549,6 +650,11 @@ func (c *Connection) TxAck() <-chan *ttnpb.TxAcknowledgment {
 	return c.txAckCh
 }
 
+// RemoteCommands returns the remote commands channel.
+func (c *Connection) RemoteCommands() <-chan *ttnpb.RunGatewayRemoteCommandRequest {
+	return c.cmdCh
+}
+
 // StatsChanged returns the stats changed channel.
 func (c *Connection) StatsChanged() <-chan struct{} {
 	return c.statsChangedCh
@@ -621,6 +727,8 @@ func (c *Connection) Stats() *ttnpb.GatewayConnectionStats {
 	}
 	if c.scheduler != nil {
 		stats.SubBands = c.scheduler.SubBandStats()
+		stats.ChannelOccupancy = c.scheduler.ChannelOccupancyStats()
+		stats.DownlinkLoad = c.scheduler.DownlinkLoad()
 	}
 
 	return stats
@@ -154,6 +154,7 @@ func (c *connection) setup(ctx context.Context) (err error) {
 					continue
 				}
 				logger.Info("Publish downlink message")
+				c.io.CaptureRaw(ttnpb.GatewayTrafficCaptureRecord_DOWNSTREAM, c.mqtt.RemoteAddr().String(), buf)
 				topicParts := c.format.DownlinkTopic(unique.ID(c.io.Context(), c.io.Gateway().GatewayIdentifiers))
 				c.session.Publish(&packet.PublishPacket{
 					TopicName:  topic.Join(topicParts),
@@ -291,6 +292,7 @@ func (c *connection) CanWrite(info *auth.Info, topicParts ...string) bool {
 
 func (c *connection) deliver(pkt *packet.PublishPacket) {
 	logger := log.FromContext(c.io.Context()).WithField("topic", pkt.TopicName)
+	c.io.CaptureRaw(ttnpb.GatewayTrafficCaptureRecord_UPSTREAM, c.mqtt.RemoteAddr().String(), pkt.Message)
 	switch {
 	case c.format.IsBirthTopic(pkt.TopicParts):
 	case c.format.IsLastWillTopic(pkt.TopicParts):
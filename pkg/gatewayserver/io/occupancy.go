@@ -0,0 +1,106 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// maxSentDownlinks is the number of recently sent downlink messages that are kept to correlate Tx acknowledgments.
+	maxSentDownlinks = 32
+	// occupancyThreshold is the channel occupancy above which the Rx2 window is preferred over the Rx1 window.
+	occupancyThreshold = 0.5
+)
+
+type sentDownlink struct {
+	correlationIDs []string
+	frequency      uint64
+}
+
+// recordSentDownlink keeps track of the frequency of the given scheduled downlink message, so that the Tx acknowledgment
+// can be attributed to the frequency.
+func (c *Connection) recordSentDownlink(msg *ttnpb.DownlinkMessage) {
+	scheduled := msg.GetScheduled()
+	if scheduled == nil || len(msg.CorrelationIDs) == 0 {
+		return
+	}
+	c.sentMu.Lock()
+	defer c.sentMu.Unlock()
+	if len(c.sent) >= maxSentDownlinks {
+		c.sent = append(c.sent[:0], c.sent[1:]...)
+	}
+	c.sent = append(c.sent, sentDownlink{
+		correlationIDs: msg.CorrelationIDs,
+		frequency:      scheduled.Frequency,
+	})
+}
+
+// popSentDownlink returns and forgets the sent downlink message that shares a correlation ID with the given ones.
+func (c *Connection) popSentDownlink(correlationIDs []string) (sentDownlink, bool) {
+	if len(correlationIDs) == 0 {
+		return sentDownlink{}, false
+	}
+	c.sentMu.Lock()
+	defer c.sentMu.Unlock()
+	for i := len(c.sent) - 1; i >= 0; i-- {
+		for _, sentCID := range c.sent[i].correlationIDs {
+			for _, cid := range correlationIDs {
+				if sentCID != cid {
+					continue
+				}
+				down := c.sent[i]
+				c.sent = append(c.sent[:i], c.sent[i+1:]...)
+				return down, true
+			}
+		}
+	}
+	return sentDownlink{}, false
+}
+
+// isChannelBusy returns whether the Tx acknowledgment result indicates that the gateway did not transmit because
+// the channel was busy.
+// Packet collisions are not taken into account, as these are conflicts with downlink messages that the gateway
+// already queued, which the scheduler of the Gateway Server accounts for.
+func isChannelBusy(result ttnpb.TxAcknowledgment_Result) bool {
+	return result == ttnpb.TxAcknowledgment_COLLISION_BEACON
+}
+
+// reportChannelOccupancy feeds the result of the Tx acknowledgment in the channel occupancy statistics of the scheduler.
+// Results that do not relate to the channel, such as invalid timing or Tx power, are ignored.
+func (c *Connection) reportChannelOccupancy(ack *ttnpb.TxAcknowledgment) {
+	if c.scheduler == nil {
+		return
+	}
+	busy := isChannelBusy(ack.Result)
+	if !busy && ack.Result != ttnpb.TxAcknowledgment_SUCCESS {
+		return
+	}
+	down, ok := c.popSentDownlink(ack.CorrelationIDs)
+	if !ok {
+		return
+	}
+	c.scheduler.ReportTransmission(down.frequency, busy)
+}
+
+// preferRx2 returns whether the Rx2 window should be tried before the Rx1 window, because the Rx1 frequency is
+// significantly occupied while the Rx2 frequency is less occupied.
+func (c *Connection) preferRx2(rx1Frequency, rx2Frequency uint64) bool {
+	if c.scheduler == nil || rx1Frequency == 0 || rx2Frequency == 0 || rx1Frequency == rx2Frequency {
+		return false
+	}
+	rx1 := c.scheduler.ChannelOccupancy(rx1Frequency)
+	return rx1 > occupancyThreshold && c.scheduler.ChannelOccupancy(rx2Frequency) < rx1
+}
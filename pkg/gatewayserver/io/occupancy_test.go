@@ -0,0 +1,119 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestChannelOccupancy(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gs := mock.NewServer(c)
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "foo-gateway"}
+	gs.RegisterGateway(ctx, ids, &ttnpb.Gateway{
+		GatewayIdentifiers: ids,
+		FrequencyPlanID:    "EU_863_870",
+	})
+
+	gtwCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_LINK),
+		},
+	})
+	if _, err := mock.ConnectFrontend(gtwCtx, ids, gs); err != nil {
+		t.Fatalf("Failed to connect frontend: %v", err)
+	}
+	conn := gs.GetConnection(ctx, ids)
+
+	for _, down := range []struct {
+		correlationID string
+		frequency     uint64
+	}{
+		{"down-1", 868100000},
+		{"down-2", 868100000},
+		{"down-3", 869525000},
+		{"down-4", 868300000},
+	} {
+		err := conn.SendDown(&ttnpb.DownlinkMessage{
+			RawPayload: []byte{0x01},
+			Settings: &ttnpb.DownlinkMessage_Scheduled{
+				Scheduled: &ttnpb.TxSettings{
+					Frequency: down.frequency,
+				},
+			},
+			CorrelationIDs: []string{down.correlationID},
+		})
+		a.So(err, should.BeNil)
+	}
+
+	for _, ack := range []*ttnpb.TxAcknowledgment{
+		{
+			CorrelationIDs: []string{"down-1"},
+			Result:         ttnpb.TxAcknowledgment_COLLISION_BEACON,
+		},
+		{
+			CorrelationIDs: []string{"down-2"},
+			Result:         ttnpb.TxAcknowledgment_SUCCESS,
+		},
+		{
+			CorrelationIDs: []string{"down-3"},
+			Result:         ttnpb.TxAcknowledgment_TX_POWER,
+		},
+		{
+			// Packet collisions do not indicate that the channel is busy.
+			CorrelationIDs: []string{"down-4"},
+			Result:         ttnpb.TxAcknowledgment_COLLISION_PACKET,
+		},
+		{
+			// Unknown downlink messages are not taken into account.
+			CorrelationIDs: []string{"unknown"},
+			Result:         ttnpb.TxAcknowledgment_COLLISION_BEACON,
+		},
+		{
+			// Acknowledgments are taken into account only once.
+			CorrelationIDs: []string{"down-1"},
+			Result:         ttnpb.TxAcknowledgment_COLLISION_BEACON,
+		},
+	} {
+		a.So(conn.HandleTxAck(ack), should.BeNil)
+	}
+
+	stats := conn.Stats()
+	if a.So(stats.ChannelOccupancy, should.HaveLength, 1) {
+		occupancy := stats.ChannelOccupancy[0]
+		a.So(occupancy.Frequency, should.Equal, 868100000)
+		a.So(occupancy.Occupancy, should.AlmostEqual, 0.5, 0.01)
+		a.So(occupancy.TxCount, should.Equal, 2)
+		a.So(occupancy.BusyCount, should.Equal, 1)
+		a.So(occupancy.LastBusyAt, should.NotBeNil)
+	}
+}
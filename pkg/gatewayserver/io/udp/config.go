@@ -16,12 +16,20 @@ package udp
 
 import "time"
 
+// TokenBucketConfig contains configuration settings for a token bucket rate limit.
+type TokenBucketConfig struct {
+	Rate  float64 `name:"rate" description:"Average number of packets per second per gateway (0 is unlimited)"`
+	Burst int     `name:"burst" description:"Maximum number of packets per gateway in a burst"`
+}
+
 // RateLimitingConfig contains configuration settings for the rate limiting
 // capabilities of the UDP gateway frontend firewall.
 type RateLimitingConfig struct {
-	Enable    bool          `name:"enable" description:"Enable rate limiting for gateways"`
-	Messages  int           `name:"messages" description:"Number of past messages to check timestamp for"`
-	Threshold time.Duration `name:"threshold" description:"Filter packet if timestamp is not newer than the older timestamps of the previous messages by this threshold"`
+	Enable    bool              `name:"enable" description:"Enable rate limiting for gateways"`
+	Messages  int               `name:"messages" description:"Number of past messages to check timestamp for"`
+	Threshold time.Duration     `name:"threshold" description:"Filter packet if timestamp is not newer than the older timestamps of the previous messages by this threshold"`
+	PushData  TokenBucketConfig `name:"push-data" description:"Token bucket rate limit of PUSH_DATA packets"`
+	PullData  TokenBucketConfig `name:"pull-data" description:"Token bucket rate limit of PULL_DATA packets"`
 }
 
 // Config contains configuration settings for the UDP gateway frontend.
@@ -43,21 +51,33 @@ type Config struct {
 	ScheduleLateTime time.Duration `name:"schedule-late-time" description:"Time in advance to send downlink to the gateway when scheduling late"`
 	// AddrChangeBlock defines the time to block traffic when the address changes.
 	AddrChangeBlock time.Duration `name:"addr-change-block" description:"Time to block traffic when a gateway's address changes"`
+	// SourceFilterCacheTTL defines for how long the source address allow-list of a gateway is used after the gateway
+	// disconnects. Packets from addresses that are not allowed are dropped before the gateway connects.
+	SourceFilterCacheTTL time.Duration `name:"source-filter-cache-ttl" description:"Time for which the source address allow-list of a disconnected gateway is cached"`
 	// RateLimitingConfig is the configuration for the rate limiting firewall capabilities.
 	RateLimiting RateLimitingConfig `name:"rate-limiting"`
 }
 
 // DefaultConfig contains the default configuration.
 var DefaultConfig = Config{
-	PacketHandlers:      1 << 4,
-	PacketBuffer:        50,
-	DownlinkPathExpires: 15 * time.Second, // Expire downlink after missing typically 3 PULL_DATA messages.
-	ConnectionExpires:   1 * time.Minute,  // Expire connection after missing typically 2 status messages.
-	ScheduleLateTime:    800 * time.Millisecond,
-	AddrChangeBlock:     1 * time.Minute, // Release address when the connection expires.
+	PacketHandlers:       1 << 4,
+	PacketBuffer:         50,
+	DownlinkPathExpires:  15 * time.Second, // Expire downlink after missing typically 3 PULL_DATA messages.
+	ConnectionExpires:    1 * time.Minute,  // Expire connection after missing typically 2 status messages.
+	ScheduleLateTime:     800 * time.Millisecond,
+	AddrChangeBlock:      1 * time.Minute, // Release address when the connection expires.
+	SourceFilterCacheTTL: 10 * time.Minute,
 	RateLimiting: RateLimitingConfig{
 		Enable:    true,
 		Messages:  10,
 		Threshold: 10 * time.Millisecond,
+		PushData: TokenBucketConfig{
+			Rate:  20,
+			Burst: 200,
+		},
+		PullData: TokenBucketConfig{
+			Rate:  1,
+			Burst: 10,
+		},
 	},
 }
@@ -33,6 +33,9 @@ type Firewall interface {
 type addrTime struct {
 	net.IP
 	lastSeen time.Time
+	// other is the last address other than IP from which traffic is received while IP is connected.
+	other          net.IP
+	otherFirstSeen time.Time
 }
 
 type memoryFirewall struct {
@@ -64,6 +67,7 @@ var (
 	errNoEUI            = errors.DefineInvalidArgument("no_eui", "packet has no gateway EUI")
 	errNoAddress        = errors.DefineInvalidArgument("no_address", "packet has no gateway address")
 	errAlreadyConnected = errors.DefineFailedPrecondition("already_connected", "gateway is already connected")
+	errEUISpoofing      = errors.DefinePermissionDenied("eui_spoofing", "gateway EUI is used from multiple addresses")
 )
 
 func (f *memoryFirewall) Filter(packet encoding.Packet) error {
@@ -78,7 +82,23 @@ func (f *memoryFirewall) Filter(packet encoding.Packet) error {
 	val, ok := f.m.Load(eui)
 	if ok {
 		a := val.(addrTime)
-		if !bytes.Equal(a.IP, packet.GatewayAddr.IP) && a.lastSeen.Add(f.addrChangeBlock).After(now) {
+		if bytes.Equal(a.IP, packet.GatewayAddr.IP) {
+			a.lastSeen = now
+			f.m.Store(eui, a)
+			return nil
+		}
+		if a.lastSeen.Add(f.addrChangeBlock).After(now) {
+			if !bytes.Equal(a.other, packet.GatewayAddr.IP) {
+				a.other, a.otherFirstSeen = packet.GatewayAddr.IP, now
+				f.m.Store(eui, a)
+			}
+			if a.lastSeen.After(a.otherFirstSeen) {
+				// The connected address sent traffic after the other address appeared, so both addresses are used in parallel.
+				return errEUISpoofing.WithAttributes(
+					"connected_ip", a.IP.String(),
+					"connecting_ip", packet.GatewayAddr.IP.String(),
+				)
+			}
 			return errAlreadyConnected.WithAttributes(
 				"connected_ip", a.IP.String(),
 				"connecting_ip", packet.GatewayAddr.IP.String(),
@@ -102,3 +122,39 @@ func (f *memoryFirewall) gc() {
 		return true
 	})
 }
+
+type sourceFilter struct {
+	allowed []*net.IPNet
+}
+
+var (
+	errSourceCIDR       = errors.DefineInvalidArgument("source_cidr", "invalid source CIDR `{cidr}`")
+	errSourceNotAllowed = errors.DefinePermissionDenied("source_not_allowed", "source address `{source_ip}` is not allowed")
+)
+
+// NewSourceFilter returns a Firewall that only accepts packets from the given source address ranges in CIDR notation.
+func NewSourceFilter(cidrs []string) (Firewall, error) {
+	f := &sourceFilter{
+		allowed: make([]*net.IPNet, 0, len(cidrs)),
+	}
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errSourceCIDR.WithAttributes("cidr", cidr).WithCause(err)
+		}
+		f.allowed = append(f.allowed, ipNet)
+	}
+	return f, nil
+}
+
+func (f *sourceFilter) Filter(packet encoding.Packet) error {
+	if packet.GatewayAddr == nil {
+		return errNoAddress.New()
+	}
+	for _, ipNet := range f.allowed {
+		if ipNet.Contains(packet.GatewayAddr.IP) {
+			return nil
+		}
+	}
+	return errSourceNotAllowed.WithAttributes("source_ip", packet.GatewayAddr.IP.String())
+}
@@ -15,11 +15,14 @@
 package udp
 
 import (
+	"context"
+	"math"
 	"sync"
 	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	encoding "go.thethings.network/lorawan-stack/v3/pkg/ttnpb/udp"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 )
 
 type rateLimitingFirewall struct {
@@ -73,3 +76,112 @@ func (f *rateLimitingFirewall) Filter(packet encoding.Packet) error {
 	}
 	return nil
 }
+
+type tokenBucket struct {
+	mu        sync.Mutex
+	tokens    float64
+	updatedAt time.Time
+}
+
+// take refills the bucket at the given rate up to the burst size, and takes a token if one is available.
+func (b *tokenBucket) take(now time.Time, conf TokenBucketConfig) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.updatedAt.IsZero() {
+		b.tokens = float64(conf.Burst)
+	} else if now.After(b.updatedAt) {
+		b.tokens = math.Min(float64(conf.Burst), b.tokens+now.Sub(b.updatedAt).Seconds()*conf.Rate)
+	}
+	b.updatedAt = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// full returns whether the bucket is refilled up to the burst size at the given time.
+func (b *tokenBucket) full(now time.Time, conf TokenBucketConfig) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.tokens+now.Sub(b.updatedAt).Seconds()*conf.Rate >= float64(conf.Burst)
+}
+
+type tokenBucketKey struct {
+	eui        types.EUI64
+	packetType encoding.PacketType
+}
+
+type tokenBucketFirewall struct {
+	f Firewall
+
+	m sync.Map // tokenBucketKey to *tokenBucket
+
+	limits map[encoding.PacketType]TokenBucketConfig
+}
+
+// NewTokenBucketFirewall returns a Firewall that limits the rate of PUSH_DATA and PULL_DATA packets of each gateway
+// with token buckets. Packet types with a zero rate are not limited.
+func NewTokenBucketFirewall(ctx context.Context, firewall Firewall, pushData, pullData TokenBucketConfig) Firewall {
+	f := &tokenBucketFirewall{
+		f: firewall,
+		limits: map[encoding.PacketType]TokenBucketConfig{
+			encoding.PushData: pushData,
+			encoding.PullData: pullData,
+		},
+	}
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		for {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				f.gc()
+			}
+		}
+	}()
+	return f
+}
+
+var errPacketRateExceeded = errors.DefineResourceExhausted("packet_rate_exceeded", "gateway `{packet_type}` packet rate exceeded")
+
+func (f *tokenBucketFirewall) Filter(packet encoding.Packet) error {
+	if packet.GatewayEUI == nil {
+		return errNoEUI.New()
+	}
+	if packet.GatewayAddr == nil {
+		return errNoAddress.New()
+	}
+	if conf, ok := f.limits[packet.PacketType]; ok && conf.Rate > 0 {
+		key := tokenBucketKey{
+			eui:        *packet.GatewayEUI,
+			packetType: packet.PacketType,
+		}
+		val, ok := f.m.Load(key)
+		if !ok {
+			val, _ = f.m.LoadOrStore(key, &tokenBucket{})
+		}
+		if !val.(*tokenBucket).take(time.Now(), conf) {
+			return errPacketRateExceeded.WithAttributes("packet_type", packet.PacketType.String())
+		}
+	}
+
+	// Continue filtering
+	if f.f != nil {
+		return f.f.Filter(packet)
+	}
+	return nil
+}
+
+// gc removes the buckets that are refilled up to the burst size, as these are equal to new buckets.
+func (f *tokenBucketFirewall) gc() {
+	now := time.Now()
+	f.m.Range(func(k, val interface{}) bool {
+		if val.(*tokenBucket).full(now, f.limits[k.(tokenBucketKey).packetType]) {
+			f.m.Delete(k)
+		}
+		return true
+	})
+}
@@ -121,3 +121,45 @@ func TestRateLimitingFirewall(t *testing.T) {
 		a.So(f.Filter(packet), should.BeNil)
 	})
 }
+
+func TestTokenBucketFirewall(t *testing.T) {
+	ctx := test.Context()
+	a := assertions.New(t)
+
+	eui1 := &types.EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	eui2 := &types.EUI64{0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02}
+	addr := &net.UDPAddr{IP: net.IP{0x01, 0x01, 0x01, 0x01}, Port: 1}
+
+	f := NewTokenBucketFirewall(ctx, nil,
+		TokenBucketConfig{Rate: 1000, Burst: 3},
+		TokenBucketConfig{Rate: 0.001, Burst: 2},
+	)
+	filter := func(eui *types.EUI64, packetType encoding.PacketType) error {
+		return f.Filter(encoding.Packet{
+			GatewayEUI:  eui,
+			GatewayAddr: addr,
+			PacketType:  packetType,
+		})
+	}
+
+	// The PULL_DATA bucket allows a burst of 2 and refills slowly.
+	a.So(filter(eui1, encoding.PullData), should.BeNil)
+	a.So(filter(eui1, encoding.PullData), should.BeNil)
+	a.So(errors.IsResourceExhausted(filter(eui1, encoding.PullData)), should.BeTrue)
+
+	// Buckets are per gateway and per packet type.
+	a.So(filter(eui2, encoding.PullData), should.BeNil)
+	for i := 0; i < 3; i++ {
+		a.So(filter(eui1, encoding.PushData), should.BeNil)
+	}
+	a.So(errors.IsResourceExhausted(filter(eui1, encoding.PushData)), should.BeTrue)
+
+	// The PUSH_DATA bucket refills quickly.
+	time.Sleep(test.Delay)
+	a.So(filter(eui1, encoding.PushData), should.BeNil)
+
+	// Other packet types are not limited.
+	for i := 0; i < 10; i++ {
+		a.So(filter(eui1, encoding.TxAck), should.BeNil)
+	}
+}
@@ -136,6 +136,30 @@ func TestMemoryFirewall(t *testing.T) {
 			},
 			ErrorCheck: isNoError, // permit change of downlink address after block time
 		},
+		{
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui2,
+				GatewayAddr: &upAddr2,
+				PacketType:  encoding.PushData,
+			},
+			ErrorCheck: errors.IsFailedPrecondition, // block change back to the previous address
+		},
+		{
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui2,
+				GatewayAddr: &addr3,
+				PacketType:  encoding.PullData,
+			},
+			ErrorCheck: isNoError, // connected address is still in use
+		},
+		{
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui2,
+				GatewayAddr: &upAddr2,
+				PacketType:  encoding.PushData,
+			},
+			ErrorCheck: errors.IsPermissionDenied, // both addresses are used in parallel
+		},
 	} {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			a := assertions.New(t)
@@ -149,3 +173,48 @@ func TestMemoryFirewall(t *testing.T) {
 		})
 	}
 }
+
+func TestSourceFilter(t *testing.T) {
+	a := assertions.New(t)
+
+	_, err := NewSourceFilter([]string{"192.0.2.1"})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	f, err := NewSourceFilter([]string{"192.0.2.0/24", "2001:db8::/32"})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	eui := types.EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	for i, tc := range []struct {
+		Addr       *net.UDPAddr
+		ErrorCheck func(error) bool
+	}{
+		{
+			Addr:       nil,
+			ErrorCheck: errors.IsInvalidArgument, // no address
+		},
+		{
+			Addr:       &net.UDPAddr{IP: net.ParseIP("192.0.2.10"), Port: 1},
+			ErrorCheck: isNoError,
+		},
+		{
+			Addr:       &net.UDPAddr{IP: net.ParseIP("2001:db8::1"), Port: 1},
+			ErrorCheck: isNoError,
+		},
+		{
+			Addr:       &net.UDPAddr{IP: net.ParseIP("198.51.100.10"), Port: 1},
+			ErrorCheck: errors.IsPermissionDenied,
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			a := assertions.New(t)
+			err := f.Filter(encoding.Packet{
+				GatewayEUI:  &eui,
+				GatewayAddr: tc.Addr,
+				PacketType:  encoding.PushData,
+			})
+			a.So(tc.ErrorCheck(err), should.BeTrue)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	evtFirewallRateLimit = events.Define(
+		"gs.gateway.firewall.rate_limit", "filter gateway traffic that exceeds the rate limit",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_STATUS_READ),
+		events.WithErrorDataType(),
+	)
+	evtFirewallSourceNotAllowed = events.Define(
+		"gs.gateway.firewall.source_not_allowed", "filter gateway traffic from source address that is not allowed",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_STATUS_READ),
+		events.WithErrorDataType(),
+	)
+	evtFirewallAddressChange = events.Define(
+		"gs.gateway.firewall.address_change", "filter gateway traffic from changed address",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_STATUS_READ),
+		events.WithErrorDataType(),
+	)
+	evtFirewallSpoofing = events.Define(
+		"gs.gateway.firewall.spoofing", "detect gateway EUI spoofing",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_STATUS_READ),
+		events.WithErrorDataType(),
+	)
+)
+
+const (
+	subsystem = "gs_udp"
+	reason    = "reason"
+
+	reasonRateLimit        = "rate_limit"
+	reasonSourceNotAllowed = "source_not_allowed"
+	reasonAddressChange    = "address_change"
+	reasonSpoofing         = "spoofing"
+	reasonInvalid          = "invalid"
+)
+
+var udpMetrics = &firewallMetrics{
+	packetsFiltered: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "firewall_filtered_total",
+			Help:      "Number of packets filtered by the firewall",
+		},
+		[]string{reason},
+	),
+}
+
+func init() {
+	metrics.MustRegister(udpMetrics)
+}
+
+type firewallMetrics struct {
+	packetsFiltered *metrics.ContextualCounterVec
+}
+
+func (m firewallMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.packetsFiltered.Describe(ch)
+}
+
+func (m firewallMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.packetsFiltered.Collect(ch)
+}
+
+// firewallEventInterval is the minimum interval between firewall events of the same type for a gateway.
+// Filtered traffic can be flooded, so events are throttled to keep the event streams usable.
+const firewallEventInterval = 10 * time.Second
+
+type firewallEventKey struct {
+	eui  types.EUI64
+	name string
+}
+
+// firewallReason returns the metric label value and event definition of the given firewall error.
+func firewallReason(err error) (string, events.Builder) {
+	switch {
+	case errors.Resemble(err, errRateExceeded), errors.Resemble(err, errPacketRateExceeded):
+		return reasonRateLimit, evtFirewallRateLimit
+	case errors.Resemble(err, errSourceNotAllowed):
+		return reasonSourceNotAllowed, evtFirewallSourceNotAllowed
+	case errors.Resemble(err, errAlreadyConnected):
+		return reasonAddressChange, evtFirewallAddressChange
+	case errors.Resemble(err, errEUISpoofing):
+		return reasonSpoofing, evtFirewallSpoofing
+	default:
+		return reasonInvalid, nil
+	}
+}
+
+// registerFirewallFilter registers a packet of the gateway with the given EUI that is filtered by the firewall.
+// The event is only published if the gateway is connected, as the gateway identifiers are not known otherwise.
+func (s *srv) registerFirewallFilter(ctx context.Context, eui types.EUI64, err error) {
+	label, def := firewallReason(err)
+	udpMetrics.packetsFiltered.WithLabelValues(ctx, label).Inc()
+	if def == nil {
+		return
+	}
+	val, ok := s.connections.Load(eui)
+	if !ok {
+		return
+	}
+	cs := val.(*state)
+	select {
+	case <-cs.ioWait:
+	default:
+		return
+	}
+	if cs.io == nil {
+		return
+	}
+	now := time.Now()
+	key := firewallEventKey{
+		eui:  eui,
+		name: def.Definition().Name(),
+	}
+	if last, ok := s.firewallEvents.Load(key); ok && now.Sub(last.(time.Time)) < firewallEventInterval {
+		return
+	}
+	s.firewallEvents.Store(key, now)
+	events.Publish(def.NewWithIdentifiersAndData(cs.io.Context(), cs.io.Gateway(), err))
+}
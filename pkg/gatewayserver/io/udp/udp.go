@@ -43,7 +43,20 @@ type srv struct {
 	conn        *net.UDPConn
 	packetCh    chan encoding.Packet
 	connections sync.Map
-	firewall    Firewall
+	// firewall filters packets before the gateway is connected.
+	firewall Firewall
+	// addrFirewall filters packets of connected gateways by address.
+	addrFirewall   Firewall
+	firewallEvents sync.Map // firewallEventKey to time.Time
+	// sourceFilters contains the source address allow-lists of gateways that are or were recently connected.
+	sourceFilters sync.Map // types.EUI64 to cachedSourceFilter
+}
+
+// cachedSourceFilter is the source address allow-list of a gateway.
+type cachedSourceFilter struct {
+	Firewall
+	// lastSeen is the time at which the gateway was last connected.
+	lastSeen time.Time
 }
 
 func (*srv) Protocol() string            { return "udp" }
@@ -54,20 +67,22 @@ var errUDPFrontendRecovered = errors.DefineInternal("udp_frontend_recovered", "i
 // Serve serves the UDP frontend.
 func Serve(ctx context.Context, server io.Server, conn *net.UDPConn, config Config) error {
 	ctx = log.NewContextWithField(ctx, "namespace", "gatewayserver/io/udp")
-	var firewall Firewall
-	if config.AddrChangeBlock > 0 {
-		firewall = NewMemoryFirewall(ctx, config.AddrChangeBlock)
-	}
+	var firewall, addrFirewall Firewall
 	if config.RateLimiting.Enable == true {
-		firewall = NewRateLimitingFirewall(firewall, config.RateLimiting.Messages, config.RateLimiting.Threshold)
+		firewall = NewRateLimitingFirewall(nil, config.RateLimiting.Messages, config.RateLimiting.Threshold)
+		firewall = NewTokenBucketFirewall(ctx, firewall, config.RateLimiting.PushData, config.RateLimiting.PullData)
+	}
+	if config.AddrChangeBlock > 0 {
+		addrFirewall = NewMemoryFirewall(ctx, config.AddrChangeBlock)
 	}
 	s := &srv{
-		ctx:      ctx,
-		config:   config,
-		server:   server,
-		conn:     conn,
-		packetCh: make(chan encoding.Packet, config.PacketBuffer),
-		firewall: firewall,
+		ctx:          ctx,
+		config:       config,
+		server:       server,
+		conn:         conn,
+		packetCh:     make(chan encoding.Packet, config.PacketBuffer),
+		firewall:     firewall,
+		addrFirewall: addrFirewall,
 	}
 	go s.gc()
 	go func() {
@@ -141,6 +156,16 @@ func (s *srv) handlePackets() {
 			ctx := log.NewContextWithField(s.ctx, "gateway_eui", eui)
 			logger := log.FromContext(ctx)
 
+			// Filter by the cached allow-list of the gateway first, so that packets from addresses that are not allowed
+			// are not acknowledged, do not consume the rate limits of the gateway and do not connect the gateway.
+			if val, ok := s.sourceFilters.Load(eui); ok {
+				if err := val.(cachedSourceFilter).Filter(packet); err != nil {
+					logger.WithError(err).Warn("Packet filtered")
+					s.registerFirewallFilter(ctx, eui, err)
+					break
+				}
+			}
+
 			switch packet.PacketType {
 			case encoding.PullData, encoding.PushData:
 				if err := s.writeAckFor(packet); err != nil {
@@ -151,6 +176,7 @@ func (s *srv) handlePackets() {
 			if s.firewall != nil {
 				if err := s.firewall.Filter(packet); err != nil {
 					logger.WithError(err).Warn("Packet filtered")
+					s.registerFirewallFilter(ctx, eui, err)
 					break
 				}
 			}
@@ -161,6 +187,15 @@ func (s *srv) handlePackets() {
 				break
 			}
 
+			// Filter by address after connecting as well, so that the allow-list of the gateway is known when it is not
+			// cached yet, and so that packets from addresses that are not allowed cannot block the gateway in the address
+			// firewall.
+			if err := s.filterAddress(cs, packet); err != nil {
+				logger.WithError(err).Warn("Packet filtered")
+				s.registerFirewallFilter(ctx, eui, err)
+				break
+			}
+
 			if err := s.handleUp(cs.io.Context(), cs, packet); err != nil {
 				logger.WithError(err).Warn("Failed to handle upstream packet")
 			}
@@ -208,6 +243,19 @@ func (s *srv) connect(ctx context.Context, eui types.EUI64) (*state, error) {
 		if err != nil {
 			return nil, err
 		}
+		if cidrs := io.Gateway().UDPAllowedSourceCIDRs; len(cidrs) > 0 {
+			cs.sourceFilter, err = NewSourceFilter(cidrs)
+			if err != nil {
+				io.Disconnect(err)
+				return nil, err
+			}
+			s.sourceFilters.Store(eui, cachedSourceFilter{
+				Firewall: cs.sourceFilter,
+				lastSeen: time.Now(),
+			})
+		} else {
+			s.sourceFilters.Delete(eui)
+		}
 	} else {
 		select {
 		case <-cs.ioWait:
@@ -221,8 +269,21 @@ func (s *srv) connect(ctx context.Context, eui types.EUI64) (*state, error) {
 	return cs, nil
 }
 
+func (s *srv) filterAddress(cs *state, packet encoding.Packet) error {
+	if cs.sourceFilter != nil {
+		if err := cs.sourceFilter.Filter(packet); err != nil {
+			return err
+		}
+	}
+	if s.addrFirewall != nil {
+		return s.addrFirewall.Filter(packet)
+	}
+	return nil
+}
+
 func (s *srv) handleUp(ctx context.Context, state *state, packet encoding.Packet) error {
 	logger := log.FromContext(ctx)
+	s.capture(state, ttnpb.GatewayTrafficCaptureRecord_UPSTREAM, packet)
 	md := encoding.UpstreamMetadata{
 		ID: state.io.Gateway().GatewayIdentifiers,
 		IP: packet.GatewayAddr.IP.String(),
@@ -369,6 +430,7 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 				logger.Debug("Write downlink message")
 				token := state.tokens.Next(down.CorrelationIDs, time.Now())
 				packet.Token = [2]byte{byte(token >> 8), byte(token)}
+				s.capture(state, ttnpb.GatewayTrafficCaptureRecord_DOWNSTREAM, packet)
 				if err := s.write(packet); err != nil {
 					logger.WithError(err).Warn("Failed to write downlink message")
 					// TODO: Report to Network Server: https://github.com/TheThingsNetwork/lorawan-stack/issues/76
@@ -411,6 +473,19 @@ func (s *srv) write(packet encoding.Packet) error {
 	return err
 }
 
+// capture captures the packet if the traffic of the gateway is being captured.
+// As the packet is marshaled again, the captured packet may differ in encoding from the packet on the wire.
+func (s *srv) capture(state *state, direction ttnpb.GatewayTrafficCaptureRecord_Direction, packet encoding.Packet) {
+	if !state.io.IsCapturing() {
+		return
+	}
+	buf, err := packet.MarshalBinary()
+	if err != nil {
+		return
+	}
+	state.io.CaptureRaw(direction, packet.GatewayAddr.String(), buf)
+}
+
 func (s *srv) writeAckFor(packet encoding.Packet) error {
 	ack, err := packet.BuildAck()
 	if err != nil {
@@ -462,6 +537,23 @@ func (s *srv) gc() {
 				}
 				return true
 			})
+			s.firewallEvents.Range(func(k, v interface{}) bool {
+				if time.Since(v.(time.Time)) > firewallEventInterval {
+					s.firewallEvents.Delete(k)
+				}
+				return true
+			})
+			now := time.Now()
+			s.sourceFilters.Range(func(k, v interface{}) bool {
+				filter := v.(cachedSourceFilter)
+				if _, ok := s.connections.Load(k); ok {
+					filter.lastSeen = now
+					s.sourceFilters.Store(k, filter)
+				} else if now.Sub(filter.lastSeen) > s.config.SourceFilterCacheTTL {
+					s.sourceFilters.Delete(k)
+				}
+				return true
+			})
 		}
 	}
 }
@@ -490,6 +582,8 @@ type state struct {
 	startHandleDownMu sync.RWMutex
 
 	tokens io.DownlinkTokens
+
+	sourceFilter Firewall
 }
 
 func recoverUDPFrontend(ctx context.Context) error {
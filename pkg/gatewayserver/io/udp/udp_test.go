@@ -50,6 +50,8 @@ var (
 		DownlinkPathExpires: 8 * timeout,
 		ConnectionExpires:   20 * timeout,
 		ScheduleLateTime:    0,
+
+		SourceFilterCacheTTL: 100 * timeout,
 	}
 )
 
@@ -193,6 +195,82 @@ func TestConnection(t *testing.T) {
 	cancelCtx()
 }
 
+func TestSourceFilterBeforeConnect(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	gs := mock.NewServer(c)
+	eui := types.EUI64{0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02}
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayID: "eui-0202020202020202",
+		EUI:       &eui,
+	}
+	gs.RegisterGateway(ctx, ids, &ttnpb.Gateway{
+		GatewayIdentifiers:    ids,
+		FrequencyPlanID:       test.EUFrequencyPlanID,
+		UDPAllowedSourceCIDRs: []string{"127.0.0.1/32"},
+	})
+
+	lis, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	go Serve(ctx, gs, lis, testConfig)
+
+	allowed, err := net.DialUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)}, lis.LocalAddr().(*net.UDPAddr))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	spoofed, err := net.DialUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 2)}, lis.LocalAddr().(*net.UDPAddr))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	send := func(conn net.Conn, i int) [2]byte {
+		packet := generatePullData(eui)
+		packet.Token[1] = byte(i)
+		buf, err := packet.MarshalBinary()
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		if _, err := conn.Write(buf); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return packet.Token
+	}
+
+	connections := &sync.Map{}
+
+	// Connect the gateway from the allowed address, so that its allow-list is cached.
+	expectAck(t, allowed, true, encoding.PullAck, send(allowed, 0))
+	expectConnection(t, gs, connections, eui, true)
+
+	// Packets from other addresses are not acknowledged.
+	expectAck(t, spoofed, false, encoding.PullAck, send(spoofed, 1))
+
+	// Packets from other addresses do not connect the gateway after the connection expires.
+	time.Sleep(testConfig.ConnectionExpires * 150 / 100)
+	connections.Delete(eui)
+	expectAck(t, spoofed, false, encoding.PullAck, send(spoofed, 2))
+	select {
+	case <-gs.Connections():
+		t.Fatal("Gateway connected from address that is not allowed")
+	case <-time.After(timeout):
+	}
+
+	// The gateway reconnects from the allowed address.
+	expectAck(t, allowed, true, encoding.PullAck, send(allowed, 3))
+	expectConnection(t, gs, connections, eui, true)
+}
+
 func TestTraffic(t *testing.T) {
 	a := assertions.New(t)
 
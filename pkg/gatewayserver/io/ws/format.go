@@ -48,3 +48,10 @@ type Formatter interface {
 	// FromDownlink generates a downlink byte stream that can be sent over the WS connection.
 	FromDownlink(ctx context.Context, uid string, down ttnpb.DownlinkMessage, concentratorTime scheduling.ConcentratorTime, dlTime time.Time) ([]byte, error)
 }
+
+// RemoteCommandFormatter is a Formatter that supports running remote commands on web socket based gateways.
+type RemoteCommandFormatter interface {
+	Formatter
+	// FromRemoteCommand generates a remote command byte stream that can be sent over the WS connection.
+	FromRemoteCommand(ctx context.Context, cmd ttnpb.RunGatewayRemoteCommandRequest) ([]byte, error)
+}
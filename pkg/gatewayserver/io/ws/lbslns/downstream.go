@@ -104,6 +104,36 @@ func (f *lbsLNS) FromDownlink(ctx context.Context, uid string, down ttnpb.Downli
 	return dnmsg.marshalJSON()
 }
 
+// RemoteCommand is the remote command "runcmd" sent to the LoRa Basics Station.
+type RemoteCommand struct {
+	Command   string   `json:"command"`
+	Arguments []string `json:"arguments"`
+}
+
+// marshalJSON marshals cmd to a JSON byte array.
+func (cmd RemoteCommand) marshalJSON() ([]byte, error) {
+	type Alias RemoteCommand
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeDownstreamRemoteCommand,
+		Alias: Alias(cmd),
+	})
+}
+
+// FromRemoteCommand implements ws.RemoteCommandFormatter.
+func (f *lbsLNS) FromRemoteCommand(ctx context.Context, req ttnpb.RunGatewayRemoteCommandRequest) ([]byte, error) {
+	cmd := RemoteCommand{
+		Command:   req.Command,
+		Arguments: req.Arguments,
+	}
+	if cmd.Arguments == nil {
+		cmd.Arguments = []string{}
+	}
+	return cmd.marshalJSON()
+}
+
 // ToDownlinkMessage translates the LNS DownlinkMessage "dnmsg" to ttnpb.DownlinkMessage.
 func (dnmsg *DownlinkMessage) ToDownlinkMessage() ttnpb.DownlinkMessage {
 	return ttnpb.DownlinkMessage{
@@ -190,3 +190,36 @@ func TestToDownlinkMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestFromRemoteCommand(t *testing.T) {
+	var lbsLNS lbsLNS
+	ctx := test.Context()
+	for _, tc := range []struct {
+		Name     string
+		Request  ttnpb.RunGatewayRemoteCommandRequest
+		Expected string
+	}{
+		{
+			Name: "NoArguments",
+			Request: ttnpb.RunGatewayRemoteCommandRequest{
+				Command: "uptime",
+			},
+			Expected: `{"msgtype":"runcmd","command":"uptime","arguments":[]}`,
+		},
+		{
+			Name: "WithArguments",
+			Request: ttnpb.RunGatewayRemoteCommandRequest{
+				Command:   "/bin/sh",
+				Arguments: []string{"-c", "df -h"},
+			},
+			Expected: `{"msgtype":"runcmd","command":"/bin/sh","arguments":["-c","df -h"]}`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			raw, err := lbsLNS.FromRemoteCommand(ctx, tc.Request)
+			a.So(err, should.BeNil)
+			a.So(string(raw), should.Equal, tc.Expected)
+		})
+	}
+}
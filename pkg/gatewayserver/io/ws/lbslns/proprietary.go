@@ -0,0 +1,111 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lbslns
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/util"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errProprietaryDataFrame = errors.Define("proprietary_data_frame", "invalid proprietary data frame received")
+
+	evtReceiveProprietaryDataFrame = events.Define(
+		"gs.up.proprietary.receive", "receive proprietary data frame",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.UplinkMessage{}),
+	)
+)
+
+// ProprietaryDataFrame is the proprietary data frame from the LoRa Basics Station.
+type ProprietaryDataFrame struct {
+	FRMPayload string  `json:"FRMPayload"`
+	RefTime    float64 `json:"RefTime"`
+	RadioMetaData
+}
+
+// MarshalJSON implements json.Marshaler.
+func (propdf ProprietaryDataFrame) MarshalJSON() ([]byte, error) {
+	type Alias ProprietaryDataFrame
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeUpstreamProprietaryDataFrame,
+		Alias: Alias(propdf),
+	})
+}
+
+// toUplinkMessage converts the Basics Station proprietary data frame "propdf" message into an UplinkMessage.
+// The payload of the message is not decoded, as proprietary frames do not follow the LoRaWAN message format.
+func (propdf *ProprietaryDataFrame) toUplinkMessage(ids ttnpb.GatewayIdentifiers, bandID string, receivedAt time.Time) (*ttnpb.UplinkMessage, error) {
+	var up ttnpb.UplinkMessage
+	up.ReceivedAt = receivedAt
+
+	rawPayload, err := hex.DecodeString(propdf.FRMPayload)
+	if err != nil {
+		return nil, errProprietaryDataFrame.WithCause(err)
+	}
+	up.RawPayload = rawPayload
+
+	timestamp := uint32(propdf.RadioMetaData.UpInfo.XTime & 0xFFFFFFFF)
+
+	var rxTime *time.Time
+	sec, nsec := math.Modf(propdf.RadioMetaData.UpInfo.RxTime)
+	if sec != 0 {
+		val := time.Unix(int64(sec), int64(nsec*(1e9)))
+		rxTime = &val
+	}
+
+	up.RxMetadata = []*ttnpb.RxMetadata{
+		{
+			GatewayIdentifiers: ids,
+			Time:               rxTime,
+			Timestamp:          timestamp,
+			RSSI:               propdf.RadioMetaData.UpInfo.RSSI,
+			ChannelRSSI:        propdf.RadioMetaData.UpInfo.RSSI,
+			SNR:                propdf.RadioMetaData.UpInfo.SNR,
+			AntennaIndex:       uint32(propdf.RadioMetaData.UpInfo.RCtx),
+		},
+	}
+
+	dataRate, isLora, err := util.GetDataRateFromIndex(bandID, propdf.RadioMetaData.DataRate)
+	if err != nil {
+		return nil, errProprietaryDataFrame.WithCause(err)
+	}
+
+	var codingRate string
+	if isLora {
+		codingRate = "4/5"
+	} else if lrfhss := dataRate.GetLRFHSS(); lrfhss != nil {
+		codingRate = lrfhss.CodingRate
+	}
+
+	up.Settings = ttnpb.TxSettings{
+		Frequency:  propdf.RadioMetaData.Frequency,
+		DataRate:   dataRate,
+		CodingRate: codingRate,
+		Timestamp:  timestamp,
+		Time:       rxTime,
+	}
+	return &up, nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lbslns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestProprietaryDataFrame(t *testing.T) {
+	gtwID := ttnpb.GatewayIdentifiers{
+		GatewayID: "eui-1122334455667788",
+		EUI:       &types.EUI64{0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88},
+	}
+
+	for _, tc := range []struct {
+		Name                  string
+		ProprietaryDataFrame  ProprietaryDataFrame
+		ExpectedUplinkMessage ttnpb.UplinkMessage
+		ErrorAssertion        func(err error) bool
+	}{
+		{
+			Name: "InvalidPayload",
+			ProprietaryDataFrame: ProprietaryDataFrame{
+				FRMPayload: "xyz",
+			},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errProprietaryDataFrame)
+			},
+		},
+		{
+			Name: "ValidFrame",
+			ProprietaryDataFrame: ProprietaryDataFrame{
+				FRMPayload: "e01122334455",
+				RadioMetaData: RadioMetaData{
+					DataRate:  1,
+					Frequency: 868300000,
+					UpInfo: UpInfo{
+						RxTime: 1548059982,
+						XTime:  12666373963464220,
+						RSSI:   89,
+						SNR:    9.25,
+					},
+				},
+			},
+			ExpectedUplinkMessage: ttnpb.UplinkMessage{
+				RawPayload: []byte{0xE0, 0x11, 0x22, 0x33, 0x44, 0x55},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIdentifiers: gtwID,
+						Time:               &[]time.Time{time.Unix(1548059982, 0)}[0],
+						Timestamp:          (uint32)(12666373963464220 & 0xFFFFFFFF),
+						RSSI:               89,
+						ChannelRSSI:        89,
+						SNR:                9.25,
+					},
+				},
+				Settings: ttnpb.TxSettings{
+					Timestamp:  (uint32)(12666373963464220 & 0xFFFFFFFF),
+					Time:       &[]time.Time{time.Unix(1548059982, 0)}[0],
+					CodingRate: "4/5",
+					Frequency:  868300000,
+					DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+						SpreadingFactor: 11,
+						Bandwidth:       125000,
+					}}},
+				},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			msg, err := tc.ProprietaryDataFrame.toUplinkMessage(gtwID, "EU_863_870", time.Time{})
+			if err != nil {
+				if tc.ErrorAssertion == nil || !a.So(tc.ErrorAssertion(err), should.BeTrue) {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			} else if tc.ErrorAssertion != nil {
+				t.Fatalf("Expected error")
+			} else {
+				a.So(*msg, should.Resemble, tc.ExpectedUplinkMessage)
+			}
+		})
+	}
+}
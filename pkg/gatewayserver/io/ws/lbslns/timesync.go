@@ -0,0 +1,66 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lbslns
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+)
+
+// TimeSyncRequest is the time synchronization request from the LoRa Basics Station.
+type TimeSyncRequest struct {
+	TxTime float64 `json:"txtime"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (req TimeSyncRequest) MarshalJSON() ([]byte, error) {
+	type Alias TimeSyncRequest
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeUpstreamTimeSync,
+		Alias: Alias(req),
+	})
+}
+
+// TimeSyncResponse is the time synchronization response to the LoRa Basics Station.
+// The GPS time is the number of microseconds since the GPS epoch.
+type TimeSyncResponse struct {
+	TxTime  float64 `json:"txtime"`
+	GPSTime int64   `json:"gpstime"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (res TimeSyncResponse) MarshalJSON() ([]byte, error) {
+	type Alias TimeSyncResponse
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeDownstreamTimeSync,
+		Alias: Alias(res),
+	})
+}
+
+// Response returns the time synchronization response to the request, with the GPS time at the given server time.
+func (req TimeSyncRequest) Response(serverTime time.Time) TimeSyncResponse {
+	return TimeSyncResponse{
+		TxTime:  req.TxTime,
+		GPSTime: int64(gpstime.ToGPS(serverTime) / time.Microsecond),
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lbslns
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestTimeSync(t *testing.T) {
+	a := assertions.New(t)
+
+	var req TimeSyncRequest
+	err := json.Unmarshal([]byte(`{"msgtype":"timesync","txtime":1548059982.123}`), &req)
+	a.So(err, should.BeNil)
+	a.So(req.TxTime, should.Equal, 1548059982.123)
+
+	// The GPS epoch is 1980-01-06 and GPS time is 18 leap seconds ahead of UTC since 2017.
+	res := req.Response(time.Unix(1600000000, 0))
+	a.So(res.TxTime, should.Equal, req.TxTime)
+	a.So(res.GPSTime, should.Equal, int64(1600000000-315964800+18)*1000000)
+
+	data, err := res.MarshalJSON()
+	a.So(err, should.BeNil)
+	a.So(string(data), should.Equal, `{"msgtype":"timesync","txtime":1548059982.123,"gpstime":1284035218000000}`)
+}
@@ -25,6 +25,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/basicstation"
 	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/util"
@@ -186,6 +187,8 @@ func (req *JoinRequest) toUplinkMessage(ids ttnpb.GatewayIdentifiers, bandID str
 	var codingRate string
 	if isLora {
 		codingRate = "4/5"
+	} else if lrfhss := dataRate.GetLRFHSS(); lrfhss != nil {
+		codingRate = lrfhss.CodingRate
 	}
 
 	up.Settings = ttnpb.TxSettings{
@@ -344,6 +347,8 @@ func (updf *UplinkDataFrame) toUplinkMessage(ids ttnpb.GatewayIdentifiers, bandI
 	var codingRate string
 	if isLora {
 		codingRate = "4/5"
+	} else if lrfhss := dataRate.GetLRFHSS(); lrfhss != nil {
+		codingRate = lrfhss.CodingRate
 	}
 
 	up.Settings = ttnpb.TxSettings{
@@ -540,7 +545,31 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids ttnpb.GatewayIden
 		}
 		recordTime(recordRTT, txConf.RefTime, txConf.XTime, receivedAt)
 
-	case TypeUpstreamProprietaryDataFrame, TypeUpstreamRemoteShell, TypeUpstreamTimeSync:
+	case TypeUpstreamTimeSync:
+		var req TimeSyncRequest
+		if err := json.Unmarshal(raw, &req); err != nil {
+			return nil, err
+		}
+		res, err := req.Response(receivedAt).MarshalJSON()
+		if err != nil {
+			logger.WithError(err).Warn("Failed to marshal time sync response")
+			return nil, err
+		}
+		return res, nil
+
+	case TypeUpstreamProprietaryDataFrame:
+		var propdf ProprietaryDataFrame
+		if err := json.Unmarshal(raw, &propdf); err != nil {
+			return nil, err
+		}
+		up, err := propdf.toUplinkMessage(ids, conn.BandID(), receivedAt)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to parse proprietary data frame")
+			return nil, err
+		}
+		events.Publish(evtReceiveProprietaryDataFrame.NewWithIdentifiersAndData(ctx, ids, up))
+
+	case TypeUpstreamRemoteShell:
 		logger.WithField("message_type", typ).Debug("Message type not implemented")
 
 	default:
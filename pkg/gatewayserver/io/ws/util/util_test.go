@@ -67,6 +67,16 @@ func TestGetDataRateFromDataRateIndex(t *testing.T) {
 				BitRate: 50000,
 			}}},
 		},
+		{
+			Name:          "Valid_EU_LRFHSS",
+			BandID:        "EU_863_870",
+			IsLora:        false,
+			DataRateIndex: 9,
+			ExpectedDataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{
+				OperatingChannelWidth: 137000,
+				CodingRate:            "2/3",
+			}}},
+		},
 		{
 			Name:             "Invalid_EU",
 			BandID:           "EU_863_870",
@@ -59,6 +59,11 @@ type srv struct {
 func (s *srv) Protocol() string            { return "ws" }
 func (s *srv) SupportsDownlinkClaim() bool { return false }
 
+func (s *srv) SupportsRemoteCommands() bool {
+	_, ok := s.formatter.(RemoteCommandFormatter)
+	return ok
+}
+
 // New creates a new WebSocket frontend.
 func New(ctx context.Context, server io.Server, formatter Formatter, cfg Config) *echo.Echo {
 	ctx = log.NewContextWithField(ctx, "namespace", "gatewayserver/io/ws")
@@ -218,6 +223,7 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 	}
 	defer ws.Close()
 	wsWriteMu := &sync.Mutex{}
+	remoteAddr := c.Request().RemoteAddr
 
 	defer func() {
 		conn.Disconnect(err)
@@ -276,6 +282,7 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 				}
 
 				logger.Info("Send downlink message")
+				conn.CaptureRaw(ttnpb.GatewayTrafficCaptureRecord_DOWNSTREAM, remoteAddr, dnmsg)
 				wsWriteMu.Lock()
 				err = ws.WriteMessage(websocket.TextMessage, dnmsg)
 				wsWriteMu.Unlock()
@@ -284,6 +291,27 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 					conn.Disconnect(err)
 					return
 				}
+			case cmd := <-conn.RemoteCommands():
+				formatter, ok := s.formatter.(RemoteCommandFormatter)
+				if !ok {
+					continue
+				}
+				runcmd, err := formatter.FromRemoteCommand(ctx, *cmd)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to marshal remote command")
+					continue
+				}
+
+				logger.WithField("command", cmd.Command).Info("Send remote command")
+				conn.CaptureRaw(ttnpb.GatewayTrafficCaptureRecord_DOWNSTREAM, remoteAddr, runcmd)
+				wsWriteMu.Lock()
+				err = ws.WriteMessage(websocket.TextMessage, runcmd)
+				wsWriteMu.Unlock()
+				if err != nil {
+					logger.WithError(err).Warn("Failed to send remote command")
+					conn.Disconnect(err)
+					return
+				}
 			}
 		}
 	}()
@@ -294,6 +322,7 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 			logger.WithError(err).Debug("Failed to read message")
 			return err
 		}
+		conn.CaptureRaw(ttnpb.GatewayTrafficCaptureRecord_UPSTREAM, remoteAddr, data)
 		sessionCtx := NewContextWithSession(ctx, &session)
 		downstream, err := s.formatter.HandleUp(sessionCtx, data, ids, conn, time.Now())
 		if err != nil {
@@ -301,6 +330,7 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 		}
 		if downstream != nil {
 			logger.Info("Send downstream message")
+			conn.CaptureRaw(ttnpb.GatewayTrafficCaptureRecord_DOWNSTREAM, remoteAddr, downstream)
 			wsWriteMu.Lock()
 			err = ws.WriteMessage(websocket.TextMessage, downstream)
 			wsWriteMu.Unlock()
@@ -993,6 +993,8 @@ func TestTraffic(t *testing.T) {
 						}
 						up.RawPayload = nil
 						up.RxMetadata[0].UplinkToken = nil
+						a.So(up.RxMetadata[0].DownlinkLoad, should.NotBeNil)
+						up.RxMetadata[0].DownlinkLoad = nil
 						expectedUp := tc.ExpectedNetworkUpstream.(ttnpb.UplinkMessage)
 						a.So(up.UplinkMessage, should.Resemble, &expectedUp)
 					case <-time.After(timeout):
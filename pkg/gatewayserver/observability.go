@@ -61,6 +61,11 @@ var (
 		events.WithVisibility(ttnpb.RIGHT_GATEWAY_STATUS_READ),
 		events.WithErrorDataType(),
 	)
+	evtRunRemoteCommand = events.Define(
+		"gs.gateway.remote_command.run", "run remote command on gateway",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC),
+		events.WithDataType(&ttnpb.RunGatewayRemoteCommandRequest{}),
+	)
 	evtReceiveUp = events.Define(
 		"gs.up.receive", "receive uplink message",
 		events.WithVisibility(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
@@ -339,6 +344,11 @@ func registerSuccessDownlink(ctx context.Context, gtw *ttnpb.Gateway, protocol s
 	gsMetrics.downlinkSent.WithLabelValues(ctx, protocol).Inc()
 }
 
+func registerFailScheduleDownlink(ctx context.Context, gtw *ttnpb.Gateway, msg *ttnpb.DownlinkMessage, err error) {
+	ctx = events.ContextWithCorrelationID(ctx, msg.CorrelationIDs...)
+	events.Publish(evtTxFailureDown.NewWithIdentifiersAndData(ctx, gtw, err))
+}
+
 func registerFailDownlink(ctx context.Context, gtw *ttnpb.Gateway, ack *ttnpb.TxAcknowledgment, protocol string) {
 	events.Publish(evtTxFailureDown.NewWithIdentifiersAndData(ctx, gtw, ack.Result))
 	gsMetrics.downlinkTxFailed.WithLabelValues(ctx, protocol).Inc()
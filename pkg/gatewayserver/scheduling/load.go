@@ -0,0 +1,103 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// txResultTracker keeps exponentially decaying transmission and failure counters of a gateway.
+// Observations decay with OccupancyHalfLife.
+type txResultTracker struct {
+	mu           sync.Mutex
+	tx, failed   float64
+	lastUpdateAt time.Time
+}
+
+func (t *txResultTracker) decay(now time.Time) {
+	if t.lastUpdateAt.IsZero() || !now.After(t.lastUpdateAt) {
+		return
+	}
+	f := math.Exp2(-float64(now.Sub(t.lastUpdateAt)) / float64(OccupancyHalfLife))
+	t.tx *= f
+	t.failed *= f
+	t.lastUpdateAt = now
+}
+
+// ReportTxResult reports the outcome of a downlink transmission.
+// If failed is true, the gateway did not transmit the downlink, regardless of the reason.
+func (s *Scheduler) ReportTxResult(failed bool) {
+	now := s.timeSource.Now()
+	s.txResults.mu.Lock()
+	defer s.txResults.mu.Unlock()
+	s.txResults.decay(now)
+	s.txResults.lastUpdateAt = now
+	s.txResults.tx++
+	if failed {
+		s.txResults.failed++
+	}
+}
+
+// TxFailureRate returns the decayed fraction of downlink transmissions that the gateway failed to transmit, between 0 and 1.
+func (s *Scheduler) TxFailureRate() float32 {
+	now := s.timeSource.Now()
+	s.txResults.mu.Lock()
+	defer s.txResults.mu.Unlock()
+	s.txResults.decay(now)
+	if s.txResults.tx == 0 {
+		return 0
+	}
+	return float32(s.txResults.failed / s.txResults.tx)
+}
+
+// QueueDepth returns the number of scheduled emissions that did not end yet.
+// This method returns 0 if the clock is not synced with the server.
+func (s *Scheduler) QueueDepth() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.clock.IsSynced() {
+		return 0
+	}
+	now, ok := s.clock.FromServerTime(s.timeSource.Now())
+	if !ok {
+		return 0
+	}
+	n := 0
+	for _, em := range s.emissions {
+		if em.Ends() > now {
+			n++
+		}
+	}
+	return n
+}
+
+// DownlinkLoad returns the downlink load of the gateway.
+// The utilization is the highest duty-cycle utilization of the sub-bands.
+func (s *Scheduler) DownlinkLoad() *ttnpb.GatewayDownlinkLoad {
+	load := &ttnpb.GatewayDownlinkLoad{
+		QueueDepth:    uint32(s.QueueDepth()),
+		TxFailureRate: s.TxFailureRate(),
+	}
+	for _, sb := range s.subBands {
+		if u := sb.DutyCycleUtilization(); u > load.Utilization {
+			load.Utilization = u
+		}
+	}
+	return load
+}
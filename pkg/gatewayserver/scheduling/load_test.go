@@ -0,0 +1,91 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDownlinkLoad(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	fps := map[string]*frequencyplans.FrequencyPlan{test.EUFrequencyPlanID: {
+		BandID: band.EU_863_870,
+	}}
+	timeSource := &mockTimeSource{
+		Time: time.Unix(0, 0),
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fps, true, nil, timeSource)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	a.So(scheduler.QueueDepth(), should.Equal, 0)
+	a.So(scheduler.TxFailureRate(), should.Equal, float32(0))
+
+	scheduler.SyncWithGatewayAbsolute(0, timeSource.Time, time.Unix(0, 0))
+	for _, timestamp := range []uint32{1000000, 4000000} {
+		_, err := scheduler.ScheduleAt(ctx, scheduling.Options{
+			PayloadSize: 10,
+			TxSettings: ttnpb.TxSettings{
+				DataRate: ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_LoRa{
+						LoRa: &ttnpb.LoRaDataRate{
+							Bandwidth:       125000,
+							SpreadingFactor: 7,
+						},
+					},
+				},
+				CodingRate: "4/5",
+				Frequency:  869525000,
+				Timestamp:  timestamp,
+			},
+			Priority: ttnpb.TxSchedulePriority_NORMAL,
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	a.So(scheduler.QueueDepth(), should.Equal, 2)
+
+	// The first emission ended.
+	timeSource.Time = timeSource.Time.Add(3 * time.Second)
+	a.So(scheduler.QueueDepth(), should.Equal, 1)
+
+	scheduler.ReportTxResult(true)
+	scheduler.ReportTxResult(false)
+	scheduler.ReportTxResult(false)
+	scheduler.ReportTxResult(true)
+	a.So(scheduler.TxFailureRate(), should.Equal, float32(0.5))
+
+	// Older observations weigh less than recent observations.
+	timeSource.Time = timeSource.Time.Add(scheduling.OccupancyHalfLife)
+	scheduler.ReportTxResult(false)
+	scheduler.ReportTxResult(false)
+	a.So(scheduler.TxFailureRate(), should.Equal, float32(0.25))
+
+	load := scheduler.DownlinkLoad()
+	a.So(load.QueueDepth, should.Equal, 0)
+	a.So(load.TxFailureRate, should.Equal, float32(0.25))
+}
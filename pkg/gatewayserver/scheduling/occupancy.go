@@ -0,0 +1,125 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// OccupancyHalfLife is the half-life of channel occupancy observations.
+// Older observations weigh less, so that a channel that became clear is preferred again over time.
+const OccupancyHalfLife = 10 * time.Minute
+
+// channelOccupancy keeps exponentially decaying transmission and busy counters for a downlink frequency.
+type channelOccupancy struct {
+	tx, busy     float64
+	txCount      uint64
+	busyCount    uint64
+	lastUpdateAt time.Time
+	lastBusyAt   time.Time
+}
+
+func (c *channelOccupancy) decay(now time.Time) {
+	if c.lastUpdateAt.IsZero() || !now.After(c.lastUpdateAt) {
+		return
+	}
+	f := math.Exp2(-float64(now.Sub(c.lastUpdateAt)) / float64(OccupancyHalfLife))
+	c.tx *= f
+	c.busy *= f
+	c.lastUpdateAt = now
+}
+
+func (c *channelOccupancy) occupancy() float32 {
+	if c.tx == 0 {
+		return 0
+	}
+	return float32(c.busy / c.tx)
+}
+
+type occupancyTracker struct {
+	mu       sync.Mutex
+	channels map[uint64]*channelOccupancy
+}
+
+// ReportTransmission reports the outcome of a downlink transmission on the given frequency.
+// If busy is true, the gateway did not transmit the downlink because the channel was busy.
+func (s *Scheduler) ReportTransmission(frequency uint64, busy bool) {
+	now := s.timeSource.Now()
+	s.occupancy.mu.Lock()
+	defer s.occupancy.mu.Unlock()
+	if s.occupancy.channels == nil {
+		s.occupancy.channels = make(map[uint64]*channelOccupancy)
+	}
+	c, ok := s.occupancy.channels[frequency]
+	if !ok {
+		c = &channelOccupancy{}
+		s.occupancy.channels[frequency] = c
+	}
+	c.decay(now)
+	c.lastUpdateAt = now
+	c.tx++
+	c.txCount++
+	if busy {
+		c.busy++
+		c.busyCount++
+		c.lastBusyAt = now
+	}
+}
+
+// ChannelOccupancy returns the occupancy of the given downlink frequency.
+// The occupancy is the decayed fraction of transmissions that the gateway reported as busy, between 0 and 1.
+func (s *Scheduler) ChannelOccupancy(frequency uint64) float32 {
+	now := s.timeSource.Now()
+	s.occupancy.mu.Lock()
+	defer s.occupancy.mu.Unlock()
+	c, ok := s.occupancy.channels[frequency]
+	if !ok {
+		return 0
+	}
+	c.decay(now)
+	return c.occupancy()
+}
+
+// ChannelOccupancyStats returns the occupancy statistics of all downlink frequencies, ordered by frequency.
+func (s *Scheduler) ChannelOccupancyStats() []*ttnpb.GatewayConnectionStats_ChannelOccupancy {
+	now := s.timeSource.Now()
+	s.occupancy.mu.Lock()
+	defer s.occupancy.mu.Unlock()
+	if len(s.occupancy.channels) == 0 {
+		return nil
+	}
+	res := make([]*ttnpb.GatewayConnectionStats_ChannelOccupancy, 0, len(s.occupancy.channels))
+	for frequency, c := range s.occupancy.channels {
+		c.decay(now)
+		stats := &ttnpb.GatewayConnectionStats_ChannelOccupancy{
+			Frequency: frequency,
+			Occupancy: c.occupancy(),
+			TxCount:   c.txCount,
+			BusyCount: c.busyCount,
+		}
+		if !c.lastBusyAt.IsZero() {
+			t := c.lastBusyAt
+			stats.LastBusyAt = &t
+		}
+		res = append(res, stats)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Frequency < res[j].Frequency })
+	return res
+}
@@ -0,0 +1,77 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestChannelOccupancy(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	fps := map[string]*frequencyplans.FrequencyPlan{test.KRFrequencyPlanID: {
+		BandID: band.KR_920_923,
+	}}
+	timeSource := &mockTimeSource{
+		Time: time.Unix(0, 0),
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fps, true, nil, timeSource)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	a.So(scheduler.ChannelOccupancy(922100000), should.Equal, float32(0))
+	a.So(scheduler.ChannelOccupancyStats(), should.BeEmpty)
+
+	scheduler.ReportTransmission(922100000, true)
+	scheduler.ReportTransmission(922100000, true)
+	scheduler.ReportTransmission(922100000, false)
+	scheduler.ReportTransmission(922100000, true)
+	scheduler.ReportTransmission(922300000, false)
+	a.So(scheduler.ChannelOccupancy(922100000), should.Equal, float32(0.75))
+	a.So(scheduler.ChannelOccupancy(922300000), should.Equal, float32(0))
+
+	// Older observations weigh less than recent observations.
+	timeSource.Time = timeSource.Time.Add(scheduling.OccupancyHalfLife)
+	scheduler.ReportTransmission(922100000, false)
+	scheduler.ReportTransmission(922100000, false)
+	a.So(scheduler.ChannelOccupancy(922100000), should.Equal, float32(0.375))
+	// Decay does not change the occupancy without new observations.
+	timeSource.Time = timeSource.Time.Add(scheduling.OccupancyHalfLife)
+	a.So(scheduler.ChannelOccupancy(922100000), should.Equal, float32(0.375))
+
+	a.So(scheduler.ChannelOccupancyStats(), should.Resemble, []*ttnpb.GatewayConnectionStats_ChannelOccupancy{
+		{
+			Frequency:  922100000,
+			Occupancy:  0.375,
+			TxCount:    6,
+			BusyCount:  3,
+			LastBusyAt: timePtr(time.Unix(0, 0)),
+		},
+		{
+			Frequency: 922300000,
+			TxCount:   1,
+		},
+	})
+}
@@ -188,6 +188,8 @@ type Scheduler struct {
 	subBands             []*SubBand
 	mu                   sync.RWMutex
 	emissions            Emissions
+	occupancy            occupancyTracker
+	txResults            txResultTracker
 	scheduleAnytimeDelay time.Duration
 }
 
@@ -269,6 +271,14 @@ var (
 	errNoServerTime          = errors.DefineAborted("no_server_time", "no server time")
 )
 
+// withTrace returns the error with the scheduling trace attached as details.
+func withTrace(err error, trace *ttnpb.DownlinkSchedulingTrace) error {
+	if ttnErr, ok := errors.From(err); ok {
+		return ttnErr.WithDetails(trace)
+	}
+	return err
+}
+
 // Options define options for scheduling downlink.
 type Options struct {
 	PayloadSize int
@@ -299,6 +309,10 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, opts Options) (Emission, err
 			medianRTT = &median
 		}
 	}
+	trace := &ttnpb.DownlinkSchedulingTrace{
+		MinScheduleTime: minScheduleTime,
+		MedianRTT:       medianRTT,
+	}
 	var starts ConcentratorTime
 	now, ok := s.clock.FromServerTime(s.timeSource.Now())
 	if opts.Time != nil {
@@ -324,26 +338,38 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, opts Options) (Emission, err
 	} else {
 		starts = s.clock.FromTimestampTime(opts.Timestamp)
 	}
+	trace.Emission = &ttnpb.DownlinkSchedulingTrace_Emission{
+		Starts: int64(starts),
+	}
 	if ok {
-		if delta := time.Duration(starts - now); delta < minScheduleTime {
-			return Emission{}, errTooLate.WithAttributes("delta", delta)
+		delta := time.Duration(starts - now)
+		margin := delta - minScheduleTime
+		trace.RTTMargin = &margin
+		if delta < minScheduleTime {
+			return Emission{}, withTrace(errTooLate.WithAttributes("delta", delta), trace)
 		}
 	}
 	sb, err := s.findSubBand(opts.Frequency)
 	if err != nil {
-		return Emission{}, err
+		return Emission{}, withTrace(err, trace)
 	}
+	trace.SubBand = sb.stats()
 	em, err := s.newEmission(opts.PayloadSize, opts.TxSettings, starts)
 	if err != nil {
-		return Emission{}, err
+		return Emission{}, withTrace(err, trace)
 	}
+	trace.Emission.Duration = em.d
 	for _, other := range s.emissions {
 		if em.OverlapsWithOffAir(other, s.timeOffAir) {
-			return Emission{}, errConflict.New()
+			trace.Conflict = &ttnpb.DownlinkSchedulingTrace_Emission{
+				Starts:   int64(other.t),
+				Duration: other.d,
+			}
+			return Emission{}, withTrace(errConflict.New(), trace)
 		}
 	}
 	if err := sb.Schedule(em, opts.Priority); err != nil {
-		return Emission{}, err
+		return Emission{}, withTrace(err, trace)
 	}
 	s.emissions = s.emissions.Insert(em)
 	return em, nil
@@ -379,6 +405,9 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, opts Options) (Emission
 	if !ok {
 		return Emission{}, errNoServerTime.New()
 	}
+	trace := &ttnpb.DownlinkSchedulingTrace{
+		MinScheduleTime: minScheduleTime,
+	}
 	if opts.Timestamp == 0 {
 		starts = now + ConcentratorTime(s.scheduleAnytimeDelay)
 		opts.Timestamp = uint32(time.Duration(starts) / time.Microsecond)
@@ -389,14 +418,21 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, opts Options) (Emission
 			opts.Timestamp += uint32(delta / time.Microsecond)
 		}
 	}
+	margin := time.Duration(starts-now) - minScheduleTime
+	trace.RTTMargin = &margin
+	trace.Emission = &ttnpb.DownlinkSchedulingTrace_Emission{
+		Starts: int64(starts),
+	}
 	sb, err := s.findSubBand(opts.Frequency)
 	if err != nil {
-		return Emission{}, err
+		return Emission{}, withTrace(err, trace)
 	}
+	trace.SubBand = sb.stats()
 	em, err := s.newEmission(opts.PayloadSize, opts.TxSettings, starts)
 	if err != nil {
-		return Emission{}, err
+		return Emission{}, withTrace(err, trace)
 	}
+	trace.Emission.Duration = em.d
 	i := 0
 	next := func() ConcentratorTime {
 		if len(s.emissions) == 0 {
@@ -429,7 +465,7 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, opts Options) (Emission
 	}
 	em, err = sb.ScheduleAnytime(em.d, next, opts.Priority)
 	if err != nil {
-		return Emission{}, err
+		return Emission{}, withTrace(err, trace)
 	}
 	s.emissions = s.emissions.Insert(em)
 	return em, nil
@@ -493,12 +529,7 @@ func (s *Scheduler) SubBandStats() []*ttnpb.GatewayConnectionStats_SubBand {
 	var res []*ttnpb.GatewayConnectionStats_SubBand
 
 	for _, sb := range s.subBands {
-		res = append(res, &ttnpb.GatewayConnectionStats_SubBand{
-			MaxFrequency:             sb.MaxFrequency,
-			MinFrequency:             sb.MinFrequency,
-			DownlinkUtilizationLimit: sb.DutyCycle,
-			DownlinkUtilization:      sb.DutyCycleUtilization(),
-		})
+		res = append(res, sb.stats())
 	}
 
 	return res
@@ -1218,3 +1218,85 @@ func TestScheduleSyncViaUplinkToken(t *testing.T) {
 		a.So(err, should.BeNil)
 	}
 }
+
+func TestScheduleTrace(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	fps := map[string]*frequencyplans.FrequencyPlan{test.EUFrequencyPlanID: {
+		BandID: band.EU_863_870,
+	}}
+	timeSource := &mockTimeSource{
+		Time: time.Unix(0, 0),
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fps, true, nil, timeSource)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	scheduler.Sync(0, timeSource.Time)
+
+	settings := ttnpb.TxSettings{
+		DataRate: ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_LoRa{
+				LoRa: &ttnpb.LoRaDataRate{
+					Bandwidth:       125000,
+					SpreadingFactor: 7,
+				},
+			},
+		},
+		CodingRate: "4/5",
+		Frequency:  869525000,
+	}
+	traceOf := func(err error) *ttnpb.DownlinkSchedulingTrace {
+		for _, details := range errors.Details(err) {
+			if trace, ok := details.(*ttnpb.DownlinkSchedulingTrace); ok {
+				return trace
+			}
+		}
+		return nil
+	}
+
+	// Too late; the trace contains the negative margin.
+	settings.Timestamp = 100
+	_, err = scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settings,
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrTooLate)
+	if trace := traceOf(err); a.So(trace, should.NotBeNil) {
+		a.So(trace.MinScheduleTime, should.Equal, scheduling.ScheduleTimeShort)
+		a.So(trace.MedianRTT, should.BeNil)
+		if a.So(trace.RTTMargin, should.NotBeNil) {
+			a.So(*trace.RTTMargin, should.Equal, 100*time.Microsecond-scheduling.ScheduleTimeShort)
+		}
+		a.So(trace.Emission.Starts, should.Equal, int64(100*time.Microsecond))
+	}
+
+	// Conflict; the trace contains the conflicting emission and the sub-band.
+	settings.Timestamp = 1000000
+	em, err := scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settings,
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settings,
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrConflict)
+	if trace := traceOf(err); a.So(trace, should.NotBeNil) {
+		if a.So(trace.Conflict, should.NotBeNil) {
+			a.So(trace.Conflict.Starts, should.Equal, int64(em.Starts()))
+			a.So(trace.Conflict.Duration, should.Equal, em.Duration())
+		}
+		if a.So(trace.SubBand, should.NotBeNil) {
+			a.So(trace.SubBand.MinFrequency, should.BeLessThanOrEqualTo, settings.Frequency)
+			a.So(trace.SubBand.MaxFrequency, should.BeGreaterThanOrEqualTo, settings.Frequency)
+		}
+		a.So(trace.Emission.Duration, should.Equal, em.Duration())
+	}
+}
@@ -107,6 +107,16 @@ func (sb *SubBand) DutyCycleUtilization() float32 {
 	return float32(val) / float32(DutyCycleWindow) / sb.DutyCycle
 }
 
+// stats returns the usage stats of the sub-band.
+func (sb *SubBand) stats() *ttnpb.GatewayConnectionStats_SubBand {
+	return &ttnpb.GatewayConnectionStats_SubBand{
+		MaxFrequency:             sb.MaxFrequency,
+		MinFrequency:             sb.MinFrequency,
+		DownlinkUtilizationLimit: sb.DutyCycle,
+		DownlinkUtilization:      sb.DutyCycleUtilization(),
+	}
+}
+
 // prioritizedDutyCycle returns the duty-cycle given the scheduling priority.
 // This is calculated as the available duty-cycle for the sub-band times the priority ceiling.
 func (sb *SubBand) prioritizedDutyCycle(p ttnpb.TxSchedulePriority) float32 {
@@ -331,6 +331,11 @@ func (is *IdentityServer) RequireAuthenticated(ctx context.Context) error {
 	return errUnauthenticated.New()
 }
 
+// AuthInfo implements rights.AuthInfoFetcher.
+func (is *IdentityServer) AuthInfo(ctx context.Context) (*ttnpb.AuthInfoResponse, error) {
+	return is.authInfo(ctx)
+}
+
 // UniversalRights returns the universal rights (that apply to any entity or
 // outside entity scope) contained in the request context. This is used to determine
 // admin rights.
@@ -16,6 +16,7 @@ package identityserver
 
 import (
 	"context"
+	"net"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
@@ -61,8 +62,18 @@ var (
 	errGatewayEUITaken            = errors.DefineAlreadyExists("gateway_eui_taken", "a gateway with EUI `{gateway_eui}` is already registered as `{gateway_id}`")
 	errGatewaySecretEncryptionKey = errors.DefineNotFound("gateway_secret_encryption_key_not_found", "a gateway secret encryption key with id `{id}` not found")
 	errAdminsPurgeGateways        = errors.DefinePermissionDenied("admins_purge_gateways", "gateways may only be purged by admins")
+	errUDPAllowedSourceCIDR       = errors.DefineInvalidArgument("udp_allowed_source_cidr", "invalid UDP allowed source CIDR `{cidr}`")
 )
 
+func validateUDPAllowedSourceCIDRs(cidrs []string) error {
+	for _, cidr := range cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errUDPAllowedSourceCIDR.WithAttributes("cidr", cidr).WithCause(err)
+		}
+	}
+	return nil
+}
+
 func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGatewayRequest) (gtw *ttnpb.Gateway, err error) {
 	if err = blacklist.Check(ctx, req.GatewayID); err != nil {
 		return nil, err
@@ -82,6 +93,9 @@ func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGa
 	if err := validateContactInfo(req.Gateway.ContactInfo); err != nil {
 		return nil, err
 	}
+	if err := validateUDPAllowedSourceCIDRs(req.UDPAllowedSourceCIDRs); err != nil {
+		return nil, err
+	}
 	if len(req.FrequencyPlanIDs) == 0 && req.FrequencyPlanID != "" {
 		req.FrequencyPlanIDs = []string{req.FrequencyPlanID}
 	}
@@ -350,6 +364,11 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 			return nil, err
 		}
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "udp_allowed_source_cidrs") {
+		if err := validateUDPAllowedSourceCIDRs(req.UDPAllowedSourceCIDRs); err != nil {
+			return nil, err
+		}
+	}
 
 	if ttnpb.HasAnyField(req.FieldMask.Paths, "lbs_lns_secret") {
 		if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_WRITE_SECRETS); err != nil {
@@ -226,6 +226,31 @@ func TestGatewaysCRUD(t *testing.T) {
 			a.So(updated.Name, should.Equal, "Updated Name")
 		}
 
+		_, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers:    created.GatewayIdentifiers,
+				UDPAllowedSourceCIDRs: []string{"192.0.2.1"},
+			},
+			FieldMask: ptypes.FieldMask{Paths: []string{"udp_allowed_source_cidrs"}},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(err, should.HaveSameErrorDefinitionAs, errUDPAllowedSourceCIDR)
+		}
+
+		updated, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers:    created.GatewayIdentifiers,
+				UDPAllowedSourceCIDRs: []string{"192.0.2.0/24"},
+			},
+			FieldMask: ptypes.FieldMask{Paths: []string{"udp_allowed_source_cidrs"}},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(updated, should.NotBeNil) {
+			a.So(updated.UDPAllowedSourceCIDRs, should.Resemble, []string{"192.0.2.0/24"})
+		}
+
 		for _, collaborator := range []*ttnpb.OrganizationOrUserIdentifiers{nil, userID.OrganizationOrUserIdentifiers()} {
 			list, err := reg.List(ctx, &ttnpb.ListGatewaysRequest{
 				FieldMask:    ptypes.FieldMask{Paths: []string{"name"}},
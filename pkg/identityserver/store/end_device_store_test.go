@@ -52,6 +52,13 @@ func TestEndDeviceStore(t *testing.T) {
 			EndDeviceIdentifiers: deviceID,
 			Name:                 "Foo EndDevice",
 			Description:          "The Amazing Foo EndDevice",
+			VersionIDs: &ttnpb.EndDeviceVersionIdentifiers{
+				BrandID:         "foo-brand",
+				ModelID:         "foo-model",
+				HardwareVersion: "1.0",
+				FirmwareVersion: "1.1",
+				BandID:          "EU_863_870",
+			},
 			Attributes: map[string]string{
 				"foo": "bar",
 				"bar": "baz",
@@ -74,6 +81,9 @@ func TestEndDeviceStore(t *testing.T) {
 			a.So(created.Name, should.Equal, "Foo EndDevice")
 			a.So(created.Description, should.Equal, "The Amazing Foo EndDevice")
 			a.So(created.Attributes, should.HaveLength, 3)
+			if a.So(created.VersionIDs, should.NotBeNil) {
+				a.So(created.VersionIDs.BandID, should.Equal, "EU_863_870")
+			}
 			if a.So(created.Picture, should.NotBeNil) {
 				a.So(created.Picture.Embedded, should.NotBeNil)
 			}
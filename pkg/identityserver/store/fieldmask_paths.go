@@ -21,6 +21,7 @@ const (
 	applicationServerAddressField       = "application_server_address"
 	attributesField                     = "attributes"
 	autoUpdateField                     = "auto_update"
+	bandIDField                         = "version_ids.band_id"
 	brandIDField                        = "version_ids.brand_id"
 	contactInfoField                    = "contact_info"
 	descriptionField                    = "description"
@@ -59,6 +60,7 @@ const (
 	temporaryPasswordCreatedAtField     = "temporary_password_created_at"
 	temporaryPasswordExpiresAtField     = "temporary_password_expires_at"
 	temporaryPasswordField              = "temporary_password"
+	udpAllowedSourceCIDRsField          = "udp_allowed_source_cidrs"
 	updateChannelField                  = "update_channel"
 	updateLocationFromStatusField       = "update_location_from_status"
 	versionIDsField                     = "version_ids"
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/lib/pq"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
@@ -66,6 +67,8 @@ type Gateway struct {
 	Antennas []GatewayAntenna
 
 	LBSLNSSecret []byte `gorm:"type:BYTEA;column:lbs_lns_secret"`
+
+	UDPAllowedSourceCIDRs pq.StringArray `gorm:"type:VARCHAR ARRAY;column:udp_allowed_source_cidrs"`
 }
 
 func init() {
@@ -132,6 +135,7 @@ var gatewayPBSetters = map[string]func(*ttnpb.Gateway, *Gateway){
 			pb.LBSLNSSecret = nil
 		}
 	},
+	udpAllowedSourceCIDRsField: func(pb *ttnpb.Gateway, gtw *Gateway) { pb.UDPAllowedSourceCIDRs = gtw.UDPAllowedSourceCIDRs },
 }
 
 // functions to set fields from the gateway proto into the gateway model.
@@ -192,6 +196,9 @@ var gatewayModelSetters = map[string]func(*Gateway, *ttnpb.Gateway){
 			gtw.LBSLNSSecret = nil
 		}
 	},
+	udpAllowedSourceCIDRsField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		gtw.UDPAllowedSourceCIDRs = pq.StringArray(pb.UDPAllowedSourceCIDRs)
+	},
 }
 
 // fieldMask to use if a nil or empty fieldmask is passed.
@@ -229,6 +236,7 @@ var gatewayColumnNames = map[string][]string{
 	scheduleAnytimeDelayField:     {scheduleAnytimeDelayField},
 	scheduleDownlinkLateField:     {scheduleDownlinkLateField},
 	statusPublicField:             {statusPublicField},
+	udpAllowedSourceCIDRsField:    {udpAllowedSourceCIDRsField},
 	updateChannelField:            {updateChannelField},
 	updateLocationFromStatusField: {updateLocationFromStatusField},
 	versionIDsField:               {"brand_id", "model_id", "hardware_version", "firmware_version"},
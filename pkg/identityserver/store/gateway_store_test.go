@@ -65,6 +65,7 @@ func TestGatewayStore(t *testing.T) {
 			ScheduleAnytimeDelay:     &scheduleAnytimeDelay,
 			UpdateLocationFromStatus: true,
 			LBSLNSSecret:             secret,
+			UDPAllowedSourceCIDRs:    []string{"192.0.2.0/24"},
 		})
 
 		a.So(err, should.BeNil)
@@ -82,6 +83,7 @@ func TestGatewayStore(t *testing.T) {
 			a.So(created.UpdateLocationFromStatus, should.BeTrue)
 			a.So(created.LBSLNSSecret, should.NotBeNil)
 			a.So(created.LBSLNSSecret, should.Resemble, secret)
+			a.So(created.UDPAllowedSourceCIDRs, should.Resemble, []string{"192.0.2.0/24"})
 		}
 
 		got, err := store.GetGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"}, &pbtypes.FieldMask{Paths: []string{"name", "attributes", "lbs_lns_secret"}})
@@ -129,7 +131,8 @@ func TestGatewayStore(t *testing.T) {
 			ScheduleAnytimeDelay:     nil,
 			UpdateLocationFromStatus: false,
 			LBSLNSSecret:             otherSecret,
-		}, &pbtypes.FieldMask{Paths: []string{"description", "attributes", "antennas", "schedule_anytime_delay", "update_location_from_status", "lbs_lns_secret"}})
+			UDPAllowedSourceCIDRs:    []string{"192.0.2.0/24", "2001:db8::/32"},
+		}, &pbtypes.FieldMask{Paths: []string{"description", "attributes", "antennas", "schedule_anytime_delay", "update_location_from_status", "lbs_lns_secret", "udp_allowed_source_cidrs"}})
 
 		a.So(err, should.BeNil)
 		if a.So(updated, should.NotBeNil) {
@@ -146,6 +149,7 @@ func TestGatewayStore(t *testing.T) {
 			a.So(*updated.ScheduleAnytimeDelay, should.Equal, time.Duration(0))
 			a.So(updated.UpdateLocationFromStatus, should.BeFalse)
 			a.So(updated.LBSLNSSecret, should.Resemble, otherSecret)
+			a.So(updated.UDPAllowedSourceCIDRs, should.Resemble, []string{"192.0.2.0/24", "2001:db8::/32"})
 		}
 
 		got, err = store.GetGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"}, nil)
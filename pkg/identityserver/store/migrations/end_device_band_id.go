@@ -0,0 +1,39 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// EndDeviceBandID adds the band ID of the end device version identifiers to the end devices.
+type EndDeviceBandID struct{}
+
+// Name implements Migration.
+func (EndDeviceBandID) Name() string {
+	return "end_device_band_id"
+}
+
+// Apply implements Migration.
+func (EndDeviceBandID) Apply(ctx context.Context, db *gorm.DB) error {
+	return db.Exec("ALTER TABLE end_devices ADD COLUMN IF NOT EXISTS band_id VARCHAR").Error
+}
+
+// Rollback implements Migration.
+func (EndDeviceBandID) Rollback(ctx context.Context, db *gorm.DB) error {
+	return db.Exec("ALTER TABLE end_devices DROP COLUMN IF EXISTS band_id").Error
+}
@@ -55,4 +55,7 @@ func Apply(ctx context.Context, db *gorm.DB, migrations ...Migration) error {
 	return nil
 }
 
-var All []Migration
+// All contains all migrations, in the order in which they are applied.
+var All = []Migration{
+	EndDeviceBandID{},
+}
@@ -14,12 +14,16 @@
 
 package joinserver
 
-import "go.thethings.network/lorawan-stack/v3/pkg/types"
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
 
 // Config represents the JoinServer configuration.
 type Config struct {
 	Devices                       DeviceRegistry                       `name:"-"`
 	Keys                          KeyRegistry                          `name:"-"`
+	DeviceRegistry                config.DeviceRegistry                `name:"device-registry" description:"End device and session key registry configuration"`
 	ApplicationActivationSettings ApplicationActivationSettingRegistry `name:"-"`
 	JoinEUIPrefixes               []types.EUI64Prefix                  `name:"join-eui-prefix" description:"JoinEUI prefixes handled by this JS"`
 	DeviceKEKLabel                string                               `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
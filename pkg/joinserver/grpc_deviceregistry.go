@@ -303,3 +303,161 @@ func (srv jsEndDeviceRegistryServer) Delete(ctx context.Context, ids *ttnpb.EndD
 	}
 	return ttnpb.Empty, err
 }
+
+// archivePaths are the paths of the end device fields stored by the Join Server, which are included in archives.
+// The NetID and the addresses, IDs and KEK labels of the Network Server and Application Server are not included,
+// since these are specific to the cluster. Imported end devices keep the values stored in the target cluster.
+var archivePaths = []string{
+	"claim_authentication_code",
+	"ids",
+	"last_dev_nonce",
+	"last_join_nonce",
+	"last_rj_count_0",
+	"last_rj_count_1",
+	"pending_session",
+	"provisioner_id",
+	"provisioning_data",
+	"resets_join_nonces",
+	"root_keys",
+	"session",
+	"used_dev_nonces",
+}
+
+// archiveSessionKeysPaths are the paths of the session keys stored in the key registry, which are included in archives.
+var archiveSessionKeysPaths = []string{
+	"app_s_key",
+	"f_nwk_s_int_key",
+	"nwk_s_enc_key",
+	"s_nwk_s_int_key",
+	"session_key_id",
+}
+
+// archivedRootKeys returns the root key envelopes of dev, which are stored by the Join Server.
+func archivedRootKeys(dev *ttnpb.EndDevice) []**ttnpb.KeyEnvelope {
+	var kes []**ttnpb.KeyEnvelope
+	if dev.RootKeys.GetAppKey() != nil {
+		kes = append(kes, &dev.RootKeys.AppKey)
+	}
+	if dev.RootKeys.GetNwkKey() != nil {
+		kes = append(kes, &dev.RootKeys.NwkKey)
+	}
+	return kes
+}
+
+// archivedSessionKeys returns the session keys of the current and pending session of dev.
+// The session keys are archived as stored, since they are wrapped with the KEKs of the Network Server and Application Server.
+func archivedSessionKeys(dev *ttnpb.EndDevice) []*ttnpb.SessionKeys {
+	var sks []*ttnpb.SessionKeys
+	if dev.Session != nil && len(dev.Session.SessionKeyID) > 0 {
+		sks = append(sks, &dev.Session.SessionKeys)
+	}
+	if dev.PendingSession != nil && len(dev.PendingSession.SessionKeyID) > 0 {
+		sks = append(sks, &dev.PendingSession.SessionKeys)
+	}
+	return sks
+}
+
+// Export implements ttnpb.JsEndDeviceRegistryServer.
+func (srv jsEndDeviceRegistryServer) Export(ctx context.Context, req *ttnpb.ExportEndDevicesRequest) (*ttnpb.EndDeviceArchive, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	devs := make([]*ttnpb.EndDevice, 0, len(req.DeviceIDs))
+	for _, devID := range req.DeviceIDs {
+		dev, err := srv.JS.devices.GetByID(ctx, req.ApplicationIdentifiers, devID, archivePaths)
+		if errors.IsNotFound(err) {
+			return nil, errDeviceNotFound.New()
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, ke := range archivedRootKeys(dev) {
+			if *ke, err = cryptoutil.UnwrapKeyEnvelope(ctx, *ke, srv.JS.KeyVault); err != nil {
+				return nil, err
+			}
+		}
+		for _, sk := range archivedSessionKeys(dev) {
+			stored, err := srv.JS.keys.GetByID(ctx, *dev.JoinEUI, *dev.DevEUI, sk.SessionKeyID, archiveSessionKeysPaths)
+			if errors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			*sk = *stored
+		}
+		devs = append(devs, dev)
+	}
+	return cryptoutil.WrapEndDeviceArchive(ctx, devs, req.KEKLabel, srv.JS.KeyVault)
+}
+
+// Import implements ttnpb.JsEndDeviceRegistryServer.
+func (srv jsEndDeviceRegistryServer) Import(ctx context.Context, req *ttnpb.ImportEndDevicesRequest) (*ttnpb.EndDevices, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	devs, err := cryptoutil.UnwrapEndDeviceArchive(ctx, req.Archive, srv.JS.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+	for _, dev := range devs {
+		if dev.ApplicationIdentifiers != req.ApplicationIdentifiers {
+			return nil, errInvalidFieldValue.WithAttributes("field", "ids.application_ids")
+		}
+		if dev.JoinEUI == nil {
+			return nil, errNoJoinEUI.New()
+		}
+		if dev.DevEUI == nil || dev.DevEUI.IsZero() {
+			return nil, errNoDevEUI.New()
+		}
+		if err := dev.ValidateFields(); err != nil {
+			return nil, err
+		}
+		for _, ke := range archivedRootKeys(dev) {
+			if (*ke).GetKey() == nil {
+				continue
+			}
+			if *ke, err = cryptoutil.WrapAES128Key(ctx, *(*ke).Key, srv.kekLabel, srv.JS.KeyVault); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	res := &ttnpb.EndDevices{
+		EndDevices: make([]*ttnpb.EndDevice, 0, len(devs)),
+	}
+	for _, dev := range devs {
+		for _, sk := range archivedSessionKeys(dev) {
+			if _, err := srv.JS.keys.SetByID(ctx, *dev.JoinEUI, *dev.DevEUI, sk.SessionKeyID, archiveSessionKeysPaths, func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error) {
+				return sk, archiveSessionKeysPaths, nil
+			}); err != nil {
+				return nil, err
+			}
+		}
+		var evt events.Event
+		stored, err := srv.JS.devices.SetByID(ctx, dev.ApplicationIdentifiers, dev.DeviceID, archivePaths, func(stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored != nil {
+				evt = evtUpdateEndDevice.NewWithIdentifiersAndData(ctx, dev.EndDeviceIdentifiers, archivePaths)
+			} else {
+				evt = evtCreateEndDevice.NewWithIdentifiersAndData(ctx, dev.EndDeviceIdentifiers, nil)
+			}
+			return dev, archivePaths, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if evt != nil {
+			events.Publish(evt)
+		}
+		res.EndDevices = append(res.EndDevices, &ttnpb.EndDevice{
+			EndDeviceIdentifiers: stored.EndDeviceIdentifiers,
+		})
+	}
+	return res, nil
+}
@@ -15,6 +15,7 @@
 package joinserver_test
 
 import (
+	"bytes"
 	"context"
 	"sync/atomic"
 	"testing"
@@ -994,3 +995,399 @@ func TestDeviceRegistryDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceRegistryExportImport(t *testing.T) {
+	registeredApplicationID := "foo-application"
+	registeredDeviceID := "foo-device"
+	registeredNwkKey := types.AES128Key{0xff, 0xee, 0xdd, 0xcc, 0xbb, 0xaa, 0x99, 0x88, 0x77, 0x66, 0x55, 0x44, 0x33, 0x22, 0x11, 0x0}
+	registeredNwkKeyEnc := []byte{0xa3, 0x34, 0x38, 0x1c, 0xca, 0x1c, 0x12, 0x7a, 0x5b, 0xb1, 0xa8, 0x97, 0x39, 0xc7, 0x5, 0x34, 0x91, 0x26, 0x9b, 0x21, 0x4f, 0x27, 0x80, 0x19}
+	registeredAppKey := types.AES128Key{0x0, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}
+	registeredAppKeyEnc := []byte{0x1f, 0xa6, 0x8b, 0xa, 0x81, 0x12, 0xb4, 0x47, 0xae, 0xf3, 0x4b, 0xd8, 0xfb, 0x5a, 0x7b, 0x82, 0x9d, 0x3e, 0x86, 0x23, 0x71, 0xd2, 0xcf, 0xe5}
+	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"test": {0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf},
+	})
+	registeredDevice := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: registeredApplicationID,
+			},
+			DeviceID: registeredDeviceID,
+			JoinEUI:  eui64Ptr(types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}),
+			DevEUI:   eui64Ptr(types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}),
+		},
+		RootKeys: &ttnpb.RootKeys{
+			NwkKey: &ttnpb.KeyEnvelope{
+				KEKLabel:     "test",
+				EncryptedKey: registeredNwkKeyEnc,
+			},
+			AppKey: &ttnpb.KeyEnvelope{
+				KEKLabel:     "test",
+				EncryptedKey: registeredAppKeyEnc,
+			},
+		},
+		LastDevNonce:  0x42,
+		LastJoinNonce: 0x24,
+		UsedDevNonces: []uint32{0x41, 0x42},
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		ContextFunc    func(context.Context) context.Context
+		KEKLabel       string
+		ErrorAssertion func(*testing.T, error) bool
+	}{
+		{
+			Name: "Permission denied",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+						),
+					},
+				})
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			},
+		},
+
+		{
+			Name: "Plain archive",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+							ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+						),
+					},
+				})
+			},
+		},
+
+		{
+			Name: "Wrapped archive",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+							ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+						),
+					},
+				})
+			},
+			KEKLabel: "test",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var imported *ttnpb.EndDevice
+
+			js := test.Must(New(
+				componenttest.NewComponent(t, &component.Config{}),
+				&Config{
+					Devices: &MockDeviceRegistry{
+						GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, error) {
+							a := assertions.New(test.MustTFromContext(ctx))
+							a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{
+								ApplicationID: registeredApplicationID,
+							})
+							a.So(devID, should.Equal, registeredDeviceID)
+							return ttnpb.FilterGetEndDevice(CopyEndDevice(registeredDevice), paths...)
+						},
+						SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+							a := assertions.New(test.MustTFromContext(ctx))
+							a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{
+								ApplicationID: registeredApplicationID,
+							})
+							a.So(devID, should.Equal, registeredDeviceID)
+							dev, sets, err := cb(nil)
+							if err != nil {
+								return nil, err
+							}
+							imported, err = ttnpb.ApplyEndDeviceFieldMask(&ttnpb.EndDevice{}, dev, sets...)
+							if err != nil {
+								return nil, err
+							}
+							return ttnpb.FilterGetEndDevice(imported, paths...)
+						},
+					},
+				},
+			)).(*JoinServer)
+			js.KeyVault = keyVault
+
+			js.AddContextFiller(tc.ContextFunc)
+			js.AddContextFiller(func(ctx context.Context) context.Context {
+				ctx, cancel := context.WithDeadline(ctx, time.Now().Add(Timeout))
+				_ = cancel
+				return ctx
+			})
+			js.AddContextFiller(func(ctx context.Context) context.Context {
+				return test.ContextWithTB(ctx, t)
+			})
+			componenttest.StartComponent(t, js.Component)
+			defer js.Close()
+
+			ctx := js.FillContext(test.Context())
+			cl := ttnpb.NewJsEndDeviceRegistryClient(js.LoopbackConn())
+
+			archive, err := cl.Export(ctx, &ttnpb.ExportEndDevicesRequest{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID},
+				DeviceIDs:              []string{registeredDeviceID},
+				KEKLabel:               tc.KEKLabel,
+			})
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(t, err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(archive.GetDataKey().GetKEKLabel(), should.Equal, tc.KEKLabel)
+
+			res, err := cl.Import(ctx, &ttnpb.ImportEndDevicesRequest{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID},
+				Archive:                archive,
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(res.EndDevices, should.Resemble, []*ttnpb.EndDevice{
+				{
+					EndDeviceIdentifiers: registeredDevice.EndDeviceIdentifiers,
+				},
+			})
+
+			expected := CopyEndDevice(registeredDevice)
+			expected.RootKeys.NwkKey = &ttnpb.KeyEnvelope{
+				EncryptedKey: registeredNwkKey[:],
+			}
+			expected.RootKeys.AppKey = &ttnpb.KeyEnvelope{
+				EncryptedKey: registeredAppKey[:],
+			}
+			a.So(imported, should.Resemble, expected)
+		})
+	}
+}
+
+func TestDeviceRegistryExportImportPeers(t *testing.T) {
+	registeredApplicationID := "foo-application"
+	registeredDeviceID := "foo-device"
+	registeredIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: registeredApplicationID,
+		},
+		DeviceID: registeredDeviceID,
+		JoinEUI:  eui64Ptr(types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}),
+		DevEUI:   eui64Ptr(types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}),
+	}
+	registeredAppKey := types.AES128Key{0x0, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}
+	sessionKeys := &ttnpb.SessionKeys{
+		SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+		FNwkSIntKey: &ttnpb.KeyEnvelope{
+			KEKLabel:     "ns-kek",
+			EncryptedKey: []byte{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18},
+		},
+		AppSKey: &ttnpb.KeyEnvelope{
+			KEKLabel:     "as-kek",
+			EncryptedKey: []byte{0x18, 0x17, 0x16, 0x15, 0x14, 0x13, 0x12, 0x11, 0x10, 0xf, 0xe, 0xd, 0xc, 0xb, 0xa, 0x9, 0x8, 0x7, 0x6, 0x5, 0x4, 0x3, 0x2, 0x1},
+		},
+	}
+	pendingSessionKeys := &ttnpb.SessionKeys{
+		SessionKeyID: []byte{0x55, 0x66, 0x77, 0x88},
+		FNwkSIntKey: &ttnpb.KeyEnvelope{
+			Key: &types.AES128Key{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf, 0x10},
+		},
+		AppSKey: &ttnpb.KeyEnvelope{
+			Key: &types.AES128Key{0x10, 0xf, 0xe, 0xd, 0xc, 0xb, 0xa, 0x9, 0x8, 0x7, 0x6, 0x5, 0x4, 0x3, 0x2, 0x1},
+		},
+	}
+	sourceDevice := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: registeredIDs,
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{
+				Key: &registeredAppKey,
+			},
+		},
+		NetID:                     &types.NetID{0x00, 0x00, 0x13},
+		NetworkServerAddress:      "ns.staging.example.com",
+		NetworkServerKEKLabel:     "staging-ns",
+		ApplicationServerAddress:  "as.staging.example.com",
+		ApplicationServerID:       "staging-as",
+		ApplicationServerKEKLabel: "staging-as",
+		LastDevNonce:              0x42,
+		LastJoinNonce:             0x24,
+		Session: &ttnpb.Session{
+			DevAddr:   types.DevAddr{0x26, 0x01, 0x02, 0x03},
+			StartedAt: time.Unix(1600000000, 0).UTC(),
+			SessionKeys: ttnpb.SessionKeys{
+				SessionKeyID: sessionKeys.SessionKeyID,
+			},
+		},
+		PendingSession: &ttnpb.Session{
+			DevAddr:   types.DevAddr{0x26, 0x01, 0x02, 0x04},
+			StartedAt: time.Unix(1600000100, 0).UTC(),
+			SessionKeys: ttnpb.SessionKeys{
+				SessionKeyID: pendingSessionKeys.SessionKeyID,
+			},
+		},
+	}
+	targetDevice := &ttnpb.EndDevice{
+		EndDeviceIdentifiers:      registeredIDs,
+		NetID:                     &types.NetID{0x00, 0x00, 0x42},
+		NetworkServerAddress:      "ns.production.example.com",
+		NetworkServerKEKLabel:     "production-ns",
+		ApplicationServerAddress:  "as.production.example.com",
+		ApplicationServerID:       "production-as",
+		ApplicationServerKEKLabel: "production-as",
+	}
+
+	newJS := func(t *testing.T, conf *Config) (*JoinServer, context.Context, ttnpb.JsEndDeviceRegistryClient) {
+		js := test.Must(New(
+			componenttest.NewComponent(t, &component.Config{}),
+			conf,
+		)).(*JoinServer)
+		js.AddContextFiller(func(ctx context.Context) context.Context {
+			return rights.NewContext(ctx, rights.Rights{
+				ApplicationRights: map[string]*ttnpb.Rights{
+					unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID}): ttnpb.RightsFrom(
+						ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+						ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+						ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+						ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+					),
+				},
+			})
+		})
+		js.AddContextFiller(func(ctx context.Context) context.Context {
+			ctx, cancel := context.WithDeadline(ctx, time.Now().Add(Timeout))
+			_ = cancel
+			return ctx
+		})
+		js.AddContextFiller(func(ctx context.Context) context.Context {
+			return test.ContextWithTB(ctx, t)
+		})
+		componenttest.StartComponent(t, js.Component)
+		return js, js.FillContext(test.Context()), ttnpb.NewJsEndDeviceRegistryClient(js.LoopbackConn())
+	}
+
+	a := assertions.New(t)
+
+	sourceJS, sourceCtx, sourceCl := newJS(t, &Config{
+		Devices: &MockDeviceRegistry{
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, error) {
+				return ttnpb.FilterGetEndDevice(CopyEndDevice(sourceDevice), paths...)
+			},
+		},
+		Keys: &MockKeyRegistry{
+			GetByIDFunc: func(ctx context.Context, joinEUI, devEUI types.EUI64, id []byte, paths []string) (*ttnpb.SessionKeys, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(joinEUI, should.Resemble, *registeredIDs.JoinEUI)
+				a.So(devEUI, should.Resemble, *registeredIDs.DevEUI)
+				for _, sk := range []*ttnpb.SessionKeys{sessionKeys, pendingSessionKeys} {
+					if bytes.Equal(id, sk.SessionKeyID) {
+						return ttnpb.FilterGetSessionKeys(deepcopy.Copy(sk).(*ttnpb.SessionKeys), paths...)
+					}
+				}
+				return nil, errNotFound.New()
+			},
+		},
+	})
+	defer sourceJS.Close()
+
+	archive, err := sourceCl.Export(sourceCtx, &ttnpb.ExportEndDevicesRequest{
+		ApplicationIdentifiers: registeredIDs.ApplicationIdentifiers,
+		DeviceIDs:              []string{registeredDeviceID},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	archived, err := cryptoutil.UnwrapEndDeviceArchive(sourceCtx, archive, nil)
+	if !a.So(err, should.BeNil) || !a.So(archived, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(archived[0].NetID, should.BeNil)
+	a.So(archived[0].NetworkServerAddress, should.BeEmpty)
+	a.So(archived[0].NetworkServerKEKLabel, should.BeEmpty)
+	a.So(archived[0].ApplicationServerAddress, should.BeEmpty)
+	a.So(archived[0].ApplicationServerID, should.BeEmpty)
+	a.So(archived[0].ApplicationServerKEKLabel, should.BeEmpty)
+	a.So(archived[0].Session.SessionKeys, should.Resemble, *sessionKeys)
+	a.So(archived[0].PendingSession.SessionKeys, should.Resemble, *pendingSessionKeys)
+
+	var imported *ttnpb.EndDevice
+	importedKeys := map[string]*ttnpb.SessionKeys{}
+	targetJS, targetCtx, targetCl := newJS(t, &Config{
+		Devices: &MockDeviceRegistry{
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				dev, sets, err := cb(CopyEndDevice(targetDevice))
+				if err != nil {
+					return nil, err
+				}
+				imported, err = ttnpb.ApplyEndDeviceFieldMask(CopyEndDevice(targetDevice), dev, sets...)
+				if err != nil {
+					return nil, err
+				}
+				return ttnpb.FilterGetEndDevice(imported, paths...)
+			},
+		},
+		Keys: &MockKeyRegistry{
+			SetByIDFunc: func(ctx context.Context, joinEUI, devEUI types.EUI64, id []byte, paths []string, cb func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(joinEUI, should.Resemble, *registeredIDs.JoinEUI)
+				a.So(devEUI, should.Resemble, *registeredIDs.DevEUI)
+				sk, sets, err := cb(nil)
+				if err != nil {
+					return nil, err
+				}
+				stored := &ttnpb.SessionKeys{}
+				if err := stored.SetFields(sk, sets...); err != nil {
+					return nil, err
+				}
+				importedKeys[string(id)] = stored
+				return ttnpb.FilterGetSessionKeys(stored, paths...)
+			},
+		},
+	})
+	defer targetJS.Close()
+
+	_, err = targetCl.Import(targetCtx, &ttnpb.ImportEndDevicesRequest{
+		ApplicationIdentifiers: registeredIDs.ApplicationIdentifiers,
+		Archive:                archive,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	expected := CopyEndDevice(targetDevice)
+	expected.RootKeys = &ttnpb.RootKeys{
+		AppKey: &ttnpb.KeyEnvelope{
+			EncryptedKey: registeredAppKey[:],
+		},
+	}
+	expected.LastDevNonce = sourceDevice.LastDevNonce
+	expected.LastJoinNonce = sourceDevice.LastJoinNonce
+	expected.Session = &ttnpb.Session{
+		DevAddr:     sourceDevice.Session.DevAddr,
+		StartedAt:   sourceDevice.Session.StartedAt,
+		SessionKeys: *sessionKeys,
+	}
+	expected.PendingSession = &ttnpb.Session{
+		DevAddr:     sourceDevice.PendingSession.DevAddr,
+		StartedAt:   sourceDevice.PendingSession.StartedAt,
+		SessionKeys: *pendingSessionKeys,
+	}
+	a.So(imported, should.Resemble, expected)
+	a.So(importedKeys, should.Resemble, map[string]*ttnpb.SessionKeys{
+		string(sessionKeys.SessionKeyID):        sessionKeys,
+		string(pendingSessionKeys.SessionKeyID): pendingSessionKeys,
+	})
+}
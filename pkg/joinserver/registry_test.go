@@ -16,15 +16,20 @@ package joinserver_test
 
 import (
 	"fmt"
+	"os"
+	"sync"
 	"testing"
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
 	"github.com/mohae/deepcopy"
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
 	. "go.thethings.network/lorawan-stack/v3/pkg/joinserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/joinserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/joinserver/sql"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
@@ -199,6 +204,44 @@ func CopySessionKeys(pb *ttnpb.SessionKeys) *ttnpb.SessionKeys {
 	return deepcopy.Copy(pb).(*ttnpb.SessionKeys)
 }
 
+// sqlTestDBMu serializes the schema changes of the SQL registry tests, which share the database.
+var sqlTestDBMu sync.Mutex
+
+// newSQLTestDB opens the test database, drops the given tables and migrates the database schema of the registries.
+func newSQLTestDB(t testing.TB, tables ...interface{}) *gorm.DB {
+	dbAddress := os.Getenv("SQL_DB_ADDRESS")
+	if dbAddress == "" {
+		dbAddress = "localhost:26257"
+	}
+	dbName := os.Getenv("TEST_DATABASE_NAME")
+	if dbName == "" {
+		dbName = "ttn_lorawan_js_registry_test"
+	}
+	dbAuth := os.Getenv("SQL_DB_AUTH")
+	if dbAuth == "" {
+		dbAuth = "root"
+	}
+	db, err := store.Open(test.Context(), fmt.Sprintf("postgresql://%s@%s/%s?sslmode=disable", dbAuth, dbAddress, dbName))
+	if err != nil {
+		t.Fatalf("Failed to open database: %s", err)
+	}
+	sqlTestDBMu.Lock()
+	defer sqlTestDBMu.Unlock()
+	if err := store.Initialize(db); err != nil {
+		db.Close()
+		t.Fatalf("Failed to initialize database: %s", err)
+	}
+	if err := db.DropTableIfExists(tables...).Error; err != nil {
+		db.Close()
+		t.Fatalf("Failed to drop tables: %s", err)
+	}
+	if err := sql.Migrate(db); err != nil {
+		db.Close()
+		t.Fatalf("Failed to migrate database: %s", err)
+	}
+	return db
+}
+
 func TestDeviceRegistries(t *testing.T) {
 	t.Parallel()
 
@@ -223,6 +266,15 @@ func TestDeviceRegistries(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "SQL",
+			New: func(t testing.TB) (DeviceRegistry, func() error) {
+				db := newSQLTestDB(t, "js_end_devices")
+				return &sql.DeviceRegistry{DB: db}, db.Close
+			},
+			// The SQL registry tests share the database tables, so they can not run in parallel.
+			N: 1,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
@@ -377,6 +429,15 @@ func TestSessionKeyRegistries(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "SQL",
+			New: func(t testing.TB) (KeyRegistry, func() error) {
+				db := newSQLTestDB(t, "js_session_keys")
+				return &sql.KeyRegistry{DB: db}, db.Close
+			},
+			// The SQL registry tests share the database tables, so they can not run in parallel.
+			N: 1,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
@@ -0,0 +1,550 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/provisioning"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errAlreadyProvisioned   = errors.DefineAlreadyExists("already_provisioned", "device already provisioned")
+	errDuplicateIdentifiers = errors.DefineAlreadyExists("duplicate_identifiers", "duplicate identifiers")
+	errInvalidFieldmask     = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers   = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errReadOnlyField        = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errProvisionerNotFound  = errors.DefineNotFound("provisioner_not_found", "provisioner `{id}` not found")
+	errDeviceNotFound       = errors.DefineNotFound("device_not_found", "end device not found")
+	errKeysNotFound         = errors.DefineNotFound("keys_not_found", "session keys not found")
+)
+
+// DeviceRegistry is a SQL implementation of joinserver.DeviceRegistry.
+type DeviceRegistry struct {
+	DB *gorm.DB
+}
+
+func provisionerUniqueID(dev *ttnpb.EndDevice) (string, error) {
+	if dev.ProvisionerID == "" {
+		return "", nil
+	}
+	provisioner := provisioning.Get(dev.ProvisionerID)
+	if provisioner == nil {
+		return "", errProvisionerNotFound.WithAttributes("id", dev.ProvisionerID)
+	}
+	return provisioner.UniqueID(dev.ProvisioningData)
+}
+
+func decodeEndDevice(b []byte) (*ttnpb.EndDevice, error) {
+	pb := &ttnpb.EndDevice{}
+	if err := proto.Unmarshal(b, pb); err != nil {
+		return nil, errDecode.WithCause(err)
+	}
+	return pb, nil
+}
+
+func idQuery(db *gorm.DB, appID ttnpb.ApplicationIdentifiers, devID string) *gorm.DB {
+	return db.Model(&endDevice{}).Where("application_id = ? AND device_id = ?", appID.ApplicationID, devID)
+}
+
+func euiQuery(db *gorm.DB, joinEUI, devEUI types.EUI64) *gorm.DB {
+	return db.Model(&endDevice{}).Where("join_eui = ? AND dev_eui = ?", joinEUI.String(), devEUI.String())
+}
+
+func (r *DeviceRegistry) get(query *gorm.DB) (*endDevice, *ttnpb.EndDevice, error) {
+	model := &endDevice{}
+	if err := query.Select("application_id, device_id, version, payload").First(model).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil, errDeviceNotFound.New()
+		}
+		return nil, nil, errDatabase.WithCause(err)
+	}
+	pb, err := decodeEndDevice(model.Payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	return model, pb, nil
+}
+
+// GetByID gets device by appID, devID.
+func (r *DeviceRegistry) GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, error) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: appID,
+		DeviceID:               devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get end device by id").End()
+
+	_, pb, err := r.get(idQuery(r.DB, appID, devID))
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.FilterGetEndDevice(pb, paths...)
+}
+
+// GetByEUI gets device by joinEUI, devEUI.
+func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.ContextualEndDevice, error) {
+	if devEUI.IsZero() {
+		return nil, errInvalidIdentifiers.New()
+	}
+
+	defer trace.StartRegion(ctx, "get end device by eui").End()
+
+	_, pb, err := r.get(euiQuery(r.DB, joinEUI, devEUI))
+	if err != nil {
+		return nil, err
+	}
+	filtered, err := ttnpb.FilterGetEndDevice(pb, paths...)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ContextualEndDevice{
+		Context:   ctx,
+		EndDevice: filtered,
+	}, nil
+}
+
+func equalEUI64(x, y *types.EUI64) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Equal(*y)
+}
+
+func (r *DeviceRegistry) set(ctx context.Context, tx *gorm.DB, model *endDevice, stored *ttnpb.EndDevice, gets []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.ContextualEndDevice, error) {
+	ctx, err := unique.WithContext(ctx, unique.ID(ctx, ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: model.ApplicationID},
+		DeviceID:               model.DeviceID,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	query := tx.Model(&endDevice{}).Where("application_id = ? AND device_id = ? AND version = ?", model.ApplicationID, model.DeviceID, model.Version)
+
+	var pb *ttnpb.EndDevice
+	if stored != nil {
+		pb = proto.Clone(stored).(*ttnpb.EndDevice)
+		pb, err = ttnpb.FilterGetEndDevice(pb, gets...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var sets []string
+	pb, sets, err = f(ctx, pb)
+	if err != nil {
+		return nil, err
+	}
+	if err := ttnpb.ProhibitFields(sets,
+		"created_at",
+		"updated_at",
+	); err != nil {
+		return nil, errInvalidFieldmask.WithCause(err)
+	}
+
+	if stored == nil && pb == nil {
+		return nil, nil
+	}
+	if pb != nil && len(sets) == 0 {
+		filtered, err := ttnpb.FilterGetEndDevice(stored, gets...)
+		if err != nil {
+			return nil, err
+		}
+		return &ttnpb.ContextualEndDevice{
+			Context:   ctx,
+			EndDevice: filtered,
+		}, nil
+	}
+
+	if pb == nil && len(sets) == 0 {
+		res := query.Delete(&endDevice{})
+		if res.Error != nil {
+			return nil, errDatabase.WithCause(res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return nil, errConcurrentModification.New()
+		}
+		return nil, nil
+	}
+
+	if pb == nil {
+		pb = &ttnpb.EndDevice{}
+	}
+
+	pb.UpdatedAt = time.Now().UTC()
+	sets = append(append(sets[:0:0], sets...),
+		"updated_at",
+	)
+
+	var updated *ttnpb.EndDevice
+	var updatedPID string
+	if stored == nil {
+		if err := ttnpb.RequireFields(sets,
+			"ids.application_ids",
+			"ids.dev_eui",
+			"ids.device_id",
+			"ids.join_eui",
+		); err != nil {
+			return nil, errInvalidFieldmask.WithCause(err)
+		}
+
+		pb.CreatedAt = pb.UpdatedAt
+		sets = append(sets, "created_at")
+
+		updated, err = ttnpb.ApplyEndDeviceFieldMask(&ttnpb.EndDevice{}, pb, sets...)
+		if err != nil {
+			return nil, err
+		}
+		updatedPID, err = provisionerUniqueID(updated)
+		if err != nil {
+			return nil, err
+		}
+		if updated.JoinEUI == nil || updated.DevEUI == nil || updated.DevEUI.IsZero() {
+			return nil, errInvalidIdentifiers.New()
+		}
+	} else {
+		if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") && pb.ApplicationID != stored.ApplicationID {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+		}
+		if ttnpb.HasAnyField(sets, "ids.device_id") && pb.DeviceID != stored.DeviceID {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.device_id")
+		}
+		if ttnpb.HasAnyField(sets, "ids.join_eui") && !equalEUI64(pb.JoinEUI, stored.JoinEUI) {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.join_eui")
+		}
+		if ttnpb.HasAnyField(sets, "ids.dev_eui") && !equalEUI64(pb.DevEUI, stored.DevEUI) {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.dev_eui")
+		}
+		if ttnpb.HasAnyField(sets, "provisioner_id") && pb.ProvisionerID != stored.ProvisionerID {
+			return nil, errReadOnlyField.WithAttributes("field", "provisioner_id")
+		}
+		if ttnpb.HasAnyField(sets, "provisioning_data") && !pb.ProvisioningData.Equal(stored.ProvisioningData) {
+			return nil, errReadOnlyField.WithAttributes("field", "provisioning_data")
+		}
+		updated, err = ttnpb.ApplyEndDeviceFieldMask(stored, pb, sets...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := updated.ValidateFields(sets...); err != nil {
+		return nil, err
+	}
+	payload, err := proto.Marshal(updated)
+	if err != nil {
+		return nil, err
+	}
+
+	if stored == nil {
+		created := &endDevice{
+			ApplicationID: model.ApplicationID,
+			DeviceID:      model.DeviceID,
+			JoinEUI:       updated.JoinEUI.String(),
+			DevEUI:        updated.DevEUI.String(),
+			Payload:       payload,
+			CreatedAt:     updated.CreatedAt,
+			UpdatedAt:     updated.UpdatedAt,
+		}
+		if updatedPID != "" {
+			created.ProvisionerID, created.ProvisionerUniqueID = &updated.ProvisionerID, &updatedPID
+		}
+		if err := tx.Create(created).Error; err != nil {
+			return nil, convertError(err)
+		}
+	} else {
+		res := query.Updates(map[string]interface{}{
+			"version":    gorm.Expr("version + 1"),
+			"payload":    payload,
+			"updated_at": updated.UpdatedAt,
+		})
+		if res.Error != nil {
+			return nil, convertError(res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return nil, errConcurrentModification.New()
+		}
+	}
+	pb, err = ttnpb.FilterGetEndDevice(updated, gets...)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ContextualEndDevice{
+		Context:   ctx,
+		EndDevice: pb,
+	}, nil
+}
+
+// SetByEUI sets device by joinEUI, devEUI.
+// SetByEUI will only succeed if the device is set via SetByID first.
+// The stored device is locked until the update is committed, so that concurrent updates of the device are serialized.
+func (r *DeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devEUI types.EUI64, gets []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.ContextualEndDevice, error) {
+	if devEUI.IsZero() {
+		return nil, errInvalidIdentifiers.New()
+	}
+
+	defer trace.StartRegion(ctx, "set end device by eui").End()
+
+	var (
+		pb     *ttnpb.ContextualEndDevice
+		setErr error
+	)
+	if err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var model *endDevice
+		var stored *ttnpb.EndDevice
+		model, stored, setErr = r.get(euiQuery(tx, joinEUI, devEUI).Set("gorm:query_option", "FOR UPDATE"))
+		if setErr != nil {
+			return setErr
+		}
+		pb, setErr = r.set(ctx, tx, model, stored, gets, f)
+		return setErr
+	}); err != nil {
+		if setErr != nil {
+			return nil, setErr
+		}
+		return nil, convertError(err)
+	}
+	return pb, nil
+}
+
+// SetByID sets device by appID, devID.
+// The stored device is locked until the update is committed, so that concurrent updates of the device are serialized.
+func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(pb *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: appID,
+		DeviceID:               devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "set end device by id").End()
+
+	var (
+		pb     *ttnpb.ContextualEndDevice
+		setErr error
+	)
+	if err := r.DB.Transaction(func(tx *gorm.DB) error {
+		model, stored, err := r.get(idQuery(tx, appID, devID).Set("gorm:query_option", "FOR UPDATE"))
+		if errors.IsNotFound(err) {
+			model = &endDevice{
+				ApplicationID: appID.ApplicationID,
+				DeviceID:      devID,
+			}
+		} else if err != nil {
+			setErr = err
+			return setErr
+		}
+		pb, setErr = r.set(ctx, tx, model, stored, gets, func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			updated, sets, err := f(stored)
+			if err != nil {
+				return nil, nil, err
+			}
+			if stored == nil && updated != nil && (updated.ApplicationIdentifiers != appID || updated.DeviceID != devID) {
+				return nil, nil, errInvalidIdentifiers.New()
+			}
+			return updated, sets, nil
+		})
+		return setErr
+	}); err != nil {
+		if setErr != nil {
+			return nil, setErr
+		}
+		return nil, convertError(err)
+	}
+	if pb == nil {
+		return nil, nil
+	}
+	return pb.EndDevice, nil
+}
+
+// KeyRegistry is a SQL implementation of joinserver.KeyRegistry.
+type KeyRegistry struct {
+	DB *gorm.DB
+}
+
+func keysQuery(db *gorm.DB, joinEUI, devEUI types.EUI64, id []byte) *gorm.DB {
+	return db.Model(&sessionKeys{}).Where("join_eui = ? AND dev_eui = ? AND session_key_id = ?", joinEUI.String(), devEUI.String(), id)
+}
+
+func decodeSessionKeys(b []byte) (*ttnpb.SessionKeys, error) {
+	pb := &ttnpb.SessionKeys{}
+	if err := proto.Unmarshal(b, pb); err != nil {
+		return nil, errDecode.WithCause(err)
+	}
+	return pb, nil
+}
+
+// GetByID gets session keys by joinEUI, devEUI, id.
+func (r *KeyRegistry) GetByID(ctx context.Context, joinEUI, devEUI types.EUI64, id []byte, paths []string) (*ttnpb.SessionKeys, error) {
+	if devEUI.IsZero() || len(id) == 0 {
+		return nil, errInvalidIdentifiers.New()
+	}
+
+	defer trace.StartRegion(ctx, "get session keys").End()
+
+	model := &sessionKeys{}
+	if err := keysQuery(r.DB, joinEUI, devEUI, id).Select("payload").First(model).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errKeysNotFound.New()
+		}
+		return nil, errDatabase.WithCause(err)
+	}
+	pb, err := decodeSessionKeys(model.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.FilterGetSessionKeys(pb, paths...)
+}
+
+// SetByID sets session keys by joinEUI, devEUI, id.
+// The stored session keys are locked until the update is committed, so that concurrent updates are serialized.
+func (r *KeyRegistry) SetByID(ctx context.Context, joinEUI, devEUI types.EUI64, id []byte, gets []string, f func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error) {
+	if devEUI.IsZero() || len(id) == 0 {
+		return nil, errInvalidIdentifiers.New()
+	}
+
+	defer trace.StartRegion(ctx, "set session keys").End()
+
+	var (
+		pb     *ttnpb.SessionKeys
+		setErr error
+	)
+	if err := r.DB.Transaction(func(tx *gorm.DB) error {
+		pb, setErr = r.setByID(tx, joinEUI, devEUI, id, gets, f)
+		return setErr
+	}); err != nil {
+		if setErr != nil {
+			return nil, setErr
+		}
+		return nil, convertError(err)
+	}
+	return pb, nil
+}
+
+func (r *KeyRegistry) setByID(tx *gorm.DB, joinEUI, devEUI types.EUI64, id []byte, gets []string, f func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error) {
+	model := &sessionKeys{}
+	var stored, pb *ttnpb.SessionKeys
+	if err := keysQuery(tx, joinEUI, devEUI, id).Set("gorm:query_option", "FOR UPDATE").Select("version, payload").First(model).Error; err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			return nil, errDatabase.WithCause(err)
+		}
+	} else {
+		if stored, err = decodeSessionKeys(model.Payload); err != nil {
+			return nil, err
+		}
+		if pb, err = decodeSessionKeys(model.Payload); err != nil {
+			return nil, err
+		}
+		if pb, err = ttnpb.FilterGetSessionKeys(pb, gets...); err != nil {
+			return nil, err
+		}
+	}
+
+	pb, sets, err := f(pb)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil && pb == nil {
+		return nil, nil
+	}
+	if pb != nil && len(sets) == 0 {
+		return ttnpb.FilterGetSessionKeys(stored, gets...)
+	}
+	if pb == nil && len(sets) == 0 {
+		res := keysQuery(tx, joinEUI, devEUI, id).Where("version = ?", model.Version).Delete(&sessionKeys{})
+		if res.Error != nil {
+			return nil, errDatabase.WithCause(res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return nil, errConcurrentModification.New()
+		}
+		return nil, nil
+	}
+
+	if pb == nil {
+		pb = &ttnpb.SessionKeys{}
+	}
+
+	var updated *ttnpb.SessionKeys
+	if stored == nil {
+		if err := ttnpb.RequireFields(sets,
+			"session_key_id",
+		); err != nil {
+			return nil, errInvalidFieldmask.WithCause(err)
+		}
+		updated, err = ttnpb.ApplySessionKeysFieldMask(&ttnpb.SessionKeys{}, pb, sets...)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(updated.SessionKeyID, id) {
+			return nil, errInvalidIdentifiers.New()
+		}
+	} else {
+		if err := ttnpb.ProhibitFields(sets,
+			"session_key_id",
+		); err != nil {
+			return nil, errInvalidFieldmask.WithCause(err)
+		}
+		updated, err = ttnpb.ApplySessionKeysFieldMask(stored, pb, sets...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := updated.ValidateFields(sets...); err != nil {
+		return nil, err
+	}
+	payload, err := proto.Marshal(updated)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if stored == nil {
+		if err := tx.Create(&sessionKeys{
+			JoinEUI:      joinEUI.String(),
+			DevEUI:       devEUI.String(),
+			SessionKeyID: id,
+			Payload:      payload,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		}).Error; err != nil {
+			return nil, convertError(err)
+		}
+	} else {
+		res := keysQuery(tx, joinEUI, devEUI, id).Where("version = ?", model.Version).Updates(map[string]interface{}{
+			"version":    gorm.Expr("version + 1"),
+			"payload":    payload,
+			"updated_at": now,
+		})
+		if res.Error != nil {
+			return nil, convertError(res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return nil, errConcurrentModification.New()
+		}
+	}
+	return ttnpb.FilterGetSessionKeys(updated, gets...)
+}
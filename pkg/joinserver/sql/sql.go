@@ -0,0 +1,90 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sql implements SQL device and session key registries of the Join Server.
+package sql
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var (
+	errDatabase               = errors.DefineInternal("database", "database error")
+	errDecode                 = errors.DefineCorruption("decode", "decode stored message")
+	errConcurrentModification = errors.DefineAborted("concurrent_modification", "entity modified concurrently")
+)
+
+// endDevice is the database model of a stored end device.
+type endDevice struct {
+	ID                  uint64    `gorm:"primary_key;auto_increment"`
+	ApplicationID       string    `gorm:"type:VARCHAR(36);not null;unique_index:js_end_device_id_index"`
+	DeviceID            string    `gorm:"type:VARCHAR(36);not null;unique_index:js_end_device_id_index"`
+	JoinEUI             string    `gorm:"type:VARCHAR(16);not null;unique_index:js_end_device_eui_index"`
+	DevEUI              string    `gorm:"type:VARCHAR(16);not null;unique_index:js_end_device_eui_index"`
+	ProvisionerID       *string   `gorm:"type:VARCHAR(36);unique_index:js_end_device_provisioner_index"`
+	ProvisionerUniqueID *string   `gorm:"type:VARCHAR;unique_index:js_end_device_provisioner_index"`
+	Version             uint64    `gorm:"not null;default:0"`
+	Payload             []byte    `gorm:"type:BYTEA;not null"`
+	CreatedAt           time.Time `gorm:"not null"`
+	UpdatedAt           time.Time `gorm:"not null"`
+}
+
+// TableName implements the gorm tabler interface.
+func (endDevice) TableName() string { return "js_end_devices" }
+
+// sessionKeys is the database model of stored session keys.
+type sessionKeys struct {
+	ID           uint64    `gorm:"primary_key;auto_increment"`
+	JoinEUI      string    `gorm:"type:VARCHAR(16);not null;unique_index:js_session_key_id_index"`
+	DevEUI       string    `gorm:"type:VARCHAR(16);not null;unique_index:js_session_key_id_index"`
+	SessionKeyID []byte    `gorm:"type:BYTEA;not null;unique_index:js_session_key_id_index"`
+	Version      uint64    `gorm:"not null;default:0"`
+	Payload      []byte    `gorm:"type:BYTEA;not null"`
+	CreatedAt    time.Time `gorm:"not null"`
+	UpdatedAt    time.Time `gorm:"not null"`
+}
+
+// TableName implements the gorm tabler interface.
+func (sessionKeys) TableName() string { return "js_session_keys" }
+
+// Migrate migrates the database schema of the device and session key registries.
+func Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(&endDevice{}, &sessionKeys{}).Error; err != nil {
+		return errDatabase.WithCause(err)
+	}
+	return nil
+}
+
+var uniqueViolationRegex = regexp.MustCompile(`duplicate key value( .+)? violates unique constraint "([a-z_]+)"`)
+
+func convertError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+		if match := uniqueViolationRegex.FindStringSubmatch(pqErr.Message); match != nil {
+			switch match[2] {
+			case "js_end_device_id_index", "js_session_key_id_index":
+				return errConcurrentModification.WithCause(err)
+			case "js_end_device_eui_index":
+				return errDuplicateIdentifiers.WithCause(err)
+			case "js_end_device_provisioner_index":
+				return errAlreadyProvisioned.WithCause(err)
+			}
+		}
+	}
+	return errDatabase.WithCause(err)
+}
@@ -0,0 +1,280 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package devicerepository contains the payload formatter message processors that use the payload codecs
+// of the Device Repository.
+package devicerepository
+
+import (
+	"context"
+	"fmt"
+	"runtime/trace"
+	"strings"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/javascript"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gopkg.in/yaml.v2"
+)
+
+// Config contains the configuration of the Device Repository payload formatter.
+type Config struct {
+	CacheSize int           `name:"cache-size" description:"Number of payload codecs to cache (0 is unlimited)"`
+	CacheTTL  time.Duration `name:"cache-ttl" description:"Duration after which cached payload codecs are reloaded from the Device Repository (0 is never)"`
+}
+
+type host struct {
+	fetcher fetch.Interface
+	scripts messageprocessors.PayloadEncodeDecoder
+	cache   gcache.Cache
+}
+
+// New creates and returns a new Device Repository payload encoder and decoder.
+// The payload codecs are retrieved from the Device Repository using the given fetcher, and they are cached
+// according to the configuration.
+func New(fetcher fetch.Interface, conf Config) messageprocessors.PayloadEncodeDecoder {
+	h := &host{
+		fetcher: fetcher,
+		scripts: javascript.New(),
+	}
+	var builder *gcache.CacheBuilder
+	if conf.CacheSize > 0 {
+		builder = gcache.New(conf.CacheSize).LRU()
+	} else {
+		builder = gcache.New(-1)
+	}
+	if conf.CacheTTL > 0 {
+		builder = builder.Expiration(conf.CacheTTL)
+	}
+	h.cache = builder.LoaderFunc(func(key interface{}) (interface{}, error) {
+		return h.loadCodec(key.(codecKey))
+	}).Build()
+	return h
+}
+
+var (
+	errNoVersionIdentifiers = errors.DefineFailedPrecondition("no_version_identifiers", "no end device version identifiers")
+	errNoBandID             = errors.DefineFailedPrecondition("no_band_id", "no band ID")
+	errFetchFile            = errors.DefineUnavailable("fetch_file", "fetch file `{file}`")
+	errParseFile            = errors.DefineCorruption("parse_file", "parse file `{file}`")
+	errFirmwareVersion      = errors.DefineNotFound(
+		"firmware_version",
+		"firmware version `{firmware_version}` of hardware version `{hardware_version}` of model `{brand_id}/{model_id}` not found",
+	)
+	errBand  = errors.DefineNotFound("band", "band `{band_id}` of model `{brand_id}/{model_id}` not found")
+	errCodec = errors.DefineNotFound("codec", "{type} codec of model `{brand_id}/{model_id}` not found")
+)
+
+// codecKey identifies the payload codec of an end device model.
+type codecKey struct {
+	BrandID         string
+	ModelID         string
+	HardwareVersion string
+	FirmwareVersion string
+	BandID          string
+}
+
+func newCodecKey(version *ttnpb.EndDeviceVersionIdentifiers) (codecKey, error) {
+	if version == nil || version.BrandID == "" || version.ModelID == "" {
+		return codecKey{}, errNoVersionIdentifiers.New()
+	}
+	if version.BandID == "" {
+		return codecKey{}, errNoBandID.New()
+	}
+	return codecKey{
+		BrandID:         version.BrandID,
+		ModelID:         version.ModelID,
+		HardwareVersion: version.HardwareVersion,
+		FirmwareVersion: version.FirmwareVersion,
+		BandID:          version.BandID,
+	}, nil
+}
+
+type endDeviceModel struct {
+	FirmwareVersions []struct {
+		Version          string   `yaml:"version"`
+		HardwareVersions []string `yaml:"hardwareVersions"`
+		Profiles         map[string]struct {
+			Codec string `yaml:"codec"`
+		} `yaml:"profiles"`
+	} `yaml:"firmwareVersions"`
+}
+
+type codecFile struct {
+	FileName string `yaml:"fileName"`
+}
+
+type endDeviceCodec struct {
+	UplinkDecoder   *codecFile `yaml:"uplinkDecoder"`
+	DownlinkEncoder *codecFile `yaml:"downlinkEncoder"`
+	DownlinkDecoder *codecFile `yaml:"downlinkDecoder"`
+}
+
+// codec contains the scripts of a payload codec. Scripts that are not defined by the codec are empty.
+type codec struct {
+	UplinkDecoder   string
+	DownlinkEncoder string
+	DownlinkDecoder string
+}
+
+func (h *host) fetchYAML(v interface{}, pathElements ...string) error {
+	b, err := h.fetcher.File(pathElements...)
+	if err != nil {
+		return errFetchFile.WithCause(err).WithAttributes("file", strings.Join(pathElements, "/"))
+	}
+	if err := yaml.Unmarshal(b, v); err != nil {
+		return errParseFile.WithCause(err).WithAttributes("file", strings.Join(pathElements, "/"))
+	}
+	return nil
+}
+
+func (h *host) fetchScript(brandID string, file *codecFile) (string, error) {
+	if file == nil || file.FileName == "" {
+		return "", nil
+	}
+	b, err := h.fetcher.File("vendor", brandID, file.FileName)
+	if err != nil {
+		return "", errFetchFile.WithCause(err).WithAttributes("file", strings.Join([]string{"vendor", brandID, file.FileName}, "/"))
+	}
+	return string(b), nil
+}
+
+// bandProfileID returns the profile identifier of the given band ID as used in the Device Repository.
+// For example, EU_863_870 becomes EU863-870.
+func bandProfileID(bandID string) string {
+	return strings.ReplaceAll(strings.Replace(bandID, "_", "", 1), "_", "-")
+}
+
+func (h *host) loadCodec(key codecKey) (*codec, error) {
+	var model endDeviceModel
+	if err := h.fetchYAML(&model, "vendor", key.BrandID, fmt.Sprintf("%s.yaml", key.ModelID)); err != nil {
+		return nil, err
+	}
+	var codecID string
+	found := false
+	for _, fw := range model.FirmwareVersions {
+		if fw.Version != key.FirmwareVersion {
+			continue
+		}
+		if key.HardwareVersion != "" && len(fw.HardwareVersions) > 0 {
+			supported := false
+			for _, hw := range fw.HardwareVersions {
+				if hw == key.HardwareVersion {
+					supported = true
+					break
+				}
+			}
+			if !supported {
+				continue
+			}
+		}
+		found = true
+		profile, ok := fw.Profiles[key.BandID]
+		if !ok {
+			if profile, ok = fw.Profiles[bandProfileID(key.BandID)]; !ok {
+				return nil, errBand.WithAttributes(
+					"brand_id", key.BrandID,
+					"model_id", key.ModelID,
+					"band_id", key.BandID,
+				)
+			}
+		}
+		codecID = profile.Codec
+		break
+	}
+	if !found {
+		return nil, errFirmwareVersion.WithAttributes(
+			"brand_id", key.BrandID,
+			"model_id", key.ModelID,
+			"hardware_version", key.HardwareVersion,
+			"firmware_version", key.FirmwareVersion,
+		)
+	}
+	res := &codec{}
+	if codecID == "" {
+		return res, nil
+	}
+	var def endDeviceCodec
+	if err := h.fetchYAML(&def, "vendor", key.BrandID, fmt.Sprintf("%s.yaml", codecID)); err != nil {
+		return nil, err
+	}
+	var err error
+	if res.UplinkDecoder, err = h.fetchScript(key.BrandID, def.UplinkDecoder); err != nil {
+		return nil, err
+	}
+	if res.DownlinkEncoder, err = h.fetchScript(key.BrandID, def.DownlinkEncoder); err != nil {
+		return nil, err
+	}
+	if res.DownlinkDecoder, err = h.fetchScript(key.BrandID, def.DownlinkDecoder); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (h *host) getScript(version *ttnpb.EndDeviceVersionIdentifiers, typ string, get func(*codec) string) (string, error) {
+	key, err := newCodecKey(version)
+	if err != nil {
+		return "", err
+	}
+	v, err := h.cache.Get(key)
+	if err != nil {
+		return "", err
+	}
+	script := get(v.(*codec))
+	if script == "" {
+		return "", errCodec.WithAttributes(
+			"type", typ,
+			"brand_id", key.BrandID,
+			"model_id", key.ModelID,
+		)
+	}
+	return script, nil
+}
+
+// EncodeDownlink encodes the message's DecodedPayload to FRMPayload using the downlink encoder of the end device model.
+func (h *host) EncodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, parameter string) error {
+	defer trace.StartRegion(ctx, "encode downlink message").End()
+
+	script, err := h.getScript(version, "downlink encoder", func(c *codec) string { return c.DownlinkEncoder })
+	if err != nil {
+		return err
+	}
+	return h.scripts.EncodeDownlink(ctx, ids, version, msg, script)
+}
+
+// DecodeUplink decodes the message's FRMPayload to DecodedPayload using the uplink decoder of the end device model.
+func (h *host) DecodeUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, parameter string) error {
+	defer trace.StartRegion(ctx, "decode uplink message").End()
+
+	script, err := h.getScript(version, "uplink decoder", func(c *codec) string { return c.UplinkDecoder })
+	if err != nil {
+		return err
+	}
+	return h.scripts.DecodeUplink(ctx, ids, version, msg, script)
+}
+
+// DecodeDownlink decodes the message's FRMPayload to DecodedPayload using the downlink decoder of the end device model.
+func (h *host) DecodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, parameter string) error {
+	defer trace.StartRegion(ctx, "decode downlink message").End()
+
+	script, err := h.getScript(version, "downlink decoder", func(c *codec) string { return c.DownlinkDecoder })
+	if err != nil {
+		return err
+	}
+	return h.scripts.DecodeDownlink(ctx, ids, version, msg, script)
+}
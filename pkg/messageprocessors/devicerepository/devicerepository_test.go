@@ -0,0 +1,199 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicerepository
+
+import (
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var testRepository = map[string][]byte{
+	"vendor/foo-vendor/foo-model.yaml": []byte(`firmwareVersions:
+  - version: '1.0'
+    hardwareVersions:
+      - '1.0'
+    profiles:
+      EU863-870:
+        id: foo-profile
+        codec: foo-model-codec
+  - version: '2.0'
+    profiles:
+      EU863-870:
+        id: foo-profile
+`),
+	"vendor/foo-vendor/foo-model-codec.yaml": []byte(`uplinkDecoder:
+  fileName: foo-model.js
+downlinkEncoder:
+  fileName: foo-model.js
+`),
+	"vendor/foo-vendor/foo-model.js": []byte(`
+function decodeUplink(input) {
+	return {
+		data: {
+			sum: input.bytes.reduce(function (a, b) { return a + b; }, 0)
+		}
+	};
+}
+
+function encodeDownlink(input) {
+	return {
+		bytes: [input.data.value],
+		fPort: 42
+	};
+}
+`),
+}
+
+func TestDeviceRepository(t *testing.T) {
+	ctx := test.Context()
+	host := New(fetch.NewMemFetcher(testRepository), Config{})
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+	version := &ttnpb.EndDeviceVersionIdentifiers{
+		BrandID:         "foo-vendor",
+		ModelID:         "foo-model",
+		HardwareVersion: "1.0",
+		FirmwareVersion: "1.0",
+		BandID:          "EU_863_870",
+	}
+
+	t.Run("DecodeUplink", func(t *testing.T) {
+		a := assertions.New(t)
+		msg := &ttnpb.ApplicationUplink{
+			FPort:      1,
+			FRMPayload: []byte{1, 2, 3},
+		}
+		err := host.DecodeUplink(ctx, ids, version, msg, "")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(msg.DecodedPayload, should.Resemble, &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"sum": {
+					Kind: &pbtypes.Value_NumberValue{NumberValue: 6},
+				},
+			},
+		})
+	})
+
+	t.Run("EncodeDownlink", func(t *testing.T) {
+		a := assertions.New(t)
+		msg := &ttnpb.ApplicationDownlink{
+			DecodedPayload: &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					"value": {
+						Kind: &pbtypes.Value_NumberValue{NumberValue: 7},
+					},
+				},
+			},
+		}
+		err := host.EncodeDownlink(ctx, ids, version, msg, "")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(msg.FRMPayload, should.Resemble, []byte{7})
+		a.So(msg.FPort, should.Equal, 42)
+	})
+
+	t.Run("NoDownlinkDecoder", func(t *testing.T) {
+		a := assertions.New(t)
+		err := host.DecodeDownlink(ctx, ids, version, &ttnpb.ApplicationDownlink{FRMPayload: []byte{7}}, "")
+		a.So(err, should.HaveSameErrorDefinitionAs, errCodec)
+	})
+
+	t.Run("NoVersionIdentifiers", func(t *testing.T) {
+		a := assertions.New(t)
+		err := host.DecodeUplink(ctx, ids, nil, &ttnpb.ApplicationUplink{}, "")
+		a.So(err, should.HaveSameErrorDefinitionAs, errNoVersionIdentifiers)
+	})
+
+	t.Run("NoBandID", func(t *testing.T) {
+		a := assertions.New(t)
+		err := host.DecodeUplink(ctx, ids, &ttnpb.EndDeviceVersionIdentifiers{
+			BrandID:         "foo-vendor",
+			ModelID:         "foo-model",
+			FirmwareVersion: "1.0",
+		}, &ttnpb.ApplicationUplink{}, "")
+		a.So(err, should.HaveSameErrorDefinitionAs, errNoBandID)
+	})
+
+	t.Run("UnknownFirmwareVersion", func(t *testing.T) {
+		a := assertions.New(t)
+		err := host.DecodeUplink(ctx, ids, &ttnpb.EndDeviceVersionIdentifiers{
+			BrandID:         "foo-vendor",
+			ModelID:         "foo-model",
+			HardwareVersion: "2.0",
+			FirmwareVersion: "1.0",
+			BandID:          "EU_863_870",
+		}, &ttnpb.ApplicationUplink{}, "")
+		a.So(err, should.HaveSameErrorDefinitionAs, errFirmwareVersion)
+	})
+
+	t.Run("UnknownBand", func(t *testing.T) {
+		a := assertions.New(t)
+		err := host.DecodeUplink(ctx, ids, &ttnpb.EndDeviceVersionIdentifiers{
+			BrandID:         "foo-vendor",
+			ModelID:         "foo-model",
+			FirmwareVersion: "1.0",
+			BandID:          "US_902_928",
+		}, &ttnpb.ApplicationUplink{}, "")
+		a.So(err, should.HaveSameErrorDefinitionAs, errBand)
+	})
+
+	t.Run("NoCodec", func(t *testing.T) {
+		a := assertions.New(t)
+		err := host.DecodeUplink(ctx, ids, &ttnpb.EndDeviceVersionIdentifiers{
+			BrandID:         "foo-vendor",
+			ModelID:         "foo-model",
+			FirmwareVersion: "2.0",
+			BandID:          "EU_863_870",
+		}, &ttnpb.ApplicationUplink{}, "")
+		a.So(err, should.HaveSameErrorDefinitionAs, errCodec)
+	})
+
+	t.Run("UnknownModel", func(t *testing.T) {
+		a := assertions.New(t)
+		err := host.DecodeUplink(ctx, ids, &ttnpb.EndDeviceVersionIdentifiers{
+			BrandID:         "foo-vendor",
+			ModelID:         "bar-model",
+			FirmwareVersion: "1.0",
+			BandID:          "EU_863_870",
+		}, &ttnpb.ApplicationUplink{}, "")
+		a.So(err, should.HaveSameErrorDefinitionAs, errFetchFile)
+	})
+}
+
+func TestBandProfileID(t *testing.T) {
+	a := assertions.New(t)
+	for bandID, profileID := range map[string]string{
+		"EU_863_870": "EU863-870",
+		"US_902_928": "US902-928",
+		"AS_923":     "AS923",
+		"EU_433":     "EU433",
+	} {
+		a.So(bandProfileID(bandID), should.Equal, profileID)
+	}
+}
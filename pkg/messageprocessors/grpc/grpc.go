@@ -0,0 +1,364 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grpc contains the payload formatter message processors that use external payload formatter services.
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"path"
+	"runtime/trace"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Config contains the configuration of the gRPC payload formatter.
+type Config struct {
+	AllowedAddresses []string             `name:"allowed-addresses" description:"Addresses (host:port) or hosts of payload formatter services that are allowed, which may contain wildcards (*)"`
+	TLS              bool                 `name:"tls" description:"Use TLS to connect to payload formatter services"`
+	Timeout          time.Duration        `name:"timeout" description:"Timeout of requests to payload formatter services"`
+	IdleTimeout      time.Duration        `name:"idle-timeout" description:"Duration after which unused connections to payload formatter services are closed (0 is never)"`
+	CircuitBreaker   CircuitBreakerConfig `name:"circuit-breaker" description:"Circuit breaker configuration of payload formatter services"`
+}
+
+// CircuitBreakerConfig contains the configuration of the circuit breaker of each payload formatter service.
+type CircuitBreakerConfig struct {
+	Threshold int           `name:"threshold" description:"Number of consecutive failures after which requests are rejected (0 is disabled)"`
+	Timeout   time.Duration `name:"timeout" description:"Duration for which requests are rejected before the service is tried again"`
+}
+
+// Option represents an option for the gRPC payload formatter.
+type Option interface {
+	apply(*host)
+}
+
+type optionFunc func(*host)
+
+func (f optionFunc) apply(h *host) { f(h) }
+
+// WithTLSConfig sets the function that returns the TLS configuration to connect to payload formatter services.
+func WithTLSConfig(f func(context.Context) (*tls.Config, error)) Option {
+	return optionFunc(func(h *host) {
+		h.getTLSConfig = f
+	})
+}
+
+// WithDialOptions appends the given dial options to the dial options that are used to connect to payload formatter services.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return optionFunc(func(h *host) {
+		h.dialOpts = append(h.dialOpts, opts...)
+	})
+}
+
+type host struct {
+	ctx  context.Context
+	conf Config
+
+	getTLSConfig func(context.Context) (*tls.Config, error)
+	dialOpts     []grpc.DialOption
+
+	connsMu sync.Mutex
+	conns   map[string]*conn
+}
+
+// conn is a pooled connection to a payload formatter service.
+type conn struct {
+	cc     *grpc.ClientConn
+	client ttnpb.PayloadFormatterServiceClient
+
+	// active and lastUsed are protected by the connsMu of the host.
+	active   int
+	lastUsed time.Time
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+}
+
+// New creates and returns a new gRPC payload encoder and decoder.
+// The formatter parameter is the address (host:port) of the payload formatter service, which must be allowed by the
+// configuration.
+// Connections to payload formatter services are reused. They are closed when they are not used for the idle timeout,
+// and when the context is done.
+func New(ctx context.Context, conf Config, opts ...Option) messageprocessors.PayloadEncodeDecoder {
+	h := &host{
+		ctx:   ctx,
+		conf:  conf,
+		conns: make(map[string]*conn),
+	}
+	for _, opt := range opts {
+		opt.apply(h)
+	}
+	go func() {
+		var tickCh <-chan time.Time
+		if conf.IdleTimeout > 0 {
+			ticker := time.NewTicker(conf.IdleTimeout / 2)
+			defer ticker.Stop()
+			tickCh = ticker.C
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				h.closeIdle(time.Time{}, true)
+				return
+			case now := <-tickCh:
+				h.closeIdle(now, false)
+			}
+		}
+	}()
+	return h
+}
+
+var (
+	errNoAddress         = errors.DefineInvalidArgument("no_address", "no payload formatter service address")
+	errAddressNotAllowed = errors.DefineInvalidArgument("address_not_allowed", "payload formatter service address `{address}` is not allowed")
+	errDial              = errors.DefineUnavailable("dial", "dial payload formatter service `{address}`")
+	errCircuitOpen       = errors.DefineUnavailable("circuit_open", "payload formatter service `{address}` is unavailable")
+)
+
+// closeIdle closes the connections that are not used since the idle timeout before now.
+// If all is true, all connections are closed.
+func (h *host) closeIdle(now time.Time, all bool) {
+	h.connsMu.Lock()
+	defer h.connsMu.Unlock()
+	for address, c := range h.conns {
+		if !all && (c.active > 0 || now.Sub(c.lastUsed) < h.conf.IdleTimeout) {
+			continue
+		}
+		if err := c.cc.Close(); err != nil {
+			log.FromContext(h.ctx).WithError(err).WithField("address", address).Warn("Failed to close connection to payload formatter service")
+		}
+		delete(h.conns, address)
+	}
+}
+
+// allowed returns whether the given address matches any of the allowed addresses of the configuration.
+// Allowed addresses without port match the host of the address.
+func (h *host) allowed(address string) bool {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return false
+	}
+	for _, pattern := range h.conf.AllowedAddresses {
+		subject := address
+		if _, _, err := net.SplitHostPort(pattern); err != nil {
+			subject = host
+		}
+		if ok, err := path.Match(pattern, subject); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// acquire returns the connection to the payload formatter service at the given address.
+// The connection must be released after use.
+func (h *host) acquire(ctx context.Context, address string) (*conn, error) {
+	if address == "" {
+		return nil, errNoAddress.New()
+	}
+	if !h.allowed(address) {
+		return nil, errAddressNotAllowed.WithAttributes("address", address)
+	}
+	h.connsMu.Lock()
+	if c, ok := h.conns[address]; ok {
+		c.active++
+		h.connsMu.Unlock()
+		return c, nil
+	}
+	h.connsMu.Unlock()
+
+	// Dial without holding the lock, so that connecting to one payload formatter service does not block the use of
+	// connections to other payload formatter services.
+	dialOpts := append(rpcclient.DefaultDialOptions(h.ctx), h.dialOpts...)
+	if h.conf.TLS {
+		tlsConfig := &tls.Config{}
+		if h.getTLSConfig != nil {
+			var err error
+			if tlsConfig, err = h.getTLSConfig(ctx); err != nil {
+				return nil, err
+			}
+		}
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	}
+	cc, err := grpc.DialContext(h.ctx, address, dialOpts...)
+	if err != nil {
+		return nil, errDial.WithCause(err).WithAttributes("address", address)
+	}
+
+	h.connsMu.Lock()
+	defer h.connsMu.Unlock()
+	if c, ok := h.conns[address]; ok {
+		// Another caller connected to the payload formatter service in the meantime; use that connection instead.
+		if err := cc.Close(); err != nil {
+			log.FromContext(h.ctx).WithError(err).WithField("address", address).Warn("Failed to close connection to payload formatter service")
+		}
+		c.active++
+		return c, nil
+	}
+	c := &conn{
+		cc:     cc,
+		client: ttnpb.NewPayloadFormatterServiceClient(cc),
+		active: 1,
+	}
+	h.conns[address] = c
+	return c, nil
+}
+
+// release releases the connection that is acquired with acquire.
+func (h *host) release(c *conn) {
+	h.connsMu.Lock()
+	c.active--
+	c.lastUsed = time.Now()
+	h.connsMu.Unlock()
+}
+
+// isServiceFailure returns whether the error indicates that the payload formatter service failed, as opposed to
+// the payload formatter service rejecting the message.
+func isServiceFailure(err error) bool {
+	return errors.IsUnavailable(err) ||
+		errors.IsDeadlineExceeded(err) ||
+		errors.IsInternal(err) ||
+		errors.IsUnknown(err) ||
+		errors.IsUnimplemented(err)
+}
+
+// call calls f with the client of the payload formatter service at the given address, considering the timeout and the
+// circuit breaker of the configuration.
+func (h *host) call(ctx context.Context, address string, f func(context.Context, ttnpb.PayloadFormatterServiceClient) error) error {
+	c, err := h.acquire(ctx, address)
+	if err != nil {
+		return err
+	}
+	defer h.release(c)
+	threshold := h.conf.CircuitBreaker.Threshold
+	if threshold > 0 {
+		c.mu.Lock()
+		open := c.failures >= threshold && time.Since(c.openedAt) < h.conf.CircuitBreaker.Timeout
+		c.mu.Unlock()
+		if open {
+			return errCircuitOpen.WithAttributes("address", address)
+		}
+	}
+	if h.conf.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.conf.Timeout)
+		defer cancel()
+	}
+	err = f(ctx, c.client)
+	if threshold > 0 {
+		c.mu.Lock()
+		if err != nil && isServiceFailure(err) {
+			c.failures++
+			if c.failures >= threshold {
+				c.openedAt = time.Now()
+			}
+		} else {
+			c.failures = 0
+		}
+		c.mu.Unlock()
+	}
+	return err
+}
+
+func versionIDs(version *ttnpb.EndDeviceVersionIdentifiers) ttnpb.EndDeviceVersionIdentifiers {
+	if version == nil {
+		return ttnpb.EndDeviceVersionIdentifiers{}
+	}
+	return *version
+}
+
+// EncodeDownlink encodes the message's DecodedPayload to FRMPayload using the payload formatter service at the given address.
+func (h *host) EncodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, address string) error {
+	defer trace.StartRegion(ctx, "encode downlink message").End()
+
+	return h.call(ctx, address, func(ctx context.Context, client ttnpb.PayloadFormatterServiceClient) error {
+		res, err := client.EncodeDownlink(ctx, &ttnpb.EncodeDownlinkMessageRequest{
+			EndDeviceIdentifiers: ids,
+			EndDeviceVersionIDs:  versionIDs(version),
+			Message:              *msg,
+			Formatter:            ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE,
+			Parameter:            address,
+		})
+		if err != nil {
+			return err
+		}
+		msg.FRMPayload = res.FRMPayload
+		msg.DecodedPayloadWarnings = res.DecodedPayloadWarnings
+		if res.FPort != 0 {
+			msg.FPort = res.FPort
+		} else if msg.FPort == 0 {
+			msg.FPort = 1
+		}
+		return nil
+	})
+}
+
+// DecodeUplink decodes the message's FRMPayload to DecodedPayload using the payload formatter service at the given address.
+func (h *host) DecodeUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, address string) error {
+	defer trace.StartRegion(ctx, "decode uplink message").End()
+
+	return h.call(ctx, address, func(ctx context.Context, client ttnpb.PayloadFormatterServiceClient) error {
+		res, err := client.DecodeUplink(ctx, &ttnpb.DecodeUplinkMessageRequest{
+			EndDeviceIdentifiers: ids,
+			EndDeviceVersionIDs:  versionIDs(version),
+			Message:              *msg,
+			Formatter:            ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE,
+			Parameter:            address,
+		})
+		if err != nil {
+			return err
+		}
+		msg.DecodedPayload = res.DecodedPayload
+		msg.DecodedPayloadWarnings = res.DecodedPayloadWarnings
+		return nil
+	})
+}
+
+// DecodeDownlink decodes the message's FRMPayload to DecodedPayload using the payload formatter service at the given address.
+func (h *host) DecodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, address string) error {
+	defer trace.StartRegion(ctx, "decode downlink message").End()
+
+	return h.call(ctx, address, func(ctx context.Context, client ttnpb.PayloadFormatterServiceClient) error {
+		res, err := client.DecodeDownlink(ctx, &ttnpb.DecodeDownlinkMessageRequest{
+			EndDeviceIdentifiers: ids,
+			EndDeviceVersionIDs:  versionIDs(version),
+			Message:              *msg,
+			Formatter:            ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE,
+			Parameter:            address,
+		})
+		if err != nil {
+			return err
+		}
+		msg.DecodedPayload = res.DecodedPayload
+		msg.DecodedPayloadWarnings = res.DecodedPayloadWarnings
+		return nil
+	})
+}
@@ -0,0 +1,123 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestAllowedAddresses(t *testing.T) {
+	h := &host{
+		conf: Config{
+			AllowedAddresses: []string{
+				"formatter.example.com:443",
+				"*.formatters.example.com",
+				"10.0.0.1:*",
+			},
+		},
+	}
+	for _, tc := range []struct {
+		Address string
+		Allowed bool
+	}{
+		{Address: "formatter.example.com:443", Allowed: true},
+		{Address: "formatter.example.com:8443"},
+		{Address: "foo.formatters.example.com:443", Allowed: true},
+		{Address: "foo.formatters.example.com:8443", Allowed: true},
+		{Address: "formatters.example.com:443"},
+		{Address: "10.0.0.1:1234", Allowed: true},
+		{Address: "10.0.0.2:1234"},
+		{Address: "localhost:1234"},
+		{Address: "formatter.example.com"},
+		{Address: "unix:///var/run/formatter.sock"},
+	} {
+		t.Run(tc.Address, func(t *testing.T) {
+			assertions.New(t).So(h.allowed(tc.Address), should.Equal, tc.Allowed)
+		})
+	}
+}
+
+func TestCloseIdle(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	h := New(ctx, Config{
+		AllowedAddresses: []string{"127.0.0.1"},
+		IdleTimeout:      time.Hour,
+	}).(*host)
+
+	active, err := h.acquire(ctx, "127.0.0.1:1")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	idle, err := h.acquire(ctx, "127.0.0.1:2")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	h.release(idle)
+
+	// Connections that are used recently are not closed.
+	h.closeIdle(time.Now(), false)
+	a.So(h.conns, should.HaveLength, 2)
+
+	// Active connections are not closed.
+	h.closeIdle(time.Now().Add(time.Hour), false)
+	a.So(h.conns, should.HaveLength, 1)
+	a.So(h.conns, should.ContainKey, "127.0.0.1:1")
+
+	h.release(active)
+	h.closeIdle(time.Now().Add(time.Hour), false)
+	a.So(h.conns, should.BeEmpty)
+}
+
+func TestAcquireConcurrent(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	h := New(ctx, Config{
+		AllowedAddresses: []string{"127.0.0.1"},
+	}).(*host)
+
+	const n = 16
+	conns := make([]*conn, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			conns[i], errs[i] = h.acquire(ctx, "127.0.0.1:1")
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < n; i++ {
+		if !a.So(errs[i], should.BeNil) {
+			t.FailNow()
+		}
+		a.So(conns[i], should.Equal, conns[0])
+	}
+	a.So(h.conns, should.HaveLength, 1)
+	a.So(conns[0].active, should.Equal, n)
+}
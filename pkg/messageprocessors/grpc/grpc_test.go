@@ -0,0 +1,195 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpc"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+var errTest = errors.DefineUnavailable("test", "test")
+
+type mockPayloadFormatterService struct {
+	fail  int32
+	calls int32
+}
+
+func (s *mockPayloadFormatterService) call() error {
+	atomic.AddInt32(&s.calls, 1)
+	if atomic.LoadInt32(&s.fail) != 0 {
+		return errTest.New()
+	}
+	return nil
+}
+
+func (s *mockPayloadFormatterService) EncodeDownlink(ctx context.Context, req *ttnpb.EncodeDownlinkMessageRequest) (*ttnpb.ApplicationDownlink, error) {
+	if err := s.call(); err != nil {
+		return nil, err
+	}
+	return &ttnpb.ApplicationDownlink{
+		FPort:      42,
+		FRMPayload: []byte{byte(req.Message.DecodedPayload.Fields["value"].GetNumberValue())},
+	}, nil
+}
+
+func (s *mockPayloadFormatterService) DecodeUplink(ctx context.Context, req *ttnpb.DecodeUplinkMessageRequest) (*ttnpb.ApplicationUplink, error) {
+	if err := s.call(); err != nil {
+		return nil, err
+	}
+	return &ttnpb.ApplicationUplink{
+		DecodedPayload: &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"device_id": {
+					Kind: &pbtypes.Value_StringValue{StringValue: req.DeviceID},
+				},
+				"length": {
+					Kind: &pbtypes.Value_NumberValue{NumberValue: float64(len(req.Message.FRMPayload))},
+				},
+			},
+		},
+	}, nil
+}
+
+func (s *mockPayloadFormatterService) DecodeDownlink(ctx context.Context, req *ttnpb.DecodeDownlinkMessageRequest) (*ttnpb.ApplicationDownlink, error) {
+	atomic.AddInt32(&s.calls, 1)
+	return nil, errTest.New()
+}
+
+func startMockPayloadFormatterService(ctx context.Context) (*mockPayloadFormatterService, string) {
+	svc := &mockPayloadFormatterService{}
+	srv := grpc.NewServer()
+	ttnpb.RegisterPayloadFormatterServiceServer(srv, svc)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	go srv.Serve(lis)
+	go func() {
+		<-ctx.Done()
+		srv.Stop()
+	}()
+	return svc, lis.Addr().String()
+}
+
+func TestPayloadFormatterService(t *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	svc, address := startMockPayloadFormatterService(ctx)
+	host := New(ctx, Config{
+		AllowedAddresses: []string{"127.0.0.1"},
+		Timeout:          5 * time.Second,
+		CircuitBreaker: CircuitBreakerConfig{
+			Threshold: 2,
+			Timeout:   time.Hour,
+		},
+	})
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+
+	t.Run("DecodeUplink", func(t *testing.T) {
+		a := assertions.New(t)
+		msg := &ttnpb.ApplicationUplink{
+			FPort:      1,
+			FRMPayload: []byte{1, 2, 3},
+		}
+		err := host.DecodeUplink(ctx, ids, nil, msg, address)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(msg.DecodedPayload, should.Resemble, &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"device_id": {
+					Kind: &pbtypes.Value_StringValue{StringValue: "foo-device"},
+				},
+				"length": {
+					Kind: &pbtypes.Value_NumberValue{NumberValue: 3},
+				},
+			},
+		})
+	})
+
+	t.Run("EncodeDownlink", func(t *testing.T) {
+		a := assertions.New(t)
+		msg := &ttnpb.ApplicationDownlink{
+			DecodedPayload: &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					"value": {
+						Kind: &pbtypes.Value_NumberValue{NumberValue: 7},
+					},
+				},
+			},
+		}
+		err := host.EncodeDownlink(ctx, ids, nil, msg, address)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(msg.FRMPayload, should.Resemble, []byte{7})
+		a.So(msg.FPort, should.Equal, 42)
+	})
+
+	t.Run("NoAddress", func(t *testing.T) {
+		a := assertions.New(t)
+		err := host.DecodeUplink(ctx, ids, nil, &ttnpb.ApplicationUplink{}, "")
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+
+	t.Run("AddressNotAllowed", func(t *testing.T) {
+		a := assertions.New(t)
+		calls := atomic.LoadInt32(&svc.calls)
+		for _, address := range []string{
+			"localhost:1234",
+			"10.0.0.1:1234",
+			"unix:///var/run/formatter.sock",
+		} {
+			err := host.DecodeUplink(ctx, ids, nil, &ttnpb.ApplicationUplink{}, address)
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+		a.So(atomic.LoadInt32(&svc.calls), should.Equal, calls)
+	})
+
+	t.Run("CircuitBreaker", func(t *testing.T) {
+		a := assertions.New(t)
+		atomic.StoreInt32(&svc.fail, 1)
+		calls := atomic.LoadInt32(&svc.calls)
+		for i := 0; i < 2; i++ {
+			err := host.DecodeUplink(ctx, ids, nil, &ttnpb.ApplicationUplink{}, address)
+			a.So(errors.IsUnavailable(err), should.BeTrue)
+		}
+		a.So(atomic.LoadInt32(&svc.calls), should.Equal, calls+2)
+
+		// The circuit is open, so the service is not called.
+		err := host.DecodeUplink(ctx, ids, nil, &ttnpb.ApplicationUplink{}, address)
+		a.So(errors.IsUnavailable(err), should.BeTrue)
+		a.So(atomic.LoadInt32(&svc.calls), should.Equal, calls+2)
+	})
+}
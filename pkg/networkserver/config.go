@@ -34,6 +34,7 @@ type ApplicationUplinkQueueConfig struct {
 // MACSettingConfig defines MAC-layer configuration.
 type MACSettingConfig struct {
 	ADRMargin                  *float32                   `name:"adr-margin" description:"The default margin Network Server should add in ADR requests if not configured in device's MAC settings"`
+	ADRAlgorithm               *ttnpb.ADRAlgorithm        `name:"adr-algorithm" description:"The ADR algorithm Network Server should use if not configured in device's MAC settings (default, loss_aware, mobile)"`
 	DesiredRx1Delay            *ttnpb.RxDelay             `name:"desired-rx1-delay" description:"Desired Rx1Delay value Network Server should use if not configured in device's MAC settings"`
 	DesiredMaxDutyCycle        *ttnpb.AggregatedDutyCycle `name:"desired-max-duty-cycle" description:"Desired MaxDutyCycle value Network Server should use if not configured in device's MAC settings"`
 	DesiredADRAckLimitExponent *ttnpb.ADRAckLimitExponent `name:"desired-adr-ack-limit-exponent" description:"Desired ADR_ACK_LIMIT value Network Server should use if not configured in device's MAC settings"`
@@ -54,6 +55,9 @@ func (c MACSettingConfig) Parse() ttnpb.MACSettings {
 	if c.ADRMargin != nil {
 		p.ADRMargin = &pbtypes.FloatValue{Value: *c.ADRMargin}
 	}
+	if c.ADRAlgorithm != nil {
+		p.ADRAlgorithm = &ttnpb.ADRAlgorithmValue{Value: *c.ADRAlgorithm}
+	}
 	if c.DesiredRx1Delay != nil {
 		p.DesiredRx1Delay = &ttnpb.RxDelayValue{Value: *c.DesiredRx1Delay}
 	}
@@ -72,6 +76,31 @@ func (c MACSettingConfig) Parse() ttnpb.MACSettings {
 	return p
 }
 
+// ADRAlgorithmConfig defines the ADR algorithms of applications.
+type ADRAlgorithmConfig struct {
+	// Applications maps application IDs to the ADR algorithm of the end devices of the application.
+	// The ADR algorithm in the MAC settings of an end device takes precedence.
+	Applications map[string]string `name:"applications" description:"ADR algorithm by application ID, used if not configured in device's MAC settings (default, loss_aware, mobile)"`
+}
+
+var errADRAlgorithm = errors.DefineInvalidArgument("adr_algorithm", "invalid ADR algorithm `{value}` of application `{application_id}`")
+
+// Parse attempts to parse the configuration and returns the ADR algorithms by application ID.
+func (c ADRAlgorithmConfig) Parse() (map[string]ttnpb.ADRAlgorithm, error) {
+	if len(c.Applications) == 0 {
+		return nil, nil
+	}
+	algs := make(map[string]ttnpb.ADRAlgorithm, len(c.Applications))
+	for appID, value := range c.Applications {
+		var alg ttnpb.ADRAlgorithm
+		if err := alg.UnmarshalText([]byte(value)); err != nil {
+			return nil, errADRAlgorithm.WithCause(err).WithAttributes("value", value, "application_id", appID)
+		}
+		algs[appID] = alg
+	}
+	return algs, nil
+}
+
 // DownlinkPriorityConfig defines priorities for downlink messages.
 type DownlinkPriorityConfig struct {
 	// JoinAccept is the downlink priority for join-accept messages.
@@ -117,6 +146,7 @@ func (c DownlinkPriorityConfig) Parse() (DownlinkPriorities, error) {
 type Config struct {
 	ApplicationUplinkQueue ApplicationUplinkQueueConfig `name:"application-uplink-queue"`
 	Devices                DeviceRegistry               `name:"-"`
+	DeviceRegistry         config.DeviceRegistry        `name:"device-registry" description:"End device registry configuration"`
 	DownlinkTasks          DownlinkTaskQueue            `name:"-"`
 	UplinkDeduplicator     UplinkDeduplicator           `name:"-"`
 	NetID                  types.NetID                  `name:"net-id" description:"NetID of this Network Server"`
@@ -125,6 +155,7 @@ type Config struct {
 	CooldownWindow         time.Duration                `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
 	DownlinkPriorities     DownlinkPriorityConfig       `name:"downlink-priorities" description:"Downlink message priorities"`
 	DefaultMACSettings     MACSettingConfig             `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`
+	ADRAlgorithms          ADRAlgorithmConfig           `name:"adr-algorithms" description:"ADR algorithms of applications"`
 	Interop                config.InteropClient         `name:"interop" description:"Interop client configuration"`
 	DeviceKEKLabel         string                       `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
 	DownlinkQueueCapacity  int                          `name:"downlink-queue-capacity" description:"Maximum downlink queue size per-session"`
@@ -135,6 +166,9 @@ var DefaultConfig = Config{
 	ApplicationUplinkQueue: ApplicationUplinkQueueConfig{
 		BufferSize: 1000,
 	},
+	DeviceRegistry: config.DeviceRegistry{
+		Provider: "redis",
+	},
 	DeduplicationWindow: 200 * time.Millisecond,
 	CooldownWindow:      time.Second,
 	DownlinkPriorities: DownlinkPriorityConfig{
@@ -596,11 +596,43 @@ type downlinkPath struct {
 	*ttnpb.DownlinkPath
 }
 
+const (
+	// downlinkPathRSSIReference is the channel RSSI (dBm) at which the RSSI does not affect the score of a downlink path.
+	downlinkPathRSSIReference = -120
+	// downlinkPathRSSIWeight is the score (dB) per dB of channel RSSI above downlinkPathRSSIReference.
+	downlinkPathRSSIWeight = 0.1
+	// downlinkLoadUtilizationPenalty is the SNR penalty (dB) of a gateway that fully utilizes its duty-cycle.
+	downlinkLoadUtilizationPenalty = 10
+	// downlinkLoadQueueDepthPenalty is the SNR penalty (dB) per downlink message queued on a gateway.
+	downlinkLoadQueueDepthPenalty = 1
+	// downlinkLoadTxFailurePenalty is the SNR penalty (dB) of a gateway that fails all its downlink transmissions.
+	downlinkLoadTxFailurePenalty = 10
+)
+
+// downlinkPathScore returns the score of the downlink path through the gateway that received the uplink with the given metadata.
+// The score is the SNR, increased by the channel RSSI if known, and penalized by the downlink load of the gateway,
+// so that busy or failing gateways are tried last.
+func downlinkPathScore(md *ttnpb.RxMetadata) float32 {
+	score := md.SNR
+	if md.ChannelRSSI != 0 {
+		score += (md.ChannelRSSI - downlinkPathRSSIReference) * downlinkPathRSSIWeight
+	}
+	if load := md.DownlinkLoad; load != nil {
+		score -= load.Utilization * downlinkLoadUtilizationPenalty
+		score -= float32(load.QueueDepth) * downlinkLoadQueueDepthPenalty
+		score -= load.TxFailureRate * downlinkLoadTxFailurePenalty
+	}
+	return score
+}
+
 func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
 	mds = append(mds[:0:0], mds...)
+	scores := make(map[*ttnpb.RxMetadata]float32, len(mds))
+	for _, md := range mds {
+		scores[md] = downlinkPathScore(md)
+	}
 	sort.SliceStable(mds, func(i, j int) bool {
-		// TODO: Improve the sorting algorithm (https://github.com/TheThingsNetwork/lorawan-stack/issues/13)
-		return mds[i].SNR > mds[j].SNR
+		return scores[mds[i]] > scores[mds[j]]
 	})
 	head := make([]downlinkPath, 0, len(mds))
 	body := make([]downlinkPath, 0, len(mds))
@@ -959,7 +991,7 @@ func rx1Parameters(phy *band.Band, macState *ttnpb.MACState, up *ttnpb.UplinkMes
 	if err != nil {
 		return 0, 0, err
 	}
-	_, ok := phy.DataRates[drIdx]
+	_, ok := phy.FindDownlinkDataRate(drIdx)
 	if !ok {
 		return 0, 0, errDataRateIndexNotFound.WithAttributes("index", drIdx)
 	}
@@ -1085,7 +1117,7 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 		if err != nil {
 			log.FromContext(ctx).WithError(err).Error("Failed to compute RX1 parameters")
 		} else {
-			dr, ok := phy.DataRates[drIdx]
+			dr, ok := phy.FindDownlinkDataRate(drIdx)
 			if !ok {
 				log.FromContext(ctx).WithError(errDataRateIndexNotFound.WithAttributes("index", drIdx)).Error("Failed to compute RX1 parameters")
 			} else {
@@ -1096,7 +1128,7 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 			}
 		}
 	}
-	rx2DR, ok := phy.DataRates[dev.MACState.CurrentParameters.Rx2DataRateIndex]
+	rx2DR, ok := phy.FindDownlinkDataRate(dev.MACState.CurrentParameters.Rx2DataRateIndex)
 	if !ok {
 		log.FromContext(ctx).WithError(errDataRateIndexNotFound.WithAttributes("index", dev.MACState.CurrentParameters.Rx2DataRateIndex)).Error("Failed to compute RX2 parameters")
 	} else {
@@ -1261,7 +1293,7 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 	default:
 		panic(fmt.Sprintf("unmatched downlink class: '%s'", slot.Class))
 	}
-	dr, ok := phy.DataRates[drIdx]
+	dr, ok := phy.FindDownlinkDataRate(drIdx)
 	if !ok {
 		log.FromContext(ctx).WithField("data_rate_index", drIdx).Error("RX2 data rate not found")
 		return downlinkAttemptResult{
@@ -1556,7 +1588,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 							rx1DRIdx = drIdx
 						}
 					}
-					_, ok := phy.DataRates[dev.PendingMACState.CurrentParameters.Rx2DataRateIndex]
+					_, ok := phy.FindDownlinkDataRate(dev.PendingMACState.CurrentParameters.Rx2DataRateIndex)
 					if !ok {
 						log.FromContext(ctx).WithError(errDataRateIndexNotFound.WithAttributes("index", dev.PendingMACState.CurrentParameters.Rx2DataRateIndex)).Error("Failed to compute RX2 parameters")
 					} else {
@@ -1087,3 +1087,153 @@ func TestGenerateDataDownlink(t *testing.T) {
 		})
 	}
 }
+
+func TestDownlinkPathsFromMetadata(t *testing.T) {
+	gtwIDs := func(id string) ttnpb.GatewayIdentifiers {
+		return ttnpb.GatewayIdentifiers{GatewayID: id}
+	}
+	pathFrom := func(id string, token []byte) downlinkPath {
+		ids := gtwIDs(id)
+		return downlinkPath{
+			GatewayIdentifiers: &ids,
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: token,
+				},
+			},
+		}
+	}
+	for _, tc := range []struct {
+		Name       string
+		RxMetadata []*ttnpb.RxMetadata
+		Expected   []downlinkPath
+	}{
+		{
+			Name: "SNR",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers:     gtwIDs("gateway-a"),
+					SNR:                    -5,
+					UplinkToken:            []byte("token-a"),
+					DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE,
+				},
+				{
+					GatewayIdentifiers:     gtwIDs("gateway-b"),
+					SNR:                    5,
+					UplinkToken:            []byte("token-b"),
+					DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE,
+				},
+			},
+			Expected: []downlinkPath{
+				pathFrom("gateway-b", []byte("token-b")),
+				pathFrom("gateway-a", []byte("token-a")),
+			},
+		},
+		{
+			Name: "RSSI",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers:     gtwIDs("gateway-a"),
+					SNR:                    7,
+					ChannelRSSI:            -115,
+					UplinkToken:            []byte("token-a"),
+					DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE,
+				},
+				{
+					GatewayIdentifiers:     gtwIDs("gateway-b"),
+					SNR:                    5,
+					ChannelRSSI:            -60,
+					UplinkToken:            []byte("token-b"),
+					DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE,
+				},
+				{
+					GatewayIdentifiers:     gtwIDs("gateway-c"),
+					SNR:                    5,
+					ChannelRSSI:            -90,
+					UplinkToken:            []byte("token-c"),
+					DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE,
+				},
+			},
+			Expected: []downlinkPath{
+				pathFrom("gateway-b", []byte("token-b")),
+				pathFrom("gateway-c", []byte("token-c")),
+				pathFrom("gateway-a", []byte("token-a")),
+			},
+		},
+		{
+			Name: "DownlinkLoad",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers: gtwIDs("gateway-a"),
+					SNR:                10,
+					UplinkToken:        []byte("token-a"),
+					DownlinkLoad: &ttnpb.GatewayDownlinkLoad{
+						Utilization:   0.9,
+						QueueDepth:    3,
+						TxFailureRate: 0.2,
+					},
+					DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE,
+				},
+				{
+					GatewayIdentifiers: gtwIDs("gateway-b"),
+					SNR:                2,
+					UplinkToken:        []byte("token-b"),
+					DownlinkLoad: &ttnpb.GatewayDownlinkLoad{
+						Utilization: 0.1,
+					},
+					DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE,
+				},
+				{
+					GatewayIdentifiers:     gtwIDs("gateway-c"),
+					SNR:                    -3,
+					UplinkToken:            []byte("token-c"),
+					DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE,
+				},
+			},
+			Expected: []downlinkPath{
+				pathFrom("gateway-b", []byte("token-b")),
+				pathFrom("gateway-c", []byte("token-c")),
+				pathFrom("gateway-a", []byte("token-a")),
+			},
+		},
+		{
+			Name: "DownlinkPathConstraint",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers:     gtwIDs("gateway-a"),
+					SNR:                    10,
+					UplinkToken:            []byte("token-a"),
+					DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_PREFER_OTHER,
+				},
+				{
+					GatewayIdentifiers: gtwIDs("gateway-b"),
+					SNR:                5,
+					UplinkToken:        []byte("token-b"),
+					DownlinkLoad: &ttnpb.GatewayDownlinkLoad{
+						QueueDepth: 10,
+					},
+					DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE,
+				},
+				{
+					GatewayIdentifiers:     gtwIDs("gateway-c"),
+					SNR:                    15,
+					UplinkToken:            []byte("token-c"),
+					DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER,
+				},
+			},
+			Expected: []downlinkPath{
+				pathFrom("gateway-b", []byte("token-b")),
+				pathFrom("gateway-a", []byte("token-a")),
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				a.So(downlinkPathsFromMetadata(tc.RxMetadata...), should.Resemble, tc.Expected)
+			},
+		})
+	}
+}
@@ -16,6 +16,7 @@ package networkserver
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
@@ -26,6 +27,8 @@ import (
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 var (
@@ -50,23 +53,49 @@ var (
 	)
 )
 
+// defaultListLimit is the number of end devices that are listed per page if no limit is specified.
+const defaultListLimit = 100
+
+func setTotalHeader(ctx context.Context, total uint64) {
+	grpc.SetHeader(ctx, metadata.Pairs("x-total-count", strconv.FormatUint(total, 10)))
+}
+
 // Get implements NsEndDeviceRegistryServer.
 func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
-	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+	gets, err := ns.endDeviceReadPaths(ctx, req.ApplicationIdentifiers, req.FieldMask.Paths)
+	if err != nil {
 		return nil, err
 	}
-	if ttnpb.HasAnyField(req.FieldMask.Paths,
+
+	dev, ctx, err := ns.devices.GetByID(ctx, req.ApplicationIdentifiers, req.DeviceID, gets)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get device from registry")
+		return nil, err
+	}
+	if err := ns.unwrapEndDeviceKeys(ctx, dev, req.FieldMask.Paths); err != nil {
+		return nil, err
+	}
+	return ttnpb.FilterGetEndDevice(dev, req.FieldMask.Paths...)
+}
+
+// endDeviceReadPaths checks that the caller has the rights to read paths of end devices of application appID
+// and returns the paths, which need to be retrieved from the registry to construct them.
+func (ns *NetworkServer) endDeviceReadPaths(ctx context.Context, appID ttnpb.ApplicationIdentifiers, paths []string) ([]string, error) {
+	if err := rights.RequireApplication(ctx, appID, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	if ttnpb.HasAnyField(paths,
 		"pending_session.queued_application_downlinks",
 		"queued_application_downlinks",
 		"session.queued_application_downlinks",
 	) {
-		if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_LINK); err != nil {
+		if err := rights.RequireApplication(ctx, appID, ttnpb.RIGHT_APPLICATION_LINK); err != nil {
 			return nil, err
 		}
 	}
 
-	gets := req.FieldMask.Paths
-	if ttnpb.HasAnyField(req.FieldMask.Paths,
+	gets := paths
+	if ttnpb.HasAnyField(paths,
 		"mac_state.queued_join_accept.keys.f_nwk_s_int_key.key",
 		"mac_state.queued_join_accept.keys.nwk_s_enc_key.key",
 		"mac_state.queued_join_accept.keys.s_nwk_s_int_key.key",
@@ -80,10 +109,10 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 		"session.keys.nwk_s_enc_key.key",
 		"session.keys.s_nwk_s_int_key.key",
 	) {
-		if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS); err != nil {
+		if err := rights.RequireApplication(ctx, appID, ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS); err != nil {
 			return nil, err
 		}
-		if ttnpb.HasAnyField(req.FieldMask.Paths,
+		if ttnpb.HasAnyField(paths,
 			"pending_session.keys.f_nwk_s_int_key.key",
 		) {
 			gets = ttnpb.AddFields(gets,
@@ -91,7 +120,7 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 				"pending_session.keys.f_nwk_s_int_key.kek_label",
 			)
 		}
-		if ttnpb.HasAnyField(req.FieldMask.Paths,
+		if ttnpb.HasAnyField(paths,
 			"pending_session.keys.nwk_s_enc_key.key",
 		) {
 			gets = ttnpb.AddFields(gets,
@@ -99,7 +128,7 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 				"pending_session.keys.nwk_s_enc_key.kek_label",
 			)
 		}
-		if ttnpb.HasAnyField(req.FieldMask.Paths,
+		if ttnpb.HasAnyField(paths,
 			"pending_session.keys.s_nwk_s_int_key.key",
 		) {
 			gets = ttnpb.AddFields(gets,
@@ -108,7 +137,7 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 			)
 		}
 
-		if ttnpb.HasAnyField(req.FieldMask.Paths,
+		if ttnpb.HasAnyField(paths,
 			"session.keys.f_nwk_s_int_key.key",
 		) {
 			gets = ttnpb.AddFields(gets,
@@ -116,7 +145,7 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 				"session.keys.f_nwk_s_int_key.kek_label",
 			)
 		}
-		if ttnpb.HasAnyField(req.FieldMask.Paths,
+		if ttnpb.HasAnyField(paths,
 			"session.keys.nwk_s_enc_key.key",
 		) {
 			gets = ttnpb.AddFields(gets,
@@ -124,7 +153,7 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 				"session.keys.nwk_s_enc_key.kek_label",
 			)
 		}
-		if ttnpb.HasAnyField(req.FieldMask.Paths,
+		if ttnpb.HasAnyField(paths,
 			"session.keys.s_nwk_s_int_key.key",
 		) {
 			gets = ttnpb.AddFields(gets,
@@ -133,7 +162,7 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 			)
 		}
 
-		if ttnpb.HasAnyField(req.FieldMask.Paths,
+		if ttnpb.HasAnyField(paths,
 			"pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key.key",
 		) {
 			gets = ttnpb.AddFields(gets,
@@ -141,7 +170,7 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 				"pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key.kek_label",
 			)
 		}
-		if ttnpb.HasAnyField(req.FieldMask.Paths,
+		if ttnpb.HasAnyField(paths,
 			"pending_mac_state.queued_join_accept.keys.nwk_s_enc_key.key",
 		) {
 			gets = ttnpb.AddFields(gets,
@@ -149,7 +178,7 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 				"pending_mac_state.queued_join_accept.keys.nwk_s_enc_key.kek_label",
 			)
 		}
-		if ttnpb.HasAnyField(req.FieldMask.Paths,
+		if ttnpb.HasAnyField(paths,
 			"pending_mac_state.queued_join_accept.keys.s_nwk_s_int_key.key",
 		) {
 			gets = ttnpb.AddFields(gets,
@@ -158,7 +187,7 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 			)
 		}
 
-		if ttnpb.HasAnyField(req.FieldMask.Paths,
+		if ttnpb.HasAnyField(paths,
 			"mac_state.queued_join_accept.keys.f_nwk_s_int_key.key",
 		) {
 			gets = ttnpb.AddFields(gets,
@@ -166,7 +195,7 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 				"mac_state.queued_join_accept.keys.f_nwk_s_int_key.kek_label",
 			)
 		}
-		if ttnpb.HasAnyField(req.FieldMask.Paths,
+		if ttnpb.HasAnyField(paths,
 			"mac_state.queued_join_accept.keys.nwk_s_enc_key.key",
 		) {
 			gets = ttnpb.AddFields(gets,
@@ -174,7 +203,7 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 				"mac_state.queued_join_accept.keys.nwk_s_enc_key.kek_label",
 			)
 		}
-		if ttnpb.HasAnyField(req.FieldMask.Paths,
+		if ttnpb.HasAnyField(paths,
 			"mac_state.queued_join_accept.keys.s_nwk_s_int_key.key",
 		) {
 			gets = ttnpb.AddFields(gets,
@@ -183,59 +212,174 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 			)
 		}
 	}
+	return gets, nil
+}
 
-	dev, ctx, err := ns.devices.GetByID(ctx, req.ApplicationIdentifiers, req.DeviceID, gets)
-	if err != nil {
-		logRegistryRPCError(ctx, err, "Failed to get device from registry")
-		return nil, err
-	}
-
-	if dev.PendingSession != nil && ttnpb.HasAnyField(req.FieldMask.Paths,
+// unwrapEndDeviceKeys unwraps the session keys of dev requested in paths.
+func (ns *NetworkServer) unwrapEndDeviceKeys(ctx context.Context, dev *ttnpb.EndDevice, paths []string) error {
+	if dev.PendingSession != nil && ttnpb.HasAnyField(paths,
 		"pending_session.keys.f_nwk_s_int_key.key",
 		"pending_session.keys.nwk_s_enc_key.key",
 		"pending_session.keys.s_nwk_s_int_key.key",
 	) {
-		sk, err := cryptoutil.UnwrapSelectedSessionKeys(ctx, ns.KeyVault, dev.PendingSession.SessionKeys, "pending_session.keys", req.FieldMask.Paths...)
+		sk, err := cryptoutil.UnwrapSelectedSessionKeys(ctx, ns.KeyVault, dev.PendingSession.SessionKeys, "pending_session.keys", paths...)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		dev.PendingSession.SessionKeys = sk
 	}
-	if dev.Session != nil && ttnpb.HasAnyField(req.FieldMask.Paths,
+	if dev.Session != nil && ttnpb.HasAnyField(paths,
 		"session.keys.f_nwk_s_int_key.key",
 		"session.keys.nwk_s_enc_key.key",
 		"session.keys.s_nwk_s_int_key.key",
 	) {
-		sk, err := cryptoutil.UnwrapSelectedSessionKeys(ctx, ns.KeyVault, dev.Session.SessionKeys, "session.keys", req.FieldMask.Paths...)
+		sk, err := cryptoutil.UnwrapSelectedSessionKeys(ctx, ns.KeyVault, dev.Session.SessionKeys, "session.keys", paths...)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		dev.Session.SessionKeys = sk
 	}
 
-	if dev.PendingMACState.GetQueuedJoinAccept() != nil && ttnpb.HasAnyField(req.FieldMask.Paths,
+	if dev.PendingMACState.GetQueuedJoinAccept() != nil && ttnpb.HasAnyField(paths,
 		"pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key.key",
 		"pending_mac_state.queued_join_accept.keys.nwk_s_enc_key.key",
 		"pending_mac_state.queued_join_accept.keys.s_nwk_s_int_key.key",
 	) {
-		sk, err := cryptoutil.UnwrapSelectedSessionKeys(ctx, ns.KeyVault, dev.PendingMACState.QueuedJoinAccept.Keys, "pending_mac_state.queued_join_accept.keys", req.FieldMask.Paths...)
+		sk, err := cryptoutil.UnwrapSelectedSessionKeys(ctx, ns.KeyVault, dev.PendingMACState.QueuedJoinAccept.Keys, "pending_mac_state.queued_join_accept.keys", paths...)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		dev.PendingMACState.QueuedJoinAccept.Keys = sk
 	}
-	if dev.MACState.GetQueuedJoinAccept() != nil && ttnpb.HasAnyField(req.FieldMask.Paths,
+	if dev.MACState.GetQueuedJoinAccept() != nil && ttnpb.HasAnyField(paths,
 		"mac_state.queued_join_accept.keys.f_nwk_s_int_key.key",
 		"mac_state.queued_join_accept.keys.nwk_s_enc_key.key",
 		"mac_state.queued_join_accept.keys.s_nwk_s_int_key.key",
 	) {
-		sk, err := cryptoutil.UnwrapSelectedSessionKeys(ctx, ns.KeyVault, dev.MACState.QueuedJoinAccept.Keys, "mac_state.queued_join_accept.keys", req.FieldMask.Paths...)
+		sk, err := cryptoutil.UnwrapSelectedSessionKeys(ctx, ns.KeyVault, dev.MACState.QueuedJoinAccept.Keys, "mac_state.queued_join_accept.keys", paths...)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		dev.MACState.QueuedJoinAccept.Keys = sk
 	}
-	return ttnpb.FilterGetEndDevice(dev, req.FieldMask.Paths...)
+	return nil
+}
+
+// hasListFilters returns whether req specifies filters that are not handled by the device registry.
+func hasListFilters(req *ttnpb.ListNsEndDevicesRequest) bool {
+	return req.LoRaWANVersion != ttnpb.MAC_UNKNOWN ||
+		len(req.DeviceClasses) > 0 ||
+		req.LastSeenAfter != nil ||
+		req.LastSeenBefore != nil
+}
+
+// matchesListFilters returns whether dev matches the filters specified in req.
+// The DevAddr prefix is matched by the device registry.
+func matchesListFilters(dev *ttnpb.EndDevice, req *ttnpb.ListNsEndDevicesRequest) bool {
+	if req.LoRaWANVersion != ttnpb.MAC_UNKNOWN && dev.LoRaWANVersion != req.LoRaWANVersion {
+		return false
+	}
+	if len(req.DeviceClasses) > 0 {
+		if dev.MACState == nil {
+			return false
+		}
+		var found bool
+		for _, class := range req.DeviceClasses {
+			if dev.MACState.DeviceClass == class {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if req.LastSeenAfter != nil || req.LastSeenBefore != nil {
+		ups := dev.GetMACState().GetRecentUplinks()
+		if len(ups) == 0 {
+			return false
+		}
+		up := LastUplink(ups...)
+		if req.LastSeenAfter != nil && up.ReceivedAt.Before(*req.LastSeenAfter) {
+			return false
+		}
+		if req.LastSeenBefore != nil && !up.ReceivedAt.Before(*req.LastSeenBefore) {
+			return false
+		}
+	}
+	return true
+}
+
+// List implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) List(ctx context.Context, req *ttnpb.ListNsEndDevicesRequest) (*ttnpb.EndDevices, error) {
+	gets, err := ns.endDeviceReadPaths(ctx, req.ApplicationIdentifiers, req.FieldMask.Paths)
+	if err != nil {
+		return nil, err
+	}
+	if req.LoRaWANVersion != ttnpb.MAC_UNKNOWN {
+		gets = ttnpb.AddFields(gets,
+			"lorawan_version",
+		)
+	}
+	if len(req.DeviceClasses) > 0 {
+		gets = ttnpb.AddFields(gets,
+			"mac_state.device_class",
+		)
+	}
+	if req.LastSeenAfter != nil || req.LastSeenBefore != nil {
+		gets = ttnpb.AddFields(gets,
+			"mac_state.recent_uplinks",
+		)
+	}
+
+	limit := uint64(req.Limit)
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	var offset uint64
+	if req.Page > 0 {
+		offset = uint64(req.Page-1) * limit
+	}
+	var (
+		total uint64
+		devs  []*ttnpb.EndDevice
+	)
+	if !hasListFilters(req) {
+		// The registry pages over the devices, so only the devices of the requested page are fetched.
+		total, err = ns.devices.RangeByApplicationID(ctx, req.ApplicationIdentifiers, req.DevAddrPrefix, offset, gets, func(ctx context.Context, dev *ttnpb.EndDevice) bool {
+			devs = append(devs, dev)
+			return uint64(len(devs)) < limit
+		})
+	} else {
+		_, err = ns.devices.RangeByApplicationID(ctx, req.ApplicationIdentifiers, req.DevAddrPrefix, 0, gets, func(ctx context.Context, dev *ttnpb.EndDevice) bool {
+			if !matchesListFilters(dev, req) {
+				return true
+			}
+			total++
+			if total > offset && total <= offset+limit {
+				devs = append(devs, dev)
+			}
+			return true
+		})
+	}
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to list devices from registry")
+		return nil, err
+	}
+	for i, dev := range devs {
+		if err := ns.unwrapEndDeviceKeys(ctx, dev, req.FieldMask.Paths); err != nil {
+			return nil, err
+		}
+		dev, err := ttnpb.FilterGetEndDevice(dev, req.FieldMask.Paths...)
+		if err != nil {
+			return nil, err
+		}
+		devs[i] = dev
+	}
+	setTotalHeader(ctx, total)
+	return &ttnpb.EndDevices{
+		EndDevices: devs,
+	}, nil
 }
 
 // Set implements NsEndDeviceRegistryServer.
@@ -647,3 +791,173 @@ func (ns *NetworkServer) Delete(ctx context.Context, req *ttnpb.EndDeviceIdentif
 	}
 	return ttnpb.Empty, err
 }
+
+// archivePaths are the paths of the end device fields stored by the Network Server, which are included in archives.
+var archivePaths = []string{
+	"battery_percentage",
+	"downlink_margin",
+	"frequency_plan_id",
+	"ids",
+	"last_dev_status_received_at",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"mac_state",
+	"max_frequency",
+	"min_frequency",
+	"multicast",
+	"pending_mac_state",
+	"pending_session",
+	"power_state",
+	"queued_application_downlinks",
+	"recent_adr_uplinks",
+	"recent_downlinks",
+	"recent_uplinks",
+	"session",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+	"version_ids",
+}
+
+// unwrapNetworkSessionKeys unwraps the network session keys in sk.
+// The AppSKey is left as-is, since it is not wrapped by the Network Server.
+func (ns *NetworkServer) unwrapNetworkSessionKeys(ctx context.Context, sk *ttnpb.SessionKeys) error {
+	for _, ke := range []**ttnpb.KeyEnvelope{
+		&sk.FNwkSIntKey,
+		&sk.NwkSEncKey,
+		&sk.SNwkSIntKey,
+	} {
+		if *ke == nil {
+			continue
+		}
+		unwrapped, err := cryptoutil.UnwrapKeyEnvelope(ctx, *ke, ns.KeyVault)
+		if err != nil {
+			return err
+		}
+		*ke = unwrapped
+	}
+	return nil
+}
+
+// wrapNetworkSessionKeys wraps the plaintext network session keys in sk using the device KEK label.
+func (ns *NetworkServer) wrapNetworkSessionKeys(ctx context.Context, sk *ttnpb.SessionKeys) error {
+	for _, ke := range []**ttnpb.KeyEnvelope{
+		&sk.FNwkSIntKey,
+		&sk.NwkSEncKey,
+		&sk.SNwkSIntKey,
+	} {
+		if (*ke).GetKey() == nil {
+			continue
+		}
+		wrapped, err := cryptoutil.WrapAES128Key(ctx, *(*ke).Key, ns.deviceKEKLabel, ns.KeyVault)
+		if err != nil {
+			return err
+		}
+		*ke = wrapped
+	}
+	return nil
+}
+
+// archivedSessionKeys returns the session keys of dev, which are stored by the Network Server.
+func archivedSessionKeys(dev *ttnpb.EndDevice) []*ttnpb.SessionKeys {
+	var sks []*ttnpb.SessionKeys
+	if dev.Session != nil {
+		sks = append(sks, &dev.Session.SessionKeys)
+	}
+	if dev.PendingSession != nil {
+		sks = append(sks, &dev.PendingSession.SessionKeys)
+	}
+	if dev.MACState.GetQueuedJoinAccept() != nil {
+		sks = append(sks, &dev.MACState.QueuedJoinAccept.Keys)
+	}
+	if dev.PendingMACState.GetQueuedJoinAccept() != nil {
+		sks = append(sks, &dev.PendingMACState.QueuedJoinAccept.Keys)
+	}
+	return sks
+}
+
+// Export implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) Export(ctx context.Context, req *ttnpb.ExportEndDevicesRequest) (*ttnpb.EndDeviceArchive, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+		ttnpb.RIGHT_APPLICATION_LINK,
+	); err != nil {
+		return nil, err
+	}
+	devs := make([]*ttnpb.EndDevice, 0, len(req.DeviceIDs))
+	for _, devID := range req.DeviceIDs {
+		dev, ctx, err := ns.devices.GetByID(ctx, req.ApplicationIdentifiers, devID, archivePaths)
+		if err != nil {
+			logRegistryRPCError(ctx, err, "Failed to get device from registry")
+			return nil, err
+		}
+		for _, sk := range archivedSessionKeys(dev) {
+			if err := ns.unwrapNetworkSessionKeys(ctx, sk); err != nil {
+				return nil, err
+			}
+		}
+		devs = append(devs, dev)
+	}
+	return cryptoutil.WrapEndDeviceArchive(ctx, devs, req.KEKLabel, ns.KeyVault)
+}
+
+// Import implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) Import(ctx context.Context, req *ttnpb.ImportEndDevicesRequest) (*ttnpb.EndDevices, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	devs, err := cryptoutil.UnwrapEndDeviceArchive(ctx, req.Archive, ns.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+	for _, dev := range devs {
+		if dev.ApplicationIdentifiers != req.ApplicationIdentifiers {
+			return nil, errInvalidFieldValue.WithAttributes("field", "ids.application_ids")
+		}
+		if err := dev.ValidateFields(); err != nil {
+			return nil, err
+		}
+		if _, err := DeviceBand(dev, ns.FrequencyPlans); err != nil {
+			return nil, err
+		}
+		for _, sk := range archivedSessionKeys(dev) {
+			if err := ns.wrapNetworkSessionKeys(ctx, sk); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	res := &ttnpb.EndDevices{
+		EndDevices: make([]*ttnpb.EndDevice, 0, len(devs)),
+	}
+	for _, dev := range devs {
+		var evt events.Event
+		stored, ctx, err := ns.devices.SetByID(ctx, dev.ApplicationIdentifiers, dev.DeviceID, archivePaths, func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored != nil {
+				evt = evtUpdateEndDevice.NewWithIdentifiersAndData(ctx, dev.EndDeviceIdentifiers, archivePaths)
+			} else {
+				evt = evtCreateEndDevice.NewWithIdentifiersAndData(ctx, dev.EndDeviceIdentifiers, nil)
+			}
+			return dev, archivePaths, nil
+		})
+		if err != nil {
+			logRegistryRPCError(ctx, err, "Failed to import device in registry")
+			return nil, err
+		}
+		if evt != nil {
+			events.Publish(evt)
+		}
+		if err := ns.updateDataDownlinkTask(ctx, stored, time.Time{}); err != nil {
+			log.FromContext(ctx).WithError(err).Error("Failed to update downlink task queue after device import")
+		}
+		res.EndDevices = append(res.EndDevices, &ttnpb.EndDevice{
+			EndDeviceIdentifiers: stored.EndDeviceIdentifiers,
+		})
+	}
+	return res, nil
+}
@@ -396,6 +396,287 @@ func TestDeviceRegistryGet(t *testing.T) {
 	}
 }
 
+func TestDeviceRegistryList(t *testing.T) {
+	lastSeen := time.Unix(42, 0).UTC()
+	devs := []*ttnpb.EndDevice{
+		{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				DeviceID:               "test-dev-1",
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+			},
+			FrequencyPlanID: test.EUFrequencyPlanID,
+			LoRaWANVersion:  ttnpb.MAC_V1_0_3,
+			MACState: &ttnpb.MACState{
+				DeviceClass: ttnpb.CLASS_A,
+				RecentUplinks: []*ttnpb.UplinkMessage{
+					{ReceivedAt: lastSeen},
+				},
+			},
+			Session: &ttnpb.Session{
+				DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+			},
+		},
+		{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				DeviceID:               "test-dev-2",
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+			},
+			FrequencyPlanID: test.EUFrequencyPlanID,
+			LoRaWANVersion:  ttnpb.MAC_V1_1,
+			MACState: &ttnpb.MACState{
+				DeviceClass: ttnpb.CLASS_C,
+				RecentUplinks: []*ttnpb.UplinkMessage{
+					{ReceivedAt: lastSeen.Add(-time.Hour)},
+					{ReceivedAt: lastSeen.Add(time.Hour)},
+				},
+			},
+			PendingSession: &ttnpb.Session{
+				DevAddr: types.DevAddr{0x02, 0x02, 0x03, 0x04},
+			},
+		},
+		{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				DeviceID:               "test-dev-3",
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+			},
+			FrequencyPlanID: test.EUFrequencyPlanID,
+			LoRaWANVersion:  ttnpb.MAC_V1_0_3,
+		},
+	}
+	makeExpected := func(ids ...string) []*ttnpb.EndDevice {
+		var ret []*ttnpb.EndDevice
+		for _, dev := range devs {
+			for _, id := range ids {
+				if dev.DeviceID == id {
+					ret = append(ret, &ttnpb.EndDevice{
+						EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+						FrequencyPlanID:      dev.FrequencyPlanID,
+					})
+				}
+			}
+		}
+		return ret
+	}
+	readRights := func(ctx context.Context) context.Context {
+		return rights.NewContext(ctx, rights.Rights{
+			ApplicationRights: map[string]*ttnpb.Rights{
+				unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}): {
+					Rights: []ttnpb.Right{
+						ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+					},
+				},
+			},
+		})
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		ContextFunc    func(context.Context) context.Context
+		Request        *ttnpb.ListNsEndDevicesRequest
+		Devices        []*ttnpb.EndDevice
+		ErrorAssertion func(*testing.T, error) bool
+		RangeCalls     uint64
+		RangeOffset    uint64
+	}{
+		{
+			Name: "No device read rights",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC,
+							},
+						},
+					},
+				})
+			},
+			Request: &ttnpb.ListNsEndDevicesRequest{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"frequency_plan_id",
+					},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				if !assertions.New(t).So(errors.IsPermissionDenied(err), should.BeTrue) {
+					t.Errorf("Received error: %s", err)
+					return false
+				}
+				return true
+			},
+		},
+
+		{
+			Name:        "no filters",
+			ContextFunc: readRights,
+			Request: &ttnpb.ListNsEndDevicesRequest{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"frequency_plan_id",
+					},
+				},
+			},
+			Devices:    makeExpected("test-dev-1", "test-dev-2", "test-dev-3"),
+			RangeCalls: 1,
+		},
+
+		{
+			Name:        "pagination",
+			ContextFunc: readRights,
+			Request: &ttnpb.ListNsEndDevicesRequest{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"frequency_plan_id",
+					},
+				},
+				Limit: 2,
+				Page:  2,
+			},
+			Devices:     makeExpected("test-dev-3"),
+			RangeCalls:  1,
+			RangeOffset: 2,
+		},
+
+		{
+			Name:        "pagination with filters",
+			ContextFunc: readRights,
+			Request: &ttnpb.ListNsEndDevicesRequest{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"frequency_plan_id",
+					},
+				},
+				DeviceClasses: []ttnpb.Class{ttnpb.CLASS_A, ttnpb.CLASS_C},
+				Limit:         1,
+				Page:          2,
+			},
+			Devices:    makeExpected("test-dev-2"),
+			RangeCalls: 1,
+		},
+
+		{
+			Name:        "LoRaWAN version and class",
+			ContextFunc: readRights,
+			Request: &ttnpb.ListNsEndDevicesRequest{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"frequency_plan_id",
+					},
+				},
+				LoRaWANVersion: ttnpb.MAC_V1_0_3,
+				DeviceClasses:  []ttnpb.Class{ttnpb.CLASS_A, ttnpb.CLASS_C},
+			},
+			Devices:    makeExpected("test-dev-1"),
+			RangeCalls: 1,
+		},
+
+		{
+			Name:        "DevAddr prefix",
+			ContextFunc: readRights,
+			Request: &ttnpb.ListNsEndDevicesRequest{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"frequency_plan_id",
+					},
+				},
+				DevAddrPrefix: &types.DevAddrPrefix{
+					DevAddr: types.DevAddr{0x02},
+					Length:  8,
+				},
+			},
+			Devices:    makeExpected("test-dev-2"),
+			RangeCalls: 1,
+		},
+
+		{
+			Name:        "last seen",
+			ContextFunc: readRights,
+			Request: &ttnpb.ListNsEndDevicesRequest{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"frequency_plan_id",
+					},
+				},
+				LastSeenAfter:  &lastSeen,
+				LastSeenBefore: func() *time.Time { t := lastSeen.Add(time.Hour); return &t }(),
+			},
+			Devices:    makeExpected("test-dev-1"),
+			RangeCalls: 1,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				var rangeCalls uint64
+
+				ns, ctx, _, stop := StartTest(
+					t,
+					TestConfig{
+						Context: ctx,
+						NetworkServer: Config{
+							Devices: &MockDeviceRegistry{
+								RangeByApplicationIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devAddrPrefix *types.DevAddrPrefix, offset uint64, gets []string, f func(context.Context, *ttnpb.EndDevice) bool) (uint64, error) {
+									atomic.AddUint64(&rangeCalls, 1)
+									a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
+									a.So(devAddrPrefix, should.Resemble, tc.Request.DevAddrPrefix)
+									a.So(offset, should.Equal, tc.RangeOffset)
+									var matches []*ttnpb.EndDevice
+									for _, dev := range devs {
+										if devAddrPrefix == nil ||
+											dev.Session != nil && devAddrPrefix.Matches(dev.Session.DevAddr) ||
+											dev.PendingSession != nil && devAddrPrefix.Matches(dev.PendingSession.DevAddr) {
+											matches = append(matches, dev)
+										}
+									}
+									for i := offset; i < uint64(len(matches)); i++ {
+										dev, err := ttnpb.FilterGetEndDevice(matches[i], gets...)
+										if err != nil {
+											return 0, err
+										}
+										if !f(ctx, dev) {
+											break
+										}
+									}
+									return uint64(len(matches)), nil
+								},
+							},
+						},
+						TaskStarter: StartTaskExclude(
+							DownlinkProcessTaskName,
+						),
+					},
+				)
+				defer stop()
+
+				ns.AddContextFiller(tc.ContextFunc)
+				ns.AddContextFiller(func(ctx context.Context) context.Context {
+					return test.ContextWithTB(ctx, t)
+				})
+
+				req := deepcopy.Copy(tc.Request).(*ttnpb.ListNsEndDevicesRequest)
+				res, err := ttnpb.NewNsEndDeviceRegistryClient(ns.LoopbackConn()).List(ctx, req)
+				if tc.ErrorAssertion != nil && a.So(tc.ErrorAssertion(t, err), should.BeTrue) {
+					a.So(res, should.BeNil)
+				} else if a.So(err, should.BeNil) {
+					a.So(res.EndDevices, should.Resemble, tc.Devices)
+				}
+				a.So(req, should.Resemble, tc.Request)
+				a.So(rangeCalls, should.Equal, tc.RangeCalls)
+			},
+		})
+	}
+}
+
 func TestDeviceRegistrySet(t *testing.T) {
 	for _, tc := range []struct {
 		Name           string
@@ -1811,3 +2092,184 @@ func TestDeviceRegistryDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceRegistryExportImport(t *testing.T) {
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			DeviceID:               "test-dev-id",
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+			DevAddr:                &types.DevAddr{0x42, 0xff, 0xff, 0xff},
+		},
+		FrequencyPlanID:   test.EUFrequencyPlanID,
+		LoRaWANPHYVersion: ttnpb.PHY_V1_0_3_REV_A,
+		LoRaWANVersion:    ttnpb.MAC_V1_0_3,
+		MACState: &ttnpb.MACState{
+			LoRaWANVersion: ttnpb.MAC_V1_0_3,
+			DeviceClass:    ttnpb.CLASS_A,
+			CurrentParameters: ttnpb.MACParameters{
+				Rx1Delay:     ttnpb.RX_DELAY_1,
+				Rx2Frequency: 869525000,
+			},
+			DesiredParameters: ttnpb.MACParameters{
+				Rx1Delay:     ttnpb.RX_DELAY_1,
+				Rx2Frequency: 869525000,
+			},
+		},
+		Session: &ttnpb.Session{
+			DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+			SessionKeys: ttnpb.SessionKeys{
+				FNwkSIntKey: &ttnpb.KeyEnvelope{
+					EncryptedKey: []byte{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+				},
+				NwkSEncKey: &ttnpb.KeyEnvelope{
+					EncryptedKey: []byte{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+				},
+				SNwkSIntKey: &ttnpb.KeyEnvelope{
+					EncryptedKey: []byte{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+				},
+			},
+			LastFCntUp:    42,
+			LastNFCntDown: 24,
+		},
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		ContextFunc    func(context.Context) context.Context
+		ErrorAssertion func(*testing.T, error) bool
+	}{
+		{
+			Name: "No device key rights",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+								ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							},
+						},
+					},
+				})
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				if !assertions.New(t).So(errors.IsPermissionDenied(err), should.BeTrue) {
+					t.Errorf("Received error: %s", err)
+					return false
+				}
+				return true
+			},
+		},
+
+		{
+			Name: "Round trip",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+								ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+								ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+								ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+								ttnpb.RIGHT_APPLICATION_LINK,
+							},
+						},
+					},
+				})
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				var imported *ttnpb.EndDevice
+
+				ns, ctx, env, stop := StartTest(
+					t,
+					TestConfig{
+						Context: ctx,
+						NetworkServer: Config{
+							Devices: &MockDeviceRegistry{
+								GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error) {
+									a := assertions.New(test.MustTFromContext(ctx))
+									a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
+									a.So(devID, should.Equal, "test-dev-id")
+									stored, err := ttnpb.FilterGetEndDevice(deepcopy.Copy(dev).(*ttnpb.EndDevice), paths...)
+									return stored, ctx, err
+								},
+								SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+									a := assertions.New(test.MustTFromContext(ctx))
+									a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
+									a.So(devID, should.Equal, "test-dev-id")
+
+									set, sets, err := f(ctx, nil)
+									if err != nil {
+										return nil, ctx, err
+									}
+									imported, err = ttnpb.ApplyEndDeviceFieldMask(&ttnpb.EndDevice{}, set, sets...)
+									if err != nil {
+										return nil, ctx, err
+									}
+									stored, err := ttnpb.FilterGetEndDevice(imported, gets...)
+									return stored, ctx, err
+								},
+							},
+							DownlinkTasks: &MockDownlinkTaskQueue{
+								AddFunc: func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, at time.Time, replace bool) error {
+									return nil
+								},
+							},
+						},
+						TaskStarter: StartTaskExclude(
+							DownlinkProcessTaskName,
+						),
+					},
+				)
+				defer stop()
+
+				go LogEvents(t, env.Events)
+
+				ns.AddContextFiller(tc.ContextFunc)
+				ns.AddContextFiller(func(ctx context.Context) context.Context {
+					return test.ContextWithTB(ctx, t)
+				})
+
+				cl := ttnpb.NewNsEndDeviceRegistryClient(ns.LoopbackConn())
+				archive, err := cl.Export(ctx, &ttnpb.ExportEndDevicesRequest{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+					DeviceIDs:              []string{"test-dev-id"},
+				})
+				if tc.ErrorAssertion != nil && a.So(tc.ErrorAssertion(t, err), should.BeTrue) {
+					a.So(archive, should.BeNil)
+					return
+				}
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+
+				res, err := cl.Import(ctx, &ttnpb.ImportEndDevicesRequest{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+					Archive:                archive,
+				})
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(res.EndDevices, should.Resemble, []*ttnpb.EndDevice{
+					{
+						EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+					},
+				})
+				a.So(imported, should.Resemble, dev)
+
+				_, err = cl.Import(ctx, &ttnpb.ImportEndDevicesRequest{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "other-app-id"},
+					Archive:                archive,
+				})
+				a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			},
+		})
+	}
+}
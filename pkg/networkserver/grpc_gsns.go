@@ -985,9 +985,12 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 				return stored, paths, nil
 			}
 			stored.RecentADRUplinks = appendRecentUplink(stored.RecentADRUplinks, up, mac.OptimalADRUplinkCount)
-			if err := mac.AdaptDataRate(ctx, stored, matched.phy, ns.defaultMACSettings); err != nil {
+			evs, err := mac.AdaptDataRate(ctx, stored, matched.phy, ns.adrAlgorithms, ns.defaultMACSettings)
+			if err != nil {
 				log.FromContext(ctx).WithError(err).Info("Failed to adapt data rate, avoid ADR")
+				return stored, paths, nil
 			}
+			queuedEvents = append(queuedEvents, evs.New(ctx, events.WithIdentifiers(stored.EndDeviceIdentifiers))...)
 			return stored, paths, nil
 		})
 	if err != nil {
@@ -1340,6 +1343,12 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 			"bandwidth", dr.LoRa.GetBandwidth(),
 			"spreading_factor", dr.LoRa.GetSpreadingFactor(),
 		))
+	case *ttnpb.DataRate_LRFHSS:
+		logger = logger.WithFields(log.Fields(
+			"coding_rate", dr.LRFHSS.GetCodingRate(),
+			"modulation_type", dr.LRFHSS.GetModulationType(),
+			"operating_channel_width", dr.LRFHSS.GetOperatingChannelWidth(),
+		))
 	default:
 		return nil, errDataRateNotFound.New()
 	}
@@ -138,6 +138,23 @@ func handleDeviceRegistryTest(ctx context.Context, reg DeviceRegistry) {
 		}
 		return !a.Failed()
 	}
+	assertApplicationDevices := func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devAddrPrefix *types.DevAddrPrefix, offset, expectedTotal uint64, expectedIDs ...string) bool {
+		t, a := test.MustNewTFromContext(ctx)
+		t.Helper()
+
+		var ids []string
+		total, err := reg.RangeByApplicationID(ctx, appID, devAddrPrefix, offset, []string{"ids"}, func(storedCtx context.Context, stored *ttnpb.EndDevice) bool {
+			a.So(storedCtx, should.HaveParentContextOrEqual, ctx)
+			a.So(stored.ApplicationIdentifiers, should.Resemble, appID)
+			ids = append(ids, stored.DeviceID)
+			return true
+		})
+		if !a.So(err, should.BeNil) {
+			t.Errorf("Expected nil error, got: %v\n", errors.Stack(err))
+			return false
+		}
+		return a.So(total, should.Equal, expectedTotal) && a.So(ids, should.Resemble, expectedIDs)
+	}
 	assertCreateDevice := func(ctx context.Context, pb *ttnpb.EndDevice, fields ...string) bool {
 		t, a := test.MustNewTFromContext(ctx)
 		t.Helper()
@@ -318,6 +335,24 @@ func handleDeviceRegistryTest(ctx context.Context, reg DeviceRegistry) {
 	), should.BeTrue) {
 		t.Fatal("pbOther current session uplink matching assertion failed")
 	}
+	if !a.So(assertApplicationDevices(ctx, pb.ApplicationIdentifiers, nil, 0, 2, pb.DeviceID, pbOther.DeviceID), should.BeTrue) {
+		t.Fatal("Application device range assertion failed with non-empty registry")
+	}
+	if !a.So(assertApplicationDevices(ctx, pb.ApplicationIdentifiers, nil, 1, 2, pbOther.DeviceID), should.BeTrue) {
+		t.Fatal("Application device range assertion with offset failed with non-empty registry")
+	}
+	if !a.So(assertApplicationDevices(ctx, pb.ApplicationIdentifiers, &types.DevAddrPrefix{
+		DevAddr: types.DevAddr{0x42},
+		Length:  7,
+	}, 0, 2, pb.DeviceID, pbOther.DeviceID), should.BeTrue) {
+		t.Fatal("Application device range assertion with DevAddr prefix failed with non-empty registry")
+	}
+	if !a.So(assertApplicationDevices(ctx, pb.ApplicationIdentifiers, &types.DevAddrPrefix{
+		DevAddr: types.DevAddr{0x43},
+		Length:  8,
+	}, 0, 1, pb.DeviceID), should.BeTrue) {
+		t.Fatal("Application device range assertion with pending session DevAddr prefix failed with non-empty registry")
+	}
 	if !a.So(assertUplinkMatch(ctx, pbCurrentUp, 1,
 		uplinkMatch{
 			EndDevice: pb,
@@ -349,6 +384,9 @@ func handleDeviceRegistryTest(ctx context.Context, reg DeviceRegistry) {
 	if !a.So(assertNoDevice(ctx, pb), should.BeTrue) {
 		t.Fatalf("Failed to assert registry emptiness after pb deletion")
 	}
+	if !a.So(assertApplicationDevices(ctx, pb.ApplicationIdentifiers, nil, 0, 1, pbOther.DeviceID), should.BeTrue) {
+		t.Fatal("Application device range assertion failed after pb deletion")
+	}
 
 	if !a.So(assertUplinkMatch(ctx, pbOtherCurrentUp, 1,
 		uplinkMatch{
@@ -366,6 +404,9 @@ func handleDeviceRegistryTest(ctx context.Context, reg DeviceRegistry) {
 	if !a.So(assertNoDevice(ctx, pbOther), should.BeTrue) {
 		t.Fatalf("Failed to assert registry emptiness after pbOther deletion")
 	}
+	if !a.So(assertApplicationDevices(ctx, pb.ApplicationIdentifiers, nil, 0, 0), should.BeTrue) {
+		t.Fatal("Application device range assertion failed after pbOther deletion")
+	}
 
 	// NOTE: Even though devices are deleted, the matching results are still cached (within TTL) and matched here. That is expected.
 	if !a.So(assertUplinkMatch(ctx, pbCurrentUp, 1,
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -79,6 +80,18 @@ const (
 
 	// DefaultADRMargin is the default ADR margin used if not specified in MACSettings of the device or NS-wide defaults.
 	DefaultADRMargin = 15
+
+	// lossRateSpike is the loss rate at the current data rate from which the loss-aware algorithm decreases the data rate.
+	lossRateSpike = 0.3
+
+	// mobileMargin is an extra margin in dB used by the mobile algorithm.
+	mobileMargin = 5
+)
+
+// EvtADRDecision is emitted when ADR parameters are computed for a device.
+var EvtADRDecision = events.Define(
+	"ns.mac.adr.decision", "ADR decision",
+	macEventOptions(events.WithDataType(&ttnpb.ADRDecision{}))...,
 )
 
 func deviceADRMargin(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) float32 {
@@ -144,14 +157,52 @@ func txPowerStep(phy *band.Band, from, to uint8) float32 {
 	return phy.TxOffset[from] - phy.TxOffset[to]
 }
 
-func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults ttnpb.MACSettings) error {
-	if len(dev.RecentADRUplinks) == 0 {
-		return nil
-	}
+// ADRInput is the input of an ADR algorithm.
+type ADRInput struct {
+	Device *ttnpb.EndDevice
+	Band   *band.Band
+
+	// MinDataRateIndex and MaxDataRateIndex are the bounds of the data rate indexes usable by the device
+	// given the enabled channels and rejected data rate indexes.
+	MinDataRateIndex        ttnpb.DataRateIndex
+	MaxDataRateIndex        ttnpb.DataRateIndex
+	RejectedDataRateIndexes map[ttnpb.DataRateIndex]struct{}
+
+	// MinTxPowerIndex and MaxTxPowerIndex are the bounds of the TX output power indexes usable by the device
+	// given the rejected TX output power indexes.
+	MinTxPowerIndex        uint8
+	MaxTxPowerIndex        uint8
+	RejectedTxPowerIndexes map[uint8]struct{}
 
+	// MaxSNR is the maximum SNR of all recent ADR uplinks.
+	MaxSNR float32
+	// WorstSNR is the lowest of the maximum SNR of each recent ADR uplink.
+	WorstSNR float32
+	// Margin is the link margin in dB computed using MaxSNR, with the ADR margin and safety margin subtracted.
+	Margin float32
+	// WorstMargin is the link margin in dB computed using WorstSNR, with the ADR margin and safety margin subtracted.
+	WorstMargin float32
+	// LossRate is the frame loss rate of the recent ADR uplinks.
+	LossRate float32
+}
+
+// ADRAlgorithm computes the desired ADR parameters of a device.
+type ADRAlgorithm interface {
+	// Adapt sets the desired ADR data rate index, TX output power index and number of transmissions
+	// in the MAC state of in.Device.
+	Adapt(ctx context.Context, in *ADRInput) error
+}
+
+var adrAlgorithms = map[ttnpb.ADRAlgorithm]ADRAlgorithm{
+	ttnpb.ADRAlgorithm_ADR_ALGORITHM_DEFAULT:    defaultADRAlgorithm{},
+	ttnpb.ADRAlgorithm_ADR_ALGORITHM_LOSS_AWARE: lossAwareADRAlgorithm{},
+	ttnpb.ADRAlgorithm_ADR_ALGORITHM_MOBILE:     mobileADRAlgorithm{},
+}
+
+func newADRInput(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults ttnpb.MACSettings) (*ADRInput, bool, error) {
 	minDataRateIndex, maxDataRateIndex, ok := channelDataRateRange(dev.MACState.CurrentParameters.Channels...)
 	if !ok {
-		return ErrCorruptedMACState
+		return nil, false, ErrCorruptedMACState
 	}
 	if maxDataRateIndex > phy.MaxADRDataRateIndex {
 		maxDataRateIndex = phy.MaxADRDataRateIndex
@@ -172,10 +223,7 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 	}
 	if minDataRateIndex > maxDataRateIndex {
 		log.FromContext(ctx).Debug("Device has rejected all possible data rate values given the channels enabled, avoid ADR.")
-		return nil
-	}
-	if dev.MACState.CurrentParameters.ADRDataRateIndex > minDataRateIndex {
-		minDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
+		return nil, false, nil
 	}
 
 	minTxPowerIndex := uint8(0)
@@ -196,13 +244,19 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 	}
 	if minTxPowerIndex > maxTxPowerIndex {
 		log.FromContext(ctx).Debug("Device has rejected all possible TX output power index values, avoid ADR.")
-		return nil
+		return nil, false, nil
 	}
 
 	maxSNR, ok := maxSNRFromMetadata(uplinkMetadata(dev.RecentADRUplinks...)...)
 	if !ok {
 		log.FromContext(ctx).Debug("Failed to determine max SNR, avoid ADR.")
-		return nil
+		return nil, false, nil
+	}
+	worstSNR := maxSNR
+	for _, up := range dev.RecentADRUplinks {
+		if snr, ok := maxSNRFromMetadata(up.RxMetadata...); ok && snr < worstSNR {
+			worstSNR = snr
+		}
 	}
 	up := LastUplink(dev.RecentADRUplinks...)
 
@@ -210,18 +264,69 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 	// minimum (floor) that we need to demodulate the signal. We subtract a
 	// configurable margin, and an extra safety margin if we're afraid that we
 	// don't have enough data for our decision.
-	var margin float32
+	var margin, worstMargin float32
 	// NOTE: We currently assume that the uplink's SF and BW correspond to CurrentParameters.ADRDataRateIndex.
 	if dr := up.Settings.DataRate.GetLoRa(); dr != nil {
-		var ok bool
 		df, ok := demodulationFloor[dr.SpreadingFactor][dr.Bandwidth]
 		if !ok {
-			return ErrInvalidDataRate.New()
+			return nil, false, ErrInvalidDataRate.New()
 		}
 		margin = maxSNR - df - deviceADRMargin(dev, defaults)
+		worstMargin = worstSNR - df - deviceADRMargin(dev, defaults)
 	}
 	if len(dev.RecentADRUplinks) < OptimalADRUplinkCount {
 		margin -= safetyMargin
+		worstMargin -= safetyMargin
+	}
+	return &ADRInput{
+		Device:                  dev,
+		Band:                    phy,
+		MinDataRateIndex:        minDataRateIndex,
+		MaxDataRateIndex:        maxDataRateIndex,
+		RejectedDataRateIndexes: rejectedDataRateIndexes,
+		MinTxPowerIndex:         minTxPowerIndex,
+		MaxTxPowerIndex:         maxTxPowerIndex,
+		RejectedTxPowerIndexes:  rejectedTxPowerIndexes,
+		MaxSNR:                  maxSNR,
+		WorstSNR:                worstSNR,
+		Margin:                  margin,
+		WorstMargin:             worstMargin,
+		LossRate:                lossRate(dev.RecentADRUplinks...),
+	}, true, nil
+}
+
+// adaptNbTrans sets the desired number of transmissions of the device based on the loss rate of the recent ADR uplinks.
+func adaptNbTrans(in *ADRInput) {
+	dev := in.Device
+	dev.MACState.DesiredParameters.ADRNbTrans = dev.MACState.CurrentParameters.ADRNbTrans
+	if dev.MACState.DesiredParameters.ADRNbTrans > maxNbTrans {
+		dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
+	}
+	if len(dev.RecentADRUplinks) >= OptimalADRUplinkCount/2 {
+		switch r := in.LossRate; {
+		case r < 0.05:
+			dev.MACState.DesiredParameters.ADRNbTrans = 1 + dev.MACState.DesiredParameters.ADRNbTrans/3
+		case r < 0.10:
+		case r < 0.30:
+			dev.MACState.DesiredParameters.ADRNbTrans = 2 + dev.MACState.DesiredParameters.ADRNbTrans/2
+		default:
+			dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
+		}
+	}
+}
+
+// defaultADRAlgorithm is the algorithm described in Semtech's "LoRaWAN - simple rate adaptation recommended algorithm".
+// It may only increase the data rate index of the device and relies on ADRAckReq backoff to recover devices whose link degrades.
+type defaultADRAlgorithm struct{}
+
+// Adapt implements ADRAlgorithm.
+func (defaultADRAlgorithm) Adapt(ctx context.Context, in *ADRInput) error {
+	dev, phy := in.Device, in.Band
+	minDataRateIndex, maxDataRateIndex := in.MinDataRateIndex, in.MaxDataRateIndex
+	minTxPowerIndex, maxTxPowerIndex := in.MinTxPowerIndex, in.MaxTxPowerIndex
+	margin := in.Margin
+	if dev.MACState.CurrentParameters.ADRDataRateIndex > minDataRateIndex {
+		minDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
 	}
 
 	// NOTE: Network Server may only increase the data rate index of the device.
@@ -237,7 +342,7 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 		maxDataRateIndex = dev.MACState.DesiredParameters.ADRDataRateIndex + ttnpb.DataRateIndex(marginSteps)
 	}
 	for drIdx := maxDataRateIndex; drIdx > minDataRateIndex; drIdx-- {
-		if _, ok := rejectedDataRateIndexes[drIdx]; ok {
+		if _, ok := in.RejectedDataRateIndexes[drIdx]; ok {
 			continue
 		}
 		margin -= float32(drIdx-dev.MACState.DesiredParameters.ADRDataRateIndex) * drStep
@@ -257,7 +362,7 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 	// If we still have margin left, we decrease the TX output power (increase the index).
 	for txPowerIdx := maxTxPowerIndex; txPowerIdx > minTxPowerIndex; txPowerIdx-- {
 		diff := txPowerStep(phy, uint8(dev.MACState.DesiredParameters.ADRTxPowerIndex), txPowerIdx)
-		if _, ok := rejectedTxPowerIndexes[txPowerIdx]; ok || diff > margin {
+		if _, ok := in.RejectedTxPowerIndexes[txPowerIdx]; ok || diff > margin {
 			continue
 		}
 		margin -= diff
@@ -265,20 +370,149 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 		break
 	}
 
-	dev.MACState.DesiredParameters.ADRNbTrans = dev.MACState.CurrentParameters.ADRNbTrans
-	if dev.MACState.DesiredParameters.ADRNbTrans > maxNbTrans {
-		dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
+	adaptNbTrans(in)
+	return nil
+}
+
+// adrUplinksAtDataRate returns the uplinks in ups received at data rate index idx.
+func adrUplinksAtDataRate(idx ttnpb.DataRateIndex, ups ...*ttnpb.UplinkMessage) []*ttnpb.UplinkMessage {
+	res := make([]*ttnpb.UplinkMessage, 0, len(ups))
+	for _, up := range ups {
+		if up.Settings.DataRateIndex == idx {
+			res = append(res, up)
+		}
 	}
-	if len(dev.RecentADRUplinks) >= OptimalADRUplinkCount/2 {
-		switch r := lossRate(dev.RecentADRUplinks...); {
-		case r < 0.05:
-			dev.MACState.DesiredParameters.ADRNbTrans = 1 + dev.MACState.DesiredParameters.ADRNbTrans/3
-		case r < 0.10:
-		case r < 0.30:
-			dev.MACState.DesiredParameters.ADRNbTrans = 2 + dev.MACState.DesiredParameters.ADRNbTrans/2
-		default:
-			dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
+	return res
+}
+
+// lossRateSpiked reports whether the loss rate of the recent ADR uplinks received at the current data rate of the device
+// reached lossRateSpike. Only uplinks at the current data rate are considered, so that the data rate is decreased at
+// most once per spike.
+func lossRateSpiked(dev *ttnpb.EndDevice) bool {
+	ups := adrUplinksAtDataRate(current.ADRDataRateIndex, dev.RecentADRUplinks...)
+	return len(ups) >= OptimalADRUplinkCount/2 && lossRate(ups...) >= lossRateSpike
+}
+
+// stepDownDataRate sets the desired parameters of the device to the first usable data rate index below the current one,
+// the maximum TX output power and the maximum number of transmissions.
+func stepDownDataRate(in *ADRInput) {
+	dev := in.Device
+	drIdx := dev.MACState.CurrentParameters.ADRDataRateIndex
+	for idx := drIdx - 1; idx >= in.MinDataRateIndex; idx-- {
+		if _, ok := in.RejectedDataRateIndexes[idx]; ok {
+			continue
 		}
+		drIdx = idx
+		break
 	}
+	switch {
+	case drIdx < in.MinDataRateIndex:
+		drIdx = in.MinDataRateIndex
+	case drIdx > in.MaxDataRateIndex:
+		drIdx = in.MaxDataRateIndex
+	}
+	dev.MACState.DesiredParameters.ADRDataRateIndex = drIdx
+	dev.MACState.DesiredParameters.ADRTxPowerIndex = uint32(in.MinTxPowerIndex)
+	dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
+}
+
+// lossAwareADRAlgorithm behaves like defaultADRAlgorithm, but decreases the data rate index and increases the TX output
+// power to the maximum when the loss rate spikes.
+type lossAwareADRAlgorithm struct{}
+
+// Adapt implements ADRAlgorithm.
+func (lossAwareADRAlgorithm) Adapt(ctx context.Context, in *ADRInput) error {
+	if !lossRateSpiked(in.Device) {
+		return defaultADRAlgorithm{}.Adapt(ctx, in)
+	}
+	log.FromContext(ctx).Debug("Loss rate spiked, decrease data rate")
+	stepDownDataRate(in)
+	return nil
+}
+
+// mobileADRAlgorithm is a conservative algorithm for mobile devices, whose link quality changes quickly.
+// It uses the weakest of the recent ADR uplinks and an extra margin, increases the data rate index by at most one step,
+// always uses the maximum TX output power and decreases the data rate index when the loss rate spikes.
+type mobileADRAlgorithm struct{}
+
+// Adapt implements ADRAlgorithm.
+func (mobileADRAlgorithm) Adapt(ctx context.Context, in *ADRInput) error {
+	dev := in.Device
+	if lossRateSpiked(dev) {
+		log.FromContext(ctx).Debug("Loss rate spiked, decrease data rate")
+		stepDownDataRate(in)
+		return nil
+	}
+
+	margin := in.WorstMargin - mobileMargin
+	drIdx := dev.MACState.CurrentParameters.ADRDataRateIndex
+	switch {
+	case drIdx < in.MinDataRateIndex:
+		margin -= float32(in.MinDataRateIndex-drIdx) * drStep
+		drIdx = in.MinDataRateIndex
+	case drIdx > in.MaxDataRateIndex:
+		drIdx = in.MaxDataRateIndex
+	}
+	for idx := drIdx + 1; idx <= in.MaxDataRateIndex; idx++ {
+		if _, ok := in.RejectedDataRateIndexes[idx]; ok {
+			continue
+		}
+		if margin >= float32(idx-drIdx)*drStep {
+			drIdx = idx
+		}
+		break
+	}
+	dev.MACState.DesiredParameters.ADRDataRateIndex = drIdx
+	dev.MACState.DesiredParameters.ADRTxPowerIndex = uint32(in.MinTxPowerIndex)
+	adaptNbTrans(in)
 	return nil
 }
+
+// AdaptDataRate computes the desired ADR parameters of dev using the ADR algorithm configured for it, see DeviceADRAlgorithm.
+// If the desired data rate, TX power or number of transmissions differ from the current parameters,
+// AdaptDataRate returns the event describing the decision.
+func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, applicationAlgorithms map[string]ttnpb.ADRAlgorithm, defaults ttnpb.MACSettings) (events.Builders, error) {
+	if len(dev.RecentADRUplinks) == 0 {
+		return nil, nil
+	}
+	if LastUplink(dev.RecentADRUplinks...).Settings.DataRate.GetLRFHSS() != nil {
+		// LR-FHSS data rates are uplink-only and have no demodulation floor to compute a link margin from.
+		log.FromContext(ctx).Debug("Last uplink uses LR-FHSS modulation, avoid ADR.")
+		return nil, nil
+	}
+	in, ok, err := newADRInput(ctx, dev, phy, defaults)
+	if err != nil || !ok {
+		return nil, err
+	}
+	alg := DeviceADRAlgorithm(dev, applicationAlgorithms, defaults)
+	impl, ok := adrAlgorithms[alg]
+	if !ok {
+		log.FromContext(ctx).WithField("adr_algorithm", alg).Warn("Unknown ADR algorithm, use default")
+		alg, impl = ttnpb.ADRAlgorithm_ADR_ALGORITHM_DEFAULT, defaultADRAlgorithm{}
+	}
+	if err := impl.Adapt(ctx, in); err != nil {
+		return nil, err
+	}
+	current, desired := &dev.MACState.CurrentParameters, &dev.MACState.DesiredParameters
+	if desired.ADRDataRateIndex == current.ADRDataRateIndex &&
+		desired.ADRTxPowerIndex == current.ADRTxPowerIndex &&
+		desired.ADRNbTrans == current.ADRNbTrans {
+		return nil, nil
+	}
+	return events.Builders{
+		EvtADRDecision.With(events.WithData(&ttnpb.ADRDecision{
+			Algorithm:            alg,
+			Uplinks:              uint32(len(dev.RecentADRUplinks)),
+			MaxSNR:               in.MaxSNR,
+			WorstSNR:             in.WorstSNR,
+			Margin:               in.Margin,
+			LossRate:             in.LossRate,
+			CurrentDataRateIndex: current.ADRDataRateIndex,
+			CurrentTxPowerIndex:  current.ADRTxPowerIndex,
+			CurrentNbTrans:       current.ADRNbTrans,
+			DesiredDataRateIndex: desired.ADRDataRateIndex,
+			DesiredTxPowerIndex:  desired.ADRTxPowerIndex,
+			DesiredNbTrans:       desired.ADRNbTrans,
+		})),
+	}, nil
+}
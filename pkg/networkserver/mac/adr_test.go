@@ -64,12 +64,51 @@ func TestAdaptDataRate(t *testing.T) {
 			},
 		},
 	})
+	lrfhssUplinks := ADRMatrixToUplinks([]ADRMatrixRow{
+		{FCnt: 10, MaxSNR: -6, GtwDiversity: 2},
+		{
+			FCnt: 11, MaxSNR: -7, GtwDiversity: 2,
+			TxSettings: ttnpb.TxSettings{
+				DataRate: ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_LRFHSS{
+						LRFHSS: &ttnpb.LRFHSSDataRate{
+							OperatingChannelWidth: 137000,
+							CodingRate:            "1/3",
+						},
+					},
+				},
+				DataRateIndex: ttnpb.DATA_RATE_8,
+			},
+		},
+	})
 	for _, tc := range []struct {
 		Name       string
 		Device     *ttnpb.EndDevice
 		DeviceDiff func(*ttnpb.EndDevice)
 		Error      error
 	}{
+		{
+			Name: "LR-FHSS uplink",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRDataRateIndex: ttnpb.DATA_RATE_8,
+						ADRNbTrans:       1,
+						ADRTxPowerIndex:  1,
+						Channels:         MakeDefaultEU868CurrentChannels(),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						ADRDataRateIndex: ttnpb.DATA_RATE_8,
+						ADRNbTrans:       1,
+						ADRTxPowerIndex:  1,
+						Channels:         MakeDefaultEU868CurrentChannels(),
+					},
+				},
+				RecentADRUplinks: lrfhssUplinks,
+			},
+		},
 		{
 			Name: "adapted example from Semtech paper/no rejections",
 			Device: &ttnpb.EndDevice{
@@ -270,7 +309,7 @@ func TestAdaptDataRate(t *testing.T) {
 			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
 				dev := CopyEndDevice(tc.Device)
 				fp := FrequencyPlan(dev.FrequencyPlanID)
-				err := AdaptDataRate(ctx, dev, LoRaWANBands[fp.BandID][dev.LoRaWANPHYVersion], ttnpb.MACSettings{})
+				_, err := AdaptDataRate(ctx, dev, LoRaWANBands[fp.BandID][dev.LoRaWANPHYVersion], nil, ttnpb.MACSettings{})
 				if !a.So(err, should.Equal, tc.Error) {
 					t.Fatalf("ADR failed with: %s", err)
 				}
@@ -283,3 +322,167 @@ func TestAdaptDataRate(t *testing.T) {
 		})
 	}
 }
+
+func TestADRAlgorithms(t *testing.T) {
+	makeUplinks := func(dr ttnpb.DataRateIndex, sf uint32, maxSNR float32, fCnts ...uint32) []*ttnpb.UplinkMessage {
+		rows := make([]ADRMatrixRow, 0, len(fCnts))
+		for _, fCnt := range fCnts {
+			rows = append(rows, ADRMatrixRow{
+				FCnt: fCnt, MaxSNR: maxSNR, GtwDiversity: 1,
+				TxSettings: ttnpb.TxSettings{
+					DataRate: ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_LoRa{
+							LoRa: &ttnpb.LoRaDataRate{
+								SpreadingFactor: sf,
+								Bandwidth:       125000,
+							},
+						},
+					},
+					DataRateIndex: dr,
+				},
+			})
+		}
+		return ADRMatrixToUplinks(rows)
+	}
+	makeDevice := func(alg ttnpb.ADRAlgorithm, dr ttnpb.DataRateIndex, ups []*ttnpb.UplinkMessage) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			FrequencyPlanID:   test.EUFrequencyPlanID,
+			LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+			MACState: &ttnpb.MACState{
+				CurrentParameters: ttnpb.MACParameters{
+					ADRDataRateIndex: dr,
+					ADRNbTrans:       1,
+					ADRTxPowerIndex:  1,
+					Channels:         MakeDefaultEU868CurrentChannels(),
+				},
+				DesiredParameters: ttnpb.MACParameters{
+					Channels: MakeDefaultEU868CurrentChannels(),
+				},
+			},
+			MACSettings: &ttnpb.MACSettings{
+				ADRMargin: &pbtypes.FloatValue{
+					Value: 2,
+				},
+				ADRAlgorithm: &ttnpb.ADRAlgorithmValue{
+					Value: alg,
+				},
+			},
+			RecentADRUplinks: ups,
+		}
+	}
+	lossyFCnts := []uint32{10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30}
+	var goodFCnts []uint32
+	for fCnt := uint32(10); fCnt < 30; fCnt++ {
+		goodFCnts = append(goodFCnts, fCnt)
+	}
+
+	for _, tc := range []struct {
+		Name       string
+		Device     *ttnpb.EndDevice
+		DeviceDiff func(*ttnpb.EndDevice)
+	}{
+		{
+			Name:   "loss-aware/no loss",
+			Device: makeDevice(ttnpb.ADRAlgorithm_ADR_ALGORITHM_LOSS_AWARE, ttnpb.DATA_RATE_0, makeUplinks(ttnpb.DATA_RATE_0, 12, 0, goodFCnts...)),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_5
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 2
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+			},
+		},
+		{
+			Name:   "loss-aware/loss spike",
+			Device: makeDevice(ttnpb.ADRAlgorithm_ADR_ALGORITHM_LOSS_AWARE, ttnpb.DATA_RATE_3, makeUplinks(ttnpb.DATA_RATE_3, 9, 5, lossyFCnts...)),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_2
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+				dev.MACState.DesiredParameters.ADRNbTrans = 3
+			},
+		},
+		{
+			Name:   "loss-aware/loss spike at previous data rate",
+			Device: makeDevice(ttnpb.ADRAlgorithm_ADR_ALGORITHM_LOSS_AWARE, ttnpb.DATA_RATE_3, makeUplinks(ttnpb.DATA_RATE_2, 10, -10, lossyFCnts...)),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_3
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
+				dev.MACState.DesiredParameters.ADRNbTrans = 3
+			},
+		},
+		{
+			Name:   "mobile/good link",
+			Device: makeDevice(ttnpb.ADRAlgorithm_ADR_ALGORITHM_MOBILE, ttnpb.DATA_RATE_0, makeUplinks(ttnpb.DATA_RATE_0, 12, 0, goodFCnts...)),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_1
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+			},
+		},
+		{
+			Name:   "mobile/weak link",
+			Device: makeDevice(ttnpb.ADRAlgorithm_ADR_ALGORITHM_MOBILE, ttnpb.DATA_RATE_0, makeUplinks(ttnpb.DATA_RATE_0, 12, -12, goodFCnts...)),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_0
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+			},
+		},
+		{
+			Name: "mobile/unchanged",
+			Device: func() *ttnpb.EndDevice {
+				dev := makeDevice(ttnpb.ADRAlgorithm_ADR_ALGORITHM_MOBILE, ttnpb.DATA_RATE_0, makeUplinks(ttnpb.DATA_RATE_0, 12, -12, goodFCnts...))
+				dev.MACState.CurrentParameters.ADRTxPowerIndex = 0
+				return dev
+			}(),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_0
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+			},
+		},
+		{
+			Name:   "mobile/loss spike",
+			Device: makeDevice(ttnpb.ADRAlgorithm_ADR_ALGORITHM_MOBILE, ttnpb.DATA_RATE_3, makeUplinks(ttnpb.DATA_RATE_3, 9, 5, lossyFCnts...)),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_2
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+				dev.MACState.DesiredParameters.ADRNbTrans = 3
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.Device)
+				fp := FrequencyPlan(dev.FrequencyPlanID)
+				evs, err := AdaptDataRate(ctx, dev, LoRaWANBands[fp.BandID][dev.LoRaWANPHYVersion], nil, ttnpb.MACSettings{})
+				if !a.So(err, should.BeNil) {
+					t.Fatalf("ADR failed with: %s", err)
+				}
+				expected := CopyEndDevice(tc.Device)
+				tc.DeviceDiff(expected)
+				a.So(dev, should.Resemble, expected)
+
+				current, desired := expected.MACState.CurrentParameters, expected.MACState.DesiredParameters
+				if desired.ADRDataRateIndex == current.ADRDataRateIndex &&
+					desired.ADRTxPowerIndex == current.ADRTxPowerIndex &&
+					desired.ADRNbTrans == current.ADRNbTrans {
+					// Decisions that do not change the ADR parameters are not published.
+					a.So(evs, should.BeEmpty)
+					return
+				}
+				if a.So(evs, should.HaveLength, 1) {
+					evt := evs[0].New(ctx)
+					a.So(evt.Name(), should.Equal, "ns.mac.adr.decision")
+					if decision, ok := evt.Data().(*ttnpb.ADRDecision); a.So(ok, should.BeTrue) {
+						a.So(decision.Algorithm, should.Equal, tc.Device.MACSettings.ADRAlgorithm.Value)
+						a.So(decision.DesiredDataRateIndex, should.Equal, expected.MACState.DesiredParameters.ADRDataRateIndex)
+						a.So(decision.DesiredTxPowerIndex, should.Equal, expected.MACState.DesiredParameters.ADRTxPowerIndex)
+						a.So(decision.DesiredNbTrans, should.Equal, expected.MACState.DesiredParameters.ADRNbTrans)
+					}
+				}
+			},
+		})
+	}
+}
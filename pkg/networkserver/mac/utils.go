@@ -158,6 +158,24 @@ func DeviceUseADR(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings, phy *band.Ba
 	return true
 }
 
+// DeviceADRAlgorithm returns the ADR algorithm of the device.
+// The ADR algorithm configured in the MAC settings of the device takes precedence over the ADR algorithm configured
+// for the application of the device in applicationAlgorithms, which takes precedence over the default MAC settings.
+func DeviceADRAlgorithm(dev *ttnpb.EndDevice, applicationAlgorithms map[string]ttnpb.ADRAlgorithm, defaults ttnpb.MACSettings) ttnpb.ADRAlgorithm {
+	if dev.GetMACSettings().GetADRAlgorithm() != nil {
+		return dev.MACSettings.ADRAlgorithm.Value
+	}
+	if alg, ok := applicationAlgorithms[dev.ApplicationID]; ok {
+		return alg
+	}
+	switch {
+	case defaults.GetADRAlgorithm() != nil:
+		return defaults.ADRAlgorithm.Value
+	default:
+		return ttnpb.ADRAlgorithm_ADR_ALGORITHM_DEFAULT
+	}
+}
+
 func DeviceResetsFCnt(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) bool {
 	switch {
 	case dev.GetMACSettings().GetResetsFCnt() != nil:
@@ -493,3 +493,69 @@ func TestNextPingSlotAt(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceADRAlgorithm(t *testing.T) {
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	applicationAlgorithms := map[string]ttnpb.ADRAlgorithm{
+		appIDs.ApplicationID: ttnpb.ADRAlgorithm_ADR_ALGORITHM_MOBILE,
+	}
+	defaults := ttnpb.MACSettings{
+		ADRAlgorithm: &ttnpb.ADRAlgorithmValue{Value: ttnpb.ADRAlgorithm_ADR_ALGORITHM_LOSS_AWARE},
+	}
+	for _, tc := range []struct {
+		Name                  string
+		Device                *ttnpb.EndDevice
+		ApplicationAlgorithms map[string]ttnpb.ADRAlgorithm
+		Defaults              ttnpb.MACSettings
+		Expected              ttnpb.ADRAlgorithm
+	}{
+		{
+			Name: "device",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs},
+				MACSettings: &ttnpb.MACSettings{
+					ADRAlgorithm: &ttnpb.ADRAlgorithmValue{Value: ttnpb.ADRAlgorithm_ADR_ALGORITHM_DEFAULT},
+				},
+			},
+			ApplicationAlgorithms: applicationAlgorithms,
+			Defaults:              defaults,
+			Expected:              ttnpb.ADRAlgorithm_ADR_ALGORITHM_DEFAULT,
+		},
+		{
+			Name: "application",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs},
+			},
+			ApplicationAlgorithms: applicationAlgorithms,
+			Defaults:              defaults,
+			Expected:              ttnpb.ADRAlgorithm_ADR_ALGORITHM_MOBILE,
+		},
+		{
+			Name: "network server default",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "other-app"},
+				},
+			},
+			ApplicationAlgorithms: applicationAlgorithms,
+			Defaults:              defaults,
+			Expected:              ttnpb.ADRAlgorithm_ADR_ALGORITHM_LOSS_AWARE,
+		},
+		{
+			Name: "fallback",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs},
+			},
+			Expected: ttnpb.ADRAlgorithm_ADR_ALGORITHM_DEFAULT,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				a.So(DeviceADRAlgorithm(tc.Device, tc.ApplicationAlgorithms, tc.Defaults), should.Equal, tc.Expected)
+			},
+		})
+	}
+}
@@ -117,6 +117,7 @@ type NetworkServer struct {
 	collectionWindow    windowDurationFunc
 
 	defaultMACSettings ttnpb.MACSettings
+	adrAlgorithms      map[string]ttnpb.ADRAlgorithm
 
 	interopClient InteropClient
 
@@ -174,6 +175,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	if err != nil {
 		return nil, err
 	}
+	adrAlgorithms, err := conf.ADRAlgorithms.Parse()
+	if err != nil {
+		return nil, err
+	}
 
 	var interopCl InteropClient
 	if !conf.Interop.IsZero() {
@@ -202,6 +207,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		downlinkTasks:         conf.DownlinkTasks,
 		downlinkPriorities:    downlinkPriorities,
 		defaultMACSettings:    conf.DefaultMACSettings.Parse(),
+		adrAlgorithms:         adrAlgorithms,
 		interopClient:         interopCl,
 		uplinkDeduplicator:    conf.UplinkDeduplicator,
 		deviceKEKLabel:        conf.DeviceKEKLabel,
@@ -1648,6 +1648,9 @@ func (env TestEnvironment) AssertHandleDataUplink(ctx context.Context, conf Data
 							EvtDropDataUplink.With(events.WithData(ErrDuplicate)),
 						)
 					}
+					if conf.FCtrl.ADR {
+						evBuilders = append(evBuilders, mac.EvtADRDecision)
+					}
 					return append(
 						append(
 							evBuilders,
@@ -2027,8 +2030,9 @@ var _ DeviceRegistry = MockDeviceRegistry{}
 
 // MockDeviceRegistry is a mock DeviceRegistry used for testing.
 type MockDeviceRegistry struct {
-	GetByIDFunc func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
-	SetByIDFunc func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
+	GetByIDFunc              func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
+	RangeByApplicationIDFunc func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devAddrPrefix *types.DevAddrPrefix, offset uint64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) (uint64, error)
+	SetByIDFunc              func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
 }
 
 // GetByEUI panics.
@@ -2044,6 +2048,14 @@ func (m MockDeviceRegistry) GetByID(ctx context.Context, appID ttnpb.Application
 	return m.GetByIDFunc(ctx, appID, devID, paths)
 }
 
+// RangeByApplicationID calls RangeByApplicationIDFunc if set and panics otherwise.
+func (m MockDeviceRegistry) RangeByApplicationID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devAddrPrefix *types.DevAddrPrefix, offset uint64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) (uint64, error) {
+	if m.RangeByApplicationIDFunc == nil {
+		panic("RangeByApplicationID called, but not set")
+	}
+	return m.RangeByApplicationIDFunc(ctx, appID, devAddrPrefix, offset, paths, f)
+}
+
 // SetByID calls SetByIDFunc if set and panics otherwise.
 func (m MockDeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
 	if m.SetByIDFunc == nil {
@@ -29,6 +29,18 @@ func deviceUIDKey(cl *ttnredis.Client, uid string) string {
 	return cl.Key("uid", uid)
 }
 
+func deviceApplicationKey(cl *ttnredis.Client, appUID string) string {
+	return cl.Key("app", appUID)
+}
+
+func deviceApplicationDevAddrKey(cl *ttnredis.Client, appUID string) string {
+	return ttnredis.Key(deviceApplicationKey(cl, appUID), "addr")
+}
+
+func deviceApplicationIndexedKey(cl *ttnredis.Client, appUID string) string {
+	return ttnredis.Key(deviceApplicationKey(cl, appUID), "indexed")
+}
+
 func deviceUIDLastInvalidationKey(cl *ttnredis.Client, uid string) string {
 	return ttnredis.Key(deviceUIDKey(cl, uid), "last-invalidation")
 }
@@ -22,12 +22,15 @@ import (
 	"io"
 	"math/rand"
 	"runtime/trace"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/blang/semver"
 	"github.com/go-redis/redis/v7"
+	"github.com/gogo/protobuf/proto"
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/oklog/ulid/v2"
 	"github.com/vmihailenco/msgpack/v5"
@@ -80,6 +83,18 @@ func (r *DeviceRegistry) uidKey(uid string) string {
 	return deviceUIDKey(r.Redis, uid)
 }
 
+func (r *DeviceRegistry) appKey(uid string) string {
+	return deviceApplicationKey(r.Redis, uid)
+}
+
+func (r *DeviceRegistry) appAddrKey(uid string) string {
+	return deviceApplicationDevAddrKey(r.Redis, uid)
+}
+
+func (r *DeviceRegistry) appIndexedKey(uid string) string {
+	return deviceApplicationIndexedKey(r.Redis, uid)
+}
+
 func (r *DeviceRegistry) addrKey(addr types.DevAddr) string {
 	return r.Redis.Key("addr", addr.String())
 }
@@ -118,6 +133,207 @@ func (r *DeviceRegistry) GetByID(ctx context.Context, appID ttnpb.ApplicationIde
 	return pb, ctx, nil
 }
 
+// rangeBatchSize is the number of devices that are fetched at once when ranging over devices.
+const rangeBatchSize = 100
+
+// devAddrIndexMember returns the member of the DevAddr index of an application, which denotes that the device with
+// unique ID uid has a session with DevAddr addr. The members are ordered lexicographically by DevAddr.
+func devAddrIndexMember(addr types.DevAddr, uid string) string {
+	return addr.String() + ":" + uid
+}
+
+// devAddrIndexMembers returns the members of the DevAddr index of the application of pb for the current and pending
+// session of pb.
+func devAddrIndexMembers(pb *ttnpb.EndDevice, uid string) []string {
+	var members []string
+	if pb.GetSession() != nil {
+		members = append(members, devAddrIndexMember(pb.Session.DevAddr, uid))
+	}
+	if pb.GetPendingSession() != nil && (pb.GetSession() == nil || !pb.PendingSession.DevAddr.Equal(pb.Session.DevAddr)) {
+		members = append(members, devAddrIndexMember(pb.PendingSession.DevAddr, uid))
+	}
+	return members
+}
+
+// indexApplication indexes the devices of application with unique ID appUID, which have been stored before the
+// application and DevAddr indexes were maintained by the registry.
+// The devices are indexed once per application, on the first range over the devices of the application.
+// This scans the keyspace for the devices of the application.
+func (r *DeviceRegistry) indexApplication(ctx context.Context, appUID string) error {
+	ik := r.appIndexedKey(appUID)
+	n, err := r.Redis.Exists(ik).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	if n > 0 {
+		return nil
+	}
+	uidPrefix := r.uidKey(appUID + ".")
+	var cursor uint64
+	for {
+		ks, next, err := r.Redis.Scan(cursor, uidPrefix+"*", rangeBatchSize).Result()
+		if err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		for _, k := range ks {
+			uid := appUID + "." + strings.TrimPrefix(k, uidPrefix)
+			if strings.Contains(uid, ":") {
+				continue
+			}
+			if err := r.Redis.Watch(func(tx *redis.Tx) error {
+				pb := &ttnpb.EndDevice{}
+				if err := ttnredis.GetProto(tx, k).ScanProto(pb); errors.IsNotFound(err) {
+					return nil
+				} else if err != nil {
+					return err
+				}
+				_, err := tx.TxPipelined(func(p redis.Pipeliner) error {
+					p.SAdd(r.appKey(appUID), uid)
+					for _, member := range devAddrIndexMembers(pb, uid) {
+						p.ZAdd(r.appAddrKey(appUID), &redis.Z{Member: member})
+					}
+					return nil
+				})
+				return err
+			}, k); err != nil && err != redis.TxFailedErr {
+				// If the transaction failed, the device has been updated concurrently, which indexes the device.
+				return ttnredis.ConvertError(err)
+			}
+		}
+		if next == 0 {
+			break
+		}
+		cursor = next
+	}
+	if err := r.Redis.Set(ik, 1, 0).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	log.FromContext(ctx).WithField("application_uid", appUID).Debug("Indexed end devices of application")
+	return nil
+}
+
+// devAddrPrefixUIDs returns the sorted unique IDs of devices of application with unique ID appUID, which have a current
+// or pending session DevAddr matching prefix, using the DevAddr index of the application.
+func (r *DeviceRegistry) devAddrPrefixUIDs(appUID string, prefix types.DevAddrPrefix) ([]string, error) {
+	min := (types.DevAddr{}).WithPrefix(prefix)
+	max := (types.DevAddr{0xff, 0xff, 0xff, 0xff}).WithPrefix(prefix)
+	members, err := r.Redis.ZRangeByLex(r.appAddrKey(appUID), &redis.ZRangeBy{
+		Min: "[" + min.String(),
+		// ';' follows ':' in the byte order, so all members with DevAddr max are included.
+		Max: "(" + max.String() + ";",
+	}).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	uidSet := make(map[string]struct{}, len(members))
+	for _, member := range members {
+		i := strings.IndexByte(member, ':')
+		if i < 0 {
+			return nil, errDatabaseCorruption.New()
+		}
+		uidSet[member[i+1:]] = struct{}{}
+	}
+	uids := make([]string, 0, len(uidSet))
+	for uid := range uidSet {
+		uids = append(uids, uid)
+	}
+	sort.Strings(uids)
+	return uids, nil
+}
+
+// getByUIDs returns the devices with the given unique IDs. Devices that are not found are skipped.
+func (r *DeviceRegistry) getByUIDs(uids []string) ([]*ttnpb.EndDevice, error) {
+	cmds := make([]*ttnredis.ProtoCmd, 0, len(uids))
+	if _, err := r.Redis.Pipelined(func(p redis.Pipeliner) error {
+		for _, uid := range uids {
+			cmds = append(cmds, ttnredis.GetProto(p, r.uidKey(uid)))
+		}
+		return nil
+	}); err != nil && !errors.IsNotFound(ttnredis.ConvertError(err)) {
+		return nil, ttnredis.ConvertError(err)
+	}
+	pbs := make([]*ttnpb.EndDevice, 0, len(cmds))
+	for _, cmd := range cmds {
+		pb := &ttnpb.EndDevice{}
+		if err := cmd.ScanProto(pb); errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+	return pbs, nil
+}
+
+// RangeByApplicationID ranges over devices of application appID ordered by device ID, starting at offset.
+// The unique IDs of the devices are paged before the devices are fetched, in batches of rangeBatchSize.
+func (r *DeviceRegistry) RangeByApplicationID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devAddrPrefix *types.DevAddrPrefix, offset uint64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) (uint64, error) {
+	if err := appID.ValidateContext(ctx); err != nil {
+		return 0, err
+	}
+
+	defer trace.StartRegion(ctx, "range end devices by application id").End()
+
+	appUID := unique.ID(ctx, appID)
+	if err := r.indexApplication(ctx, appUID); err != nil {
+		return 0, err
+	}
+	var (
+		total uint64
+		batch func(offset uint64) ([]*ttnpb.EndDevice, error)
+	)
+	if devAddrPrefix != nil {
+		uids, err := r.devAddrPrefixUIDs(appUID, *devAddrPrefix)
+		if err != nil {
+			return 0, err
+		}
+		total = uint64(len(uids))
+		batch = func(offset uint64) ([]*ttnpb.EndDevice, error) {
+			end := offset + rangeBatchSize
+			if end > total {
+				end = total
+			}
+			return r.getByUIDs(uids[offset:end])
+		}
+	} else {
+		n, err := r.Redis.SCard(r.appKey(appUID)).Result()
+		if err != nil {
+			return 0, ttnredis.ConvertError(err)
+		}
+		total = uint64(n)
+		batch = func(offset uint64) ([]*ttnpb.EndDevice, error) {
+			var pbs []*ttnpb.EndDevice
+			err := ttnredis.FindProtos(r.Redis, r.appKey(appUID), r.uidKey,
+				ttnredis.FindProtosSorted(true),
+				ttnredis.FindProtosWithOffsetAndCount(int64(offset), rangeBatchSize),
+			).Range(func() (proto.Message, func() (bool, error)) {
+				pb := &ttnpb.EndDevice{}
+				return pb, func() (bool, error) {
+					pbs = append(pbs, pb)
+					return true, nil
+				}
+			})
+			return pbs, err
+		}
+	}
+	for ; offset < total; offset += rangeBatchSize {
+		pbs, err := batch(offset)
+		if err != nil {
+			return 0, err
+		}
+		for _, pb := range pbs {
+			pb, err := ttnpb.FilterGetEndDevice(pb, paths...)
+			if err != nil {
+				return 0, err
+			}
+			if !f(ctx, pb) {
+				return total, nil
+			}
+		}
+	}
+	return total, nil
+}
+
 // GetByEUI gets device by joinEUI, devEUI.
 func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error) {
 	defer trace.StartRegion(ctx, "get end device by eui").End()
@@ -859,6 +1075,10 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 			if pb == nil && len(sets) == 0 {
 				p.Del(uk)
 				p.Del(deviceUIDLastInvalidationKey(r.Redis, uid))
+				p.SRem(r.appKey(unique.ID(ctx, appID)), uid)
+				for _, member := range devAddrIndexMembers(stored, uid) {
+					p.ZRem(r.appAddrKey(unique.ID(ctx, appID)), member)
+				}
 				if stored.JoinEUI != nil && stored.DevEUI != nil {
 					p.Del(r.euiKey(*stored.JoinEUI, *stored.DevEUI))
 				}
@@ -1055,6 +1275,27 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 				}
 			}
 
+			// The indexes are always updated, so that devices which are stored before the indexes were maintained
+			// are indexed as well.
+			appUID := unique.ID(ctx, appID)
+			p.SAdd(r.appKey(appUID), uid)
+			updatedMembers := devAddrIndexMembers(updated, uid)
+			var removedMembers []interface{}
+		storedMembers:
+			for _, member := range devAddrIndexMembers(stored, uid) {
+				for _, updatedMember := range updatedMembers {
+					if member == updatedMember {
+						continue storedMembers
+					}
+				}
+				removedMembers = append(removedMembers, member)
+			}
+			if len(removedMembers) > 0 {
+				p.ZRem(r.appAddrKey(appUID), removedMembers...)
+			}
+			for _, member := range updatedMembers {
+				p.ZAdd(r.appAddrKey(appUID), &redis.Z{Member: member})
+			}
 			_, err := ttnredis.SetProto(p, uk, updated, 0)
 			if err != nil {
 				return err
@@ -0,0 +1,107 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDeviceRegistryIndexApplication(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "networkserver_test", "devices")
+	defer flush()
+	defer cl.Close()
+
+	reg := &DeviceRegistry{
+		Redis:   cl,
+		LockTTL: test.Delay << 10,
+	}
+	if err := reg.Init(); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	appID := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	for _, dev := range []*ttnpb.EndDevice{
+		{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: appID,
+				DeviceID:               "test-dev-1",
+			},
+			Session: &ttnpb.Session{
+				DevAddr: types.DevAddr{0x42, 0x00, 0x00, 0x01},
+			},
+		},
+		{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: appID,
+				DeviceID:               "test-dev-2",
+			},
+			Session: &ttnpb.Session{
+				DevAddr: types.DevAddr{0x43, 0x00, 0x00, 0x01},
+			},
+		},
+	} {
+		_, _, err := reg.SetByID(ctx, appID, dev.DeviceID, nil, func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			return dev, []string{
+				"ids.application_ids",
+				"ids.device_id",
+				"session.dev_addr",
+			}, nil
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	// Remove the indexes of the application, as if the devices were stored before the indexes were maintained.
+	appUID := unique.ID(ctx, appID)
+	if err := cl.Del(reg.appKey(appUID), reg.appAddrKey(appUID), reg.appIndexedKey(appUID)).Err(); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	rangeDeviceIDs := func(devAddrPrefix *types.DevAddrPrefix) []string {
+		var ids []string
+		total, err := reg.RangeByApplicationID(ctx, appID, devAddrPrefix, 0, []string{"ids"}, func(_ context.Context, dev *ttnpb.EndDevice) bool {
+			ids = append(ids, dev.DeviceID)
+			return true
+		})
+		a.So(err, should.BeNil)
+		a.So(total, should.Equal, uint64(len(ids)))
+		return ids
+	}
+	a.So(rangeDeviceIDs(&types.DevAddrPrefix{
+		DevAddr: types.DevAddr{0x43},
+		Length:  8,
+	}), should.Resemble, []string{"test-dev-2"})
+	a.So(rangeDeviceIDs(nil), should.Resemble, []string{"test-dev-1", "test-dev-2"})
+	a.So(rangeDeviceIDs(&types.DevAddrPrefix{
+		DevAddr: types.DevAddr{0x42},
+		Length:  7,
+	}), should.Resemble, []string{"test-dev-1", "test-dev-2"})
+
+	n, err := cl.Exists(reg.appIndexedKey(appUID)).Result()
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, int64(1))
+}
@@ -41,6 +41,11 @@ type UplinkMatch interface {
 type DeviceRegistry interface {
 	GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error)
 	GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
+	// RangeByApplicationID calls f for each device of application appID ordered by device ID, starting at offset, until f
+	// returns false. If devAddrPrefix is not nil, only devices with a current or pending session DevAddr matching
+	// devAddrPrefix are ranged over. RangeByApplicationID returns the total number of devices that are ranged over when
+	// starting at offset 0 and f never returns false.
+	RangeByApplicationID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devAddrPrefix *types.DevAddrPrefix, offset uint64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) (uint64, error)
 	RangeByUplinkMatches(ctx context.Context, up *ttnpb.UplinkMessage, cacheTTL time.Duration, f func(context.Context, UplinkMatch) (bool, error)) error
 	SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
 }
@@ -106,6 +111,16 @@ func (w replacedEndDeviceFieldRegistryWrapper) GetByID(ctx context.Context, appI
 	return dev, ctx, nil
 }
 
+func (w replacedEndDeviceFieldRegistryWrapper) RangeByApplicationID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devAddrPrefix *types.DevAddrPrefix, offset uint64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) (uint64, error) {
+	paths, replaced := registry.MatchReplacedEndDeviceFields(paths, w.fields)
+	return w.DeviceRegistry.RangeByApplicationID(ctx, appID, devAddrPrefix, offset, paths, func(ctx context.Context, dev *ttnpb.EndDevice) bool {
+		for _, d := range replaced {
+			d.GetTransform(dev)
+		}
+		return f(ctx, dev)
+	})
+}
+
 func (w replacedEndDeviceFieldRegistryWrapper) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
 	paths, replaced := registry.MatchReplacedEndDeviceFields(paths, w.fields)
 	dev, ctx, err := w.DeviceRegistry.SetByID(ctx, appID, devID, paths, func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
@@ -0,0 +1,603 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"runtime/trace"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errInvalidFieldmask     = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers   = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errDuplicateIdentifiers = errors.DefineAlreadyExists("duplicate_identifiers", "duplicate identifiers")
+	errReadOnlyField        = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errDeviceNotFound       = errors.DefineNotFound("device_not_found", "end device not found")
+	errNoUplinkMatch        = errors.DefineNotFound("no_uplink_match", "no device matches uplink")
+)
+
+// DeviceRegistry is a SQL implementation of networkserver.DeviceRegistry.
+type DeviceRegistry struct {
+	DB *gorm.DB
+}
+
+func deviceSupports32BitFCnt(pb *ttnpb.EndDevice) bool {
+	if pb.GetMACSettings().GetSupports32BitFCnt() != nil {
+		return pb.MACSettings.Supports32BitFCnt.Value
+	}
+	return true
+}
+
+func decodeEndDevice(b []byte) (*ttnpb.EndDevice, error) {
+	pb := &ttnpb.EndDevice{}
+	if err := proto.Unmarshal(b, pb); err != nil {
+		return nil, errDecode.WithCause(err)
+	}
+	return pb, nil
+}
+
+func (r *DeviceRegistry) get(query *gorm.DB, paths []string) (*ttnpb.EndDevice, error) {
+	model := &endDevice{}
+	if err := query.Select("payload").First(model).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errDeviceNotFound.New()
+		}
+		return nil, errDatabase.WithCause(err)
+	}
+	pb, err := decodeEndDevice(model.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.FilterGetEndDevice(pb, paths...)
+}
+
+// GetByID gets device by appID, devID.
+func (r *DeviceRegistry) GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: appID,
+		DeviceID:               devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, ctx, err
+	}
+
+	defer trace.StartRegion(ctx, "get end device by id").End()
+
+	pb, err := r.get(r.DB.Where("application_id = ? AND device_id = ?", appID.ApplicationID, devID), paths)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
+
+// GetByEUI gets device by joinEUI, devEUI.
+func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error) {
+	defer trace.StartRegion(ctx, "get end device by eui").End()
+
+	pb, err := r.get(r.DB.Where("join_eui = ? AND dev_eui = ?", joinEUI.String(), devEUI.String()), paths)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
+
+// devAddrPrefixRange returns the lowest and highest DevAddr with the given prefix, as stored in the database.
+func devAddrPrefixRange(prefix types.DevAddrPrefix) (string, string) {
+	lo := prefix.DevAddr.Mask(prefix.Length)
+	hi := lo
+	for i := prefix.Length; i < 32; i++ {
+		hi[i/8] |= 1 << (7 - i%8)
+	}
+	return lo.String(), hi.String()
+}
+
+// RangeByApplicationID ranges over devices of application appID ordered by device ID, starting at offset.
+func (r *DeviceRegistry) RangeByApplicationID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devAddrPrefix *types.DevAddrPrefix, offset uint64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) (uint64, error) {
+	if err := appID.ValidateContext(ctx); err != nil {
+		return 0, err
+	}
+
+	defer trace.StartRegion(ctx, "range end devices by application id").End()
+
+	query := r.DB.Model(&endDevice{}).Where("application_id = ?", appID.ApplicationID)
+	if devAddrPrefix != nil {
+		lo, hi := devAddrPrefixRange(*devAddrPrefix)
+		query = query.Where("(dev_addr BETWEEN ? AND ?) OR (pending_dev_addr BETWEEN ? AND ?)", lo, hi, lo, hi)
+	}
+	var total uint64
+	if err := query.Count(&total).Error; err != nil {
+		return 0, errDatabase.WithCause(err)
+	}
+	rows, err := query.
+		Order("device_id").
+		Offset(offset).
+		Select("payload").
+		Rows()
+	if err != nil {
+		return 0, errDatabase.WithCause(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var payload []byte
+		if err := rows.Scan(&payload); err != nil {
+			return 0, errDatabase.WithCause(err)
+		}
+		pb, err := decodeEndDevice(payload)
+		if err != nil {
+			return 0, err
+		}
+		pb, err = ttnpb.FilterGetEndDevice(pb, paths...)
+		if err != nil {
+			return 0, err
+		}
+		if !f(ctx, pb) {
+			return total, nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, errDatabase.WithCause(err)
+	}
+	return total, nil
+}
+
+type uplinkMatch struct {
+	appID          ttnpb.ApplicationIdentifiers
+	devID          string
+	loRaWANVersion ttnpb.MACVersion
+	fNwkSIntKey    *ttnpb.KeyEnvelope
+	resetsFCnt     *bool
+
+	fCnt      uint32
+	lastFCnt  uint32
+	isPending bool
+}
+
+func (m uplinkMatch) ApplicationIdentifiers() ttnpb.ApplicationIdentifiers {
+	return m.appID
+}
+
+func (m uplinkMatch) DeviceID() string {
+	return m.devID
+}
+
+func (m uplinkMatch) LoRaWANVersion() ttnpb.MACVersion {
+	return m.loRaWANVersion
+}
+
+func (m uplinkMatch) FNwkSIntKey() *ttnpb.KeyEnvelope {
+	return m.fNwkSIntKey
+}
+
+func (m uplinkMatch) FCnt() uint32 {
+	return m.fCnt
+}
+
+func (m uplinkMatch) LastFCnt() uint32 {
+	return m.lastFCnt
+}
+
+func (m uplinkMatch) ResetsFCnt() *pbtypes.BoolValue {
+	if m.resetsFCnt == nil {
+		return nil
+	}
+	return &pbtypes.BoolValue{
+		Value: *m.resetsFCnt,
+	}
+}
+
+func (m uplinkMatch) IsPending() bool {
+	return m.isPending
+}
+
+func (m *uplinkMatch) toModel() (*uplinkMatchResult, error) {
+	fNwkSIntKey, err := proto.Marshal(m.fNwkSIntKey)
+	if err != nil {
+		return nil, err
+	}
+	return &uplinkMatchResult{
+		ApplicationID:  m.appID.ApplicationID,
+		DeviceID:       m.devID,
+		LoRaWANVersion: int32(m.loRaWANVersion),
+		FNwkSIntKey:    fNwkSIntKey,
+		ResetsFCnt:     m.resetsFCnt,
+		FCnt:           int64(m.fCnt),
+		LastFCnt:       int64(m.lastFCnt),
+		IsPending:      m.isPending,
+	}, nil
+}
+
+func (m *uplinkMatchResult) toUplinkMatch() (*uplinkMatch, error) {
+	fNwkSIntKey := &ttnpb.KeyEnvelope{}
+	if err := proto.Unmarshal(m.FNwkSIntKey, fNwkSIntKey); err != nil {
+		return nil, errDecode.WithCause(err)
+	}
+	return &uplinkMatch{
+		appID:          ttnpb.ApplicationIdentifiers{ApplicationID: m.ApplicationID},
+		devID:          m.DeviceID,
+		loRaWANVersion: ttnpb.MACVersion(m.LoRaWANVersion),
+		fNwkSIntKey:    fNwkSIntKey,
+		resetsFCnt:     m.ResetsFCnt,
+		fCnt:           uint32(m.FCnt),
+		lastFCnt:       uint32(m.LastFCnt),
+		isPending:      m.IsPending,
+	}, nil
+}
+
+// uplinkMatchClass is the class of an uplink match. The matches are attempted in the order of the classes.
+// NOTE: The order must be consistent with the one used by the Redis device registry.
+type uplinkMatchClass uint8
+
+const (
+	shortFCntLE uplinkMatchClass = iota
+	longFCntLE
+	pending
+	longFCntGT
+	shortFCntGT
+
+	uplinkMatchClassCount
+)
+
+// uplinkMatches returns the uplink matches of pb for an uplink with devAddr and frame counter lsb.
+func uplinkMatches(pb *ttnpb.EndDevice, devAddr types.DevAddr, lsb uint16) (ms [uplinkMatchClassCount]*uplinkMatch) {
+	if pb.GetMACState() != nil &&
+		pb.GetSession() != nil &&
+		pb.Session.DevAddr.Equal(devAddr) &&
+		pb.Session.FNwkSIntKey != nil {
+		var resetsFCnt *bool
+		if pb.GetMACSettings().GetResetsFCnt() != nil {
+			resetsFCnt = &pb.MACSettings.ResetsFCnt.Value
+		}
+		supports32BitFCnt := deviceSupports32BitFCnt(pb)
+		m := &uplinkMatch{
+			appID:          pb.ApplicationIdentifiers,
+			devID:          pb.DeviceID,
+			loRaWANVersion: pb.MACState.LoRaWANVersion,
+			fNwkSIntKey:    pb.Session.FNwkSIntKey,
+			resetsFCnt:     resetsFCnt,
+			fCnt:           FullFCnt(lsb, pb.Session.LastFCntUp, supports32BitFCnt),
+			lastFCnt:       pb.Session.LastFCntUp,
+		}
+		switch {
+		case !supports32BitFCnt && pb.Session.LastFCntUp <= uint32(lsb):
+			ms[shortFCntLE] = m
+		case !supports32BitFCnt:
+			ms[shortFCntGT] = m
+		case pb.Session.LastFCntUp&0xffff <= uint32(lsb):
+			ms[longFCntLE] = m
+		default:
+			ms[longFCntGT] = m
+		}
+	}
+	if pb.GetPendingMACState() != nil &&
+		pb.GetPendingSession() != nil &&
+		pb.PendingSession.DevAddr.Equal(devAddr) &&
+		pb.PendingSession.FNwkSIntKey != nil {
+		ms[pending] = &uplinkMatch{
+			appID:          pb.ApplicationIdentifiers,
+			devID:          pb.DeviceID,
+			loRaWANVersion: pb.PendingMACState.LoRaWANVersion,
+			fNwkSIntKey:    pb.PendingSession.FNwkSIntKey,
+			fCnt:           uint32(lsb),
+			isPending:      true,
+		}
+	}
+	return ms
+}
+
+// RangeByUplinkMatches ranges over devices matching the uplink.
+func (r *DeviceRegistry) RangeByUplinkMatches(ctx context.Context, up *ttnpb.UplinkMessage, cacheTTL time.Duration, f func(context.Context, networkserver.UplinkMatch) (bool, error)) error {
+	defer trace.StartRegion(ctx, "range end devices by dev_addr").End()
+	if cacheTTL < time.Millisecond {
+		cacheTTL = time.Millisecond
+	}
+	pld := up.Payload.GetMACPayload()
+	devAddr := pld.DevAddr.String()
+	payloadHash := uplinkPayloadHash(up.RawPayload)
+	lsb := uint16(pld.FCnt)
+	now := time.Now().UTC()
+
+	cached := &uplinkMatchResult{}
+	err := r.DB.Where("dev_addr = ? AND payload_hash = ? AND expires_at > ?", devAddr, payloadHash, now).First(cached).Error
+	switch {
+	case err == nil:
+		if err := r.DB.Model(cached).Update("expires_at", now.Add(cacheTTL)).Error; err != nil {
+			return errDatabase.WithCause(err)
+		}
+		m, err := cached.toUplinkMatch()
+		if err != nil {
+			return err
+		}
+		ctx := log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: m.appID,
+			DeviceID:               m.devID,
+		}))
+		ok, err := f(ctx, m)
+		if err != nil {
+			return errNoUplinkMatch.WithCause(err)
+		}
+		if !ok {
+			return errNoUplinkMatch.New()
+		}
+		return nil
+
+	case !gorm.IsRecordNotFoundError(err):
+		return errDatabase.WithCause(err)
+	}
+
+	rows, err := r.DB.Model(&endDevice{}).
+		Where("dev_addr = ? OR pending_dev_addr = ?", devAddr, devAddr).
+		Order("id").
+		Select("payload").
+		Rows()
+	if err != nil {
+		return errDatabase.WithCause(err)
+	}
+	defer rows.Close()
+	var classes [uplinkMatchClassCount][]*uplinkMatch
+	for rows.Next() {
+		var payload []byte
+		if err := rows.Scan(&payload); err != nil {
+			return errDatabase.WithCause(err)
+		}
+		pb, err := decodeEndDevice(payload)
+		if err != nil {
+			return err
+		}
+		for i, m := range uplinkMatches(pb, pld.DevAddr, lsb) {
+			if m != nil {
+				classes[i] = append(classes[i], m)
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errDatabase.WithCause(err)
+	}
+	for _, c := range []uplinkMatchClass{shortFCntLE, shortFCntGT} {
+		ms := classes[c]
+		sort.SliceStable(ms, func(i, j int) bool { return ms[i].lastFCnt < ms[j].lastFCnt })
+	}
+	for _, c := range []uplinkMatchClass{longFCntLE, longFCntGT} {
+		ms := classes[c]
+		sort.SliceStable(ms, func(i, j int) bool { return ms[i].lastFCnt&0xffff < ms[j].lastFCnt&0xffff })
+	}
+
+	for _, ms := range classes {
+		for _, m := range ms {
+			ctx := log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: m.appID,
+				DeviceID:               m.devID,
+			}))
+			ok, err := f(ctx, m)
+			if err != nil {
+				return errNoUplinkMatch.WithCause(err)
+			}
+			if !ok {
+				continue
+			}
+			model, err := m.toModel()
+			if err != nil {
+				return err
+			}
+			model.DevAddr = devAddr
+			model.PayloadHash = payloadHash
+			model.ExpiresAt = now.Add(cacheTTL)
+			if err := r.DB.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Where("expires_at <= ? OR (dev_addr = ? AND payload_hash = ?)", now, devAddr, payloadHash).Delete(&uplinkMatchResult{}).Error; err != nil {
+					return err
+				}
+				return tx.Create(model).Error
+			}); err != nil {
+				return errDatabase.WithCause(err)
+			}
+			return nil
+		}
+	}
+	return errNoUplinkMatch.New()
+}
+
+func equalEUI64(x, y *types.EUI64) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Equal(*y)
+}
+
+func devAddrString(ses *ttnpb.Session) *string {
+	if ses == nil {
+		return nil
+	}
+	s := ses.DevAddr.String()
+	return &s
+}
+
+// SetByID sets device by appID, devID.
+// The stored device is locked until the update is committed, so that concurrent updates of the device are serialized.
+func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(ctx context.Context, pb *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: appID,
+		DeviceID:               devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, ctx, err
+	}
+
+	defer trace.StartRegion(ctx, "set end device by id").End()
+
+	var (
+		pb     *ttnpb.EndDevice
+		setErr error
+	)
+	if err := r.DB.Transaction(func(tx *gorm.DB) error {
+		pb, setErr = r.setByID(ctx, tx, appID, devID, gets, f)
+		return setErr
+	}); err != nil {
+		if setErr != nil {
+			return nil, ctx, setErr
+		}
+		return nil, ctx, convertError(err)
+	}
+	return pb, ctx, nil
+}
+
+func (r *DeviceRegistry) setByID(ctx context.Context, tx *gorm.DB, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(ctx context.Context, pb *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+	query := tx.Model(&endDevice{}).Where("application_id = ? AND device_id = ?", appID.ApplicationID, devID)
+
+	model := &endDevice{}
+	var stored, pb *ttnpb.EndDevice
+	if err := query.Set("gorm:query_option", "FOR UPDATE").Select("version, payload").First(model).Error; err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			return nil, errDatabase.WithCause(err)
+		}
+	} else {
+		if stored, err = decodeEndDevice(model.Payload); err != nil {
+			return nil, err
+		}
+		if pb, err = decodeEndDevice(model.Payload); err != nil {
+			return nil, err
+		}
+		if pb, err = ttnpb.FilterGetEndDevice(pb, gets...); err != nil {
+			return nil, err
+		}
+	}
+
+	pb, sets, err := f(ctx, pb)
+	if err != nil {
+		return nil, err
+	}
+	if err := ttnpb.ProhibitFields(sets,
+		"created_at",
+		"updated_at",
+	); err != nil {
+		return nil, errInvalidFieldmask.WithCause(err)
+	}
+
+	if stored == nil && pb == nil {
+		return nil, nil
+	}
+	if pb != nil && len(sets) == 0 {
+		pb, err = ttnpb.FilterGetEndDevice(stored, gets...)
+		if err != nil {
+			return nil, err
+		}
+		return pb, nil
+	}
+	if pb == nil && len(sets) == 0 {
+		res := query.Where("version = ?", model.Version).Delete(&endDevice{})
+		if res.Error != nil {
+			return nil, errDatabase.WithCause(res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return nil, errConcurrentModification.New()
+		}
+		return nil, nil
+	}
+
+	if err := pb.ValidateFields(sets...); err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		if err := ttnpb.RequireFields(sets,
+			"ids.application_ids",
+			"ids.device_id",
+		); err != nil {
+			return nil, errInvalidFieldmask.WithCause(err)
+		}
+		if pb.ApplicationIdentifiers != appID || pb.DeviceID != devID {
+			return nil, errInvalidIdentifiers.New()
+		}
+	} else {
+		if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") && pb.ApplicationID != stored.ApplicationID {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+		}
+		if ttnpb.HasAnyField(sets, "ids.device_id") && pb.DeviceID != stored.DeviceID {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.device_id")
+		}
+		if ttnpb.HasAnyField(sets, "ids.join_eui") && !equalEUI64(pb.JoinEUI, stored.JoinEUI) {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.join_eui")
+		}
+		if ttnpb.HasAnyField(sets, "ids.dev_eui") && !equalEUI64(pb.DevEUI, stored.DevEUI) {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.dev_eui")
+		}
+	}
+
+	updated := stored
+	if updated == nil {
+		updated = &ttnpb.EndDevice{}
+	}
+	updated, err = ttnpb.ApplyEndDeviceFieldMask(updated, pb, sets...)
+	if err != nil {
+		return nil, err
+	}
+	updated.UpdatedAt = time.Now().UTC()
+	if stored == nil {
+		updated.CreatedAt = updated.UpdatedAt
+	}
+	payload, err := proto.Marshal(updated)
+	if err != nil {
+		return nil, err
+	}
+
+	var joinEUI, devEUI *string
+	if updated.JoinEUI != nil && updated.DevEUI != nil {
+		joinEUI, devEUI = euiString(updated.JoinEUI), euiString(updated.DevEUI)
+	}
+	if stored == nil {
+		if err := tx.Create(&endDevice{
+			ApplicationID:  appID.ApplicationID,
+			DeviceID:       devID,
+			JoinEUI:        joinEUI,
+			DevEUI:         devEUI,
+			DevAddr:        devAddrString(updated.Session),
+			PendingDevAddr: devAddrString(updated.PendingSession),
+			Payload:        payload,
+			CreatedAt:      updated.CreatedAt,
+			UpdatedAt:      updated.UpdatedAt,
+		}).Error; err != nil {
+			return nil, convertError(err)
+		}
+	} else {
+		res := query.Where("version = ?", model.Version).Updates(map[string]interface{}{
+			"dev_addr":         devAddrString(updated.Session),
+			"pending_dev_addr": devAddrString(updated.PendingSession),
+			"version":          gorm.Expr("version + 1"),
+			"payload":          payload,
+			"updated_at":       updated.UpdatedAt,
+		})
+		if res.Error != nil {
+			return nil, convertError(res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return nil, errConcurrentModification.New()
+		}
+	}
+	pb, err = ttnpb.FilterGetEndDevice(updated, gets...)
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
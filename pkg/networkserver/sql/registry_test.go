@@ -0,0 +1,126 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test/shared"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/sql"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ networkserver.DeviceRegistry = &DeviceRegistry{}
+
+func newTestDB(t *testing.T) *gorm.DB {
+	dbAddress := os.Getenv("SQL_DB_ADDRESS")
+	if dbAddress == "" {
+		dbAddress = "localhost:26257"
+	}
+	dbName := os.Getenv("TEST_DATABASE_NAME")
+	if dbName == "" {
+		dbName = "ttn_lorawan_ns_registry_test"
+	}
+	dbAuth := os.Getenv("SQL_DB_AUTH")
+	if dbAuth == "" {
+		dbAuth = "root"
+	}
+	db, err := store.Open(test.Context(), fmt.Sprintf("postgresql://%s@%s/%s?sslmode=disable", dbAuth, dbAddress, dbName))
+	if err != nil {
+		t.Fatalf("Failed to open database: %s", err)
+	}
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Errorf("Failed to close database: %s", err)
+		}
+	})
+	if err := store.Initialize(db); err != nil {
+		t.Fatalf("Failed to initialize database: %s", err)
+	}
+	if err := db.DropTableIfExists("ns_end_devices", "ns_uplink_matches").Error; err != nil {
+		t.Fatalf("Failed to drop tables: %s", err)
+	}
+	if err := Migrate(db); err != nil {
+		t.Fatalf("Failed to migrate database: %s", err)
+	}
+	return db
+}
+
+func newTestRegistry(t *testing.T) *DeviceRegistry {
+	return &DeviceRegistry{DB: newTestDB(t)}
+}
+
+func TestDeviceRegistry(t *testing.T) {
+	HandleDeviceRegistryTest(t, newTestRegistry(t))
+}
+
+func TestDeviceRegistryConcurrentSet(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	reg := newTestRegistry(t)
+
+	appID := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	devID := "test-dev"
+	_, _, err := reg.SetByID(ctx, appID, devID, nil, func(_ context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: appID,
+				DeviceID:               devID,
+			},
+		}, []string{
+			"ids.application_ids",
+			"ids.device_id",
+		}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	const writers = 16
+	var wg sync.WaitGroup
+	errCh := make(chan error, writers)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := reg.SetByID(ctx, appID, devID, []string{"downlink_margin"}, func(_ context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+				stored.DownlinkMargin++
+				return stored, []string{"downlink_margin"}, nil
+			})
+			errCh <- err
+		}()
+	}
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		a.So(err, should.BeNil)
+	}
+
+	dev, _, err := reg.GetByID(ctx, appID, devID, []string{"downlink_margin"})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(dev.DownlinkMargin, should.Equal, int32(writers))
+}
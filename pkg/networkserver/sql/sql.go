@@ -0,0 +1,109 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sql implements a SQL device registry of the Network Server.
+package sql
+
+import (
+	"encoding/hex"
+	"hash/fnv"
+	"regexp"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errDatabase               = errors.DefineInternal("database", "database error")
+	errDecode                 = errors.DefineCorruption("decode", "decode stored end device")
+	errConcurrentModification = errors.DefineAborted("concurrent_modification", "end device modified concurrently")
+)
+
+// endDevice is the database model of a stored end device.
+type endDevice struct {
+	ID             uint64    `gorm:"primary_key;auto_increment"`
+	ApplicationID  string    `gorm:"type:VARCHAR(36);not null;unique_index:ns_end_device_id_index"`
+	DeviceID       string    `gorm:"type:VARCHAR(36);not null;unique_index:ns_end_device_id_index"`
+	JoinEUI        *string   `gorm:"type:VARCHAR(16);unique_index:ns_end_device_eui_index"`
+	DevEUI         *string   `gorm:"type:VARCHAR(16);unique_index:ns_end_device_eui_index"`
+	DevAddr        *string   `gorm:"type:VARCHAR(8);index:ns_end_device_dev_addr_index"`
+	PendingDevAddr *string   `gorm:"type:VARCHAR(8);index:ns_end_device_pending_dev_addr_index"`
+	Version        uint64    `gorm:"not null;default:0"`
+	Payload        []byte    `gorm:"type:BYTEA;not null"`
+	CreatedAt      time.Time `gorm:"not null"`
+	UpdatedAt      time.Time `gorm:"not null"`
+}
+
+// TableName implements the gorm tabler interface.
+func (endDevice) TableName() string { return "ns_end_devices" }
+
+// uplinkMatchResult is the database model of a cached uplink matching result.
+type uplinkMatchResult struct {
+	ID             uint64    `gorm:"primary_key;auto_increment"`
+	DevAddr        string    `gorm:"type:VARCHAR(8);not null;unique_index:ns_uplink_match_key_index"`
+	PayloadHash    string    `gorm:"type:VARCHAR(16);not null;unique_index:ns_uplink_match_key_index"`
+	ApplicationID  string    `gorm:"type:VARCHAR(36);not null"`
+	DeviceID       string    `gorm:"type:VARCHAR(36);not null"`
+	LoRaWANVersion int32     `gorm:"not null"`
+	FNwkSIntKey    []byte    `gorm:"type:BYTEA;not null"`
+	ResetsFCnt     *bool     `gorm:"type:BOOLEAN"`
+	FCnt           int64     `gorm:"not null"`
+	LastFCnt       int64     `gorm:"not null"`
+	IsPending      bool      `gorm:"not null"`
+	ExpiresAt      time.Time `gorm:"not null;index:ns_uplink_match_expires_at_index"`
+}
+
+// TableName implements the gorm tabler interface.
+func (uplinkMatchResult) TableName() string { return "ns_uplink_matches" }
+
+// Migrate migrates the database schema of the device registry.
+func Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(&endDevice{}, &uplinkMatchResult{}).Error; err != nil {
+		return errDatabase.WithCause(err)
+	}
+	return nil
+}
+
+var uniqueViolationRegex = regexp.MustCompile(`duplicate key value( .+)? violates unique constraint "([a-z_]+)"`)
+
+func convertError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+		if match := uniqueViolationRegex.FindStringSubmatch(pqErr.Message); match != nil {
+			switch match[2] {
+			case "ns_end_device_id_index":
+				return errConcurrentModification.WithCause(err)
+			case "ns_end_device_eui_index":
+				return errDuplicateIdentifiers.WithCause(err)
+			}
+		}
+	}
+	return errDatabase.WithCause(err)
+}
+
+func euiString(eui *types.EUI64) *string {
+	if eui == nil {
+		return nil
+	}
+	s := eui.String()
+	return &s
+}
+
+func uplinkPayloadHash(b []byte) string {
+	h := fnv.New64a()
+	_, _ = h.Write(b)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -31,6 +31,8 @@ func Compute(payloadSize int, settings ttnpb.TxSettings) (d time.Duration, err e
 		return computeLoRa(payloadSize, settings.Frequency, uint8(dr.LoRa.SpreadingFactor), dr.LoRa.Bandwidth, settings.CodingRate, settings.EnableCRC)
 	case *ttnpb.DataRate_FSK:
 		return computeFSK(payloadSize, settings.Frequency, dr.FSK.BitRate, settings.EnableCRC)
+	case *ttnpb.DataRate_LRFHSS:
+		return computeLRFHSS(payloadSize, dr.LRFHSS.CodingRate)
 	default:
 		panic("invalid modulation")
 	}
@@ -140,3 +142,37 @@ func computeFSK(payloadSize int, frequency uint64, bitRate uint32, crc bool) (ti
 		return 0, errFrequency.WithAttributes("frequency", frequency)
 	}
 }
+
+const (
+	lrfhssHeaderBits   = 114
+	lrfhssFragmentBits = 48
+	lrfhssBitDuration  = 2048 * time.Microsecond
+)
+
+// computeLRFHSS computes the LR-FHSS time-on-air. The frequency is not taken into account, as the hopping
+// pattern and bit duration are the same in all regions.
+func computeLRFHSS(payloadSize int, codingRate string) (time.Duration, error) {
+	// See Semtech LR1110 LR-FHSS time-on-air computation.
+	headerCount := 2
+	// The payload is followed by a 16 bit CRC and 6 tail bits.
+	bits := (payloadSize+2)*8 + 6
+	switch codingRate {
+	case "5/6":
+		bits = (bits*6 + 4) / 5
+	case "2/3":
+		bits = bits * 3 / 2
+	case "1/2":
+		bits = bits * 2
+	case "1/3":
+		headerCount = 3
+		bits = bits * 3
+	default:
+		return 0, errCodingRate.WithAttributes("coding_rate", codingRate)
+	}
+	// Each fragment is preceded by a 2 bit sync word.
+	payloadBits := bits / lrfhssFragmentBits * (lrfhssFragmentBits + 2)
+	if rem := bits % lrfhssFragmentBits; rem > 0 {
+		payloadBits += rem + 2
+	}
+	return time.Duration(headerCount*lrfhssHeaderBits+payloadBits) * lrfhssBitDuration, nil
+}
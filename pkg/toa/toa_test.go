@@ -175,6 +175,43 @@ func TestFSK(t *testing.T) {
 	a.So(toa, should.AlmostEqual, 33760*time.Microsecond)
 }
 
+func TestLRFHSS(t *testing.T) {
+	a := assertions.New(t)
+	for cr, us := range map[string]int{
+		"1/3": 1355776,
+		"2/3": 796672,
+	} {
+		scheduled := ttnpb.TxSettings{
+			Frequency: 868100000,
+			DataRate: ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_LRFHSS{
+					LRFHSS: &ttnpb.LRFHSSDataRate{
+						OperatingChannelWidth: 137000,
+						CodingRate:            cr,
+					},
+				},
+			},
+		}
+		toa, err := Compute(10, scheduled)
+		a.So(err, should.BeNil)
+		a.So(toa, should.Equal, time.Duration(us)*time.Microsecond)
+	}
+
+	// Invalid coding rate.
+	_, err := Compute(10, ttnpb.TxSettings{
+		Frequency: 868100000,
+		DataRate: ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_LRFHSS{
+				LRFHSS: &ttnpb.LRFHSSDataRate{
+					OperatingChannelWidth: 137000,
+					CodingRate:            "4/5",
+				},
+			},
+		},
+	})
+	a.So(err, should.NotBeNil)
+}
+
 func getDownlink() ttnpb.DownlinkMessage { return ttnpb.DownlinkMessage{} }
 
 func ExampleCompute() {
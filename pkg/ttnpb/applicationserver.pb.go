@@ -330,97 +330,100 @@ func init() {
 }
 
 var fileDescriptor_df9d75a19dc066e1 = []byte{
-	// 1428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4d, 0x6c, 0x13, 0x47,
-	0x1b, 0xde, 0xb1, 0x9d, 0xbf, 0xe1, 0xfb, 0x42, 0xb2, 0xf0, 0xf1, 0x25, 0x2e, 0x9d, 0x44, 0x86,
-	0x22, 0xc7, 0xc2, 0xbb, 0x34, 0xb4, 0x55, 0x9b, 0xaa, 0x8d, 0x6c, 0x08, 0x29, 0x90, 0xa8, 0x60,
-	0x87, 0x56, 0x0a, 0x3f, 0xd6, 0xc4, 0x3b, 0x71, 0x56, 0x5e, 0xef, 0x0e, 0x3b, 0xb3, 0x09, 0x06,
-	0x22, 0xa1, 0xaa, 0xa2, 0x88, 0x43, 0x8b, 0x5a, 0x21, 0x71, 0x6c, 0x55, 0x55, 0xe2, 0x88, 0xda,
-	0x43, 0x39, 0xb5, 0x48, 0x15, 0x12, 0x6a, 0x2f, 0x54, 0xbd, 0x70, 0x4a, 0xc9, 0xba, 0x07, 0xa4,
-	0x5e, 0x38, 0x22, 0x4e, 0xd5, 0xce, 0xae, 0x63, 0xc7, 0x4e, 0x82, 0xa1, 0x88, 0xaa, 0xb7, 0xd9,
-	0x9d, 0xf7, 0x7d, 0xde, 0xe7, 0x79, 0xe7, 0x79, 0xc7, 0x6b, 0x38, 0x64, 0x58, 0x36, 0x5e, 0xc0,
-	0x66, 0x92, 0x71, 0x9c, 0x2f, 0xaa, 0x98, 0xea, 0x2a, 0xa6, 0xd4, 0xd0, 0xf3, 0x98, 0xeb, 0x96,
-	0xc9, 0x88, 0x3d, 0x4f, 0x6c, 0x85, 0xda, 0x16, 0xb7, 0xe4, 0x6e, 0xce, 0x4d, 0x25, 0x08, 0x57,
-	0xe6, 0xf7, 0x46, 0x53, 0x05, 0x9d, 0xcf, 0x39, 0x33, 0x4a, 0xde, 0x2a, 0xa9, 0xc4, 0x9c, 0xb7,
-	0xca, 0xd4, 0xb6, 0xce, 0x94, 0x55, 0x11, 0x9c, 0x4f, 0x16, 0x88, 0x99, 0x9c, 0xc7, 0x86, 0xae,
-	0x61, 0x4e, 0xd4, 0xa6, 0x85, 0x0f, 0x19, 0x4d, 0xd6, 0x41, 0x14, 0xac, 0x82, 0xe5, 0x27, 0xcf,
-	0x38, 0xb3, 0xe2, 0x49, 0x3c, 0x88, 0x55, 0x10, 0xbe, 0xbd, 0x60, 0x59, 0x05, 0x83, 0xf8, 0x2c,
-	0x4d, 0xd3, 0xe2, 0x3e, 0xc9, 0x60, 0xf7, 0xa5, 0x60, 0x77, 0x05, 0x83, 0x94, 0x28, 0x2f, 0x07,
-	0x9b, 0x83, 0x8d, 0x9b, 0xb3, 0x3a, 0x31, 0xb4, 0x5c, 0x09, 0xb3, 0x62, 0x10, 0x31, 0xd0, 0x18,
-	0xc1, 0xf5, 0x12, 0x61, 0x1c, 0x97, 0x68, 0x10, 0x80, 0x1a, 0x03, 0x16, 0x6c, 0x4c, 0x29, 0xb1,
-	0xab, 0xf5, 0x63, 0xcd, 0xad, 0x24, 0xa6, 0x96, 0xd3, 0xc8, 0xbc, 0x9e, 0xaf, 0x0a, 0xde, 0xd1,
-	0x1c, 0xa3, 0x6b, 0xc4, 0xe4, 0xfa, 0xac, 0x5e, 0x03, 0x1a, 0x6c, 0x0e, 0x2a, 0x11, 0xc6, 0x70,
-	0x81, 0x54, 0x23, 0xb6, 0xaf, 0x11, 0x71, 0x9a, 0x73, 0x7f, 0x37, 0x76, 0x3b, 0x0c, 0x37, 0xa7,
-	0x6a, 0x87, 0x38, 0xa1, 0x9b, 0x45, 0xf9, 0x36, 0x80, 0xdb, 0x4c, 0xc2, 0x17, 0x2c, 0xbb, 0x98,
-	0xf3, 0x4f, 0x35, 0x87, 0x35, 0xcd, 0x26, 0x8c, 0xf5, 0x81, 0x41, 0x10, 0xef, 0x4a, 0x7f, 0x0a,
-	0x1e, 0xa7, 0x2f, 0x03, 0xfb, 0x13, 0x30, 0xfc, 0x31, 0x38, 0x15, 0x1f, 0x1d, 0x89, 0x8f, 0x8e,
-	0x1c, 0xc7, 0xc9, 0xb3, 0xa9, 0xe4, 0xf4, 0x9e, 0xe4, 0x5b, 0x27, 0xcf, 0xd7, 0xad, 0x6b, 0xcb,
-	0x13, 0xc9, 0x93, 0x89, 0xba, 0x8d, 0xa1, 0x13, 0xca, 0x50, 0xc2, 0xcb, 0x4b, 0x25, 0xa7, 0x71,
-	0xf2, 0xac, 0x9f, 0x57, 0x5b, 0xd7, 0x96, 0x22, 0xaf, 0xb6, 0x31, 0x14, 0x1f, 0x1d, 0x19, 0x39,
-	0xee, 0xad, 0xce, 0xbd, 0xba, 0xfb, 0xf5, 0xc5, 0xa1, 0xd1, 0x9d, 0xe7, 0x4f, 0xed, 0xcc, 0x6c,
-	0x0d, 0xe8, 0x66, 0x05, 0xdb, 0x94, 0x4f, 0x56, 0x4e, 0xc0, 0x0e, 0x4c, 0xf5, 0x5c, 0x91, 0x94,
-	0xfb, 0x42, 0x82, 0x77, 0xef, 0xe3, 0x74, 0xc4, 0x0e, 0xf5, 0x00, 0x77, 0x69, 0xa0, 0x3d, 0x75,
-	0xe4, 0xe0, 0x61, 0x52, 0xce, 0xb4, 0x63, 0xaa, 0x1f, 0x26, 0x65, 0xf9, 0x43, 0x28, 0x6b, 0x64,
-	0x16, 0x3b, 0x06, 0xcf, 0xcd, 0x5a, 0x76, 0x09, 0x73, 0x4e, 0x6c, 0xd6, 0x17, 0x1e, 0x04, 0xf1,
-	0x4d, 0xc3, 0x71, 0x65, 0xb5, 0x9b, 0x95, 0x49, 0xbf, 0xc3, 0x47, 0x70, 0xd9, 0xb0, 0xb0, 0x76,
-	0x60, 0x25, 0x3e, 0xd3, 0x1b, 0x60, 0xd4, 0x5e, 0xc9, 0xfd, 0x30, 0xcc, 0x0d, 0xd6, 0x17, 0x19,
-	0x04, 0xf1, 0xce, 0x74, 0x87, 0xbb, 0x34, 0x10, 0x9e, 0x9a, 0xc8, 0x66, 0xbc, 0x77, 0xf2, 0x21,
-	0xb8, 0x85, 0x15, 0x75, 0x9a, 0xa3, 0x3e, 0x4e, 0x2e, 0x6f, 0x97, 0x29, 0xb7, 0xfa, 0xda, 0x44,
-	0xd1, 0xa8, 0xe2, 0x5b, 0x48, 0xa9, 0x5a, 0x48, 0x49, 0x5b, 0x96, 0xf1, 0x01, 0x36, 0x1c, 0x92,
-	0xe9, 0xf5, 0xd2, 0x82, 0xea, 0xfb, 0x44, 0x52, 0xec, 0x47, 0x00, 0xfb, 0xc7, 0x09, 0x6f, 0x38,
-	0xca, 0x0c, 0x39, 0xed, 0x10, 0xc6, 0x65, 0x0c, 0x37, 0xd7, 0x4d, 0x6a, 0x4e, 0xd7, 0xfc, 0x93,
-	0xdc, 0x34, 0xbc, 0xab, 0x51, 0x5a, 0x1d, 0xc0, 0xc1, 0x9a, 0xd9, 0xd2, 0x3d, 0x8f, 0xd3, 0x6d,
-	0x97, 0x41, 0xa8, 0x07, 0xdc, 0x59, 0x1a, 0x90, 0xee, 0x2e, 0x0d, 0x80, 0x4c, 0x37, 0xae, 0x8f,
-	0x64, 0xf2, 0x28, 0x84, 0xb5, 0x31, 0x11, 0xfd, 0x5e, 0x4b, 0xc3, 0x01, 0x2f, 0x64, 0x12, 0xb3,
-	0x62, 0x3a, 0xe2, 0x21, 0x65, 0xba, 0x66, 0xab, 0x2f, 0x62, 0x17, 0x43, 0xb0, 0x3f, 0xfb, 0x4f,
-	0x2a, 0x18, 0x83, 0x11, 0x43, 0x37, 0xab, 0xdc, 0x07, 0x36, 0xc0, 0xf5, 0x88, 0xad, 0x01, 0x28,
-	0xd2, 0x1b, 0x1a, 0x11, 0x7e, 0xfa, 0x46, 0x7c, 0x16, 0x81, 0x5b, 0x1b, 0x8a, 0x65, 0x39, 0xe6,
-	0x4c, 0x7e, 0x07, 0x76, 0x79, 0x15, 0x88, 0x96, 0xc3, 0x3c, 0x50, 0xdf, 0x0c, 0x3c, 0x55, 0xbd,
-	0x89, 0xd2, 0x91, 0x2b, 0xbf, 0x0f, 0x80, 0x4c, 0xa7, 0x9f, 0x92, 0xe2, 0x1b, 0x8d, 0x75, 0xe8,
-	0xdf, 0x34, 0xd6, 0xef, 0xc3, 0x2d, 0x06, 0x66, 0x3c, 0xe7, 0xd0, 0x9c, 0x4d, 0xf2, 0x44, 0x9f,
-	0xf7, 0x1b, 0x12, 0x6e, 0xb1, 0x21, 0x3d, 0x5e, 0xf2, 0x31, 0x9a, 0x09, 0x52, 0x53, 0x5c, 0xee,
-	0x87, 0x9d, 0x0e, 0xcd, 0xe5, 0x2d, 0xc7, 0xe4, 0x62, 0x4e, 0x23, 0x99, 0x0e, 0x87, 0xee, 0xf3,
-	0x1e, 0xe5, 0x93, 0x30, 0x2a, 0x6a, 0x69, 0xd6, 0x82, 0xe9, 0x35, 0xd2, 0xbb, 0x1c, 0x16, 0xb0,
-	0xad, 0xf9, 0x25, 0xdb, 0x5a, 0x2c, 0xf9, 0x7f, 0x0f, 0x63, 0x7f, 0x00, 0x71, 0xa0, 0x8a, 0x90,
-	0xe2, 0xf2, 0x2b, 0xb0, 0x7b, 0x05, 0xd9, 0xaf, 0xdf, 0x2e, 0xea, 0xff, 0xb7, 0xfa, 0x56, 0xb0,
-	0x18, 0xfe, 0x39, 0x02, 0x43, 0x29, 0x26, 0x5f, 0x05, 0xb0, 0x63, 0x9c, 0x70, 0x71, 0x47, 0x0f,
-	0x35, 0xda, 0x73, 0xdd, 0xe1, 0x8f, 0x3e, 0xc9, 0xc9, 0xb1, 0x77, 0x3f, 0xfa, 0xed, 0x8f, 0x2f,
-	0x42, 0x6f, 0xca, 0x6f, 0xa8, 0x98, 0xad, 0xfa, 0x45, 0x57, 0xcf, 0x35, 0xcc, 0x9c, 0xb2, 0xfa,
-	0x79, 0x51, 0x15, 0x8e, 0xbf, 0x06, 0x60, 0x47, 0x76, 0x3d, 0x5e, 0xd9, 0x67, 0xe7, 0x95, 0x12,
-	0xbc, 0xde, 0x8e, 0x3e, 0x23, 0xaf, 0x11, 0x90, 0x90, 0xcf, 0x43, 0xb8, 0x9f, 0x18, 0x84, 0x13,
-	0x41, 0xae, 0xc5, 0xbb, 0x22, 0xba, 0xad, 0xe9, 0x44, 0xc7, 0xbc, 0xcf, 0x83, 0x98, 0x22, 0x08,
-	0xc5, 0x13, 0xbb, 0x9e, 0x44, 0x28, 0x68, 0xcc, 0xe7, 0x00, 0xfe, 0x27, 0x38, 0x30, 0x7f, 0x82,
-	0x5b, 0x25, 0xb0, 0xf3, 0x09, 0xad, 0x11, 0x68, 0xb1, 0xd7, 0x04, 0x1d, 0x45, 0xde, 0xdd, 0x1a,
-	0x1d, 0x95, 0x79, 0x59, 0xc3, 0x5f, 0x75, 0xc2, 0xb6, 0x14, 0xa5, 0x29, 0x26, 0x4f, 0xc1, 0xae,
-	0xac, 0x33, 0xc3, 0xf2, 0xb6, 0x3e, 0x43, 0x5a, 0xa6, 0xf6, 0xf2, 0x06, 0x71, 0xc7, 0xe8, 0x1e,
-	0x20, 0xff, 0x02, 0x60, 0x6f, 0xd5, 0xeb, 0x47, 0x1d, 0xe2, 0x90, 0x23, 0x0e, 0x9b, 0x93, 0x9b,
-	0x14, 0xad, 0x0a, 0xa9, 0x5a, 0x62, 0xbd, 0xc6, 0x9f, 0x11, 0x4a, 0xed, 0x58, 0xa9, 0x59, 0x69,
-	0xed, 0xb3, 0x69, 0x0d, 0x23, 0x34, 0x1b, 0xc3, 0x0f, 0x6d, 0xce, 0x5b, 0x59, 0x2e, 0xaa, 0xde,
-	0xec, 0xa9, 0xd4, 0x61, 0x73, 0x9e, 0x81, 0x7e, 0x05, 0x70, 0x6b, 0x03, 0x55, 0x6a, 0xe0, 0x3c,
-	0xf9, 0x9b, 0x82, 0xce, 0x09, 0x41, 0x4e, 0x8c, 0xbe, 0x30, 0x41, 0xb6, 0xcf, 0xdb, 0xd3, 0xf4,
-	0x5d, 0xe3, 0x09, 0x4d, 0xe8, 0x8c, 0x37, 0x0b, 0x1a, 0x33, 0xb5, 0xfd, 0x02, 0xa4, 0x55, 0x67,
-	0x56, 0x31, 0x59, 0x2c, 0x23, 0xe4, 0x4d, 0xc8, 0x87, 0x9e, 0x7e, 0x72, 0x57, 0xf4, 0x34, 0x08,
-	0x90, 0xbf, 0x06, 0xf0, 0x7f, 0xe3, 0x84, 0x4f, 0x1e, 0x9d, 0x9a, 0xda, 0x67, 0x99, 0x26, 0xc9,
-	0x0b, 0x67, 0x9a, 0xb3, 0x56, 0xcb, 0xd6, 0x8d, 0x35, 0x7d, 0xc7, 0x35, 0x61, 0xb5, 0x7e, 0x17,
-	0x2e, 0x8a, 0xaf, 0xe8, 0x64, 0x7e, 0x25, 0x3d, 0xa9, 0x7b, 0x5c, 0x7e, 0x02, 0xb0, 0x3b, 0xab,
-	0x97, 0x1c, 0x03, 0x73, 0x72, 0x8c, 0x8a, 0x5b, 0x60, 0xe3, 0x89, 0x59, 0xd7, 0x22, 0x67, 0x05,
-	0x13, 0x1e, 0xb3, 0x5e, 0x84, 0x45, 0x1c, 0xaa, 0xb2, 0x80, 0xf5, 0x08, 0x48, 0x0c, 0xff, 0x19,
-	0x81, 0x5b, 0x52, 0x6c, 0xc5, 0x00, 0x19, 0x52, 0xd0, 0x19, 0xb7, 0xcb, 0xf2, 0xb7, 0x00, 0x86,
-	0xc7, 0x09, 0x97, 0x77, 0xac, 0xf1, 0xeb, 0x53, 0x17, 0xed, 0x7b, 0xbf, 0x7f, 0x5d, 0x43, 0xc5,
-	0x8a, 0x42, 0x1b, 0x91, 0xf3, 0x2f, 0x40, 0x9b, 0x7c, 0x31, 0x04, 0xc3, 0xd9, 0xb5, 0x48, 0x67,
-	0x9f, 0x8e, 0xf4, 0x0f, 0x40, 0xb0, 0xfe, 0x1e, 0x44, 0x37, 0xa4, 0xad, 0x3c, 0x23, 0x6d, 0x65,
-	0x35, 0xed, 0x11, 0x90, 0x98, 0x9e, 0x8c, 0xbd, 0xf7, 0xbc, 0x2a, 0x79, 0x73, 0x7f, 0x15, 0xc0,
-	0x76, 0xff, 0xd7, 0xb0, 0xc5, 0x61, 0x5f, 0xcf, 0x9a, 0x93, 0xa2, 0x11, 0xe3, 0x89, 0xb1, 0xe7,
-	0x32, 0xde, 0xe9, 0x6f, 0xc0, 0x9d, 0x65, 0x04, 0xee, 0x2e, 0x23, 0x70, 0x6f, 0x19, 0x49, 0xf7,
-	0x97, 0x91, 0xf4, 0x60, 0x19, 0x49, 0x0f, 0x97, 0x91, 0xf4, 0x68, 0x19, 0x81, 0x0b, 0x2e, 0x02,
-	0x97, 0x5c, 0x24, 0x5d, 0x77, 0x11, 0xb8, 0xe1, 0x22, 0xe9, 0xa6, 0x8b, 0xa4, 0x5b, 0x2e, 0x92,
-	0xee, 0xb8, 0x08, 0xdc, 0x75, 0x11, 0xb8, 0xe7, 0x22, 0xe9, 0xbe, 0x8b, 0xc0, 0x03, 0x17, 0x49,
-	0x0f, 0x5d, 0x04, 0x1e, 0xb9, 0x48, 0xba, 0x50, 0x41, 0xd2, 0xa5, 0x0a, 0x02, 0x57, 0x2a, 0x48,
-	0xba, 0x56, 0x41, 0xe0, 0xcb, 0x0a, 0x92, 0xae, 0x57, 0x90, 0x74, 0xa3, 0x82, 0xc0, 0xcd, 0x0a,
-	0x02, 0xb7, 0x2a, 0x08, 0x4c, 0xab, 0x05, 0x4b, 0xe1, 0x73, 0x84, 0xcf, 0xe9, 0x66, 0x81, 0x29,
-	0xc1, 0xa7, 0xa6, 0xba, 0xfa, 0xdf, 0xf2, 0xfc, 0x5e, 0x95, 0x16, 0x0b, 0x2a, 0xe7, 0x26, 0x9d,
-	0x99, 0x69, 0x17, 0x6d, 0xd8, 0xfb, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x64, 0xe8, 0x0d, 0xc4,
-	0x08, 0x11, 0x00, 0x00,
+	// 1488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0x4d, 0x6c, 0x13, 0x47,
+	0x14, 0xc7, 0x77, 0x6c, 0xe7, 0x6b, 0x68, 0x43, 0xb2, 0x50, 0x9a, 0xb8, 0x74, 0x12, 0x2d, 0x94,
+	0x3a, 0x16, 0xde, 0xa5, 0xa1, 0xad, 0xda, 0x54, 0x6d, 0x6a, 0x43, 0x48, 0x81, 0x44, 0x05, 0x3b,
+	0xb4, 0x52, 0xf8, 0xb0, 0x26, 0xf6, 0xc4, 0x59, 0x79, 0xbd, 0xbb, 0xec, 0xcc, 0x3a, 0x18, 0x88,
+	0x84, 0xaa, 0x8a, 0x22, 0x0e, 0x2d, 0x6a, 0x85, 0x84, 0x7a, 0xa2, 0xaa, 0x2a, 0x21, 0xf5, 0x82,
+	0xda, 0x43, 0x39, 0xb5, 0x48, 0x15, 0x12, 0x6a, 0x2f, 0x54, 0xbd, 0x70, 0x4a, 0xc9, 0xba, 0x07,
+	0x8e, 0x1c, 0x11, 0xa7, 0x6a, 0x67, 0xd7, 0x76, 0x62, 0xc7, 0xc1, 0xa4, 0x88, 0xaa, 0xb7, 0xd9,
+	0x9d, 0xf7, 0xf1, 0x7b, 0x6f, 0xfe, 0x6f, 0xbc, 0x86, 0x43, 0x9a, 0x61, 0xe1, 0x79, 0xac, 0xc7,
+	0x28, 0xc3, 0x99, 0xbc, 0x82, 0x4d, 0x55, 0xc1, 0xa6, 0xa9, 0xa9, 0x19, 0xcc, 0x54, 0x43, 0xa7,
+	0xc4, 0x2a, 0x12, 0x4b, 0x36, 0x2d, 0x83, 0x19, 0x62, 0x37, 0x63, 0xba, 0xec, 0x9b, 0xcb, 0xc5,
+	0xdd, 0xe1, 0x78, 0x4e, 0x65, 0x73, 0xf6, 0x8c, 0x9c, 0x31, 0x0a, 0x0a, 0xd1, 0x8b, 0x46, 0xc9,
+	0xb4, 0x8c, 0x53, 0x25, 0x85, 0x1b, 0x67, 0x62, 0x39, 0xa2, 0xc7, 0x8a, 0x58, 0x53, 0xb3, 0x98,
+	0x11, 0xa5, 0x61, 0xe1, 0x85, 0x0c, 0xc7, 0x96, 0x85, 0xc8, 0x19, 0x39, 0xc3, 0x73, 0x9e, 0xb1,
+	0x67, 0xf9, 0x13, 0x7f, 0xe0, 0x2b, 0xdf, 0x7c, 0x6b, 0xce, 0x30, 0x72, 0x1a, 0xf1, 0x28, 0x75,
+	0xdd, 0x60, 0x1e, 0xa4, 0xbf, 0xfb, 0x92, 0xbf, 0x5b, 0x8d, 0x41, 0x0a, 0x26, 0x2b, 0xf9, 0x9b,
+	0x83, 0xf5, 0x9b, 0xb3, 0x2a, 0xd1, 0xb2, 0xe9, 0x02, 0xa6, 0x79, 0xdf, 0x62, 0xa0, 0xde, 0x82,
+	0xa9, 0x05, 0x42, 0x19, 0x2e, 0x98, 0xbe, 0x01, 0xaa, 0x37, 0x98, 0xb7, 0xb0, 0x69, 0x12, 0xab,
+	0x92, 0x5f, 0x6a, 0x6c, 0x25, 0xd1, 0xb3, 0xe9, 0x2c, 0x29, 0xaa, 0x99, 0x4a, 0xc1, 0xdb, 0x1a,
+	0x6d, 0xd4, 0x2c, 0xd1, 0x99, 0x3a, 0xab, 0xd6, 0x02, 0x0d, 0x36, 0x1a, 0x15, 0x08, 0xa5, 0x38,
+	0x47, 0x2a, 0x16, 0x5b, 0x57, 0xb1, 0x38, 0xc9, 0x98, 0xb7, 0x2b, 0xdd, 0x0a, 0xc2, 0x8d, 0xf1,
+	0xda, 0x21, 0x4e, 0xa8, 0x7a, 0x5e, 0xbc, 0x05, 0xe0, 0x16, 0x9d, 0xb0, 0x79, 0xc3, 0xca, 0xa7,
+	0xbd, 0x53, 0x4d, 0xe3, 0x6c, 0xd6, 0x22, 0x94, 0xf6, 0x81, 0x41, 0x10, 0xe9, 0x4a, 0x7c, 0x0e,
+	0x1e, 0x25, 0x2e, 0x02, 0xeb, 0x33, 0x30, 0xfc, 0x29, 0x38, 0x11, 0x19, 0x1d, 0x89, 0x8c, 0x8e,
+	0x1c, 0xc5, 0xb1, 0xd3, 0xf1, 0xd8, 0xf4, 0xae, 0xd8, 0xdb, 0xc7, 0xcf, 0x2e, 0x5b, 0xd7, 0x96,
+	0xc7, 0x62, 0xc7, 0xa3, 0xcb, 0x36, 0x86, 0x8e, 0xc9, 0x43, 0x51, 0xd7, 0x2f, 0x1e, 0x9b, 0xc6,
+	0xb1, 0xd3, 0x9e, 0x5f, 0x6d, 0x5d, 0x5b, 0x72, 0xbf, 0xda, 0xc6, 0x50, 0x64, 0x74, 0x64, 0xe4,
+	0xa8, 0xbb, 0x3a, 0xf3, 0xda, 0xce, 0x37, 0x16, 0x86, 0x46, 0xb7, 0x9f, 0x3d, 0xb1, 0x3d, 0xb9,
+	0xd9, 0xc7, 0x4d, 0x71, 0xda, 0xb8, 0x07, 0x2b, 0x46, 0x61, 0x07, 0x36, 0xd5, 0x74, 0x9e, 0x94,
+	0xfa, 0x02, 0x9c, 0xbb, 0xf7, 0x51, 0x22, 0x64, 0x05, 0x7a, 0x80, 0xb3, 0x38, 0xd0, 0x1e, 0x3f,
+	0xb4, 0xff, 0x20, 0x29, 0x25, 0xdb, 0xb1, 0xa9, 0x1e, 0x24, 0x25, 0xf1, 0x63, 0x28, 0x66, 0xc9,
+	0x2c, 0xb6, 0x35, 0x96, 0x9e, 0x35, 0xac, 0x02, 0x66, 0x8c, 0x58, 0xb4, 0x2f, 0x38, 0x08, 0x22,
+	0x1b, 0x86, 0x23, 0xf2, 0x4a, 0x35, 0xcb, 0x93, 0x5e, 0x87, 0x0f, 0xe1, 0x92, 0x66, 0xe0, 0xec,
+	0xbe, 0xaa, 0x7d, 0xb2, 0xd7, 0x8f, 0x51, 0x7b, 0x25, 0xf6, 0xc3, 0x20, 0xd3, 0x68, 0x5f, 0x68,
+	0x10, 0x44, 0x3a, 0x13, 0x1d, 0xce, 0xe2, 0x40, 0x70, 0x6a, 0x22, 0x95, 0x74, 0xdf, 0x89, 0x07,
+	0xe0, 0x26, 0x9a, 0x57, 0xcd, 0xb4, 0xe9, 0xc5, 0x49, 0x67, 0xac, 0x92, 0xc9, 0x8c, 0xbe, 0x36,
+	0x9e, 0x34, 0x2c, 0x7b, 0x12, 0x92, 0x2b, 0x12, 0x92, 0x13, 0x86, 0xa1, 0x7d, 0x84, 0x35, 0x9b,
+	0x24, 0x7b, 0x5d, 0x37, 0x3f, 0xfb, 0x1e, 0xee, 0x24, 0xfd, 0x02, 0x60, 0xff, 0x38, 0x61, 0x75,
+	0x47, 0x99, 0x24, 0x27, 0x6d, 0x42, 0x99, 0x88, 0xe1, 0xc6, 0x65, 0x93, 0x9a, 0x56, 0xb3, 0xde,
+	0x49, 0x6e, 0x18, 0xde, 0x51, 0x5f, 0xda, 0xb2, 0x00, 0xfb, 0x6b, 0x62, 0x4b, 0xf4, 0x3c, 0x4a,
+	0xb4, 0x5d, 0x04, 0x81, 0x1e, 0x70, 0x7b, 0x71, 0x40, 0xb8, 0xb3, 0x38, 0x00, 0x92, 0xdd, 0x78,
+	0xb9, 0x25, 0x15, 0x47, 0x21, 0xac, 0x8d, 0x49, 0x5f, 0xa0, 0x49, 0x0d, 0xfb, 0x5c, 0x93, 0x49,
+	0x4c, 0xf3, 0x89, 0x90, 0x1b, 0x29, 0xd9, 0x35, 0x5b, 0x79, 0x21, 0x9d, 0x0f, 0xc0, 0xfe, 0xd4,
+	0x7f, 0x59, 0xc1, 0x18, 0x0c, 0x69, 0xaa, 0x5e, 0x61, 0x1f, 0x58, 0x23, 0xae, 0x0b, 0xb6, 0x4a,
+	0x40, 0xee, 0x5e, 0xd7, 0x88, 0xe0, 0x93, 0x37, 0xe2, 0x8b, 0x10, 0xdc, 0x5c, 0x97, 0x2c, 0xc5,
+	0x30, 0xa3, 0xe2, 0xbb, 0xb0, 0xcb, 0xcd, 0x40, 0xb2, 0x69, 0xcc, 0xfa, 0x40, 0x93, 0xc0, 0x53,
+	0x95, 0x9b, 0x28, 0x11, 0xba, 0xf4, 0xd7, 0x00, 0x48, 0x76, 0x7a, 0x2e, 0x71, 0xb6, 0xd6, 0x58,
+	0x07, 0xfe, 0x4f, 0x63, 0xfd, 0x21, 0xdc, 0xa4, 0x61, 0xca, 0xd2, 0xb6, 0x99, 0xb6, 0x48, 0x86,
+	0xa8, 0x45, 0xaf, 0x21, 0xc1, 0x16, 0x1b, 0xd2, 0xe3, 0x3a, 0x1f, 0x31, 0x93, 0xbe, 0x6b, 0x9c,
+	0x89, 0xfd, 0xb0, 0xd3, 0x36, 0xd3, 0x19, 0xc3, 0xd6, 0x19, 0x9f, 0xd3, 0x50, 0xb2, 0xc3, 0x36,
+	0xf7, 0xb8, 0x8f, 0xe2, 0x71, 0x18, 0xe6, 0xb9, 0xb2, 0xc6, 0xbc, 0xee, 0x36, 0xd2, 0xbd, 0x1c,
+	0xe6, 0xb1, 0x95, 0xf5, 0x52, 0xb6, 0xb5, 0x98, 0xf2, 0x45, 0x37, 0xc6, 0x5e, 0x3f, 0xc4, 0xbe,
+	0x4a, 0x84, 0x38, 0x13, 0x5f, 0x81, 0xdd, 0xd5, 0xc8, 0x5e, 0xfe, 0x76, 0x9e, 0xff, 0xf9, 0xca,
+	0x5b, 0x4e, 0x31, 0xfc, 0x5b, 0x08, 0x06, 0xe2, 0x54, 0xbc, 0x0c, 0x60, 0xc7, 0x38, 0x61, 0xfc,
+	0x8e, 0x1e, 0xaa, 0x97, 0x67, 0xd3, 0xe1, 0x0f, 0x3f, 0x4e, 0xc9, 0xd2, 0x7b, 0x9f, 0xfc, 0xf9,
+	0xf7, 0x57, 0x81, 0xb7, 0xc4, 0x37, 0x15, 0x4c, 0x57, 0xfc, 0xa2, 0x2b, 0x67, 0xea, 0x66, 0x4e,
+	0x5e, 0xf9, 0xbc, 0xa0, 0x70, 0xc5, 0x5f, 0x01, 0xb0, 0x23, 0xd5, 0x8c, 0x2b, 0xb5, 0x7e, 0xae,
+	0x38, 0xe7, 0x7a, 0x27, 0xbc, 0x4e, 0xae, 0x11, 0x10, 0x15, 0xcf, 0x42, 0xb8, 0x97, 0x68, 0x84,
+	0x11, 0x0e, 0xd7, 0xe2, 0x5d, 0x11, 0xde, 0xd2, 0x70, 0xa2, 0x63, 0xee, 0xe7, 0x81, 0x24, 0x73,
+	0xa0, 0x48, 0x74, 0xc7, 0xe3, 0x80, 0xfc, 0xc6, 0x7c, 0x09, 0xe0, 0x73, 0xfe, 0x81, 0x79, 0x13,
+	0xdc, 0x2a, 0xc0, 0xf6, 0xc7, 0xb4, 0x86, 0x47, 0x93, 0x5e, 0xe7, 0x38, 0xb2, 0xb8, 0xb3, 0x35,
+	0x1c, 0x85, 0xba, 0x5e, 0xc3, 0xdf, 0x74, 0xc2, 0xb6, 0xb8, 0x69, 0xc6, 0xa9, 0x38, 0x05, 0xbb,
+	0x52, 0xf6, 0x0c, 0xcd, 0x58, 0xea, 0x0c, 0x69, 0x19, 0xed, 0xe5, 0x35, 0xec, 0x8e, 0x98, 0xbb,
+	0x80, 0xf8, 0x3b, 0x80, 0xbd, 0x15, 0xad, 0x1f, 0xb6, 0x89, 0x4d, 0x0e, 0xd9, 0x74, 0x4e, 0x6c,
+	0xa8, 0x68, 0x85, 0x49, 0x45, 0x12, 0xcd, 0x1a, 0x7f, 0x8a, 0x57, 0x6a, 0x49, 0x85, 0xc6, 0x4a,
+	0x6b, 0x9f, 0x4d, 0xab, 0x08, 0xa1, 0x51, 0x18, 0x9e, 0x69, 0xa3, 0x5f, 0x75, 0xb9, 0xa0, 0xb8,
+	0xb3, 0xa7, 0x98, 0x36, 0x9d, 0x73, 0x05, 0xf4, 0x07, 0x80, 0x9b, 0xeb, 0x50, 0x4d, 0x0d, 0x67,
+	0xc8, 0xbf, 0x2c, 0xe8, 0x0c, 0x2f, 0xc8, 0x96, 0xcc, 0x67, 0x56, 0x90, 0xe5, 0x71, 0xbb, 0x35,
+	0xfd, 0x58, 0x7f, 0x42, 0x13, 0x2a, 0x65, 0x8d, 0x05, 0x8d, 0xe9, 0xd9, 0xbd, 0x3c, 0x48, 0xab,
+	0xca, 0xac, 0xc4, 0xa4, 0x52, 0x92, 0x97, 0x37, 0x21, 0x1e, 0x78, 0xf2, 0xc9, 0xad, 0xd6, 0x53,
+	0x57, 0x80, 0xf8, 0x2d, 0x80, 0x2f, 0x8c, 0x13, 0x36, 0x79, 0x78, 0x6a, 0x6a, 0x8f, 0xa1, 0xeb,
+	0x24, 0xc3, 0x95, 0xa9, 0xcf, 0x1a, 0x2d, 0x4b, 0x57, 0x6a, 0xf8, 0x8e, 0x6b, 0x88, 0xd5, 0xfa,
+	0x5d, 0xb8, 0xc0, 0xbf, 0xa2, 0x63, 0x99, 0xaa, 0x7b, 0x4c, 0x75, 0x59, 0x7e, 0x05, 0xb0, 0x3b,
+	0xa5, 0x16, 0x6c, 0x0d, 0x33, 0x72, 0xc4, 0xe4, 0xb7, 0xc0, 0xda, 0x13, 0xd3, 0x54, 0x22, 0xa7,
+	0x39, 0x09, 0x93, 0x8c, 0x67, 0x21, 0x11, 0xdb, 0x54, 0xa8, 0x4f, 0x3d, 0x02, 0xa2, 0xc3, 0xdf,
+	0x77, 0xc0, 0x4d, 0x71, 0x5a, 0x15, 0x40, 0x92, 0xe4, 0x54, 0xca, 0xac, 0x92, 0xf8, 0x03, 0x80,
+	0xc1, 0x71, 0xc2, 0xc4, 0x6d, 0xab, 0xfc, 0xfa, 0x2c, 0xb3, 0xf6, 0xb4, 0xdf, 0xdf, 0x54, 0x50,
+	0x52, 0x9e, 0xd7, 0x46, 0xc4, 0xcc, 0x33, 0xa8, 0x4d, 0x3c, 0x1f, 0x80, 0xc1, 0xd4, 0x6a, 0xd0,
+	0xa9, 0x27, 0x83, 0xfe, 0x19, 0x70, 0xea, 0x9f, 0x40, 0x78, 0x4d, 0x6c, 0x79, 0x9d, 0xd8, 0xf2,
+	0x4a, 0xec, 0x11, 0x10, 0x9d, 0x9e, 0x94, 0x3e, 0x78, 0x5a, 0x99, 0xdc, 0xb9, 0xbf, 0x0c, 0x60,
+	0xbb, 0xf7, 0x6b, 0xd8, 0xe2, 0xb0, 0x37, 0x93, 0xe6, 0x24, 0x6f, 0xc4, 0x78, 0x74, 0xec, 0xa9,
+	0x8c, 0xb7, 0x78, 0x15, 0xc0, 0xf6, 0xb1, 0x53, 0xa6, 0x61, 0x31, 0xf1, 0xd5, 0x06, 0x2e, 0xfe,
+	0xbe, 0x4a, 0x47, 0x2b, 0xe7, 0x34, 0xd8, 0xb4, 0x80, 0xb8, 0x95, 0x99, 0x53, 0x8b, 0x44, 0x3a,
+	0xc8, 0x21, 0xc7, 0xa4, 0xf7, 0xd7, 0x0f, 0x49, 0x78, 0x72, 0xb7, 0x75, 0x5f, 0x03, 0xd8, 0xbe,
+	0xbf, 0xb0, 0x3a, 0xa2, 0xf7, 0xbe, 0x11, 0x31, 0xdc, 0x14, 0x91, 0x3e, 0x0d, 0x38, 0xb5, 0xe0,
+	0xc3, 0x25, 0xbe, 0x03, 0xd3, 0x4a, 0xce, 0x90, 0xd9, 0x1c, 0x61, 0x73, 0xaa, 0x9e, 0xa3, 0xb2,
+	0xff, 0xd9, 0xac, 0xac, 0xfc, 0xe7, 0x5f, 0xdc, 0xad, 0x98, 0xf9, 0x9c, 0xc2, 0x98, 0x6e, 0xce,
+	0xdc, 0x5e, 0x42, 0xe0, 0xce, 0x12, 0x02, 0x77, 0x97, 0x90, 0x70, 0x6f, 0x09, 0x09, 0xf7, 0x97,
+	0x90, 0xf0, 0x60, 0x09, 0x09, 0x0f, 0x97, 0x10, 0x38, 0xe7, 0x20, 0x70, 0xc1, 0x41, 0xc2, 0x35,
+	0x07, 0x81, 0xeb, 0x0e, 0x12, 0x6e, 0x38, 0x48, 0xb8, 0xe9, 0x20, 0xe1, 0xb6, 0x83, 0xc0, 0x1d,
+	0x07, 0x81, 0xbb, 0x0e, 0x12, 0xee, 0x39, 0x08, 0xdc, 0x77, 0x90, 0xf0, 0xc0, 0x41, 0xe0, 0xa1,
+	0x83, 0x84, 0x73, 0x65, 0x24, 0x5c, 0x28, 0x23, 0x70, 0xa9, 0x8c, 0x84, 0x2b, 0x65, 0x04, 0xae,
+	0x96, 0x91, 0x70, 0xad, 0x8c, 0x84, 0xeb, 0x65, 0x04, 0x6e, 0x94, 0x11, 0xb8, 0x59, 0x46, 0x60,
+	0xa6, 0x9d, 0xcb, 0x68, 0xf7, 0x3f, 0x03, 0x00, 0x0b, 0x94, 0x04, 0x7c, 0x48, 0x12, 0x00, 0x00,
 }
 
 func (this *ApplicationLink) Equal(that interface{}) bool {
@@ -1082,6 +1085,13 @@ type AsEndDeviceRegistryClient interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Export returns an archive of the stored state of the given end devices,
+	// including their MAC state, sessions and queues.
+	Export(ctx context.Context, in *ExportEndDevicesRequest, opts ...grpc.CallOption) (*EndDeviceArchive, error)
+	// Import restores the end devices in the given archive.
+	// Existing end devices are replaced by the archived ones.
+	// The identifiers of the imported end devices are returned.
+	Import(ctx context.Context, in *ImportEndDevicesRequest, opts ...grpc.CallOption) (*EndDevices, error)
 }
 
 type asEndDeviceRegistryClient struct {
@@ -1119,6 +1129,24 @@ func (c *asEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIde
 	return out, nil
 }
 
+func (c *asEndDeviceRegistryClient) Export(ctx context.Context, in *ExportEndDevicesRequest, opts ...grpc.CallOption) (*EndDeviceArchive, error) {
+	out := new(EndDeviceArchive)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsEndDeviceRegistry/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asEndDeviceRegistryClient) Import(ctx context.Context, in *ImportEndDevicesRequest, opts ...grpc.CallOption) (*EndDevices, error) {
+	out := new(EndDevices)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsEndDeviceRegistry/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AsEndDeviceRegistryServer is the server API for AsEndDeviceRegistry service.
 type AsEndDeviceRegistryServer interface {
 	// Get returns the device that matches the given identifiers.
@@ -1129,6 +1157,13 @@ type AsEndDeviceRegistryServer interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// Export returns an archive of the stored state of the given end devices,
+	// including their MAC state, sessions and queues.
+	Export(context.Context, *ExportEndDevicesRequest) (*EndDeviceArchive, error)
+	// Import restores the end devices in the given archive.
+	// Existing end devices are replaced by the archived ones.
+	// The identifiers of the imported end devices are returned.
+	Import(context.Context, *ImportEndDevicesRequest) (*EndDevices, error)
 }
 
 // UnimplementedAsEndDeviceRegistryServer can be embedded to have forward compatible implementations.
@@ -1144,6 +1179,12 @@ func (*UnimplementedAsEndDeviceRegistryServer) Set(ctx context.Context, req *Set
 func (*UnimplementedAsEndDeviceRegistryServer) Delete(ctx context.Context, req *EndDeviceIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedAsEndDeviceRegistryServer) Export(ctx context.Context, req *ExportEndDevicesRequest) (*EndDeviceArchive, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedAsEndDeviceRegistryServer) Import(ctx context.Context, req *ImportEndDevicesRequest) (*EndDevices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
 
 func RegisterAsEndDeviceRegistryServer(s *grpc.Server, srv AsEndDeviceRegistryServer) {
 	s.RegisterService(&_AsEndDeviceRegistry_serviceDesc, srv)
@@ -1203,6 +1244,42 @@ func _AsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AsEndDeviceRegistry_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsEndDeviceRegistryServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsEndDeviceRegistry/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsEndDeviceRegistryServer).Export(ctx, req.(*ExportEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AsEndDeviceRegistry_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsEndDeviceRegistryServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsEndDeviceRegistry/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsEndDeviceRegistryServer).Import(ctx, req.(*ImportEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.AsEndDeviceRegistry",
 	HandlerType: (*AsEndDeviceRegistryServer)(nil),
@@ -1219,6 +1296,14 @@ var _AsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _AsEndDeviceRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _AsEndDeviceRegistry_Export_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _AsEndDeviceRegistry_Import_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/applicationserver.proto",
@@ -18,6 +18,12 @@ import "context"
 
 // ValidateContext wraps the generated validator with (optionally context-based) custom checks.
 func (m *GetStoredApplicationUpRequest) ValidateContext(context.Context) error {
+	switch {
+	case m.ApplicationIDs == nil && m.EndDeviceIDs == nil:
+		return errMissingIdentifiers.New()
+	case m.ApplicationIDs != nil && m.EndDeviceIDs != nil:
+		return errIdentifiers.New()
+	}
 	return m.ValidateFields()
 }
 
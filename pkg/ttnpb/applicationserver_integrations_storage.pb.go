@@ -54,9 +54,11 @@ type GetStoredApplicationUpRequest struct {
 	// Query uplinks on a specific FPort only.
 	FPort *types.UInt32Value `protobuf:"bytes,7,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
 	// Order results.
-	Order                string   `protobuf:"bytes,8,opt,name=order,proto3" json:"order,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Order string `protobuf:"bytes,8,opt,name=order,proto3" json:"order,omitempty"`
+	// The names of the upstream message fields that should be returned.
+	FieldMask            types.FieldMask `protobuf:"bytes,9,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *GetStoredApplicationUpRequest) Reset()      { *m = GetStoredApplicationUpRequest{} }
@@ -147,6 +149,13 @@ func (m *GetStoredApplicationUpRequest) GetOrder() string {
 	return ""
 }
 
+func (m *GetStoredApplicationUpRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 func init() {
 	proto.RegisterType((*GetStoredApplicationUpRequest)(nil), "ttn.lorawan.v3.GetStoredApplicationUpRequest")
 	golang_proto.RegisterType((*GetStoredApplicationUpRequest)(nil), "ttn.lorawan.v3.GetStoredApplicationUpRequest")
@@ -160,59 +169,61 @@ func init() {
 }
 
 var fileDescriptor_6ff0e9f52f73d254 = []byte{
-	// 822 bytes of a gzipped FileDescriptorProto
+	// 862 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3d, 0x6c, 0x1c, 0x45,
-	0x14, 0x9e, 0x31, 0x39, 0x13, 0xd6, 0xc7, 0x59, 0x5a, 0x45, 0xd1, 0xe9, 0x14, 0xcf, 0x59, 0x06,
-	0xa1, 0x34, 0xb7, 0x8b, 0x7c, 0x0d, 0x42, 0x08, 0x29, 0x27, 0x23, 0x64, 0xd1, 0xa0, 0x09, 0xa6,
-	0x48, 0xb3, 0xcc, 0xed, 0xbe, 0x5b, 0x0f, 0xb7, 0x37, 0x33, 0x99, 0x99, 0x3b, 0x63, 0x59, 0x96,
-	0x22, 0xaa, 0x94, 0x91, 0x68, 0x28, 0x69, 0x90, 0x2c, 0x51, 0x90, 0x32, 0x05, 0x45, 0x0a, 0x0a,
-	0x8b, 0x02, 0x05, 0x21, 0xa4, 0x54, 0x21, 0xb7, 0x4b, 0x91, 0x32, 0x65, 0x94, 0x0a, 0xdd, 0xee,
-	0xda, 0xf7, 0x07, 0x86, 0x74, 0xf3, 0xe6, 0x7d, 0xdf, 0x7b, 0x4f, 0xdf, 0x7c, 0xf3, 0x9c, 0x0f,
-	0x13, 0xa9, 0xd9, 0x01, 0x13, 0x2d, 0x63, 0x59, 0xd8, 0xf7, 0x99, 0xe2, 0x3e, 0x53, 0x2a, 0xe1,
-	0x21, 0xb3, 0x5c, 0x0a, 0x03, 0x7a, 0x04, 0x3a, 0xe0, 0xc2, 0x42, 0xac, 0x8b, 0x9b, 0xc0, 0x58,
-	0xa9, 0x59, 0x0c, 0x9e, 0xd2, 0xd2, 0x4a, 0xb7, 0x66, 0xad, 0xf0, 0xca, 0x1a, 0xde, 0xa8, 0xdd,
-	0xb8, 0x11, 0x73, 0xbb, 0x3f, 0xec, 0x7a, 0xa1, 0x1c, 0xf8, 0x20, 0x46, 0xf2, 0x50, 0x69, 0xf9,
-	0xd5, 0xa1, 0x9f, 0x83, 0xc3, 0x56, 0x0c, 0xa2, 0x35, 0x62, 0x09, 0x8f, 0x98, 0x05, 0x7f, 0xe9,
-	0x50, 0x94, 0x6c, 0xb4, 0x66, 0x4a, 0xc4, 0x32, 0x96, 0x05, 0xb9, 0x3b, 0xec, 0xe5, 0x51, 0x1e,
-	0xe4, 0xa7, 0x12, 0x7e, 0x2d, 0x96, 0x32, 0x4e, 0xa0, 0x18, 0x5d, 0x08, 0x69, 0x8b, 0x39, 0xcb,
-	0x6c, 0xb3, 0xcc, 0x9e, 0xd7, 0xb0, 0x7c, 0x00, 0xc6, 0xb2, 0x81, 0x2a, 0x01, 0x64, 0x11, 0x70,
-	0xa0, 0x99, 0x52, 0xa0, 0xcf, 0x0a, 0xbc, 0xb5, 0x2c, 0x10, 0x8f, 0x40, 0x58, 0xde, 0xe3, 0x53,
-	0xd0, 0xe6, 0x32, 0x68, 0x00, 0xc6, 0xb0, 0x18, 0x4a, 0xc4, 0xd6, 0xaf, 0x15, 0x67, 0xe3, 0x63,
-	0xb0, 0x37, 0xad, 0xd4, 0x10, 0xdd, 0x98, 0x2a, 0xbc, 0xa7, 0x28, 0xdc, 0x1e, 0x82, 0xb1, 0x6e,
-	0xec, 0xac, 0xcf, 0x28, 0x1f, 0xf0, 0xc8, 0xd4, 0xf1, 0x26, 0xbe, 0xbe, 0xb6, 0xfd, 0x8e, 0x37,
-	0xaf, 0xb1, 0x37, 0x43, 0xdf, 0x9d, 0x8e, 0xd2, 0xb9, 0x7a, 0xfa, 0xa4, 0x89, 0xd3, 0x27, 0xcd,
-	0xda, 0x6c, 0x7e, 0xc7, 0xd0, 0x1a, 0x9b, 0xc5, 0x1b, 0xf7, 0x0b, 0xa7, 0x06, 0x22, 0x0a, 0x22,
-	0x18, 0xf1, 0x10, 0xf2, 0x3e, 0x2b, 0x79, 0x9f, 0xb7, 0x17, 0xfb, 0x7c, 0x24, 0xa2, 0x9d, 0x1c,
-	0x34, 0xdb, 0xe5, 0x4a, 0xd9, 0xa5, 0x3a, 0xcd, 0xee, 0x18, 0x5a, 0x85, 0x29, 0xd6, 0xb8, 0x3f,
-	0x63, 0xe7, 0x92, 0x3d, 0x54, 0x50, 0x7f, 0x6d, 0x13, 0x5f, 0x7f, 0xa3, 0xf3, 0x23, 0x7e, 0xd9,
-	0xf9, 0x01, 0xeb, 0x13, 0x4c, 0x11, 0xad, 0x0d, 0x55, 0xc2, 0x45, 0x3f, 0x28, 0xe5, 0xa1, 0x6b,
-	0x5f, 0x4a, 0x2e, 0x02, 0x16, 0x86, 0xa0, 0x2c, 0xad, 0x46, 0xf2, 0x40, 0xe4, 0x69, 0x16, 0xf6,
-	0xe9, 0x9b, 0xe7, 0x91, 0x98, 0x0f, 0x0d, 0x08, 0x4b, 0xd7, 0xcf, 0xc3, 0x1e, 0xe3, 0x09, 0x44,
-	0x33, 0x17, 0xb7, 0x87, 0x30, 0x84, 0x88, 0x36, 0xe6, 0x2f, 0x02, 0x2e, 0xce, 0x8c, 0x16, 0xd1,
-	0xf5, 0x44, 0x96, 0x3a, 0x1b, 0x99, 0x8c, 0x20, 0xa2, 0xd5, 0x89, 0xd5, 0x27, 0x7a, 0x44, 0xcc,
-	0x32, 0x9a, 0x4f, 0xef, 0x6e, 0x3b, 0x95, 0x84, 0x0f, 0xb8, 0xad, 0x5f, 0xca, 0xf5, 0xb9, 0xe6,
-	0x15, 0x56, 0xf1, 0xce, 0xac, 0xe2, 0xed, 0xed, 0x0a, 0xdb, 0xde, 0xfe, 0x9c, 0x25, 0x43, 0xa0,
-	0x05, 0xd4, 0x7d, 0xdf, 0xa9, 0xb0, 0x9e, 0x05, 0x5d, 0xaf, 0xe4, 0x9c, 0xc6, 0x12, 0xe7, 0xb3,
-	0x33, 0xff, 0x75, 0x2e, 0x4f, 0x94, 0xbc, 0xf7, 0x67, 0x13, 0xd3, 0x82, 0xe2, 0x7e, 0xe0, 0xac,
-	0x76, 0xa1, 0x27, 0x35, 0xd4, 0x57, 0x5f, 0x81, 0x5c, 0x72, 0xdc, 0xb6, 0xb3, 0xda, 0x0b, 0x94,
-	0xd4, 0xb6, 0xfe, 0xfa, 0xff, 0x19, 0xb7, 0xf7, 0xa9, 0xd4, 0xd6, 0x7d, 0xcf, 0xa9, 0x48, 0x1d,
-	0x81, 0xae, 0x5f, 0xce, 0x5f, 0x6a, 0xeb, 0x65, 0xa7, 0xa9, 0x37, 0x28, 0xa2, 0xd5, 0x96, 0x86,
-	0x10, 0xf8, 0x08, 0xa2, 0x80, 0x59, 0xba, 0x36, 0x1b, 0x14, 0x84, 0xed, 0x3f, 0x56, 0x9c, 0x2b,
-	0x73, 0x3e, 0xbe, 0x59, 0xec, 0x05, 0xf7, 0xa7, 0x15, 0xe7, 0xea, 0x3f, 0x3b, 0xdd, 0x6d, 0x2d,
-	0x3a, 0xec, 0xc2, 0x1f, 0xd1, 0xd8, 0xb8, 0xc0, 0xf8, 0x7b, 0x6a, 0xeb, 0x37, 0xfc, 0xf5, 0xef,
-	0x7f, 0x7d, 0xb3, 0xf2, 0x0b, 0x76, 0x8f, 0x7c, 0x66, 0xe6, 0xd6, 0x96, 0x7f, 0x34, 0xef, 0x70,
-	0x6f, 0xe1, 0x67, 0x2d, 0xc4, 0xc7, 0x7e, 0x01, 0x5d, 0xe6, 0x9d, 0x1f, 0x8f, 0x7d, 0xc5, 0xc2,
-	0xfe, 0xe4, 0x43, 0xfb, 0xe5, 0x02, 0xf4, 0x8f, 0x26, 0x26, 0x39, 0xbe, 0xf5, 0x89, 0xbb, 0xbb,
-	0xdc, 0xfe, 0xbf, 0xfa, 0xfd, 0x4b, 0xb1, 0x77, 0x71, 0xe7, 0x7b, 0x7c, 0x3a, 0x26, 0xf8, 0xd1,
-	0x98, 0xe0, 0xc7, 0x63, 0x82, 0x9e, 0x8e, 0x09, 0x7a, 0x36, 0x26, 0xe8, 0xf9, 0x98, 0xa0, 0x17,
-	0x63, 0x82, 0xef, 0xa4, 0x04, 0xdf, 0x4d, 0x09, 0x3a, 0x49, 0x09, 0xbe, 0x9f, 0x12, 0xf4, 0x20,
-	0x25, 0xe8, 0x61, 0x4a, 0xd0, 0x69, 0x4a, 0xf0, 0xa3, 0x94, 0xe0, 0xc7, 0x29, 0x41, 0x4f, 0x53,
-	0x82, 0x9f, 0xa5, 0x04, 0x3d, 0x4f, 0x09, 0x7e, 0x91, 0x12, 0x74, 0x27, 0x23, 0xe8, 0x6e, 0x46,
-	0xf0, 0xbd, 0x8c, 0xa0, 0x6f, 0x33, 0x82, 0xbf, 0xcb, 0x08, 0x3a, 0xc9, 0x08, 0xba, 0x9f, 0x11,
-	0xfc, 0x20, 0x23, 0xf8, 0x61, 0x46, 0xf0, 0x2d, 0x3f, 0x96, 0x9e, 0xdd, 0x07, 0xbb, 0xcf, 0x45,
-	0x6c, 0x3c, 0x01, 0xf6, 0x40, 0xea, 0xbe, 0x3f, 0xbf, 0xd6, 0x46, 0x6d, 0x5f, 0xf5, 0x63, 0xdf,
-	0x5a, 0xa1, 0xba, 0xdd, 0xd5, 0xdc, 0x56, 0xed, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xed, 0x0e,
-	0x9f, 0x74, 0x41, 0x06, 0x00, 0x00,
+	0x14, 0x9e, 0x31, 0xb6, 0x89, 0xd7, 0xc7, 0x59, 0x5a, 0x45, 0xd1, 0xe9, 0x14, 0xcf, 0x59, 0x06,
+	0xa1, 0x34, 0xb7, 0x8b, 0x7c, 0x0d, 0x42, 0x08, 0x94, 0x93, 0x01, 0x59, 0x08, 0x09, 0x4d, 0x30,
+	0x45, 0x9a, 0x65, 0x6e, 0xf7, 0xed, 0x7a, 0xb8, 0xbd, 0x99, 0xcd, 0xcc, 0xdc, 0x19, 0xcb, 0xb2,
+	0x14, 0x51, 0xa5, 0x23, 0x12, 0x0d, 0x25, 0x0d, 0x92, 0x25, 0x0a, 0x52, 0xa6, 0xa0, 0x48, 0x41,
+	0x61, 0x51, 0x05, 0x21, 0xa4, 0x54, 0x26, 0xb7, 0x4b, 0x91, 0x32, 0x65, 0x94, 0x0a, 0xdd, 0xee,
+	0x9e, 0xef, 0x8f, 0x04, 0xe8, 0xe6, 0xcd, 0xfb, 0xbe, 0xf7, 0x9e, 0xde, 0x7b, 0xdf, 0xb3, 0xde,
+	0x8b, 0xa5, 0x62, 0x87, 0x4c, 0x34, 0xb5, 0x61, 0x7e, 0xd7, 0x65, 0x09, 0x77, 0x59, 0x92, 0xc4,
+	0xdc, 0x67, 0x86, 0x4b, 0xa1, 0x41, 0x0d, 0x40, 0x79, 0x5c, 0x18, 0x88, 0x54, 0xf1, 0xe3, 0x69,
+	0x23, 0x15, 0x8b, 0xc0, 0x49, 0x94, 0x34, 0xd2, 0xae, 0x1a, 0x23, 0x9c, 0x32, 0x86, 0x33, 0x68,
+	0xd5, 0xaf, 0x47, 0xdc, 0x1c, 0xf4, 0x3b, 0x8e, 0x2f, 0x7b, 0x2e, 0x88, 0x81, 0x3c, 0x4a, 0x94,
+	0xfc, 0xea, 0xc8, 0xcd, 0xc1, 0x7e, 0x33, 0x02, 0xd1, 0x1c, 0xb0, 0x98, 0x07, 0xcc, 0x80, 0xbb,
+	0xf0, 0x28, 0x42, 0xd6, 0x9b, 0x53, 0x21, 0x22, 0x19, 0xc9, 0x82, 0xdc, 0xe9, 0x87, 0xb9, 0x95,
+	0x1b, 0xf9, 0xab, 0x84, 0x5f, 0x8d, 0xa4, 0x8c, 0x62, 0x28, 0x4a, 0x17, 0x42, 0x9a, 0xa2, 0xce,
+	0xd2, 0xbb, 0x55, 0x7a, 0x2f, 0x62, 0x84, 0x1c, 0xe2, 0xc0, 0xeb, 0x31, 0xdd, 0x2d, 0x11, 0x8d,
+	0x79, 0x84, 0xe1, 0x3d, 0xd0, 0x86, 0xf5, 0x92, 0x12, 0x40, 0xe6, 0x01, 0x87, 0x8a, 0x25, 0x09,
+	0xa8, 0x71, 0x8a, 0xd7, 0x17, 0x5b, 0xc8, 0x03, 0x10, 0x86, 0x87, 0x7c, 0x02, 0xda, 0x5a, 0x04,
+	0xf5, 0x40, 0x6b, 0x16, 0x41, 0x89, 0xd8, 0xfe, 0x66, 0xd5, 0xda, 0xfc, 0x08, 0xcc, 0x0d, 0x23,
+	0x15, 0x04, 0xd7, 0x27, 0x33, 0xd8, 0x4f, 0x28, 0xdc, 0xea, 0x83, 0x36, 0x76, 0x64, 0x6d, 0x4c,
+	0xcd, 0xc6, 0xe3, 0x81, 0xae, 0xe1, 0x2d, 0x7c, 0x6d, 0x7d, 0xe7, 0x4d, 0x67, 0x76, 0x0a, 0xce,
+	0x14, 0x7d, 0x6f, 0x52, 0x4a, 0xfb, 0xca, 0xd9, 0x79, 0x03, 0xa7, 0xe7, 0x8d, 0xea, 0xb4, 0x7f,
+	0x57, 0xd3, 0x2a, 0x9b, 0xc6, 0x6b, 0xfb, 0x0b, 0xab, 0x0a, 0x22, 0xf0, 0x02, 0x18, 0x70, 0x1f,
+	0xf2, 0x3c, 0x4b, 0x79, 0x9e, 0x37, 0xe6, 0xf3, 0x7c, 0x20, 0x82, 0xdd, 0x1c, 0x34, 0x9d, 0xe5,
+	0x72, 0x99, 0xa5, 0x32, 0xf1, 0xee, 0x6a, 0x5a, 0x81, 0x09, 0x56, 0xdb, 0xbf, 0x60, 0x6b, 0xd9,
+	0x1c, 0x25, 0x50, 0x7b, 0x65, 0x0b, 0x5f, 0x5b, 0x6b, 0xff, 0x84, 0x9f, 0xb7, 0x7f, 0xc4, 0xea,
+	0x14, 0x53, 0x44, 0xab, 0xfd, 0x24, 0xe6, 0xa2, 0xeb, 0x95, 0xed, 0xa1, 0xeb, 0x5f, 0x4a, 0x2e,
+	0x3c, 0xe6, 0xfb, 0x90, 0x18, 0x5a, 0x09, 0xe4, 0xa1, 0xc8, 0xdd, 0xcc, 0xef, 0xd2, 0xd7, 0x2e,
+	0x2c, 0x31, 0x6b, 0x6a, 0x10, 0x86, 0x6e, 0x5c, 0x98, 0x21, 0xe3, 0x31, 0x04, 0x53, 0x1f, 0xb7,
+	0xfa, 0xd0, 0x87, 0x80, 0xd6, 0x67, 0x3f, 0x3c, 0x2e, 0xc6, 0xab, 0x18, 0xd0, 0x8d, 0x58, 0x96,
+	0x7d, 0xd6, 0x32, 0x1e, 0x40, 0x40, 0x2b, 0x23, 0x31, 0x8c, 0xfa, 0x11, 0x30, 0xc3, 0x68, 0x5e,
+	0xbd, 0xbd, 0x63, 0xad, 0xc4, 0xbc, 0xc7, 0x4d, 0x6d, 0x39, 0xef, 0xcf, 0x55, 0xa7, 0x58, 0x15,
+	0x67, 0xbc, 0x2a, 0xce, 0xfe, 0x9e, 0x30, 0xad, 0x9d, 0xcf, 0x59, 0xdc, 0x07, 0x5a, 0x40, 0xed,
+	0x77, 0xac, 0x15, 0x16, 0x1a, 0x50, 0xb5, 0x95, 0x9c, 0x53, 0x5f, 0xe0, 0x7c, 0x36, 0xde, 0xbf,
+	0xf6, 0xa5, 0x51, 0x27, 0xef, 0xfe, 0xd9, 0xc0, 0xb4, 0xa0, 0xd8, 0xef, 0x5a, 0xab, 0x1d, 0x08,
+	0xa5, 0x82, 0xda, 0xea, 0xff, 0x20, 0x97, 0x1c, 0xbb, 0x65, 0xad, 0x86, 0x5e, 0x22, 0x95, 0xa9,
+	0xbd, 0xfa, 0x5f, 0xca, 0x0d, 0x3f, 0x95, 0xca, 0xd8, 0x6f, 0x5b, 0x2b, 0x52, 0x05, 0xa0, 0x6a,
+	0x97, 0xf2, 0x49, 0x6d, 0x3f, 0x6f, 0x37, 0xd4, 0x26, 0x45, 0xb4, 0xd2, 0x54, 0xe0, 0x03, 0x1f,
+	0x40, 0xe0, 0x31, 0x43, 0xd7, 0xa7, 0x8d, 0x82, 0x60, 0xbf, 0x6f, 0x59, 0x13, 0xb1, 0xd5, 0xd6,
+	0x5e, 0x50, 0xf0, 0x87, 0x23, 0xc8, 0x27, 0x4c, 0x77, 0xdb, 0xcb, 0x67, 0xe7, 0x0d, 0x44, 0xd7,
+	0xc2, 0xf1, 0xc7, 0xce, 0x1f, 0x4b, 0xd6, 0xe5, 0x19, 0x21, 0xdc, 0x28, 0x4e, 0x8f, 0xfd, 0xf3,
+	0x92, 0x75, 0xe5, 0x9f, 0xa5, 0x62, 0x37, 0xe7, 0x57, 0xf4, 0xa5, 0x92, 0xaa, 0x6f, 0xbe, 0x44,
+	0x39, 0xfb, 0xc9, 0xf6, 0x6f, 0xf8, 0xeb, 0xdf, 0xff, 0xfa, 0x76, 0xe9, 0x57, 0x6c, 0x1f, 0xbb,
+	0x4c, 0xcf, 0x5c, 0x46, 0xf7, 0x78, 0x56, 0x22, 0xce, 0x9c, 0x34, 0xe7, 0xec, 0x13, 0xb7, 0x80,
+	0x2e, 0xf2, 0x2e, 0x9e, 0x27, 0x6e, 0xc2, 0xfc, 0xee, 0xe8, 0x22, 0xb8, 0xe5, 0x8d, 0x75, 0x8f,
+	0x47, 0x5b, 0x76, 0x72, 0xf3, 0x63, 0x7b, 0x6f, 0x31, 0xfd, 0xbf, 0xe5, 0x7b, 0x41, 0xb0, 0xb7,
+	0x70, 0xfb, 0x07, 0x7c, 0x36, 0x24, 0xf8, 0xe1, 0x90, 0xe0, 0x47, 0x43, 0x82, 0x1e, 0x0f, 0x09,
+	0x7a, 0x32, 0x24, 0xe8, 0xe9, 0x90, 0xa0, 0x67, 0x43, 0x82, 0x6f, 0xa7, 0x04, 0xdf, 0x49, 0x09,
+	0x3a, 0x4d, 0x09, 0xbe, 0x97, 0x12, 0x74, 0x3f, 0x25, 0xe8, 0x41, 0x4a, 0xd0, 0x59, 0x4a, 0xf0,
+	0xc3, 0x94, 0xe0, 0x47, 0x29, 0x41, 0x8f, 0x53, 0x82, 0x9f, 0xa4, 0x04, 0x3d, 0x4d, 0x09, 0x7e,
+	0x96, 0x12, 0x74, 0x3b, 0x23, 0xe8, 0x4e, 0x46, 0xf0, 0xdd, 0x8c, 0xa0, 0xef, 0x32, 0x82, 0xbf,
+	0xcf, 0x08, 0x3a, 0xcd, 0x08, 0xba, 0x97, 0x11, 0x7c, 0x3f, 0x23, 0xf8, 0x41, 0x46, 0xf0, 0x4d,
+	0x37, 0x92, 0x8e, 0x39, 0x00, 0x73, 0xc0, 0x45, 0xa4, 0x1d, 0x01, 0xe6, 0x50, 0xaa, 0xae, 0x3b,
+	0x7b, 0x17, 0x07, 0x2d, 0x37, 0xe9, 0x46, 0xae, 0x31, 0x22, 0xe9, 0x74, 0x56, 0xf3, 0x25, 0x69,
+	0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x91, 0xe5, 0x01, 0x0e, 0xa4, 0x06, 0x00, 0x00,
 }
 
 func (this *GetStoredApplicationUpRequest) Equal(that interface{}) bool {
@@ -266,6 +277,9 @@ func (this *GetStoredApplicationUpRequest) Equal(that interface{}) bool {
 	if this.Order != that1.Order {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 
@@ -398,6 +412,16 @@ func (m *GetStoredApplicationUpRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if len(m.Order) > 0 {
 		i -= len(m.Order)
 		copy(dAtA[i:], m.Order)
@@ -418,22 +442,22 @@ func (m *GetStoredApplicationUpRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		dAtA[i] = 0x3a
 	}
 	if m.Before != nil {
-		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Before, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Before):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Before, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Before):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.After != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.After, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.After):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.After, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.After):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -516,6 +540,8 @@ func NewPopulatedGetStoredApplicationUpRequest(r randyApplicationserverIntegrati
 		this.FPort = types.NewPopulatedUInt32Value(r, easy)
 	}
 	this.Order = randStringApplicationserverIntegrationsStorage(r)
+	v1 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v1
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -540,9 +566,9 @@ func randUTF8RuneApplicationserverIntegrationsStorage(r randyApplicationserverIn
 	return rune(ru + 61)
 }
 func randStringApplicationserverIntegrationsStorage(r randyApplicationserverIntegrationsStorage) string {
-	v1 := r.Intn(100)
-	tmps := make([]rune, v1)
-	for i := 0; i < v1; i++ {
+	v2 := r.Intn(100)
+	tmps := make([]rune, v2)
+	for i := 0; i < v2; i++ {
 		tmps[i] = randUTF8RuneApplicationserverIntegrationsStorage(r)
 	}
 	return string(tmps)
@@ -564,11 +590,11 @@ func randFieldApplicationserverIntegrationsStorage(dAtA []byte, r randyApplicati
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplicationserverIntegrationsStorage(dAtA, uint64(key))
-		v2 := r.Int63()
+		v3 := r.Int63()
 		if r.Intn(2) == 0 {
-			v2 *= -1
+			v3 *= -1
 		}
-		dAtA = encodeVarintPopulateApplicationserverIntegrationsStorage(dAtA, uint64(v2))
+		dAtA = encodeVarintPopulateApplicationserverIntegrationsStorage(dAtA, uint64(v3))
 	case 1:
 		dAtA = encodeVarintPopulateApplicationserverIntegrationsStorage(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -631,6 +657,8 @@ func (m *GetStoredApplicationUpRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
 	}
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
 	return n
 }
 
@@ -653,6 +681,7 @@ func (this *GetStoredApplicationUpRequest) String() string {
 		`Before:` + strings.Replace(fmt.Sprintf("%v", this.Before), "Timestamp", "types.Timestamp", 1) + `,`,
 		`FPort:` + strings.Replace(fmt.Sprintf("%v", this.FPort), "UInt32Value", "types.UInt32Value", 1) + `,`,
 		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -974,6 +1003,39 @@ func (m *GetStoredApplicationUpRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Order = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverIntegrationsStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserverIntegrationsStorage(dAtA[iNdEx:])
@@ -15,6 +15,7 @@ var GetStoredApplicationUpRequestFieldPathsNested = []string{
 	"end_device_ids.device_id",
 	"end_device_ids.join_eui",
 	"f_port",
+	"field_mask",
 	"limit",
 	"order",
 	"type",
@@ -26,6 +27,7 @@ var GetStoredApplicationUpRequestFieldPathsTopLevel = []string{
 	"before",
 	"end_device_ids",
 	"f_port",
+	"field_mask",
 	"limit",
 	"order",
 	"type",
@@ -65,6 +65,30 @@ func (ApplicationPubSub_MQTTProvider_QoS) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1dce56ec18597200, []int{1, 1, 0}
 }
 
+type ApplicationPubSub_KafkaProvider_SASL_Mechanism int32
+
+const (
+	ApplicationPubSub_KafkaProvider_SASL_PLAIN         ApplicationPubSub_KafkaProvider_SASL_Mechanism = 0
+	ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_256 ApplicationPubSub_KafkaProvider_SASL_Mechanism = 1
+	ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_512 ApplicationPubSub_KafkaProvider_SASL_Mechanism = 2
+)
+
+var ApplicationPubSub_KafkaProvider_SASL_Mechanism_name = map[int32]string{
+	0: "PLAIN",
+	1: "SCRAM_SHA_256",
+	2: "SCRAM_SHA_512",
+}
+
+var ApplicationPubSub_KafkaProvider_SASL_Mechanism_value = map[string]int32{
+	"PLAIN":         0,
+	"SCRAM_SHA_256": 1,
+	"SCRAM_SHA_512": 2,
+}
+
+func (ApplicationPubSub_KafkaProvider_SASL_Mechanism) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 3, 0, 0}
+}
+
 type ApplicationPubSubIdentifiers struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	PubSubID               string   `protobuf:"bytes,2,opt,name=pub_sub_id,json=pubSubId,proto3" json:"pub_sub_id,omitempty"`
@@ -124,6 +148,7 @@ type ApplicationPubSub struct {
 	//	*ApplicationPubSub_NATS
 	//	*ApplicationPubSub_MQTT
 	//	*ApplicationPubSub_AWSIoT
+	//	*ApplicationPubSub_Kafka
 	Provider isApplicationPubSub_Provider `protobuf_oneof:"provider"`
 	// Base topic name to which the messages topic is appended.
 	BaseTopic string `protobuf:"bytes,6,opt,name=base_topic,json=baseTopic,proto3" json:"base_topic,omitempty"`
@@ -193,10 +218,14 @@ type ApplicationPubSub_MQTT struct {
 type ApplicationPubSub_AWSIoT struct {
 	AWSIoT *ApplicationPubSub_AWSIoTProvider `protobuf:"bytes,101,opt,name=aws_iot,json=awsIot,proto3,oneof" json:"aws_iot,omitempty"`
 }
+type ApplicationPubSub_Kafka struct {
+	Kafka *ApplicationPubSub_KafkaProvider `protobuf:"bytes,20,opt,name=kafka,proto3,oneof" json:"kafka,omitempty"`
+}
 
 func (*ApplicationPubSub_NATS) isApplicationPubSub_Provider()   {}
 func (*ApplicationPubSub_MQTT) isApplicationPubSub_Provider()   {}
 func (*ApplicationPubSub_AWSIoT) isApplicationPubSub_Provider() {}
+func (*ApplicationPubSub_Kafka) isApplicationPubSub_Provider()  {}
 
 func (m *ApplicationPubSub) GetProvider() isApplicationPubSub_Provider {
 	if m != nil {
@@ -247,6 +276,13 @@ func (m *ApplicationPubSub) GetAWSIoT() *ApplicationPubSub_AWSIoTProvider {
 	return nil
 }
 
+func (m *ApplicationPubSub) GetKafka() *ApplicationPubSub_KafkaProvider {
+	if x, ok := m.GetProvider().(*ApplicationPubSub_Kafka); ok {
+		return x.Kafka
+	}
+	return nil
+}
+
 func (m *ApplicationPubSub) GetBaseTopic() string {
 	if m != nil {
 		return m.BaseTopic
@@ -344,6 +380,7 @@ func (*ApplicationPubSub) XXX_OneofWrappers() []interface{} {
 		(*ApplicationPubSub_NATS)(nil),
 		(*ApplicationPubSub_MQTT)(nil),
 		(*ApplicationPubSub_AWSIoT)(nil),
+		(*ApplicationPubSub_Kafka)(nil),
 	}
 }
 
@@ -534,6 +571,11 @@ type ApplicationPubSub_AWSIoTProvider struct {
 	// The endpoint address to connect to. If the endpoint address is left empty,
 	// the integration will try to discover it.
 	EndpointAddress string `protobuf:"bytes,4,opt,name=endpoint_address,json=endpointAddress,proto3" json:"endpoint_address,omitempty"`
+	// The PEM encoded X.509 client certificate to connect to the endpoint.
+	// If set, the integration authenticates with the client certificate instead of with AWS credentials.
+	TLSClientCert []byte `protobuf:"bytes,6,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
+	// The PEM encoded private key of the X.509 client certificate.
+	TLSClientKey []byte `protobuf:"bytes,7,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
 	// Types that are valid to be assigned to Deployment:
 	//	*ApplicationPubSub_AWSIoTProvider_Default
 	Deployment           isApplicationPubSub_AWSIoTProvider_Deployment `protobuf_oneof:"deployment"`
@@ -621,6 +663,20 @@ func (m *ApplicationPubSub_AWSIoTProvider) GetEndpointAddress() string {
 	return ""
 }
 
+func (m *ApplicationPubSub_AWSIoTProvider) GetTLSClientCert() []byte {
+	if m != nil {
+		return m.TLSClientCert
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_AWSIoTProvider) GetTLSClientKey() []byte {
+	if m != nil {
+		return m.TLSClientKey
+	}
+	return nil
+}
+
 func (m *ApplicationPubSub_AWSIoTProvider) GetDefault() *ApplicationPubSub_AWSIoTProvider_DefaultIntegration {
 	if x, ok := m.GetDeployment().(*ApplicationPubSub_AWSIoTProvider_Default); ok {
 		return x.Default
@@ -809,6 +865,167 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) GetStackName() str
 	return ""
 }
 
+// The Kafka provider settings.
+type ApplicationPubSub_KafkaProvider struct {
+	// The addresses of the Kafka bootstrap brokers, in host:port format.
+	Brokers []string `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	// The consumer group which is used to consume the downlink topics.
+	ConsumerGroup string `protobuf:"bytes,2,opt,name=consumer_group,json=consumerGroup,proto3" json:"consumer_group,omitempty"`
+	// If set, the integration authenticates using SASL.
+	SASL   *ApplicationPubSub_KafkaProvider_SASL `protobuf:"bytes,3,opt,name=sasl,proto3" json:"sasl,omitempty"`
+	UseTLS bool                                  `protobuf:"varint,4,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// The server Root CA certificate. PEM formatted.
+	TLSCA []byte `protobuf:"bytes,5,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// The client certificate. PEM formatted.
+	TLSClientCert []byte `protobuf:"bytes,6,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
+	// The client private key. PEM formatted.
+	TLSClientKey         []byte   `protobuf:"bytes,7,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationPubSub_KafkaProvider) Reset()      { *m = ApplicationPubSub_KafkaProvider{} }
+func (*ApplicationPubSub_KafkaProvider) ProtoMessage() {}
+func (*ApplicationPubSub_KafkaProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 3}
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSub_KafkaProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider.Merge(m, src)
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPubSub_KafkaProvider proto.InternalMessageInfo
+
+func (m *ApplicationPubSub_KafkaProvider) GetBrokers() []string {
+	if m != nil {
+		return m.Brokers
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetConsumerGroup() string {
+	if m != nil {
+		return m.ConsumerGroup
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetSASL() *ApplicationPubSub_KafkaProvider_SASL {
+	if m != nil {
+		return m.SASL
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetUseTLS() bool {
+	if m != nil {
+		return m.UseTLS
+	}
+	return false
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSCA() []byte {
+	if m != nil {
+		return m.TLSCA
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSClientCert() []byte {
+	if m != nil {
+		return m.TLSClientCert
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSClientKey() []byte {
+	if m != nil {
+		return m.TLSClientKey
+	}
+	return nil
+}
+
+type ApplicationPubSub_KafkaProvider_SASL struct {
+	Mechanism            ApplicationPubSub_KafkaProvider_SASL_Mechanism `protobuf:"varint,1,opt,name=mechanism,proto3,enum=ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASL_Mechanism" json:"mechanism,omitempty"`
+	Username             string                                         `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string                                         `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) Reset()      { *m = ApplicationPubSub_KafkaProvider_SASL{} }
+func (*ApplicationPubSub_KafkaProvider_SASL) ProtoMessage() {}
+func (*ApplicationPubSub_KafkaProvider_SASL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 3, 0}
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL.Merge(m, src)
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL proto.InternalMessageInfo
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) GetMechanism() ApplicationPubSub_KafkaProvider_SASL_Mechanism {
+	if m != nil {
+		return m.Mechanism
+	}
+	return ApplicationPubSub_KafkaProvider_SASL_PLAIN
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
 type ApplicationPubSub_Message struct {
 	// The topic on which the Application Server publishes or receives the messages.
 	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
@@ -819,7 +1036,7 @@ type ApplicationPubSub_Message struct {
 func (m *ApplicationPubSub_Message) Reset()      { *m = ApplicationPubSub_Message{} }
 func (*ApplicationPubSub_Message) ProtoMessage() {}
 func (*ApplicationPubSub_Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1dce56ec18597200, []int{1, 3}
+	return fileDescriptor_1dce56ec18597200, []int{1, 4}
 }
 func (m *ApplicationPubSub_Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,6 +1304,8 @@ func (m *SetApplicationPubSubRequest) GetFieldMask() types.FieldMask {
 func init() {
 	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_QoS", ApplicationPubSub_MQTTProvider_QoS_name, ApplicationPubSub_MQTTProvider_QoS_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_QoS", ApplicationPubSub_MQTTProvider_QoS_name, ApplicationPubSub_MQTTProvider_QoS_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASL_Mechanism", ApplicationPubSub_KafkaProvider_SASL_Mechanism_name, ApplicationPubSub_KafkaProvider_SASL_Mechanism_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASL_Mechanism", ApplicationPubSub_KafkaProvider_SASL_Mechanism_name, ApplicationPubSub_KafkaProvider_SASL_Mechanism_value)
 	proto.RegisterType((*ApplicationPubSubIdentifiers)(nil), "ttn.lorawan.v3.ApplicationPubSubIdentifiers")
 	golang_proto.RegisterType((*ApplicationPubSubIdentifiers)(nil), "ttn.lorawan.v3.ApplicationPubSubIdentifiers")
 	proto.RegisterType((*ApplicationPubSub)(nil), "ttn.lorawan.v3.ApplicationPubSub")
@@ -1105,6 +1324,10 @@ func init() {
 	golang_proto.RegisterType((*ApplicationPubSub_AWSIoTProvider_AssumeRole)(nil), "ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.AssumeRole")
 	proto.RegisterType((*ApplicationPubSub_AWSIoTProvider_DefaultIntegration)(nil), "ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.DefaultIntegration")
 	golang_proto.RegisterType((*ApplicationPubSub_AWSIoTProvider_DefaultIntegration)(nil), "ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.DefaultIntegration")
+	proto.RegisterType((*ApplicationPubSub_KafkaProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_KafkaProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider")
+	proto.RegisterType((*ApplicationPubSub_KafkaProvider_SASL)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider.SASL")
+	golang_proto.RegisterType((*ApplicationPubSub_KafkaProvider_SASL)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider.SASL")
 	proto.RegisterType((*ApplicationPubSub_Message)(nil), "ttn.lorawan.v3.ApplicationPubSub.Message")
 	golang_proto.RegisterType((*ApplicationPubSub_Message)(nil), "ttn.lorawan.v3.ApplicationPubSub.Message")
 	proto.RegisterType((*ApplicationPubSubs)(nil), "ttn.lorawan.v3.ApplicationPubSubs")
@@ -1129,151 +1352,166 @@ func init() {
 }
 
 var fileDescriptor_1dce56ec18597200 = []byte{
-	// 2304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x98, 0x4f, 0x6c, 0x1b, 0xc7,
-	0xd5, 0xc0, 0x39, 0xfa, 0x43, 0x89, 0x43, 0x4a, 0xa2, 0x27, 0xc9, 0x97, 0x0d, 0x13, 0x2f, 0xfd,
-	0x31, 0x46, 0x4a, 0xc9, 0x59, 0xd2, 0xa6, 0xd2, 0x20, 0xa1, 0x5b, 0xd8, 0x5c, 0x49, 0x8e, 0x95,
-	0x28, 0x8a, 0xb4, 0xa4, 0x91, 0xc6, 0x96, 0xbd, 0x18, 0x72, 0x47, 0xd4, 0x46, 0xcb, 0xdd, 0xf5,
-	0xce, 0xac, 0x14, 0x35, 0x31, 0x60, 0xe4, 0x94, 0xf6, 0x50, 0x18, 0xe8, 0xa1, 0x01, 0x72, 0x68,
-	0x81, 0xa2, 0x68, 0x80, 0x5e, 0x72, 0x6b, 0x6e, 0x0d, 0xd0, 0x8b, 0x8f, 0x01, 0xda, 0x43, 0x4e,
-	0x6a, 0x44, 0xf5, 0x90, 0x5b, 0x73, 0x6b, 0xa0, 0x02, 0x45, 0x31, 0xb3, 0xbb, 0x24, 0x25, 0x3a,
-	0x96, 0x29, 0xa3, 0x3d, 0xf1, 0xcd, 0xbe, 0xf7, 0x7e, 0xfb, 0xe6, 0xcd, 0xe3, 0xbc, 0x99, 0x85,
-	0xe7, 0x2d, 0xc7, 0xc3, 0xdb, 0xd8, 0x56, 0x28, 0xc3, 0x8d, 0xcd, 0x22, 0x76, 0xcd, 0x22, 0x76,
-	0x5d, 0xcb, 0x6c, 0x60, 0x66, 0x3a, 0x36, 0x25, 0xde, 0x16, 0xf1, 0x74, 0xd7, 0xaf, 0x53, 0xbf,
-	0x5e, 0x70, 0x3d, 0x87, 0x39, 0x68, 0x92, 0x31, 0xbb, 0x10, 0x7a, 0x15, 0xb6, 0x66, 0x33, 0x95,
-	0xa6, 0xc9, 0x36, 0xfc, 0x7a, 0xa1, 0xe1, 0xb4, 0x8a, 0xc4, 0xde, 0x72, 0x76, 0x5c, 0xcf, 0x79,
-	0x6f, 0xa7, 0x28, 0x8c, 0x1b, 0x4a, 0x93, 0xd8, 0xca, 0x16, 0xb6, 0x4c, 0x03, 0x33, 0x52, 0xec,
-	0x13, 0x02, 0x64, 0x46, 0xe9, 0x41, 0x34, 0x9d, 0xa6, 0x13, 0x38, 0xd7, 0xfd, 0x75, 0x31, 0x12,
-	0x03, 0x21, 0x85, 0xe6, 0xcf, 0x35, 0x1d, 0xa7, 0x69, 0x91, 0x20, 0x58, 0xdb, 0x76, 0x58, 0x10,
-	0x6b, 0xa8, 0x95, 0x43, 0x6d, 0x87, 0x61, 0xf8, 0x9e, 0x30, 0x08, 0xf5, 0xcf, 0x1e, 0xd5, 0x93,
-	0x96, 0xcb, 0x76, 0x42, 0xe5, 0x99, 0xa3, 0xca, 0x75, 0x93, 0x58, 0x86, 0xde, 0xc2, 0x74, 0x33,
-	0xb4, 0xc8, 0x1e, 0xb5, 0x60, 0x66, 0x8b, 0x50, 0x86, 0x5b, 0x6e, 0x68, 0xf0, 0x7c, 0x7f, 0x46,
-	0x4d, 0x83, 0xd8, 0xcc, 0x5c, 0x37, 0x89, 0x17, 0x06, 0x99, 0xfb, 0x2b, 0x80, 0xcf, 0x55, 0xba,
-	0x79, 0x5e, 0xf1, 0xeb, 0x55, 0xbf, 0xbe, 0xd8, 0x35, 0x43, 0x18, 0x4e, 0xf5, 0xac, 0x83, 0x6e,
-	0x1a, 0x54, 0x02, 0x67, 0x40, 0x3e, 0x59, 0x7a, 0xa1, 0x70, 0x38, 0xff, 0x85, 0x1e, 0x4c, 0x0f,
-	0x40, 0x4d, 0x1f, 0xa8, 0xa3, 0x3f, 0x07, 0x43, 0x69, 0x70, 0x7f, 0x37, 0x1b, 0xfb, 0x72, 0x37,
-	0x0b, 0xb4, 0x49, 0xdc, 0x6b, 0x49, 0xd1, 0x2a, 0x84, 0xae, 0x5f, 0xd7, 0xa9, 0x5f, 0xd7, 0x4d,
-	0x43, 0x1a, 0x3a, 0x03, 0xf2, 0x09, 0x75, 0xf6, 0x40, 0x3d, 0xeb, 0xe5, 0xa4, 0xb3, 0x25, 0xf9,
-	0xd6, 0x0d, 0xac, 0xfc, 0xf4, 0xbc, 0xf2, 0xea, 0xcd, 0xfc, 0xa5, 0xf2, 0x0d, 0xe5, 0xe6, 0xa5,
-	0x68, 0x38, 0xfd, 0x7e, 0xe9, 0xc5, 0x3b, 0x67, 0xdb, 0xbb, 0xd9, 0xf1, 0x30, 0xe8, 0x79, 0x6d,
-	0xdc, 0x0d, 0xc3, 0xcf, 0xed, 0x9d, 0x86, 0xa7, 0xfa, 0xa6, 0x85, 0x56, 0xe0, 0x70, 0x37, 0xfe,
-	0x17, 0x1f, 0x12, 0x7f, 0x5f, 0x1a, 0x1e, 0x30, 0x0b, 0x8e, 0x42, 0x73, 0x10, 0x36, 0x3c, 0x82,
-	0x19, 0x31, 0x74, 0xcc, 0x44, 0xe8, 0xc9, 0x52, 0xa6, 0x10, 0xac, 0x4c, 0x21, 0x5a, 0x99, 0x42,
-	0x2d, 0x5a, 0x19, 0x75, 0x9c, 0xbb, 0xdf, 0xfb, 0x5b, 0x16, 0x68, 0x89, 0xd0, 0xaf, 0xc2, 0x38,
-	0xc4, 0x77, 0x8d, 0x08, 0x32, 0x3c, 0x08, 0x24, 0xf4, 0xab, 0x30, 0x74, 0x09, 0xc6, 0xd7, 0x1d,
-	0xaf, 0x85, 0x99, 0x34, 0x22, 0x12, 0xf8, 0x83, 0x20, 0x81, 0x4f, 0x1e, 0x97, 0x40, 0x2d, 0x74,
-	0x43, 0xcb, 0x70, 0xc4, 0xc6, 0x8c, 0x4a, 0xa7, 0xc4, 0xfb, 0x0b, 0xc7, 0x66, 0xa7, 0xb0, 0x5c,
-	0xa9, 0x55, 0x57, 0x3c, 0x67, 0xcb, 0x34, 0x88, 0xa7, 0x8e, 0xb7, 0x77, 0xb3, 0x23, 0xfc, 0xc9,
-	0xd5, 0x98, 0x26, 0x38, 0x9c, 0xd7, 0xba, 0xcd, 0x98, 0xf4, 0xcc, 0xa3, 0xf2, 0xde, 0x5c, 0xad,
-	0xd5, 0x0e, 0xf3, 0xf8, 0x13, 0xce, 0xe3, 0x1c, 0xf4, 0x36, 0x1c, 0xc3, 0xdb, 0x54, 0x37, 0x1d,
-	0x26, 0x11, 0x81, 0x3c, 0x7f, 0x3c, 0xb2, 0xf2, 0x76, 0x75, 0xd1, 0xe9, 0x42, 0x61, 0x7b, 0x37,
-	0x1b, 0x0f, 0x9e, 0x5d, 0x8d, 0x69, 0x71, 0xbc, 0x4d, 0x17, 0x1d, 0x86, 0x5e, 0x80, 0xb0, 0x8e,
-	0x29, 0xd1, 0x99, 0xe3, 0x9a, 0x0d, 0x29, 0x2e, 0xb2, 0x37, 0x76, 0xa0, 0x8e, 0x78, 0x43, 0x92,
-	0xa1, 0x25, 0xb8, 0xaa, 0xc6, 0x35, 0x68, 0x19, 0x4e, 0x18, 0xce, 0xb6, 0x6d, 0x99, 0xf6, 0xa6,
-	0xee, 0xfa, 0x74, 0x43, 0x1a, 0x13, 0x61, 0x4c, 0x3f, 0xc2, 0xcc, 0x08, 0xa5, 0xb8, 0x49, 0xb4,
-	0x54, 0xe4, 0xbf, 0xe2, 0xd3, 0x0d, 0x54, 0x83, 0xe9, 0x0e, 0xcf, 0x23, 0xae, 0x85, 0x1b, 0x44,
-	0x1a, 0x1f, 0x14, 0x39, 0x15, 0x21, 0xb4, 0x80, 0x80, 0x56, 0xe0, 0xa4, 0xef, 0x0a, 0x66, 0x2b,
-	0x30, 0x91, 0x12, 0x83, 0x32, 0x27, 0x02, 0x40, 0x38, 0x44, 0xaf, 0xc3, 0xe4, 0xbb, 0x8e, 0x69,
-	0xeb, 0xb8, 0xd1, 0x20, 0x2e, 0x93, 0xe0, 0xa0, 0x38, 0xc8, 0xbd, 0x2b, 0xc2, 0x19, 0x2d, 0xc1,
-	0x4e, 0x0e, 0x74, 0xdc, 0xd8, 0x94, 0x92, 0x83, 0xc2, 0x92, 0x91, 0x7b, 0xa5, 0xb1, 0x79, 0x68,
-	0x45, 0x6c, 0x8e, 0x4b, 0x9d, 0x78, 0x45, 0x96, 0xf1, 0x11, 0x1e, 0x25, 0x36, 0x93, 0x26, 0x4e,
-	0xcc, 0xab, 0x12, 0x9b, 0x21, 0x0d, 0x76, 0x96, 0x47, 0x5f, 0xc7, 0xa6, 0x45, 0x0c, 0x69, 0x72,
-	0x50, 0xe2, 0x64, 0x44, 0xb8, 0x22, 0x00, 0x87, 0x98, 0xb7, 0x7d, 0xe2, 0x13, 0x43, 0x9a, 0x3a,
-	0x31, 0x73, 0x55, 0x00, 0x50, 0x13, 0x66, 0x0e, 0x33, 0x75, 0xd3, 0x8e, 0x3a, 0xa3, 0x21, 0x3d,
-	0x31, 0x28, 0x5e, 0x3a, 0x84, 0x5f, 0xec, 0xa2, 0x78, 0xf0, 0x96, 0x13, 0x76, 0x12, 0xea, 0x58,
-	0x5b, 0xc4, 0x90, 0xd2, 0x03, 0x07, 0x1f, 0x11, 0xaa, 0x02, 0xc0, 0x4b, 0x8a, 0x9f, 0x0e, 0xcc,
-	0x06, 0xd1, 0x0d, 0xcc, 0xb0, 0x84, 0x06, 0x2e, 0xa9, 0xd0, 0x7d, 0x1e, 0x33, 0x9c, 0x99, 0x87,
-	0xa9, 0xde, 0x7d, 0x0d, 0xbd, 0x04, 0x61, 0x78, 0xf6, 0xf0, 0x3d, 0x4b, 0x74, 0x8e, 0x84, 0xfa,
-	0xd4, 0x81, 0x3a, 0xea, 0x0d, 0x7f, 0x04, 0x40, 0x7b, 0x37, 0x9b, 0xa8, 0x0a, 0xed, 0x35, 0x6d,
-	0x49, 0x4b, 0x04, 0x86, 0xd7, 0x3c, 0x2b, 0xf3, 0x49, 0x1c, 0xa6, 0x7a, 0xb7, 0xb3, 0x93, 0x61,
-	0xd0, 0x79, 0x98, 0x68, 0x58, 0x26, 0xb1, 0x59, 0xb7, 0x2f, 0x3e, 0x11, 0x6c, 0x4c, 0x4f, 0xf3,
-	0xbe, 0x37, 0x27, 0x74, 0xbc, 0xef, 0x05, 0x56, 0x8b, 0x06, 0x7a, 0x1e, 0x8e, 0xfb, 0x94, 0x78,
-	0x36, 0x6e, 0x11, 0xd1, 0x48, 0x7a, 0x76, 0xb2, 0x8e, 0x82, 0x1b, 0xb9, 0x98, 0xd2, 0x6d, 0xc7,
-	0x33, 0xc2, 0x66, 0xd1, 0x35, 0x8a, 0x14, 0xc8, 0x84, 0x13, 0xd4, 0xaf, 0xd3, 0x86, 0x67, 0xd6,
-	0x89, 0x7e, 0xdb, 0xa1, 0xd2, 0xe8, 0x19, 0x90, 0x9f, 0x2c, 0x95, 0x06, 0xdb, 0xc7, 0x0b, 0xab,
-	0x4e, 0x55, 0x4d, 0xb7, 0x77, 0xb3, 0xa9, 0x6a, 0x04, 0x5b, 0x75, 0xaa, 0x5a, 0x8a, 0x76, 0x47,
-	0x14, 0x35, 0x60, 0xd2, 0xf5, 0xeb, 0x96, 0x49, 0x37, 0xc4, 0x8b, 0xe2, 0x27, 0x7e, 0xd1, 0x64,
-	0x7b, 0x37, 0x0b, 0x57, 0x02, 0x14, 0x7f, 0x0d, 0x74, 0x23, 0x99, 0xa2, 0xe7, 0xe1, 0x98, 0xcf,
-	0x37, 0x79, 0x8b, 0x8a, 0x7d, 0x7b, 0x3c, 0x68, 0x06, 0xd7, 0x28, 0xa9, 0x2d, 0x55, 0xb5, 0xb8,
-	0x4f, 0x49, 0xcd, 0xa2, 0xe8, 0x0c, 0x8c, 0x33, 0x8b, 0xea, 0x0d, 0x2c, 0x36, 0xe2, 0x94, 0x9a,
-	0x68, 0xef, 0x66, 0x47, 0x6b, 0x4b, 0xd5, 0xb9, 0x8a, 0x36, 0xca, 0x2c, 0x3a, 0x87, 0xd1, 0xab,
-	0x70, 0x4a, 0x58, 0x04, 0xcb, 0xd2, 0x20, 0x1e, 0x13, 0xfb, 0x6b, 0x4a, 0x3d, 0xd5, 0xde, 0xcd,
-	0x4e, 0x70, 0x53, 0xa1, 0x99, 0x23, 0x1e, 0xd3, 0x26, 0xb8, 0x4b, 0x67, 0x88, 0x5e, 0x86, 0x93,
-	0x3d, 0xae, 0x9b, 0x64, 0x47, 0x6c, 0xa5, 0xa9, 0x20, 0x3d, 0x1d, 0xcf, 0x37, 0xc8, 0x8e, 0x96,
-	0xea, 0x38, 0xbe, 0x41, 0x76, 0xd0, 0x35, 0x38, 0xb6, 0x41, 0xb0, 0x41, 0x3c, 0x2a, 0x25, 0xcf,
-	0x0c, 0xe7, 0x93, 0xa5, 0x8b, 0x03, 0xa6, 0xe6, 0x6a, 0xe0, 0xbd, 0x60, 0x33, 0x6f, 0x47, 0x8b,
-	0x58, 0x99, 0x32, 0x4c, 0xf5, 0x2a, 0x50, 0x1a, 0x0e, 0xf3, 0x98, 0x44, 0x6d, 0x6a, 0x5c, 0x44,
-	0x4f, 0xc2, 0xd1, 0x2d, 0x6c, 0xf9, 0x24, 0x28, 0x3d, 0x2d, 0x18, 0x94, 0x87, 0x5e, 0x01, 0xb9,
-	0x1f, 0xc1, 0xe1, 0x55, 0xa7, 0x8a, 0xd2, 0x30, 0x55, 0xa9, 0xe9, 0x6f, 0xbe, 0x55, 0xad, 0xe9,
-	0x6f, 0x2d, 0xcf, 0x2d, 0xa4, 0x63, 0xe8, 0x14, 0x9c, 0xa8, 0xd4, 0xf4, 0xa5, 0x85, 0x4a, 0xf4,
-	0x08, 0x70, 0xa3, 0x85, 0x9f, 0x54, 0xe6, 0x6a, 0x4b, 0xef, 0x04, 0x4f, 0x86, 0x32, 0x3f, 0x83,
-	0x70, 0xf2, 0x70, 0x67, 0x46, 0x9f, 0x0c, 0xc1, 0xb8, 0x47, 0x9a, 0xa6, 0x63, 0x87, 0x7f, 0x8e,
-	0x0f, 0x87, 0x0e, 0xd4, 0x7f, 0x03, 0xef, 0x5f, 0x40, 0x83, 0x78, 0x5d, 0xa1, 0x8e, 0xcf, 0x36,
-	0x94, 0x0b, 0x5a, 0x02, 0xbb, 0x0a, 0xc1, 0x94, 0x29, 0x17, 0xf8, 0x21, 0x52, 0xb1, 0x1d, 0x8f,
-	0x6d, 0x3c, 0x70, 0x5c, 0xd2, 0x20, 0x76, 0x3b, 0x6e, 0x93, 0x91, 0xdc, 0x63, 0xdb, 0x1d, 0x97,
-	0xb4, 0x54, 0x03, 0x2b, 0x0d, 0x62, 0x33, 0x0f, 0x5b, 0xca, 0x05, 0x2d, 0x45, 0xfc, 0x9e, 0x11,
-	0x24, 0x7e, 0xc0, 0x0d, 0xe5, 0x4e, 0x28, 0xc4, 0x57, 0xb6, 0x89, 0xc0, 0x75, 0xc4, 0x52, 0x57,
-	0x9c, 0xd5, 0x60, 0x8b, 0x74, 0x8d, 0x29, 0x8e, 0xe2, 0x4e, 0xf8, 0xb4, 0x4f, 0x2c, 0x09, 0x31,
-	0xa2, 0x45, 0x62, 0x49, 0x0b, 0x53, 0x82, 0xde, 0x81, 0x90, 0x37, 0x5f, 0x4a, 0x45, 0xd5, 0x04,
-	0xa7, 0xcc, 0xf2, 0xa0, 0xa7, 0x9f, 0x42, 0x45, 0x20, 0x78, 0x7d, 0x25, 0x70, 0x24, 0xa2, 0x35,
-	0x98, 0xc4, 0x94, 0xfa, 0x2d, 0xa2, 0x7b, 0x8e, 0x45, 0xc2, 0xc3, 0xe7, 0xc5, 0xc1, 0xd9, 0x82,
-	0xa1, 0x39, 0x16, 0xd1, 0x20, 0xee, 0xc8, 0xe8, 0xb7, 0x00, 0xa6, 0x89, 0x6d, 0xb8, 0x8e, 0x69,
-	0x33, 0x1d, 0x1b, 0x86, 0x47, 0x28, 0x0d, 0xb7, 0x9c, 0xf7, 0x0e, 0x54, 0xdf, 0xa3, 0xd2, 0x5d,
-	0x50, 0xb2, 0x6f, 0xe5, 0xf3, 0x79, 0x7e, 0x28, 0xad, 0x28, 0xd7, 0xf9, 0xb9, 0xf4, 0x83, 0x1e,
-	0xb9, 0x2b, 0xae, 0x29, 0x37, 0x67, 0x7a, 0x14, 0xd3, 0x6b, 0x85, 0xe9, 0x99, 0xfc, 0x8d, 0x8a,
-	0x72, 0x3d, 0x3c, 0xcd, 0x7e, 0xd0, 0x23, 0x77, 0x45, 0xe1, 0xd5, 0x55, 0x4c, 0x7f, 0x30, 0x7d,
-	0x56, 0x9b, 0x8a, 0x22, 0xaa, 0x04, 0x01, 0x21, 0x1d, 0x8e, 0x19, 0x64, 0x1d, 0xfb, 0x16, 0x13,
-	0x9b, 0x5c, 0xb2, 0x34, 0x37, 0xf0, 0xfc, 0xe7, 0x03, 0xff, 0x45, 0x9b, 0x91, 0x66, 0x70, 0xc9,
-	0xbb, 0x1a, 0xd3, 0x22, 0x6a, 0xe6, 0x8f, 0x00, 0x26, 0x3a, 0xd9, 0x47, 0x57, 0xe0, 0x44, 0x77,
-	0x35, 0xf9, 0xce, 0x1e, 0x54, 0x7c, 0xee, 0x40, 0x4d, 0x7b, 0x93, 0xe9, 0x34, 0x4f, 0xc9, 0xd8,
-	0xad, 0x1b, 0x6b, 0xdb, 0x37, 0x67, 0xf8, 0xed, 0x26, 0xd9, 0x71, 0x5c, 0x9c, 0xd7, 0x92, 0x9d,
-	0x85, 0x5b, 0x34, 0xd0, 0x2c, 0x3c, 0x45, 0x49, 0xc3, 0x23, 0x4c, 0x3f, 0x52, 0x1c, 0x9d, 0xfd,
-	0x3c, 0xaf, 0x4d, 0x05, 0x16, 0xdd, 0x97, 0x2b, 0x70, 0x82, 0x12, 0x4a, 0x79, 0x03, 0x66, 0xce,
-	0x26, 0xb1, 0xc3, 0x2e, 0x31, 0x2e, 0x7a, 0x91, 0x74, 0x77, 0x48, 0x4b, 0x85, 0xea, 0x1a, 0xd7,
-	0x66, 0xfe, 0x09, 0x20, 0xec, 0xae, 0x2d, 0xd2, 0xe0, 0x30, 0xf6, 0xa2, 0xbf, 0xe8, 0xe5, 0x03,
-	0xf5, 0x65, 0xef, 0xa5, 0x52, 0xe9, 0x16, 0xf6, 0xec, 0x32, 0xde, 0xa6, 0x65, 0x13, 0xb7, 0xca,
-	0xe5, 0x1b, 0x3c, 0xcf, 0xef, 0x5f, 0x28, 0xdd, 0x29, 0xf3, 0x82, 0x5a, 0x2b, 0x76, 0xb3, 0xaf,
-	0x9f, 0xfb, 0xf1, 0x8b, 0x85, 0xcb, 0xca, 0xcd, 0x73, 0x7c, 0x5a, 0xc3, 0x15, 0x6d, 0x59, 0xe3,
-	0x30, 0xb4, 0x08, 0x93, 0xe4, 0x3d, 0xc6, 0x5b, 0x93, 0xd5, 0x6d, 0x73, 0xf9, 0x03, 0xf5, 0x69,
-	0xef, 0x29, 0xe9, 0x7e, 0xa2, 0x94, 0xe6, 0xa9, 0x10, 0x9e, 0xe5, 0xb5, 0xa2, 0x12, 0xe4, 0x04,
-	0x2e, 0x84, 0x0e, 0x8b, 0xf3, 0x1a, 0x8c, 0x9c, 0x17, 0x0d, 0xf4, 0x3a, 0x4c, 0x47, 0x93, 0x8b,
-	0xee, 0xda, 0x61, 0x45, 0x3f, 0xd3, 0x77, 0x9d, 0x9a, 0x0f, 0x0d, 0xd4, 0x91, 0x8f, 0xf9, 0x4d,
-	0x6a, 0x2a, 0x74, 0x8c, 0x1e, 0x67, 0xde, 0x86, 0xa8, 0x7f, 0x51, 0x51, 0x05, 0x42, 0x71, 0x9b,
-	0xd6, 0x45, 0x87, 0xed, 0x2c, 0x5c, 0xd6, 0x3b, 0xcd, 0x97, 0x4d, 0xba, 0x15, 0xce, 0xf6, 0x48,
-	0x05, 0x9e, 0xd5, 0x12, 0xc2, 0x6b, 0x19, 0xb7, 0x88, 0x9a, 0x82, 0xd0, 0x20, 0xae, 0xe5, 0xec,
-	0xb4, 0x88, 0xcd, 0x32, 0x79, 0x38, 0x16, 0x9d, 0xb3, 0x4f, 0xc3, 0xd1, 0xe0, 0x0a, 0x02, 0x0e,
-	0xf7, 0xe4, 0xe0, 0xa9, 0x3a, 0x05, 0xc7, 0xdd, 0x68, 0xbb, 0x1c, 0xfe, 0x4e, 0x05, 0xb9, 0x55,
-	0x88, 0xfa, 0xea, 0x92, 0xa2, 0x8b, 0x70, 0x2c, 0xf8, 0x4a, 0xc2, 0xef, 0xb9, 0xbc, 0x5b, 0xfc,
-	0xff, 0xb1, 0xc5, 0xac, 0x45, 0x1e, 0xb9, 0xdf, 0x03, 0x28, 0xf5, 0xa9, 0xaf, 0x88, 0xfb, 0x21,
-	0x45, 0x6f, 0xc1, 0xb1, 0xe0, 0xaa, 0x18, 0x91, 0x7f, 0x78, 0x2c, 0x39, 0x74, 0x2d, 0x84, 0xbf,
-	0x61, 0x07, 0x0a, 0x29, 0xbc, 0x03, 0xf5, 0x2a, 0x06, 0xea, 0x40, 0x9f, 0x01, 0xf8, 0xec, 0x6b,
-	0x84, 0xf5, 0xcf, 0x85, 0xdc, 0xf6, 0x09, 0x65, 0xff, 0x85, 0xab, 0xfe, 0x25, 0x08, 0xbb, 0xdf,
-	0x60, 0xbe, 0xf7, 0xaa, 0x7f, 0x85, 0x9b, 0xbc, 0x89, 0xe9, 0xa6, 0x3a, 0xc2, 0xdd, 0xb5, 0xc4,
-	0x7a, 0xf4, 0x20, 0xf7, 0x67, 0x00, 0x4f, 0x2f, 0x99, 0xb4, 0x3f, 0x66, 0x1a, 0x05, 0xfd, 0x3f,
-	0xf8, 0xd6, 0xf2, 0xd8, 0xb3, 0xf8, 0x03, 0x80, 0xcf, 0x56, 0x1f, 0x92, 0xf8, 0x37, 0x60, 0x3c,
-	0xa8, 0xa6, 0x30, 0xf4, 0xe3, 0xcb, 0xef, 0x01, 0x51, 0x87, 0x88, 0xc7, 0x8e, 0xb6, 0xf4, 0xa7,
-	0x38, 0x7c, 0xe6, 0x01, 0xa1, 0x36, 0x4d, 0xca, 0x0b, 0xee, 0x5d, 0x08, 0x5f, 0x23, 0x2c, 0xaa,
-	0xef, 0xff, 0xeb, 0x03, 0x2f, 0xb4, 0x5c, 0xb6, 0x93, 0xc9, 0x3f, 0x6a, 0x99, 0xe7, 0x32, 0x1f,
-	0xfe, 0xe5, 0xef, 0xbf, 0x1c, 0x7a, 0x12, 0xa1, 0x22, 0xa6, 0xc5, 0x60, 0x0a, 0x4a, 0x58, 0xec,
-	0xe8, 0xd7, 0x00, 0x0e, 0xbf, 0x46, 0x18, 0x3a, 0x77, 0x94, 0xf6, 0x90, 0x2a, 0xce, 0x1c, 0x9f,
-	0xbc, 0xdc, 0x55, 0xf1, 0x4e, 0x15, 0x5d, 0xee, 0xbe, 0xb3, 0xf8, 0xbe, 0x69, 0xd0, 0xc2, 0x91,
-	0x4a, 0x3a, 0x32, 0xbe, 0x13, 0x18, 0x75, 0xbf, 0xbb, 0xdd, 0x41, 0xbf, 0x00, 0x70, 0x84, 0xd7,
-	0x27, 0x52, 0x8e, 0xbe, 0xf5, 0xa1, 0x55, 0x9b, 0xc9, 0x1d, 0x1b, 0x24, 0xcd, 0xcd, 0x8a, 0x28,
-	0x15, 0x74, 0xae, 0x37, 0xca, 0x63, 0x22, 0x44, 0xff, 0x00, 0x70, 0xb8, 0xfa, 0xa0, 0x94, 0x55,
-	0x1f, 0x2f, 0x65, 0xbf, 0x02, 0x22, 0x9a, 0x7b, 0x20, 0xb3, 0xdc, 0x1b, 0x4e, 0xf8, 0x71, 0xf9,
-	0x91, 0x72, 0xd7, 0x63, 0xdb, 0x93, 0xc2, 0x32, 0x98, 0xb9, 0x7e, 0x31, 0xf7, 0xf2, 0xc9, 0xa0,
-	0x65, 0x30, 0x83, 0xee, 0x01, 0x18, 0x9f, 0x27, 0x16, 0x61, 0x04, 0x0d, 0xb4, 0x67, 0x65, 0xbe,
-	0xa7, 0x76, 0x73, 0x97, 0xc5, 0x4c, 0xcb, 0x33, 0xaf, 0x0c, 0x90, 0x77, 0x11, 0x74, 0x34, 0x25,
-	0xf5, 0x77, 0xe0, 0xfe, 0x9e, 0x0c, 0xbe, 0xdc, 0x93, 0xc1, 0x57, 0x7b, 0x72, 0xec, 0xeb, 0x3d,
-	0x39, 0xf6, 0xcd, 0x9e, 0x1c, 0xfb, 0x76, 0x4f, 0x8e, 0x7d, 0xb7, 0x27, 0x83, 0xbb, 0x6d, 0x19,
-	0x7c, 0xd4, 0x96, 0x63, 0x9f, 0xb6, 0x65, 0xf0, 0x59, 0x5b, 0x8e, 0x7d, 0xde, 0x96, 0x63, 0x5f,
-	0xb4, 0xe5, 0xd8, 0xfd, 0xb6, 0x0c, 0xbe, 0x6c, 0xcb, 0xe0, 0xab, 0xb6, 0x1c, 0xfb, 0xba, 0x2d,
-	0x83, 0x6f, 0xda, 0x72, 0xec, 0xdb, 0xb6, 0x0c, 0xbe, 0x6b, 0xcb, 0xb1, 0xbb, 0xfb, 0x72, 0xec,
-	0xa3, 0x7d, 0x19, 0xdc, 0xdb, 0x97, 0x63, 0x1f, 0xef, 0xcb, 0xe0, 0x37, 0xfb, 0x72, 0xec, 0xd3,
-	0x7d, 0x39, 0xf6, 0xd9, 0xbe, 0x0c, 0x3e, 0xdf, 0x97, 0xc1, 0x17, 0xfb, 0x32, 0xb8, 0x5e, 0x6c,
-	0x3a, 0x05, 0xb6, 0x41, 0xd8, 0x86, 0x69, 0x37, 0x69, 0xc1, 0x26, 0x6c, 0xdb, 0xf1, 0x36, 0x8b,
-	0x87, 0xbf, 0x68, 0x6f, 0xcd, 0x16, 0xdd, 0xcd, 0x66, 0x91, 0x31, 0xdb, 0xad, 0xd7, 0xe3, 0x62,
-	0xe6, 0xb3, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x33, 0x31, 0x82, 0x4b, 0x48, 0x18, 0x00, 0x00,
+	// 2538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xe7, 0x88, 0x12, 0x25, 0x3e, 0x52, 0x12, 0x3d, 0x71, 0xfe, 0x59, 0x33, 0xc9, 0x4a, 0x7f,
+	0xc6, 0x48, 0x65, 0x3b, 0x24, 0x6d, 0x2a, 0x31, 0x12, 0x39, 0xad, 0x4d, 0x4a, 0xb6, 0xa5, 0x58,
+	0x56, 0xa4, 0x25, 0x8d, 0x34, 0xfe, 0x5a, 0x0c, 0xb9, 0x23, 0x6a, 0xa3, 0xe5, 0xee, 0x7a, 0x67,
+	0x56, 0x8a, 0xea, 0x18, 0x30, 0x72, 0x0a, 0x72, 0x28, 0x8c, 0xf6, 0xd0, 0x00, 0x39, 0xb4, 0x40,
+	0x51, 0x34, 0x68, 0x2f, 0xb9, 0x35, 0xb7, 0x06, 0xe8, 0xc5, 0xc7, 0x00, 0xed, 0x21, 0x27, 0x35,
+	0xa2, 0x7a, 0xc8, 0xad, 0x39, 0xb5, 0x81, 0x5a, 0x14, 0xc5, 0xec, 0x07, 0x3f, 0x24, 0xc7, 0x12,
+	0x65, 0xa4, 0x27, 0xbe, 0x99, 0xf7, 0xde, 0x6f, 0xde, 0xbc, 0xf7, 0x76, 0xde, 0x9b, 0x21, 0x9c,
+	0x36, 0x2c, 0x87, 0xac, 0x13, 0x33, 0xcb, 0x38, 0xa9, 0xad, 0xe6, 0x89, 0xad, 0xe7, 0x89, 0x6d,
+	0x1b, 0x7a, 0x8d, 0x70, 0xdd, 0x32, 0x19, 0x75, 0xd6, 0xa8, 0xa3, 0xda, 0x6e, 0x95, 0xb9, 0xd5,
+	0x9c, 0xed, 0x58, 0xdc, 0xc2, 0x23, 0x9c, 0x9b, 0xb9, 0x40, 0x2b, 0xb7, 0x36, 0x99, 0x2e, 0xd6,
+	0x75, 0xbe, 0xe2, 0x56, 0x73, 0x35, 0xab, 0x91, 0xa7, 0xe6, 0x9a, 0xb5, 0x61, 0x3b, 0xd6, 0xbb,
+	0x1b, 0x79, 0x4f, 0xb8, 0x96, 0xad, 0x53, 0x33, 0xbb, 0x46, 0x0c, 0x5d, 0x23, 0x9c, 0xe6, 0xf7,
+	0x10, 0x3e, 0x64, 0x3a, 0xdb, 0x01, 0x51, 0xb7, 0xea, 0x96, 0xaf, 0x5c, 0x75, 0x97, 0xbd, 0x91,
+	0x37, 0xf0, 0xa8, 0x40, 0xfc, 0xb9, 0xba, 0x65, 0xd5, 0x0d, 0xea, 0x1b, 0x6b, 0x9a, 0x16, 0xf7,
+	0x6d, 0x0d, 0xb8, 0x72, 0xc0, 0x6d, 0x61, 0x68, 0xae, 0xe3, 0x09, 0x04, 0xfc, 0x67, 0x77, 0xf3,
+	0x69, 0xc3, 0xe6, 0x1b, 0x01, 0x73, 0x7c, 0x37, 0x73, 0x59, 0xa7, 0x86, 0xa6, 0x36, 0x08, 0x5b,
+	0x0d, 0x24, 0xc6, 0x76, 0x4b, 0x70, 0xbd, 0x41, 0x19, 0x27, 0x0d, 0x3b, 0x10, 0x78, 0x61, 0xaf,
+	0x47, 0x75, 0x8d, 0x9a, 0x5c, 0x5f, 0xd6, 0xa9, 0x13, 0x18, 0x99, 0xf9, 0x0b, 0x82, 0xe7, 0x8a,
+	0x6d, 0x3f, 0x2f, 0xba, 0xd5, 0xb2, 0x5b, 0x9d, 0x6b, 0x8b, 0x61, 0x02, 0xa3, 0x1d, 0x71, 0x50,
+	0x75, 0x8d, 0x49, 0x68, 0x1c, 0x4d, 0x24, 0x0a, 0x2f, 0xe6, 0xba, 0xfd, 0x9f, 0xeb, 0x80, 0xe9,
+	0x00, 0x28, 0xa5, 0x76, 0x4a, 0x03, 0x1f, 0xa2, 0xbe, 0x14, 0x7a, 0xb8, 0x39, 0x16, 0xf9, 0x62,
+	0x73, 0x0c, 0x29, 0x23, 0xa4, 0x53, 0x92, 0xe1, 0x25, 0x00, 0xdb, 0xad, 0xaa, 0xcc, 0xad, 0xaa,
+	0xba, 0x26, 0xf5, 0x8d, 0xa3, 0x89, 0x78, 0x69, 0x72, 0xa7, 0x74, 0xdc, 0xc9, 0x48, 0xc7, 0x0b,
+	0xf2, 0xed, 0x1b, 0x24, 0xfb, 0x93, 0xd3, 0xd9, 0xd7, 0x6e, 0x4d, 0x9c, 0x9f, 0xba, 0x91, 0xbd,
+	0x75, 0x3e, 0x1c, 0x9e, 0xb8, 0x5b, 0x78, 0xe9, 0xde, 0xf1, 0xe6, 0xe6, 0xd8, 0x50, 0x60, 0xf4,
+	0x8c, 0x32, 0x64, 0x07, 0xe6, 0x67, 0x7e, 0x77, 0x1c, 0x8e, 0xec, 0xd9, 0x16, 0x5e, 0x84, 0x68,
+	0xdb, 0xfe, 0x97, 0x1e, 0x63, 0xff, 0x1e, 0x37, 0x3c, 0x62, 0x17, 0x02, 0x0a, 0x4f, 0x03, 0xd4,
+	0x1c, 0x4a, 0x38, 0xd5, 0x54, 0xc2, 0x3d, 0xd3, 0x13, 0x85, 0x74, 0xce, 0x8f, 0x4c, 0x2e, 0x8c,
+	0x4c, 0xae, 0x12, 0x46, 0xa6, 0x34, 0x24, 0xd4, 0x1f, 0xfc, 0x75, 0x0c, 0x29, 0xf1, 0x40, 0xaf,
+	0xc8, 0x05, 0x88, 0x6b, 0x6b, 0x21, 0x48, 0xb4, 0x17, 0x90, 0x40, 0xaf, 0xc8, 0xf1, 0x79, 0x88,
+	0x2d, 0x5b, 0x4e, 0x83, 0x70, 0xa9, 0xdf, 0x73, 0xe0, 0x0f, 0x7c, 0x07, 0x1e, 0xdd, 0xcf, 0x81,
+	0x4a, 0xa0, 0x86, 0x17, 0xa0, 0xdf, 0x24, 0x9c, 0x49, 0x47, 0xbc, 0xf5, 0x73, 0xfb, 0x7a, 0x27,
+	0xb7, 0x50, 0xac, 0x94, 0x17, 0x1d, 0x6b, 0x4d, 0xd7, 0xa8, 0x53, 0x1a, 0x6a, 0x6e, 0x8e, 0xf5,
+	0x8b, 0x99, 0xd9, 0x88, 0xe2, 0xe1, 0x08, 0xbc, 0xc6, 0x1d, 0xce, 0xa5, 0x63, 0x07, 0xc5, 0xbb,
+	0xba, 0x54, 0xa9, 0x74, 0xe3, 0x89, 0x19, 0x81, 0x27, 0x70, 0xf0, 0x5b, 0x30, 0x48, 0xd6, 0x99,
+	0xaa, 0x5b, 0x5c, 0xa2, 0x1e, 0xe4, 0xe9, 0xfd, 0x21, 0x8b, 0x6f, 0x95, 0xe7, 0xac, 0x36, 0x28,
+	0x34, 0x37, 0xc7, 0x62, 0xfe, 0xdc, 0x6c, 0x44, 0x89, 0x91, 0x75, 0x36, 0x67, 0x71, 0x7c, 0x19,
+	0x06, 0x56, 0xc9, 0xf2, 0x2a, 0x91, 0x8e, 0x7a, 0xb0, 0xf9, 0xfd, 0x61, 0xaf, 0x08, 0xf1, 0x10,
+	0x75, 0x36, 0xa2, 0xf8, 0xfa, 0xf8, 0x45, 0x80, 0x2a, 0x61, 0x54, 0xe5, 0x96, 0xad, 0xd7, 0xa4,
+	0x98, 0x17, 0x86, 0xc1, 0x9d, 0x52, 0xbf, 0xd3, 0x27, 0x69, 0x4a, 0x5c, 0xb0, 0x2a, 0x82, 0x83,
+	0x17, 0x60, 0x58, 0xb3, 0xd6, 0x4d, 0x43, 0x37, 0x57, 0x55, 0xdb, 0x65, 0x2b, 0xd2, 0xa0, 0xb7,
+	0xf0, 0x89, 0x03, 0xb8, 0x88, 0x32, 0x46, 0xea, 0x54, 0x49, 0x86, 0xfa, 0x8b, 0x2e, 0x5b, 0xc1,
+	0x15, 0x48, 0xb5, 0xf0, 0x1c, 0x6a, 0x1b, 0xa4, 0x46, 0xa5, 0xa1, 0x5e, 0x21, 0x47, 0x43, 0x08,
+	0xc5, 0x47, 0xc0, 0x8b, 0x30, 0xe2, 0xda, 0x1e, 0x66, 0xc3, 0x17, 0x91, 0xe2, 0xbd, 0x62, 0x0e,
+	0xfb, 0x00, 0xc1, 0x10, 0xbf, 0x01, 0x89, 0x77, 0x2c, 0xdd, 0x54, 0x49, 0xad, 0x46, 0x6d, 0x2e,
+	0x41, 0xaf, 0x70, 0x20, 0xb4, 0x8b, 0x9e, 0x32, 0x9e, 0x87, 0x96, 0x0f, 0x54, 0x52, 0x5b, 0x95,
+	0x12, 0xbd, 0x82, 0x25, 0x42, 0xf5, 0x62, 0x6d, 0xb5, 0x2b, 0x22, 0xa6, 0x80, 0x4b, 0x1e, 0x3a,
+	0x22, 0x0b, 0x64, 0x17, 0x1e, 0xa3, 0x26, 0x97, 0x86, 0x0f, 0x8d, 0x57, 0xa6, 0x26, 0xc7, 0x0a,
+	0xb4, 0xc2, 0xa3, 0x2e, 0x13, 0xdd, 0xa0, 0x9a, 0x34, 0xd2, 0x2b, 0xe2, 0x48, 0x88, 0x70, 0xc9,
+	0x03, 0xe8, 0xc2, 0xbc, 0xe3, 0x52, 0x97, 0x6a, 0xd2, 0xe8, 0xa1, 0x31, 0x97, 0x3c, 0x00, 0x5c,
+	0x87, 0x74, 0x37, 0xa6, 0xaa, 0x9b, 0x61, 0x89, 0xd5, 0xa4, 0xa7, 0x7a, 0x85, 0x97, 0xba, 0xe0,
+	0xe7, 0xda, 0x50, 0xc2, 0x78, 0xc3, 0x0a, 0x4a, 0x12, 0xb3, 0x8c, 0x35, 0xaa, 0x49, 0xa9, 0x9e,
+	0x8d, 0x0f, 0x11, 0xca, 0x1e, 0x80, 0x48, 0x29, 0xd1, 0x66, 0xe8, 0x35, 0xaa, 0x6a, 0x84, 0x13,
+	0x09, 0xf7, 0x9c, 0x52, 0x81, 0xfa, 0x0c, 0xe1, 0x24, 0x3d, 0x03, 0xc9, 0xce, 0x03, 0x12, 0xbf,
+	0x0c, 0x10, 0x34, 0x31, 0xae, 0x63, 0x78, 0x25, 0x28, 0x5e, 0x7a, 0x7a, 0xa7, 0x34, 0xe0, 0x44,
+	0x3f, 0x40, 0xa8, 0xb9, 0x39, 0x16, 0x2f, 0x7b, 0xdc, 0x6b, 0xca, 0xbc, 0x12, 0xf7, 0x05, 0xaf,
+	0x39, 0x46, 0xfa, 0xe3, 0x18, 0x24, 0x3b, 0xcf, 0xc5, 0xc3, 0xc1, 0xe0, 0xd3, 0x10, 0xaf, 0x19,
+	0x3a, 0x35, 0x79, 0xbb, 0xc0, 0x3e, 0xe5, 0x1f, 0x4c, 0xcf, 0x88, 0x02, 0x3a, 0xed, 0xf1, 0x44,
+	0x01, 0xf5, 0xa5, 0xe6, 0x34, 0xfc, 0x02, 0x0c, 0xb9, 0x8c, 0x3a, 0x26, 0x69, 0x50, 0x29, 0xda,
+	0x7d, 0x92, 0xb5, 0x18, 0x42, 0xc8, 0x26, 0x8c, 0xad, 0x5b, 0x8e, 0x26, 0xf5, 0xef, 0x12, 0x0a,
+	0x19, 0x58, 0x87, 0x61, 0xe6, 0x56, 0x59, 0xcd, 0xd1, 0xab, 0x54, 0xbd, 0x63, 0x31, 0x69, 0x60,
+	0x1c, 0x4d, 0x8c, 0x14, 0x0a, 0xbd, 0x15, 0x84, 0xdc, 0x92, 0x55, 0x2e, 0xa5, 0x9a, 0x9b, 0x63,
+	0xc9, 0x72, 0x08, 0xb6, 0x64, 0x95, 0x95, 0x24, 0x6b, 0x8f, 0x18, 0xae, 0x41, 0xc2, 0x76, 0xab,
+	0x86, 0xce, 0x56, 0xbc, 0x85, 0x62, 0x87, 0x5e, 0x68, 0xa4, 0xb9, 0x39, 0x06, 0x8b, 0x3e, 0x94,
+	0x58, 0x06, 0xec, 0x90, 0x66, 0xf8, 0x05, 0x18, 0x74, 0xc5, 0x21, 0x6f, 0x30, 0xef, 0xdc, 0x1e,
+	0xf2, 0xab, 0xca, 0x35, 0x46, 0x2b, 0xf3, 0x65, 0x25, 0xe6, 0x32, 0x5a, 0x31, 0x18, 0x1e, 0x87,
+	0x18, 0x37, 0x98, 0x5a, 0x23, 0xde, 0x41, 0x9c, 0x2c, 0xc5, 0x9b, 0x9b, 0x63, 0x03, 0x95, 0xf9,
+	0xf2, 0x74, 0x51, 0x19, 0xe0, 0x06, 0x9b, 0x26, 0xf8, 0x35, 0x18, 0xf5, 0x24, 0xfc, 0xb0, 0xd4,
+	0xa8, 0xc3, 0xbd, 0xf3, 0x35, 0x59, 0x3a, 0xd2, 0xdc, 0x1c, 0x1b, 0x16, 0xa2, 0x1e, 0x67, 0x9a,
+	0x3a, 0x5c, 0x19, 0x16, 0x2a, 0xad, 0x21, 0x3e, 0x0b, 0x23, 0x1d, 0xaa, 0xab, 0x74, 0xc3, 0x3b,
+	0x4a, 0x93, 0xbe, 0x7b, 0x5a, 0x9a, 0x57, 0xe8, 0x86, 0x92, 0x6c, 0x29, 0x5e, 0xa1, 0x1b, 0xf8,
+	0x1a, 0x0c, 0xae, 0x50, 0xa2, 0x51, 0x87, 0x49, 0x89, 0xf1, 0xe8, 0x44, 0xa2, 0x70, 0xae, 0x47,
+	0xd7, 0xcc, 0xfa, 0xda, 0x17, 0x4d, 0xee, 0x6c, 0x28, 0x21, 0x56, 0x7a, 0x0a, 0x92, 0x9d, 0x0c,
+	0x9c, 0x82, 0xa8, 0xb0, 0xc9, 0xcb, 0x4d, 0x45, 0x90, 0xf8, 0x28, 0x0c, 0xac, 0x11, 0xc3, 0xa5,
+	0x7e, 0xea, 0x29, 0xfe, 0x60, 0xaa, 0xef, 0x55, 0x94, 0x79, 0x1d, 0xa2, 0x4b, 0x56, 0x19, 0xa7,
+	0x20, 0x59, 0xac, 0xa8, 0x57, 0xdf, 0x2c, 0x57, 0xd4, 0x37, 0x17, 0xa6, 0x2f, 0xa6, 0x22, 0xf8,
+	0x08, 0x0c, 0x17, 0x2b, 0xea, 0xfc, 0xc5, 0x62, 0x38, 0x85, 0x84, 0xd0, 0xc5, 0x1f, 0x17, 0xa7,
+	0x2b, 0xf3, 0x6f, 0xfb, 0x33, 0x7d, 0xe9, 0x7f, 0x03, 0x8c, 0x74, 0x97, 0x78, 0xfc, 0x71, 0x1f,
+	0xc4, 0x1c, 0x5a, 0xd7, 0x2d, 0x33, 0xf8, 0x38, 0xde, 0xef, 0xdb, 0x29, 0xfd, 0x07, 0x39, 0xff,
+	0x42, 0x0a, 0x90, 0xe5, 0x2c, 0xb3, 0x5c, 0xbe, 0x92, 0x3d, 0xa3, 0xc4, 0x89, 0x9d, 0xa5, 0x84,
+	0xf1, 0xec, 0x19, 0xd1, 0x8d, 0x66, 0x4d, 0xcb, 0xe1, 0x2b, 0x8f, 0x1c, 0x17, 0x14, 0x20, 0x76,
+	0x4b, 0x6d, 0x24, 0xa4, 0x3b, 0x64, 0xdb, 0xe3, 0x82, 0x92, 0xac, 0x91, 0x6c, 0x8d, 0x9a, 0xdc,
+	0x21, 0x46, 0xf6, 0x8c, 0x92, 0xa4, 0x6e, 0xc7, 0x08, 0xa8, 0xeb, 0xe3, 0x06, 0x74, 0xcb, 0x14,
+	0xea, 0x66, 0xd7, 0x29, 0xe3, 0x9d, 0x64, 0xa1, 0x4d, 0x4e, 0x2a, 0xd0, 0xa0, 0x6d, 0x61, 0x46,
+	0x42, 0xbb, 0xe3, 0x2e, 0xdb, 0x43, 0x16, 0x3c, 0x32, 0x44, 0x0b, 0xc9, 0x82, 0x12, 0xb8, 0x04,
+	0xbf, 0x0d, 0x20, 0x8a, 0x2f, 0x63, 0x5e, 0xd6, 0xf8, 0xed, 0xea, 0x54, 0xaf, 0x6d, 0x54, 0xae,
+	0xe8, 0x41, 0x88, 0xfc, 0x8a, 0x93, 0x90, 0xc4, 0x37, 0x21, 0x41, 0x18, 0x73, 0x1b, 0x54, 0x75,
+	0x2c, 0x83, 0x06, 0x5d, 0xec, 0xb9, 0xde, 0xb1, 0x3d, 0x0c, 0xc5, 0x32, 0xa8, 0x02, 0xa4, 0x45,
+	0xe3, 0x5f, 0x23, 0x48, 0x51, 0x53, 0xb3, 0x2d, 0xdd, 0xe4, 0x2a, 0xd1, 0x34, 0x87, 0x32, 0x16,
+	0x1c, 0x39, 0xef, 0xee, 0x94, 0x5c, 0x87, 0x49, 0xf7, 0x51, 0xc1, 0xbc, 0x3d, 0x31, 0x31, 0x21,
+	0xba, 0xdb, 0x62, 0xf6, 0xba, 0x68, 0x70, 0xdf, 0xeb, 0xa0, 0xdb, 0xe4, 0xcd, 0xec, 0xad, 0x93,
+	0x1d, 0x8c, 0x13, 0x37, 0x73, 0x27, 0x4e, 0x4e, 0xdc, 0x28, 0x66, 0xaf, 0x07, 0x6d, 0xf1, 0x7b,
+	0x1d, 0x74, 0x9b, 0xf4, 0xb4, 0xda, 0x8c, 0x13, 0xef, 0x9d, 0x38, 0xae, 0x8c, 0x86, 0x16, 0x15,
+	0x7d, 0x83, 0x1e, 0xf5, 0x4d, 0xc7, 0x0e, 0xfd, 0x4d, 0x0f, 0x1e, 0xe8, 0x9b, 0x56, 0x61, 0x50,
+	0xa3, 0xcb, 0xc4, 0x35, 0xb8, 0x77, 0xae, 0x26, 0x0a, 0xd3, 0x3d, 0xbb, 0x7c, 0xc6, 0xd7, 0x9f,
+	0x33, 0x39, 0xad, 0xfb, 0x17, 0xd4, 0xd9, 0x88, 0x12, 0xa2, 0xa6, 0xff, 0x80, 0x20, 0xde, 0x0a,
+	0x38, 0xbe, 0x04, 0xc3, 0xed, 0x04, 0x12, 0xc5, 0xc4, 0xff, 0xc8, 0x32, 0x3b, 0xa5, 0x94, 0x33,
+	0x92, 0x4a, 0x89, 0x28, 0x0c, 0xde, 0xbe, 0x71, 0x73, 0xfd, 0xd6, 0x49, 0x71, 0x33, 0x4b, 0xb4,
+	0x14, 0xe7, 0x66, 0x94, 0x44, 0x2b, 0x57, 0xe6, 0x34, 0x3c, 0x09, 0x47, 0x18, 0xad, 0x39, 0x94,
+	0xab, 0xbb, 0xf2, 0xb1, 0x55, 0x42, 0x26, 0x94, 0x51, 0x5f, 0xa2, 0xbd, 0x78, 0x16, 0x86, 0x19,
+	0x65, 0x4c, 0xd4, 0x7c, 0x6e, 0xad, 0x52, 0x33, 0x28, 0x4c, 0x43, 0x5e, 0xf9, 0x93, 0xee, 0xf7,
+	0x29, 0xc9, 0x80, 0x5d, 0x11, 0xdc, 0xf4, 0x3f, 0x11, 0x40, 0x3b, 0x9d, 0xb0, 0x02, 0x51, 0xe2,
+	0x84, 0xa7, 0xc2, 0x85, 0x9d, 0xd2, 0x59, 0xe7, 0xe5, 0x42, 0xe1, 0x36, 0x71, 0xcc, 0x29, 0xb2,
+	0xce, 0xa6, 0x74, 0xd2, 0x98, 0x9a, 0xba, 0x21, 0x42, 0x7b, 0xf7, 0x4c, 0xe1, 0xde, 0x94, 0xc8,
+	0xe1, 0x9b, 0xf9, 0x76, 0xc0, 0xd5, 0x53, 0x3f, 0x7c, 0x29, 0x77, 0x21, 0x7b, 0xeb, 0x94, 0xd8,
+	0x56, 0xb4, 0xa8, 0x2c, 0x28, 0x02, 0x0c, 0xcf, 0x41, 0x82, 0xbe, 0xcb, 0x45, 0x35, 0x34, 0xda,
+	0x95, 0x75, 0x62, 0xa7, 0xf4, 0x8c, 0xf3, 0xb4, 0xf4, 0x30, 0x5e, 0x48, 0x09, 0x57, 0x78, 0x9a,
+	0x53, 0x37, 0xf3, 0x59, 0xdf, 0x27, 0x70, 0x31, 0x50, 0x98, 0x9b, 0x51, 0x20, 0x54, 0x9e, 0xd3,
+	0xf0, 0x1b, 0x90, 0x0a, 0x37, 0x17, 0xbe, 0x13, 0x04, 0x1f, 0xd1, 0xb1, 0x3d, 0x57, 0xc1, 0x99,
+	0x40, 0xa0, 0xd4, 0xff, 0x91, 0xb8, 0x05, 0x8e, 0x06, 0x8a, 0xe1, 0x74, 0xfa, 0x2d, 0xc0, 0x7b,
+	0x83, 0x8a, 0x8b, 0x00, 0xde, 0x4b, 0x80, 0xea, 0x15, 0xf5, 0x56, 0xe0, 0xc6, 0x9c, 0xe7, 0x45,
+	0xd8, 0xa4, 0xdb, 0xc1, 0x6e, 0x77, 0x25, 0xfd, 0x71, 0x25, 0xee, 0x69, 0x2d, 0x90, 0x06, 0x2d,
+	0x25, 0x01, 0x34, 0x6a, 0x1b, 0xd6, 0x46, 0x83, 0x9a, 0x3c, 0xfd, 0xe1, 0x00, 0x0c, 0x77, 0x5d,
+	0x85, 0x70, 0x16, 0x06, 0xab, 0x8e, 0xb5, 0x2a, 0x2a, 0x0c, 0x1a, 0x8f, 0xfa, 0x5d, 0x46, 0xea,
+	0x67, 0x68, 0x78, 0x08, 0xa5, 0x52, 0x99, 0x41, 0x67, 0x20, 0x85, 0x44, 0x98, 0x42, 0x19, 0x3c,
+	0x0d, 0x23, 0x35, 0xcb, 0x14, 0x11, 0x72, 0xd4, 0xba, 0x63, 0xb9, 0x76, 0xe0, 0xc1, 0xe7, 0x76,
+	0x4a, 0xc7, 0x9c, 0x67, 0x52, 0x48, 0xd2, 0x0a, 0x47, 0x6e, 0xb7, 0xbf, 0xd5, 0x9c, 0xea, 0xd9,
+	0x33, 0x1c, 0xea, 0x5c, 0x16, 0x2a, 0x58, 0x81, 0x7e, 0x46, 0x98, 0x11, 0x38, 0xeb, 0xe5, 0x1e,
+	0x6f, 0x6f, 0xb9, 0x72, 0xb1, 0x3c, 0xef, 0xdf, 0x36, 0x05, 0xa5, 0x78, 0x58, 0x9d, 0x35, 0xbe,
+	0xff, 0x00, 0x35, 0x7e, 0xe0, 0xe0, 0x35, 0xfe, 0x7b, 0x3e, 0x0f, 0xd2, 0xff, 0x40, 0xe0, 0x6d,
+	0x04, 0x2f, 0x43, 0xbc, 0x41, 0x6b, 0x2b, 0xc4, 0xd4, 0x59, 0xc3, 0x0b, 0xf6, 0x48, 0xe1, 0x47,
+	0x87, 0xf1, 0x4d, 0xee, 0x6a, 0x88, 0xe2, 0x7d, 0x68, 0xef, 0x8b, 0x37, 0x10, 0xa5, 0x0d, 0xdd,
+	0xd5, 0x28, 0xf6, 0x1d, 0xa4, 0x51, 0x8c, 0x7e, 0x47, 0xa3, 0x98, 0x79, 0x1d, 0xe2, 0xad, 0xb5,
+	0x70, 0x1c, 0x06, 0x16, 0xe7, 0x8b, 0x73, 0x0b, 0x7e, 0x2b, 0x50, 0x9e, 0x56, 0x8a, 0x57, 0xd5,
+	0xf2, 0x6c, 0x51, 0x2d, 0xbc, 0x72, 0x36, 0x85, 0xba, 0xa7, 0x5e, 0x39, 0x53, 0x48, 0xf5, 0xa5,
+	0x27, 0x60, 0x30, 0xbc, 0x67, 0x3e, 0x0f, 0x03, 0xfe, 0x15, 0x1c, 0x75, 0x2f, 0xe5, 0xcf, 0x96,
+	0x46, 0x61, 0xc8, 0x0e, 0x13, 0x36, 0xfa, 0x6d, 0x09, 0x65, 0x96, 0x00, 0xef, 0xf1, 0x04, 0xc3,
+	0xe7, 0x60, 0xd0, 0x7f, 0x6e, 0xf4, 0x73, 0x39, 0x51, 0xf8, 0xff, 0x7d, 0xdd, 0xa7, 0x84, 0x1a,
+	0x99, 0xdf, 0x22, 0x90, 0xf6, 0xb0, 0x2f, 0x79, 0x0f, 0x2d, 0x0c, 0xbf, 0x09, 0x83, 0xfe, 0x9b,
+	0x4b, 0x88, 0xfc, 0xca, 0xbe, 0xc8, 0x81, 0x6a, 0x2e, 0xf8, 0x0d, 0x3a, 0xb0, 0x00, 0x45, 0x74,
+	0x60, 0x9d, 0x8c, 0x9e, 0x3a, 0xb0, 0x4f, 0x11, 0x3c, 0x7b, 0x99, 0xf2, 0xbd, 0x7b, 0xa1, 0x77,
+	0x5c, 0xca, 0xf8, 0xf7, 0xf0, 0x66, 0x76, 0x1e, 0xa0, 0xfd, 0x98, 0xf9, 0x9d, 0x6f, 0x66, 0x97,
+	0x84, 0xc8, 0x55, 0xc2, 0x56, 0x4b, 0xfd, 0x42, 0x5d, 0x89, 0x2f, 0x87, 0x13, 0x99, 0x3f, 0x21,
+	0x78, 0x7e, 0x5e, 0x67, 0x7b, 0x6d, 0x66, 0xa1, 0xd1, 0xff, 0x83, 0x47, 0xcb, 0x27, 0xde, 0xc5,
+	0xef, 0x11, 0x3c, 0x5b, 0x7e, 0x8c, 0xe3, 0xaf, 0x40, 0xcc, 0xcf, 0xa6, 0xc0, 0xf4, 0xfd, 0xd3,
+	0xef, 0x11, 0x56, 0x07, 0x10, 0x4f, 0x6c, 0x6d, 0xe1, 0x8f, 0x31, 0x38, 0xf6, 0x08, 0x53, 0xeb,
+	0x3a, 0x13, 0x09, 0xf7, 0x0e, 0xc0, 0x65, 0xca, 0xc3, 0xfc, 0xfe, 0xbf, 0x3d, 0xc0, 0x17, 0xc5,
+	0xcb, 0x76, 0x7a, 0xe2, 0xa0, 0x69, 0x9e, 0x49, 0xbf, 0xff, 0xe7, 0xbf, 0xfd, 0xbc, 0xef, 0x28,
+	0xc6, 0x79, 0xc2, 0xf2, 0xfe, 0x16, 0xb2, 0x41, 0xb2, 0xe3, 0x5f, 0x22, 0x88, 0x5e, 0xa6, 0x1c,
+	0x9f, 0xda, 0x8d, 0xf6, 0x98, 0x2c, 0x4e, 0xef, 0xef, 0xbc, 0xcc, 0xac, 0xb7, 0x66, 0x09, 0x5f,
+	0x68, 0xaf, 0x99, 0xbf, 0xab, 0x6b, 0x2c, 0xb7, 0x2b, 0x93, 0x76, 0x8d, 0xef, 0xf9, 0x42, 0xed,
+	0x07, 0xec, 0x7b, 0xf8, 0xa7, 0x08, 0xfa, 0x45, 0x7e, 0xe2, 0xec, 0xee, 0x55, 0x1f, 0x9b, 0xb5,
+	0xe9, 0xcc, 0xbe, 0x46, 0xb2, 0xcc, 0xa4, 0x67, 0x65, 0x16, 0x9f, 0xea, 0xb4, 0x72, 0x1f, 0x0b,
+	0xf1, 0xdf, 0x11, 0x44, 0xcb, 0x8f, 0x72, 0x59, 0xf9, 0xc9, 0x5c, 0xf6, 0x0b, 0xe4, 0x59, 0xf3,
+	0x00, 0xa5, 0x17, 0x3a, 0xcd, 0xf1, 0x7f, 0x73, 0x07, 0xf2, 0x5d, 0x87, 0x6c, 0x87, 0x0b, 0xa7,
+	0xd0, 0xc9, 0xeb, 0xe7, 0x32, 0x67, 0x0f, 0x07, 0x3a, 0x85, 0x4e, 0xe2, 0x07, 0x08, 0x62, 0x33,
+	0xd4, 0xa0, 0x9c, 0xe2, 0x9e, 0xce, 0xac, 0xf4, 0x77, 0xe4, 0x6e, 0xe6, 0x82, 0xb7, 0xd3, 0xa9,
+	0x93, 0xaf, 0xf6, 0xe0, 0xf7, 0xfc, 0xdd, 0x8e, 0x2d, 0x95, 0x7e, 0x83, 0x1e, 0x6e, 0xc9, 0xe8,
+	0x8b, 0x2d, 0x19, 0x7d, 0xb9, 0x25, 0x47, 0xbe, 0xda, 0x92, 0x23, 0x5f, 0x6f, 0xc9, 0x91, 0x6f,
+	0xb6, 0xe4, 0xc8, 0xb7, 0x5b, 0x32, 0xba, 0xdf, 0x94, 0xd1, 0x07, 0x4d, 0x39, 0xf2, 0x49, 0x53,
+	0x46, 0x9f, 0x36, 0xe5, 0xc8, 0x67, 0x4d, 0x39, 0xf2, 0x79, 0x53, 0x8e, 0x3c, 0x6c, 0xca, 0xe8,
+	0x8b, 0xa6, 0x8c, 0xbe, 0x6c, 0xca, 0x91, 0xaf, 0x9a, 0x32, 0xfa, 0xba, 0x29, 0x47, 0xbe, 0x69,
+	0xca, 0xe8, 0xdb, 0xa6, 0x1c, 0xb9, 0xbf, 0x2d, 0x47, 0x3e, 0xd8, 0x96, 0xd1, 0x83, 0x6d, 0x39,
+	0xf2, 0xd1, 0xb6, 0x8c, 0x7e, 0xb5, 0x2d, 0x47, 0x3e, 0xd9, 0x96, 0x23, 0x9f, 0x6e, 0xcb, 0xe8,
+	0xb3, 0x6d, 0x19, 0x7d, 0xbe, 0x2d, 0xa3, 0xeb, 0xf9, 0xba, 0x95, 0xe3, 0x2b, 0x94, 0xaf, 0xe8,
+	0x66, 0x9d, 0xe5, 0x4c, 0xca, 0xd7, 0x2d, 0x67, 0x35, 0xdf, 0xfd, 0xd7, 0xd0, 0xda, 0x64, 0xde,
+	0x5e, 0xad, 0xe7, 0x39, 0x37, 0xed, 0x6a, 0x35, 0xe6, 0xed, 0x7c, 0xf2, 0xbf, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0x50, 0x8b, 0x83, 0xd6, 0x91, 0x1b, 0x00, 0x00,
 }
 
 func (x ApplicationPubSub_MQTTProvider_QoS) String() string {
@@ -1283,6 +1521,13 @@ func (x ApplicationPubSub_MQTTProvider_QoS) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x ApplicationPubSub_KafkaProvider_SASL_Mechanism) String() string {
+	s, ok := ApplicationPubSub_KafkaProvider_SASL_Mechanism_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (this *ApplicationPubSubIdentifiers) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1463,6 +1708,30 @@ func (this *ApplicationPubSub_AWSIoT) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationPubSub_Kafka) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_Kafka)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_Kafka)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Kafka.Equal(that1.Kafka) {
+		return false
+	}
+	return true
+}
 func (this *ApplicationPubSub_NATSProvider) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1577,6 +1846,12 @@ func (this *ApplicationPubSub_AWSIoTProvider) Equal(that interface{}) bool {
 	if this.EndpointAddress != that1.EndpointAddress {
 		return false
 	}
+	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
+		return false
+	}
 	if that1.Deployment == nil {
 		if this.Deployment != nil {
 			return false
@@ -1702,6 +1977,83 @@ func (this *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) Equal(that inte
 	}
 	return true
 }
+func (this *ApplicationPubSub_KafkaProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_KafkaProvider)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_KafkaProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Brokers) != len(that1.Brokers) {
+		return false
+	}
+	for i := range this.Brokers {
+		if this.Brokers[i] != that1.Brokers[i] {
+			return false
+		}
+	}
+	if this.ConsumerGroup != that1.ConsumerGroup {
+		return false
+	}
+	if !this.SASL.Equal(that1.SASL) {
+		return false
+	}
+	if this.UseTLS != that1.UseTLS {
+		return false
+	}
+	if !bytes.Equal(this.TLSCA, that1.TLSCA) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationPubSub_KafkaProvider_SASL) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_KafkaProvider_SASL)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_KafkaProvider_SASL)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Mechanism != that1.Mechanism {
+		return false
+	}
+	if this.Username != that1.Username {
+		return false
+	}
+	if this.Password != that1.Password {
+		return false
+	}
+	return true
+}
 func (this *ApplicationPubSub_Message) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2375,6 +2727,29 @@ func (m *ApplicationPubSub_NATS) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ApplicationPubSub_Kafka) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_Kafka) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ApplicationPubSub_MQTT) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -2582,17 +2957,31 @@ func (m *ApplicationPubSub_AWSIoTProvider) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
-	if m.Deployment != nil {
-		{
-			size := m.Deployment.Size()
-			i -= size
-			if _, err := m.Deployment.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if len(m.EndpointAddress) > 0 {
-		i -= len(m.EndpointAddress)
+	if len(m.TLSClientKey) > 0 {
+		i -= len(m.TLSClientKey)
+		copy(dAtA[i:], m.TLSClientKey)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TLSClientCert) > 0 {
+		i -= len(m.TLSClientCert)
+		copy(dAtA[i:], m.TLSClientCert)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientCert)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Deployment != nil {
+		{
+			size := m.Deployment.Size()
+			i -= size
+			if _, err := m.Deployment.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.EndpointAddress) > 0 {
+		i -= len(m.EndpointAddress)
 		copy(dAtA[i:], m.EndpointAddress)
 		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.EndpointAddress)))
 		i--
@@ -2718,12 +3107,12 @@ func (m *ApplicationPubSub_AWSIoTProvider_AssumeRole) MarshalToSizedBuffer(dAtA
 	var l int
 	_ = l
 	if m.SessionDuration != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.SessionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.SessionDuration):])
-		if err23 != nil {
-			return 0, err23
+		n24, err24 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.SessionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.SessionDuration):])
+		if err24 != nil {
+			return 0, err24
 		}
-		i -= n23
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n23))
+		i -= n24
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n24))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2774,6 +3163,130 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) MarshalToSizedBuff
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationPubSub_KafkaProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_KafkaProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TLSClientKey) > 0 {
+		i -= len(m.TLSClientKey)
+		copy(dAtA[i:], m.TLSClientKey)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TLSClientCert) > 0 {
+		i -= len(m.TLSClientCert)
+		copy(dAtA[i:], m.TLSClientCert)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientCert)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TLSCA) > 0 {
+		i -= len(m.TLSCA)
+		copy(dAtA[i:], m.TLSCA)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSCA)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UseTLS {
+		i--
+		if m.UseTLS {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SASL != nil {
+		{
+			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerGroup) > 0 {
+		i -= len(m.ConsumerGroup)
+		copy(dAtA[i:], m.ConsumerGroup)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ConsumerGroup)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Brokers) > 0 {
+		for iNdEx := len(m.Brokers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Brokers[iNdEx])
+			copy(dAtA[i:], m.Brokers[iNdEx])
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Brokers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Mechanism != 0 {
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(m.Mechanism))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationPubSub_Message) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3073,10 +3586,12 @@ func NewPopulatedApplicationPubSub(r randyApplicationserverPubsub, easy bool) *A
 	if r.Intn(5) != 0 {
 		this.LocationSolved = NewPopulatedApplicationPubSub_Message(r, easy)
 	}
-	oneofNumber_Provider := []int32{17, 25, 101}[r.Intn(3)]
+	oneofNumber_Provider := []int32{17, 20, 25, 101}[r.Intn(4)]
 	switch oneofNumber_Provider {
 	case 17:
 		this.Provider = NewPopulatedApplicationPubSub_NATS(r, easy)
+	case 20:
+		this.Provider = NewPopulatedApplicationPubSub_Kafka(r, easy)
 	case 25:
 		this.Provider = NewPopulatedApplicationPubSub_MQTT(r, easy)
 	case 101:
@@ -3098,6 +3613,11 @@ func NewPopulatedApplicationPubSub_NATS(r randyApplicationserverPubsub, easy boo
 	this.NATS = NewPopulatedApplicationPubSub_NATSProvider(r, easy)
 	return this
 }
+func NewPopulatedApplicationPubSub_Kafka(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Kafka {
+	this := &ApplicationPubSub_Kafka{}
+	this.Kafka = NewPopulatedApplicationPubSub_KafkaProvider(r, easy)
+	return this
+}
 func NewPopulatedApplicationPubSub_MQTT(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_MQTT {
 	this := &ApplicationPubSub_MQTT{}
 	this.MQTT = NewPopulatedApplicationPubSub_MQTTProvider(r, easy)
@@ -3167,6 +3687,16 @@ func NewPopulatedApplicationPubSub_AWSIoTProvider(r randyApplicationserverPubsub
 	case 5:
 		this.Deployment = NewPopulatedApplicationPubSub_AWSIoTProvider_Default(r, easy)
 	}
+	v9 := r.Intn(100)
+	this.TLSClientCert = make([]byte, v9)
+	for i := 0; i < v9; i++ {
+		this.TLSClientCert[i] = byte(r.Intn(256))
+	}
+	v10 := r.Intn(100)
+	this.TLSClientKey = make([]byte, v10)
+	for i := 0; i < v10; i++ {
+		this.TLSClientKey[i] = byte(r.Intn(256))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3207,6 +3737,48 @@ func NewPopulatedApplicationPubSub_AWSIoTProvider_DefaultIntegration(r randyAppl
 	return this
 }
 
+func NewPopulatedApplicationPubSub_KafkaProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_KafkaProvider {
+	this := &ApplicationPubSub_KafkaProvider{}
+	v11 := r.Intn(10)
+	this.Brokers = make([]string, v11)
+	for i := 0; i < v11; i++ {
+		this.Brokers[i] = randStringApplicationserverPubsub(r)
+	}
+	this.ConsumerGroup = randStringApplicationserverPubsub(r)
+	if r.Intn(5) != 0 {
+		this.SASL = NewPopulatedApplicationPubSub_KafkaProvider_SASL(r, easy)
+	}
+	this.UseTLS = bool(r.Intn(2) == 0)
+	v12 := r.Intn(100)
+	this.TLSCA = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.TLSCA[i] = byte(r.Intn(256))
+	}
+	v13 := r.Intn(100)
+	this.TLSClientCert = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.TLSClientCert[i] = byte(r.Intn(256))
+	}
+	v14 := r.Intn(100)
+	this.TLSClientKey = make([]byte, v14)
+	for i := 0; i < v14; i++ {
+		this.TLSClientKey[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_KafkaProvider_SASL(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_KafkaProvider_SASL {
+	this := &ApplicationPubSub_KafkaProvider_SASL{}
+	this.Mechanism = ApplicationPubSub_KafkaProvider_SASL_Mechanism([]int32{0, 1, 2}[r.Intn(3)])
+	this.Username = randStringApplicationserverPubsub(r)
+	this.Password = randStringApplicationserverPubsub(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedApplicationPubSub_Message(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Message {
 	this := &ApplicationPubSub_Message{}
 	this.Topic = randStringApplicationserverPubsub(r)
@@ -3218,9 +3790,9 @@ func NewPopulatedApplicationPubSub_Message(r randyApplicationserverPubsub, easy
 func NewPopulatedApplicationPubSubs(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubs {
 	this := &ApplicationPubSubs{}
 	if r.Intn(5) != 0 {
-		v9 := r.Intn(5)
-		this.Pubsubs = make([]*ApplicationPubSub, v9)
-		for i := 0; i < v9; i++ {
+		v15 := r.Intn(5)
+		this.Pubsubs = make([]*ApplicationPubSub, v15)
+		for i := 0; i < v15; i++ {
 			this.Pubsubs[i] = NewPopulatedApplicationPubSub(r, easy)
 		}
 	}
@@ -3232,9 +3804,9 @@ func NewPopulatedApplicationPubSubs(r randyApplicationserverPubsub, easy bool) *
 func NewPopulatedApplicationPubSubFormats(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubFormats {
 	this := &ApplicationPubSubFormats{}
 	if r.Intn(5) != 0 {
-		v10 := r.Intn(10)
+		v16 := r.Intn(10)
 		this.Formats = make(map[string]string)
-		for i := 0; i < v10; i++ {
+		for i := 0; i < v16; i++ {
 			this.Formats[randStringApplicationserverPubsub(r)] = randStringApplicationserverPubsub(r)
 		}
 	}
@@ -3245,10 +3817,10 @@ func NewPopulatedApplicationPubSubFormats(r randyApplicationserverPubsub, easy b
 
 func NewPopulatedGetApplicationPubSubRequest(r randyApplicationserverPubsub, easy bool) *GetApplicationPubSubRequest {
 	this := &GetApplicationPubSubRequest{}
-	v11 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
-	this.ApplicationPubSubIdentifiers = *v11
-	v12 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v12
+	v17 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
+	this.ApplicationPubSubIdentifiers = *v17
+	v18 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v18
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3256,10 +3828,10 @@ func NewPopulatedGetApplicationPubSubRequest(r randyApplicationserverPubsub, eas
 
 func NewPopulatedListApplicationPubSubsRequest(r randyApplicationserverPubsub, easy bool) *ListApplicationPubSubsRequest {
 	this := &ListApplicationPubSubsRequest{}
-	v13 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v13
-	v14 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v14
+	v19 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v19
+	v20 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v20
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3267,10 +3839,10 @@ func NewPopulatedListApplicationPubSubsRequest(r randyApplicationserverPubsub, e
 
 func NewPopulatedSetApplicationPubSubRequest(r randyApplicationserverPubsub, easy bool) *SetApplicationPubSubRequest {
 	this := &SetApplicationPubSubRequest{}
-	v15 := NewPopulatedApplicationPubSub(r, easy)
-	this.ApplicationPubSub = *v15
-	v16 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v16
+	v21 := NewPopulatedApplicationPubSub(r, easy)
+	this.ApplicationPubSub = *v21
+	v22 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v22
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3295,9 +3867,9 @@ func randUTF8RuneApplicationserverPubsub(r randyApplicationserverPubsub) rune {
 	return rune(ru + 61)
 }
 func randStringApplicationserverPubsub(r randyApplicationserverPubsub) string {
-	v17 := r.Intn(100)
-	tmps := make([]rune, v17)
-	for i := 0; i < v17; i++ {
+	v23 := r.Intn(100)
+	tmps := make([]rune, v23)
+	for i := 0; i < v23; i++ {
 		tmps[i] = randUTF8RuneApplicationserverPubsub(r)
 	}
 	return string(tmps)
@@ -3319,11 +3891,11 @@ func randFieldApplicationserverPubsub(dAtA []byte, r randyApplicationserverPubsu
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
-		v18 := r.Int63()
+		v24 := r.Int63()
 		if r.Intn(2) == 0 {
-			v18 *= -1
+			v24 *= -1
 		}
-		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(v18))
+		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(v24))
 	case 1:
 		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3449,6 +4021,18 @@ func (m *ApplicationPubSub_NATS) Size() (n int) {
 	}
 	return n
 }
+func (m *ApplicationPubSub_Kafka) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
 func (m *ApplicationPubSub_MQTT) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3565,6 +4149,14 @@ func (m *ApplicationPubSub_AWSIoTProvider) Size() (n int) {
 	if m.Deployment != nil {
 		n += m.Deployment.Size()
 	}
+	l = len(m.TLSClientCert)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
 	return n
 }
 
@@ -3635,6 +4227,64 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationPubSub_KafkaProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Brokers) > 0 {
+		for _, s := range m.Brokers {
+			l = len(s)
+			n += 1 + l + sovApplicationserverPubsub(uint64(l))
+		}
+	}
+	l = len(m.ConsumerGroup)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.SASL != nil {
+		l = m.SASL.Size()
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.UseTLS {
+		n += 2
+	}
+	l = len(m.TLSCA)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientCert)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mechanism != 0 {
+		n += 1 + sovApplicationserverPubsub(uint64(m.Mechanism))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationPubSub_Message) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3773,6 +4423,16 @@ func (this *ApplicationPubSub_NATS) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationPubSub_Kafka) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_Kafka{`,
+		`Kafka:` + strings.Replace(fmt.Sprintf("%v", this.Kafka), "ApplicationPubSub_KafkaProvider", "ApplicationPubSub_KafkaProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationPubSub_MQTT) String() string {
 	if this == nil {
 		return "nil"
@@ -3843,6 +4503,8 @@ func (this *ApplicationPubSub_AWSIoTProvider) String() string {
 		`AssumeRole:` + strings.Replace(fmt.Sprintf("%v", this.AssumeRole), "ApplicationPubSub_AWSIoTProvider_AssumeRole", "ApplicationPubSub_AWSIoTProvider_AssumeRole", 1) + `,`,
 		`EndpointAddress:` + fmt.Sprintf("%v", this.EndpointAddress) + `,`,
 		`Deployment:` + fmt.Sprintf("%v", this.Deployment) + `,`,
+		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
+		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3891,6 +4553,34 @@ func (this *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) String() string
 	}, "")
 	return s
 }
+func (this *ApplicationPubSub_KafkaProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_KafkaProvider{`,
+		`Brokers:` + fmt.Sprintf("%v", this.Brokers) + `,`,
+		`ConsumerGroup:` + fmt.Sprintf("%v", this.ConsumerGroup) + `,`,
+		`SASL:` + strings.Replace(fmt.Sprintf("%v", this.SASL), "ApplicationPubSub_KafkaProvider_SASL", "ApplicationPubSub_KafkaProvider_SASL", 1) + `,`,
+		`UseTLS:` + fmt.Sprintf("%v", this.UseTLS) + `,`,
+		`TLSCA:` + fmt.Sprintf("%v", this.TLSCA) + `,`,
+		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
+		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_KafkaProvider_SASL) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_KafkaProvider_SASL{`,
+		`Mechanism:` + fmt.Sprintf("%v", this.Mechanism) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationPubSub_Message) String() string {
 	if this == nil {
 		return "nil"
@@ -4754,9 +5444,9 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MQTT", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4783,13 +5473,48 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ApplicationPubSub_MQTTProvider{}
+			v := &ApplicationPubSub_KafkaProvider{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Provider = &ApplicationPubSub_MQTT{v}
+			m.Provider = &ApplicationPubSub_Kafka{v}
 			iNdEx = postIndex
-		case 101:
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MQTT", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_MQTTProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_MQTT{v}
+			iNdEx = postIndex
+		case 101:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AWSIoT", wireType)
 			}
@@ -5601,6 +6326,74 @@ func (m *ApplicationPubSub_AWSIoTProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.Deployment = &ApplicationPubSub_AWSIoTProvider_Default{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientCert == nil {
+				m.TLSClientCert = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientKey == nil {
+				m.TLSClientKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
@@ -6012,6 +6805,417 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) Unmarshal(dAtA []b
 	}
 	return nil
 }
+func (m *ApplicationPubSub_KafkaProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SASL == nil {
+				m.SASL = &ApplicationPubSub_KafkaProvider_SASL{}
+			}
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseTLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseTLS = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSCA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSCA = append(m.TLSCA[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSCA == nil {
+				m.TLSCA = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientCert == nil {
+				m.TLSClientCert = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientKey == nil {
+				m.TLSClientKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SASL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SASL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
+			}
+			m.Mechanism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mechanism |= ApplicationPubSub_KafkaProvider_SASL_Mechanism(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationPubSub_Message) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -524,6 +524,39 @@ func (dst *ApplicationPubSub) SetFields(src *ApplicationPubSub, paths ...string)
 							dst.Provider = nil
 						}
 					}
+				case "kafka":
+					_, srcOk := src.Provider.(*ApplicationPubSub_Kafka)
+					if !srcOk && src.Provider != nil {
+						return fmt.Errorf("attempt to set oneof 'kafka', while different oneof is set in source")
+					}
+					_, dstOk := dst.Provider.(*ApplicationPubSub_Kafka)
+					if !dstOk && dst.Provider != nil {
+						return fmt.Errorf("attempt to set oneof 'kafka', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ApplicationPubSub_KafkaProvider
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Provider.(*ApplicationPubSub_Kafka).Kafka
+						}
+						if dstOk {
+							newDst = dst.Provider.(*ApplicationPubSub_Kafka).Kafka
+						} else {
+							newDst = &ApplicationPubSub_KafkaProvider{}
+							dst.Provider = &ApplicationPubSub_Kafka{Kafka: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Provider = src.Provider
+						} else {
+							dst.Provider = nil
+						}
+					}
 
 				default:
 					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
@@ -905,6 +938,24 @@ func (dst *ApplicationPubSub_AWSIoTProvider) SetFields(src *ApplicationPubSub_AW
 				var zero string
 				dst.EndpointAddress = zero
 			}
+		case "tls_client_cert":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_cert' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientCert = src.TLSClientCert
+			} else {
+				dst.TLSClientCert = nil
+			}
+		case "tls_client_key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientKey = src.TLSClientKey
+			} else {
+				dst.TLSClientKey = nil
+			}
 
 		case "deployment":
 			if len(subs) == 0 && src == nil {
@@ -967,6 +1018,98 @@ func (dst *ApplicationPubSub_AWSIoTProvider) SetFields(src *ApplicationPubSub_AW
 	return nil
 }
 
+func (dst *ApplicationPubSub_KafkaProvider) SetFields(src *ApplicationPubSub_KafkaProvider, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "brokers":
+			if len(subs) > 0 {
+				return fmt.Errorf("'brokers' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Brokers = src.Brokers
+			} else {
+				dst.Brokers = nil
+			}
+		case "consumer_group":
+			if len(subs) > 0 {
+				return fmt.Errorf("'consumer_group' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ConsumerGroup = src.ConsumerGroup
+			} else {
+				var zero string
+				dst.ConsumerGroup = zero
+			}
+		case "sasl":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationPubSub_KafkaProvider_SASL
+				if (src == nil || src.SASL == nil) && dst.SASL == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SASL
+				}
+				if dst.SASL != nil {
+					newDst = dst.SASL
+				} else {
+					newDst = &ApplicationPubSub_KafkaProvider_SASL{}
+					dst.SASL = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SASL = src.SASL
+				} else {
+					dst.SASL = nil
+				}
+			}
+		case "use_tls":
+			if len(subs) > 0 {
+				return fmt.Errorf("'use_tls' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UseTLS = src.UseTLS
+			} else {
+				var zero bool
+				dst.UseTLS = zero
+			}
+		case "tls_ca":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_ca' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSCA = src.TLSCA
+			} else {
+				dst.TLSCA = nil
+			}
+		case "tls_client_cert":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_cert' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientCert = src.TLSClientCert
+			} else {
+				dst.TLSClientCert = nil
+			}
+		case "tls_client_key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientKey = src.TLSClientKey
+			} else {
+				dst.TLSClientKey = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationPubSub_Message) SetFields(src *ApplicationPubSub_Message, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -1089,3 +1232,44 @@ func (dst *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) SetFields(src *A
 	}
 	return nil
 }
+
+func (dst *ApplicationPubSub_KafkaProvider_SASL) SetFields(src *ApplicationPubSub_KafkaProvider_SASL, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "mechanism":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mechanism' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Mechanism = src.Mechanism
+			} else {
+				var zero ApplicationPubSub_KafkaProvider_SASL_Mechanism
+				dst.Mechanism = zero
+			}
+		case "username":
+			if len(subs) > 0 {
+				return fmt.Errorf("'username' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Username = src.Username
+			} else {
+				var zero string
+				dst.Username = zero
+			}
+		case "password":
+			if len(subs) > 0 {
+				return fmt.Errorf("'password' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Password = src.Password
+			} else {
+				var zero string
+				dst.Password = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
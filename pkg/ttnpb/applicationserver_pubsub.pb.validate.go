@@ -376,7 +376,7 @@ func (m *ApplicationPubSub) ValidateFields(paths ...string) error {
 			}
 			if len(subs) == 0 {
 				subs = []string{
-					"nats", "mqtt", "aws_iot",
+					"nats", "mqtt", "aws_iot", "kafka",
 				}
 			}
 			for name, subs := range _processPaths(subs) {
@@ -430,6 +430,22 @@ func (m *ApplicationPubSub) ValidateFields(paths ...string) error {
 						}
 					}
 
+				case "kafka":
+					w, ok := m.Provider.(*ApplicationPubSub_Kafka)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetKafka()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ApplicationPubSubValidationError{
+								field:  "kafka",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
 				}
 			}
 		default:
@@ -1299,6 +1315,10 @@ func (m *ApplicationPubSub_AWSIoTProvider) ValidateFields(paths ...string) error
 				}
 			}
 
+		case "tls_client_cert":
+			// no validation rules for TLSClientCert
+		case "tls_client_key":
+			// no validation rules for TLSClientKey
 		case "deployment":
 			if len(subs) == 0 {
 				subs = []string{
@@ -1418,6 +1438,154 @@ var _ApplicationPubSub_AWSIoTProvider_Region_InLookup = map[string]struct{}{
 
 var _ApplicationPubSub_AWSIoTProvider_EndpointAddress_Pattern = regexp.MustCompile("^((([a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)*([A-Za-z0-9]|[A-Za-z0-9][A-Za-z0-9\\-]*[A-Za-z0-9])|)$")
 
+// ValidateFields checks the field values on ApplicationPubSub_KafkaProvider
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationPubSub_KafkaProvider) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationPubSub_KafkaProviderFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "brokers":
+
+			if len(m.GetBrokers()) < 1 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "brokers",
+					reason: "value must contain at least 1 item(s)",
+				}
+			}
+
+			if len(m.GetBrokers()) > 16 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "brokers",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetBrokers() {
+				_, _ = idx, item
+
+				if l := utf8.RuneCountInString(item); l < 1 || l > 256 {
+					return ApplicationPubSub_KafkaProviderValidationError{
+						field:  fmt.Sprintf("brokers[%v]", idx),
+						reason: "value length must be between 1 and 256 runes, inclusive",
+					}
+				}
+
+			}
+
+		case "consumer_group":
+
+			if l := utf8.RuneCountInString(m.GetConsumerGroup()); l < 1 || l > 100 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "consumer_group",
+					reason: "value length must be between 1 and 100 runes, inclusive",
+				}
+			}
+
+			if !_ApplicationPubSub_KafkaProvider_ConsumerGroup_Pattern.MatchString(m.GetConsumerGroup()) {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "consumer_group",
+					reason: "value does not match regex pattern \"^[a-zA-Z0-9._-]*$\"",
+				}
+			}
+
+		case "sasl":
+
+			if v, ok := interface{}(m.GetSASL()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationPubSub_KafkaProviderValidationError{
+						field:  "sasl",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "use_tls":
+			// no validation rules for UseTLS
+		case "tls_ca":
+			// no validation rules for TLSCA
+		case "tls_client_cert":
+			// no validation rules for TLSClientCert
+		case "tls_client_key":
+			// no validation rules for TLSClientKey
+		default:
+			return ApplicationPubSub_KafkaProviderValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationPubSub_KafkaProviderValidationError is the validation error
+// returned by ApplicationPubSub_KafkaProvider.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationPubSub_KafkaProviderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPubSub_KafkaProviderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPubSub_KafkaProviderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPubSub_KafkaProviderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPubSub_KafkaProviderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPubSub_KafkaProviderValidationError) ErrorName() string {
+	return "ApplicationPubSub_KafkaProviderValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPubSub_KafkaProviderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPubSub_KafkaProvider.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPubSub_KafkaProviderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPubSub_KafkaProviderValidationError{}
+
+var _ApplicationPubSub_KafkaProvider_ConsumerGroup_Pattern = regexp.MustCompile("^[a-zA-Z0-9._-]*$")
+
 // ValidateFields checks the field values on ApplicationPubSub_Message with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1858,3 +2026,112 @@ var _ interface {
 } = ApplicationPubSub_AWSIoTProvider_DefaultIntegrationValidationError{}
 
 var _ApplicationPubSub_AWSIoTProvider_DefaultIntegration_StackName_Pattern = regexp.MustCompile("^[A-Za-z][A-Za-z0-9\\-]*$")
+
+// ValidateFields checks the field values on
+// ApplicationPubSub_KafkaProvider_SASL with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *ApplicationPubSub_KafkaProvider_SASL) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationPubSub_KafkaProvider_SASLFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "mechanism":
+
+			if _, ok := ApplicationPubSub_KafkaProvider_SASL_Mechanism_name[int32(m.GetMechanism())]; !ok {
+				return ApplicationPubSub_KafkaProvider_SASLValidationError{
+					field:  "mechanism",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "username":
+
+			if utf8.RuneCountInString(m.GetUsername()) > 100 {
+				return ApplicationPubSub_KafkaProvider_SASLValidationError{
+					field:  "username",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		case "password":
+
+			if utf8.RuneCountInString(m.GetPassword()) > 100 {
+				return ApplicationPubSub_KafkaProvider_SASLValidationError{
+					field:  "password",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		default:
+			return ApplicationPubSub_KafkaProvider_SASLValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationPubSub_KafkaProvider_SASLValidationError is the validation error
+// returned by ApplicationPubSub_KafkaProvider_SASL.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationPubSub_KafkaProvider_SASLValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) ErrorName() string {
+	return "ApplicationPubSub_KafkaProvider_SASLValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPubSub_KafkaProvider_SASL.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPubSub_KafkaProvider_SASLValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPubSub_KafkaProvider_SASLValidationError{}
@@ -4,6 +4,7 @@
 package ttnpb
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	io "io"
@@ -236,8 +237,14 @@ type ApplicationWebhookTemplate struct {
 	DownlinkQueueInvalidated *ApplicationWebhookTemplate_Message `protobuf:"bytes,21,opt,name=downlink_queue_invalidated,json=downlinkQueueInvalidated,proto3" json:"downlink_queue_invalidated,omitempty"`
 	LocationSolved           *ApplicationWebhookTemplate_Message `protobuf:"bytes,18,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
 	ServiceData              *ApplicationWebhookTemplate_Message `protobuf:"bytes,20,opt,name=service_data,json=serviceData,proto3" json:"service_data,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}                            `json:"-"`
-	XXX_sizecache            int32                               `json:"-"`
+	// The secret used to sign the requests of the webhook. Can contain template fields, in RFC 6570 format.
+	// See ApplicationWebhook.signing_secret.
+	SigningSecret string `protobuf:"bytes,22,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// Whether the endpoint requires mutual TLS.
+	// If set, the user provides a TLS client certificate and key when creating the webhook.
+	TLSClientCertificate bool     `protobuf:"varint,23,opt,name=tls_client_certificate,json=tlsClientCertificate,proto3" json:"tls_client_certificate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ApplicationWebhookTemplate) Reset()      { *m = ApplicationWebhookTemplate{} }
@@ -412,6 +419,20 @@ func (m *ApplicationWebhookTemplate) GetServiceData() *ApplicationWebhookTemplat
 	return nil
 }
 
+func (m *ApplicationWebhookTemplate) GetSigningSecret() string {
+	if m != nil {
+		return m.SigningSecret
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookTemplate) GetTLSClientCertificate() bool {
+	if m != nil {
+		return m.TLSClientCertificate
+	}
+	return false
+}
+
 type ApplicationWebhookTemplate_Message struct {
 	// Path to append to the base URL. Can contain template fields, in RFC 6570 format.
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -503,6 +524,96 @@ func (m *ApplicationWebhookTemplates) GetTemplates() []*ApplicationWebhookTempla
 	return nil
 }
 
+// ApplicationWebhookHealth is the health of a webhook, based on the recent requests to its endpoint.
+type ApplicationWebhookHealth struct {
+	// Number of consecutive failed requests.
+	FailedAttempts      uint64     `protobuf:"varint,1,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	LastFailedAttemptAt *time.Time `protobuf:"bytes,2,opt,name=last_failed_attempt_at,json=lastFailedAttemptAt,proto3,stdtime" json:"last_failed_attempt_at,omitempty"`
+	// Error of the last failed request.
+	LastFailedAttemptDetails *ErrorDetails `protobuf:"bytes,3,opt,name=last_failed_attempt_details,json=lastFailedAttemptDetails,proto3" json:"last_failed_attempt_details,omitempty"`
+	LastSuccessfulAttemptAt  *time.Time    `protobuf:"bytes,4,opt,name=last_successful_attempt_at,json=lastSuccessfulAttemptAt,proto3,stdtime" json:"last_successful_attempt_at,omitempty"`
+	// Whether the webhook is suspended because of too many consecutive failed requests.
+	// Requests of suspended webhooks are not sent.
+	Suspended            bool       `protobuf:"varint,5,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	SuspendedAt          *time.Time `protobuf:"bytes,6,opt,name=suspended_at,json=suspendedAt,proto3,stdtime" json:"suspended_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ApplicationWebhookHealth) Reset()      { *m = ApplicationWebhookHealth{} }
+func (*ApplicationWebhookHealth) ProtoMessage() {}
+func (*ApplicationWebhookHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{5}
+}
+func (m *ApplicationWebhookHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationWebhookHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookHealth.Merge(m, src)
+}
+func (m *ApplicationWebhookHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookHealth proto.InternalMessageInfo
+
+func (m *ApplicationWebhookHealth) GetFailedAttempts() uint64 {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return 0
+}
+
+func (m *ApplicationWebhookHealth) GetLastFailedAttemptAt() *time.Time {
+	if m != nil {
+		return m.LastFailedAttemptAt
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookHealth) GetLastFailedAttemptDetails() *ErrorDetails {
+	if m != nil {
+		return m.LastFailedAttemptDetails
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookHealth) GetLastSuccessfulAttemptAt() *time.Time {
+	if m != nil {
+		return m.LastSuccessfulAttemptAt
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookHealth) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+func (m *ApplicationWebhookHealth) GetSuspendedAt() *time.Time {
+	if m != nil {
+		return m.SuspendedAt
+	}
+	return nil
+}
+
 type ApplicationWebhook struct {
 	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
 	CreatedAt                     time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
@@ -531,14 +642,30 @@ type ApplicationWebhook struct {
 	DownlinkQueueInvalidated *ApplicationWebhook_Message `protobuf:"bytes,19,opt,name=downlink_queue_invalidated,json=downlinkQueueInvalidated,proto3" json:"downlink_queue_invalidated,omitempty"`
 	LocationSolved           *ApplicationWebhook_Message `protobuf:"bytes,14,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
 	ServiceData              *ApplicationWebhook_Message `protobuf:"bytes,18,opt,name=service_data,json=serviceData,proto3" json:"service_data,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}                    `json:"-"`
-	XXX_sizecache            int32                       `json:"-"`
+	// The secret used to sign the requests of the webhook with HMAC-SHA256.
+	// If set, the X-Webhook-Timestamp header contains the Unix time in seconds at which the request is sent,
+	// and the X-Webhook-Signature header contains `sha256=` followed by the hex encoded HMAC-SHA256 of the timestamp,
+	// a dot and the request body.
+	SigningSecret string `protobuf:"bytes,20,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// PEM encoded TLS client certificate, used for mutual TLS with the endpoint.
+	TLSClientCert []byte `protobuf:"bytes,21,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
+	// PEM encoded TLS client key, used for mutual TLS with the endpoint.
+	TLSClientKey []byte `protobuf:"bytes,22,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
+	// The health of the webhook. This field is managed by the Application Server.
+	// Setting this field resets the health, which enables a suspended webhook again.
+	Health *ApplicationWebhookHealth `protobuf:"bytes,23,opt,name=health,proto3" json:"health,omitempty"`
+	// Batching of messages. If set, messages are accumulated and sent in a single request.
+	// Messages of the JSON format are sent as a JSON array, and messages of the Protocol Buffers format
+	// are sent as length-delimited (varint) messages.
+	Batching             *ApplicationWebhook_Batching `protobuf:"bytes,24,opt,name=batching,proto3" json:"batching,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
 func (m *ApplicationWebhook) Reset()      { *m = ApplicationWebhook{} }
 func (*ApplicationWebhook) ProtoMessage() {}
 func (*ApplicationWebhook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{5}
+	return fileDescriptor_2652f2d8eaceda0e, []int{6}
 }
 func (m *ApplicationWebhook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,6 +813,41 @@ func (m *ApplicationWebhook) GetServiceData() *ApplicationWebhook_Message {
 	return nil
 }
 
+func (m *ApplicationWebhook) GetSigningSecret() string {
+	if m != nil {
+		return m.SigningSecret
+	}
+	return ""
+}
+
+func (m *ApplicationWebhook) GetTLSClientCert() []byte {
+	if m != nil {
+		return m.TLSClientCert
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetTLSClientKey() []byte {
+	if m != nil {
+		return m.TLSClientKey
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetHealth() *ApplicationWebhookHealth {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetBatching() *ApplicationWebhook_Batching {
+	if m != nil {
+		return m.Batching
+	}
+	return nil
+}
+
 type ApplicationWebhook_Message struct {
 	// Path to append to the base URL.
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -696,7 +858,7 @@ type ApplicationWebhook_Message struct {
 func (m *ApplicationWebhook_Message) Reset()      { *m = ApplicationWebhook_Message{} }
 func (*ApplicationWebhook_Message) ProtoMessage() {}
 func (*ApplicationWebhook_Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{5, 2}
+	return fileDescriptor_2652f2d8eaceda0e, []int{6, 2}
 }
 func (m *ApplicationWebhook_Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,6 +894,63 @@ func (m *ApplicationWebhook_Message) GetPath() string {
 	return ""
 }
 
+type ApplicationWebhook_Batching struct {
+	// Maximum number of messages in a batch.
+	// If set to 0 or 1, messages are not batched.
+	MaxMessages uint32 `protobuf:"varint,1,opt,name=max_messages,json=maxMessages,proto3" json:"max_messages,omitempty"`
+	// Maximum delay of the first message in a batch before the batch is sent.
+	// If set to 0, the batch is sent after 1 second.
+	MaxDelay             time.Duration `protobuf:"bytes,2,opt,name=max_delay,json=maxDelay,proto3,stdduration" json:"max_delay"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ApplicationWebhook_Batching) Reset()      { *m = ApplicationWebhook_Batching{} }
+func (*ApplicationWebhook_Batching) ProtoMessage() {}
+func (*ApplicationWebhook_Batching) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{6, 3}
+}
+func (m *ApplicationWebhook_Batching) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhook_Batching) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhook_Batching.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationWebhook_Batching) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhook_Batching.Merge(m, src)
+}
+func (m *ApplicationWebhook_Batching) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhook_Batching) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhook_Batching.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhook_Batching proto.InternalMessageInfo
+
+func (m *ApplicationWebhook_Batching) GetMaxMessages() uint32 {
+	if m != nil {
+		return m.MaxMessages
+	}
+	return 0
+}
+
+func (m *ApplicationWebhook_Batching) GetMaxDelay() time.Duration {
+	if m != nil {
+		return m.MaxDelay
+	}
+	return 0
+}
+
 type ApplicationWebhooks struct {
 	Webhooks             []*ApplicationWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -741,7 +960,7 @@ type ApplicationWebhooks struct {
 func (m *ApplicationWebhooks) Reset()      { *m = ApplicationWebhooks{} }
 func (*ApplicationWebhooks) ProtoMessage() {}
 func (*ApplicationWebhooks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{6}
+	return fileDescriptor_2652f2d8eaceda0e, []int{7}
 }
 func (m *ApplicationWebhooks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -787,7 +1006,7 @@ type ApplicationWebhookFormats struct {
 func (m *ApplicationWebhookFormats) Reset()      { *m = ApplicationWebhookFormats{} }
 func (*ApplicationWebhookFormats) ProtoMessage() {}
 func (*ApplicationWebhookFormats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{7}
+	return fileDescriptor_2652f2d8eaceda0e, []int{8}
 }
 func (m *ApplicationWebhookFormats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -833,7 +1052,7 @@ type GetApplicationWebhookRequest struct {
 func (m *GetApplicationWebhookRequest) Reset()      { *m = GetApplicationWebhookRequest{} }
 func (*GetApplicationWebhookRequest) ProtoMessage() {}
 func (*GetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{8}
+	return fileDescriptor_2652f2d8eaceda0e, []int{9}
 }
 func (m *GetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1098,7 @@ type ListApplicationWebhooksRequest struct {
 func (m *ListApplicationWebhooksRequest) Reset()      { *m = ListApplicationWebhooksRequest{} }
 func (*ListApplicationWebhooksRequest) ProtoMessage() {}
 func (*ListApplicationWebhooksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{9}
+	return fileDescriptor_2652f2d8eaceda0e, []int{10}
 }
 func (m *ListApplicationWebhooksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,7 +1144,7 @@ type SetApplicationWebhookRequest struct {
 func (m *SetApplicationWebhookRequest) Reset()      { *m = SetApplicationWebhookRequest{} }
 func (*SetApplicationWebhookRequest) ProtoMessage() {}
 func (*SetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{10}
+	return fileDescriptor_2652f2d8eaceda0e, []int{11}
 }
 func (m *SetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,7 +1190,7 @@ type GetApplicationWebhookTemplateRequest struct {
 func (m *GetApplicationWebhookTemplateRequest) Reset()      { *m = GetApplicationWebhookTemplateRequest{} }
 func (*GetApplicationWebhookTemplateRequest) ProtoMessage() {}
 func (*GetApplicationWebhookTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{11}
+	return fileDescriptor_2652f2d8eaceda0e, []int{12}
 }
 func (m *GetApplicationWebhookTemplateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1018,7 +1237,7 @@ func (m *ListApplicationWebhookTemplatesRequest) Reset() {
 }
 func (*ListApplicationWebhookTemplatesRequest) ProtoMessage() {}
 func (*ListApplicationWebhookTemplatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{12}
+	return fileDescriptor_2652f2d8eaceda0e, []int{13}
 }
 func (m *ListApplicationWebhookTemplatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,225 +1273,542 @@ func (m *ListApplicationWebhookTemplatesRequest) GetFieldMask() types.FieldMask
 	return types.FieldMask{}
 }
 
-func init() {
-	proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
-	golang_proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
-	proto.RegisterType((*ApplicationWebhookTemplateIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplateIdentifiers")
-	golang_proto.RegisterType((*ApplicationWebhookTemplateIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplateIdentifiers")
-	proto.RegisterType((*ApplicationWebhookTemplateField)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplateField")
-	golang_proto.RegisterType((*ApplicationWebhookTemplateField)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplateField")
-	proto.RegisterType((*ApplicationWebhookTemplate)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate")
-	golang_proto.RegisterType((*ApplicationWebhookTemplate)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate")
-	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate.HeadersEntry")
-	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate.HeadersEntry")
-	proto.RegisterType((*ApplicationWebhookTemplate_Message)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate.Message")
-	golang_proto.RegisterType((*ApplicationWebhookTemplate_Message)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate.Message")
-	proto.RegisterType((*ApplicationWebhookTemplates)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplates")
-	golang_proto.RegisterType((*ApplicationWebhookTemplates)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplates")
-	proto.RegisterType((*ApplicationWebhook)(nil), "ttn.lorawan.v3.ApplicationWebhook")
-	golang_proto.RegisterType((*ApplicationWebhook)(nil), "ttn.lorawan.v3.ApplicationWebhook")
-	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.HeadersEntry")
-	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.HeadersEntry")
-	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.TemplateFieldsEntry")
-	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.TemplateFieldsEntry")
-	proto.RegisterType((*ApplicationWebhook_Message)(nil), "ttn.lorawan.v3.ApplicationWebhook.Message")
-	golang_proto.RegisterType((*ApplicationWebhook_Message)(nil), "ttn.lorawan.v3.ApplicationWebhook.Message")
-	proto.RegisterType((*ApplicationWebhooks)(nil), "ttn.lorawan.v3.ApplicationWebhooks")
-	golang_proto.RegisterType((*ApplicationWebhooks)(nil), "ttn.lorawan.v3.ApplicationWebhooks")
-	proto.RegisterType((*ApplicationWebhookFormats)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats")
-	golang_proto.RegisterType((*ApplicationWebhookFormats)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats")
-	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats.FormatsEntry")
-	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats.FormatsEntry")
-	proto.RegisterType((*GetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookRequest")
-	golang_proto.RegisterType((*GetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookRequest")
-	proto.RegisterType((*ListApplicationWebhooksRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhooksRequest")
-	golang_proto.RegisterType((*ListApplicationWebhooksRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhooksRequest")
-	proto.RegisterType((*SetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.SetApplicationWebhookRequest")
-	golang_proto.RegisterType((*SetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.SetApplicationWebhookRequest")
-	proto.RegisterType((*GetApplicationWebhookTemplateRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookTemplateRequest")
-	golang_proto.RegisterType((*GetApplicationWebhookTemplateRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookTemplateRequest")
-	proto.RegisterType((*ListApplicationWebhookTemplatesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookTemplatesRequest")
-	golang_proto.RegisterType((*ListApplicationWebhookTemplatesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookTemplatesRequest")
+// ApplicationWebhookRequest is an outgoing webhook request that is persisted for delivery.
+type ApplicationWebhookRequest struct {
+	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	// Unique identifier of the request.
+	ID        string    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt time.Time `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	URL       string    `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// HTTP headers of the request.
+	Headers map[string]string `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Body    []byte            `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	// Number of delivery attempts.
+	Attempts      uint32     `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastAttemptAt *time.Time `protobuf:"bytes,8,opt,name=last_attempt_at,json=lastAttemptAt,proto3,stdtime" json:"last_attempt_at,omitempty"`
+	// Error of the last delivery attempt.
+	LastError            *ErrorDetails `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ApplicationWebhookRequest) Reset()      { *m = ApplicationWebhookRequest{} }
+func (*ApplicationWebhookRequest) ProtoMessage() {}
+func (*ApplicationWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{14}
+}
+func (m *ApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterFile("lorawan-stack/api/applicationserver_web.proto", fileDescriptor_2652f2d8eaceda0e)
+func (m *ApplicationWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/applicationserver_web.proto", fileDescriptor_2652f2d8eaceda0e)
+func (m *ApplicationWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookRequest.Merge(m, src)
 }
-
-var fileDescriptor_2652f2d8eaceda0e = []byte{
-	// 1860 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4f, 0x6c, 0xdb, 0xd6,
-	0x19, 0xd7, 0xf3, 0x3f, 0x59, 0x4f, 0xb6, 0xe3, 0x3c, 0x3b, 0x2d, 0xa7, 0x24, 0x94, 0xc1, 0x66,
-	0xad, 0x93, 0x45, 0xd4, 0xe0, 0x34, 0xdb, 0x6a, 0x74, 0x0d, 0xac, 0xba, 0x71, 0xbd, 0x24, 0x4d,
-	0x43, 0x35, 0x2d, 0xda, 0xa0, 0x15, 0x9e, 0xc5, 0x27, 0x99, 0x13, 0x45, 0xb2, 0xe4, 0x93, 0x3d,
-	0xaf, 0x08, 0x56, 0xec, 0x14, 0xec, 0xb2, 0x62, 0x39, 0x6c, 0xa7, 0xa1, 0xc0, 0x30, 0xa0, 0x3b,
-	0xad, 0xd8, 0xa9, 0x87, 0x1d, 0x8a, 0x61, 0x87, 0x1c, 0x03, 0xec, 0xb0, 0x9e, 0xbc, 0x9a, 0xda,
-	0xa1, 0xa7, 0xa1, 0xc7, 0xc0, 0xa7, 0x81, 0x8f, 0x8f, 0x14, 0xa9, 0x3f, 0x31, 0x25, 0x27, 0x3d,
-	0x99, 0xe4, 0xfb, 0xbe, 0xdf, 0xf7, 0xfb, 0xbe, 0xf7, 0xf1, 0xfb, 0x3d, 0xd1, 0xb0, 0xa0, 0x9b,
-	0x36, 0xde, 0xc5, 0x46, 0xc1, 0xa1, 0xb8, 0xda, 0x28, 0x62, 0x4b, 0x2b, 0x62, 0xcb, 0xd2, 0xb5,
-	0x2a, 0xa6, 0x9a, 0x69, 0x38, 0xc4, 0xde, 0x21, 0x76, 0x65, 0x97, 0x6c, 0xc9, 0x96, 0x6d, 0x52,
-	0x13, 0xcd, 0x51, 0x6a, 0xc8, 0xdc, 0x45, 0xde, 0xb9, 0x94, 0x5b, 0xab, 0x6b, 0x74, 0xbb, 0xb5,
-	0x25, 0x57, 0xcd, 0x66, 0x91, 0x18, 0x3b, 0xe6, 0x9e, 0x65, 0x9b, 0xbf, 0xd8, 0x2b, 0x32, 0xe3,
-	0x6a, 0xa1, 0x4e, 0x8c, 0xc2, 0x0e, 0xd6, 0x35, 0x15, 0x53, 0x52, 0xec, 0xb9, 0xf0, 0x21, 0x73,
-	0x85, 0x08, 0x44, 0xdd, 0xac, 0x9b, 0xbe, 0xf3, 0x56, 0xab, 0xc6, 0xee, 0xd8, 0x0d, 0xbb, 0xe2,
-	0xe6, 0x67, 0xea, 0xa6, 0x59, 0xd7, 0x89, 0xcf, 0xd4, 0x30, 0x4c, 0xea, 0x13, 0xe5, 0xab, 0xa7,
-	0xf9, 0x6a, 0x88, 0x41, 0x9a, 0x16, 0xdd, 0xe3, 0x8b, 0x4b, 0xdd, 0x8b, 0x35, 0x8d, 0xe8, 0x6a,
-	0xa5, 0x89, 0x9d, 0x06, 0xb7, 0xc8, 0x77, 0x5b, 0x50, 0xad, 0x49, 0x1c, 0x8a, 0x9b, 0x16, 0x37,
-	0x78, 0xae, 0xb7, 0x5c, 0x9a, 0x4a, 0x0c, 0xaa, 0xd5, 0x34, 0x62, 0x73, 0x12, 0xd2, 0xbf, 0x01,
-	0x3c, 0xbb, 0xd6, 0x29, 0xe2, 0x3b, 0x64, 0x6b, 0xdb, 0x34, 0x1b, 0x9b, 0x1d, 0x3b, 0x84, 0xe1,
-	0x89, 0x48, 0x95, 0x2b, 0x9a, 0xea, 0x08, 0x60, 0x09, 0x2c, 0x67, 0x57, 0x9e, 0x97, 0xe3, 0x05,
-	0x96, 0x23, 0x38, 0x11, 0x80, 0xd2, 0xfc, 0x61, 0x69, 0xf2, 0x37, 0x60, 0x6c, 0x1e, 0x3c, 0xd8,
-	0xcf, 0xa7, 0x1e, 0xee, 0xe7, 0x81, 0x32, 0x87, 0xa3, 0x96, 0x0e, 0x2a, 0x43, 0xb8, 0xeb, 0x07,
-	0xae, 0x68, 0xaa, 0x30, 0xb6, 0x04, 0x96, 0x33, 0xa5, 0x17, 0x0f, 0x4b, 0xe7, 0x6c, 0x49, 0x38,
-	0xb7, 0x22, 0x7e, 0x70, 0x07, 0x17, 0x7e, 0xf9, 0xc3, 0xc2, 0x4b, 0xef, 0x2f, 0x5f, 0x59, 0xbd,
-	0x53, 0x78, 0xff, 0x4a, 0x70, 0x7b, 0xfe, 0xa3, 0x95, 0x8b, 0x77, 0xcf, 0xb9, 0xfb, 0xf9, 0x4c,
-	0xc0, 0x7a, 0x5d, 0xc9, 0xec, 0x06, 0x09, 0x48, 0xbf, 0x82, 0xdf, 0xef, 0x4d, 0xec, 0x2d, 0xd2,
-	0xb4, 0x74, 0x4c, 0x49, 0x34, 0xc1, 0xb7, 0x61, 0x96, 0xf2, 0xc7, 0x5e, 0x78, 0xc0, 0xc2, 0x5f,
-	0x4e, 0x1e, 0x1e, 0x86, 0xa0, 0xeb, 0x0a, 0xa4, 0x61, 0x00, 0xe9, 0x7f, 0x00, 0xe6, 0x07, 0x33,
-	0xb8, 0xea, 0xed, 0x27, 0xfa, 0x29, 0x1c, 0x0b, 0x43, 0x16, 0x92, 0x87, 0x1c, 0xdb, 0x5c, 0x57,
-	0xc6, 0x34, 0x15, 0x9d, 0x86, 0x13, 0x06, 0x6e, 0x12, 0x5e, 0xb2, 0xf4, 0x61, 0x69, 0xc2, 0x1e,
-	0x13, 0x16, 0x15, 0xf6, 0x10, 0x9d, 0x87, 0x59, 0x95, 0x38, 0x55, 0x5b, 0xb3, 0xbc, 0xf0, 0xc2,
-	0x78, 0xd4, 0x46, 0x55, 0xa2, 0x6b, 0xe8, 0x19, 0x38, 0xe5, 0x90, 0xaa, 0x4d, 0xa8, 0x30, 0xb1,
-	0x04, 0x96, 0xa7, 0x15, 0x7e, 0x87, 0x2e, 0xc2, 0x59, 0x95, 0xd4, 0x70, 0x4b, 0xa7, 0x95, 0x1d,
-	0xac, 0xb7, 0x88, 0x30, 0x19, 0x07, 0x99, 0xe1, 0xab, 0x6f, 0x7b, 0x8b, 0xd2, 0xdf, 0x67, 0x61,
-	0x6e, 0x70, 0xc2, 0xe8, 0x5d, 0x38, 0xde, 0x69, 0x9e, 0xcb, 0x8f, 0x69, 0x9e, 0xc1, 0x7b, 0xd5,
-	0xa7, 0x97, 0x3c, 0xcc, 0x27, 0x56, 0x07, 0x19, 0x4e, 0xeb, 0x66, 0xdd, 0xac, 0xb4, 0x6c, 0x9d,
-	0x55, 0x22, 0x53, 0x5a, 0x38, 0x2c, 0x4d, 0xda, 0xe3, 0xf7, 0x00, 0x70, 0xf7, 0xf3, 0xe9, 0xeb,
-	0x66, 0xdd, 0xbc, 0xad, 0x5c, 0x57, 0xd2, 0x9e, 0xd1, 0x6d, 0x5b, 0xf7, 0xec, 0x35, 0xa3, 0xe6,
-	0xdb, 0x4f, 0xf6, 0xda, 0x6f, 0x1a, 0x35, 0xdf, 0xde, 0x33, 0xf2, 0xec, 0x37, 0xe1, 0x49, 0xd5,
-	0xac, 0xb6, 0x9a, 0xc4, 0xf0, 0x47, 0x01, 0x73, 0x9c, 0x62, 0x8e, 0x67, 0x22, 0x8e, 0xf3, 0xeb,
-	0x51, 0x23, 0x0f, 0x61, 0x3e, 0xe6, 0xc6, 0x43, 0x6f, 0x61, 0x87, 0x30, 0x84, 0x74, 0x6f, 0xe8,
-	0x12, 0x76, 0x08, 0x0b, 0xed, 0x19, 0x79, 0xf6, 0xb7, 0x60, 0x7a, 0x9b, 0x60, 0x95, 0xd8, 0x8e,
-	0x30, 0xbd, 0x34, 0xbe, 0x9c, 0x5d, 0xf9, 0x71, 0xf2, 0x1d, 0x90, 0x5f, 0xf7, 0x3d, 0x5f, 0x33,
-	0xa8, 0xbd, 0xa7, 0x04, 0x38, 0xe8, 0x0a, 0x9c, 0xaa, 0x99, 0x76, 0x13, 0x53, 0x21, 0xc3, 0x08,
-	0xbc, 0xe0, 0x37, 0xf0, 0xe2, 0x51, 0x0d, 0xac, 0x70, 0x37, 0xb4, 0x01, 0xa7, 0xd8, 0x58, 0x73,
-	0x04, 0xc8, 0x28, 0x15, 0x93, 0x53, 0x62, 0xaf, 0x8f, 0xc2, 0xdd, 0xd1, 0x4d, 0xf8, 0x6c, 0xd5,
-	0x26, 0xde, 0x0b, 0xac, 0x9a, 0xbb, 0x86, 0xae, 0x19, 0x8d, 0x0a, 0xb6, 0xb4, 0x4a, 0x83, 0xec,
-	0x09, 0x0b, 0x5e, 0x43, 0x97, 0x04, 0x77, 0x3f, 0xbf, 0xf8, 0x2a, 0x33, 0x59, 0xe7, 0x16, 0x6b,
-	0x6f, 0x6e, 0x5e, 0x23, 0x7b, 0xca, 0x62, 0x35, 0xfe, 0xd4, 0xd2, 0xae, 0x91, 0x3d, 0xf4, 0x2e,
-	0x9c, 0x6b, 0x59, 0x0c, 0xa7, 0x49, 0x1c, 0x07, 0xd7, 0x89, 0x90, 0x65, 0x6d, 0xbb, 0x32, 0x44,
-	0xd1, 0x6e, 0xf8, 0x9e, 0xca, 0xac, 0x8f, 0xc4, 0x6f, 0x51, 0x19, 0x66, 0x7f, 0x6e, 0x6a, 0x46,
-	0x05, 0x57, 0xab, 0xc4, 0xa2, 0xc2, 0xcc, 0xc8, 0xb8, 0xd0, 0x83, 0x59, 0x63, 0x28, 0xe8, 0x36,
-	0x9c, 0xe9, 0x64, 0x5e, 0x6d, 0x08, 0xb3, 0x23, 0xa3, 0x66, 0x03, 0x9c, 0xb5, 0x6a, 0x03, 0xbd,
-	0x03, 0x67, 0x43, 0x58, 0xc3, 0xc3, 0x9d, 0x1b, 0x19, 0x37, 0xe4, 0xf7, 0x06, 0xee, 0x02, 0x76,
-	0x88, 0x41, 0x85, 0x13, 0xc7, 0x07, 0x2e, 0x13, 0x83, 0xa2, 0x3b, 0xf0, 0x44, 0x08, 0x5c, 0xc3,
-	0x9a, 0x4e, 0x54, 0x61, 0x7e, 0x64, 0xe8, 0xb9, 0x00, 0xea, 0x2a, 0x43, 0x8a, 0x81, 0x7f, 0xd8,
-	0x22, 0x2d, 0xa2, 0x0a, 0x27, 0x8f, 0x0f, 0x7e, 0x8b, 0x21, 0x21, 0x0b, 0xe6, 0xe2, 0xe0, 0x15,
-	0xcd, 0x08, 0x8e, 0x1f, 0xaa, 0x70, 0x6a, 0xe4, 0x38, 0x42, 0x2c, 0xce, 0x66, 0x07, 0xd3, 0x4b,
-	0x47, 0x37, 0xb9, 0xac, 0x3b, 0xa6, 0xbe, 0x43, 0x54, 0x01, 0x8d, 0x9e, 0x4e, 0x00, 0x55, 0x66,
-	0x48, 0x5e, 0x47, 0x7a, 0x27, 0x32, 0xad, 0x4a, 0x2a, 0x2a, 0xa6, 0x58, 0x58, 0x1c, 0xbd, 0x23,
-	0x39, 0xce, 0x3a, 0xa6, 0x38, 0xb7, 0x0a, 0x67, 0xa2, 0xc3, 0x08, 0xcd, 0xc3, 0x71, 0xef, 0x2d,
-	0x67, 0x0a, 0xaa, 0x78, 0x97, 0x68, 0x11, 0x4e, 0xfa, 0x5a, 0xc5, 0xc4, 0x40, 0xf1, 0x6f, 0x56,
-	0xc7, 0x7e, 0x02, 0x72, 0x67, 0x61, 0x3a, 0x78, 0x09, 0x11, 0x9c, 0xb0, 0x30, 0xdd, 0xe6, 0x7e,
-	0xec, 0x5a, 0xaa, 0xc3, 0xd3, 0x83, 0xd9, 0x38, 0xe8, 0x75, 0x98, 0x09, 0xc4, 0xdd, 0x13, 0x31,
-	0x6f, 0x5e, 0x5d, 0x48, 0x9e, 0x8d, 0xd2, 0x71, 0x96, 0xee, 0xcf, 0x42, 0xd4, 0x6b, 0x89, 0x6e,
-	0x45, 0xf5, 0xb1, 0x70, 0x34, 0x74, 0x02, 0x5d, 0x7c, 0x15, 0x42, 0x7f, 0xbc, 0xa9, 0x15, 0x4c,
-	0x59, 0x41, 0xb2, 0x2b, 0x39, 0xd9, 0x3f, 0x38, 0xca, 0xc1, 0xc1, 0x51, 0x7e, 0x2b, 0x38, 0x38,
-	0x96, 0xa6, 0x3d, 0xf7, 0x4f, 0xfe, 0x93, 0x07, 0x4a, 0x86, 0xfb, 0xad, 0x51, 0x0f, 0xa4, 0x65,
-	0xa9, 0x01, 0xc8, 0xf8, 0x30, 0x20, 0xdc, 0x6f, 0x8d, 0xc6, 0xe4, 0x6a, 0x22, 0x81, 0x5c, 0x6d,
-	0x76, 0xe4, 0x6a, 0x32, 0xa9, 0x36, 0x1c, 0x29, 0x53, 0x53, 0xa3, 0xc9, 0xd4, 0x07, 0x70, 0x26,
-	0x72, 0x40, 0x74, 0xf8, 0xac, 0x1a, 0xf1, 0x04, 0x33, 0xc1, 0x76, 0x27, 0xdb, 0x39, 0x27, 0x3a,
-	0xa8, 0x02, 0x4f, 0x84, 0xf8, 0x5c, 0x0f, 0xe7, 0x59, 0xce, 0x3f, 0x4a, 0x90, 0x73, 0x4c, 0x10,
-	0x79, 0xea, 0x73, 0x34, 0xf6, 0x10, 0xbd, 0x0c, 0xe7, 0x7b, 0x74, 0xf1, 0x24, 0xab, 0x05, 0x72,
-	0xf7, 0xf3, 0x73, 0x5d, 0x8a, 0x18, 0x0e, 0x26, 0xae, 0x85, 0xb7, 0x7a, 0xb4, 0x30, 0xcd, 0x0a,
-	0x90, 0xa0, 0xfb, 0x07, 0x69, 0xe0, 0xb5, 0xb8, 0x06, 0x4e, 0x0f, 0x8d, 0x17, 0xd5, 0xbe, 0x1b,
-	0x5d, 0xda, 0x97, 0x19, 0x1a, 0x2d, 0xa6, 0x79, 0x37, 0xbb, 0x35, 0x0f, 0x0e, 0x8d, 0x17, 0xd7,
-	0xba, 0x9b, 0xdd, 0x5a, 0x97, 0x1d, 0x1d, 0x90, 0x69, 0x5c, 0xb9, 0x57, 0xe3, 0x66, 0x86, 0x86,
-	0xec, 0xd6, 0xb6, 0x72, 0xaf, 0xb6, 0xcd, 0x8e, 0x0e, 0xca, 0x35, 0x6d, 0xfb, 0xb1, 0x9a, 0xb6,
-	0x30, 0x34, 0xfe, 0x60, 0x2d, 0x2b, 0xf7, 0x6a, 0xd9, 0xdc, 0xf0, 0xf4, 0xbb, 0x34, 0xec, 0x46,
-	0x97, 0x86, 0xa1, 0xe1, 0x3b, 0xeb, 0x49, 0x69, 0xd7, 0x1a, 0x5c, 0xe8, 0xf3, 0xa6, 0x3f, 0x49,
-	0xf9, 0xbb, 0x0d, 0x17, 0x7a, 0x13, 0x71, 0xd0, 0x2b, 0x70, 0x9a, 0xff, 0xa6, 0x0e, 0x54, 0x4f,
-	0x3a, 0x3a, 0x7f, 0x25, 0xf4, 0x91, 0xfe, 0x02, 0xe0, 0xf7, 0x7a, 0x0d, 0xae, 0xb2, 0xc9, 0xea,
-	0xa0, 0x37, 0x61, 0xda, 0x1f, 0xb2, 0x01, 0x78, 0x82, 0x91, 0xc7, 0x7d, 0x65, 0xfe, 0x97, 0x4f,
-	0x7b, 0x0e, 0xe3, 0x15, 0x39, 0xba, 0x30, 0x4c, 0x85, 0xa4, 0xbf, 0x01, 0x78, 0x66, 0x83, 0xd0,
-	0x3e, 0xf9, 0x90, 0x0f, 0x5b, 0xc4, 0xa1, 0x4f, 0x43, 0xa2, 0xaf, 0x40, 0xd8, 0xf9, 0xb4, 0x33,
-	0x50, 0xa2, 0xd9, 0x9e, 0xdf, 0xc0, 0x4e, 0xa3, 0x34, 0xe1, 0xb9, 0x2b, 0x99, 0x5a, 0xf0, 0x40,
-	0xfa, 0x27, 0x80, 0xe2, 0x75, 0xcd, 0xe9, 0xc3, 0xda, 0x09, 0x68, 0x7f, 0x07, 0x9f, 0x70, 0x8e,
-	0x9d, 0xc6, 0x5f, 0x01, 0x3c, 0x53, 0x7e, 0x5c, 0xed, 0xdf, 0x80, 0x69, 0xde, 0x54, 0x9c, 0x7c,
-	0x82, 0x3e, 0xec, 0x43, 0x3c, 0x00, 0x39, 0x3e, 0xe3, 0x7f, 0x00, 0x78, 0xae, 0x6f, 0xb7, 0x84,
-	0x67, 0x3e, 0xce, 0xfc, 0x29, 0x7e, 0xf8, 0x38, 0x76, 0x12, 0x1a, 0x7c, 0xbe, 0x7f, 0xf3, 0x84,
-	0x07, 0xdf, 0x20, 0x8b, 0x78, 0x28, 0x30, 0x74, 0xa8, 0x95, 0xdf, 0x66, 0xfa, 0x7d, 0x1e, 0x52,
-	0x48, 0x5d, 0x73, 0xbc, 0x17, 0x55, 0x87, 0x70, 0x83, 0xd0, 0x60, 0x30, 0x3c, 0xd3, 0x83, 0xfc,
-	0x5a, 0xd3, 0xa2, 0x7b, 0xb9, 0xf3, 0x89, 0xe7, 0x83, 0x74, 0xfa, 0xd7, 0xff, 0xfa, 0xef, 0xfd,
-	0xb1, 0x53, 0x68, 0xa1, 0x88, 0x9d, 0x22, 0xdf, 0xf5, 0x02, 0x1f, 0x13, 0xe8, 0x53, 0x00, 0xb3,
-	0x1b, 0x84, 0x86, 0x1f, 0xa7, 0x5e, 0xec, 0xc6, 0x4d, 0xb2, 0xb3, 0xb9, 0x21, 0x7e, 0x00, 0x48,
-	0x45, 0x46, 0xe7, 0x3c, 0x7a, 0x21, 0x4a, 0x27, 0xfc, 0x51, 0x50, 0xfc, 0x48, 0x53, 0x1d, 0x39,
-	0x72, 0xcc, 0xbc, 0x8b, 0xee, 0x03, 0x38, 0xeb, 0xed, 0x4d, 0xe7, 0x27, 0x48, 0xcf, 0x70, 0x4c,
-	0xb6, 0x75, 0xb9, 0x1f, 0x24, 0xa7, 0xe9, 0x48, 0x67, 0x19, 0xcf, 0x67, 0xd1, 0xa9, 0xbe, 0x3c,
-	0xd1, 0x9f, 0x00, 0x1c, 0xdf, 0x20, 0x14, 0x5d, 0x4c, 0x54, 0xb0, 0x80, 0x41, 0x82, 0x77, 0x55,
-	0xfa, 0x19, 0x0b, 0xbc, 0x8e, 0x4a, 0x91, 0xc0, 0xbc, 0x2e, 0x5d, 0xd3, 0xab, 0xeb, 0xfe, 0xae,
-	0x6f, 0xd4, 0xf9, 0x84, 0x7c, 0x17, 0xfd, 0x0e, 0xc0, 0x09, 0xaf, 0x38, 0x48, 0x4e, 0x56, 0xb2,
-	0xb0, 0x54, 0xcf, 0x1d, 0x4d, 0xd4, 0x91, 0x2e, 0x33, 0xa6, 0x45, 0x54, 0x88, 0x33, 0x3d, 0x82,
-	0x25, 0x7a, 0x04, 0xe0, 0x78, 0xb9, 0x5f, 0xe9, 0xca, 0xc7, 0x2d, 0xdd, 0x1f, 0x01, 0x63, 0xf4,
-	0x7b, 0x90, 0x53, 0xe2, 0x94, 0xf8, 0x95, 0x9c, 0xa8, 0x88, 0x51, 0xe3, 0x48, 0x31, 0x57, 0xc1,
-	0x85, 0xf7, 0x5e, 0x91, 0x5e, 0x1a, 0x19, 0x78, 0x15, 0x5c, 0xf0, 0x7a, 0x79, 0x6a, 0x9d, 0xe8,
-	0x84, 0x12, 0x34, 0x9c, 0x6c, 0xe6, 0x06, 0x0c, 0x02, 0xa9, 0xc4, 0x32, 0x7e, 0xf9, 0xc2, 0xea,
-	0x50, 0x7b, 0x10, 0x12, 0xf7, 0x6e, 0x4a, 0x7f, 0x06, 0x0f, 0x0e, 0x44, 0xf0, 0xf0, 0x40, 0x04,
-	0x5f, 0x1d, 0x88, 0xa9, 0xaf, 0x0f, 0xc4, 0xd4, 0x37, 0x07, 0x62, 0xea, 0xdb, 0x03, 0x31, 0xf5,
-	0xe8, 0x40, 0x04, 0x1f, 0xbb, 0x22, 0xb8, 0xe7, 0x8a, 0xa9, 0xcf, 0x5c, 0x11, 0x7c, 0xee, 0x8a,
-	0xa9, 0x2f, 0x5c, 0x31, 0xf5, 0xa5, 0x2b, 0xa6, 0x1e, 0xb8, 0x22, 0x78, 0xe8, 0x8a, 0xe0, 0x2b,
-	0x57, 0x4c, 0x7d, 0xed, 0x8a, 0xe0, 0x1b, 0x57, 0x4c, 0x7d, 0xeb, 0x8a, 0xe0, 0x91, 0x2b, 0xa6,
-	0x3e, 0x6e, 0x8b, 0xa9, 0x7b, 0x6d, 0x11, 0x7c, 0xd2, 0x16, 0x53, 0x7f, 0x68, 0x8b, 0xe0, 0xd3,
-	0xb6, 0x98, 0xfa, 0xac, 0x2d, 0xa6, 0x3e, 0x6f, 0x8b, 0xe0, 0x8b, 0xb6, 0x08, 0xbe, 0x6c, 0x8b,
-	0xe0, 0xbd, 0x62, 0xdd, 0x94, 0xe9, 0x36, 0xa1, 0xdb, 0x9a, 0x51, 0x77, 0x64, 0x83, 0xd0, 0x5d,
-	0xd3, 0x6e, 0x14, 0xe3, 0xff, 0xad, 0xd9, 0xb9, 0x54, 0xb4, 0x1a, 0xf5, 0x22, 0xa5, 0x86, 0xb5,
-	0xb5, 0x35, 0xc5, 0x72, 0xbf, 0xf4, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x39, 0xf2, 0x8d, 0x16,
-	0x01, 0x1b, 0x00, 0x00,
+func (m *ApplicationWebhookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookRequest.DiscardUnknown(m)
 }
 
-func (this *ApplicationWebhookIdentifiers) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_ApplicationWebhookRequest proto.InternalMessageInfo
 
-	that1, ok := that.(*ApplicationWebhookIdentifiers)
-	if !ok {
-		that2, ok := that.(ApplicationWebhookIdentifiers)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+func (m *ApplicationWebhookRequest) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
-		return false
+	return ""
+}
+
+func (m *ApplicationWebhookRequest) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if this.WebhookID != that1.WebhookID {
-		return false
+	return time.Time{}
+}
+
+func (m *ApplicationWebhookRequest) GetURL() string {
+	if m != nil {
+		return m.URL
 	}
-	return true
+	return ""
 }
-func (this *ApplicationWebhookTemplateIdentifiers) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ApplicationWebhookRequest) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
 	}
+	return nil
+}
 
-	that1, ok := that.(*ApplicationWebhookTemplateIdentifiers)
-	if !ok {
-		that2, ok := that.(ApplicationWebhookTemplateIdentifiers)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ApplicationWebhookRequest) GetBody() []byte {
+	if m != nil {
+		return m.Body
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *ApplicationWebhookRequest) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
 	}
-	if this.TemplateID != that1.TemplateID {
-		return false
+	return 0
+}
+
+func (m *ApplicationWebhookRequest) GetLastAttemptAt() *time.Time {
+	if m != nil {
+		return m.LastAttemptAt
 	}
-	return true
+	return nil
+}
+
+func (m *ApplicationWebhookRequest) GetLastError() *ErrorDetails {
+	if m != nil {
+		return m.LastError
+	}
+	return nil
+}
+
+type ApplicationWebhookDeadLetters struct {
+	Requests             []*ApplicationWebhookRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ApplicationWebhookDeadLetters) Reset()      { *m = ApplicationWebhookDeadLetters{} }
+func (*ApplicationWebhookDeadLetters) ProtoMessage() {}
+func (*ApplicationWebhookDeadLetters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{15}
+}
+func (m *ApplicationWebhookDeadLetters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookDeadLetters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookDeadLetters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationWebhookDeadLetters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookDeadLetters.Merge(m, src)
+}
+func (m *ApplicationWebhookDeadLetters) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookDeadLetters) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookDeadLetters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookDeadLetters proto.InternalMessageInfo
+
+func (m *ApplicationWebhookDeadLetters) GetRequests() []*ApplicationWebhookRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+type ListApplicationWebhookDeadLettersRequest struct {
+	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	// Limit the number of results per page.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page                 uint32   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListApplicationWebhookDeadLettersRequest) Reset() {
+	*m = ListApplicationWebhookDeadLettersRequest{}
+}
+func (*ListApplicationWebhookDeadLettersRequest) ProtoMessage() {}
+func (*ListApplicationWebhookDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{16}
+}
+func (m *ListApplicationWebhookDeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListApplicationWebhookDeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListApplicationWebhookDeadLettersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListApplicationWebhookDeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationWebhookDeadLettersRequest.Merge(m, src)
+}
+func (m *ListApplicationWebhookDeadLettersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListApplicationWebhookDeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationWebhookDeadLettersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationWebhookDeadLettersRequest proto.InternalMessageInfo
+
+func (m *ListApplicationWebhookDeadLettersRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListApplicationWebhookDeadLettersRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+type ReplayApplicationWebhookDeadLettersRequest struct {
+	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	// The IDs of the requests to replay.
+	// If empty, all dead-lettered requests of the webhook are replayed.
+	RequestIDs           []string `protobuf:"bytes,2,rep,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplayApplicationWebhookDeadLettersRequest) Reset() {
+	*m = ReplayApplicationWebhookDeadLettersRequest{}
+}
+func (*ReplayApplicationWebhookDeadLettersRequest) ProtoMessage() {}
+func (*ReplayApplicationWebhookDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{17}
+}
+func (m *ReplayApplicationWebhookDeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayApplicationWebhookDeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayApplicationWebhookDeadLettersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayApplicationWebhookDeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayApplicationWebhookDeadLettersRequest.Merge(m, src)
+}
+func (m *ReplayApplicationWebhookDeadLettersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayApplicationWebhookDeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayApplicationWebhookDeadLettersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayApplicationWebhookDeadLettersRequest proto.InternalMessageInfo
+
+func (m *ReplayApplicationWebhookDeadLettersRequest) GetRequestIDs() []string {
+	if m != nil {
+		return m.RequestIDs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
+	golang_proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
+	proto.RegisterType((*ApplicationWebhookTemplateIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplateIdentifiers")
+	golang_proto.RegisterType((*ApplicationWebhookTemplateIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplateIdentifiers")
+	proto.RegisterType((*ApplicationWebhookTemplateField)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplateField")
+	golang_proto.RegisterType((*ApplicationWebhookTemplateField)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplateField")
+	proto.RegisterType((*ApplicationWebhookTemplate)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate")
+	golang_proto.RegisterType((*ApplicationWebhookTemplate)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate.HeadersEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate.HeadersEntry")
+	proto.RegisterType((*ApplicationWebhookTemplate_Message)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate.Message")
+	golang_proto.RegisterType((*ApplicationWebhookTemplate_Message)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate.Message")
+	proto.RegisterType((*ApplicationWebhookTemplates)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplates")
+	golang_proto.RegisterType((*ApplicationWebhookTemplates)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplates")
+	proto.RegisterType((*ApplicationWebhookHealth)(nil), "ttn.lorawan.v3.ApplicationWebhookHealth")
+	golang_proto.RegisterType((*ApplicationWebhookHealth)(nil), "ttn.lorawan.v3.ApplicationWebhookHealth")
+	proto.RegisterType((*ApplicationWebhook)(nil), "ttn.lorawan.v3.ApplicationWebhook")
+	golang_proto.RegisterType((*ApplicationWebhook)(nil), "ttn.lorawan.v3.ApplicationWebhook")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.HeadersEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.HeadersEntry")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.TemplateFieldsEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.TemplateFieldsEntry")
+	proto.RegisterType((*ApplicationWebhook_Message)(nil), "ttn.lorawan.v3.ApplicationWebhook.Message")
+	golang_proto.RegisterType((*ApplicationWebhook_Message)(nil), "ttn.lorawan.v3.ApplicationWebhook.Message")
+	proto.RegisterType((*ApplicationWebhook_Batching)(nil), "ttn.lorawan.v3.ApplicationWebhook.Batching")
+	golang_proto.RegisterType((*ApplicationWebhook_Batching)(nil), "ttn.lorawan.v3.ApplicationWebhook.Batching")
+	proto.RegisterType((*ApplicationWebhooks)(nil), "ttn.lorawan.v3.ApplicationWebhooks")
+	golang_proto.RegisterType((*ApplicationWebhooks)(nil), "ttn.lorawan.v3.ApplicationWebhooks")
+	proto.RegisterType((*ApplicationWebhookFormats)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats")
+	golang_proto.RegisterType((*ApplicationWebhookFormats)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats.FormatsEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats.FormatsEntry")
+	proto.RegisterType((*GetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookRequest")
+	golang_proto.RegisterType((*GetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookRequest")
+	proto.RegisterType((*ListApplicationWebhooksRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhooksRequest")
+	golang_proto.RegisterType((*ListApplicationWebhooksRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhooksRequest")
+	proto.RegisterType((*SetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.SetApplicationWebhookRequest")
+	golang_proto.RegisterType((*SetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.SetApplicationWebhookRequest")
+	proto.RegisterType((*GetApplicationWebhookTemplateRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookTemplateRequest")
+	golang_proto.RegisterType((*GetApplicationWebhookTemplateRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookTemplateRequest")
+	proto.RegisterType((*ListApplicationWebhookTemplatesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookTemplatesRequest")
+	golang_proto.RegisterType((*ListApplicationWebhookTemplatesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookTemplatesRequest")
+	proto.RegisterType((*ApplicationWebhookRequest)(nil), "ttn.lorawan.v3.ApplicationWebhookRequest")
+	golang_proto.RegisterType((*ApplicationWebhookRequest)(nil), "ttn.lorawan.v3.ApplicationWebhookRequest")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookRequest.HeadersEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookRequest.HeadersEntry")
+	proto.RegisterType((*ApplicationWebhookDeadLetters)(nil), "ttn.lorawan.v3.ApplicationWebhookDeadLetters")
+	golang_proto.RegisterType((*ApplicationWebhookDeadLetters)(nil), "ttn.lorawan.v3.ApplicationWebhookDeadLetters")
+	proto.RegisterType((*ListApplicationWebhookDeadLettersRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookDeadLettersRequest")
+	golang_proto.RegisterType((*ListApplicationWebhookDeadLettersRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookDeadLettersRequest")
+	proto.RegisterType((*ReplayApplicationWebhookDeadLettersRequest)(nil), "ttn.lorawan.v3.ReplayApplicationWebhookDeadLettersRequest")
+	golang_proto.RegisterType((*ReplayApplicationWebhookDeadLettersRequest)(nil), "ttn.lorawan.v3.ReplayApplicationWebhookDeadLettersRequest")
+}
+
+func init() {
+	proto.RegisterFile("lorawan-stack/api/applicationserver_web.proto", fileDescriptor_2652f2d8eaceda0e)
+}
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/applicationserver_web.proto", fileDescriptor_2652f2d8eaceda0e)
+}
+
+var fileDescriptor_2652f2d8eaceda0e = []byte{
+	// 2559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xe7, 0x88, 0x14, 0x45, 0x0e, 0x3f, 0x24, 0x8f, 0x14, 0x79, 0x4d, 0xcb, 0x2b, 0x61, 0xe3,
+	0x7f, 0x22, 0x2b, 0x21, 0xf9, 0x87, 0x92, 0xb4, 0x89, 0x9a, 0xc6, 0x21, 0x4d, 0x5b, 0x56, 0x23,
+	0xc7, 0xf1, 0x32, 0x4e, 0x90, 0x18, 0x09, 0xb1, 0xe2, 0x8e, 0xa8, 0xad, 0x96, 0xbb, 0xcc, 0xee,
+	0x50, 0xb2, 0x12, 0x18, 0x35, 0x0a, 0x14, 0x08, 0x7a, 0x0a, 0x92, 0x43, 0x73, 0x2a, 0x02, 0x14,
+	0x05, 0xd2, 0xf6, 0xd0, 0x34, 0xa7, 0xe4, 0x50, 0x20, 0x28, 0x7a, 0x08, 0x7a, 0x32, 0x90, 0x43,
+	0x83, 0x1e, 0xd4, 0x88, 0xea, 0xc1, 0xa7, 0x22, 0x47, 0x43, 0xa7, 0x62, 0x66, 0x67, 0x97, 0xcb,
+	0x0f, 0x59, 0x4b, 0xca, 0x6e, 0x4f, 0xe2, 0xce, 0xbc, 0xf7, 0x9b, 0xf7, 0xde, 0xbc, 0x7d, 0xef,
+	0x37, 0xb3, 0x82, 0x59, 0xdd, 0xb4, 0x94, 0x6d, 0xc5, 0xc8, 0xda, 0x44, 0xa9, 0x6e, 0xe6, 0x95,
+	0x86, 0x96, 0x57, 0x1a, 0x0d, 0x5d, 0xab, 0x2a, 0x44, 0x33, 0x0d, 0x1b, 0x5b, 0x5b, 0xd8, 0xaa,
+	0x6c, 0xe3, 0xb5, 0x5c, 0xc3, 0x32, 0x89, 0x89, 0xd2, 0x84, 0x18, 0x39, 0xae, 0x92, 0xdb, 0x7a,
+	0x2a, 0x53, 0xa8, 0x69, 0x64, 0xa3, 0xb9, 0x96, 0xab, 0x9a, 0xf5, 0x3c, 0x36, 0xb6, 0xcc, 0x9d,
+	0x86, 0x65, 0xde, 0xdc, 0xc9, 0x33, 0xe1, 0x6a, 0xb6, 0x86, 0x8d, 0xec, 0x96, 0xa2, 0x6b, 0xaa,
+	0x42, 0x70, 0xbe, 0xe7, 0x87, 0x03, 0x99, 0xc9, 0xfa, 0x20, 0x6a, 0x66, 0xcd, 0x74, 0x94, 0xd7,
+	0x9a, 0xeb, 0xec, 0x89, 0x3d, 0xb0, 0x5f, 0x5c, 0x7c, 0xa6, 0x66, 0x9a, 0x35, 0x1d, 0x3b, 0x96,
+	0x1a, 0x86, 0x49, 0x1c, 0x43, 0xf9, 0xac, 0xc8, 0x67, 0x3d, 0x0c, 0xb5, 0x69, 0x31, 0x01, 0x3e,
+	0x7f, 0xba, 0x7b, 0x1e, 0xd7, 0x1b, 0x64, 0x87, 0x4f, 0xce, 0x75, 0x4f, 0xae, 0x6b, 0x58, 0x57,
+	0x2b, 0x75, 0xc5, 0xde, 0xe4, 0x12, 0xb3, 0xdd, 0x12, 0x44, 0xab, 0x63, 0x9b, 0x28, 0xf5, 0x06,
+	0x17, 0x38, 0xd3, 0x1b, 0x4e, 0x6c, 0x59, 0xa6, 0xc5, 0xa7, 0x1f, 0xed, 0x9d, 0xd6, 0x54, 0x6c,
+	0x10, 0x6d, 0x5d, 0xc3, 0x16, 0xf7, 0x41, 0xfa, 0x3b, 0x80, 0x67, 0x0a, 0xed, 0x3d, 0x78, 0x1d,
+	0xaf, 0x6d, 0x98, 0xe6, 0xe6, 0x4a, 0x5b, 0x0e, 0x29, 0x70, 0xdc, 0xb7, 0x49, 0x15, 0x4d, 0xb5,
+	0x05, 0x30, 0x07, 0xe6, 0x13, 0x8b, 0x8f, 0xe5, 0x3a, 0xf7, 0x27, 0xe7, 0xc3, 0xf1, 0x01, 0x14,
+	0x27, 0x0e, 0x8a, 0xa3, 0xbf, 0x04, 0x23, 0x13, 0xe0, 0xeb, 0xdd, 0xd9, 0xd0, 0x9d, 0xdd, 0x59,
+	0x20, 0xa7, 0x15, 0xbf, 0xa4, 0x8d, 0xca, 0x10, 0x6e, 0x3b, 0x0b, 0x57, 0x34, 0x55, 0x18, 0x99,
+	0x03, 0xf3, 0xf1, 0xe2, 0xd3, 0x07, 0xc5, 0xb3, 0x96, 0x24, 0x9c, 0x5d, 0x14, 0xdf, 0xbe, 0xa1,
+	0x64, 0xdf, 0xfd, 0xff, 0xec, 0x73, 0x6f, 0xcd, 0x9f, 0x5f, 0xba, 0x91, 0x7d, 0xeb, 0xbc, 0xfb,
+	0x78, 0xee, 0xbd, 0xc5, 0x27, 0x6f, 0x9d, 0x6d, 0xed, 0xce, 0xc6, 0x5d, 0xab, 0x4b, 0x72, 0x7c,
+	0xdb, 0x75, 0x40, 0xfa, 0x19, 0xfc, 0xbf, 0x5e, 0xc7, 0x5e, 0xc5, 0xf5, 0x86, 0xae, 0x10, 0xec,
+	0x77, 0xf0, 0x35, 0x98, 0x20, 0x7c, 0x98, 0x2e, 0x0f, 0xd8, 0xf2, 0xcf, 0x04, 0x5f, 0x1e, 0x7a,
+	0xa0, 0x25, 0x19, 0x12, 0x6f, 0x01, 0xe9, 0xdf, 0x00, 0xce, 0x1e, 0x6e, 0xc1, 0x25, 0xba, 0xdd,
+	0xe8, 0xc7, 0x70, 0xc4, 0x5b, 0x32, 0x1b, 0x7c, 0xc9, 0x91, 0x95, 0x92, 0x3c, 0xa2, 0xa9, 0xe8,
+	0x34, 0x8c, 0x18, 0x4a, 0x1d, 0xf3, 0x90, 0x8d, 0x1d, 0x14, 0x23, 0xd6, 0x88, 0x30, 0x25, 0xb3,
+	0x41, 0x74, 0x0e, 0x26, 0x54, 0x6c, 0x57, 0x2d, 0xad, 0x41, 0x97, 0x17, 0xc2, 0x7e, 0x19, 0x55,
+	0xf6, 0xcf, 0xa1, 0x69, 0x18, 0xb5, 0x71, 0xd5, 0xc2, 0x44, 0x88, 0xcc, 0x81, 0xf9, 0x98, 0xcc,
+	0x9f, 0xd0, 0x93, 0x30, 0xa5, 0xe2, 0x75, 0xa5, 0xa9, 0x93, 0xca, 0x96, 0xa2, 0x37, 0xb1, 0x30,
+	0xda, 0x09, 0x92, 0xe4, 0xb3, 0xaf, 0xd1, 0x49, 0xe9, 0xcf, 0x69, 0x98, 0x39, 0xdc, 0x61, 0xf4,
+	0x06, 0x0c, 0xb7, 0x93, 0xe7, 0x99, 0xfb, 0x24, 0xcf, 0xe1, 0x7b, 0xd5, 0x27, 0x97, 0x28, 0xe6,
+	0x03, 0x8b, 0x43, 0x0e, 0xc6, 0x74, 0xb3, 0x66, 0x56, 0x9a, 0x96, 0xce, 0x22, 0x11, 0x2f, 0x4e,
+	0x1e, 0x14, 0x47, 0xad, 0xf0, 0xfb, 0x00, 0xb4, 0x76, 0x67, 0xc7, 0x56, 0xcd, 0x9a, 0x79, 0x5d,
+	0x5e, 0x95, 0xc7, 0xa8, 0xd0, 0x75, 0x4b, 0xa7, 0xf2, 0x9a, 0xb1, 0xee, 0xc8, 0x8f, 0xf6, 0xca,
+	0xaf, 0x18, 0xeb, 0x8e, 0x3c, 0x15, 0xa2, 0xf2, 0x2b, 0xf0, 0x84, 0x6a, 0x56, 0x9b, 0x75, 0x6c,
+	0x38, 0x95, 0x84, 0x29, 0x46, 0x99, 0xe2, 0x8c, 0x4f, 0x71, 0xa2, 0xe4, 0x17, 0xa2, 0x08, 0x13,
+	0x1d, 0x6a, 0x7c, 0xe9, 0x35, 0xc5, 0xc6, 0x0c, 0x61, 0xac, 0x77, 0xe9, 0xa2, 0x62, 0x63, 0xb6,
+	0x34, 0x15, 0xa2, 0xf2, 0xd7, 0xe0, 0xd8, 0x06, 0x56, 0x54, 0x6c, 0xd9, 0x42, 0x6c, 0x2e, 0x3c,
+	0x9f, 0x58, 0xfc, 0x61, 0xf0, 0x1d, 0xc8, 0x5d, 0x76, 0x34, 0x2f, 0x1a, 0xc4, 0xda, 0x91, 0x5d,
+	0x1c, 0x74, 0x1e, 0x46, 0xd7, 0x4d, 0xab, 0xae, 0x10, 0x21, 0xce, 0x0c, 0x78, 0xdc, 0x49, 0xe0,
+	0xa9, 0xa3, 0x12, 0x58, 0xe6, 0x6a, 0x68, 0x19, 0x46, 0x59, 0xd5, 0xb3, 0x05, 0xc8, 0x4c, 0xca,
+	0x07, 0x37, 0x89, 0xbd, 0x3e, 0x32, 0x57, 0x47, 0x57, 0xe1, 0xc9, 0xaa, 0x85, 0xe9, 0x0b, 0xac,
+	0x9a, 0xdb, 0x86, 0xae, 0x19, 0x9b, 0x15, 0xa5, 0xa1, 0x55, 0x36, 0xf1, 0x8e, 0x30, 0x49, 0x13,
+	0xba, 0x28, 0xb4, 0x76, 0x67, 0xa7, 0x2e, 0x30, 0x91, 0x12, 0x97, 0x28, 0xbc, 0xb2, 0xf2, 0x12,
+	0xde, 0x91, 0xa7, 0xaa, 0x9d, 0xa3, 0x0d, 0xed, 0x25, 0xbc, 0x83, 0xde, 0x80, 0xe9, 0x66, 0x83,
+	0xe1, 0xd4, 0xb1, 0x6d, 0x2b, 0x35, 0x2c, 0x24, 0x58, 0xda, 0x2e, 0x0e, 0x10, 0xb4, 0x2b, 0x8e,
+	0xa6, 0x9c, 0x72, 0x90, 0xf8, 0x23, 0x2a, 0xc3, 0xc4, 0x4f, 0x4d, 0xcd, 0xa8, 0x28, 0xd5, 0x2a,
+	0x6e, 0x10, 0x21, 0x39, 0x34, 0x2e, 0xa4, 0x30, 0x05, 0x86, 0x82, 0xae, 0xc3, 0x64, 0xdb, 0xf3,
+	0xea, 0xa6, 0x90, 0x1a, 0x1a, 0x35, 0xe1, 0xe2, 0x14, 0xaa, 0x9b, 0xe8, 0x75, 0x98, 0xf2, 0x60,
+	0x0d, 0x8a, 0x9b, 0x1e, 0x1a, 0xd7, 0xb3, 0xef, 0x65, 0xa5, 0x0b, 0xd8, 0xc6, 0x06, 0x11, 0xc6,
+	0x8f, 0x0f, 0x5c, 0xc6, 0x06, 0x41, 0x37, 0xe0, 0xb8, 0x07, 0xbc, 0xae, 0x68, 0x3a, 0x56, 0x85,
+	0x89, 0xa1, 0xa1, 0xd3, 0x2e, 0xd4, 0x25, 0x86, 0xd4, 0x01, 0xfe, 0x4e, 0x13, 0x37, 0xb1, 0x2a,
+	0x9c, 0x38, 0x3e, 0xf8, 0x35, 0x86, 0x84, 0x1a, 0x30, 0xd3, 0x09, 0x5e, 0xd1, 0x0c, 0x97, 0xbd,
+	0xa8, 0xc2, 0x23, 0x43, 0xaf, 0x23, 0x74, 0xac, 0xb3, 0xd2, 0xc6, 0xa4, 0xee, 0xe8, 0x26, 0x6f,
+	0xeb, 0xb6, 0xa9, 0x6f, 0x61, 0x55, 0x40, 0xc3, 0xbb, 0xe3, 0x42, 0x95, 0x19, 0x12, 0xcd, 0x48,
+	0x4a, 0xe8, 0xb4, 0x2a, 0xae, 0xa8, 0x0a, 0x51, 0x84, 0xa9, 0xe1, 0x33, 0x92, 0xe3, 0x94, 0x14,
+	0xa2, 0xa0, 0x3c, 0x4c, 0xdb, 0x5a, 0xcd, 0xd0, 0x8c, 0x5a, 0x85, 0x77, 0xac, 0x69, 0x56, 0x7b,
+	0x62, 0xac, 0xf8, 0x09, 0xb7, 0x47, 0xe4, 0x14, 0x9f, 0x2f, 0xb3, 0x69, 0xf4, 0x32, 0x9c, 0x26,
+	0xba, 0x5d, 0xa9, 0xea, 0x1a, 0x36, 0x48, 0xa5, 0x8a, 0x2d, 0xda, 0x4a, 0xaa, 0x0a, 0xc1, 0xc2,
+	0xc9, 0x76, 0x65, 0x78, 0x75, 0xb5, 0x7c, 0x81, 0x09, 0x5c, 0x68, 0xcf, 0xcb, 0x53, 0x44, 0xb7,
+	0x7b, 0x46, 0x33, 0x4b, 0x30, 0xe9, 0xaf, 0x86, 0x68, 0x02, 0x86, 0x69, 0x99, 0x61, 0x2d, 0x5c,
+	0xa6, 0x3f, 0xd1, 0x14, 0x1c, 0x75, 0x9a, 0x25, 0xeb, 0x46, 0xb2, 0xf3, 0xb0, 0x34, 0xf2, 0x2c,
+	0xc8, 0x9c, 0x81, 0x63, 0x6e, 0x15, 0x40, 0x30, 0xd2, 0x50, 0xc8, 0x06, 0xd7, 0x63, 0xbf, 0xa5,
+	0x1a, 0x3c, 0x7d, 0x78, 0x38, 0x6c, 0x74, 0x19, 0xc6, 0x5d, 0x76, 0x41, 0xbb, 0x28, 0x2d, 0x98,
+	0x0b, 0xc1, 0xc3, 0x29, 0xb7, 0x95, 0xa5, 0x2f, 0xc3, 0x50, 0xe8, 0x95, 0xbc, 0x8c, 0x15, 0x9d,
+	0x6c, 0xa0, 0xc7, 0xe1, 0xb8, 0xf3, 0xe2, 0x54, 0x14, 0x42, 0x55, 0x88, 0xd3, 0xb2, 0x23, 0x72,
+	0xda, 0x19, 0x2e, 0xf0, 0x51, 0x74, 0x1d, 0x4e, 0xeb, 0x8a, 0x4d, 0x2a, 0x9d, 0xd2, 0x15, 0x85,
+	0x30, 0xc7, 0x13, 0x8b, 0x99, 0x9c, 0x43, 0x60, 0x73, 0x2e, 0x81, 0xcd, 0xbd, 0xea, 0x12, 0xd8,
+	0x62, 0xe4, 0x83, 0x7f, 0xce, 0x02, 0x79, 0x92, 0xea, 0x5f, 0xf2, 0xa3, 0x16, 0xe8, 0x1b, 0x7c,
+	0xba, 0x1f, 0xac, 0x8a, 0x89, 0xa2, 0xe9, 0x36, 0x6b, 0xdf, 0x89, 0xc5, 0x99, 0x6e, 0xc7, 0x2f,
+	0x52, 0xe2, 0x5b, 0x72, 0x64, 0x64, 0xa1, 0x07, 0x97, 0xcf, 0xa0, 0xb7, 0x60, 0x86, 0x81, 0xdb,
+	0xcd, 0x6a, 0x15, 0xdb, 0xf6, 0x7a, 0x53, 0xf7, 0xdb, 0x1d, 0x09, 0x68, 0xf7, 0x49, 0x8a, 0x51,
+	0xf6, 0x20, 0xda, 0xb6, 0xcf, 0xc0, 0xb8, 0xdd, 0xb4, 0x1b, 0xd8, 0x50, 0xb1, 0xca, 0x08, 0x41,
+	0x4c, 0x6e, 0x0f, 0xa0, 0x0b, 0x30, 0xe9, 0x3d, 0xd0, 0xe5, 0xa2, 0x01, 0x97, 0x4b, 0x78, 0x5a,
+	0x05, 0x22, 0xfd, 0x63, 0x02, 0xa2, 0xde, 0xbd, 0x43, 0xd7, 0xfc, 0xe4, 0x2a, 0x7b, 0x74, 0x5a,
+	0x04, 0x20, 0x55, 0x17, 0x20, 0x74, 0x7a, 0xa3, 0x1a, 0x6c, 0x4f, 0x63, 0x54, 0x9d, 0x19, 0x1c,
+	0xe7, 0x7a, 0x05, 0x42, 0x41, 0x9a, 0x0d, 0xd5, 0x05, 0x09, 0x0f, 0x02, 0xc2, 0xf5, 0x0a, 0xa4,
+	0x83, 0xeb, 0x44, 0x02, 0x70, 0x9d, 0x95, 0x36, 0xd7, 0x19, 0x0d, 0x4a, 0x2c, 0x8e, 0xe4, 0x38,
+	0xd1, 0xe1, 0x38, 0xce, 0xdb, 0x30, 0xe9, 0x3b, 0x5d, 0xd8, 0xc2, 0xf8, 0x71, 0xe8, 0x6f, 0x84,
+	0xed, 0x4e, 0xa2, 0x7d, 0xc8, 0xb0, 0x51, 0x05, 0x8e, 0x7b, 0xf8, 0x9c, 0x4c, 0x4d, 0x30, 0x9f,
+	0x7f, 0x10, 0xc0, 0xe7, 0x0e, 0x36, 0xc5, 0x5d, 0x4f, 0x93, 0x8e, 0x41, 0xf4, 0x3c, 0x9c, 0xe8,
+	0x21, 0x55, 0x27, 0x58, 0x2c, 0x50, 0x6b, 0x77, 0x36, 0xdd, 0x45, 0xa7, 0xd2, 0x6a, 0x27, 0x91,
+	0xba, 0xd6, 0x43, 0xa4, 0xc6, 0xe6, 0x40, 0xb0, 0xca, 0x75, 0x18, 0x81, 0x7a, 0xa9, 0x93, 0x40,
+	0xc5, 0x06, 0xc6, 0xf3, 0x13, 0xa7, 0x2b, 0x5d, 0xc4, 0x29, 0x3e, 0x30, 0x5a, 0x07, 0x61, 0xba,
+	0xda, 0x4d, 0x98, 0xe0, 0xc0, 0x78, 0x9d, 0x44, 0xe9, 0x6a, 0x37, 0x51, 0x4a, 0x0c, 0x0f, 0xc8,
+	0x08, 0x52, 0xb9, 0x97, 0x20, 0x25, 0x07, 0x86, 0xec, 0x26, 0x46, 0xe5, 0x5e, 0x62, 0x94, 0x1a,
+	0x1e, 0x94, 0x13, 0xa2, 0x8d, 0xfb, 0x12, 0xa2, 0xc9, 0x81, 0xf1, 0x0f, 0x27, 0x42, 0xe5, 0x5e,
+	0x22, 0x94, 0x1e, 0xdc, 0xfc, 0x2e, 0x02, 0x74, 0xa5, 0x8b, 0x00, 0xa1, 0xc1, 0x33, 0xeb, 0xfe,
+	0xc4, 0x67, 0xea, 0xfe, 0xc4, 0xa7, 0x00, 0xc7, 0xbb, 0x88, 0x0f, 0x23, 0x91, 0xc9, 0xe2, 0xa9,
+	0x83, 0xe2, 0xe8, 0xbb, 0x61, 0xe1, 0xf6, 0x8b, 0xad, 0xdd, 0xd9, 0x54, 0x07, 0xf3, 0x91, 0x53,
+	0x1d, 0x94, 0x07, 0xbd, 0x00, 0xd3, 0x3e, 0x08, 0xfa, 0xe2, 0x4f, 0x33, 0x04, 0xc1, 0x87, 0x90,
+	0xf4, 0x10, 0xe8, 0xeb, 0x9f, 0xf4, 0x00, 0xe8, 0xcb, 0xff, 0x22, 0x8c, 0x6e, 0x30, 0x52, 0xc1,
+	0xb8, 0x56, 0x62, 0x71, 0xfe, 0x68, 0xe7, 0x1d, 0x12, 0x22, 0x73, 0x3d, 0xb4, 0x4c, 0x2b, 0x3f,
+	0xa9, 0x6e, 0x68, 0x46, 0x4d, 0x10, 0x18, 0xc6, 0x13, 0x01, 0x02, 0x58, 0xe4, 0x2a, 0xb2, 0xa7,
+	0x7c, 0x2c, 0xda, 0x56, 0x80, 0x93, 0x7d, 0x0a, 0xe5, 0x03, 0x64, 0x7e, 0x99, 0x5f, 0x00, 0x18,
+	0x73, 0x8d, 0x46, 0x4f, 0xc0, 0x64, 0x5d, 0xb9, 0xe9, 0xd6, 0x4b, 0xa7, 0xa7, 0xa7, 0xd8, 0x3e,
+	0x2f, 0x84, 0x85, 0xbb, 0x63, 0x72, 0xa2, 0xae, 0xdc, 0xe4, 0x60, 0x36, 0x5a, 0x85, 0x71, 0x2a,
+	0xac, 0x62, 0x5d, 0xd9, 0xe1, 0x3d, 0xfa, 0x54, 0x4f, 0x7b, 0x2d, 0xf1, 0x7b, 0xc9, 0xe2, 0xd4,
+	0x41, 0x31, 0xfe, 0x7b, 0x10, 0x95, 0x46, 0x62, 0xcf, 0x2f, 0x86, 0x68, 0xa7, 0xfd, 0x98, 0x76,
+	0xda, 0x58, 0x5d, 0xb9, 0x59, 0xa2, 0x00, 0xd2, 0x75, 0x38, 0xd9, 0x1b, 0x4e, 0x1b, 0xbd, 0x00,
+	0x63, 0xfc, 0x5e, 0xcd, 0x25, 0x9e, 0xd2, 0xd1, 0xbb, 0x20, 0x7b, 0x3a, 0xd2, 0xef, 0x00, 0x3c,
+	0xd5, 0x2b, 0x70, 0x89, 0x35, 0x48, 0x1b, 0xbd, 0x02, 0xc7, 0x9c, 0x5e, 0xe9, 0x82, 0x07, 0xe8,
+	0x5c, 0x5c, 0x37, 0xc7, 0xff, 0xf2, 0xa6, 0xcd, 0x61, 0xe8, 0x66, 0xfb, 0x27, 0x06, 0xd9, 0x29,
+	0xe9, 0x73, 0x00, 0x67, 0x96, 0x31, 0xe9, 0xe3, 0x0f, 0x7e, 0xa7, 0x89, 0x6d, 0xf2, 0x30, 0x98,
+	0xd6, 0x79, 0x08, 0xdb, 0xb7, 0xbf, 0x87, 0x32, 0x2d, 0x96, 0x7b, 0x57, 0x14, 0x7b, 0xb3, 0x18,
+	0xa1, 0xea, 0x72, 0x7c, 0xdd, 0x1d, 0x90, 0xfe, 0x0a, 0xa0, 0xb8, 0xaa, 0xd9, 0x7d, 0xac, 0xb6,
+	0x5d, 0xb3, 0xff, 0x0b, 0xd7, 0xb8, 0xc7, 0x76, 0xe3, 0x8f, 0x00, 0xce, 0x94, 0xef, 0x17, 0xfb,
+	0x97, 0xe1, 0x18, 0x4f, 0x2a, 0x6e, 0x7c, 0x80, 0x3c, 0xec, 0x63, 0xb8, 0x0b, 0x72, 0x7c, 0x8b,
+	0xff, 0x02, 0xe0, 0xd9, 0xbe, 0xd9, 0xe2, 0x1d, 0xbb, 0xb8, 0xe5, 0x0f, 0xf1, 0xf2, 0xf3, 0xd8,
+	0x4e, 0x68, 0xf0, 0xb1, 0xfe, 0xc9, 0xe3, 0x9d, 0x3d, 0x5d, 0x2f, 0x3a, 0x97, 0x02, 0x83, 0x2f,
+	0xf5, 0x87, 0x48, 0xbf, 0x4a, 0xf0, 0x10, 0x5f, 0xad, 0x69, 0x76, 0xc1, 0xee, 0xdc, 0x0b, 0x47,
+	0x7d, 0x37, 0xe7, 0x9d, 0x87, 0x9b, 0xf0, 0x70, 0x87, 0x9b, 0x53, 0x30, 0xdc, 0x3e, 0x92, 0x8c,
+	0xb5, 0x76, 0x67, 0xc3, 0xf4, 0x18, 0x42, 0xc7, 0x68, 0x51, 0xeb, 0x3c, 0x82, 0x04, 0x28, 0x6a,
+	0x3c, 0x0c, 0x87, 0x9c, 0x44, 0x10, 0x8c, 0xac, 0x99, 0xea, 0x0e, 0x3b, 0x87, 0x24, 0x65, 0xf6,
+	0x1b, 0x65, 0x60, 0xcc, 0x3b, 0xa4, 0x53, 0x5e, 0x9d, 0x92, 0xbd, 0x67, 0x74, 0x19, 0x8e, 0xb3,
+	0xa3, 0xae, 0xef, 0x7c, 0x1b, 0x0b, 0x78, 0xe0, 0x4c, 0x51, 0xc5, 0xf6, 0xa9, 0xf6, 0x47, 0x10,
+	0x32, 0x24, 0xf6, 0x71, 0x49, 0x88, 0x07, 0x38, 0x80, 0xc7, 0xa9, 0x3c, 0x1b, 0x39, 0x4e, 0xe3,
+	0x95, 0xd6, 0xfb, 0x7d, 0x9b, 0x2a, 0x61, 0x45, 0x5d, 0xc5, 0x84, 0xd0, 0x98, 0x5c, 0x84, 0x31,
+	0xcb, 0x09, 0x9a, 0xdb, 0x3b, 0xce, 0x05, 0x0e, 0xb3, 0xec, 0xa9, 0x4a, 0x7f, 0x02, 0x70, 0xbe,
+	0xff, 0x1b, 0xe0, 0x5b, 0xec, 0x21, 0x26, 0xa9, 0x08, 0x47, 0x75, 0xad, 0xae, 0x39, 0x87, 0x6c,
+	0x7f, 0xab, 0x77, 0x86, 0x1d, 0xca, 0x50, 0xc3, 0x2c, 0x4d, 0x53, 0x32, 0xfb, 0x2d, 0x7d, 0x09,
+	0xe0, 0x82, 0x8c, 0x1b, 0xba, 0xb2, 0xf3, 0xbf, 0xb2, 0xfa, 0x59, 0x98, 0xe0, 0x11, 0x64, 0xdd,
+	0x64, 0x64, 0x2e, 0x3c, 0x1f, 0x2f, 0x9e, 0x3c, 0x28, 0x46, 0x3f, 0x04, 0xe1, 0x89, 0xbb, 0xf4,
+	0x75, 0x80, 0x7c, 0xf5, 0x95, 0x92, 0x2d, 0x43, 0x2e, 0xbb, 0xa2, 0xda, 0x8b, 0x9f, 0x27, 0xfb,
+	0x7d, 0x28, 0x92, 0x71, 0x4d, 0xb3, 0x69, 0x8a, 0xe8, 0x10, 0x2e, 0x63, 0xe2, 0xd2, 0x83, 0xe9,
+	0x9e, 0x74, 0xbd, 0x48, 0x3f, 0xa3, 0x66, 0xce, 0x05, 0x66, 0x09, 0xd2, 0xe9, 0x9f, 0x7f, 0xf3,
+	0xaf, 0x8f, 0x46, 0x1e, 0x41, 0x93, 0x79, 0xc5, 0xce, 0xf3, 0xda, 0x9f, 0xe5, 0x64, 0x01, 0x7d,
+	0x02, 0x60, 0x62, 0x19, 0x13, 0xef, 0x33, 0xd5, 0xd3, 0xdd, 0xb8, 0x41, 0xea, 0x7b, 0x66, 0x80,
+	0x9b, 0x38, 0x29, 0xcf, 0xcc, 0x39, 0x87, 0x1e, 0xf7, 0x9b, 0xe3, 0xdd, 0xce, 0xe5, 0xdf, 0xd3,
+	0x54, 0x3b, 0xe7, 0xbb, 0x33, 0xb8, 0x85, 0x3e, 0x02, 0x30, 0x45, 0xf3, 0xb3, 0x7d, 0x17, 0xd8,
+	0x53, 0x4d, 0x82, 0x15, 0xf0, 0xcc, 0x13, 0xc1, 0xcd, 0xb4, 0xa5, 0x33, 0xcc, 0xce, 0x93, 0xe8,
+	0x91, 0xbe, 0x76, 0xa2, 0xdf, 0x00, 0x18, 0x5e, 0xa6, 0x1f, 0x09, 0x03, 0x05, 0xcc, 0xb5, 0x20,
+	0x40, 0xc7, 0x96, 0x7e, 0xc2, 0x16, 0x2e, 0xa1, 0xa2, 0x6f, 0x61, 0x1e, 0x97, 0x2e, 0x0e, 0xd3,
+	0xf5, 0x7c, 0xcb, 0x11, 0x6a, 0x7f, 0x4c, 0xbe, 0x85, 0x3e, 0x04, 0x30, 0x42, 0x83, 0x83, 0x72,
+	0xc1, 0x42, 0xe6, 0x85, 0xea, 0xd1, 0xa3, 0x0d, 0xb5, 0xa5, 0x67, 0x98, 0xa5, 0x79, 0x94, 0xed,
+	0xb4, 0xf4, 0x08, 0x2b, 0xd1, 0x3d, 0x00, 0xc3, 0xe5, 0x7e, 0xa1, 0x2b, 0x1f, 0x37, 0x74, 0xbf,
+	0x06, 0xcc, 0xa2, 0x5f, 0x81, 0x8c, 0xdc, 0x69, 0x12, 0xff, 0x95, 0x0b, 0x14, 0x44, 0xbf, 0xb0,
+	0x2f, 0x98, 0x4b, 0x60, 0xe1, 0xcd, 0x17, 0xa4, 0xe7, 0x86, 0x06, 0x5e, 0x02, 0x0b, 0x34, 0x97,
+	0xa3, 0x25, 0xac, 0x63, 0x82, 0xd1, 0x60, 0x65, 0x28, 0x73, 0x48, 0x21, 0x90, 0x8a, 0xcc, 0xe3,
+	0xe7, 0x17, 0x96, 0x06, 0xda, 0x03, 0xcf, 0x70, 0xb6, 0x21, 0xdf, 0x00, 0x38, 0x4e, 0xf3, 0xc1,
+	0xdf, 0x5c, 0x9e, 0x0d, 0x96, 0x30, 0xbd, 0xc5, 0x36, 0x13, 0xc0, 0x31, 0x9f, 0x96, 0xf4, 0x3a,
+	0x73, 0xe0, 0x1a, 0xba, 0x7a, 0xfc, 0x74, 0xcf, 0xab, 0x58, 0x51, 0xb3, 0x3a, 0xf7, 0xe0, 0x6f,
+	0x00, 0x9e, 0x70, 0x7a, 0x84, 0xdf, 0xaf, 0xa5, 0x6e, 0xeb, 0x82, 0xb7, 0x91, 0x43, 0xf7, 0x40,
+	0x61, 0x2e, 0xdc, 0x90, 0x5e, 0x7b, 0xc0, 0x2e, 0xe4, 0x2d, 0x66, 0xdb, 0x12, 0x58, 0x28, 0xfe,
+	0x16, 0x7c, 0xbd, 0x27, 0x82, 0x3b, 0x7b, 0x22, 0xf8, 0x76, 0x4f, 0x0c, 0x7d, 0xb7, 0x27, 0x86,
+	0xee, 0xee, 0x89, 0xa1, 0xef, 0xf7, 0xc4, 0xd0, 0xbd, 0x3d, 0x11, 0xdc, 0x6e, 0x89, 0xe0, 0xfd,
+	0x96, 0x18, 0xfa, 0xb4, 0x25, 0x82, 0xcf, 0x5a, 0x62, 0xe8, 0x8b, 0x96, 0x18, 0xfa, 0xaa, 0x25,
+	0x86, 0xbe, 0x6e, 0x89, 0xe0, 0x4e, 0x4b, 0x04, 0xdf, 0xb6, 0xc4, 0xd0, 0x77, 0x2d, 0x11, 0xdc,
+	0x6d, 0x89, 0xa1, 0xef, 0x5b, 0x22, 0xb8, 0xd7, 0x12, 0x43, 0xb7, 0xf7, 0xc5, 0xd0, 0xfb, 0xfb,
+	0x22, 0xf8, 0x60, 0x5f, 0x0c, 0x7d, 0xbc, 0x2f, 0x82, 0x4f, 0xf6, 0xc5, 0xd0, 0xa7, 0xfb, 0x62,
+	0xe8, 0xb3, 0x7d, 0x11, 0x7c, 0xb1, 0x2f, 0x82, 0xaf, 0xf6, 0x45, 0xf0, 0x66, 0xbe, 0x66, 0xe6,
+	0xc8, 0x06, 0x26, 0xf4, 0x68, 0x6e, 0xe7, 0x0c, 0x4c, 0xb6, 0x4d, 0x6b, 0x33, 0xdf, 0xf9, 0xaf,
+	0x35, 0x5b, 0x4f, 0xe5, 0x1b, 0x9b, 0xb5, 0x3c, 0x21, 0x46, 0x63, 0x6d, 0x2d, 0xca, 0x42, 0xf3,
+	0xd4, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x09, 0x14, 0xa7, 0x76, 0xed, 0x24, 0x00, 0x00,
+}
+
+func (this *ApplicationWebhookIdentifiers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhookIdentifiers)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookIdentifiers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if this.WebhookID != that1.WebhookID {
+		return false
+	}
+	return true
+}
+func (this *ApplicationWebhookTemplateIdentifiers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhookTemplateIdentifiers)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookTemplateIdentifiers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TemplateID != that1.TemplateID {
+		return false
+	}
+	return true
 }
 func (this *ApplicationWebhookTemplateField) Equal(that interface{}) bool {
 	if that == nil {
@@ -1402,6 +1938,12 @@ func (this *ApplicationWebhookTemplate) Equal(that interface{}) bool {
 	if !this.ServiceData.Equal(that1.ServiceData) {
 		return false
 	}
+	if this.SigningSecret != that1.SigningSecret {
+		return false
+	}
+	if this.TLSClientCertificate != that1.TLSClientCertificate {
+		return false
+	}
 	return true
 }
 func (this *ApplicationWebhookTemplate_Message) Equal(that interface{}) bool {
@@ -1457,6 +1999,57 @@ func (this *ApplicationWebhookTemplates) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationWebhookHealth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhookHealth)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookHealth)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FailedAttempts != that1.FailedAttempts {
+		return false
+	}
+	if that1.LastFailedAttemptAt == nil {
+		if this.LastFailedAttemptAt != nil {
+			return false
+		}
+	} else if !this.LastFailedAttemptAt.Equal(*that1.LastFailedAttemptAt) {
+		return false
+	}
+	if !this.LastFailedAttemptDetails.Equal(that1.LastFailedAttemptDetails) {
+		return false
+	}
+	if that1.LastSuccessfulAttemptAt == nil {
+		if this.LastSuccessfulAttemptAt != nil {
+			return false
+		}
+	} else if !this.LastSuccessfulAttemptAt.Equal(*that1.LastSuccessfulAttemptAt) {
+		return false
+	}
+	if this.Suspended != that1.Suspended {
+		return false
+	}
+	if that1.SuspendedAt == nil {
+		if this.SuspendedAt != nil {
+			return false
+		}
+	} else if !this.SuspendedAt.Equal(*that1.SuspendedAt) {
+		return false
+	}
+	return true
+}
 func (this *ApplicationWebhook) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1543,6 +2136,21 @@ func (this *ApplicationWebhook) Equal(that interface{}) bool {
 	if !this.ServiceData.Equal(that1.ServiceData) {
 		return false
 	}
+	if this.SigningSecret != that1.SigningSecret {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
+		return false
+	}
+	if !this.Health.Equal(that1.Health) {
+		return false
+	}
+	if !this.Batching.Equal(that1.Batching) {
+		return false
+	}
 	return true
 }
 func (this *ApplicationWebhook_Message) Equal(that interface{}) bool {
@@ -1569,14 +2177,14 @@ func (this *ApplicationWebhook_Message) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ApplicationWebhooks) Equal(that interface{}) bool {
+func (this *ApplicationWebhook_Batching) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ApplicationWebhooks)
+	that1, ok := that.(*ApplicationWebhook_Batching)
 	if !ok {
-		that2, ok := that.(ApplicationWebhooks)
+		that2, ok := that.(ApplicationWebhook_Batching)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1588,13 +2196,40 @@ func (this *ApplicationWebhooks) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Webhooks) != len(that1.Webhooks) {
+	if this.MaxMessages != that1.MaxMessages {
 		return false
 	}
-	for i := range this.Webhooks {
-		if !this.Webhooks[i].Equal(that1.Webhooks[i]) {
-			return false
-		}
+	if this.MaxDelay != that1.MaxDelay {
+		return false
+	}
+	return true
+}
+func (this *ApplicationWebhooks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhooks)
+	if !ok {
+		that2, ok := that.(ApplicationWebhooks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Webhooks) != len(that1.Webhooks) {
+		return false
+	}
+	for i := range this.Webhooks {
+		if !this.Webhooks[i].Equal(that1.Webhooks[i]) {
+			return false
+		}
 	}
 	return true
 }
@@ -1759,6 +2394,154 @@ func (this *ListApplicationWebhookTemplatesRequest) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *ApplicationWebhookRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhookRequest)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Body, that1.Body) {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	if that1.LastAttemptAt == nil {
+		if this.LastAttemptAt != nil {
+			return false
+		}
+	} else if !this.LastAttemptAt.Equal(*that1.LastAttemptAt) {
+		return false
+	}
+	if !this.LastError.Equal(that1.LastError) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationWebhookDeadLetters) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhookDeadLetters)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookDeadLetters)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Requests) != len(that1.Requests) {
+		return false
+	}
+	for i := range this.Requests {
+		if !this.Requests[i].Equal(that1.Requests[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ListApplicationWebhookDeadLettersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListApplicationWebhookDeadLettersRequest)
+	if !ok {
+		that2, ok := that.(ListApplicationWebhookDeadLettersRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Page != that1.Page {
+		return false
+	}
+	return true
+}
+func (this *ReplayApplicationWebhookDeadLettersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplayApplicationWebhookDeadLettersRequest)
+	if !ok {
+		that2, ok := that.(ReplayApplicationWebhookDeadLettersRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if len(this.RequestIDs) != len(that1.RequestIDs) {
+		return false
+	}
+	for i := range this.RequestIDs {
+		if this.RequestIDs[i] != that1.RequestIDs[i] {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -1779,6 +2562,11 @@ type ApplicationWebhookRegistryClient interface {
 	List(ctx context.Context, in *ListApplicationWebhooksRequest, opts ...grpc.CallOption) (*ApplicationWebhooks, error)
 	Set(ctx context.Context, in *SetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
 	Delete(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// List the requests of the webhook that could not be delivered after the maximum number of attempts.
+	ListDeadLetters(ctx context.Context, in *ListApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ApplicationWebhookDeadLetters, error)
+	// Replay dead-lettered requests of the webhook.
+	// Replayed requests are removed from the dead letters and queued for delivery.
+	ReplayDeadLetters(ctx context.Context, in *ReplayApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type applicationWebhookRegistryClient struct {
@@ -1852,6 +2640,24 @@ func (c *applicationWebhookRegistryClient) Delete(ctx context.Context, in *Appli
 	return out, nil
 }
 
+func (c *applicationWebhookRegistryClient) ListDeadLetters(ctx context.Context, in *ListApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ApplicationWebhookDeadLetters, error) {
+	out := new(ApplicationWebhookDeadLetters)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) ReplayDeadLetters(ctx context.Context, in *ReplayApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/ReplayDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationWebhookRegistryServer is the server API for ApplicationWebhookRegistry service.
 type ApplicationWebhookRegistryServer interface {
 	GetFormats(context.Context, *types.Empty) (*ApplicationWebhookFormats, error)
@@ -1861,6 +2667,11 @@ type ApplicationWebhookRegistryServer interface {
 	List(context.Context, *ListApplicationWebhooksRequest) (*ApplicationWebhooks, error)
 	Set(context.Context, *SetApplicationWebhookRequest) (*ApplicationWebhook, error)
 	Delete(context.Context, *ApplicationWebhookIdentifiers) (*types.Empty, error)
+	// List the requests of the webhook that could not be delivered after the maximum number of attempts.
+	ListDeadLetters(context.Context, *ListApplicationWebhookDeadLettersRequest) (*ApplicationWebhookDeadLetters, error)
+	// Replay dead-lettered requests of the webhook.
+	// Replayed requests are removed from the dead letters and queued for delivery.
+	ReplayDeadLetters(context.Context, *ReplayApplicationWebhookDeadLettersRequest) (*types.Empty, error)
 }
 
 // UnimplementedApplicationWebhookRegistryServer can be embedded to have forward compatible implementations.
@@ -1888,6 +2699,12 @@ func (*UnimplementedApplicationWebhookRegistryServer) Set(ctx context.Context, r
 func (*UnimplementedApplicationWebhookRegistryServer) Delete(ctx context.Context, req *ApplicationWebhookIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedApplicationWebhookRegistryServer) ListDeadLetters(ctx context.Context, req *ListApplicationWebhookDeadLettersRequest) (*ApplicationWebhookDeadLetters, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (*UnimplementedApplicationWebhookRegistryServer) ReplayDeadLetters(ctx context.Context, req *ReplayApplicationWebhookDeadLettersRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetters not implemented")
+}
 
 func RegisterApplicationWebhookRegistryServer(s *grpc.Server, srv ApplicationWebhookRegistryServer) {
 	s.RegisterService(&_ApplicationWebhookRegistry_serviceDesc, srv)
@@ -2019,6 +2836,42 @@ func _ApplicationWebhookRegistry_Delete_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationWebhookRegistry_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationWebhookDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ListDeadLetters(ctx, req.(*ListApplicationWebhookDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayApplicationWebhookDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ReplayDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/ReplayDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ReplayDeadLetters(ctx, req.(*ReplayApplicationWebhookDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.ApplicationWebhookRegistry",
 	HandlerType: (*ApplicationWebhookRegistryServer)(nil),
@@ -2051,6 +2904,14 @@ var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationWebhookRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _ApplicationWebhookRegistry_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _ApplicationWebhookRegistry_ReplayDeadLetters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/applicationserver_web.proto",
@@ -2207,6 +3068,27 @@ func (m *ApplicationWebhookTemplate) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.TLSClientCertificate {
+		i--
+		if m.TLSClientCertificate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.SigningSecret) > 0 {
+		i -= len(m.SigningSecret)
+		copy(dAtA[i:], m.SigningSecret)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.SigningSecret)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if m.DownlinkQueueInvalidated != nil {
 		{
 			size, err := m.DownlinkQueueInvalidated.MarshalToSizedBuffer(dAtA[:i])
@@ -2511,7 +3393,7 @@ func (m *ApplicationWebhookTemplates) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationWebhook) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationWebhookHealth) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2521,33 +3403,49 @@ func (m *ApplicationWebhook) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationWebhook) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationWebhookHealth) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationWebhookHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DownlinkQueueInvalidated != nil {
-		{
-			size, err := m.DownlinkQueueInvalidated.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	if m.SuspendedAt != nil {
+		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SuspendedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SuspendedAt):])
+		if err13 != nil {
+			return 0, err13
 		}
+		i -= n13
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n13))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x32
+	}
+	if m.Suspended {
 		i--
-		dAtA[i] = 0x9a
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ServiceData != nil {
+	if m.LastSuccessfulAttemptAt != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSuccessfulAttemptAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSuccessfulAttemptAt):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastFailedAttemptDetails != nil {
 		{
-			size, err := m.ServiceData.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LastFailedAttemptDetails.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2555,28 +3453,147 @@ func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0x1a
 	}
-	if len(m.DownlinkAPIKey) > 0 {
-		i -= len(m.DownlinkAPIKey)
-		copy(dAtA[i:], m.DownlinkAPIKey)
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.DownlinkAPIKey)))
+	if m.LastFailedAttemptAt != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastFailedAttemptAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastFailedAttemptAt):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n16))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x12
+	}
+	if m.FailedAttempts != 0 {
+		i = encodeVarintApplicationserverWeb(dAtA, i, m.FailedAttempts)
 		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0x8
 	}
-	if len(m.TemplateFields) > 0 {
-		for k := range m.TemplateFields {
-			v := m.TemplateFields[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationWebhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Batching != nil {
+		{
+			size, err := m.Batching.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.Health != nil {
+		{
+			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.TLSClientKey) > 0 {
+		i -= len(m.TLSClientKey)
+		copy(dAtA[i:], m.TLSClientKey)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.TLSClientKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.TLSClientCert) > 0 {
+		i -= len(m.TLSClientCert)
+		copy(dAtA[i:], m.TLSClientCert)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.TLSClientCert)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.SigningSecret) > 0 {
+		i -= len(m.SigningSecret)
+		copy(dAtA[i:], m.SigningSecret)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.SigningSecret)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.DownlinkQueueInvalidated != nil {
+		{
+			size, err := m.DownlinkQueueInvalidated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ServiceData != nil {
+		{
+			size, err := m.ServiceData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.DownlinkAPIKey) > 0 {
+		i -= len(m.DownlinkAPIKey)
+		copy(dAtA[i:], m.DownlinkAPIKey)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.DownlinkAPIKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.TemplateFields) > 0 {
+		for k := range m.TemplateFields {
+			v := m.TemplateFields[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
 			i -= len(k)
 			copy(dAtA[i:], k)
 			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(k)))
@@ -2730,20 +3747,20 @@ func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err24 != nil {
-		return 0, err24
+	n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err30 != nil {
+		return 0, err30
 	}
-	i -= n24
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n24))
+	i -= n30
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n30))
 	i--
 	dAtA[i] = 0x1a
-	n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err25 != nil {
-		return 0, err25
+	n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err31 != nil {
+		return 0, err31
 	}
-	i -= n25
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n25))
+	i -= n31
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n31))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -2789,6 +3806,42 @@ func (m *ApplicationWebhook_Message) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationWebhook_Batching) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhook_Batching) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationWebhook_Batching) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n33, err33 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDelay):])
+	if err33 != nil {
+		return 0, err33
+	}
+	i -= n33
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n33))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxMessages != 0 {
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.MaxMessages))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationWebhooks) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3073,94 +4126,324 @@ func (m *ListApplicationWebhookTemplatesRequest) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApplicationserverWeb(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplicationserverWeb(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func NewPopulatedApplicationWebhookIdentifiers(r randyApplicationserverWeb, easy bool) *ApplicationWebhookIdentifiers {
-	this := &ApplicationWebhookIdentifiers{}
-	v1 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v1
-	this.WebhookID = randStringApplicationserverWeb(r)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
-}
-
-func NewPopulatedApplicationWebhookTemplateIdentifiers(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplateIdentifiers {
-	this := &ApplicationWebhookTemplateIdentifiers{}
-	this.TemplateID = randStringApplicationserverWeb(r)
-	if !easy && r.Intn(10) != 0 {
+func (m *ApplicationWebhookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedApplicationWebhookTemplateField(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplateField {
-	this := &ApplicationWebhookTemplateField{}
-	this.ID = randStringApplicationserverWeb(r)
-	this.Name = randStringApplicationserverWeb(r)
-	this.Description = randStringApplicationserverWeb(r)
-	this.Secret = bool(r.Intn(2) == 0)
-	this.DefaultValue = randStringApplicationserverWeb(r)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *ApplicationWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedApplicationWebhookTemplate(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplate {
-	this := &ApplicationWebhookTemplate{}
-	v2 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
-	this.ApplicationWebhookTemplateIdentifiers = *v2
-	this.Name = randStringApplicationserverWeb(r)
-	this.Description = randStringApplicationserverWeb(r)
-	this.LogoURL = randStringApplicationserverWeb(r)
-	this.InfoURL = randStringApplicationserverWeb(r)
-	this.DocumentationURL = randStringApplicationserverWeb(r)
-	this.BaseURL = randStringApplicationserverWeb(r)
-	if r.Intn(5) != 0 {
-		v3 := r.Intn(10)
-		this.Headers = make(map[string]string)
-		for i := 0; i < v3; i++ {
-			this.Headers[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
+func (m *ApplicationWebhookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastError != nil {
+		{
+			size, err := m.LastError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	this.Format = randStringApplicationserverWeb(r)
-	if r.Intn(5) != 0 {
-		v4 := r.Intn(5)
-		this.Fields = make([]*ApplicationWebhookTemplateField, v4)
-		for i := 0; i < v4; i++ {
-			this.Fields[i] = NewPopulatedApplicationWebhookTemplateField(r, easy)
+	if m.LastAttemptAt != nil {
+		n44, err44 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastAttemptAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAttemptAt):])
+		if err44 != nil {
+			return 0, err44
 		}
+		i -= n44
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n44))
+		i--
+		dAtA[i] = 0x42
 	}
-	if r.Intn(5) != 0 {
-		this.UplinkMessage = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.JoinAccept = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	if m.Attempts != 0 {
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x38
 	}
-	if r.Intn(5) != 0 {
-		this.DownlinkAck = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if r.Intn(5) != 0 {
-		this.DownlinkNack = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if r.Intn(5) != 0 {
-		this.DownlinkSent = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if r.Intn(5) != 0 {
-		this.DownlinkFailed = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	n45, err45 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err45 != nil {
+		return 0, err45
 	}
-	if r.Intn(5) != 0 {
-		this.DownlinkQueued = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	i -= n45
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n45))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if r.Intn(5) != 0 {
+	{
+		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationWebhookDeadLetters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookDeadLetters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationWebhookDeadLetters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListApplicationWebhookDeadLettersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListApplicationWebhookDeadLettersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListApplicationWebhookDeadLettersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Page != 0 {
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayApplicationWebhookDeadLettersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayApplicationWebhookDeadLettersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayApplicationWebhookDeadLettersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestIDs) > 0 {
+		for iNdEx := len(m.RequestIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequestIDs[iNdEx])
+			copy(dAtA[i:], m.RequestIDs[iNdEx])
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.RequestIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplicationserverWeb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationserverWeb(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedApplicationWebhookIdentifiers(r randyApplicationserverWeb, easy bool) *ApplicationWebhookIdentifiers {
+	this := &ApplicationWebhookIdentifiers{}
+	v1 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v1
+	this.WebhookID = randStringApplicationserverWeb(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookTemplateIdentifiers(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplateIdentifiers {
+	this := &ApplicationWebhookTemplateIdentifiers{}
+	this.TemplateID = randStringApplicationserverWeb(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookTemplateField(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplateField {
+	this := &ApplicationWebhookTemplateField{}
+	this.ID = randStringApplicationserverWeb(r)
+	this.Name = randStringApplicationserverWeb(r)
+	this.Description = randStringApplicationserverWeb(r)
+	this.Secret = bool(r.Intn(2) == 0)
+	this.DefaultValue = randStringApplicationserverWeb(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookTemplate(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplate {
+	this := &ApplicationWebhookTemplate{}
+	v2 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
+	this.ApplicationWebhookTemplateIdentifiers = *v2
+	this.Name = randStringApplicationserverWeb(r)
+	this.Description = randStringApplicationserverWeb(r)
+	this.LogoURL = randStringApplicationserverWeb(r)
+	this.InfoURL = randStringApplicationserverWeb(r)
+	this.DocumentationURL = randStringApplicationserverWeb(r)
+	this.BaseURL = randStringApplicationserverWeb(r)
+	if r.Intn(5) != 0 {
+		v3 := r.Intn(10)
+		this.Headers = make(map[string]string)
+		for i := 0; i < v3; i++ {
+			this.Headers[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
+		}
+	}
+	this.Format = randStringApplicationserverWeb(r)
+	if r.Intn(5) != 0 {
+		v4 := r.Intn(5)
+		this.Fields = make([]*ApplicationWebhookTemplateField, v4)
+		for i := 0; i < v4; i++ {
+			this.Fields[i] = NewPopulatedApplicationWebhookTemplateField(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.UplinkMessage = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.JoinAccept = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkAck = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkNack = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkSent = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkFailed = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkQueued = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
 		this.LocationSolved = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
 	}
 	this.CreateDownlinkAPIKey = bool(r.Intn(2) == 0)
@@ -3170,6 +4453,8 @@ func NewPopulatedApplicationWebhookTemplate(r randyApplicationserverWeb, easy bo
 	if r.Intn(5) != 0 {
 		this.DownlinkQueueInvalidated = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
 	}
+	this.SigningSecret = randStringApplicationserverWeb(r)
+	this.TLSClientCertificate = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3197,6 +4482,27 @@ func NewPopulatedApplicationWebhookTemplates(r randyApplicationserverWeb, easy b
 	return this
 }
 
+func NewPopulatedApplicationWebhookHealth(r randyApplicationserverWeb, easy bool) *ApplicationWebhookHealth {
+	this := &ApplicationWebhookHealth{}
+	this.FailedAttempts = uint64(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.LastFailedAttemptAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		this.LastFailedAttemptDetails = NewPopulatedErrorDetails(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.LastSuccessfulAttemptAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.Suspended = bool(r.Intn(2) == 0)
+	if r.Intn(5) != 0 {
+		this.SuspendedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedApplicationWebhook(r randyApplicationserverWeb, easy bool) *ApplicationWebhook {
 	this := &ApplicationWebhook{}
 	v6 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
@@ -3255,6 +4561,23 @@ func NewPopulatedApplicationWebhook(r randyApplicationserverWeb, easy bool) *App
 	if r.Intn(5) != 0 {
 		this.DownlinkQueueInvalidated = NewPopulatedApplicationWebhook_Message(r, easy)
 	}
+	this.SigningSecret = randStringApplicationserverWeb(r)
+	v11 := r.Intn(100)
+	this.TLSClientCert = make([]byte, v11)
+	for i := 0; i < v11; i++ {
+		this.TLSClientCert[i] = byte(r.Intn(256))
+	}
+	v12 := r.Intn(100)
+	this.TLSClientKey = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.TLSClientKey[i] = byte(r.Intn(256))
+	}
+	if r.Intn(5) == 0 {
+		this.Health = NewPopulatedApplicationWebhookHealth(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Batching = NewPopulatedApplicationWebhook_Batching(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3268,12 +4591,22 @@ func NewPopulatedApplicationWebhook_Message(r randyApplicationserverWeb, easy bo
 	return this
 }
 
+func NewPopulatedApplicationWebhook_Batching(r randyApplicationserverWeb, easy bool) *ApplicationWebhook_Batching {
+	this := &ApplicationWebhook_Batching{}
+	this.MaxMessages = r.Uint32()
+	v13 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.MaxDelay = *v13
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedApplicationWebhooks(r randyApplicationserverWeb, easy bool) *ApplicationWebhooks {
 	this := &ApplicationWebhooks{}
-	if r.Intn(5) != 0 {
-		v11 := r.Intn(5)
-		this.Webhooks = make([]*ApplicationWebhook, v11)
-		for i := 0; i < v11; i++ {
+	if r.Intn(5) == 0 {
+		v14 := r.Intn(5)
+		this.Webhooks = make([]*ApplicationWebhook, v14)
+		for i := 0; i < v14; i++ {
 			this.Webhooks[i] = NewPopulatedApplicationWebhook(r, easy)
 		}
 	}
@@ -3285,9 +4618,9 @@ func NewPopulatedApplicationWebhooks(r randyApplicationserverWeb, easy bool) *Ap
 func NewPopulatedApplicationWebhookFormats(r randyApplicationserverWeb, easy bool) *ApplicationWebhookFormats {
 	this := &ApplicationWebhookFormats{}
 	if r.Intn(5) != 0 {
-		v12 := r.Intn(10)
+		v15 := r.Intn(10)
 		this.Formats = make(map[string]string)
-		for i := 0; i < v12; i++ {
+		for i := 0; i < v15; i++ {
 			this.Formats[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
 		}
 	}
@@ -3298,10 +4631,10 @@ func NewPopulatedApplicationWebhookFormats(r randyApplicationserverWeb, easy boo
 
 func NewPopulatedGetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *GetApplicationWebhookRequest {
 	this := &GetApplicationWebhookRequest{}
-	v13 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
-	this.ApplicationWebhookIdentifiers = *v13
-	v14 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v14
+	v16 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v16
+	v17 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v17
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3309,10 +4642,10 @@ func NewPopulatedGetApplicationWebhookRequest(r randyApplicationserverWeb, easy
 
 func NewPopulatedListApplicationWebhooksRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhooksRequest {
 	this := &ListApplicationWebhooksRequest{}
-	v15 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v15
-	v16 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v16
+	v18 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v18
+	v19 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v19
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3320,10 +4653,10 @@ func NewPopulatedListApplicationWebhooksRequest(r randyApplicationserverWeb, eas
 
 func NewPopulatedSetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *SetApplicationWebhookRequest {
 	this := &SetApplicationWebhookRequest{}
-	v17 := NewPopulatedApplicationWebhook(r, easy)
-	this.ApplicationWebhook = *v17
-	v18 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v18
+	v20 := NewPopulatedApplicationWebhook(r, easy)
+	this.ApplicationWebhook = *v20
+	v21 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v21
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3331,10 +4664,10 @@ func NewPopulatedSetApplicationWebhookRequest(r randyApplicationserverWeb, easy
 
 func NewPopulatedGetApplicationWebhookTemplateRequest(r randyApplicationserverWeb, easy bool) *GetApplicationWebhookTemplateRequest {
 	this := &GetApplicationWebhookTemplateRequest{}
-	v19 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
-	this.ApplicationWebhookTemplateIdentifiers = *v19
-	v20 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v20
+	v22 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
+	this.ApplicationWebhookTemplateIdentifiers = *v22
+	v23 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v23
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3342,35 +4675,106 @@ func NewPopulatedGetApplicationWebhookTemplateRequest(r randyApplicationserverWe
 
 func NewPopulatedListApplicationWebhookTemplatesRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhookTemplatesRequest {
 	this := &ListApplicationWebhookTemplatesRequest{}
-	v21 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v21
+	v24 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v24
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-type randyApplicationserverWeb interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+func NewPopulatedApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *ApplicationWebhookRequest {
+	this := &ApplicationWebhookRequest{}
+	v25 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v25
+	this.ID = randStringApplicationserverWeb(r)
+	v26 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v26
+	this.URL = randStringApplicationserverWeb(r)
+	if r.Intn(5) != 0 {
+		v27 := r.Intn(10)
+		this.Headers = make(map[string]string)
+		for i := 0; i < v27; i++ {
+			this.Headers[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
+		}
+	}
+	v28 := r.Intn(100)
+	this.Body = make([]byte, v28)
+	for i := 0; i < v28; i++ {
+		this.Body[i] = byte(r.Intn(256))
+	}
+	this.Attempts = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.LastAttemptAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		this.LastError = NewPopulatedErrorDetails(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func randUTF8RuneApplicationserverWeb(r randyApplicationserverWeb) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+func NewPopulatedApplicationWebhookDeadLetters(r randyApplicationserverWeb, easy bool) *ApplicationWebhookDeadLetters {
+	this := &ApplicationWebhookDeadLetters{}
+	if r.Intn(5) == 0 {
+		v29 := r.Intn(5)
+		this.Requests = make([]*ApplicationWebhookRequest, v29)
+		for i := 0; i < v29; i++ {
+			this.Requests[i] = NewPopulatedApplicationWebhookRequest(r, easy)
+		}
 	}
-	return rune(ru + 61)
-}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListApplicationWebhookDeadLettersRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhookDeadLettersRequest {
+	this := &ListApplicationWebhookDeadLettersRequest{}
+	v30 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v30
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedReplayApplicationWebhookDeadLettersRequest(r randyApplicationserverWeb, easy bool) *ReplayApplicationWebhookDeadLettersRequest {
+	this := &ReplayApplicationWebhookDeadLettersRequest{}
+	v31 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v31
+	v32 := r.Intn(10)
+	this.RequestIDs = make([]string, v32)
+	for i := 0; i < v32; i++ {
+		this.RequestIDs[i] = randStringApplicationserverWeb(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyApplicationserverWeb interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneApplicationserverWeb(r randyApplicationserverWeb) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
 func randStringApplicationserverWeb(r randyApplicationserverWeb) string {
-	v22 := r.Intn(100)
-	tmps := make([]rune, v22)
-	for i := 0; i < v22; i++ {
+	v33 := r.Intn(100)
+	tmps := make([]rune, v33)
+	for i := 0; i < v33; i++ {
 		tmps[i] = randUTF8RuneApplicationserverWeb(r)
 	}
 	return string(tmps)
@@ -3392,11 +4796,11 @@ func randFieldApplicationserverWeb(dAtA []byte, r randyApplicationserverWeb, fie
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
-		v23 := r.Int63()
+		v34 := r.Int63()
 		if r.Intn(2) == 0 {
-			v23 *= -1
+			v34 *= -1
 		}
-		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(v23))
+		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(v34))
 	case 1:
 		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3570,6 +4974,13 @@ func (m *ApplicationWebhookTemplate) Size() (n int) {
 		l = m.DownlinkQueueInvalidated.Size()
 		n += 2 + l + sovApplicationserverWeb(uint64(l))
 	}
+	l = len(m.SigningSecret)
+	if l > 0 {
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.TLSClientCertificate {
+		n += 3
+	}
 	return n
 }
 
@@ -3601,6 +5012,37 @@ func (m *ApplicationWebhookTemplates) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationWebhookHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FailedAttempts != 0 {
+		n += 1 + sovApplicationserverWeb(m.FailedAttempts)
+	}
+	if m.LastFailedAttemptAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastFailedAttemptAt)
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.LastFailedAttemptDetails != nil {
+		l = m.LastFailedAttemptDetails.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.LastSuccessfulAttemptAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSuccessfulAttemptAt)
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.Suspended {
+		n += 2
+	}
+	if m.SuspendedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.SuspendedAt)
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationWebhook) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3685,6 +5127,26 @@ func (m *ApplicationWebhook) Size() (n int) {
 		l = m.DownlinkQueueInvalidated.Size()
 		n += 2 + l + sovApplicationserverWeb(uint64(l))
 	}
+	l = len(m.SigningSecret)
+	if l > 0 {
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.TLSClientCert)
+	if l > 0 {
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.TLSClientKey)
+	if l > 0 {
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.Batching != nil {
+		l = m.Batching.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
 	return n
 }
 
@@ -3701,6 +5163,20 @@ func (m *ApplicationWebhook_Message) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationWebhook_Batching) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMessages != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.MaxMessages))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDelay)
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	return n
+}
+
 func (m *ApplicationWebhooks) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3796,6 +5272,99 @@ func (m *ListApplicationWebhookTemplatesRequest) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationWebhookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApplicationserverWeb(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.Attempts))
+	}
+	if m.LastAttemptAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAttemptAt)
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.LastError != nil {
+		l = m.LastError.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhookDeadLetters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListApplicationWebhookDeadLettersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *ReplayApplicationWebhookDeadLettersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if len(m.RequestIDs) > 0 {
+		for _, s := range m.RequestIDs {
+			l = len(s)
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovApplicationserverWeb(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3878,6 +5447,8 @@ func (this *ApplicationWebhookTemplate) String() string {
 		`CreateDownlinkAPIKey:` + fmt.Sprintf("%v", this.CreateDownlinkAPIKey) + `,`,
 		`ServiceData:` + strings.Replace(fmt.Sprintf("%v", this.ServiceData), "ApplicationWebhookTemplate_Message", "ApplicationWebhookTemplate_Message", 1) + `,`,
 		`DownlinkQueueInvalidated:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkQueueInvalidated), "ApplicationWebhookTemplate_Message", "ApplicationWebhookTemplate_Message", 1) + `,`,
+		`SigningSecret:` + fmt.Sprintf("%v", this.SigningSecret) + `,`,
+		`TLSClientCertificate:` + fmt.Sprintf("%v", this.TLSClientCertificate) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3907,6 +5478,21 @@ func (this *ApplicationWebhookTemplates) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationWebhookHealth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookHealth{`,
+		`FailedAttempts:` + fmt.Sprintf("%v", this.FailedAttempts) + `,`,
+		`LastFailedAttemptAt:` + strings.Replace(fmt.Sprintf("%v", this.LastFailedAttemptAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastFailedAttemptDetails:` + strings.Replace(fmt.Sprintf("%v", this.LastFailedAttemptDetails), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`LastSuccessfulAttemptAt:` + strings.Replace(fmt.Sprintf("%v", this.LastSuccessfulAttemptAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Suspended:` + fmt.Sprintf("%v", this.Suspended) + `,`,
+		`SuspendedAt:` + strings.Replace(fmt.Sprintf("%v", this.SuspendedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationWebhook) String() string {
 	if this == nil {
 		return "nil"
@@ -3951,6 +5537,11 @@ func (this *ApplicationWebhook) String() string {
 		`DownlinkAPIKey:` + fmt.Sprintf("%v", this.DownlinkAPIKey) + `,`,
 		`ServiceData:` + strings.Replace(fmt.Sprintf("%v", this.ServiceData), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
 		`DownlinkQueueInvalidated:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkQueueInvalidated), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`SigningSecret:` + fmt.Sprintf("%v", this.SigningSecret) + `,`,
+		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
+		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
+		`Health:` + strings.Replace(this.Health.String(), "ApplicationWebhookHealth", "ApplicationWebhookHealth", 1) + `,`,
+		`Batching:` + strings.Replace(fmt.Sprintf("%v", this.Batching), "ApplicationWebhook_Batching", "ApplicationWebhook_Batching", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3965,6 +5556,17 @@ func (this *ApplicationWebhook_Message) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationWebhook_Batching) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhook_Batching{`,
+		`MaxMessages:` + fmt.Sprintf("%v", this.MaxMessages) + `,`,
+		`MaxDelay:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.MaxDelay), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationWebhooks) String() string {
 	if this == nil {
 		return "nil"
@@ -4054,6 +5656,72 @@ func (this *ListApplicationWebhookTemplatesRequest) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationWebhookRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&ApplicationWebhookRequest{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`LastAttemptAt:` + strings.Replace(fmt.Sprintf("%v", this.LastAttemptAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastError:` + strings.Replace(fmt.Sprintf("%v", this.LastError), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookDeadLetters) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRequests := "[]*ApplicationWebhookRequest{"
+	for _, f := range this.Requests {
+		repeatedStringForRequests += strings.Replace(f.String(), "ApplicationWebhookRequest", "ApplicationWebhookRequest", 1) + ","
+	}
+	repeatedStringForRequests += "}"
+	s := strings.Join([]string{`&ApplicationWebhookDeadLetters{`,
+		`Requests:` + repeatedStringForRequests + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListApplicationWebhookDeadLettersRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListApplicationWebhookDeadLettersRequest{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplayApplicationWebhookDeadLettersRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplayApplicationWebhookDeadLettersRequest{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`RequestIDs:` + fmt.Sprintf("%v", this.RequestIDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringApplicationserverWeb(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -5293,36 +6961,88 @@ func (m *ApplicationWebhookTemplate) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningSecret", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationWebhookTemplate_Message) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+			m.SigningSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertificate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLSClientCertificate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookTemplate_Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowApplicationserverWeb
@@ -5489,7 +7209,7 @@ func (m *ApplicationWebhookTemplates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
+func (m *ApplicationWebhookHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5512,17 +7232,17 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWebhook: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationWebhookHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWebhook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationWebhookHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
 			}
-			var msglen int
+			m.FailedAttempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -5532,28 +7252,14 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FailedAttempts |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailedAttemptAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5580,13 +7286,16 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastFailedAttemptAt == nil {
+				m.LastFailedAttemptAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastFailedAttemptAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailedAttemptDetails", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5613,45 +7322,16 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastFailedAttemptDetails == nil {
+				m.LastFailedAttemptDetails = &ErrorDetails{}
+			}
+			if err := m.LastFailedAttemptDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseURL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserverWeb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BaseURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessfulAttemptAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5678,109 +7358,18 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = make(map[string]string)
+			if m.LastSuccessfulAttemptAt == nil {
+				m.LastSuccessfulAttemptAt = new(time.Time)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplicationserverWeb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApplicationserverWeb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApplicationserverWeb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastSuccessfulAttemptAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -5790,27 +7379,15 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Format = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			m.Suspended = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5837,160 +7414,69 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UplinkMessage == nil {
-				m.UplinkMessage = &ApplicationWebhook_Message{}
+			if m.SuspendedAt == nil {
+				m.SuspendedAt = new(time.Time)
 			}
-			if err := m.UplinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SuspendedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinAccept", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserverWeb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.JoinAccept == nil {
-				m.JoinAccept = &ApplicationWebhook_Message{}
-			}
-			if err := m.JoinAccept.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAck", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserverWeb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DownlinkAck == nil {
-				m.DownlinkAck = &ApplicationWebhook_Message{}
-			}
-			if err := m.DownlinkAck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkNack", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserverWeb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkNack == nil {
-				m.DownlinkNack = &ApplicationWebhook_Message{}
-			}
-			if err := m.DownlinkNack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkSent", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserverWeb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverWeb
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkSent == nil {
-				m.DownlinkSent = &ApplicationWebhook_Message{}
-			}
-			if err := m.DownlinkSent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 12:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkFailed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6017,16 +7503,13 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkFailed == nil {
-				m.DownlinkFailed = &ApplicationWebhook_Message{}
-			}
-			if err := m.DownlinkFailed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkQueued", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6053,16 +7536,13 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkQueued == nil {
-				m.DownlinkQueued = &ApplicationWebhook_Message{}
-			}
-			if err := m.DownlinkQueued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocationSolved", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6089,18 +7569,15 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LocationSolved == nil {
-				m.LocationSolved = &ApplicationWebhook_Message{}
-			}
-			if err := m.LocationSolved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookTemplateIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -6110,31 +7587,27 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApplicationWebhookTemplateIdentifiers == nil {
-				m.ApplicationWebhookTemplateIdentifiers = &ApplicationWebhookTemplateIdentifiers{}
-			}
-			if err := m.ApplicationWebhookTemplateIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BaseURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6161,8 +7634,8 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateFields == nil {
-				m.TemplateFields = make(map[string]string)
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -6257,11 +7730,11 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.TemplateFields[mapkey] = mapvalue
+			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 17:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAPIKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6289,11 +7762,11 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DownlinkAPIKey = string(dAtA[iNdEx:postIndex])
+			m.Format = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6320,16 +7793,1607 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServiceData == nil {
-				m.ServiceData = &ApplicationWebhook_Message{}
+			if m.UplinkMessage == nil {
+				m.UplinkMessage = &ApplicationWebhook_Message{}
 			}
-			if err := m.ServiceData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UplinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinAccept", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JoinAccept == nil {
+				m.JoinAccept = &ApplicationWebhook_Message{}
+			}
+			if err := m.JoinAccept.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkAck == nil {
+				m.DownlinkAck = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkAck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkNack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkNack == nil {
+				m.DownlinkNack = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkNack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkSent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkSent == nil {
+				m.DownlinkSent = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkSent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkFailed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkFailed == nil {
+				m.DownlinkFailed = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkFailed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkQueued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkQueued == nil {
+				m.DownlinkQueued = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkQueued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationSolved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LocationSolved == nil {
+				m.LocationSolved = &ApplicationWebhook_Message{}
+			}
+			if err := m.LocationSolved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookTemplateIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApplicationWebhookTemplateIdentifiers == nil {
+				m.ApplicationWebhookTemplateIdentifiers = &ApplicationWebhookTemplateIdentifiers{}
+			}
+			if err := m.ApplicationWebhookTemplateIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateFields == nil {
+				m.TemplateFields = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TemplateFields[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAPIKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DownlinkAPIKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ServiceData == nil {
+				m.ServiceData = &ApplicationWebhook_Message{}
+			}
+			if err := m.ServiceData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkQueueInvalidated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkQueueInvalidated == nil {
+				m.DownlinkQueueInvalidated = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkQueueInvalidated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientCert == nil {
+				m.TLSClientCert = []byte{}
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientKey == nil {
+				m.TLSClientKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Health == nil {
+				m.Health = &ApplicationWebhookHealth{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batching", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batching == nil {
+				m.Batching = &ApplicationWebhook_Batching{}
+			}
+			if err := m.Batching.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhook_Batching) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Batching: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Batching: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessages", wireType)
+			}
+			m.MaxMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMessages |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Webhooks = append(m.Webhooks, &ApplicationWebhook{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookFormats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookFormats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Formats == nil {
+				m.Formats = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Formats[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetApplicationWebhookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListApplicationWebhooksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListApplicationWebhooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetApplicationWebhookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationWebhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetApplicationWebhookTemplateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetApplicationWebhookTemplateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetApplicationWebhookTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookTemplateIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationWebhookTemplateIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkQueueInvalidated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6356,10 +9420,7 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkQueueInvalidated == nil {
-				m.DownlinkQueueInvalidated = &ApplicationWebhook_Message{}
-			}
-			if err := m.DownlinkQueueInvalidated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6387,7 +9448,7 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
+func (m *ListApplicationWebhookTemplatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6410,17 +9471,17 @@ func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListApplicationWebhookTemplatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListApplicationWebhookTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -6430,23 +9491,24 @@ func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6472,7 +9534,7 @@ func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
+func (m *ApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6495,15 +9557,15 @@ func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWebhooks: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationWebhookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWebhooks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6530,67 +9592,110 @@ func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Webhooks = append(m.Webhooks, &ApplicationWebhook{})
-			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserverWeb
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWebhookFormats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWebhookFormats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Formats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6617,8 +9722,8 @@ func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Formats == nil {
-				m.Formats = make(map[string]string)
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -6674,105 +9779,52 @@ func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowApplicationserverWeb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Formats[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserverWeb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetApplicationWebhookRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -6782,30 +9834,31 @@ func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			var msglen int
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -6815,81 +9868,14 @@ func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Attempts |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserverWeb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListApplicationWebhooksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListApplicationWebhooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6916,13 +9902,16 @@ func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastAttemptAt == nil {
+				m.LastAttemptAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastAttemptAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6949,7 +9938,10 @@ func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastError == nil {
+				m.LastError = &ErrorDetails{}
+			}
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6977,7 +9969,7 @@ func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationWebhookDeadLetters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7000,48 +9992,15 @@ func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetApplicationWebhookRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationWebhookDeadLetters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationWebhookDeadLetters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhook", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserverWeb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ApplicationWebhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7068,7 +10027,8 @@ func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, &ApplicationWebhookRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7096,7 +10056,7 @@ func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetApplicationWebhookTemplateRequest) Unmarshal(dAtA []byte) error {
+func (m *ListApplicationWebhookDeadLettersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7119,15 +10079,15 @@ func (m *GetApplicationWebhookTemplateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetApplicationWebhookTemplateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListApplicationWebhookDeadLettersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetApplicationWebhookTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListApplicationWebhookDeadLettersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookTemplateIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7154,15 +10114,15 @@ func (m *GetApplicationWebhookTemplateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationWebhookTemplateIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -7172,25 +10132,30 @@ func (m *GetApplicationWebhookTemplateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
@@ -7215,7 +10180,7 @@ func (m *GetApplicationWebhookTemplateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListApplicationWebhookTemplatesRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplayApplicationWebhookDeadLettersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7238,15 +10203,15 @@ func (m *ListApplicationWebhookTemplatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListApplicationWebhookTemplatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplayApplicationWebhookDeadLettersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListApplicationWebhookTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplayApplicationWebhookDeadLettersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7273,10 +10238,42 @@ func (m *ListApplicationWebhookTemplatesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestIDs = append(m.RequestIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
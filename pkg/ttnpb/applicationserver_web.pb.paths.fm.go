@@ -65,6 +65,8 @@ var ApplicationWebhookTemplateFieldPathsNested = []string{
 	"name",
 	"service_data",
 	"service_data.path",
+	"signing_secret",
+	"tls_client_certificate",
 	"uplink_message",
 	"uplink_message.path",
 }
@@ -90,6 +92,8 @@ var ApplicationWebhookTemplateFieldPathsTopLevel = []string{
 	"logo_url",
 	"name",
 	"service_data",
+	"signing_secret",
+	"tls_client_certificate",
 	"uplink_message",
 }
 var ApplicationWebhookTemplatesFieldPathsNested = []string{
@@ -99,8 +103,41 @@ var ApplicationWebhookTemplatesFieldPathsNested = []string{
 var ApplicationWebhookTemplatesFieldPathsTopLevel = []string{
 	"templates",
 }
+var ApplicationWebhookHealthFieldPathsNested = []string{
+	"failed_attempts",
+	"last_failed_attempt_at",
+	"last_failed_attempt_details",
+	"last_failed_attempt_details.attributes",
+	"last_failed_attempt_details.cause",
+	"last_failed_attempt_details.cause.attributes",
+	"last_failed_attempt_details.cause.correlation_id",
+	"last_failed_attempt_details.cause.message_format",
+	"last_failed_attempt_details.cause.name",
+	"last_failed_attempt_details.cause.namespace",
+	"last_failed_attempt_details.code",
+	"last_failed_attempt_details.correlation_id",
+	"last_failed_attempt_details.details",
+	"last_failed_attempt_details.message_format",
+	"last_failed_attempt_details.name",
+	"last_failed_attempt_details.namespace",
+	"last_successful_attempt_at",
+	"suspended",
+	"suspended_at",
+}
+
+var ApplicationWebhookHealthFieldPathsTopLevel = []string{
+	"failed_attempts",
+	"last_failed_attempt_at",
+	"last_failed_attempt_details",
+	"last_successful_attempt_at",
+	"suspended",
+	"suspended_at",
+}
 var ApplicationWebhookFieldPathsNested = []string{
 	"base_url",
+	"batching",
+	"batching.max_delay",
+	"batching.max_messages",
 	"created_at",
 	"downlink_ack",
 	"downlink_ack.path",
@@ -117,6 +154,26 @@ var ApplicationWebhookFieldPathsNested = []string{
 	"downlink_sent.path",
 	"format",
 	"headers",
+	"health",
+	"health.failed_attempts",
+	"health.last_failed_attempt_at",
+	"health.last_failed_attempt_details",
+	"health.last_failed_attempt_details.attributes",
+	"health.last_failed_attempt_details.cause",
+	"health.last_failed_attempt_details.cause.attributes",
+	"health.last_failed_attempt_details.cause.correlation_id",
+	"health.last_failed_attempt_details.cause.message_format",
+	"health.last_failed_attempt_details.cause.name",
+	"health.last_failed_attempt_details.cause.namespace",
+	"health.last_failed_attempt_details.code",
+	"health.last_failed_attempt_details.correlation_id",
+	"health.last_failed_attempt_details.details",
+	"health.last_failed_attempt_details.message_format",
+	"health.last_failed_attempt_details.name",
+	"health.last_failed_attempt_details.namespace",
+	"health.last_successful_attempt_at",
+	"health.suspended",
+	"health.suspended_at",
 	"ids",
 	"ids.application_ids",
 	"ids.application_ids.application_id",
@@ -127,9 +184,12 @@ var ApplicationWebhookFieldPathsNested = []string{
 	"location_solved.path",
 	"service_data",
 	"service_data.path",
+	"signing_secret",
 	"template_fields",
 	"template_ids",
 	"template_ids.template_id",
+	"tls_client_cert",
+	"tls_client_key",
 	"updated_at",
 	"uplink_message",
 	"uplink_message.path",
@@ -137,6 +197,7 @@ var ApplicationWebhookFieldPathsNested = []string{
 
 var ApplicationWebhookFieldPathsTopLevel = []string{
 	"base_url",
+	"batching",
 	"created_at",
 	"downlink_ack",
 	"downlink_api_key",
@@ -147,12 +208,16 @@ var ApplicationWebhookFieldPathsTopLevel = []string{
 	"downlink_sent",
 	"format",
 	"headers",
+	"health",
 	"ids",
 	"join_accept",
 	"location_solved",
 	"service_data",
+	"signing_secret",
 	"template_fields",
 	"template_ids",
+	"tls_client_cert",
+	"tls_client_key",
 	"updated_at",
 	"uplink_message",
 }
@@ -196,6 +261,9 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"field_mask",
 	"webhook",
 	"webhook.base_url",
+	"webhook.batching",
+	"webhook.batching.max_delay",
+	"webhook.batching.max_messages",
 	"webhook.created_at",
 	"webhook.downlink_ack",
 	"webhook.downlink_ack.path",
@@ -212,6 +280,26 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"webhook.downlink_sent.path",
 	"webhook.format",
 	"webhook.headers",
+	"webhook.health",
+	"webhook.health.failed_attempts",
+	"webhook.health.last_failed_attempt_at",
+	"webhook.health.last_failed_attempt_details",
+	"webhook.health.last_failed_attempt_details.attributes",
+	"webhook.health.last_failed_attempt_details.cause",
+	"webhook.health.last_failed_attempt_details.cause.attributes",
+	"webhook.health.last_failed_attempt_details.cause.correlation_id",
+	"webhook.health.last_failed_attempt_details.cause.message_format",
+	"webhook.health.last_failed_attempt_details.cause.name",
+	"webhook.health.last_failed_attempt_details.cause.namespace",
+	"webhook.health.last_failed_attempt_details.code",
+	"webhook.health.last_failed_attempt_details.correlation_id",
+	"webhook.health.last_failed_attempt_details.details",
+	"webhook.health.last_failed_attempt_details.message_format",
+	"webhook.health.last_failed_attempt_details.name",
+	"webhook.health.last_failed_attempt_details.namespace",
+	"webhook.health.last_successful_attempt_at",
+	"webhook.health.suspended",
+	"webhook.health.suspended_at",
 	"webhook.ids",
 	"webhook.ids.application_ids",
 	"webhook.ids.application_ids.application_id",
@@ -222,9 +310,12 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"webhook.location_solved.path",
 	"webhook.service_data",
 	"webhook.service_data.path",
+	"webhook.signing_secret",
 	"webhook.template_fields",
 	"webhook.template_ids",
 	"webhook.template_ids.template_id",
+	"webhook.tls_client_cert",
+	"webhook.tls_client_key",
 	"webhook.updated_at",
 	"webhook.uplink_message",
 	"webhook.uplink_message.path",
@@ -251,6 +342,78 @@ var ListApplicationWebhookTemplatesRequestFieldPathsNested = []string{
 var ListApplicationWebhookTemplatesRequestFieldPathsTopLevel = []string{
 	"field_mask",
 }
+var ApplicationWebhookRequestFieldPathsNested = []string{
+	"attempts",
+	"body",
+	"created_at",
+	"headers",
+	"id",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+	"last_attempt_at",
+	"last_error",
+	"last_error.attributes",
+	"last_error.cause",
+	"last_error.cause.attributes",
+	"last_error.cause.correlation_id",
+	"last_error.cause.message_format",
+	"last_error.cause.name",
+	"last_error.cause.namespace",
+	"last_error.code",
+	"last_error.correlation_id",
+	"last_error.details",
+	"last_error.message_format",
+	"last_error.name",
+	"last_error.namespace",
+	"url",
+}
+
+var ApplicationWebhookRequestFieldPathsTopLevel = []string{
+	"attempts",
+	"body",
+	"created_at",
+	"headers",
+	"id",
+	"ids",
+	"last_attempt_at",
+	"last_error",
+	"url",
+}
+var ApplicationWebhookDeadLettersFieldPathsNested = []string{
+	"requests",
+}
+
+var ApplicationWebhookDeadLettersFieldPathsTopLevel = []string{
+	"requests",
+}
+var ListApplicationWebhookDeadLettersRequestFieldPathsNested = []string{
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+	"limit",
+	"page",
+}
+
+var ListApplicationWebhookDeadLettersRequestFieldPathsTopLevel = []string{
+	"ids",
+	"limit",
+	"page",
+}
+var ReplayApplicationWebhookDeadLettersRequestFieldPathsNested = []string{
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+	"request_ids",
+}
+
+var ReplayApplicationWebhookDeadLettersRequestFieldPathsTopLevel = []string{
+	"ids",
+	"request_ids",
+}
 var ApplicationWebhookTemplate_MessageFieldPathsNested = []string{
 	"path",
 }
@@ -265,3 +428,12 @@ var ApplicationWebhook_MessageFieldPathsNested = []string{
 var ApplicationWebhook_MessageFieldPathsTopLevel = []string{
 	"path",
 }
+var ApplicationWebhook_BatchingFieldPathsNested = []string{
+	"max_delay",
+	"max_messages",
+}
+
+var ApplicationWebhook_BatchingFieldPathsTopLevel = []string{
+	"max_delay",
+	"max_messages",
+}
@@ -499,6 +499,26 @@ func (dst *ApplicationWebhookTemplate) SetFields(src *ApplicationWebhookTemplate
 					dst.ServiceData = nil
 				}
 			}
+		case "signing_secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'signing_secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SigningSecret = src.SigningSecret
+			} else {
+				var zero string
+				dst.SigningSecret = zero
+			}
+		case "tls_client_certificate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_certificate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientCertificate = src.TLSClientCertificate
+			} else {
+				var zero bool
+				dst.TLSClientCertificate = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -527,6 +547,89 @@ func (dst *ApplicationWebhookTemplates) SetFields(src *ApplicationWebhookTemplat
 	return nil
 }
 
+func (dst *ApplicationWebhookHealth) SetFields(src *ApplicationWebhookHealth, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "failed_attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'failed_attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FailedAttempts = src.FailedAttempts
+			} else {
+				var zero uint64
+				dst.FailedAttempts = zero
+			}
+		case "last_failed_attempt_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_failed_attempt_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastFailedAttemptAt = src.LastFailedAttemptAt
+			} else {
+				dst.LastFailedAttemptAt = nil
+			}
+		case "last_failed_attempt_details":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.LastFailedAttemptDetails == nil) && dst.LastFailedAttemptDetails == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LastFailedAttemptDetails
+				}
+				if dst.LastFailedAttemptDetails != nil {
+					newDst = dst.LastFailedAttemptDetails
+				} else {
+					newDst = &ErrorDetails{}
+					dst.LastFailedAttemptDetails = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LastFailedAttemptDetails = src.LastFailedAttemptDetails
+				} else {
+					dst.LastFailedAttemptDetails = nil
+				}
+			}
+		case "last_successful_attempt_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_successful_attempt_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastSuccessfulAttemptAt = src.LastSuccessfulAttemptAt
+			} else {
+				dst.LastSuccessfulAttemptAt = nil
+			}
+		case "suspended":
+			if len(subs) > 0 {
+				return fmt.Errorf("'suspended' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Suspended = src.Suspended
+			} else {
+				var zero bool
+				dst.Suspended = zero
+			}
+		case "suspended_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'suspended_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SuspendedAt = src.SuspendedAt
+			} else {
+				dst.SuspendedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -891,6 +994,84 @@ func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...strin
 					dst.ServiceData = nil
 				}
 			}
+		case "signing_secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'signing_secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SigningSecret = src.SigningSecret
+			} else {
+				var zero string
+				dst.SigningSecret = zero
+			}
+		case "tls_client_cert":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_cert' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientCert = src.TLSClientCert
+			} else {
+				dst.TLSClientCert = nil
+			}
+		case "tls_client_key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientKey = src.TLSClientKey
+			} else {
+				dst.TLSClientKey = nil
+			}
+		case "health":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookHealth
+				if (src == nil || src.Health == nil) && dst.Health == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Health
+				}
+				if dst.Health != nil {
+					newDst = dst.Health
+				} else {
+					newDst = &ApplicationWebhookHealth{}
+					dst.Health = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Health = src.Health
+				} else {
+					dst.Health = nil
+				}
+			}
+		case "batching":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhook_Batching
+				if (src == nil || src.Batching == nil) && dst.Batching == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Batching
+				}
+				if dst.Batching != nil {
+					newDst = dst.Batching
+				} else {
+					newDst = &ApplicationWebhook_Batching{}
+					dst.Batching = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Batching = src.Batching
+				} else {
+					dst.Batching = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1116,6 +1297,234 @@ func (dst *ListApplicationWebhookTemplatesRequest) SetFields(src *ListApplicatio
 	return nil
 }
 
+func (dst *ApplicationWebhookRequest) SetFields(src *ApplicationWebhookRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookIdentifiers
+				}
+				newDst = &dst.ApplicationWebhookIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookIdentifiers = src.ApplicationWebhookIdentifiers
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.ApplicationWebhookIdentifiers = zero
+				}
+			}
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ID = src.ID
+			} else {
+				var zero string
+				dst.ID = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				var zero time.Time
+				dst.CreatedAt = zero
+			}
+		case "url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.URL = src.URL
+			} else {
+				var zero string
+				dst.URL = zero
+			}
+		case "headers":
+			if len(subs) > 0 {
+				return fmt.Errorf("'headers' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Headers = src.Headers
+			} else {
+				dst.Headers = nil
+			}
+		case "body":
+			if len(subs) > 0 {
+				return fmt.Errorf("'body' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Body = src.Body
+			} else {
+				dst.Body = nil
+			}
+		case "attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Attempts = src.Attempts
+			} else {
+				var zero uint32
+				dst.Attempts = zero
+			}
+		case "last_attempt_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_attempt_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastAttemptAt = src.LastAttemptAt
+			} else {
+				dst.LastAttemptAt = nil
+			}
+		case "last_error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.LastError == nil) && dst.LastError == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LastError
+				}
+				if dst.LastError != nil {
+					newDst = dst.LastError
+				} else {
+					newDst = &ErrorDetails{}
+					dst.LastError = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LastError = src.LastError
+				} else {
+					dst.LastError = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationWebhookDeadLetters) SetFields(src *ApplicationWebhookDeadLetters, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "requests":
+			if len(subs) > 0 {
+				return fmt.Errorf("'requests' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Requests = src.Requests
+			} else {
+				dst.Requests = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListApplicationWebhookDeadLettersRequest) SetFields(src *ListApplicationWebhookDeadLettersRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookIdentifiers
+				}
+				newDst = &dst.ApplicationWebhookIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookIdentifiers = src.ApplicationWebhookIdentifiers
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.ApplicationWebhookIdentifiers = zero
+				}
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ReplayApplicationWebhookDeadLettersRequest) SetFields(src *ReplayApplicationWebhookDeadLettersRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookIdentifiers
+				}
+				newDst = &dst.ApplicationWebhookIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookIdentifiers = src.ApplicationWebhookIdentifiers
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.ApplicationWebhookIdentifiers = zero
+				}
+			}
+		case "request_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'request_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RequestIDs = src.RequestIDs
+			} else {
+				dst.RequestIDs = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationWebhookTemplate_Message) SetFields(src *ApplicationWebhookTemplate_Message, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -1157,3 +1566,34 @@ func (dst *ApplicationWebhook_Message) SetFields(src *ApplicationWebhook_Message
 	}
 	return nil
 }
+
+func (dst *ApplicationWebhook_Batching) SetFields(src *ApplicationWebhook_Batching, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "max_messages":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_messages' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxMessages = src.MaxMessages
+			} else {
+				var zero uint32
+				dst.MaxMessages = zero
+			}
+		case "max_delay":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_delay' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxDelay = src.MaxDelay
+			} else {
+				var zero time.Duration
+				dst.MaxDelay = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
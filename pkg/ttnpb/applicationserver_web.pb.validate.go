@@ -638,6 +638,17 @@ func (m *ApplicationWebhookTemplate) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "signing_secret":
+
+			if utf8.RuneCountInString(m.GetSigningSecret()) > 256 {
+				return ApplicationWebhookTemplateValidationError{
+					field:  "signing_secret",
+					reason: "value length must be at most 256 runes",
+				}
+			}
+
+		case "tls_client_certificate":
+			// no validation rules for TLSClientCertificate
 		default:
 			return ApplicationWebhookTemplateValidationError{
 				field:  name,
@@ -806,6 +817,140 @@ var _ interface {
 	ErrorName() string
 } = ApplicationWebhookTemplatesValidationError{}
 
+// ValidateFields checks the field values on ApplicationWebhookHealth with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationWebhookHealth) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookHealthFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "failed_attempts":
+			// no validation rules for FailedAttempts
+		case "last_failed_attempt_at":
+
+			if v, ok := interface{}(m.GetLastFailedAttemptAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookHealthValidationError{
+						field:  "last_failed_attempt_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_failed_attempt_details":
+
+			if v, ok := interface{}(m.GetLastFailedAttemptDetails()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookHealthValidationError{
+						field:  "last_failed_attempt_details",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_successful_attempt_at":
+
+			if v, ok := interface{}(m.GetLastSuccessfulAttemptAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookHealthValidationError{
+						field:  "last_successful_attempt_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "suspended":
+			// no validation rules for Suspended
+		case "suspended_at":
+
+			if v, ok := interface{}(m.GetSuspendedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookHealthValidationError{
+						field:  "suspended_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationWebhookHealthValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookHealthValidationError is the validation error returned by
+// ApplicationWebhookHealth.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhookHealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookHealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookHealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookHealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookHealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookHealthValidationError) ErrorName() string {
+	return "ApplicationWebhookHealthValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookHealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookHealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookHealthValidationError{}
+
 // ValidateFields checks the field values on ApplicationWebhook with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1026,6 +1171,57 @@ func (m *ApplicationWebhook) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "signing_secret":
+
+			if utf8.RuneCountInString(m.GetSigningSecret()) > 256 {
+				return ApplicationWebhookValidationError{
+					field:  "signing_secret",
+					reason: "value length must be at most 256 runes",
+				}
+			}
+
+		case "tls_client_cert":
+
+			if len(m.GetTLSClientCert()) > 8192 {
+				return ApplicationWebhookValidationError{
+					field:  "tls_client_cert",
+					reason: "value length must be at most 8192 bytes",
+				}
+			}
+
+		case "tls_client_key":
+
+			if len(m.GetTLSClientKey()) > 8192 {
+				return ApplicationWebhookValidationError{
+					field:  "tls_client_key",
+					reason: "value length must be at most 8192 bytes",
+				}
+			}
+
+		case "health":
+
+			if v, ok := interface{}(m.GetHealth()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookValidationError{
+						field:  "health",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "batching":
+
+			if v, ok := interface{}(m.GetBatching()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookValidationError{
+						field:  "batching",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return ApplicationWebhookValidationError{
 				field:  name,
@@ -1794,25 +1990,81 @@ var _ interface {
 	ErrorName() string
 } = ListApplicationWebhookTemplatesRequestValidationError{}
 
-// ValidateFields checks the field values on ApplicationWebhookTemplate_Message
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, an error is returned.
-func (m *ApplicationWebhookTemplate_Message) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on ApplicationWebhookRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationWebhookRequest) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = ApplicationWebhookTemplate_MessageFieldPathsNested
+		paths = ApplicationWebhookRequestFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "path":
-			// no validation rules for Path
+		case "ids":
+
+			if v, ok := interface{}(&m.ApplicationWebhookIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookRequestValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "id":
+			// no validation rules for ID
+		case "created_at":
+
+			if v, ok := interface{}(&m.CreatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookRequestValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "url":
+			// no validation rules for URL
+		case "headers":
+			// no validation rules for Headers
+		case "body":
+			// no validation rules for Body
+		case "attempts":
+			// no validation rules for Attempts
+		case "last_attempt_at":
+
+			if v, ok := interface{}(m.GetLastAttemptAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookRequestValidationError{
+						field:  "last_attempt_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_error":
+
+			if v, ok := interface{}(m.GetLastError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookRequestValidationError{
+						field:  "last_error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
-			return ApplicationWebhookTemplate_MessageValidationError{
+			return ApplicationWebhookRequestValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -1821,10 +2073,10 @@ func (m *ApplicationWebhookTemplate_Message) ValidateFields(paths ...string) err
 	return nil
 }
 
-// ApplicationWebhookTemplate_MessageValidationError is the validation error
-// returned by ApplicationWebhookTemplate_Message.ValidateFields if the
-// designated constraints aren't met.
-type ApplicationWebhookTemplate_MessageValidationError struct {
+// ApplicationWebhookRequestValidationError is the validation error returned by
+// ApplicationWebhookRequest.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhookRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1832,24 +2084,24 @@ type ApplicationWebhookTemplate_MessageValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApplicationWebhookTemplate_MessageValidationError) Field() string { return e.field }
+func (e ApplicationWebhookRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApplicationWebhookTemplate_MessageValidationError) Reason() string { return e.reason }
+func (e ApplicationWebhookRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApplicationWebhookTemplate_MessageValidationError) Cause() error { return e.cause }
+func (e ApplicationWebhookRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApplicationWebhookTemplate_MessageValidationError) Key() bool { return e.key }
+func (e ApplicationWebhookRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApplicationWebhookTemplate_MessageValidationError) ErrorName() string {
-	return "ApplicationWebhookTemplate_MessageValidationError"
+func (e ApplicationWebhookRequestValidationError) ErrorName() string {
+	return "ApplicationWebhookRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ApplicationWebhookTemplate_MessageValidationError) Error() string {
+func (e ApplicationWebhookRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1861,14 +2113,14 @@ func (e ApplicationWebhookTemplate_MessageValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApplicationWebhookTemplate_Message.%s: %s%s",
+		"invalid %sApplicationWebhookRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApplicationWebhookTemplate_MessageValidationError{}
+var _ error = ApplicationWebhookRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1876,27 +2128,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApplicationWebhookTemplate_MessageValidationError{}
+} = ApplicationWebhookRequestValidationError{}
 
-// ValidateFields checks the field values on ApplicationWebhook_Message with
+// ValidateFields checks the field values on ApplicationWebhookDeadLetters with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
-func (m *ApplicationWebhook_Message) ValidateFields(paths ...string) error {
+func (m *ApplicationWebhookDeadLetters) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = ApplicationWebhook_MessageFieldPathsNested
+		paths = ApplicationWebhookDeadLettersFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "path":
-			// no validation rules for Path
+		case "requests":
+
+			for idx, item := range m.GetRequests() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationWebhookDeadLettersValidationError{
+							field:  fmt.Sprintf("requests[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
 		default:
-			return ApplicationWebhook_MessageValidationError{
+			return ApplicationWebhookDeadLettersValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -1905,10 +2172,10 @@ func (m *ApplicationWebhook_Message) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// ApplicationWebhook_MessageValidationError is the validation error returned
-// by ApplicationWebhook_Message.ValidateFields if the designated constraints
-// aren't met.
-type ApplicationWebhook_MessageValidationError struct {
+// ApplicationWebhookDeadLettersValidationError is the validation error
+// returned by ApplicationWebhookDeadLetters.ValidateFields if the designated
+// constraints aren't met.
+type ApplicationWebhookDeadLettersValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1916,24 +2183,24 @@ type ApplicationWebhook_MessageValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApplicationWebhook_MessageValidationError) Field() string { return e.field }
+func (e ApplicationWebhookDeadLettersValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApplicationWebhook_MessageValidationError) Reason() string { return e.reason }
+func (e ApplicationWebhookDeadLettersValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApplicationWebhook_MessageValidationError) Cause() error { return e.cause }
+func (e ApplicationWebhookDeadLettersValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApplicationWebhook_MessageValidationError) Key() bool { return e.key }
+func (e ApplicationWebhookDeadLettersValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApplicationWebhook_MessageValidationError) ErrorName() string {
-	return "ApplicationWebhook_MessageValidationError"
+func (e ApplicationWebhookDeadLettersValidationError) ErrorName() string {
+	return "ApplicationWebhookDeadLettersValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ApplicationWebhook_MessageValidationError) Error() string {
+func (e ApplicationWebhookDeadLettersValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1945,14 +2212,14 @@ func (e ApplicationWebhook_MessageValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApplicationWebhook_Message.%s: %s%s",
+		"invalid %sApplicationWebhookDeadLetters.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApplicationWebhook_MessageValidationError{}
+var _ error = ApplicationWebhookDeadLettersValidationError{}
 
 var _ interface {
 	Field() string
@@ -1960,4 +2227,473 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApplicationWebhook_MessageValidationError{}
+} = ApplicationWebhookDeadLettersValidationError{}
+
+// ValidateFields checks the field values on
+// ListApplicationWebhookDeadLettersRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *ListApplicationWebhookDeadLettersRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListApplicationWebhookDeadLettersRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.ApplicationWebhookIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListApplicationWebhookDeadLettersRequestValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListApplicationWebhookDeadLettersRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		default:
+			return ListApplicationWebhookDeadLettersRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListApplicationWebhookDeadLettersRequestValidationError is the validation
+// error returned by ListApplicationWebhookDeadLettersRequest.ValidateFields
+// if the designated constraints aren't met.
+type ListApplicationWebhookDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) ErrorName() string {
+	return "ListApplicationWebhookDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationWebhookDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationWebhookDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationWebhookDeadLettersRequestValidationError{}
+
+// ValidateFields checks the field values on
+// ReplayApplicationWebhookDeadLettersRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *ReplayApplicationWebhookDeadLettersRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ReplayApplicationWebhookDeadLettersRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.ApplicationWebhookIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ReplayApplicationWebhookDeadLettersRequestValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "request_ids":
+
+			if len(m.GetRequestIDs()) > 1000 {
+				return ReplayApplicationWebhookDeadLettersRequestValidationError{
+					field:  "request_ids",
+					reason: "value must contain no more than 1000 item(s)",
+				}
+			}
+
+		default:
+			return ReplayApplicationWebhookDeadLettersRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ReplayApplicationWebhookDeadLettersRequestValidationError is the validation
+// error returned by ReplayApplicationWebhookDeadLettersRequest.ValidateFields
+// if the designated constraints aren't met.
+type ReplayApplicationWebhookDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayApplicationWebhookDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayApplicationWebhookDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayApplicationWebhookDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayApplicationWebhookDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayApplicationWebhookDeadLettersRequestValidationError) ErrorName() string {
+	return "ReplayApplicationWebhookDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayApplicationWebhookDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayApplicationWebhookDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayApplicationWebhookDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayApplicationWebhookDeadLettersRequestValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhookTemplate_Message
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationWebhookTemplate_Message) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookTemplate_MessageFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "path":
+			// no validation rules for Path
+		default:
+			return ApplicationWebhookTemplate_MessageValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookTemplate_MessageValidationError is the validation error
+// returned by ApplicationWebhookTemplate_Message.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationWebhookTemplate_MessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookTemplate_MessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookTemplate_MessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookTemplate_MessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookTemplate_MessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookTemplate_MessageValidationError) ErrorName() string {
+	return "ApplicationWebhookTemplate_MessageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookTemplate_MessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookTemplate_Message.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookTemplate_MessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookTemplate_MessageValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhook_Message with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ApplicationWebhook_Message) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhook_MessageFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "path":
+			// no validation rules for Path
+		default:
+			return ApplicationWebhook_MessageValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhook_MessageValidationError is the validation error returned
+// by ApplicationWebhook_Message.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhook_MessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhook_MessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhook_MessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhook_MessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhook_MessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhook_MessageValidationError) ErrorName() string {
+	return "ApplicationWebhook_MessageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhook_MessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhook_Message.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhook_MessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhook_MessageValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhook_Batching with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ApplicationWebhook_Batching) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhook_BatchingFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "max_messages":
+
+			if m.GetMaxMessages() > 1000 {
+				return ApplicationWebhook_BatchingValidationError{
+					field:  "max_messages",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "max_delay":
+
+		default:
+			return ApplicationWebhook_BatchingValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhook_BatchingValidationError is the validation error returned
+// by ApplicationWebhook_Batching.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhook_BatchingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhook_BatchingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhook_BatchingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhook_BatchingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhook_BatchingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhook_BatchingValidationError) ErrorName() string {
+	return "ApplicationWebhook_BatchingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhook_BatchingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhook_Batching.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhook_BatchingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhook_BatchingValidationError{}
@@ -63,6 +63,36 @@ func (PowerState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a656ee0551c94a80, []int{0}
 }
 
+// ADR algorithm used by the Network Server to compute the data rate, TX output power and number of transmissions of a device.
+type ADRAlgorithm int32
+
+const (
+	// Algorithm that only increases the data rate index, and relies on ADRAckReq to recover devices whose link degrades.
+	ADRAlgorithm_ADR_ALGORITHM_DEFAULT ADRAlgorithm = 0
+	// Algorithm that decreases the data rate index and increases the TX output power when the frame loss rate spikes
+	// or when the link margin is exhausted.
+	ADRAlgorithm_ADR_ALGORITHM_LOSS_AWARE ADRAlgorithm = 1
+	// Conservative algorithm for mobile devices, which uses the weakest recent uplink, increases the data rate index
+	// by at most one step and keeps the maximum TX output power.
+	ADRAlgorithm_ADR_ALGORITHM_MOBILE ADRAlgorithm = 2
+)
+
+var ADRAlgorithm_name = map[int32]string{
+	0: "ADR_ALGORITHM_DEFAULT",
+	1: "ADR_ALGORITHM_LOSS_AWARE",
+	2: "ADR_ALGORITHM_MOBILE",
+}
+
+var ADRAlgorithm_value = map[string]int32{
+	"ADR_ALGORITHM_DEFAULT":    0,
+	"ADR_ALGORITHM_LOSS_AWARE": 1,
+	"ADR_ALGORITHM_MOBILE":     2,
+}
+
+func (ADRAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{1}
+}
+
 type Session struct {
 	// Device Address, issued by the Network Server or chosen by device manufacturer in case of testing range (beginning with 00-03).
 	// Known by Network Server, Application Server and Join Server. Owned by Network Server.
@@ -622,6 +652,7 @@ type EndDeviceVersionIdentifiers struct {
 	ModelID              string   `protobuf:"bytes,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
 	HardwareVersion      string   `protobuf:"bytes,3,opt,name=hardware_version,json=hardwareVersion,proto3" json:"hardware_version,omitempty"`
 	FirmwareVersion      string   `protobuf:"bytes,4,opt,name=firmware_version,json=firmwareVersion,proto3" json:"firmware_version,omitempty"`
+	BandID               string   `protobuf:"bytes,5,opt,name=band_id,json=bandId,proto3" json:"band_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -686,6 +717,13 @@ func (m *EndDeviceVersionIdentifiers) GetFirmwareVersion() string {
 	return ""
 }
 
+func (m *EndDeviceVersionIdentifiers) GetBandID() string {
+	if m != nil {
+		return m.BandID
+	}
+	return ""
+}
+
 // Template for creating end devices.
 type EndDeviceVersion struct {
 	// Version identifiers.
@@ -919,8 +957,11 @@ type MACSettings struct {
 	// The frequency of the class B beacon (Hz) Network Server should configure device to use via MAC commands.
 	// If unset, the default value from Network Server configuration will be used.
 	DesiredBeaconFrequency *types.UInt64Value `protobuf:"bytes,29,opt,name=desired_beacon_frequency,json=desiredBeaconFrequency,proto3" json:"desired_beacon_frequency,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}           `json:"-"`
-	XXX_sizecache          int32              `json:"-"`
+	// The ADR algorithm Network Server should use for the device.
+	// If unset, the default value from Network Server configuration will be used.
+	ADRAlgorithm         *ADRAlgorithmValue `protobuf:"bytes,30,opt,name=adr_algorithm,json=adrAlgorithm,proto3" json:"adr_algorithm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *MACSettings) Reset()      { *m = MACSettings{} }
@@ -1151,6 +1192,13 @@ func (m *MACSettings) GetDesiredBeaconFrequency() *types.UInt64Value {
 	return nil
 }
 
+func (m *MACSettings) GetADRAlgorithm() *ADRAlgorithmValue {
+	if m != nil {
+		return m.ADRAlgorithm
+	}
+	return nil
+}
+
 // MACState represents the state of MAC layer of the device.
 // MACState is reset on each join for OTAA or ResetInd for ABP devices.
 // This is used internally by the Network Server.
@@ -1564,6 +1612,197 @@ func (m *MACState_DataRateRanges) GetRanges() []*MACState_DataRateRange {
 	return nil
 }
 
+type ADRAlgorithmValue struct {
+	Value                ADRAlgorithm `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.ADRAlgorithm" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ADRAlgorithmValue) Reset()      { *m = ADRAlgorithmValue{} }
+func (*ADRAlgorithmValue) ProtoMessage() {}
+func (*ADRAlgorithmValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{8}
+}
+func (m *ADRAlgorithmValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ADRAlgorithmValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ADRAlgorithmValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ADRAlgorithmValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRAlgorithmValue.Merge(m, src)
+}
+func (m *ADRAlgorithmValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ADRAlgorithmValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRAlgorithmValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ADRAlgorithmValue proto.InternalMessageInfo
+
+func (m *ADRAlgorithmValue) GetValue() ADRAlgorithm {
+	if m != nil {
+		return m.Value
+	}
+	return ADRAlgorithm_ADR_ALGORITHM_DEFAULT
+}
+
+// ADRDecision is a decision of the ADR algorithm of the Network Server, including the inputs of the algorithm.
+type ADRDecision struct {
+	// ADR algorithm that made the decision.
+	Algorithm ADRAlgorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=ttn.lorawan.v3.ADRAlgorithm" json:"algorithm,omitempty"`
+	// Number of recent uplink messages used for the decision.
+	Uplinks uint32 `protobuf:"varint,2,opt,name=uplinks,proto3" json:"uplinks,omitempty"`
+	// Highest SNR of the recent uplink messages (dB).
+	MaxSNR float32 `protobuf:"fixed32,3,opt,name=max_snr,json=maxSnr,proto3" json:"max_snr,omitempty"`
+	// Lowest of the highest SNR per recent uplink message (dB).
+	WorstSNR float32 `protobuf:"fixed32,4,opt,name=worst_snr,json=worstSnr,proto3" json:"worst_snr,omitempty"`
+	// Link margin of the highest SNR at the current data rate, after subtracting the ADR margin (dB).
+	Margin float32 `protobuf:"fixed32,5,opt,name=margin,proto3" json:"margin,omitempty"`
+	// Frame loss rate of the recent uplink messages.
+	LossRate float32 `protobuf:"fixed32,6,opt,name=loss_rate,json=lossRate,proto3" json:"loss_rate,omitempty"`
+	// Data rate index in use by the device.
+	CurrentDataRateIndex DataRateIndex `protobuf:"varint,7,opt,name=current_data_rate_index,json=currentDataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"current_data_rate_index,omitempty"`
+	// TX output power index in use by the device.
+	CurrentTxPowerIndex uint32 `protobuf:"varint,8,opt,name=current_tx_power_index,json=currentTxPowerIndex,proto3" json:"current_tx_power_index,omitempty"`
+	// Number of transmissions in use by the device.
+	CurrentNbTrans uint32 `protobuf:"varint,9,opt,name=current_nb_trans,json=currentNbTrans,proto3" json:"current_nb_trans,omitempty"`
+	// Data rate index decided by the algorithm.
+	DesiredDataRateIndex DataRateIndex `protobuf:"varint,10,opt,name=desired_data_rate_index,json=desiredDataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"desired_data_rate_index,omitempty"`
+	// TX output power index decided by the algorithm.
+	DesiredTxPowerIndex uint32 `protobuf:"varint,11,opt,name=desired_tx_power_index,json=desiredTxPowerIndex,proto3" json:"desired_tx_power_index,omitempty"`
+	// Number of transmissions decided by the algorithm.
+	DesiredNbTrans       uint32   `protobuf:"varint,12,opt,name=desired_nb_trans,json=desiredNbTrans,proto3" json:"desired_nb_trans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ADRDecision) Reset()      { *m = ADRDecision{} }
+func (*ADRDecision) ProtoMessage() {}
+func (*ADRDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{9}
+}
+func (m *ADRDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ADRDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ADRDecision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ADRDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRDecision.Merge(m, src)
+}
+func (m *ADRDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *ADRDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ADRDecision proto.InternalMessageInfo
+
+func (m *ADRDecision) GetAlgorithm() ADRAlgorithm {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ADRAlgorithm_ADR_ALGORITHM_DEFAULT
+}
+
+func (m *ADRDecision) GetUplinks() uint32 {
+	if m != nil {
+		return m.Uplinks
+	}
+	return 0
+}
+
+func (m *ADRDecision) GetMaxSNR() float32 {
+	if m != nil {
+		return m.MaxSNR
+	}
+	return 0
+}
+
+func (m *ADRDecision) GetWorstSNR() float32 {
+	if m != nil {
+		return m.WorstSNR
+	}
+	return 0
+}
+
+func (m *ADRDecision) GetMargin() float32 {
+	if m != nil {
+		return m.Margin
+	}
+	return 0
+}
+
+func (m *ADRDecision) GetLossRate() float32 {
+	if m != nil {
+		return m.LossRate
+	}
+	return 0
+}
+
+func (m *ADRDecision) GetCurrentDataRateIndex() DataRateIndex {
+	if m != nil {
+		return m.CurrentDataRateIndex
+	}
+	return DATA_RATE_0
+}
+
+func (m *ADRDecision) GetCurrentTxPowerIndex() uint32 {
+	if m != nil {
+		return m.CurrentTxPowerIndex
+	}
+	return 0
+}
+
+func (m *ADRDecision) GetCurrentNbTrans() uint32 {
+	if m != nil {
+		return m.CurrentNbTrans
+	}
+	return 0
+}
+
+func (m *ADRDecision) GetDesiredDataRateIndex() DataRateIndex {
+	if m != nil {
+		return m.DesiredDataRateIndex
+	}
+	return DATA_RATE_0
+}
+
+func (m *ADRDecision) GetDesiredTxPowerIndex() uint32 {
+	if m != nil {
+		return m.DesiredTxPowerIndex
+	}
+	return 0
+}
+
+func (m *ADRDecision) GetDesiredNbTrans() uint32 {
+	if m != nil {
+		return m.DesiredNbTrans
+	}
+	return 0
+}
+
 // Authentication code for end devices.
 type EndDeviceAuthenticationCode struct {
 	Value                string     `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
@@ -1576,7 +1815,7 @@ type EndDeviceAuthenticationCode struct {
 func (m *EndDeviceAuthenticationCode) Reset()      { *m = EndDeviceAuthenticationCode{} }
 func (*EndDeviceAuthenticationCode) ProtoMessage() {}
 func (*EndDeviceAuthenticationCode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{8}
+	return fileDescriptor_a656ee0551c94a80, []int{10}
 }
 func (m *EndDeviceAuthenticationCode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1788,7 +2027,7 @@ type EndDevice struct {
 func (m *EndDevice) Reset()      { *m = EndDevice{} }
 func (*EndDevice) ProtoMessage() {}
 func (*EndDevice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{9}
+	return fileDescriptor_a656ee0551c94a80, []int{11}
 }
 func (m *EndDevice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2176,7 +2415,7 @@ type EndDevices struct {
 func (m *EndDevices) Reset()      { *m = EndDevices{} }
 func (*EndDevices) ProtoMessage() {}
 func (*EndDevices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{10}
+	return fileDescriptor_a656ee0551c94a80, []int{12}
 }
 func (m *EndDevices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2221,7 +2460,7 @@ type CreateEndDeviceRequest struct {
 func (m *CreateEndDeviceRequest) Reset()      { *m = CreateEndDeviceRequest{} }
 func (*CreateEndDeviceRequest) ProtoMessage() {}
 func (*CreateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{11}
+	return fileDescriptor_a656ee0551c94a80, []int{13}
 }
 func (m *CreateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2262,7 +2501,7 @@ type UpdateEndDeviceRequest struct {
 func (m *UpdateEndDeviceRequest) Reset()      { *m = UpdateEndDeviceRequest{} }
 func (*UpdateEndDeviceRequest) ProtoMessage() {}
 func (*UpdateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{12}
+	return fileDescriptor_a656ee0551c94a80, []int{14}
 }
 func (m *UpdateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2310,7 +2549,7 @@ type GetEndDeviceRequest struct {
 func (m *GetEndDeviceRequest) Reset()      { *m = GetEndDeviceRequest{} }
 func (*GetEndDeviceRequest) ProtoMessage() {}
 func (*GetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{13}
+	return fileDescriptor_a656ee0551c94a80, []int{15}
 }
 func (m *GetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2356,7 +2595,7 @@ type GetEndDeviceIdentifiersForEUIsRequest struct {
 func (m *GetEndDeviceIdentifiersForEUIsRequest) Reset()      { *m = GetEndDeviceIdentifiersForEUIsRequest{} }
 func (*GetEndDeviceIdentifiersForEUIsRequest) ProtoMessage() {}
 func (*GetEndDeviceIdentifiersForEUIsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{14}
+	return fileDescriptor_a656ee0551c94a80, []int{16}
 }
 func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2404,7 +2643,7 @@ type ListEndDevicesRequest struct {
 func (m *ListEndDevicesRequest) Reset()      { *m = ListEndDevicesRequest{} }
 func (*ListEndDevicesRequest) ProtoMessage() {}
 func (*ListEndDevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{15}
+	return fileDescriptor_a656ee0551c94a80, []int{17}
 }
 func (m *ListEndDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2473,7 +2712,7 @@ type SetEndDeviceRequest struct {
 func (m *SetEndDeviceRequest) Reset()      { *m = SetEndDeviceRequest{} }
 func (*SetEndDeviceRequest) ProtoMessage() {}
 func (*SetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{16}
+	return fileDescriptor_a656ee0551c94a80, []int{18}
 }
 func (m *SetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2527,7 +2766,7 @@ type EndDeviceTemplate struct {
 func (m *EndDeviceTemplate) Reset()      { *m = EndDeviceTemplate{} }
 func (*EndDeviceTemplate) ProtoMessage() {}
 func (*EndDeviceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{17}
+	return fileDescriptor_a656ee0551c94a80, []int{19}
 }
 func (m *EndDeviceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2588,7 +2827,7 @@ type EndDeviceTemplateFormat struct {
 func (m *EndDeviceTemplateFormat) Reset()      { *m = EndDeviceTemplateFormat{} }
 func (*EndDeviceTemplateFormat) ProtoMessage() {}
 func (*EndDeviceTemplateFormat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{18}
+	return fileDescriptor_a656ee0551c94a80, []int{20}
 }
 func (m *EndDeviceTemplateFormat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2647,7 +2886,7 @@ type EndDeviceTemplateFormats struct {
 func (m *EndDeviceTemplateFormats) Reset()      { *m = EndDeviceTemplateFormats{} }
 func (*EndDeviceTemplateFormats) ProtoMessage() {}
 func (*EndDeviceTemplateFormats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{19}
+	return fileDescriptor_a656ee0551c94a80, []int{21}
 }
 func (m *EndDeviceTemplateFormats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2695,7 +2934,7 @@ type ConvertEndDeviceTemplateRequest struct {
 func (m *ConvertEndDeviceTemplateRequest) Reset()      { *m = ConvertEndDeviceTemplateRequest{} }
 func (*ConvertEndDeviceTemplateRequest) ProtoMessage() {}
 func (*ConvertEndDeviceTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{20}
+	return fileDescriptor_a656ee0551c94a80, []int{22}
 }
 func (m *ConvertEndDeviceTemplateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2738,417 +2977,646 @@ func (m *ConvertEndDeviceTemplateRequest) GetData() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
-	proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
-	golang_proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
-	proto.RegisterType((*MACParameters)(nil), "ttn.lorawan.v3.MACParameters")
-	golang_proto.RegisterType((*MACParameters)(nil), "ttn.lorawan.v3.MACParameters")
-	proto.RegisterType((*MACParameters_Channel)(nil), "ttn.lorawan.v3.MACParameters.Channel")
-	golang_proto.RegisterType((*MACParameters_Channel)(nil), "ttn.lorawan.v3.MACParameters.Channel")
-	proto.RegisterType((*EndDeviceBrand)(nil), "ttn.lorawan.v3.EndDeviceBrand")
-	golang_proto.RegisterType((*EndDeviceBrand)(nil), "ttn.lorawan.v3.EndDeviceBrand")
-	proto.RegisterType((*EndDeviceModel)(nil), "ttn.lorawan.v3.EndDeviceModel")
-	golang_proto.RegisterType((*EndDeviceModel)(nil), "ttn.lorawan.v3.EndDeviceModel")
-	proto.RegisterType((*EndDeviceVersionIdentifiers)(nil), "ttn.lorawan.v3.EndDeviceVersionIdentifiers")
-	golang_proto.RegisterType((*EndDeviceVersionIdentifiers)(nil), "ttn.lorawan.v3.EndDeviceVersionIdentifiers")
-	proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
-	golang_proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
-	proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
-	golang_proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
-	proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
-	golang_proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
-	proto.RegisterMapType((map[uint64]*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.RejectedDataRateRangesEntry")
-	golang_proto.RegisterMapType((map[uint64]*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.RejectedDataRateRangesEntry")
-	proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
-	golang_proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
-	proto.RegisterType((*MACState_DataRateRange)(nil), "ttn.lorawan.v3.MACState.DataRateRange")
-	golang_proto.RegisterType((*MACState_DataRateRange)(nil), "ttn.lorawan.v3.MACState.DataRateRange")
-	proto.RegisterType((*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.DataRateRanges")
-	golang_proto.RegisterType((*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.DataRateRanges")
-	proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
-	golang_proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
-	proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
-	golang_proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
-	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDevice.AttributesEntry")
-	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDevice.AttributesEntry")
-	proto.RegisterMapType((map[string]*Location)(nil), "ttn.lorawan.v3.EndDevice.LocationsEntry")
-	golang_proto.RegisterMapType((map[string]*Location)(nil), "ttn.lorawan.v3.EndDevice.LocationsEntry")
-	proto.RegisterType((*EndDevices)(nil), "ttn.lorawan.v3.EndDevices")
-	golang_proto.RegisterType((*EndDevices)(nil), "ttn.lorawan.v3.EndDevices")
-	proto.RegisterType((*CreateEndDeviceRequest)(nil), "ttn.lorawan.v3.CreateEndDeviceRequest")
-	golang_proto.RegisterType((*CreateEndDeviceRequest)(nil), "ttn.lorawan.v3.CreateEndDeviceRequest")
-	proto.RegisterType((*UpdateEndDeviceRequest)(nil), "ttn.lorawan.v3.UpdateEndDeviceRequest")
-	golang_proto.RegisterType((*UpdateEndDeviceRequest)(nil), "ttn.lorawan.v3.UpdateEndDeviceRequest")
-	proto.RegisterType((*GetEndDeviceRequest)(nil), "ttn.lorawan.v3.GetEndDeviceRequest")
-	golang_proto.RegisterType((*GetEndDeviceRequest)(nil), "ttn.lorawan.v3.GetEndDeviceRequest")
-	proto.RegisterType((*GetEndDeviceIdentifiersForEUIsRequest)(nil), "ttn.lorawan.v3.GetEndDeviceIdentifiersForEUIsRequest")
-	golang_proto.RegisterType((*GetEndDeviceIdentifiersForEUIsRequest)(nil), "ttn.lorawan.v3.GetEndDeviceIdentifiersForEUIsRequest")
-	proto.RegisterType((*ListEndDevicesRequest)(nil), "ttn.lorawan.v3.ListEndDevicesRequest")
-	golang_proto.RegisterType((*ListEndDevicesRequest)(nil), "ttn.lorawan.v3.ListEndDevicesRequest")
-	proto.RegisterType((*SetEndDeviceRequest)(nil), "ttn.lorawan.v3.SetEndDeviceRequest")
-	golang_proto.RegisterType((*SetEndDeviceRequest)(nil), "ttn.lorawan.v3.SetEndDeviceRequest")
-	proto.RegisterType((*EndDeviceTemplate)(nil), "ttn.lorawan.v3.EndDeviceTemplate")
-	golang_proto.RegisterType((*EndDeviceTemplate)(nil), "ttn.lorawan.v3.EndDeviceTemplate")
-	proto.RegisterType((*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormat")
-	golang_proto.RegisterType((*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormat")
-	proto.RegisterType((*EndDeviceTemplateFormats)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats")
-	golang_proto.RegisterType((*EndDeviceTemplateFormats)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats")
-	proto.RegisterMapType((map[string]*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats.FormatsEntry")
-	golang_proto.RegisterMapType((map[string]*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats.FormatsEntry")
-	proto.RegisterType((*ConvertEndDeviceTemplateRequest)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest")
-	golang_proto.RegisterType((*ConvertEndDeviceTemplateRequest)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest")
+// An archive of the end devices that are stored by a single component.
+// Archives are used to move end devices between deployments, including their MAC state and sessions.
+type EndDeviceArchive struct {
+	// The version of the archive format.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The data key that encrypts the end devices, wrapped with a KEK.
+	// If not set, the end devices are not encrypted.
+	DataKey *KeyEnvelope `protobuf:"bytes,2,opt,name=data_key,json=dataKey,proto3" json:"data_key,omitempty"`
+	// The serialized EndDevices message, encrypted with the data key if it is set.
+	EndDevices []byte `protobuf:"bytes,3,opt,name=end_devices,json=endDevices,proto3" json:"end_devices,omitempty"`
+	// The time when the archive was created.
+	CreatedAt            *time.Time `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80)
+func (m *EndDeviceArchive) Reset()      { *m = EndDeviceArchive{} }
+func (*EndDeviceArchive) ProtoMessage() {}
+func (*EndDeviceArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{23}
 }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80)
+func (m *EndDeviceArchive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-var fileDescriptor_a656ee0551c94a80 = []byte{
-	// 5155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7b, 0x4b, 0x6c, 0x1c, 0xd9,
-	0x75, 0x76, 0x57, 0xb3, 0xc9, 0xee, 0x3e, 0x24, 0xfb, 0x71, 0x49, 0x8a, 0x25, 0x4a, 0xea, 0xe6,
-	0xb4, 0x28, 0x0d, 0xa5, 0x11, 0x29, 0x91, 0x1a, 0x8d, 0x6d, 0xd9, 0xf3, 0xcb, 0x5d, 0x6c, 0x72,
-	0xd4, 0x92, 0x28, 0xd1, 0x57, 0xaf, 0x7f, 0xf4, 0x2a, 0x17, 0xbb, 0x2e, 0xa9, 0x1a, 0x76, 0x57,
-	0xb5, 0xab, 0xaa, 0x29, 0xd2, 0x33, 0x02, 0x26, 0x46, 0x02, 0x3b, 0x46, 0x12, 0x38, 0x5a, 0x19,
-	0x59, 0x04, 0xb3, 0x31, 0xe0, 0x55, 0x60, 0x04, 0x59, 0x0c, 0xb2, 0xb1, 0xb3, 0x48, 0x30, 0x9b,
-	0x00, 0xb3, 0xf0, 0xc2, 0xf0, 0x82, 0xb1, 0x5a, 0x1b, 0xaf, 0x02, 0x2f, 0x0d, 0x2e, 0x8c, 0xe0,
-	0x3e, 0xea, 0xd1, 0x2f, 0x3e, 0x46, 0x63, 0x63, 0x36, 0x64, 0xf5, 0xbd, 0xe7, 0x7c, 0xe7, 0xdc,
-	0x73, 0x5f, 0xe7, 0x51, 0x05, 0x85, 0xaa, 0x65, 0x6b, 0xcf, 0x34, 0x73, 0xc6, 0x71, 0xb5, 0xca,
-	0xc6, 0x79, 0xad, 0x6e, 0x9c, 0x27, 0xa6, 0xae, 0xea, 0x64, 0xd3, 0xa8, 0x90, 0xd9, 0xba, 0x6d,
-	0xb9, 0x16, 0x4a, 0xb9, 0xae, 0x39, 0x2b, 0xe8, 0x66, 0x37, 0x2f, 0x4e, 0x14, 0xd7, 0x0d, 0xf7,
-	0x69, 0x63, 0x75, 0xb6, 0x62, 0xd5, 0xce, 0x13, 0x73, 0xd3, 0xda, 0xae, 0xdb, 0xd6, 0xd6, 0xf6,
-	0x79, 0x46, 0x5c, 0x99, 0x59, 0x27, 0xe6, 0xcc, 0xa6, 0x56, 0x35, 0x74, 0xcd, 0x25, 0xe7, 0x3b,
-	0x1e, 0x38, 0xe4, 0xc4, 0x4c, 0x08, 0x62, 0xdd, 0x5a, 0xb7, 0x38, 0xf3, 0x6a, 0x63, 0x8d, 0xfd,
-	0x62, 0x3f, 0xd8, 0x93, 0x20, 0xcf, 0xad, 0x5b, 0xd6, 0x7a, 0x95, 0x04, 0x54, 0x7a, 0xc3, 0xd6,
-	0x5c, 0xc3, 0x32, 0x45, 0xff, 0x64, 0x7b, 0xff, 0x9a, 0x41, 0xaa, 0xba, 0x5a, 0xd3, 0x9c, 0x0d,
-	0x41, 0x71, 0xbc, 0x9d, 0xc2, 0x71, 0xed, 0x46, 0xc5, 0x15, 0xbd, 0xf9, 0xf6, 0x5e, 0xd7, 0xa8,
-	0x11, 0xc7, 0xd5, 0x6a, 0xf5, 0x5e, 0x0a, 0x3c, 0xb3, 0xb5, 0x7a, 0x9d, 0xd8, 0x8e, 0xe8, 0x3f,
-	0xd9, 0x69, 0x46, 0x43, 0x27, 0xa6, 0x6b, 0xac, 0x19, 0x01, 0xd1, 0xf1, 0x4e, 0xa2, 0x0f, 0x2c,
-	0xc3, 0xec, 0xdd, 0xbb, 0x41, 0xb6, 0x3d, 0xde, 0x7c, 0x67, 0xaf, 0x37, 0x23, 0xc2, 0x04, 0x9d,
-	0x04, 0x35, 0xe2, 0x38, 0xda, 0x3a, 0xd9, 0x03, 0xa2, 0x6e, 0x54, 0xdc, 0x86, 0x4d, 0xf6, 0x82,
-	0x70, 0x35, 0x5d, 0x73, 0x35, 0x4e, 0x51, 0xf8, 0xab, 0x18, 0xc4, 0x6f, 0x13, 0xc7, 0x31, 0x2c,
-	0x13, 0x3d, 0x80, 0x84, 0x4e, 0x36, 0x55, 0x4d, 0xd7, 0x6d, 0x39, 0x3a, 0x29, 0x4d, 0x0f, 0x29,
-	0x57, 0x3e, 0xdb, 0xc9, 0x47, 0x7e, 0xbb, 0x93, 0xff, 0xda, 0xba, 0x35, 0xeb, 0x3e, 0x25, 0xee,
-	0x53, 0xc3, 0x5c, 0x77, 0x66, 0x4d, 0xe2, 0x3e, 0xb3, 0xec, 0x8d, 0xf3, 0xad, 0xe0, 0x9b, 0x17,
-	0xcf, 0xd7, 0x37, 0xd6, 0xcf, 0xbb, 0xdb, 0x75, 0xe2, 0xcc, 0x96, 0xc8, 0x66, 0x51, 0xd7, 0x6d,
-	0x1c, 0xd7, 0xf9, 0x03, 0x2a, 0x42, 0x8c, 0x8e, 0x5d, 0xee, 0x9b, 0x94, 0xa6, 0x07, 0xe7, 0x8f,
-	0xcd, 0xb6, 0x2e, 0xc0, 0x59, 0xa1, 0xc2, 0x75, 0xb2, 0xed, 0x28, 0x99, 0x5d, 0xa5, 0xff, 0xc7,
-	0x52, 0x34, 0x23, 0x51, 0xe1, 0x9f, 0xef, 0xe4, 0x25, 0xcc, 0x58, 0xd1, 0x1b, 0x30, 0x5c, 0xd5,
-	0x1c, 0x57, 0x5d, 0x53, 0x2b, 0xa6, 0xab, 0x36, 0xea, 0x72, 0x6c, 0x52, 0x9a, 0x1e, 0xc6, 0x40,
-	0x1b, 0x97, 0x16, 0x4c, 0xf7, 0x6e, 0x1d, 0x4d, 0x43, 0x96, 0x91, 0x98, 0x82, 0x48, 0xb7, 0x9e,
-	0x99, 0x72, 0x3f, 0x23, 0x63, 0xbc, 0x37, 0x29, 0x5d, 0xc9, 0x7a, 0x66, 0xfa, 0x94, 0x5a, 0x98,
-	0x72, 0x20, 0xa0, 0x2c, 0xfa, 0x94, 0xb3, 0x30, 0xca, 0x28, 0x2b, 0x96, 0xb9, 0x16, 0x26, 0x8e,
-	0x33, 0xe2, 0x0c, 0xed, 0x5b, 0xb0, 0xcc, 0x35, 0x9f, 0x7e, 0x01, 0xc0, 0x71, 0x35, 0xdb, 0x25,
-	0xba, 0xaa, 0xb9, 0x72, 0x82, 0x8d, 0x77, 0x62, 0x96, 0xaf, 0xb6, 0x59, 0x6f, 0xb5, 0xcd, 0xde,
-	0xf1, 0x96, 0xa3, 0x92, 0xa0, 0xc3, 0xfc, 0xc9, 0xff, 0xe4, 0x25, 0x9c, 0x14, 0x7c, 0x45, 0x17,
-	0x11, 0x38, 0xfe, 0xbd, 0x06, 0x69, 0x50, 0x8c, 0x7a, 0xbd, 0x6a, 0x54, 0xd8, 0xd6, 0x60, 0x72,
-	0xab, 0x86, 0xb9, 0xe1, 0xc8, 0xc9, 0xc9, 0xbe, 0xe9, 0xc1, 0xf9, 0x93, 0xed, 0x66, 0x2c, 0x06,
-	0xc4, 0x25, 0x41, 0x8b, 0x27, 0x38, 0x50, 0x97, 0x2e, 0xe7, 0x5a, 0x2c, 0x21, 0x65, 0xa2, 0x85,
-	0x7f, 0xc9, 0xc0, 0xf0, 0x72, 0x71, 0x61, 0x45, 0xb3, 0xb5, 0x1a, 0x71, 0x89, 0xed, 0xa0, 0xd3,
-	0x90, 0xa8, 0x69, 0x5b, 0x2a, 0x31, 0xec, 0xba, 0x2c, 0x4d, 0x4a, 0xd3, 0x51, 0x65, 0xb0, 0xb9,
-	0x93, 0x8f, 0x2f, 0x6b, 0x5b, 0x8b, 0x65, 0xbc, 0x82, 0xe3, 0x35, 0x6d, 0x6b, 0xd1, 0xb0, 0xeb,
-	0xe8, 0x03, 0x18, 0xd1, 0x74, 0x5b, 0xa5, 0xeb, 0x49, 0xb5, 0x35, 0x97, 0xa8, 0x86, 0xa9, 0x93,
-	0x2d, 0x36, 0x31, 0xa9, 0xf9, 0x13, 0xed, 0xda, 0x95, 0x34, 0x57, 0xc3, 0x9a, 0x4b, 0xca, 0x94,
-	0x48, 0x39, 0xbe, 0xab, 0xf4, 0xff, 0x80, 0x4e, 0x73, 0x73, 0x27, 0x9f, 0x29, 0x96, 0x70, 0x4b,
-	0x2f, 0xce, 0x68, 0xba, 0xdd, 0xd2, 0x82, 0xde, 0x03, 0x44, 0x65, 0xb9, 0x5b, 0x6a, 0xdd, 0x7a,
-	0x46, 0x6c, 0x21, 0x8a, 0x4d, 0xae, 0x32, 0xb1, 0xab, 0xc4, 0xce, 0x46, 0xe5, 0x74, 0x73, 0x27,
-	0x9f, 0x2e, 0x96, 0xf0, 0x9d, 0xad, 0x15, 0x4a, 0xc2, 0x91, 0xd2, 0x9a, 0x6e, 0x87, 0x1b, 0xd0,
-	0xd7, 0x60, 0x88, 0x02, 0x99, 0xab, 0xaa, 0x6b, 0x6b, 0xa6, 0xc3, 0x67, 0x5d, 0x19, 0x0b, 0x20,
-	0xa0, 0x58, 0xc2, 0x37, 0x57, 0xef, 0xd0, 0x4e, 0x0c, 0x9a, 0x6e, 0x8b, 0x67, 0x74, 0x09, 0x86,
-	0x29, 0xa3, 0x56, 0xd9, 0x50, 0xab, 0x46, 0xcd, 0x70, 0xf9, 0x12, 0x50, 0xb2, 0xcd, 0x9d, 0xfc,
-	0x60, 0xb1, 0x84, 0x8b, 0x95, 0x8d, 0x1b, 0xac, 0x59, 0xc2, 0x83, 0x9a, 0x6e, 0x7b, 0x3f, 0xc3,
-	0x6c, 0x3a, 0xa9, 0x6a, 0xdb, 0x6c, 0x4d, 0xb4, 0xb0, 0x95, 0x58, 0xb3, 0xcf, 0xc6, 0x7e, 0xa2,
-	0xff, 0x07, 0x49, 0x7b, 0x6b, 0x4e, 0xb0, 0x24, 0x99, 0x45, 0xc7, 0xdb, 0x2d, 0x8a, 0xb7, 0x18,
-	0xad, 0x92, 0xf0, 0x6c, 0x89, 0x13, 0xf6, 0xd6, 0x1c, 0xe7, 0xff, 0x3a, 0x8c, 0x32, 0x7e, 0x7f,
-	0x6e, 0xac, 0xb5, 0x35, 0x87, 0xb8, 0x32, 0x30, 0xe9, 0x71, 0x3e, 0xdc, 0x38, 0xce, 0x52, 0x06,
-	0x61, 0xe8, 0x5b, 0x8c, 0x02, 0xdd, 0x83, 0x11, 0x7b, 0x6b, 0xbe, 0x63, 0x56, 0x07, 0x0f, 0x32,
-	0xab, 0x81, 0x26, 0x19, 0x7b, 0x6b, 0xbe, 0x75, 0x06, 0x67, 0x61, 0x98, 0xe2, 0xae, 0xd9, 0xe4,
-	0x7b, 0x0d, 0x62, 0x56, 0xb6, 0xe5, 0xa1, 0x49, 0x69, 0x3a, 0xa6, 0x24, 0x77, 0x95, 0x81, 0xf9,
-	0xd8, 0xf4, 0x27, 0x7f, 0x3f, 0x80, 0x87, 0xec, 0xad, 0xf9, 0x25, 0xaf, 0x1b, 0xdd, 0x86, 0x14,
-	0x5d, 0x85, 0x7a, 0xc3, 0xdd, 0x56, 0x2b, 0xdb, 0x95, 0x2a, 0x91, 0x87, 0x99, 0x0a, 0x9d, 0xcb,
-	0x7e, 0x7d, 0xdd, 0x26, 0xeb, 0x9a, 0x4b, 0xf4, 0x52, 0xc3, 0xdd, 0x5e, 0xa0, 0xa4, 0x21, 0x45,
-	0x86, 0x6a, 0xda, 0x96, 0xdf, 0x8e, 0x74, 0x18, 0xb7, 0x09, 0x3d, 0xa4, 0x55, 0x7a, 0x23, 0xa8,
-	0x75, 0x62, 0x1b, 0x96, 0x6e, 0x54, 0x0c, 0x77, 0x5b, 0x4e, 0x31, 0xf4, 0x42, 0x87, 0x91, 0x19,
-	0x39, 0xdd, 0xb0, 0x8b, 0x5b, 0x75, 0xcb, 0x24, 0xa6, 0x1b, 0x02, 0x1f, 0xb3, 0xfd, 0xde, 0x95,
-	0x00, 0x0a, 0xad, 0x83, 0x2c, 0xa4, 0x54, 0xac, 0x86, 0xe9, 0xb6, 0x88, 0x49, 0x77, 0x1f, 0x04,
-	0x17, 0xb3, 0x40, 0xc9, 0xbb, 0xc8, 0x39, 0x62, 0x07, 0xdd, 0x61, 0x41, 0xdf, 0x84, 0x91, 0xba,
-	0x61, 0xae, 0xab, 0x4e, 0xd5, 0x72, 0x43, 0x96, 0xcd, 0x30, 0xcb, 0x0e, 0xee, 0x2a, 0x89, 0xf9,
-	0x01, 0x39, 0xc2, 0x6c, 0x9b, 0xa5, 0x74, 0xb7, 0xab, 0x96, 0x1b, 0x18, 0xf8, 0x21, 0x1c, 0x0d,
-	0x98, 0xdb, 0xa7, 0x3b, 0x7b, 0x90, 0xe9, 0x8e, 0xca, 0x12, 0x1e, 0xf3, 0x80, 0x5b, 0x67, 0xfb,
-	0x1d, 0xc8, 0xac, 0x12, 0xad, 0x62, 0x99, 0x21, 0xb5, 0x50, 0xa7, 0x5a, 0x69, 0x4e, 0x14, 0x28,
-	0x75, 0x1d, 0x12, 0x95, 0xa7, 0x9a, 0x69, 0x92, 0xaa, 0x23, 0x8f, 0xb0, 0x63, 0xee, 0x54, 0xbb,
-	0x0e, 0x2d, 0x87, 0xd5, 0xec, 0x02, 0xa7, 0x66, 0xc6, 0x7a, 0x21, 0x45, 0x13, 0x12, 0xf6, 0x01,
-	0xd0, 0x12, 0x64, 0x1b, 0x75, 0x7a, 0xd6, 0xa9, 0xfa, 0x33, 0x52, 0xad, 0xb2, 0x39, 0x97, 0x47,
-	0x7b, 0x9c, 0xc9, 0x8a, 0x65, 0x55, 0xef, 0x69, 0xd5, 0x06, 0xc1, 0x69, 0xce, 0x54, 0xa2, 0x3c,
-	0x74, 0x6a, 0xd1, 0x35, 0x18, 0xf1, 0x0e, 0xdf, 0x30, 0xd2, 0xd8, 0xbe, 0x48, 0x59, 0x8f, 0x2d,
-	0xc0, 0xda, 0x84, 0x23, 0x2d, 0xc7, 0x88, 0x4a, 0xc4, 0x74, 0xcb, 0x47, 0x18, 0xdc, 0x74, 0xc7,
-	0xf2, 0x0e, 0xce, 0x16, 0x6f, 0x65, 0x30, 0x70, 0x65, 0xbc, 0xb9, 0x93, 0x1f, 0xe9, 0xd2, 0x8b,
-	0x47, 0x42, 0xe7, 0x8f, 0xd7, 0x18, 0x96, 0xcb, 0x0e, 0x95, 0x40, 0xee, 0xf8, 0x5e, 0x72, 0xd9,
-	0x69, 0xd2, 0x53, 0x6e, 0x4b, 0xaf, 0x27, 0xb7, 0xa5, 0x11, 0xad, 0x43, 0xbe, 0xe7, 0x2a, 0x53,
-	0x37, 0x29, 0xa0, 0x2c, 0x33, 0x05, 0x0a, 0x7b, 0xae, 0x35, 0x6e, 0xcf, 0x89, 0xae, 0x8b, 0x8d,
-	0xf5, 0x4d, 0xfc, 0x3a, 0x0a, 0x71, 0xb1, 0x18, 0xd0, 0xdb, 0x90, 0x11, 0x13, 0x1f, 0xac, 0x3e,
-	0xa9, 0xfd, 0xb8, 0x11, 0xd3, 0x1c, 0xac, 0xbd, 0xaf, 0x03, 0xf2, 0xa7, 0x39, 0xe0, 0x8b, 0xb6,
-	0xf3, 0xf9, 0x93, 0x1a, 0x70, 0xde, 0x83, 0x91, 0x9a, 0x61, 0x76, 0x6c, 0xa2, 0xbe, 0x43, 0x9e,
-	0x99, 0x35, 0xc3, 0x6c, 0xdd, 0x45, 0x14, 0x97, 0x9e, 0x81, 0x5f, 0xe4, 0x86, 0x0d, 0xe3, 0x6a,
-	0x5b, 0xad, 0xb8, 0x27, 0x61, 0x98, 0x98, 0xda, 0x6a, 0x95, 0xa8, 0xdc, 0x06, 0xec, 0x22, 0x4d,
-	0xe0, 0x21, 0xde, 0x78, 0x97, 0xb5, 0x5d, 0x8e, 0x7d, 0xfa, 0x49, 0x3e, 0xc2, 0xff, 0x5e, 0x8b,
-	0x25, 0xa2, 0x99, 0xbe, 0x6b, 0xb1, 0x44, 0x5f, 0x26, 0x56, 0xa8, 0x41, 0x6a, 0xd1, 0xd4, 0x4b,
-	0x2c, 0xa2, 0x50, 0x6c, 0xcd, 0xd4, 0xd1, 0x11, 0x88, 0x1a, 0x3a, 0x33, 0x70, 0x52, 0x19, 0x68,
-	0xee, 0xe4, 0xa3, 0xe5, 0x12, 0x8e, 0x1a, 0x3a, 0x42, 0x10, 0x33, 0xb5, 0x1a, 0x61, 0x26, 0x4c,
-	0x62, 0xf6, 0x8c, 0x8e, 0x42, 0x5f, 0xc3, 0xae, 0x32, 0xd3, 0x24, 0x95, 0x78, 0x73, 0x27, 0xdf,
-	0x77, 0x17, 0xdf, 0xc0, 0xb4, 0x0d, 0x8d, 0x42, 0x7f, 0xd5, 0x5a, 0xb7, 0x1c, 0x39, 0x36, 0xd9,
-	0x37, 0x9d, 0xc4, 0xfc, 0x47, 0xe1, 0x5f, 0xa5, 0x90, 0xbc, 0x65, 0x4b, 0x27, 0x55, 0xb4, 0x0c,
-	0x89, 0x55, 0x2a, 0x58, 0xf5, 0xa5, 0xce, 0xef, 0x2a, 0x53, 0x76, 0x41, 0x9e, 0x9a, 0xcf, 0x3d,
-	0x79, 0xa8, 0xcd, 0x7c, 0xff, 0xc2, 0xcc, 0x37, 0x1e, 0x4f, 0x5f, 0xb9, 0xfc, 0x70, 0xe6, 0xf1,
-	0x15, 0xef, 0xe7, 0x99, 0x0f, 0xe7, 0xcf, 0x3d, 0x9f, 0xa2, 0x7e, 0x0c, 0xd3, 0xb9, 0x5c, 0xc2,
-	0x71, 0x86, 0x51, 0xd6, 0xd1, 0xbb, 0x4c, 0x7d, 0xa6, 0xa4, 0x32, 0x73, 0x70, 0xa0, 0xf6, 0x51,
-	0xf6, 0x05, 0xa3, 0x2c, 0xfc, 0x63, 0x14, 0x8e, 0xf9, 0x4a, 0xdf, 0x23, 0x36, 0x75, 0x6f, 0xcb,
-	0x41, 0x00, 0xf1, 0x65, 0x8f, 0x60, 0x19, 0x12, 0x35, 0x6a, 0x19, 0xd5, 0x1f, 0xc7, 0x61, 0xe0,
-	0x98, 0x51, 0x29, 0x1c, 0xc3, 0x28, 0xeb, 0xe8, 0x0c, 0x64, 0x9e, 0x6a, 0xb6, 0xfe, 0x4c, 0xb3,
-	0x89, 0xba, 0xc9, 0x95, 0x17, 0xa3, 0x4b, 0x7b, 0xed, 0x62, 0x4c, 0x94, 0x74, 0xcd, 0xb0, 0x6b,
-	0x2d, 0xa4, 0x31, 0x4e, 0xea, 0xb5, 0x0b, 0xd2, 0xc2, 0xaf, 0x07, 0x20, 0xd3, 0x6e, 0x13, 0x74,
-	0x0b, 0xfa, 0x0c, 0xdd, 0x61, 0x36, 0x18, 0x9c, 0x7f, 0xab, 0x7d, 0x45, 0xef, 0x61, 0xc2, 0x2e,
-	0x81, 0x02, 0x45, 0x42, 0x2a, 0xa4, 0x05, 0x80, 0xaf, 0x4f, 0x94, 0x6d, 0x97, 0x89, 0x2e, 0xf7,
-	0x88, 0x80, 0xa5, 0x1e, 0xa4, 0xef, 0x8d, 0xa6, 0x6e, 0x58, 0x58, 0xbb, 0x5f, 0xbc, 0x29, 0xfa,
-	0x70, 0x4a, 0xb0, 0x78, 0x1a, 0x1b, 0x30, 0xe2, 0x09, 0xa8, 0x3f, 0xdd, 0x6e, 0xb1, 0x4f, 0x17,
-	0x21, 0x2b, 0x57, 0xdf, 0xf7, 0x84, 0x9c, 0x08, 0x09, 0xc9, 0x0a, 0x21, 0x41, 0x37, 0xce, 0x0a,
-	0xae, 0x95, 0xa7, 0xdb, 0x9e, 0xa8, 0x25, 0xc8, 0xfa, 0xe7, 0x90, 0x5a, 0xaf, 0x6a, 0x26, 0x9d,
-	0x5f, 0x66, 0x5d, 0xe6, 0xf3, 0xda, 0x51, 0xf9, 0xdb, 0xd4, 0xe7, 0xf5, 0xcf, 0xa1, 0x95, 0xaa,
-	0x66, 0x96, 0x4b, 0x38, 0xbd, 0xd6, 0xd2, 0x40, 0xf7, 0xe7, 0x40, 0xfd, 0xa9, 0xe5, 0x5a, 0x8e,
-	0xdc, 0xcf, 0x76, 0x96, 0xf8, 0x85, 0xa6, 0x21, 0xe3, 0x34, 0xea, 0x75, 0xcb, 0x76, 0x1d, 0xb5,
-	0x52, 0xd5, 0x1c, 0x47, 0x5d, 0x65, 0xfe, 0x70, 0x02, 0xa7, 0xbc, 0xf6, 0x05, 0xda, 0xac, 0x74,
-	0xa1, 0xac, 0x30, 0xff, 0xb7, 0x9d, 0x72, 0x01, 0x11, 0x18, 0xd5, 0xc9, 0x9a, 0xd6, 0xa8, 0xba,
-	0x6a, 0x4d, 0xab, 0xa8, 0x0e, 0x71, 0x5d, 0x1a, 0x36, 0x8a, 0x50, 0xe8, 0x58, 0x97, 0x49, 0xb8,
-	0x2d, 0x48, 0x94, 0x23, 0xcd, 0x9d, 0x3c, 0x2a, 0x71, 0xe6, 0x50, 0x3b, 0x46, 0x02, 0x70, 0x59,
-	0xab, 0x78, 0x6d, 0xf4, 0x04, 0xa3, 0x27, 0x6e, 0x70, 0x4c, 0x53, 0x1f, 0x39, 0x86, 0x87, 0x6a,
-	0x46, 0xc8, 0x99, 0xa0, 0x44, 0xda, 0x56, 0x88, 0x08, 0x04, 0x91, 0xb6, 0xd5, 0x42, 0xe4, 0x0f,
-	0x8d, 0x3a, 0x59, 0xcc, 0xd3, 0x4d, 0xe0, 0x21, 0xaf, 0xf1, 0x9a, 0x65, 0x98, 0xe8, 0x1c, 0x20,
-	0x9b, 0x38, 0x44, 0x90, 0xa8, 0xa6, 0x65, 0x56, 0x88, 0xc3, 0x3c, 0xd8, 0x04, 0xce, 0xf0, 0x1e,
-	0x4a, 0x77, 0x93, 0xb5, 0x23, 0x02, 0x9e, 0xca, 0xea, 0x9a, 0x65, 0xd7, 0x34, 0x97, 0x7a, 0x2a,
-	0xcc, 0x7d, 0xed, 0x72, 0xcf, 0x2e, 0xf3, 0xa8, 0x7e, 0x45, 0xdb, 0xae, 0x5a, 0x9a, 0xbe, 0xe4,
-	0xd3, 0x2b, 0x43, 0xe1, 0x05, 0x8e, 0xb3, 0x02, 0x31, 0x20, 0xe0, 0x47, 0x73, 0xe1, 0x3f, 0x46,
-	0x60, 0x30, 0x64, 0x2d, 0xf4, 0x1e, 0xa4, 0xc5, 0x5c, 0x32, 0x2f, 0xc5, 0x6a, 0xb8, 0x62, 0x77,
-	0x1d, 0xed, 0x70, 0x54, 0x4a, 0x22, 0xeb, 0xa2, 0xc4, 0x7e, 0x4a, 0x23, 0xd0, 0x61, 0xc6, 0xa7,
-	0xdc, 0xe1, 0x5c, 0xe8, 0x3e, 0x8c, 0x05, 0x37, 0x77, 0xd8, 0x85, 0x8d, 0x32, 0xb8, 0x0e, 0x17,
-	0x76, 0x45, 0xdc, 0xcd, 0xdc, 0x41, 0xe5, 0x17, 0xf6, 0x48, 0xbd, 0xa5, 0x91, 0x7b, 0xad, 0x8f,
-	0xf6, 0x72, 0x3c, 0xfb, 0x0e, 0xec, 0x0c, 0xf4, 0xf0, 0x3c, 0xef, 0x77, 0xf7, 0x89, 0x63, 0x0c,
-	0xf7, 0x78, 0x87, 0x0d, 0xee, 0x96, 0x4d, 0xf7, 0x9d, 0xb7, 0xb9, 0x67, 0x13, 0xbe, 0xe4, 0x3b,
-	0xfd, 0x65, 0xdc, 0xc5, 0xa5, 0x3d, 0x7a, 0x38, 0xd4, 0x0e, 0x77, 0xd7, 0x9f, 0xac, 0x8a, 0x3f,
-	0x59, 0xfd, 0x87, 0x99, 0xac, 0x05, 0x6f, 0xb2, 0xbe, 0x11, 0x8e, 0x17, 0x07, 0x84, 0x56, 0xdd,
-	0xe3, 0x45, 0x6e, 0xbd, 0x20, 0x54, 0xbc, 0xd7, 0x23, 0x54, 0x8c, 0xef, 0x31, 0xb6, 0x8b, 0xf3,
-	0x7c, 0x6c, 0x7b, 0x05, 0x92, 0xdf, 0xe9, 0x1e, 0x48, 0x26, 0x0e, 0x3c, 0xc1, 0x9d, 0x31, 0xe4,
-	0x8d, 0xf6, 0x18, 0x32, 0x79, 0x38, 0xfb, 0xb7, 0x46, 0x98, 0xdf, 0x82, 0x89, 0x35, 0xad, 0xe2,
-	0x5a, 0xf6, 0xb6, 0x5a, 0x67, 0x7b, 0xd8, 0x07, 0x36, 0x88, 0x23, 0xc3, 0x64, 0xdf, 0x74, 0x0c,
-	0xcb, 0x82, 0x62, 0x85, 0x11, 0x2c, 0x05, 0xfd, 0xe8, 0x66, 0x47, 0x7c, 0x3a, 0xd8, 0xc3, 0x91,
-	0xee, 0x8c, 0x4f, 0xf9, 0xf8, 0x5a, 0x43, 0xd3, 0x0a, 0x8c, 0xf9, 0xe7, 0xd0, 0xc5, 0x79, 0x75,
-	0xd5, 0x10, 0xb9, 0x2e, 0x76, 0xca, 0xec, 0x19, 0x66, 0x28, 0x63, 0xf4, 0x46, 0xb9, 0x2d, 0x98,
-	0x2f, 0xce, 0x2b, 0x06, 0xcb, 0x88, 0xe1, 0xac, 0xd3, 0xde, 0x84, 0xae, 0x40, 0xbc, 0xe1, 0x10,
-	0x55, 0xd3, 0x6d, 0x71, 0x1c, 0xed, 0x05, 0x0b, 0xcd, 0x9d, 0xfc, 0xc0, 0x5d, 0x87, 0x14, 0x4b,
-	0x18, 0x0f, 0x34, 0x1c, 0x52, 0xd4, 0x6d, 0x54, 0x06, 0xa0, 0x61, 0x44, 0x4d, 0xb3, 0xd7, 0x0d,
-	0x93, 0xc5, 0xcc, 0xf4, 0x50, 0x6f, 0xc7, 0x58, 0xaa, 0x5a, 0x9a, 0x88, 0x16, 0x86, 0x9b, 0x3b,
-	0xf9, 0x64, 0xb1, 0x84, 0x97, 0x19, 0x07, 0x4e, 0x6a, 0xba, 0xcd, 0x1f, 0xd1, 0xb7, 0x60, 0x48,
-	0x9c, 0xa9, 0x7c, 0x9c, 0xe9, 0x7d, 0xc3, 0x29, 0xe0, 0xf4, 0x6c, 0x24, 0xf7, 0x61, 0xdc, 0x71,
-	0x35, 0xb7, 0xe1, 0x74, 0x46, 0xf2, 0x99, 0x83, 0xed, 0xa0, 0x31, 0xce, 0xdf, 0x1e, 0xbc, 0xdf,
-	0x03, 0x59, 0x00, 0x77, 0x06, 0xef, 0xd9, 0xfd, 0xb7, 0x04, 0x3e, 0xc2, 0xb9, 0x3b, 0x62, 0xf5,
-	0xab, 0x90, 0xd5, 0x89, 0x63, 0xd8, 0x44, 0x57, 0x83, 0x9d, 0x8a, 0x0e, 0xb0, 0x53, 0xd3, 0x82,
-	0x0d, 0x7b, 0x1b, 0xf6, 0x11, 0x1c, 0x6f, 0x41, 0x6a, 0xdf, 0xb8, 0x23, 0x07, 0xd0, 0x52, 0x0e,
-	0x81, 0xb6, 0x6e, 0xdb, 0xef, 0xc2, 0xb1, 0x00, 0xbd, 0x73, 0xfb, 0x8e, 0x1e, 0x78, 0xfb, 0x8e,
-	0xfb, 0x22, 0xda, 0x76, 0xf1, 0x43, 0x18, 0x0b, 0x4b, 0x08, 0x76, 0xf3, 0xd8, 0xe1, 0x76, 0xf3,
-	0x48, 0x20, 0x20, 0xd8, 0xd4, 0x8f, 0xe1, 0x88, 0x07, 0xde, 0xb6, 0x3d, 0x8f, 0x1c, 0x72, 0x7b,
-	0x7a, 0xf0, 0xcb, 0xe1, 0x5d, 0xfa, 0x77, 0x12, 0xe4, 0x3c, 0xfc, 0x1e, 0x71, 0xfc, 0xf8, 0x21,
-	0xe3, 0xf8, 0x5c, 0x73, 0x27, 0x3f, 0x51, 0xe2, 0x98, 0xdd, 0xc2, 0xf9, 0x09, 0x21, 0xaf, 0xd8,
-	0x25, 0xaa, 0xef, 0xa6, 0x4e, 0x5b, 0x78, 0x2f, 0x1f, 0x32, 0xbc, 0xef, 0x54, 0xa7, 0x35, 0xca,
-	0x6f, 0x55, 0xa7, 0x35, 0xd8, 0xdf, 0x80, 0x37, 0x3c, 0x6d, 0x7a, 0xdf, 0xf0, 0xc7, 0x0e, 0xbc,
-	0x82, 0xbc, 0x65, 0xbe, 0xd2, 0xf5, 0xa2, 0x5f, 0x0b, 0x16, 0x6a, 0xb7, 0x0b, 0xff, 0xf8, 0xe1,
-	0x16, 0x93, 0xdc, 0x26, 0x2b, 0x58, 0x51, 0x1a, 0x78, 0x7d, 0x6a, 0xc7, 0xfd, 0x7f, 0xe2, 0x70,
-	0x42, 0xbc, 0xa5, 0xa9, 0xb4, 0xba, 0x01, 0x85, 0x7f, 0x1f, 0x81, 0x04, 0xf5, 0xe1, 0x5c, 0xcd,
-	0x25, 0xe8, 0x01, 0xa0, 0x4a, 0xc3, 0xb6, 0x09, 0x3d, 0x7b, 0xfc, 0x3c, 0x97, 0xf0, 0xe1, 0x4e,
-	0xec, 0x99, 0x0c, 0x6b, 0x77, 0x19, 0x05, 0x4c, 0x28, 0xb5, 0xff, 0x80, 0x7a, 0xa6, 0xc2, 0x66,
-	0x01, 0x76, 0xf4, 0x0b, 0x60, 0x7b, 0xe6, 0x0a, 0xb0, 0x15, 0x18, 0xe2, 0x65, 0x46, 0x1e, 0x21,
-	0x88, 0x88, 0x68, 0xac, 0x1d, 0x95, 0x47, 0x14, 0x41, 0x76, 0x62, 0x90, 0x33, 0xb1, 0xe6, 0x6e,
-	0xd1, 0x5b, 0xec, 0x4b, 0x8d, 0xde, 0x1e, 0xc3, 0x84, 0x5f, 0xcf, 0x31, 0xec, 0x1a, 0xd1, 0xfd,
-	0xb2, 0x8a, 0xaa, 0x79, 0xbe, 0xd7, 0x5e, 0xf5, 0x9a, 0x18, 0xab, 0xd5, 0x8c, 0x7b, 0x75, 0x1f,
-	0x06, 0xe1, 0x55, 0x54, 0x8a, 0x2e, 0xba, 0x04, 0x32, 0x83, 0xd7, 0xc9, 0xa6, 0x2a, 0x6e, 0x11,
-	0xbf, 0x60, 0xc5, 0xeb, 0x4b, 0x23, 0xb4, 0xbf, 0x44, 0x36, 0x6f, 0xb3, 0x5e, 0x51, 0xb9, 0xea,
-	0xe9, 0x6a, 0xc7, 0x5f, 0xd3, 0xd5, 0x26, 0x70, 0xbc, 0x4e, 0x4c, 0x9d, 0x62, 0x77, 0x2b, 0x25,
-	0x09, 0x67, 0xec, 0x60, 0x95, 0x24, 0x01, 0xd4, 0xa5, 0x0f, 0x2d, 0x42, 0x46, 0x14, 0xac, 0x6c,
-	0xe2, 0xd4, 0x2d, 0xd3, 0x21, 0x5e, 0x91, 0xaa, 0xdb, 0xbc, 0x2d, 0x58, 0xb5, 0x9a, 0x66, 0xea,
-	0x38, 0xcd, 0x79, 0xb0, 0xc7, 0x42, 0x61, 0x3c, 0x6d, 0xd9, 0xd6, 0x70, 0x5c, 0xee, 0x86, 0xed,
-	0x03, 0x23, 0x78, 0xb0, 0x60, 0x41, 0xdf, 0x01, 0x24, 0xb4, 0x61, 0xc1, 0x9a, 0x56, 0xa9, 0x90,
-	0xba, 0x2b, 0xbc, 0xb3, 0x93, 0xdd, 0x02, 0x50, 0xba, 0xed, 0x66, 0x69, 0xfc, 0x56, 0x64, 0xa4,
-	0x58, 0x0c, 0x26, 0x68, 0x41, 0xcb, 0x30, 0xea, 0x69, 0xc6, 0x30, 0x85, 0x7a, 0xc2, 0x37, 0xeb,
-	0x88, 0x6a, 0x29, 0xa7, 0x50, 0x07, 0x23, 0xc1, 0x18, 0x6a, 0x43, 0x17, 0xa8, 0xcb, 0xad, 0x3e,
-	0x33, 0x4c, 0xdd, 0x7a, 0xe6, 0xa8, 0xda, 0xa6, 0x66, 0x54, 0xb5, 0x55, 0x51, 0xe1, 0x48, 0x60,
-	0x64, 0x6f, 0xdd, 0xe7, 0x5d, 0x45, 0xaf, 0x07, 0x95, 0x20, 0x65, 0x93, 0x0a, 0x61, 0x2b, 0x89,
-	0x17, 0x01, 0x53, 0xcc, 0x30, 0x1d, 0x9b, 0x96, 0x27, 0xef, 0x44, 0x50, 0x89, 0x87, 0x39, 0x13,
-	0x6f, 0x74, 0xd0, 0x35, 0xc8, 0x08, 0x94, 0xa0, 0x98, 0x98, 0x66, 0x38, 0xf9, 0x8e, 0xe3, 0x58,
-	0x10, 0x78, 0x48, 0x69, 0xce, 0xe8, 0x57, 0x0f, 0x51, 0x15, 0x0a, 0xbc, 0xda, 0xca, 0xeb, 0xc1,
-	0xaa, 0x61, 0x1a, 0xae, 0x41, 0xaf, 0xd1, 0x96, 0x1d, 0x95, 0x39, 0xe0, 0x8e, 0xca, 0xb1, 0x02,
-	0x2d, 0x87, 0x2a, 0x7b, 0x48, 0xa1, 0x8d, 0xf5, 0x13, 0x09, 0x72, 0x36, 0xf9, 0x80, 0x54, 0x5c,
-	0x71, 0xd3, 0xb5, 0xdd, 0x2a, 0xc4, 0x91, 0xb3, 0x93, 0x7d, 0xfb, 0x67, 0x45, 0x67, 0x76, 0x95,
-	0xa1, 0x17, 0x52, 0x32, 0x93, 0x2e, 0xf8, 0x47, 0xc6, 0x04, 0x16, 0xb8, 0xed, 0x65, 0x48, 0xe2,
-	0xe0, 0x09, 0x4f, 0x66, 0xb1, 0xad, 0x20, 0x49, 0x1c, 0x54, 0x83, 0x13, 0x2d, 0x1a, 0xb5, 0xd6,
-	0x26, 0x89, 0x23, 0xa3, 0xc9, 0xbe, 0xe9, 0x61, 0xe5, 0xad, 0x5d, 0x65, 0xf0, 0x85, 0x94, 0xc8,
-	0xa4, 0x0b, 0x5e, 0x85, 0xf1, 0x68, 0x48, 0x60, 0xb8, 0x36, 0x49, 0x1c, 0x7c, 0x34, 0x24, 0xaf,
-	0xb5, 0x0b, 0x15, 0x61, 0xd4, 0x17, 0x17, 0x8e, 0x53, 0x46, 0x68, 0x9c, 0xa2, 0xa4, 0xb8, 0x94,
-	0x82, 0xef, 0x1b, 0x79, 0xb4, 0xe1, 0x90, 0xe5, 0x1a, 0x64, 0xf8, 0xe9, 0x14, 0x9a, 0xa0, 0xd1,
-	0x03, 0x4e, 0x50, 0x8a, 0x9d, 0x5b, 0xc1, 0x84, 0x58, 0xe0, 0xeb, 0x1a, 0x9a, 0x0b, 0x5b, 0x33,
-	0xd7, 0x89, 0x23, 0x8f, 0xb1, 0x35, 0xf5, 0x76, 0xcf, 0xbd, 0xe6, 0x19, 0xc0, 0x33, 0x29, 0x66,
-	0x6c, 0x8b, 0xa6, 0x6b, 0x6f, 0xb3, 0x5a, 0x57, 0x97, 0xce, 0x89, 0x3f, 0x49, 0x00, 0xa1, 0x1d,
-	0x79, 0x12, 0xe2, 0x75, 0x9e, 0x32, 0x61, 0x57, 0xe3, 0x10, 0xbb, 0x66, 0xbf, 0x1f, 0xcb, 0x64,
-	0xe5, 0x37, 0xb0, 0xd7, 0x83, 0x16, 0x20, 0xee, 0xed, 0xd4, 0xe8, 0xbe, 0x3b, 0xb5, 0xed, 0x86,
-	0xf3, 0x38, 0xd1, 0xbb, 0x07, 0x7f, 0x79, 0xa1, 0x15, 0x81, 0xbf, 0xb8, 0x40, 0x43, 0x7c, 0xcb,
-	0xb6, 0x49, 0x95, 0x1f, 0xbd, 0x86, 0x2e, 0xf2, 0xdb, 0x4a, 0x6e, 0x57, 0x49, 0xbe, 0x90, 0x06,
-	0x0a, 0x31, 0x3b, 0x2a, 0xeb, 0xf4, 0xea, 0x5a, 0x08, 0xc8, 0xca, 0x25, 0x07, 0xa7, 0x42, 0x6c,
-	0x65, 0xdd, 0x99, 0xf8, 0xa5, 0x04, 0xc3, 0x2d, 0x36, 0xe9, 0x55, 0x76, 0x90, 0xfe, 0x4c, 0x65,
-	0x87, 0xe8, 0x6b, 0x96, 0x1d, 0x26, 0x1e, 0x40, 0xaa, 0x75, 0x52, 0xd1, 0x55, 0x18, 0x10, 0x4b,
-	0x46, 0x62, 0x4b, 0xe6, 0x74, 0xcf, 0x25, 0xd3, 0xc2, 0x18, 0xaa, 0xf6, 0x09, 0xfe, 0x09, 0x1b,
-	0x8e, 0xed, 0xb1, 0xaa, 0x50, 0x06, 0xfa, 0x36, 0x88, 0x28, 0x02, 0x61, 0xfa, 0x88, 0xde, 0x85,
-	0x7e, 0x5e, 0x7e, 0xe2, 0x2b, 0xe3, 0xcd, 0x83, 0x49, 0x76, 0x30, 0xe7, 0xba, 0x1c, 0xfd, 0xba,
-	0x24, 0x12, 0x70, 0x9f, 0x4b, 0xa1, 0x5c, 0x7f, 0xb1, 0xe1, 0x3e, 0x25, 0xa6, 0x2b, 0x2e, 0xc8,
-	0x05, 0x4b, 0x27, 0x68, 0xc6, 0x13, 0xc4, 0x13, 0xfd, 0xe3, 0xbb, 0xca, 0xa8, 0x8d, 0xe6, 0x33,
-	0x4f, 0x1e, 0x16, 0x67, 0x1e, 0x5c, 0x98, 0xf9, 0xc6, 0xe3, 0x0f, 0xe7, 0xce, 0x5d, 0x9c, 0x7f,
-	0x3e, 0x25, 0x80, 0xd1, 0x15, 0x00, 0xf6, 0x72, 0x95, 0xba, 0x66, 0x5b, 0x35, 0xa1, 0xdc, 0xfe,
-	0xdb, 0x33, 0xc9, 0x78, 0x96, 0x6c, 0xab, 0x86, 0xbe, 0x09, 0x09, 0x0e, 0xe0, 0x5a, 0x62, 0xcd,
-	0xee, 0xcf, 0x1e, 0x67, 0x1c, 0x77, 0x2c, 0x31, 0xa4, 0x3f, 0x4e, 0x42, 0xd2, 0x1f, 0x12, 0xba,
-	0x1a, 0xce, 0xd1, 0x4f, 0xf5, 0xcc, 0xd1, 0x1f, 0x20, 0x39, 0xbf, 0x00, 0x50, 0xb1, 0x89, 0x26,
-	0xde, 0x8e, 0x89, 0x1e, 0xe6, 0xed, 0x18, 0xc1, 0x57, 0x74, 0x29, 0x48, 0xa3, 0xae, 0x7b, 0x20,
-	0x7d, 0x87, 0x01, 0x11, 0x7c, 0x45, 0x17, 0x1d, 0x13, 0x45, 0x1b, 0x9e, 0x4d, 0x8f, 0xf3, 0x6c,
-	0xfa, 0xbc, 0xa8, 0x51, 0x9d, 0x85, 0x41, 0x9d, 0x38, 0x15, 0xdb, 0xa8, 0xd3, 0x49, 0x64, 0x5e,
-	0x61, 0x92, 0x2d, 0x39, 0xbb, 0x4f, 0xfe, 0x3c, 0x8d, 0xc3, 0x9d, 0xe8, 0x19, 0x80, 0xe6, 0xba,
-	0xb6, 0xb1, 0xda, 0x70, 0x89, 0x23, 0x0f, 0xb0, 0x55, 0x7c, 0xa6, 0xa7, 0x8d, 0x66, 0x8b, 0x3e,
-	0x2d, 0x5b, 0x97, 0xca, 0xb9, 0x5d, 0xe5, 0xcc, 0x3f, 0x49, 0xa7, 0x0b, 0x07, 0x2a, 0xd6, 0xe0,
-	0x90, 0x28, 0xf4, 0x08, 0x06, 0x85, 0x8b, 0xcc, 0xce, 0x94, 0xf8, 0xe1, 0x2b, 0x28, 0xa9, 0xe6,
-	0x4e, 0x1e, 0xbc, 0xf6, 0x92, 0x83, 0x61, 0xd3, 0xa3, 0x71, 0x50, 0x19, 0x90, 0x43, 0x6c, 0xe6,
-	0xcd, 0xd7, 0x6d, 0x6b, 0xcd, 0xa8, 0x12, 0xd5, 0xd0, 0x99, 0xbb, 0x98, 0x54, 0x8e, 0x05, 0xb5,
-	0x87, 0xcc, 0x6d, 0x4e, 0xb4, 0xc2, 0x69, 0xca, 0x25, 0x9c, 0x71, 0x5a, 0x5b, 0x74, 0xf4, 0x9f,
-	0x12, 0x1c, 0xf1, 0x9c, 0x04, 0xda, 0x49, 0x6c, 0xf6, 0x92, 0x19, 0x71, 0x1c, 0x96, 0xbe, 0x4b,
-	0x2a, 0xff, 0x20, 0xed, 0x2a, 0x3f, 0x96, 0xec, 0x1f, 0x4a, 0xf3, 0x7f, 0x2d, 0x3d, 0x99, 0xbe,
-	0x72, 0x99, 0x8e, 0x5d, 0x9b, 0xf9, 0xbe, 0xd8, 0x1e, 0x1f, 0x85, 0x9e, 0x83, 0xc7, 0x47, 0x33,
-	0x8f, 0xcf, 0x86, 0x3a, 0xce, 0x3c, 0x9a, 0x3d, 0x73, 0x96, 0xf2, 0x15, 0x67, 0x1e, 0x08, 0x93,
-	0x7d, 0x14, 0x7a, 0x0e, 0x1e, 0x19, 0x5f, 0xd0, 0x71, 0x66, 0xfa, 0xca, 0xe5, 0xcb, 0x0f, 0xc5,
-	0x2e, 0xbc, 0xf4, 0xfc, 0xcc, 0x95, 0xa9, 0x8f, 0x9e, 0x4c, 0xe1, 0x51, 0xa1, 0xee, 0x6d, 0xa6,
-	0x6d, 0x91, 0x2b, 0x8b, 0x1e, 0x80, 0xdc, 0x36, 0x8c, 0x0d, 0xb2, 0xa1, 0x56, 0xb5, 0x55, 0x52,
-	0x95, 0xcf, 0xb3, 0x81, 0xbc, 0xc1, 0x97, 0xc8, 0xc7, 0x99, 0xe6, 0x4e, 0x7e, 0xec, 0x66, 0x18,
-	0xe3, 0xfa, 0xe2, 0xf5, 0x1b, 0x94, 0x10, 0x8f, 0xb5, 0x40, 0x5f, 0x27, 0x1b, 0xac, 0x19, 0xfd,
-	0xb7, 0x04, 0x13, 0x61, 0x07, 0xbd, 0xcd, 0x4e, 0xf0, 0xd5, 0xb4, 0x93, 0x1c, 0x52, 0xb9, 0xd5,
-	0x56, 0x6b, 0x70, 0xbc, 0xcb, 0x70, 0x02, 0x7b, 0x5d, 0x60, 0x03, 0x3a, 0x15, 0xb2, 0xd7, 0xd1,
-	0x62, 0x3b, 0x96, 0x6f, 0xb3, 0xa3, 0x1d, 0x62, 0x7c, 0xbb, 0x61, 0x18, 0xeb, 0x22, 0xc7, 0xd0,
-	0xe5, 0x39, 0x26, 0x20, 0xc7, 0x57, 0xaa, 0xce, 0xde, 0x58, 0x68, 0x07, 0x29, 0x97, 0xf0, 0x48,
-	0x07, 0x72, 0x59, 0x47, 0xbf, 0x94, 0x60, 0x84, 0x39, 0xf9, 0x6d, 0x93, 0x30, 0xf8, 0xd5, 0x9c,
-	0x84, 0x2c, 0xd5, 0xb5, 0xd5, 0xfa, 0x2e, 0x24, 0xab, 0x16, 0x1f, 0x95, 0x23, 0x0f, 0xb1, 0x23,
-	0x69, 0xba, 0xf7, 0x91, 0x74, 0xc3, 0x23, 0xfd, 0x22, 0x27, 0x52, 0x20, 0x08, 0xcd, 0x41, 0x5c,
-	0xbc, 0x7f, 0x2a, 0xcf, 0xb3, 0xc3, 0x68, 0xbc, 0x33, 0x6c, 0x65, 0xdd, 0xd8, 0xa3, 0xeb, 0x5a,
-	0x80, 0x1c, 0x3e, 0x70, 0x01, 0x32, 0xd5, 0xb5, 0x00, 0xd9, 0x25, 0x85, 0x90, 0xfe, 0x4b, 0x14,
-	0x80, 0x33, 0x7f, 0xa9, 0x02, 0x70, 0xf6, 0xf0, 0x05, 0xe0, 0x8e, 0x6a, 0x29, 0x3a, 0x48, 0xb5,
-	0x74, 0xe4, 0x20, 0xd5, 0xd2, 0xd1, 0x03, 0x57, 0x4b, 0xc7, 0x7a, 0x54, 0x4b, 0x2f, 0x41, 0xd2,
-	0xb6, 0x2c, 0x57, 0x65, 0x4e, 0x36, 0x4f, 0xd2, 0xca, 0x1d, 0x09, 0x71, 0xcb, 0x72, 0xa9, 0x87,
-	0x8d, 0x13, 0xb6, 0x78, 0x42, 0xef, 0xc3, 0x80, 0x49, 0x5c, 0x6a, 0x90, 0x71, 0xe6, 0xff, 0x2b,
-	0xbf, 0xdd, 0xc9, 0x5f, 0x3a, 0xec, 0x9b, 0xca, 0x37, 0x89, 0x5b, 0x2e, 0x35, 0x77, 0xf2, 0xfd,
-	0xec, 0x01, 0xf7, 0x9b, 0xc4, 0x2d, 0xeb, 0xe8, 0x16, 0x0c, 0xb5, 0xd4, 0xae, 0xe5, 0xfd, 0x6b,
-	0xd7, 0x34, 0xae, 0x0b, 0x97, 0x61, 0xf1, 0x60, 0x2d, 0x54, 0xad, 0x5e, 0x80, 0x24, 0x03, 0xa4,
-	0xfe, 0xa4, 0xa8, 0x19, 0xca, 0xbd, 0xfc, 0x4d, 0x65, 0xa8, 0xb9, 0x93, 0xf7, 0xb3, 0x81, 0x38,
-	0x41, 0x71, 0x58, 0x5e, 0xf0, 0x7d, 0xc8, 0x7a, 0x39, 0x88, 0x00, 0xec, 0xdc, 0x3e, 0x60, 0x23,
-	0x74, 0x7d, 0xac, 0x70, 0x36, 0x1f, 0xd3, 0xcb, 0x98, 0x2c, 0x7b, 0xd0, 0x73, 0x10, 0x77, 0x78,
-	0x18, 0x23, 0x4f, 0x74, 0xdf, 0xba, 0x22, 0xca, 0xc1, 0x1e, 0x1d, 0xfa, 0x36, 0x78, 0x28, 0xaa,
-	0xc7, 0x7a, 0x6c, 0x6f, 0xd6, 0x94, 0xa0, 0xf7, 0x5e, 0x38, 0x9f, 0x82, 0x94, 0x9f, 0x2b, 0x63,
-	0x4b, 0x84, 0xa5, 0x6c, 0x87, 0xf1, 0x90, 0xc8, 0x90, 0xb1, 0xe5, 0x81, 0x4e, 0x43, 0xba, 0xe1,
-	0x10, 0x3d, 0xa0, 0x72, 0xe4, 0x13, 0x34, 0xae, 0xc6, 0xc3, 0xb4, 0xd9, 0x23, 0x73, 0x28, 0x1d,
-	0x43, 0x0b, 0x56, 0x9c, 0x9c, 0x0b, 0x5e, 0xe8, 0xf6, 0x97, 0x1b, 0xfa, 0x9a, 0xa0, 0xb3, 0x3f,
-	0x10, 0xf5, 0x9d, 0x0b, 0x72, 0x9e, 0xbd, 0x13, 0x4b, 0x2f, 0xa1, 0xa1, 0x1b, 0x9a, 0xe3, 0xe2,
-	0x6b, 0xac, 0x76, 0x73, 0x81, 0x2b, 0x82, 0x3f, 0xe0, 0xbf, 0x3a, 0x19, 0xe7, 0xe4, 0xc9, 0xae,
-	0x8c, 0x73, 0x2d, 0x8c, 0x73, 0xe8, 0x09, 0x1c, 0x6b, 0xcf, 0x09, 0xda, 0xa4, 0x42, 0x8c, 0x4d,
-	0xee, 0xc0, 0xbe, 0x71, 0x98, 0x9c, 0xa3, 0x9f, 0x38, 0xc4, 0x02, 0xa1, 0xe8, 0xa2, 0x45, 0x18,
-	0xe4, 0x79, 0x07, 0xbe, 0x22, 0x0a, 0x3d, 0xce, 0x21, 0x4a, 0xc2, 0xd7, 0x44, 0x10, 0xa2, 0x41,
-	0xdd, 0x6f, 0x45, 0x0f, 0x01, 0xad, 0xb2, 0x17, 0x0b, 0xb6, 0xd5, 0x3a, 0xb1, 0x2b, 0xc4, 0x74,
-	0xb5, 0x75, 0x22, 0x9f, 0xdc, 0xbf, 0xc2, 0x97, 0xde, 0x55, 0x86, 0x00, 0x4e, 0x44, 0x22, 0x1f,
-	0x5f, 0x99, 0x89, 0x44, 0x22, 0x11, 0x9c, 0x15, 0x38, 0x2b, 0x3e, 0x0c, 0x7a, 0x13, 0xd2, 0x7e,
-	0xd2, 0x41, 0xd4, 0x0e, 0xa7, 0x26, 0xa5, 0xe9, 0x7e, 0x9c, 0xf2, 0x9a, 0x45, 0x51, 0x50, 0xa3,
-	0x47, 0x07, 0xcb, 0x50, 0x69, 0xba, 0xed, 0xe7, 0xba, 0x4e, 0x1d, 0x20, 0xd7, 0xa5, 0x8c, 0x52,
-	0x7f, 0x14, 0x33, 0xe6, 0x62, 0x09, 0x8b, 0x94, 0x17, 0x16, 0x09, 0xaf, 0xa2, 0x6e, 0x7b, 0x49,
-	0xb0, 0xce, 0x54, 0xda, 0xe9, 0x2f, 0x29, 0x95, 0xf6, 0xe6, 0x17, 0x4c, 0xa5, 0xed, 0xf7, 0xbe,
-	0xff, 0xf4, 0x97, 0xf2, 0xbe, 0x3f, 0xba, 0x0a, 0x10, 0x7a, 0x1d, 0xe5, 0xcc, 0xe1, 0x5e, 0x47,
-	0xc1, 0x21, 0x5e, 0xb4, 0x0a, 0xa9, 0xba, 0x6d, 0x6d, 0x1a, 0x74, 0x1f, 0x73, 0x7f, 0xeb, 0x2c,
-	0xbb, 0x94, 0xbe, 0xb9, 0xab, 0xbc, 0x69, 0x9f, 0x92, 0xa7, 0xe6, 0xdf, 0xd8, 0xdb, 0x6d, 0xf8,
-	0xe8, 0xc9, 0x54, 0x73, 0x27, 0x3f, 0xbc, 0x12, 0x60, 0x94, 0x4b, 0x78, 0x38, 0x04, 0x59, 0xd6,
-	0x51, 0x09, 0xb2, 0x7e, 0x03, 0x3d, 0x65, 0x74, 0xcd, 0xd5, 0xe4, 0xb7, 0xc4, 0x11, 0xd3, 0xbe,
-	0x1c, 0x6f, 0xb3, 0xaf, 0x7f, 0x70, 0x26, 0xcc, 0x41, 0xe3, 0x76, 0x74, 0x1c, 0x92, 0xb5, 0x46,
-	0x95, 0xc6, 0xe3, 0x8e, 0x2b, 0xcf, 0xb0, 0x1b, 0x28, 0x68, 0x40, 0xeb, 0x70, 0xb4, 0x52, 0xd5,
-	0x8c, 0x9a, 0xaa, 0xb5, 0x84, 0xed, 0x6a, 0xc5, 0xd2, 0x89, 0x3c, 0xbb, 0x4f, 0x44, 0xd5, 0x19,
-	0xea, 0xe3, 0x71, 0x86, 0xd6, 0x25, 0x07, 0x30, 0x0b, 0x23, 0xce, 0x86, 0x51, 0x57, 0x45, 0x62,
-	0x4a, 0xad, 0xd8, 0xdb, 0x75, 0xd7, 0x92, 0x2f, 0x32, 0x85, 0xb2, 0xb4, 0x4b, 0x18, 0x7c, 0x81,
-	0x75, 0xa0, 0x87, 0x70, 0xbc, 0x0b, 0xbd, 0x6a, 0x6d, 0x12, 0xdb, 0x36, 0x74, 0x22, 0xbf, 0xbd,
-	0x6f, 0xad, 0xfc, 0x68, 0x07, 0xe8, 0x2d, 0xc1, 0x3c, 0xf1, 0x2e, 0xa4, 0xdb, 0xc2, 0xd0, 0x70,
-	0x7a, 0x24, 0xc9, 0xd3, 0x23, 0xa3, 0xe1, 0xf4, 0x48, 0x32, 0x94, 0xf5, 0x98, 0xb8, 0x07, 0xa9,
-	0x56, 0x97, 0xb1, 0x0b, 0xf7, 0x6c, 0x6b, 0x72, 0xa5, 0xe3, 0x7e, 0xf2, 0x00, 0x3a, 0xb3, 0x29,
-	0x57, 0x01, 0x7c, 0x0b, 0x3b, 0xe8, 0x32, 0x0c, 0x06, 0x9f, 0xaf, 0x79, 0x49, 0xa2, 0xa3, 0x3d,
-	0xa7, 0x04, 0x03, 0xf1, 0x79, 0x0b, 0x3a, 0x1c, 0x59, 0x60, 0x49, 0x83, 0xa0, 0x5b, 0x64, 0xf4,
-	0xae, 0x01, 0x04, 0xa8, 0xfe, 0xdb, 0x51, 0xbd, 0x40, 0xbb, 0x24, 0x33, 0x92, 0xbe, 0x98, 0xc2,
-	0xcf, 0x24, 0x38, 0x72, 0x97, 0xa5, 0x15, 0xfe, 0x9c, 0x62, 0xd0, 0x15, 0x80, 0xe0, 0x1b, 0xb8,
-	0x9e, 0x99, 0x93, 0x25, 0x4a, 0xb2, 0xac, 0x39, 0x1b, 0x4a, 0x8c, 0x65, 0x20, 0x93, 0x6b, 0x5e,
-	0x43, 0xe1, 0xdf, 0x24, 0x18, 0x79, 0x8f, 0xb8, 0x1d, 0x4a, 0x3e, 0x82, 0x54, 0xa0, 0xa4, 0xfa,
-	0xfa, 0x79, 0x9e, 0x21, 0x12, 0xd0, 0x39, 0xaf, 0xaf, 0xf6, 0xff, 0x4a, 0x70, 0x2a, 0xac, 0x76,
-	0x48, 0xf8, 0x92, 0x65, 0x2f, 0xde, 0x2d, 0x3b, 0xde, 0x40, 0x2a, 0x90, 0x60, 0x77, 0x3f, 0x69,
-	0x18, 0x22, 0x23, 0x7c, 0x55, 0x7c, 0xbf, 0x76, 0x68, 0xaf, 0x70, 0xf1, 0x6e, 0xf9, 0x9d, 0xb7,
-	0x9b, 0x3b, 0xf9, 0x38, 0x75, 0x1b, 0x16, 0xef, 0x96, 0x71, 0x9c, 0x22, 0x2f, 0x36, 0x0c, 0xf4,
-	0x5d, 0x88, 0xd3, 0x6b, 0x9c, 0xca, 0xe0, 0xdf, 0xc8, 0xbd, 0xf7, 0xba, 0x32, 0x06, 0x4a, 0x64,
-	0x93, 0x8a, 0x18, 0xd0, 0xc9, 0xe6, 0x62, 0xc3, 0x28, 0xbc, 0xe8, 0x83, 0xb1, 0x1b, 0x86, 0x13,
-	0x8c, 0xd8, 0x1f, 0xa0, 0x06, 0xe9, 0xf0, 0xf5, 0x10, 0x4c, 0xd5, 0xe9, 0x3d, 0x2e, 0x86, 0xbd,
-	0x27, 0x2b, 0xa5, 0x85, 0x29, 0x5f, 0x7f, 0xba, 0xd0, 0x27, 0x12, 0xf4, 0x5b, 0xb6, 0x4e, 0x6c,
-	0xf1, 0x82, 0xf7, 0xdf, 0x4a, 0xbb, 0xca, 0xdf, 0x48, 0xf6, 0x0f, 0x24, 0x1c, 0xc1, 0x49, 0x7f,
-	0x8d, 0x61, 0x98, 0x09, 0x9e, 0xfd, 0x59, 0xc3, 0xc9, 0x19, 0xff, 0xd1, 0xb3, 0x32, 0x4e, 0xcc,
-	0x78, 0x4f, 0x2c, 0x35, 0x87, 0xfb, 0x67, 0xd8, 0xbf, 0x70, 0x0a, 0x0e, 0x0f, 0xcd, 0x84, 0x7f,
-	0x85, 0x32, 0x8c, 0x78, 0x70, 0x26, 0xf4, 0x83, 0x2b, 0x86, 0x72, 0xd0, 0xcf, 0xbf, 0xdf, 0x62,
-	0x1f, 0x10, 0x32, 0x67, 0xe8, 0x6c, 0x9f, 0xfc, 0xfb, 0x38, 0xe6, 0xcd, 0x08, 0x41, 0xac, 0x4e,
-	0x3d, 0x1f, 0xfe, 0xe1, 0x20, 0x7b, 0x2e, 0xfc, 0xb3, 0x04, 0x23, 0xb7, 0xbb, 0x6c, 0x9e, 0xa5,
-	0xc3, 0xed, 0xf0, 0xd6, 0xf2, 0xc0, 0x97, 0xb9, 0xbb, 0xff, 0x4b, 0x82, 0xac, 0x2f, 0xe7, 0x0e,
-	0xa9, 0xd5, 0xab, 0xd4, 0xa5, 0xfb, 0xaa, 0xa8, 0x87, 0xa6, 0x61, 0xb0, 0xa6, 0xd5, 0x59, 0x89,
-	0x9b, 0x5e, 0x14, 0x7d, 0xe1, 0xa4, 0xab, 0x8e, 0x41, 0xf4, 0x5d, 0x27, 0xdb, 0x85, 0x4f, 0x25,
-	0x18, 0xef, 0x18, 0x08, 0xf7, 0x42, 0xfc, 0x9c, 0xad, 0xd4, 0xca, 0xde, 0x35, 0x67, 0x1b, 0x0d,
-	0xe7, 0x6c, 0x3f, 0x93, 0x5a, 0x73, 0xb6, 0x77, 0x20, 0xcd, 0x32, 0x9a, 0x64, 0xcb, 0x25, 0xa6,
-	0xc3, 0xb2, 0x24, 0x7d, 0xac, 0x24, 0xf3, 0xd6, 0xae, 0x32, 0xfd, 0x42, 0x3a, 0x95, 0xd1, 0x65,
-	0xa9, 0x90, 0xb7, 0x4f, 0xcc, 0x1f, 0x7b, 0x32, 0x7d, 0xe5, 0xf2, 0xa3, 0x59, 0xcf, 0x79, 0xf9,
-	0x70, 0xee, 0xdc, 0xdc, 0x3b, 0xcf, 0xcf, 0x7c, 0x38, 0x77, 0x6e, 0xfe, 0xf9, 0x14, 0x4e, 0x51,
-	0x8c, 0x45, 0x1f, 0xa2, 0xf0, 0x27, 0x09, 0xe4, 0x1e, 0xaa, 0x3b, 0xe8, 0x39, 0xc4, 0xb9, 0xff,
-	0xe4, 0x5d, 0x62, 0x97, 0x7a, 0xce, 0x43, 0x1b, 0xeb, 0xac, 0xf8, 0xff, 0x45, 0xb2, 0x33, 0x9e,
-	0xcc, 0x89, 0x0a, 0x0c, 0x85, 0x61, 0xba, 0xdc, 0xd8, 0xfb, 0x95, 0x43, 0x7a, 0xa8, 0x17, 0xba,
-	0xc0, 0x0b, 0x3f, 0x94, 0x20, 0xbf, 0x60, 0x99, 0x9b, 0xc4, 0x76, 0x3b, 0xa8, 0xbd, 0x1d, 0xb3,
-	0x02, 0x49, 0xae, 0x53, 0xf0, 0xe5, 0xc3, 0xc5, 0x83, 0x7f, 0xaa, 0x90, 0xe0, 0x42, 0xcb, 0x25,
-	0x9c, 0xe0, 0x28, 0x65, 0xf6, 0xf9, 0x05, 0x73, 0x0d, 0xd9, 0x79, 0x8c, 0xd9, 0xf3, 0xd9, 0x25,
-	0x80, 0x20, 0xde, 0x41, 0x59, 0x18, 0x5e, 0xb9, 0x75, 0x7f, 0x11, 0xab, 0x77, 0x6f, 0x5e, 0xbf,
-	0x79, 0xeb, 0xfe, 0xcd, 0x4c, 0x24, 0x68, 0x52, 0x8a, 0x77, 0xee, 0x2c, 0xe2, 0xf7, 0x33, 0x12,
-	0x42, 0x90, 0xe2, 0x4d, 0x8b, 0xff, 0xff, 0xce, 0x22, 0xbe, 0x59, 0xbc, 0x91, 0x89, 0x2a, 0x3f,
-	0x93, 0x3e, 0x7b, 0x99, 0x93, 0x3e, 0x7f, 0x99, 0x93, 0x7e, 0xf3, 0x32, 0x17, 0xf9, 0xdd, 0xcb,
-	0x5c, 0xe4, 0xf7, 0x2f, 0x73, 0x91, 0x3f, 0xbc, 0xcc, 0x45, 0xfe, 0xf8, 0x32, 0x27, 0x7d, 0xdc,
-	0xcc, 0x49, 0x3f, 0x6a, 0xe6, 0x22, 0x3f, 0x6f, 0xe6, 0xa4, 0x5f, 0x34, 0x73, 0x91, 0x4f, 0x9b,
-	0xb9, 0xc8, 0xaf, 0x9a, 0xb9, 0xc8, 0x67, 0xcd, 0x9c, 0xf4, 0x79, 0x33, 0x27, 0xfd, 0xa6, 0x99,
-	0x8b, 0xfc, 0xae, 0x99, 0x93, 0x7e, 0xdf, 0xcc, 0x45, 0xfe, 0xd0, 0xcc, 0x49, 0x7f, 0x6c, 0xe6,
-	0x22, 0x1f, 0xbf, 0xca, 0x45, 0x7e, 0xf4, 0x2a, 0x27, 0xfd, 0xe4, 0x55, 0x2e, 0xf2, 0xd3, 0x57,
-	0x39, 0xe9, 0x93, 0x57, 0xb9, 0xc8, 0xcf, 0x5f, 0xe5, 0x22, 0xbf, 0x78, 0x95, 0x93, 0x3e, 0x7d,
-	0x95, 0x93, 0x7e, 0xf5, 0x2a, 0x27, 0x3d, 0x38, 0x7f, 0x88, 0xcb, 0xc4, 0x35, 0xeb, 0xab, 0xab,
-	0x03, 0x6c, 0x13, 0x5e, 0xfc, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xd3, 0x51, 0xff, 0xfe,
-	0x3f, 0x00, 0x00,
+func (m *EndDeviceArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceArchive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndDeviceArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceArchive.Merge(m, src)
+}
+func (m *EndDeviceArchive) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceArchive.DiscardUnknown(m)
 }
 
-func (x PowerState) String() string {
-	s, ok := PowerState_name[int32(x)]
-	if ok {
-		return s
+var xxx_messageInfo_EndDeviceArchive proto.InternalMessageInfo
+
+func (m *EndDeviceArchive) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
 	}
-	return strconv.Itoa(int(x))
+	return 0
 }
-func (this *Session) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EndDeviceArchive) GetDataKey() *KeyEnvelope {
+	if m != nil {
+		return m.DataKey
 	}
+	return nil
+}
 
-	that1, ok := that.(*Session)
-	if !ok {
+func (m *EndDeviceArchive) GetEndDevices() []byte {
+	if m != nil {
+		return m.EndDevices
+	}
+	return nil
+}
+
+func (m *EndDeviceArchive) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type ExportEndDevicesRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	// The IDs of the end devices to export.
+	DeviceIDs []string `protobuf:"bytes,2,rep,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"`
+	// The label of the KEK that is used to wrap the data key of the archive.
+	// If empty, the archive is not encrypted.
+	KEKLabel             string   `protobuf:"bytes,3,opt,name=kek_label,json=kekLabel,proto3" json:"kek_label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportEndDevicesRequest) Reset()      { *m = ExportEndDevicesRequest{} }
+func (*ExportEndDevicesRequest) ProtoMessage() {}
+func (*ExportEndDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{24}
+}
+func (m *ExportEndDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportEndDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportEndDevicesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportEndDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportEndDevicesRequest.Merge(m, src)
+}
+func (m *ExportEndDevicesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportEndDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportEndDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportEndDevicesRequest proto.InternalMessageInfo
+
+func (m *ExportEndDevicesRequest) GetDeviceIDs() []string {
+	if m != nil {
+		return m.DeviceIDs
+	}
+	return nil
+}
+
+func (m *ExportEndDevicesRequest) GetKEKLabel() string {
+	if m != nil {
+		return m.KEKLabel
+	}
+	return ""
+}
+
+type ImportEndDevicesRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	// The archive that contains the end devices to import.
+	// The end devices must belong to the application.
+	Archive              *EndDeviceArchive `protobuf:"bytes,2,opt,name=archive,proto3" json:"archive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImportEndDevicesRequest) Reset()      { *m = ImportEndDevicesRequest{} }
+func (*ImportEndDevicesRequest) ProtoMessage() {}
+func (*ImportEndDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{25}
+}
+func (m *ImportEndDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportEndDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportEndDevicesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportEndDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportEndDevicesRequest.Merge(m, src)
+}
+func (m *ImportEndDevicesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportEndDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportEndDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportEndDevicesRequest proto.InternalMessageInfo
+
+func (m *ImportEndDevicesRequest) GetArchive() *EndDeviceArchive {
+	if m != nil {
+		return m.Archive
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ADRAlgorithm", ADRAlgorithm_name, ADRAlgorithm_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ADRAlgorithm", ADRAlgorithm_name, ADRAlgorithm_value)
+	proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
+	golang_proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
+	proto.RegisterType((*MACParameters)(nil), "ttn.lorawan.v3.MACParameters")
+	golang_proto.RegisterType((*MACParameters)(nil), "ttn.lorawan.v3.MACParameters")
+	proto.RegisterType((*MACParameters_Channel)(nil), "ttn.lorawan.v3.MACParameters.Channel")
+	golang_proto.RegisterType((*MACParameters_Channel)(nil), "ttn.lorawan.v3.MACParameters.Channel")
+	proto.RegisterType((*EndDeviceBrand)(nil), "ttn.lorawan.v3.EndDeviceBrand")
+	golang_proto.RegisterType((*EndDeviceBrand)(nil), "ttn.lorawan.v3.EndDeviceBrand")
+	proto.RegisterType((*EndDeviceModel)(nil), "ttn.lorawan.v3.EndDeviceModel")
+	golang_proto.RegisterType((*EndDeviceModel)(nil), "ttn.lorawan.v3.EndDeviceModel")
+	proto.RegisterType((*EndDeviceVersionIdentifiers)(nil), "ttn.lorawan.v3.EndDeviceVersionIdentifiers")
+	golang_proto.RegisterType((*EndDeviceVersionIdentifiers)(nil), "ttn.lorawan.v3.EndDeviceVersionIdentifiers")
+	proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
+	golang_proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
+	proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
+	golang_proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
+	proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
+	golang_proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
+	proto.RegisterMapType((map[uint64]*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.RejectedDataRateRangesEntry")
+	golang_proto.RegisterMapType((map[uint64]*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.RejectedDataRateRangesEntry")
+	proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
+	golang_proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
+	proto.RegisterType((*MACState_DataRateRange)(nil), "ttn.lorawan.v3.MACState.DataRateRange")
+	golang_proto.RegisterType((*MACState_DataRateRange)(nil), "ttn.lorawan.v3.MACState.DataRateRange")
+	proto.RegisterType((*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.DataRateRanges")
+	golang_proto.RegisterType((*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.DataRateRanges")
+	proto.RegisterType((*ADRAlgorithmValue)(nil), "ttn.lorawan.v3.ADRAlgorithmValue")
+	golang_proto.RegisterType((*ADRAlgorithmValue)(nil), "ttn.lorawan.v3.ADRAlgorithmValue")
+	proto.RegisterType((*ADRDecision)(nil), "ttn.lorawan.v3.ADRDecision")
+	golang_proto.RegisterType((*ADRDecision)(nil), "ttn.lorawan.v3.ADRDecision")
+	proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
+	golang_proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
+	proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
+	golang_proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDevice.AttributesEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDevice.AttributesEntry")
+	proto.RegisterMapType((map[string]*Location)(nil), "ttn.lorawan.v3.EndDevice.LocationsEntry")
+	golang_proto.RegisterMapType((map[string]*Location)(nil), "ttn.lorawan.v3.EndDevice.LocationsEntry")
+	proto.RegisterType((*EndDevices)(nil), "ttn.lorawan.v3.EndDevices")
+	golang_proto.RegisterType((*EndDevices)(nil), "ttn.lorawan.v3.EndDevices")
+	proto.RegisterType((*CreateEndDeviceRequest)(nil), "ttn.lorawan.v3.CreateEndDeviceRequest")
+	golang_proto.RegisterType((*CreateEndDeviceRequest)(nil), "ttn.lorawan.v3.CreateEndDeviceRequest")
+	proto.RegisterType((*UpdateEndDeviceRequest)(nil), "ttn.lorawan.v3.UpdateEndDeviceRequest")
+	golang_proto.RegisterType((*UpdateEndDeviceRequest)(nil), "ttn.lorawan.v3.UpdateEndDeviceRequest")
+	proto.RegisterType((*GetEndDeviceRequest)(nil), "ttn.lorawan.v3.GetEndDeviceRequest")
+	golang_proto.RegisterType((*GetEndDeviceRequest)(nil), "ttn.lorawan.v3.GetEndDeviceRequest")
+	proto.RegisterType((*GetEndDeviceIdentifiersForEUIsRequest)(nil), "ttn.lorawan.v3.GetEndDeviceIdentifiersForEUIsRequest")
+	golang_proto.RegisterType((*GetEndDeviceIdentifiersForEUIsRequest)(nil), "ttn.lorawan.v3.GetEndDeviceIdentifiersForEUIsRequest")
+	proto.RegisterType((*ListEndDevicesRequest)(nil), "ttn.lorawan.v3.ListEndDevicesRequest")
+	golang_proto.RegisterType((*ListEndDevicesRequest)(nil), "ttn.lorawan.v3.ListEndDevicesRequest")
+	proto.RegisterType((*SetEndDeviceRequest)(nil), "ttn.lorawan.v3.SetEndDeviceRequest")
+	golang_proto.RegisterType((*SetEndDeviceRequest)(nil), "ttn.lorawan.v3.SetEndDeviceRequest")
+	proto.RegisterType((*EndDeviceTemplate)(nil), "ttn.lorawan.v3.EndDeviceTemplate")
+	golang_proto.RegisterType((*EndDeviceTemplate)(nil), "ttn.lorawan.v3.EndDeviceTemplate")
+	proto.RegisterType((*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormat")
+	golang_proto.RegisterType((*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormat")
+	proto.RegisterType((*EndDeviceTemplateFormats)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats")
+	golang_proto.RegisterType((*EndDeviceTemplateFormats)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats")
+	proto.RegisterMapType((map[string]*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats.FormatsEntry")
+	golang_proto.RegisterMapType((map[string]*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats.FormatsEntry")
+	proto.RegisterType((*ConvertEndDeviceTemplateRequest)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest")
+	golang_proto.RegisterType((*ConvertEndDeviceTemplateRequest)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest")
+	proto.RegisterType((*EndDeviceArchive)(nil), "ttn.lorawan.v3.EndDeviceArchive")
+	golang_proto.RegisterType((*EndDeviceArchive)(nil), "ttn.lorawan.v3.EndDeviceArchive")
+	proto.RegisterType((*ExportEndDevicesRequest)(nil), "ttn.lorawan.v3.ExportEndDevicesRequest")
+	golang_proto.RegisterType((*ExportEndDevicesRequest)(nil), "ttn.lorawan.v3.ExportEndDevicesRequest")
+	proto.RegisterType((*ImportEndDevicesRequest)(nil), "ttn.lorawan.v3.ImportEndDevicesRequest")
+	golang_proto.RegisterType((*ImportEndDevicesRequest)(nil), "ttn.lorawan.v3.ImportEndDevicesRequest")
+}
+
+func init() {
+	proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80)
+}
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80)
+}
+
+var fileDescriptor_a656ee0551c94a80 = []byte{
+	// 5626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x4b, 0x6c, 0x1c, 0xc7,
+	0x99, 0x9e, 0x1e, 0x0e, 0x39, 0x33, 0x3f, 0xc9, 0x79, 0x14, 0x49, 0xb1, 0x45, 0x49, 0x33, 0xd4,
+	0x58, 0xb6, 0x29, 0x59, 0xa4, 0x4c, 0xca, 0x76, 0x12, 0xc5, 0x5e, 0x65, 0x9a, 0x43, 0x59, 0x23,
+	0x91, 0x12, 0x53, 0xd4, 0x23, 0x96, 0x64, 0x75, 0x9a, 0xd3, 0x45, 0xaa, 0xcd, 0x99, 0xee, 0x49,
+	0x77, 0x0f, 0x45, 0xc6, 0x36, 0xe0, 0x0d, 0x76, 0x91, 0x6c, 0xb0, 0xbb, 0x30, 0x74, 0x0a, 0xb2,
+	0xc0, 0xc2, 0x97, 0x00, 0x39, 0x2d, 0x82, 0xc5, 0x1e, 0x8c, 0x05, 0x16, 0x09, 0x16, 0xd8, 0x85,
+	0x2f, 0x0b, 0xf8, 0x90, 0x43, 0x90, 0x03, 0x37, 0x1a, 0x5d, 0x7c, 0x5a, 0xe4, 0x18, 0xf0, 0x10,
+	0x2c, 0xea, 0xd1, 0x8f, 0x79, 0x91, 0x43, 0xdb, 0x49, 0x7c, 0x21, 0x7b, 0xaa, 0xfe, 0xff, 0xfb,
+	0xeb, 0xf1, 0x57, 0xd5, 0xff, 0xa8, 0x82, 0x42, 0xd5, 0xb2, 0xb5, 0xc7, 0x9a, 0x39, 0xeb, 0xb8,
+	0x5a, 0x65, 0xeb, 0x82, 0x56, 0x37, 0x2e, 0x10, 0x53, 0x57, 0x75, 0xb2, 0x6d, 0x54, 0xc8, 0x5c,
+	0xdd, 0xb6, 0x5c, 0x0b, 0xa5, 0x5c, 0xd7, 0x9c, 0x13, 0x74, 0x73, 0xdb, 0x17, 0xa7, 0x8a, 0x9b,
+	0x86, 0xfb, 0xa8, 0xb1, 0x3e, 0x57, 0xb1, 0x6a, 0x17, 0x88, 0xb9, 0x6d, 0xed, 0xd6, 0x6d, 0x6b,
+	0x67, 0xf7, 0x02, 0x23, 0xae, 0xcc, 0x6e, 0x12, 0x73, 0x76, 0x5b, 0xab, 0x1a, 0xba, 0xe6, 0x92,
+	0x0b, 0x1d, 0x1f, 0x1c, 0x72, 0x6a, 0x36, 0x04, 0xb1, 0x69, 0x6d, 0x5a, 0x9c, 0x79, 0xbd, 0xb1,
+	0xc1, 0x7e, 0xb1, 0x1f, 0xec, 0x4b, 0x90, 0xe7, 0x36, 0x2d, 0x6b, 0xb3, 0x4a, 0x02, 0x2a, 0xbd,
+	0x61, 0x6b, 0xae, 0x61, 0x99, 0xa2, 0x7e, 0xba, 0xbd, 0x7e, 0xc3, 0x20, 0x55, 0x5d, 0xad, 0x69,
+	0xce, 0x96, 0xa0, 0x38, 0xd9, 0x4e, 0xe1, 0xb8, 0x76, 0xa3, 0xe2, 0x8a, 0xda, 0x7c, 0x7b, 0xad,
+	0x6b, 0xd4, 0x88, 0xe3, 0x6a, 0xb5, 0x7a, 0xaf, 0x06, 0x3c, 0xb6, 0xb5, 0x7a, 0x9d, 0xd8, 0x8e,
+	0xa8, 0x7f, 0xae, 0x73, 0x18, 0x0d, 0x9d, 0x98, 0xae, 0xb1, 0x61, 0x04, 0x44, 0x27, 0x3b, 0x89,
+	0xde, 0xb1, 0x0c, 0xb3, 0x77, 0xed, 0x16, 0xd9, 0xf5, 0x78, 0xf3, 0x9d, 0xb5, 0xde, 0x8c, 0x88,
+	0x21, 0xe8, 0x24, 0xa8, 0x11, 0xc7, 0xd1, 0x36, 0xc9, 0x01, 0x10, 0x75, 0xa3, 0xe2, 0x36, 0x6c,
+	0x72, 0x10, 0x84, 0xab, 0xe9, 0x9a, 0xab, 0x71, 0x8a, 0xc2, 0x5f, 0xc7, 0x20, 0xbe, 0x46, 0x1c,
+	0xc7, 0xb0, 0x4c, 0x74, 0x0f, 0x12, 0x3a, 0xd9, 0x56, 0x35, 0x5d, 0xb7, 0xe5, 0xe8, 0xb4, 0x34,
+	0x33, 0xa2, 0x5c, 0xfe, 0x64, 0x2f, 0x1f, 0xf9, 0xed, 0x5e, 0xfe, 0x6b, 0x9b, 0xd6, 0x9c, 0xfb,
+	0x88, 0xb8, 0x8f, 0x0c, 0x73, 0xd3, 0x99, 0x33, 0x89, 0xfb, 0xd8, 0xb2, 0xb7, 0x2e, 0xb4, 0x82,
+	0x6f, 0x5f, 0xbc, 0x50, 0xdf, 0xda, 0xbc, 0xe0, 0xee, 0xd6, 0x89, 0x33, 0x57, 0x22, 0xdb, 0x45,
+	0x5d, 0xb7, 0x71, 0x5c, 0xe7, 0x1f, 0xa8, 0x08, 0x31, 0xda, 0x77, 0x79, 0x60, 0x5a, 0x9a, 0x19,
+	0x5e, 0x38, 0x31, 0xd7, 0xaa, 0x80, 0x73, 0xa2, 0x09, 0xd7, 0xc9, 0xae, 0xa3, 0x64, 0xf6, 0x95,
+	0xc1, 0x1f, 0x4b, 0xd1, 0x8c, 0x44, 0x85, 0x7f, 0xba, 0x97, 0x97, 0x30, 0x63, 0x45, 0xa7, 0x61,
+	0xb4, 0xaa, 0x39, 0xae, 0xba, 0xa1, 0x56, 0x4c, 0x57, 0x6d, 0xd4, 0xe5, 0xd8, 0xb4, 0x34, 0x33,
+	0x8a, 0x81, 0x16, 0x5e, 0x59, 0x34, 0xdd, 0xdb, 0x75, 0x34, 0x03, 0x59, 0x46, 0x62, 0x0a, 0x22,
+	0xdd, 0x7a, 0x6c, 0xca, 0x83, 0x8c, 0x8c, 0xf1, 0xde, 0xa0, 0x74, 0x25, 0xeb, 0xb1, 0xe9, 0x53,
+	0x6a, 0x61, 0xca, 0xa1, 0x80, 0xb2, 0xe8, 0x53, 0xce, 0xc1, 0x38, 0xa3, 0xac, 0x58, 0xe6, 0x46,
+	0x98, 0x38, 0xce, 0x88, 0x33, 0xb4, 0x6e, 0xd1, 0x32, 0x37, 0x7c, 0xfa, 0x45, 0x00, 0xc7, 0xd5,
+	0x6c, 0x97, 0xe8, 0xaa, 0xe6, 0xca, 0x09, 0xd6, 0xdf, 0xa9, 0x39, 0xae, 0x6d, 0x73, 0x9e, 0xb6,
+	0xcd, 0xdd, 0xf2, 0xd4, 0x51, 0x49, 0xd0, 0x6e, 0x7e, 0xf8, 0xbf, 0x79, 0x09, 0x27, 0x05, 0x5f,
+	0xd1, 0x45, 0x04, 0x4e, 0x7e, 0xaf, 0x41, 0x1a, 0x14, 0xa3, 0x5e, 0xaf, 0x1a, 0x15, 0xb6, 0x34,
+	0x98, 0xdc, 0xaa, 0x61, 0x6e, 0x39, 0x72, 0x72, 0x7a, 0x60, 0x66, 0x78, 0xe1, 0xb9, 0xf6, 0x61,
+	0x2c, 0x06, 0xc4, 0x25, 0x41, 0x8b, 0xa7, 0x38, 0x50, 0x97, 0x2a, 0xe7, 0x5a, 0x2c, 0x21, 0x65,
+	0xa2, 0x85, 0x7f, 0xc9, 0xc0, 0xe8, 0x4a, 0x71, 0x71, 0x55, 0xb3, 0xb5, 0x1a, 0x71, 0x89, 0xed,
+	0xa0, 0x17, 0x20, 0x51, 0xd3, 0x76, 0x54, 0x62, 0xd8, 0x75, 0x59, 0x9a, 0x96, 0x66, 0xa2, 0xca,
+	0x70, 0x73, 0x2f, 0x1f, 0x5f, 0xd1, 0x76, 0x96, 0xca, 0x78, 0x15, 0xc7, 0x6b, 0xda, 0xce, 0x92,
+	0x61, 0xd7, 0xd1, 0x3b, 0x30, 0xa6, 0xe9, 0xb6, 0x4a, 0xf5, 0x49, 0xb5, 0x35, 0x97, 0xa8, 0x86,
+	0xa9, 0x93, 0x1d, 0x36, 0x31, 0xa9, 0x85, 0x53, 0xed, 0xad, 0x2b, 0x69, 0xae, 0x86, 0x35, 0x97,
+	0x94, 0x29, 0x91, 0x72, 0x72, 0x5f, 0x19, 0xfc, 0x01, 0x9d, 0xe6, 0xe6, 0x5e, 0x3e, 0x53, 0x2c,
+	0xe1, 0x96, 0x5a, 0x9c, 0xd1, 0x74, 0xbb, 0xa5, 0x04, 0xbd, 0x09, 0x88, 0xca, 0x72, 0x77, 0xd4,
+	0xba, 0xf5, 0x98, 0xd8, 0x42, 0x14, 0x9b, 0x5c, 0x65, 0x6a, 0x5f, 0x89, 0x9d, 0x8b, 0xca, 0xe9,
+	0xe6, 0x5e, 0x3e, 0x5d, 0x2c, 0xe1, 0x5b, 0x3b, 0xab, 0x94, 0x84, 0x23, 0xa5, 0x35, 0xdd, 0x0e,
+	0x17, 0xa0, 0xaf, 0xc1, 0x08, 0x05, 0x32, 0xd7, 0x55, 0xd7, 0xd6, 0x4c, 0x87, 0xcf, 0xba, 0x32,
+	0x11, 0x40, 0x40, 0xb1, 0x84, 0x6f, 0xac, 0xdf, 0xa2, 0x95, 0x18, 0x34, 0xdd, 0x16, 0xdf, 0xe8,
+	0x55, 0x18, 0xa5, 0x8c, 0x5a, 0x65, 0x4b, 0xad, 0x1a, 0x35, 0xc3, 0xe5, 0x2a, 0xa0, 0x64, 0x65,
+	0xda, 0x85, 0xe1, 0x62, 0x09, 0x17, 0x2b, 0x5b, 0xcb, 0xb4, 0x02, 0x0f, 0x6b, 0xba, 0xed, 0xfd,
+	0x08, 0xb3, 0xe9, 0xa4, 0xaa, 0xed, 0xca, 0x89, 0x4e, 0xb6, 0x12, 0xad, 0xf0, 0xd8, 0xd8, 0x0f,
+	0xf4, 0x57, 0x90, 0xb4, 0x77, 0xe6, 0x05, 0x4b, 0x92, 0x8d, 0xe8, 0x64, 0xfb, 0x88, 0xe2, 0x1d,
+	0x46, 0xab, 0x24, 0xbc, 0xb1, 0xc4, 0x09, 0x7b, 0x67, 0x9e, 0xf3, 0x7f, 0x1d, 0xc6, 0x19, 0xbf,
+	0x3f, 0x37, 0xd6, 0xc6, 0x86, 0x43, 0x5c, 0x19, 0x98, 0xf4, 0x38, 0xef, 0x6e, 0x1c, 0x67, 0x29,
+	0x83, 0x18, 0xe8, 0x9b, 0x8c, 0x02, 0xdd, 0x81, 0x31, 0x7b, 0x67, 0xa1, 0x63, 0x56, 0x87, 0xfb,
+	0x99, 0xd5, 0xa0, 0x25, 0x19, 0x7b, 0x67, 0xa1, 0x75, 0x06, 0xe7, 0x60, 0x94, 0xe2, 0x6e, 0xd8,
+	0xe4, 0x7b, 0x0d, 0x62, 0x56, 0x76, 0xe5, 0x91, 0x69, 0x69, 0x26, 0xa6, 0x24, 0xf7, 0x95, 0xa1,
+	0x85, 0xd8, 0xcc, 0x47, 0xff, 0x30, 0x84, 0x47, 0xec, 0x9d, 0x85, 0x2b, 0x5e, 0x35, 0x5a, 0x83,
+	0x14, 0xd5, 0x42, 0xbd, 0xe1, 0xee, 0xaa, 0x95, 0xdd, 0x4a, 0x95, 0xc8, 0xa3, 0xac, 0x09, 0x9d,
+	0x6a, 0xbf, 0xb9, 0x69, 0x93, 0x4d, 0xcd, 0x25, 0x7a, 0xa9, 0xe1, 0xee, 0x2e, 0x52, 0xd2, 0x50,
+	0x43, 0x46, 0x6a, 0xda, 0x8e, 0x5f, 0x8e, 0x74, 0x98, 0xb4, 0x09, 0xdd, 0xa4, 0x55, 0x7a, 0x22,
+	0xa8, 0x75, 0x62, 0x1b, 0x96, 0x6e, 0x54, 0x0c, 0x77, 0x57, 0x4e, 0x31, 0xf4, 0x42, 0xc7, 0x20,
+	0x33, 0x72, 0xba, 0x60, 0x97, 0x76, 0xea, 0x96, 0x49, 0x4c, 0x37, 0x04, 0x3e, 0x61, 0xfb, 0xb5,
+	0xab, 0x01, 0x14, 0xda, 0x04, 0x59, 0x48, 0xa9, 0x58, 0x0d, 0xd3, 0x6d, 0x11, 0x93, 0xee, 0xde,
+	0x09, 0x2e, 0x66, 0x91, 0x92, 0x77, 0x91, 0x73, 0xcc, 0x0e, 0xaa, 0xc3, 0x82, 0xbe, 0x09, 0x63,
+	0x75, 0xc3, 0xdc, 0x54, 0x9d, 0xaa, 0xe5, 0x86, 0x46, 0x36, 0xc3, 0x46, 0x76, 0x78, 0x5f, 0x49,
+	0x2c, 0x0c, 0xc9, 0x11, 0x36, 0xb6, 0x59, 0x4a, 0xb7, 0x56, 0xb5, 0xdc, 0x60, 0x80, 0xef, 0xc3,
+	0xf1, 0x80, 0xb9, 0x7d, 0xba, 0xb3, 0xfd, 0x4c, 0x77, 0x54, 0x96, 0xf0, 0x84, 0x07, 0xdc, 0x3a,
+	0xdb, 0xaf, 0x41, 0x66, 0x9d, 0x68, 0x15, 0xcb, 0x0c, 0x35, 0x0b, 0x75, 0x36, 0x2b, 0xcd, 0x89,
+	0x82, 0x46, 0x5d, 0x87, 0x44, 0xe5, 0x91, 0x66, 0x9a, 0xa4, 0xea, 0xc8, 0x63, 0x6c, 0x9b, 0x7b,
+	0xbe, 0xbd, 0x0d, 0x2d, 0x9b, 0xd5, 0xdc, 0x22, 0xa7, 0x66, 0x83, 0xf5, 0x44, 0x8a, 0x26, 0x24,
+	0xec, 0x03, 0xa0, 0x2b, 0x90, 0x6d, 0xd4, 0xe9, 0x5e, 0xa7, 0xea, 0x8f, 0x49, 0xb5, 0xca, 0xe6,
+	0x5c, 0x1e, 0xef, 0xb1, 0x27, 0x2b, 0x96, 0x55, 0xbd, 0xa3, 0x55, 0x1b, 0x04, 0xa7, 0x39, 0x53,
+	0x89, 0xf2, 0xd0, 0xa9, 0x45, 0xd7, 0x60, 0xcc, 0xdb, 0x7c, 0xc3, 0x48, 0x13, 0x87, 0x22, 0x65,
+	0x3d, 0xb6, 0x00, 0x6b, 0x1b, 0x8e, 0xb5, 0x6c, 0x23, 0x2a, 0x11, 0xd3, 0x2d, 0x1f, 0x63, 0x70,
+	0x33, 0x1d, 0xea, 0x1d, 0xec, 0x2c, 0x9e, 0x66, 0x30, 0x70, 0x65, 0xb2, 0xb9, 0x97, 0x1f, 0xeb,
+	0x52, 0x8b, 0xc7, 0x42, 0xfb, 0x8f, 0x57, 0x18, 0x96, 0xcb, 0x36, 0x95, 0x40, 0xee, 0xe4, 0x41,
+	0x72, 0xd9, 0x6e, 0xd2, 0x53, 0x6e, 0x4b, 0xad, 0x27, 0xb7, 0xa5, 0x10, 0x6d, 0x42, 0xbe, 0xa7,
+	0x96, 0xa9, 0xdb, 0x14, 0x50, 0x96, 0x59, 0x03, 0x0a, 0x07, 0xea, 0x1a, 0x1f, 0xcf, 0xa9, 0xae,
+	0xca, 0xc6, 0xea, 0xa6, 0x7e, 0x1d, 0x85, 0xb8, 0x50, 0x06, 0xf4, 0x0a, 0x64, 0xc4, 0xc4, 0x07,
+	0xda, 0x27, 0xb5, 0x6f, 0x37, 0x62, 0x9a, 0x03, 0xdd, 0xfb, 0x3a, 0x20, 0x7f, 0x9a, 0x03, 0xbe,
+	0x68, 0x3b, 0x9f, 0x3f, 0xa9, 0x01, 0xe7, 0x1d, 0x18, 0xab, 0x19, 0x66, 0xc7, 0x22, 0x1a, 0x38,
+	0xe2, 0x9e, 0x59, 0x33, 0xcc, 0xd6, 0x55, 0x44, 0x71, 0xb5, 0x9d, 0x0e, 0xdc, 0xd8, 0x51, 0x71,
+	0xb5, 0x9d, 0x56, 0xdc, 0xe7, 0x60, 0x94, 0x98, 0xda, 0x7a, 0x95, 0xa8, 0x7c, 0x0c, 0xd8, 0x41,
+	0x9a, 0xc0, 0x23, 0xbc, 0xf0, 0x36, 0x2b, 0xbb, 0x14, 0xfb, 0xf8, 0xa3, 0x7c, 0x84, 0xff, 0xbd,
+	0x16, 0x4b, 0x44, 0x33, 0x03, 0xd7, 0x62, 0x89, 0x81, 0x4c, 0xac, 0x50, 0x83, 0xd4, 0x92, 0xa9,
+	0x97, 0x98, 0x47, 0xa1, 0xd8, 0x9a, 0xa9, 0xa3, 0x63, 0x10, 0x35, 0x74, 0x36, 0xc0, 0x49, 0x65,
+	0xa8, 0xb9, 0x97, 0x8f, 0x96, 0x4b, 0x38, 0x6a, 0xe8, 0x08, 0x41, 0xcc, 0xd4, 0x6a, 0x84, 0x0d,
+	0x61, 0x12, 0xb3, 0x6f, 0x74, 0x1c, 0x06, 0x1a, 0x76, 0x95, 0x0d, 0x4d, 0x52, 0x89, 0x37, 0xf7,
+	0xf2, 0x03, 0xb7, 0xf1, 0x32, 0xa6, 0x65, 0x68, 0x1c, 0x06, 0xab, 0xd6, 0xa6, 0xe5, 0xc8, 0xb1,
+	0xe9, 0x81, 0x99, 0x24, 0xe6, 0x3f, 0x0a, 0xff, 0x2a, 0x85, 0xe4, 0xad, 0x58, 0x3a, 0xa9, 0xa2,
+	0x15, 0x48, 0xac, 0x53, 0xc1, 0xaa, 0x2f, 0x75, 0x61, 0x5f, 0x39, 0x63, 0x17, 0xe4, 0x33, 0x0b,
+	0xb9, 0x87, 0xf7, 0xb5, 0xd9, 0xef, 0xbf, 0x3c, 0xfb, 0x8d, 0xb7, 0x67, 0x2e, 0x5f, 0xba, 0x3f,
+	0xfb, 0xf6, 0x65, 0xef, 0xe7, 0xd9, 0x77, 0x17, 0xce, 0xbf, 0x7f, 0x86, 0xda, 0x31, 0xac, 0xcd,
+	0xe5, 0x12, 0x8e, 0x33, 0x8c, 0xb2, 0x8e, 0xde, 0x60, 0xcd, 0x67, 0x8d, 0x54, 0x66, 0xfb, 0x07,
+	0x6a, 0xef, 0xe5, 0x40, 0xd0, 0xcb, 0xc2, 0x7f, 0x44, 0xe1, 0x84, 0xdf, 0xe8, 0x3b, 0xc4, 0xa6,
+	0xe6, 0x6d, 0x39, 0x70, 0x20, 0xbe, 0xec, 0x1e, 0xac, 0x40, 0xa2, 0x46, 0x47, 0x46, 0xf5, 0xfb,
+	0x71, 0x14, 0x38, 0x36, 0xa8, 0x14, 0x8e, 0x61, 0x94, 0x75, 0x74, 0x16, 0x32, 0x8f, 0x34, 0x5b,
+	0x7f, 0xac, 0xd9, 0x44, 0xdd, 0xe6, 0x8d, 0x17, 0xbd, 0x4b, 0x7b, 0xe5, 0xa2, 0x4f, 0x94, 0x74,
+	0xc3, 0xb0, 0x6b, 0x2d, 0xa4, 0x31, 0x4e, 0xea, 0x95, 0x7b, 0xa4, 0xe7, 0x20, 0xbe, 0x2e, 0xba,
+	0x3c, 0xc8, 0xda, 0x98, 0xdd, 0x57, 0x62, 0x76, 0x54, 0x9e, 0x6e, 0xee, 0xe5, 0x87, 0x14, 0xde,
+	0xa3, 0xa1, 0x75, 0xd6, 0xa1, 0xc2, 0xaf, 0x87, 0x20, 0xd3, 0x3e, 0x7e, 0xe8, 0x26, 0x0c, 0x18,
+	0xba, 0xc3, 0xc6, 0x6b, 0x78, 0xe1, 0xa5, 0x76, 0xed, 0x3f, 0x60, 0xb8, 0xbb, 0x38, 0x15, 0x14,
+	0x09, 0xa9, 0x90, 0x16, 0x00, 0x7e, 0xdb, 0xa3, 0x6c, 0x69, 0x4d, 0x75, 0x39, 0x73, 0x04, 0xac,
+	0x32, 0xe5, 0xad, 0xab, 0xe6, 0x5e, 0x3e, 0xb5, 0x6c, 0x61, 0xed, 0x6e, 0xf1, 0x86, 0xa8, 0xc3,
+	0x29, 0xc1, 0xe2, 0xb5, 0xd8, 0x80, 0x31, 0x4f, 0x40, 0xfd, 0xd1, 0x6e, 0xcb, 0x58, 0x76, 0x11,
+	0xb2, 0x7a, 0xf5, 0x2d, 0x4f, 0xc8, 0xa9, 0x90, 0x90, 0xac, 0x10, 0x12, 0x54, 0xe3, 0xac, 0xe0,
+	0x5a, 0x7d, 0xb4, 0xeb, 0x89, 0xba, 0x02, 0x59, 0x7f, 0xcf, 0x52, 0xeb, 0x55, 0xcd, 0xa4, 0xe3,
+	0xcc, 0x66, 0x82, 0xd9, 0xc7, 0x76, 0x54, 0xfe, 0x16, 0xb5, 0x8f, 0xfd, 0x3d, 0x6b, 0xb5, 0xaa,
+	0x99, 0xe5, 0x12, 0x4e, 0x6f, 0xb4, 0x14, 0xd0, 0xb5, 0x3c, 0x54, 0x7f, 0x64, 0xb9, 0x96, 0x23,
+	0x0f, 0xb2, 0x55, 0x28, 0x7e, 0xa1, 0x19, 0xc8, 0x38, 0x8d, 0x7a, 0xdd, 0xb2, 0x5d, 0x47, 0xad,
+	0x54, 0x35, 0xc7, 0x51, 0xd7, 0x99, 0xed, 0x9c, 0xc0, 0x29, 0xaf, 0x7c, 0x91, 0x16, 0x2b, 0x5d,
+	0x28, 0x2b, 0x72, 0xbc, 0x0b, 0xe5, 0x22, 0x22, 0x30, 0xae, 0x93, 0x0d, 0xad, 0x51, 0x75, 0xd5,
+	0x9a, 0x56, 0x51, 0x1d, 0xe2, 0xba, 0xd4, 0xc5, 0x94, 0x13, 0xdd, 0xdd, 0xc4, 0x95, 0xe2, 0xe2,
+	0x9a, 0x20, 0x51, 0x8e, 0x35, 0xf7, 0xf2, 0xa8, 0xc4, 0x99, 0x43, 0xe5, 0x18, 0x09, 0xc0, 0x15,
+	0xad, 0xe2, 0x95, 0xd1, 0xdd, 0x8e, 0xee, 0xce, 0xc1, 0x96, 0x4e, 0xed, 0xe9, 0x18, 0x1e, 0xa9,
+	0x19, 0x21, 0xc3, 0x83, 0x12, 0x69, 0x3b, 0x21, 0x22, 0x10, 0x44, 0xda, 0x4e, 0x0b, 0x91, 0xdf,
+	0x35, 0x6a, 0x90, 0x31, 0xab, 0x38, 0x81, 0x47, 0xbc, 0xc2, 0x6b, 0x96, 0x61, 0xa2, 0xf3, 0x80,
+	0x6c, 0xe2, 0x10, 0x41, 0xa2, 0x9a, 0x96, 0x59, 0x21, 0x0e, 0xb3, 0x76, 0x13, 0x38, 0xc3, 0x6b,
+	0x28, 0xdd, 0x0d, 0x56, 0x8e, 0x08, 0x78, 0x4d, 0x56, 0x37, 0x2c, 0xbb, 0xa6, 0xb9, 0xd4, 0xaa,
+	0x91, 0x47, 0xbb, 0x9f, 0xc9, 0x2b, 0x3c, 0x02, 0xb0, 0xaa, 0xed, 0x56, 0x2d, 0x4d, 0xbf, 0xe2,
+	0xd3, 0x2b, 0x23, 0x61, 0x05, 0xc7, 0x59, 0x81, 0x18, 0x10, 0xf0, 0x6d, 0xbc, 0xf0, 0x4f, 0xe3,
+	0x30, 0x1c, 0x1a, 0x2d, 0xf4, 0x26, 0xa4, 0xc5, 0x5c, 0x32, 0x8b, 0xc6, 0x6a, 0xb8, 0x62, 0x75,
+	0x1d, 0xef, 0x30, 0x6a, 0x4a, 0x22, 0x42, 0xa3, 0xc4, 0x7e, 0x42, 0xbd, 0xd5, 0x51, 0xc6, 0xa7,
+	0xdc, 0xe2, 0x5c, 0xe8, 0x2e, 0x4c, 0x04, 0xa7, 0x7c, 0xd8, 0xdc, 0x8d, 0x32, 0xb8, 0x0e, 0x73,
+	0x77, 0x55, 0x9c, 0xe3, 0xdc, 0x98, 0xe5, 0x87, 0xfb, 0x58, 0xbd, 0xa5, 0x90, 0x5b, 0xb8, 0x0f,
+	0x0e, 0x32, 0x52, 0x07, 0xfa, 0x36, 0x1c, 0x7a, 0x58, 0xa9, 0x77, 0xbb, 0xdb, 0xcf, 0x31, 0x86,
+	0x7b, 0xb2, 0x63, 0x0c, 0x6e, 0x97, 0x4d, 0xf7, 0xb5, 0x57, 0xb8, 0x15, 0x14, 0x36, 0x08, 0x3a,
+	0x6d, 0x6b, 0xdc, 0xc5, 0xfc, 0x3d, 0x7e, 0x34, 0xd4, 0x0e, 0xd3, 0xd8, 0x9f, 0xac, 0x8a, 0x3f,
+	0x59, 0x83, 0x47, 0x99, 0xac, 0x45, 0x6f, 0xb2, 0xbe, 0x11, 0xf6, 0x2d, 0x87, 0x44, 0xab, 0xba,
+	0xfb, 0x96, 0x7c, 0xf4, 0x02, 0xb7, 0xf2, 0x4e, 0x0f, 0xb7, 0x32, 0x7e, 0x40, 0xdf, 0x2e, 0x2e,
+	0xf0, 0xbe, 0x1d, 0xe4, 0x74, 0x7e, 0xbb, 0xbb, 0xd3, 0x99, 0xe8, 0x7b, 0x82, 0x3b, 0xfd, 0xcd,
+	0xe5, 0x76, 0x7f, 0x33, 0x79, 0xb4, 0xf1, 0x6f, 0xf5, 0x46, 0x5f, 0x87, 0xa9, 0x0d, 0xad, 0xe2,
+	0x5a, 0xf6, 0xae, 0x5a, 0x67, 0x6b, 0xd8, 0x07, 0x36, 0x88, 0x23, 0xc3, 0xf4, 0xc0, 0x4c, 0x0c,
+	0xcb, 0x82, 0x62, 0x95, 0x11, 0x5c, 0x09, 0xea, 0xd1, 0x8d, 0x0e, 0x5f, 0x76, 0xb8, 0x87, 0xd1,
+	0xdd, 0xe9, 0xcb, 0xf2, 0xfe, 0xb5, 0xba, 0xb1, 0x15, 0x98, 0xf0, 0xf7, 0xa1, 0x8b, 0x0b, 0xea,
+	0xba, 0x21, 0xe2, 0x62, 0xf2, 0xc8, 0x61, 0x2e, 0x89, 0x32, 0x41, 0x4f, 0x94, 0x35, 0xc1, 0x7c,
+	0x71, 0x41, 0x31, 0x58, 0xf4, 0x0c, 0x67, 0x9d, 0xf6, 0x22, 0x74, 0x19, 0xe2, 0x0d, 0x87, 0xa8,
+	0x9a, 0x6e, 0xcb, 0xa3, 0x87, 0xc2, 0x02, 0x3d, 0xc4, 0x6f, 0x3b, 0xa4, 0x58, 0xc2, 0x78, 0xa8,
+	0xe1, 0x90, 0xa2, 0x6e, 0xa3, 0x32, 0xd0, 0xf8, 0x89, 0x5a, 0xd3, 0xec, 0x4d, 0xc3, 0x94, 0x53,
+	0x62, 0x53, 0x6f, 0xc7, 0xb8, 0x52, 0xb5, 0x34, 0xe1, 0x59, 0x8c, 0x36, 0xf7, 0xf2, 0xc9, 0x62,
+	0x09, 0xaf, 0x30, 0x0e, 0x9c, 0xd4, 0x74, 0x9b, 0x7f, 0xa2, 0xd7, 0x61, 0x44, 0xec, 0xa9, 0xbc,
+	0x9f, 0xe9, 0x43, 0x5d, 0x2f, 0xe0, 0xf4, 0xac, 0x27, 0x77, 0x61, 0xd2, 0x71, 0x35, 0xb7, 0xe1,
+	0x74, 0x7a, 0xfd, 0x99, 0xfe, 0x56, 0xd0, 0x04, 0xe7, 0x6f, 0x77, 0xf4, 0xef, 0x80, 0x2c, 0x80,
+	0x3b, 0x1d, 0xfd, 0xec, 0xe1, 0x4b, 0x02, 0x1f, 0xe3, 0xdc, 0x1d, 0x7e, 0xfd, 0x55, 0xc8, 0xea,
+	0xc4, 0x31, 0x6c, 0xa2, 0xab, 0xc1, 0x4a, 0x45, 0x7d, 0xac, 0xd4, 0xb4, 0x60, 0xc3, 0xde, 0x82,
+	0x7d, 0x00, 0x27, 0x5b, 0x90, 0xda, 0x17, 0xee, 0x58, 0x1f, 0xad, 0x94, 0x43, 0xa0, 0xad, 0xcb,
+	0xf6, 0xbb, 0x70, 0x22, 0x40, 0xef, 0x5c, 0xbe, 0xe3, 0x7d, 0x2f, 0xdf, 0x49, 0x5f, 0x44, 0xdb,
+	0x2a, 0xbe, 0x0f, 0x13, 0x61, 0x09, 0xc1, 0x6a, 0x9e, 0x38, 0xda, 0x6a, 0x1e, 0x0b, 0x04, 0x04,
+	0x8b, 0xfa, 0x6d, 0x38, 0xe6, 0x81, 0xb7, 0x2d, 0xcf, 0x63, 0x47, 0x5c, 0x9e, 0x1e, 0xfc, 0x4a,
+	0x78, 0x95, 0xfe, 0xbd, 0x04, 0x39, 0x0f, 0xbf, 0x87, 0xcf, 0x3f, 0x79, 0x44, 0x9f, 0x3f, 0xd7,
+	0xdc, 0xcb, 0x4f, 0x95, 0x38, 0x66, 0x17, 0x22, 0x3c, 0x25, 0xe4, 0x15, 0xbb, 0x44, 0x00, 0xba,
+	0x35, 0xa7, 0x2d, 0x14, 0x20, 0x1f, 0x31, 0x14, 0xd0, 0xd9, 0x9c, 0x16, 0xa2, 0xb6, 0xe6, 0xb4,
+	0xd4, 0xa1, 0x2d, 0x38, 0xed, 0xb5, 0xa6, 0xf7, 0x09, 0x7f, 0xa2, 0x6f, 0x0d, 0xf2, 0xd4, 0x7c,
+	0xb5, 0xeb, 0x41, 0xbf, 0x01, 0x27, 0x3a, 0x85, 0x05, 0xca, 0x74, 0xf2, 0x68, 0xca, 0x24, 0xb7,
+	0xc9, 0x0a, 0x34, 0x4a, 0x03, 0xaf, 0x4e, 0xed, 0x38, 0xff, 0x4f, 0x1d, 0x4d, 0x88, 0xa7, 0x9a,
+	0x4a, 0x9b, 0x19, 0xf0, 0x1d, 0x11, 0x50, 0xae, 0x6e, 0x5a, 0xb6, 0xe1, 0x3e, 0xaa, 0xc9, 0x39,
+	0x86, 0x7b, 0xba, 0xdb, 0xa4, 0x79, 0x34, 0x1c, 0x3c, 0xd3, 0xdc, 0xcb, 0x8f, 0x84, 0x8b, 0x31,
+	0x0d, 0x85, 0xfb, 0xbf, 0x0a, 0xff, 0x3e, 0x06, 0x09, 0x6a, 0x1d, 0xba, 0x9a, 0x4b, 0xd0, 0x3d,
+	0x40, 0x95, 0x86, 0x6d, 0x13, 0xba, 0xab, 0xf9, 0xd1, 0x36, 0x61, 0x1d, 0x9e, 0x3a, 0x30, 0x24,
+	0xd7, 0x6e, 0x8c, 0x0a, 0x98, 0x80, 0x80, 0x62, 0xfb, 0xb3, 0x11, 0x60, 0x47, 0x3f, 0x07, 0xb6,
+	0x37, 0x11, 0x01, 0xb6, 0x02, 0x23, 0x3c, 0xd9, 0xc9, 0x7d, 0x0f, 0xe1, 0x6b, 0x4d, 0xb4, 0xa3,
+	0x72, 0x5f, 0x25, 0x88, 0x91, 0x0c, 0x73, 0x26, 0x56, 0xdc, 0xcd, 0x2f, 0x8c, 0x7d, 0xa9, 0x7e,
+	0xe1, 0xdb, 0x30, 0xe5, 0x67, 0x95, 0x0c, 0xbb, 0x46, 0x74, 0x3f, 0xb9, 0xa3, 0x6a, 0x9e, 0x55,
+	0x77, 0x50, 0xd6, 0x28, 0xc6, 0x32, 0x46, 0x93, 0x5e, 0xf6, 0x89, 0x41, 0x78, 0x79, 0x9d, 0x22,
+	0xcd, 0x39, 0xc8, 0x0c, 0x9e, 0xe6, 0xf3, 0xc4, 0xf9, 0xe4, 0xa7, 0xcd, 0x78, 0x96, 0x6b, 0x8c,
+	0xd6, 0x97, 0xc8, 0xf6, 0x1a, 0xab, 0x15, 0xf9, 0xb3, 0x9e, 0x46, 0x7c, 0xfc, 0x0b, 0x1a, 0xf1,
+	0x04, 0x4e, 0xd6, 0x89, 0xa9, 0x53, 0xec, 0x6e, 0x09, 0x2d, 0x39, 0xd1, 0x1d, 0xbf, 0x6b, 0x3e,
+	0x4b, 0x00, 0x75, 0xa9, 0x43, 0x4b, 0x90, 0x11, 0x69, 0x33, 0x9b, 0x38, 0x75, 0xcb, 0x74, 0x88,
+	0x97, 0x2a, 0xeb, 0x36, 0x6f, 0x8b, 0x56, 0xad, 0xa6, 0x99, 0x3a, 0x4e, 0x73, 0x1e, 0xec, 0xb1,
+	0x50, 0x18, 0xaf, 0xb5, 0x6c, 0xd1, 0x39, 0x2e, 0x37, 0xf0, 0x0e, 0x81, 0x11, 0x3c, 0x58, 0xb0,
+	0xa0, 0x6f, 0x03, 0x12, 0xad, 0x61, 0x6e, 0xa0, 0x56, 0xa9, 0x90, 0xba, 0x2b, 0x0f, 0x77, 0xef,
+	0xaa, 0xb7, 0xec, 0xe6, 0xa8, 0x67, 0x58, 0x64, 0xa4, 0x58, 0x74, 0x26, 0x28, 0x41, 0x2b, 0x30,
+	0xee, 0xb5, 0x8c, 0x61, 0x8a, 0xe6, 0xc9, 0x23, 0xdd, 0xfd, 0x65, 0xca, 0x29, 0x9a, 0x83, 0x91,
+	0x60, 0x0c, 0x95, 0xa1, 0x97, 0xa9, 0x31, 0xaf, 0x3e, 0x36, 0x4c, 0xdd, 0x7a, 0xec, 0xa8, 0xda,
+	0xb6, 0x66, 0x54, 0x69, 0xf8, 0x8f, 0x59, 0x7b, 0x09, 0x8c, 0xec, 0x9d, 0xbb, 0xbc, 0xaa, 0xe8,
+	0xd5, 0xa0, 0x12, 0xa4, 0x6c, 0x52, 0x21, 0x4c, 0x93, 0x78, 0x2a, 0x32, 0x35, 0x3d, 0xd0, 0x6d,
+	0xd1, 0xf2, 0x10, 0xa2, 0x70, 0x57, 0xf1, 0x28, 0x67, 0xe2, 0x85, 0x0e, 0xba, 0x06, 0x19, 0x81,
+	0x12, 0xa4, 0x34, 0xd3, 0x0c, 0x27, 0xdf, 0xb1, 0xd1, 0x0b, 0x02, 0x0f, 0x29, 0xcd, 0x19, 0xbd,
+	0x62, 0x07, 0x55, 0xa1, 0xc0, 0x73, 0xbe, 0x3c, 0x2b, 0xad, 0x1a, 0xa6, 0xe1, 0x1a, 0xf4, 0x80,
+	0x6e, 0x59, 0x51, 0x99, 0x3e, 0x57, 0x54, 0x8e, 0xa5, 0x89, 0x39, 0x54, 0xd9, 0x43, 0x0a, 0x2d,
+	0xac, 0x0f, 0x25, 0xc8, 0xd9, 0xe4, 0x1d, 0x52, 0x71, 0xc5, 0x19, 0xda, 0x76, 0x5e, 0x11, 0x47,
+	0xce, 0x4e, 0x0f, 0x1c, 0x1e, 0x9b, 0x9d, 0xdd, 0x57, 0x46, 0x9e, 0x48, 0xc9, 0x4c, 0xba, 0xe0,
+	0x6f, 0x19, 0x53, 0x58, 0xe0, 0xb6, 0x27, 0x43, 0x89, 0x83, 0xa7, 0x3c, 0x99, 0xc5, 0xb6, 0xb4,
+	0x28, 0x71, 0x50, 0x0d, 0x4e, 0xb5, 0xb4, 0xa8, 0x35, 0x43, 0x4a, 0x1c, 0x19, 0x4d, 0x0f, 0xcc,
+	0x8c, 0x2a, 0x2f, 0xed, 0x2b, 0xc3, 0x4f, 0xa4, 0x44, 0x26, 0x5d, 0xf0, 0xf2, 0x9c, 0xc7, 0x43,
+	0x02, 0xc3, 0x19, 0x52, 0xe2, 0xe0, 0xe3, 0x21, 0x79, 0xad, 0x55, 0xa8, 0x08, 0xe3, 0xbe, 0xb8,
+	0xb0, 0x07, 0x44, 0x73, 0x35, 0x31, 0x25, 0xc5, 0xa5, 0x14, 0x7c, 0xab, 0xcb, 0xa3, 0x0d, 0x3b,
+	0x43, 0xd7, 0x20, 0xc3, 0x77, 0xa7, 0xd0, 0x04, 0x8d, 0xf7, 0x39, 0x41, 0x29, 0xb6, 0x6f, 0x05,
+	0x13, 0x62, 0x81, 0xdf, 0xd6, 0xd0, 0x5c, 0xd8, 0x9a, 0xb9, 0x49, 0x1c, 0x79, 0x82, 0xe9, 0xd4,
+	0x2b, 0x3d, 0xd7, 0x9a, 0x37, 0x00, 0xde, 0x90, 0x62, 0xc6, 0xb6, 0x64, 0xba, 0xf6, 0x2e, 0xcb,
+	0xb8, 0x75, 0xa9, 0x9c, 0xfa, 0xa3, 0x04, 0x10, 0x5a, 0x91, 0xcf, 0x41, 0xbc, 0xce, 0x83, 0x31,
+	0xec, 0x68, 0x1c, 0x61, 0x07, 0xf8, 0xf7, 0x63, 0x99, 0xac, 0x7c, 0x1a, 0x7b, 0x35, 0x68, 0x11,
+	0xe2, 0xde, 0x4a, 0x8d, 0x1e, 0xba, 0x52, 0xdb, 0x4e, 0x38, 0x8f, 0x13, 0xbd, 0xd1, 0xff, 0x15,
+	0x8a, 0x56, 0x04, 0xc6, 0xc6, 0x82, 0x07, 0x96, 0x6d, 0x93, 0x2a, 0xdf, 0x7a, 0x0d, 0x5d, 0x44,
+	0xd9, 0x95, 0xdc, 0xbe, 0x92, 0x7c, 0x22, 0x0d, 0x15, 0x68, 0x84, 0x50, 0xa7, 0x47, 0xd7, 0x62,
+	0x40, 0x56, 0x2e, 0x39, 0x38, 0x15, 0x62, 0x2b, 0xeb, 0xce, 0xd4, 0x2f, 0x25, 0x18, 0x6d, 0x19,
+	0x93, 0x5e, 0xc9, 0x0f, 0xe9, 0x4f, 0x94, 0xfc, 0x88, 0x7e, 0xc1, 0xe4, 0xc7, 0xd4, 0x3d, 0x48,
+	0xb5, 0x4e, 0x2a, 0xba, 0x0a, 0x43, 0x42, 0x65, 0x24, 0xa6, 0x32, 0x2f, 0xf4, 0x54, 0x99, 0x16,
+	0xc6, 0x50, 0xce, 0x51, 0xf0, 0x4f, 0xd9, 0x70, 0xe2, 0x00, 0xad, 0x42, 0x19, 0x18, 0xd8, 0x22,
+	0x22, 0x15, 0x85, 0xe9, 0x27, 0x7a, 0x03, 0x06, 0x79, 0x12, 0x8c, 0x6b, 0xc6, 0x8b, 0xfd, 0x49,
+	0x76, 0x30, 0xe7, 0xba, 0x14, 0xfd, 0xba, 0x24, 0x42, 0x7b, 0xdf, 0x86, 0x6c, 0x87, 0xc5, 0x87,
+	0x5e, 0xf7, 0xd0, 0xf9, 0x64, 0x9c, 0x3c, 0xc8, 0x46, 0x0c, 0x8d, 0x19, 0x67, 0x2a, 0xfc, 0x74,
+	0x10, 0xe8, 0x05, 0x85, 0x12, 0xa9, 0x18, 0xcc, 0x6a, 0x29, 0x41, 0x32, 0xb0, 0x3a, 0x8f, 0x86,
+	0x18, 0x30, 0x22, 0x19, 0xe2, 0xde, 0xe1, 0x11, 0x65, 0xb6, 0x88, 0xf7, 0x93, 0x2e, 0x26, 0x3a,
+	0xe1, 0x8e, 0x69, 0x33, 0x2d, 0x8f, 0xf2, 0xa0, 0xc2, 0x8a, 0xb6, 0xb3, 0x76, 0x03, 0xe3, 0xa1,
+	0x9a, 0xb6, 0xb3, 0x66, 0xda, 0xe8, 0x2c, 0x24, 0x1f, 0x5b, 0xb6, 0xe3, 0x32, 0xb2, 0x18, 0x23,
+	0x1b, 0x69, 0xee, 0xe5, 0x13, 0x77, 0x69, 0x21, 0x25, 0x4c, 0xb0, 0x6a, 0x4a, 0x7a, 0x0c, 0x86,
+	0x44, 0xec, 0x81, 0x5a, 0x54, 0x51, 0x2c, 0x7e, 0xa1, 0x13, 0x90, 0xac, 0x5a, 0x8e, 0xc3, 0x94,
+	0x8a, 0xd9, 0x43, 0x51, 0x9c, 0xa0, 0x05, 0x74, 0x78, 0xd1, 0x43, 0x98, 0xf4, 0xec, 0xde, 0x76,
+	0xcd, 0x8b, 0x1f, 0x4d, 0xf3, 0xc6, 0x05, 0x4e, 0xab, 0x56, 0xbf, 0x0e, 0xc7, 0x3c, 0xfc, 0xb6,
+	0xcb, 0x2c, 0x89, 0xf0, 0xd5, 0x8c, 0x34, 0x1e, 0x13, 0x64, 0x2d, 0xb7, 0x57, 0xe6, 0x21, 0xe3,
+	0x71, 0xfb, 0x37, 0x58, 0x92, 0xad, 0x7c, 0x29, 0x41, 0xe0, 0xdd, 0x5b, 0x79, 0x08, 0x9e, 0x73,
+	0xdd, 0xd1, 0x21, 0x38, 0x62, 0x87, 0x04, 0x4e, 0x47, 0x87, 0x3c, 0xfc, 0xb6, 0x0e, 0x0d, 0xb7,
+	0x75, 0x48, 0x90, 0xb5, 0x77, 0xc8, 0xe3, 0xf6, 0x3b, 0x34, 0xd2, 0xd6, 0x21, 0x41, 0x20, 0x3a,
+	0x24, 0xf4, 0xfd, 0x53, 0x29, 0x94, 0x61, 0x2b, 0x36, 0xdc, 0x47, 0xc4, 0x74, 0x85, 0x41, 0xb8,
+	0x68, 0xe9, 0x04, 0xcd, 0x86, 0x55, 0x3f, 0xa9, 0x4c, 0xee, 0x2b, 0xe3, 0x36, 0x5a, 0xc8, 0x3c,
+	0xbc, 0x5f, 0x9c, 0xbd, 0x47, 0xd3, 0x5f, 0xef, 0xce, 0x9f, 0xbf, 0xb8, 0xf0, 0xfe, 0x19, 0xa1,
+	0xeb, 0xe8, 0x32, 0x00, 0xbb, 0xd2, 0xa8, 0x6e, 0xd8, 0x56, 0x4d, 0x8e, 0xf6, 0x79, 0x1c, 0x25,
+	0x19, 0xcf, 0x15, 0xdb, 0xaa, 0xa1, 0x6f, 0x42, 0x82, 0x03, 0xb8, 0x96, 0x3c, 0xd0, 0x27, 0x7b,
+	0x9c, 0x71, 0xdc, 0xb2, 0x44, 0x97, 0xfe, 0x30, 0x0d, 0x49, 0xbf, 0x4b, 0xe8, 0x6a, 0x38, 0xdb,
+	0x75, 0xa6, 0x67, 0xb6, 0xab, 0x8f, 0x34, 0xd7, 0x22, 0x40, 0xc5, 0x26, 0x9a, 0xb8, 0x93, 0x16,
+	0x3d, 0xca, 0x9d, 0x34, 0xc1, 0x57, 0x74, 0x29, 0x48, 0xa3, 0xae, 0x7b, 0x20, 0x03, 0x47, 0x01,
+	0x11, 0x7c, 0x45, 0x17, 0x9d, 0x10, 0xa9, 0x52, 0x9e, 0x97, 0x8a, 0xf3, 0xbc, 0xd4, 0x82, 0xc8,
+	0x0c, 0x9f, 0x83, 0x61, 0x9d, 0x38, 0x15, 0xdb, 0xa8, 0xd3, 0x49, 0x14, 0x39, 0x42, 0xaa, 0x7d,
+	0xf6, 0x80, 0xfc, 0x69, 0x1a, 0x87, 0x2b, 0xd1, 0x63, 0x00, 0xcd, 0x75, 0x6d, 0x63, 0xbd, 0xe1,
+	0x12, 0x7a, 0x87, 0x8b, 0xee, 0xda, 0x67, 0x7b, 0x8e, 0xd1, 0x5c, 0xd1, 0xa7, 0x65, 0xfb, 0xb0,
+	0x72, 0x7e, 0x5f, 0x39, 0xfb, 0x53, 0xe9, 0x85, 0x42, 0x5f, 0x29, 0x52, 0x1c, 0x12, 0x85, 0x1e,
+	0xc0, 0xb0, 0x70, 0x09, 0xd9, 0x19, 0x1a, 0x3f, 0x7a, 0x2e, 0x32, 0x45, 0xef, 0x98, 0x79, 0xe5,
+	0x25, 0x07, 0xc3, 0xb6, 0x47, 0xe3, 0xa0, 0x32, 0x20, 0x87, 0xd8, 0x94, 0x51, 0xad, 0xdb, 0xd6,
+	0x86, 0x51, 0x25, 0x34, 0x8b, 0x97, 0x60, 0x23, 0x71, 0x22, 0xc8, 0xe2, 0x65, 0xd6, 0x38, 0xd1,
+	0x2a, 0xa7, 0x29, 0x97, 0x70, 0xc6, 0x69, 0x2d, 0xd1, 0xd1, 0x7f, 0x49, 0x70, 0xcc, 0x33, 0x8a,
+	0x69, 0x25, 0xb1, 0xd9, 0xd5, 0x4e, 0xe2, 0xf0, 0x0d, 0x23, 0xa9, 0xfc, 0xa3, 0xb4, 0xaf, 0xfc,
+	0x58, 0xb2, 0x7f, 0x28, 0x2d, 0xfc, 0x8d, 0xf4, 0x70, 0xe6, 0xf2, 0x25, 0xda, 0x77, 0x6d, 0xf6,
+	0xfb, 0x62, 0x79, 0xbc, 0x17, 0xfa, 0x0e, 0x3e, 0x1f, 0xcc, 0xbe, 0x7d, 0x2e, 0x54, 0x71, 0xf6,
+	0xc1, 0xdc, 0xd9, 0x73, 0x94, 0xaf, 0x38, 0x7b, 0x4f, 0x0c, 0xd9, 0x7b, 0xa1, 0xef, 0xe0, 0x93,
+	0xf1, 0x05, 0x15, 0x67, 0x67, 0x2e, 0x5f, 0xba, 0x74, 0x5f, 0xac, 0xc2, 0x57, 0xdf, 0x3f, 0x7b,
+	0xf9, 0xcc, 0x7b, 0x0f, 0xcf, 0xe0, 0x71, 0xd1, 0xdc, 0x35, 0xd6, 0xda, 0x22, 0x6f, 0x2c, 0xba,
+	0x07, 0x72, 0x5b, 0x37, 0xb6, 0xc8, 0x96, 0x5a, 0xd5, 0xd6, 0x49, 0x55, 0xbe, 0xc0, 0x3a, 0x72,
+	0x9a, 0xab, 0xc8, 0x07, 0x34, 0xbc, 0x31, 0x71, 0x23, 0x8c, 0x71, 0x7d, 0xe9, 0xfa, 0x32, 0x25,
+	0xc4, 0x13, 0x2d, 0xd0, 0xd7, 0xc9, 0x16, 0x2b, 0x46, 0xff, 0x23, 0xc1, 0x54, 0xd8, 0x21, 0x6d,
+	0x1b, 0x27, 0xf8, 0x6a, 0x8e, 0x93, 0x1c, 0x6a, 0x72, 0xeb, 0x58, 0x6d, 0xc0, 0xc9, 0x2e, 0xdd,
+	0x09, 0xc6, 0xeb, 0x65, 0xd6, 0xa1, 0xe7, 0x43, 0xe3, 0x75, 0xbc, 0xd8, 0x8e, 0xe5, 0x8f, 0xd9,
+	0xf1, 0x0e, 0x31, 0xfe, 0xb8, 0x61, 0x98, 0xe8, 0x22, 0xc7, 0xd0, 0xe5, 0x79, 0x26, 0x20, 0xb7,
+	0xaf, 0x78, 0xd6, 0xe4, 0x58, 0x07, 0x7e, 0xb9, 0x84, 0xc7, 0x3a, 0x90, 0xcb, 0x3a, 0xfa, 0xa5,
+	0x04, 0x63, 0xcc, 0xa9, 0x6d, 0x9b, 0x84, 0xe1, 0xaf, 0xe6, 0x24, 0x64, 0x69, 0x5b, 0x5b, 0x47,
+	0xdf, 0xa5, 0x66, 0x05, 0xef, 0x15, 0x3d, 0xc3, 0x06, 0xba, 0x45, 0x52, 0x83, 0x2d, 0x69, 0xd9,
+	0x23, 0xfd, 0x3c, 0x3b, 0x52, 0x20, 0x08, 0xcd, 0x43, 0x5c, 0xdc, 0xfa, 0x96, 0x17, 0xd8, 0x66,
+	0x34, 0xd9, 0x19, 0xa6, 0x61, 0xd5, 0xd8, 0xa3, 0xeb, 0x9a, 0xca, 0x1f, 0xed, 0x3b, 0x95, 0x9f,
+	0xea, 0x9a, 0xca, 0xef, 0x12, 0x32, 0x4b, 0xff, 0x39, 0xae, 0x52, 0x64, 0xfe, 0x5c, 0x57, 0x29,
+	0xb2, 0x47, 0xbf, 0x4a, 0xd1, 0x71, 0xef, 0x00, 0xf5, 0x73, 0xef, 0x60, 0xac, 0x9f, 0x7b, 0x07,
+	0xe3, 0x7d, 0xdf, 0x3b, 0x98, 0xe8, 0x71, 0xef, 0xe0, 0x55, 0x48, 0xda, 0x96, 0xe5, 0xaa, 0xcc,
+	0xa9, 0xe4, 0xe9, 0x0e, 0xb9, 0x23, 0xb5, 0x64, 0x59, 0x2e, 0xf5, 0x28, 0x71, 0xc2, 0x16, 0x5f,
+	0xe8, 0x2d, 0x18, 0x32, 0x89, 0x4b, 0x07, 0x64, 0x92, 0xf9, 0xbb, 0xca, 0x6f, 0xf7, 0xf2, 0xaf,
+	0x1e, 0xf5, 0x7d, 0xc0, 0x0d, 0xe2, 0x96, 0x4b, 0xcd, 0xbd, 0xfc, 0x20, 0xfb, 0xc0, 0x83, 0x26,
+	0x71, 0xcb, 0x3a, 0xba, 0x09, 0x23, 0x2d, 0xb7, 0x40, 0xe4, 0xc3, 0x6f, 0x81, 0xd0, 0x38, 0x46,
+	0xf8, 0x42, 0x03, 0x1e, 0xae, 0x85, 0xee, 0x7d, 0x2c, 0x42, 0x92, 0x01, 0xba, 0xd4, 0xce, 0x3f,
+	0xde, 0xbd, 0x8b, 0x9e, 0x7f, 0xc5, 0x9d, 0x08, 0xef, 0x17, 0x4e, 0x50, 0x1c, 0xfa, 0x85, 0xde,
+	0x82, 0xac, 0x17, 0x73, 0x0b, 0xc0, 0xce, 0x1f, 0x02, 0x36, 0x46, 0xf5, 0x63, 0x95, 0xb3, 0xf9,
+	0x98, 0x5e, 0x84, 0x70, 0xc5, 0x83, 0x9e, 0x87, 0xb8, 0xc3, 0xdd, 0x76, 0x79, 0xaa, 0xfb, 0xd2,
+	0x15, 0x5e, 0x3d, 0xf6, 0xe8, 0xd0, 0xb7, 0xc0, 0x43, 0x51, 0x3d, 0xd6, 0x13, 0x07, 0xb3, 0xa6,
+	0x04, 0xbd, 0xf8, 0x8d, 0xce, 0x40, 0xca, 0x8f, 0x0d, 0x33, 0x15, 0x61, 0xc9, 0x8f, 0x51, 0x3c,
+	0x22, 0x22, 0xc2, 0x4c, 0x3d, 0xd0, 0x0b, 0x90, 0x6e, 0x38, 0x44, 0x0f, 0xa8, 0x1c, 0xf9, 0x14,
+	0x8d, 0x23, 0xe1, 0x51, 0x5a, 0xec, 0x91, 0xd1, 0xa7, 0x02, 0x69, 0x86, 0x16, 0x68, 0x9c, 0x9c,
+	0x0b, 0x9e, 0x51, 0xf8, 0xea, 0x86, 0xbe, 0x26, 0xe8, 0xec, 0x77, 0x44, 0xa6, 0xf4, 0x65, 0x39,
+	0x4f, 0xe9, 0x78, 0x4e, 0x62, 0x59, 0x73, 0x5c, 0x7c, 0x8d, 0x65, 0x41, 0x5f, 0xe6, 0x0d, 0xc1,
+	0xef, 0xf0, 0x5f, 0x9d, 0x8c, 0xf3, 0xf2, 0x74, 0x57, 0xc6, 0xf9, 0x16, 0xc6, 0x79, 0xf4, 0x10,
+	0x4e, 0xb4, 0xc7, 0xc0, 0x69, 0xec, 0xd0, 0xd8, 0xe6, 0x06, 0xec, 0xe9, 0xa3, 0xc4, 0xd8, 0xfd,
+	0x40, 0x39, 0x16, 0x08, 0x45, 0x17, 0x2d, 0xc1, 0x30, 0xf7, 0x75, 0xb8, 0x46, 0x14, 0x7a, 0xec,
+	0x43, 0x94, 0x84, 0xeb, 0x44, 0xe0, 0x47, 0x41, 0xdd, 0x2f, 0x45, 0xf7, 0x01, 0xad, 0xb3, 0x2b,
+	0x3a, 0xbb, 0x34, 0xe2, 0x5e, 0x21, 0xa6, 0xab, 0x6d, 0x12, 0xf9, 0xb9, 0xc3, 0x73, 0xe5, 0xe9,
+	0x7d, 0x65, 0x04, 0xe0, 0x54, 0x24, 0xf2, 0xc1, 0xe5, 0xd9, 0x48, 0x24, 0x12, 0xc1, 0x59, 0x81,
+	0xb3, 0xea, 0xc3, 0xa0, 0x17, 0x21, 0xed, 0x07, 0xd9, 0x84, 0x27, 0x7c, 0x66, 0x5a, 0x9a, 0x19,
+	0xc4, 0x29, 0xaf, 0x58, 0xa4, 0xd7, 0x35, 0xba, 0x75, 0x50, 0x2e, 0x16, 0x42, 0xf4, 0xdc, 0xf3,
+	0xe7, 0xfb, 0x88, 0xed, 0x2a, 0xe3, 0xd4, 0x1e, 0xc5, 0x8c, 0xb9, 0x58, 0xc2, 0xbc, 0xce, 0xc1,
+	0x22, 0xc0, 0x5b, 0xd4, 0x6d, 0x51, 0xd2, 0x25, 0x74, 0xfc, 0xc2, 0x97, 0x14, 0x3a, 0x7e, 0xf1,
+	0x73, 0x86, 0x8e, 0x0f, 0x7b, 0x65, 0x33, 0xf3, 0xa5, 0xbc, 0xb2, 0x41, 0x57, 0x01, 0x42, 0x17,
+	0xbb, 0xce, 0x1e, 0xed, 0x62, 0x17, 0x0e, 0xf1, 0xa2, 0x75, 0x48, 0xd5, 0x6d, 0x6b, 0x9b, 0x05,
+	0x63, 0xb8, 0xbd, 0x75, 0x8e, 0x1d, 0x4a, 0xdf, 0xdc, 0x57, 0x5e, 0xb4, 0x9f, 0x97, 0xcf, 0x2c,
+	0x9c, 0x3e, 0xd8, 0x6c, 0x78, 0xef, 0x21, 0xbd, 0xee, 0x39, 0xba, 0x1a, 0x60, 0x94, 0x4b, 0x78,
+	0x34, 0x04, 0x59, 0xd6, 0x51, 0x09, 0xb2, 0x7e, 0x01, 0xdd, 0x65, 0x74, 0xcd, 0xd5, 0xe4, 0x97,
+	0xc4, 0x16, 0xd3, 0xae, 0x8e, 0x6b, 0xec, 0xcd, 0x1d, 0xce, 0x84, 0x39, 0x68, 0x70, 0x00, 0x9d,
+	0x84, 0x64, 0xad, 0x51, 0xa5, 0xfe, 0xb8, 0xe3, 0xca, 0xb3, 0xec, 0x04, 0x0a, 0x0a, 0xd0, 0x26,
+	0x1c, 0xaf, 0x54, 0x35, 0xa3, 0xa6, 0x6a, 0x2d, 0x6e, 0xbb, 0x5a, 0xb1, 0x74, 0x22, 0xcf, 0x1d,
+	0xe2, 0x51, 0x75, 0xba, 0xfa, 0x78, 0x92, 0xa1, 0x75, 0x56, 0xa0, 0x39, 0x18, 0x73, 0xb6, 0x8c,
+	0xba, 0x2a, 0x02, 0xb1, 0x6a, 0xc5, 0xde, 0xad, 0xbb, 0x96, 0x7c, 0x91, 0x35, 0x28, 0x4b, 0xab,
+	0xc4, 0x80, 0x2f, 0xb2, 0x0a, 0x74, 0x1f, 0x4e, 0x76, 0xa1, 0x57, 0xad, 0x6d, 0x62, 0xdb, 0x86,
+	0x4e, 0xe4, 0x57, 0x0e, 0xbd, 0x75, 0x72, 0xbc, 0x03, 0xf4, 0xa6, 0x60, 0x9e, 0x7a, 0x03, 0xd2,
+	0x6d, 0x6e, 0x68, 0x38, 0x1c, 0x98, 0xe4, 0xe1, 0xc0, 0xf1, 0x70, 0x38, 0x30, 0x19, 0x8a, 0xf2,
+	0x4d, 0xdd, 0x81, 0x54, 0xab, 0xc9, 0xd8, 0x85, 0x7b, 0xae, 0x35, 0x98, 0xd8, 0x71, 0x3e, 0x79,
+	0x00, 0x9d, 0xd1, 0xc3, 0xab, 0x00, 0xfe, 0x08, 0x3b, 0xe8, 0x12, 0x0c, 0x07, 0x8f, 0x46, 0xbd,
+	0xa0, 0xe8, 0xf1, 0x9e, 0x53, 0x82, 0x81, 0xf8, 0xbc, 0x05, 0x1d, 0x8e, 0x2d, 0xb2, 0xa0, 0x41,
+	0x50, 0x2d, 0x22, 0xd8, 0xd7, 0x00, 0x02, 0x54, 0xff, 0x9e, 0x61, 0x2f, 0xd0, 0x2e, 0xc1, 0x8c,
+	0xa4, 0x2f, 0xa6, 0xf0, 0x33, 0x09, 0x8e, 0xdd, 0x66, 0x61, 0x85, 0x3f, 0xa5, 0x18, 0x1a, 0x15,
+	0x0a, 0x5e, 0x9e, 0xf6, 0x8c, 0x9c, 0x5c, 0xa1, 0x24, 0x2b, 0x9a, 0xb3, 0xa5, 0xc4, 0x28, 0x08,
+	0x4e, 0x6e, 0x78, 0x05, 0x85, 0x7f, 0x93, 0x60, 0xec, 0x4d, 0xe2, 0x76, 0x34, 0xf2, 0x01, 0xa4,
+	0x82, 0x46, 0xaa, 0x5f, 0x3c, 0xce, 0x33, 0x42, 0x02, 0x3a, 0xe7, 0x8b, 0x37, 0xfb, 0xff, 0x24,
+	0x78, 0x3e, 0xdc, 0xec, 0x90, 0xf0, 0x2b, 0x96, 0xbd, 0x74, 0xbb, 0xec, 0x78, 0x1d, 0xa9, 0x40,
+	0x82, 0x9d, 0xfd, 0xa4, 0x61, 0x88, 0x0c, 0xc8, 0x55, 0xf1, 0x6a, 0xf4, 0xc8, 0x56, 0xe1, 0xd2,
+	0xed, 0xf2, 0x6b, 0xaf, 0xd0, 0xfb, 0xe8, 0xd4, 0x6c, 0x58, 0xba, 0x5d, 0xc6, 0x71, 0x8a, 0xbc,
+	0xd4, 0x30, 0xd0, 0x77, 0x81, 0xbe, 0x24, 0x65, 0x32, 0xf8, 0xcb, 0xd4, 0x37, 0xbf, 0xa8, 0x8c,
+	0xa1, 0x12, 0xd9, 0xa6, 0x22, 0x86, 0x74, 0xb2, 0xbd, 0xd4, 0x30, 0x0a, 0x4f, 0x06, 0x60, 0x62,
+	0xd9, 0x70, 0x82, 0x1e, 0xfb, 0x1d, 0xd4, 0x20, 0x1d, 0x3e, 0x1e, 0x82, 0xa9, 0x7a, 0xe1, 0x80,
+	0x83, 0xe1, 0xe0, 0xc9, 0x4a, 0x69, 0x61, 0xca, 0x2f, 0x3e, 0x5d, 0xe8, 0x23, 0x09, 0x06, 0x2d,
+	0x5b, 0x27, 0xb6, 0x78, 0x56, 0xf1, 0x77, 0xd2, 0xbe, 0xf2, 0xb7, 0x92, 0xfd, 0x03, 0x09, 0x47,
+	0x70, 0xd2, 0xd7, 0x31, 0x0c, 0xb3, 0xc1, 0xb7, 0x3f, 0x6b, 0x38, 0x39, 0xeb, 0x7f, 0x7a, 0xa3,
+	0x8c, 0x13, 0xb3, 0xde, 0x17, 0x0b, 0xcd, 0xe1, 0xc1, 0x59, 0xf6, 0x2f, 0x1c, 0x82, 0xc3, 0x23,
+	0xb3, 0xe1, 0x5f, 0xa1, 0x08, 0x23, 0x1e, 0x9e, 0x0d, 0xfd, 0xe0, 0x0d, 0x43, 0x39, 0x18, 0xe4,
+	0xaf, 0x26, 0xd9, 0xb3, 0x5d, 0x66, 0x0c, 0x9d, 0x1b, 0x90, 0x3f, 0x8b, 0x63, 0x5e, 0x4c, 0x1f,
+	0x51, 0xd4, 0xa9, 0xe5, 0xc3, 0x9f, 0xeb, 0xb2, 0xef, 0xc2, 0x3f, 0x4b, 0x30, 0xb6, 0xd6, 0x65,
+	0xf1, 0x5c, 0x39, 0xda, 0x0a, 0x6f, 0x4d, 0x87, 0x7d, 0x99, 0xab, 0xfb, 0xbf, 0x25, 0xc8, 0xfa,
+	0x72, 0x6e, 0x91, 0x5a, 0xbd, 0x4a, 0x4d, 0xba, 0xaf, 0x4a, 0xf3, 0xd0, 0x0c, 0x0c, 0xd7, 0xb4,
+	0x3a, 0xbb, 0xd2, 0x41, 0x0f, 0x8a, 0x81, 0x70, 0xd0, 0x55, 0xc7, 0x20, 0xea, 0xae, 0x93, 0xdd,
+	0xc2, 0xc7, 0x12, 0x4c, 0x76, 0x74, 0x84, 0x5b, 0x21, 0x7e, 0xcc, 0x56, 0x6a, 0x65, 0xef, 0x1a,
+	0xb3, 0x8d, 0x86, 0x63, 0xb6, 0x9f, 0x48, 0xad, 0x31, 0xdb, 0x5b, 0x90, 0x66, 0x11, 0x4d, 0xb2,
+	0xe3, 0x12, 0xd3, 0x61, 0x51, 0x92, 0x01, 0x96, 0x82, 0x7c, 0x69, 0x5f, 0x99, 0x79, 0x22, 0x3d,
+	0x9f, 0xd1, 0x65, 0xa9, 0x90, 0xb7, 0x4f, 0x2d, 0x9c, 0xa0, 0x11, 0x9e, 0x07, 0x73, 0x9e, 0xf1,
+	0xf2, 0xee, 0xfc, 0xf9, 0xf9, 0xd7, 0xde, 0x3f, 0xfb, 0xee, 0xfc, 0x79, 0x1a, 0xaf, 0x4f, 0x51,
+	0x8c, 0x25, 0x1f, 0xa2, 0xf0, 0x47, 0x09, 0xe4, 0x1e, 0x4d, 0x77, 0xd0, 0xfb, 0x10, 0xe7, 0xf6,
+	0x93, 0x77, 0x88, 0xbd, 0xda, 0x73, 0x1e, 0xda, 0x58, 0xe7, 0xc4, 0xff, 0xcf, 0x13, 0x9d, 0xf1,
+	0x64, 0x4e, 0x55, 0x60, 0x24, 0x0c, 0xd3, 0xe5, 0xc4, 0x3e, 0x2c, 0xfd, 0xd7, 0xa3, 0x79, 0xa1,
+	0x03, 0xbc, 0xf0, 0x43, 0x09, 0xf2, 0x8b, 0x96, 0xb9, 0x4d, 0x6c, 0xb7, 0x83, 0xda, 0x5b, 0x31,
+	0xab, 0x90, 0xe4, 0x6d, 0x0a, 0xde, 0x1b, 0x5d, 0xec, 0xff, 0x81, 0x50, 0x82, 0x0b, 0x2d, 0x97,
+	0x70, 0x82, 0xa3, 0x94, 0xd9, 0xa3, 0x27, 0x66, 0x1a, 0xb2, 0xfd, 0x18, 0xb3, 0x6f, 0xba, 0x1c,
+	0x82, 0x47, 0x3b, 0x45, 0xbb, 0xf2, 0xc8, 0xd8, 0x26, 0x34, 0xdd, 0xe7, 0xc5, 0x6a, 0x24, 0x9e,
+	0xee, 0x13, 0x3f, 0xd1, 0x6b, 0x90, 0xa0, 0x6c, 0x4c, 0x37, 0x7b, 0xe4, 0xc5, 0xaf, 0x93, 0xdd,
+	0x25, 0x73, 0x9b, 0x54, 0xad, 0x3a, 0xc1, 0x71, 0x4a, 0x7c, 0x9d, 0xec, 0xa2, 0x7c, 0xab, 0x75,
+	0x32, 0xc0, 0x5a, 0x10, 0x32, 0x41, 0xe8, 0xc2, 0x09, 0xe5, 0x3b, 0x62, 0xfd, 0xe6, 0x72, 0xfc,
+	0x5c, 0x47, 0xe1, 0xc3, 0x28, 0x4c, 0xd2, 0x7b, 0x8a, 0xf6, 0x5f, 0xe6, 0x3c, 0x50, 0x01, 0x42,
+	0x86, 0x41, 0x94, 0xad, 0x91, 0x6f, 0xed, 0x2b, 0x2f, 0x3f, 0x91, 0x66, 0x13, 0x52, 0xe6, 0xb3,
+	0xb8, 0x2c, 0x15, 0xfa, 0x9d, 0xba, 0xa4, 0x38, 0xbf, 0x4b, 0x8e, 0x77, 0x10, 0x50, 0x01, 0xf3,
+	0x90, 0x0c, 0x82, 0xc2, 0x7c, 0x5b, 0x18, 0x0f, 0x05, 0x85, 0x13, 0x7e, 0x0c, 0x38, 0xb1, 0x25,
+	0x42, 0xbe, 0x85, 0xff, 0x94, 0x60, 0xb2, 0x5c, 0xfb, 0x8b, 0x0d, 0x49, 0x09, 0xe2, 0x1a, 0x57,
+	0x28, 0xa1, 0x2a, 0xd3, 0xbd, 0x1d, 0x04, 0x4e, 0xa7, 0x24, 0x3c, 0x50, 0xec, 0xb1, 0x9e, 0xbb,
+	0x02, 0x10, 0x38, 0xe4, 0x28, 0x0b, 0xa3, 0xab, 0x37, 0xef, 0x2e, 0x61, 0xf5, 0xf6, 0x8d, 0xeb,
+	0x37, 0x6e, 0xde, 0xbd, 0x91, 0x89, 0x04, 0x45, 0x4a, 0xf1, 0xd6, 0xad, 0x25, 0xfc, 0x56, 0x46,
+	0x42, 0x08, 0x52, 0xbc, 0x68, 0xe9, 0x3b, 0xb7, 0x96, 0xf0, 0x8d, 0xe2, 0x72, 0x26, 0x7a, 0x4e,
+	0x83, 0x96, 0x6b, 0x94, 0xe8, 0x38, 0x4c, 0x14, 0x4b, 0x58, 0x2d, 0x2e, 0xbf, 0x79, 0x13, 0x97,
+	0x6f, 0x5d, 0x5d, 0x51, 0x4b, 0x4b, 0x57, 0x8a, 0xb7, 0x97, 0x6f, 0x65, 0x22, 0xe8, 0x24, 0xc8,
+	0xad, 0x55, 0xcb, 0x37, 0xd7, 0xd6, 0xd4, 0xe2, 0xdd, 0x22, 0x5e, 0xca, 0x48, 0x48, 0x86, 0xf1,
+	0xd6, 0xda, 0x95, 0x9b, 0x4a, 0x79, 0x79, 0x29, 0x13, 0x55, 0x7e, 0x26, 0xdd, 0xbb, 0x70, 0x04,
+	0xe3, 0xc6, 0x35, 0xeb, 0xeb, 0x9f, 0x3c, 0xcd, 0x49, 0x9f, 0x3e, 0xcd, 0x49, 0xbf, 0x79, 0x9a,
+	0x8b, 0xfc, 0xee, 0x69, 0x2e, 0xf2, 0xd9, 0xd3, 0x5c, 0xe4, 0xf7, 0x4f, 0x73, 0x91, 0x3f, 0x3c,
+	0xcd, 0x49, 0x1f, 0x34, 0x73, 0xd2, 0x8f, 0x9a, 0xb9, 0xc8, 0xcf, 0x9b, 0x39, 0xe9, 0x17, 0xcd,
+	0x5c, 0xe4, 0xe3, 0x66, 0x2e, 0xf2, 0xab, 0x66, 0x2e, 0xf2, 0x49, 0x33, 0x27, 0x7d, 0xda, 0xcc,
+	0x49, 0xbf, 0x69, 0xe6, 0x22, 0xbf, 0x6b, 0xe6, 0xa4, 0xcf, 0x9a, 0xb9, 0xc8, 0xef, 0x9b, 0x39,
+	0xe9, 0x0f, 0xcd, 0x5c, 0xe4, 0x83, 0x67, 0xb9, 0xc8, 0x8f, 0x9e, 0xe5, 0xa4, 0x0f, 0x9f, 0xe5,
+	0x22, 0x3f, 0x79, 0x96, 0x93, 0x3e, 0x7a, 0x96, 0x8b, 0xfc, 0xfc, 0x59, 0x2e, 0xf2, 0x8b, 0x67,
+	0x39, 0xe9, 0xe3, 0x67, 0x39, 0xe9, 0x57, 0xcf, 0x72, 0xd2, 0xfa, 0x10, 0x5b, 0x4f, 0x17, 0xff,
+	0x7f, 0x00, 0x4f, 0x4d, 0xbc, 0xf2, 0x78, 0x46, 0x00, 0x00,
+}
+
+func (x PowerState) String() string {
+	s, ok := PowerState_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ADRAlgorithm) String() string {
+	s, ok := ADRAlgorithm_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *Session) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Session)
+	if !ok {
 		that2, ok := that.(Session)
 		if ok {
 			that1 = &that2
@@ -3419,6 +3887,9 @@ func (this *EndDeviceVersionIdentifiers) Equal(that interface{}) bool {
 	if this.FirmwareVersion != that1.FirmwareVersion {
 		return false
 	}
+	if this.BandID != that1.BandID {
+		return false
+	}
 	return true
 }
 func (this *EndDeviceVersion) Equal(that interface{}) bool {
@@ -3612,6 +4083,9 @@ func (this *MACSettings) Equal(that interface{}) bool {
 	if !this.DesiredBeaconFrequency.Equal(that1.DesiredBeaconFrequency) {
 		return false
 	}
+	if !this.ADRAlgorithm.Equal(that1.ADRAlgorithm) {
+		return false
+	}
 	return true
 }
 func (this *MACState) Equal(that interface{}) bool {
@@ -3844,6 +4318,87 @@ func (this *MACState_DataRateRanges) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ADRAlgorithmValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ADRAlgorithmValue)
+	if !ok {
+		that2, ok := that.(ADRAlgorithmValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
+}
+func (this *ADRDecision) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ADRDecision)
+	if !ok {
+		that2, ok := that.(ADRDecision)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Algorithm != that1.Algorithm {
+		return false
+	}
+	if this.Uplinks != that1.Uplinks {
+		return false
+	}
+	if this.MaxSNR != that1.MaxSNR {
+		return false
+	}
+	if this.WorstSNR != that1.WorstSNR {
+		return false
+	}
+	if this.Margin != that1.Margin {
+		return false
+	}
+	if this.LossRate != that1.LossRate {
+		return false
+	}
+	if this.CurrentDataRateIndex != that1.CurrentDataRateIndex {
+		return false
+	}
+	if this.CurrentTxPowerIndex != that1.CurrentTxPowerIndex {
+		return false
+	}
+	if this.CurrentNbTrans != that1.CurrentNbTrans {
+		return false
+	}
+	if this.DesiredDataRateIndex != that1.DesiredDataRateIndex {
+		return false
+	}
+	if this.DesiredTxPowerIndex != that1.DesiredTxPowerIndex {
+		return false
+	}
+	if this.DesiredNbTrans != that1.DesiredNbTrans {
+		return false
+	}
+	return true
+}
 func (this *EndDeviceAuthenticationCode) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -4420,30 +4975,129 @@ func (this *ConvertEndDeviceTemplateRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *Session) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *EndDeviceArchive) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Session) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QueuedApplicationDownlinks) > 0 {
-		for iNdEx := len(m.QueuedApplicationDownlinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueuedApplicationDownlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+	that1, ok := that.(*EndDeviceArchive)
+	if !ok {
+		that2, ok := that.(EndDeviceArchive)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if !this.DataKey.Equal(that1.DataKey) {
+		return false
+	}
+	if !bytes.Equal(this.EndDevices, that1.EndDevices) {
+		return false
+	}
+	if that1.CreatedAt == nil {
+		if this.CreatedAt != nil {
+			return false
+		}
+	} else if !this.CreatedAt.Equal(*that1.CreatedAt) {
+		return false
+	}
+	return true
+}
+func (this *ExportEndDevicesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExportEndDevicesRequest)
+	if !ok {
+		that2, ok := that.(ExportEndDevicesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if len(this.DeviceIDs) != len(that1.DeviceIDs) {
+		return false
+	}
+	for i := range this.DeviceIDs {
+		if this.DeviceIDs[i] != that1.DeviceIDs[i] {
+			return false
+		}
+	}
+	if this.KEKLabel != that1.KEKLabel {
+		return false
+	}
+	return true
+}
+func (this *ImportEndDevicesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ImportEndDevicesRequest)
+	if !ok {
+		that2, ok := that.(ImportEndDevicesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if !this.Archive.Equal(that1.Archive) {
+		return false
+	}
+	return true
+}
+func (m *Session) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Session) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueuedApplicationDownlinks) > 0 {
+		for iNdEx := len(m.QueuedApplicationDownlinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedApplicationDownlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4871,6 +5525,13 @@ func (m *EndDeviceVersionIdentifiers) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.BandID) > 0 {
+		i -= len(m.BandID)
+		copy(dAtA[i:], m.BandID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.BandID)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.FirmwareVersion) > 0 {
 		i -= len(m.FirmwareVersion)
 		copy(dAtA[i:], m.FirmwareVersion)
@@ -5053,6 +5714,20 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ADRAlgorithm != nil {
+		{
+			size, err := m.ADRAlgorithm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
 	if m.DesiredBeaconFrequency != nil {
 		{
 			size, err := m.DesiredBeaconFrequency.MarshalToSizedBuffer(dAtA[:i])
@@ -5839,6 +6514,121 @@ func (m *MACState_DataRateRanges) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ADRAlgorithmValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ADRAlgorithmValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRAlgorithmValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ADRDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ADRDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DesiredNbTrans != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DesiredNbTrans))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.DesiredTxPowerIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DesiredTxPowerIndex))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.DesiredDataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DesiredDataRateIndex))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CurrentNbTrans != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.CurrentNbTrans))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CurrentTxPowerIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.CurrentTxPowerIndex))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CurrentDataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.CurrentDataRateIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LossRate != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.LossRate)))
+		i--
+		dAtA[i] = 0x35
+	}
+	if m.Margin != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Margin)))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if m.WorstSNR != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.WorstSNR)))
+		i--
+		dAtA[i] = 0x25
+	}
+	if m.MaxSNR != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.MaxSNR)))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.Uplinks != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Uplinks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Algorithm != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EndDeviceAuthenticationCode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6980,42 +7770,193 @@ func (m *ConvertEndDeviceTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEndDevice(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEndDevice(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EndDeviceArchive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func NewPopulatedSession(r randyEndDevice, easy bool) *Session {
-	this := &Session{}
-	v1 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddr(r)
-	this.DevAddr = *v1
-	v2 := NewPopulatedSessionKeys(r, easy)
-	this.SessionKeys = *v2
-	this.LastFCntUp = r.Uint32()
-	this.LastNFCntDown = r.Uint32()
-	this.LastAFCntDown = r.Uint32()
-	this.LastConfFCntDown = r.Uint32()
-	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.StartedAt = *v3
-	if r.Intn(5) != 0 {
-		v4 := r.Intn(5)
-		this.QueuedApplicationDownlinks = make([]*ApplicationDownlink, v4)
-		for i := 0; i < v4; i++ {
-			this.QueuedApplicationDownlinks[i] = NewPopulatedApplicationDownlink(r, easy)
+
+func (m *EndDeviceArchive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceArchive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != nil {
+		n79, err79 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err79 != nil {
+			return 0, err79
 		}
+		i -= n79
+		i = encodeVarintEndDevice(dAtA, i, uint64(n79))
+		i--
+		dAtA[i] = 0x22
 	}
-	if !easy && r.Intn(10) != 0 {
+	if len(m.EndDevices) > 0 {
+		i -= len(m.EndDevices)
+		copy(dAtA[i:], m.EndDevices)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.EndDevices)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return this
+	if m.DataKey != nil {
+		{
+			size, err := m.DataKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedEndDeviceBrand(r randyEndDevice, easy bool) *EndDeviceBrand {
+func (m *ExportEndDevicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KEKLabel) > 0 {
+		i -= len(m.KEKLabel)
+		copy(dAtA[i:], m.KEKLabel)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.KEKLabel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DeviceIDs) > 0 {
+		for iNdEx := len(m.DeviceIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeviceIDs[iNdEx])
+			copy(dAtA[i:], m.DeviceIDs[iNdEx])
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(m.DeviceIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportEndDevicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Archive != nil {
+		{
+			size, err := m.Archive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEndDevice(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEndDevice(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedSession(r randyEndDevice, easy bool) *Session {
+	this := &Session{}
+	v1 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddr(r)
+	this.DevAddr = *v1
+	v2 := NewPopulatedSessionKeys(r, easy)
+	this.SessionKeys = *v2
+	this.LastFCntUp = r.Uint32()
+	this.LastNFCntDown = r.Uint32()
+	this.LastAFCntDown = r.Uint32()
+	this.LastConfFCntDown = r.Uint32()
+	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.StartedAt = *v3
+	if r.Intn(5) != 0 {
+		v4 := r.Intn(5)
+		this.QueuedApplicationDownlinks = make([]*ApplicationDownlink, v4)
+		for i := 0; i < v4; i++ {
+			this.QueuedApplicationDownlinks[i] = NewPopulatedApplicationDownlink(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDeviceBrand(r randyEndDevice, easy bool) *EndDeviceBrand {
 	this := &EndDeviceBrand{}
 	this.ID = randStringEndDevice(r)
 	this.Name = randStringEndDevice(r)
@@ -7046,6 +7987,7 @@ func NewPopulatedEndDeviceVersionIdentifiers(r randyEndDevice, easy bool) *EndDe
 	this.ModelID = randStringEndDevice(r)
 	this.HardwareVersion = randStringEndDevice(r)
 	this.FirmwareVersion = randStringEndDevice(r)
+	this.BandID = randStringEndDevice(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -7139,6 +8081,9 @@ func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
 	if r.Intn(5) != 0 {
 		this.DesiredBeaconFrequency = types.NewPopulatedUInt64Value(r, easy)
 	}
+	if r.Intn(5) != 0 {
+		this.ADRAlgorithm = NewPopulatedADRAlgorithmValue(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -7188,6 +8133,14 @@ func NewPopulatedMACState_DataRateRanges(r randyEndDevice, easy bool) *MACState_
 	return this
 }
 
+func NewPopulatedADRAlgorithmValue(r randyEndDevice, easy bool) *ADRAlgorithmValue {
+	this := &ADRAlgorithmValue{}
+	this.Value = ADRAlgorithm([]int32{0, 1, 2}[r.Intn(3)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedEndDevices(r randyEndDevice, easy bool) *EndDevices {
 	this := &EndDevices{}
 	if r.Intn(5) != 0 {
@@ -7322,6 +8275,52 @@ func NewPopulatedConvertEndDeviceTemplateRequest(r randyEndDevice, easy bool) *C
 	return this
 }
 
+func NewPopulatedEndDeviceArchive(r randyEndDevice, easy bool) *EndDeviceArchive {
+	this := &EndDeviceArchive{}
+	this.Version = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.DataKey = NewPopulatedKeyEnvelope(r, easy)
+	}
+	v29 := r.Intn(100)
+	this.EndDevices = make([]byte, v29)
+	for i := 0; i < v29; i++ {
+		this.EndDevices[i] = byte(r.Intn(256))
+	}
+	if r.Intn(5) != 0 {
+		this.CreatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedExportEndDevicesRequest(r randyEndDevice, easy bool) *ExportEndDevicesRequest {
+	this := &ExportEndDevicesRequest{}
+	v30 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v30
+	v31 := r.Intn(10)
+	this.DeviceIDs = make([]string, v31)
+	for i := 0; i < v31; i++ {
+		this.DeviceIDs[i] = randStringEndDevice(r)
+	}
+	this.KEKLabel = randStringEndDevice(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedImportEndDevicesRequest(r randyEndDevice, easy bool) *ImportEndDevicesRequest {
+	this := &ImportEndDevicesRequest{}
+	v32 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v32
+	if r.Intn(5) != 0 {
+		this.Archive = NewPopulatedEndDeviceArchive(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyEndDevice interface {
 	Float32() float32
 	Float64() float64
@@ -7341,9 +8340,9 @@ func randUTF8RuneEndDevice(r randyEndDevice) rune {
 	return rune(ru + 61)
 }
 func randStringEndDevice(r randyEndDevice) string {
-	v29 := r.Intn(100)
-	tmps := make([]rune, v29)
-	for i := 0; i < v29; i++ {
+	v33 := r.Intn(100)
+	tmps := make([]rune, v33)
+	for i := 0; i < v33; i++ {
 		tmps[i] = randUTF8RuneEndDevice(r)
 	}
 	return string(tmps)
@@ -7365,11 +8364,11 @@ func randFieldEndDevice(dAtA []byte, r randyEndDevice, fieldNumber int, wire int
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
-		v30 := r.Int63()
+		v34 := r.Int63()
 		if r.Intn(2) == 0 {
-			v30 *= -1
+			v34 *= -1
 		}
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(v30))
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(v34))
 	case 1:
 		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -7604,6 +8603,10 @@ func (m *EndDeviceVersionIdentifiers) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEndDevice(uint64(l))
 	}
+	l = len(m.BandID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -7779,6 +8782,10 @@ func (m *MACSettings) Size() (n int) {
 		l = m.DesiredBeaconFrequency.Size()
 		n += 2 + l + sovEndDevice(uint64(l))
 	}
+	if m.ADRAlgorithm != nil {
+		l = m.ADRAlgorithm.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -7946,6 +8953,63 @@ func (m *MACState_DataRateRanges) Size() (n int) {
 	return n
 }
 
+func (m *ADRAlgorithmValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovEndDevice(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *ADRDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Algorithm != 0 {
+		n += 1 + sovEndDevice(uint64(m.Algorithm))
+	}
+	if m.Uplinks != 0 {
+		n += 1 + sovEndDevice(uint64(m.Uplinks))
+	}
+	if m.MaxSNR != 0 {
+		n += 5
+	}
+	if m.WorstSNR != 0 {
+		n += 5
+	}
+	if m.Margin != 0 {
+		n += 5
+	}
+	if m.LossRate != 0 {
+		n += 5
+	}
+	if m.CurrentDataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.CurrentDataRateIndex))
+	}
+	if m.CurrentTxPowerIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.CurrentTxPowerIndex))
+	}
+	if m.CurrentNbTrans != 0 {
+		n += 1 + sovEndDevice(uint64(m.CurrentNbTrans))
+	}
+	if m.DesiredDataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.DesiredDataRateIndex))
+	}
+	if m.DesiredTxPowerIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.DesiredTxPowerIndex))
+	}
+	if m.DesiredNbTrans != 0 {
+		n += 1 + sovEndDevice(uint64(m.DesiredNbTrans))
+	}
+	return n
+}
+
 func (m *EndDeviceAuthenticationCode) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8366,6 +9430,66 @@ func (m *ConvertEndDeviceTemplateRequest) Size() (n int) {
 	return n
 }
 
+func (m *EndDeviceArchive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovEndDevice(uint64(m.Version))
+	}
+	if m.DataKey != nil {
+		l = m.DataKey.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.EndDevices)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *ExportEndDevicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.DeviceIDs) > 0 {
+		for _, s := range m.DeviceIDs {
+			l = len(s)
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	l = len(m.KEKLabel)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *ImportEndDevicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.Archive != nil {
+		l = m.Archive.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
 func sovEndDevice(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -8478,6 +9602,7 @@ func (this *EndDeviceVersionIdentifiers) String() string {
 		`ModelID:` + fmt.Sprintf("%v", this.ModelID) + `,`,
 		`HardwareVersion:` + fmt.Sprintf("%v", this.HardwareVersion) + `,`,
 		`FirmwareVersion:` + fmt.Sprintf("%v", this.FirmwareVersion) + `,`,
+		`BandID:` + fmt.Sprintf("%v", this.BandID) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8537,6 +9662,7 @@ func (this *MACSettings) String() string {
 		`DesiredPingSlotDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
 		`DesiredPingSlotFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
 		`DesiredBeaconFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredBeaconFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
+		`ADRAlgorithm:` + strings.Replace(this.ADRAlgorithm.String(), "ADRAlgorithmValue", "ADRAlgorithmValue", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8640,6 +9766,37 @@ func (this *MACState_DataRateRanges) String() string {
 	}, "")
 	return s
 }
+func (this *ADRAlgorithmValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRAlgorithmValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRDecision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRDecision{`,
+		`Algorithm:` + fmt.Sprintf("%v", this.Algorithm) + `,`,
+		`Uplinks:` + fmt.Sprintf("%v", this.Uplinks) + `,`,
+		`MaxSNR:` + fmt.Sprintf("%v", this.MaxSNR) + `,`,
+		`WorstSNR:` + fmt.Sprintf("%v", this.WorstSNR) + `,`,
+		`Margin:` + fmt.Sprintf("%v", this.Margin) + `,`,
+		`LossRate:` + fmt.Sprintf("%v", this.LossRate) + `,`,
+		`CurrentDataRateIndex:` + fmt.Sprintf("%v", this.CurrentDataRateIndex) + `,`,
+		`CurrentTxPowerIndex:` + fmt.Sprintf("%v", this.CurrentTxPowerIndex) + `,`,
+		`CurrentNbTrans:` + fmt.Sprintf("%v", this.CurrentNbTrans) + `,`,
+		`DesiredDataRateIndex:` + fmt.Sprintf("%v", this.DesiredDataRateIndex) + `,`,
+		`DesiredTxPowerIndex:` + fmt.Sprintf("%v", this.DesiredTxPowerIndex) + `,`,
+		`DesiredNbTrans:` + fmt.Sprintf("%v", this.DesiredNbTrans) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EndDeviceAuthenticationCode) String() string {
 	if this == nil {
 		return "nil"
@@ -8891,16 +10048,52 @@ func (this *ConvertEndDeviceTemplateRequest) String() string {
 	}, "")
 	return s
 }
-func valueToStringEndDevice(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *EndDeviceArchive) String() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	s := strings.Join([]string{`&EndDeviceArchive{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`DataKey:` + strings.Replace(fmt.Sprintf("%v", this.DataKey), "KeyEnvelope", "KeyEnvelope", 1) + `,`,
+		`EndDevices:` + fmt.Sprintf("%v", this.EndDevices) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
 }
-func (m *Session) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+func (this *ExportEndDevicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExportEndDevicesRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`DeviceIDs:` + fmt.Sprintf("%v", this.DeviceIDs) + `,`,
+		`KEKLabel:` + fmt.Sprintf("%v", this.KEKLabel) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImportEndDevicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImportEndDevicesRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Archive:` + strings.Replace(fmt.Sprintf("%v", this.Archive), "EndDeviceArchive", "EndDeviceArchive", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEndDevice(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Session) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -10360,6 +11553,38 @@ func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
 			}
 			m.FirmwareVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BandID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BandID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -11836,6 +13061,42 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAlgorithm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ADRAlgorithm == nil {
+				m.ADRAlgorithm = &ADRAlgorithmValue{}
+			}
+			if err := m.ADRAlgorithm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -13143,7 +14404,7 @@ func (m *MACState_DataRateRanges) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
+func (m *ADRAlgorithmValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13166,85 +14427,17 @@ func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndDeviceAuthenticationCode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ADRAlgorithmValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDeviceAuthenticationCode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ADRAlgorithmValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ValidFrom == nil {
-				m.ValidFrom = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidTo", wireType)
-			}
-			var msglen int
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -13254,28 +14447,11 @@ func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Value |= ADRAlgorithm(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ValidTo == nil {
-				m.ValidTo = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidTo, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -13300,7 +14476,7 @@ func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndDevice) Unmarshal(dAtA []byte) error {
+func (m *ADRDecision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13323,17 +14499,17 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndDevice: wiretype end group for non-group")
+			return fmt.Errorf("proto: ADRDecision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDevice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ADRDecision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
 			}
-			var msglen int
+			m.Algorithm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -13343,30 +14519,16 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Algorithm |= ADRAlgorithm(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplinks", wireType)
 			}
-			var msglen int
+			m.Uplinks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -13376,30 +14538,60 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Uplinks |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSNR", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.MaxSNR = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorstSNR", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.WorstSNR = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.Margin = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossRate", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.LossRate = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDataRateIndex", wireType)
+			}
+			m.CurrentDataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -13409,13 +14601,403 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CurrentDataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTxPowerIndex", wireType)
+			}
+			m.CurrentTxPowerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTxPowerIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentNbTrans", wireType)
+			}
+			m.CurrentNbTrans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentNbTrans |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredDataRateIndex", wireType)
+			}
+			m.DesiredDataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DesiredDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredTxPowerIndex", wireType)
+			}
+			m.DesiredTxPowerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DesiredTxPowerIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredNbTrans", wireType)
+			}
+			m.DesiredNbTrans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DesiredNbTrans |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceAuthenticationCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceAuthenticationCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidFrom == nil {
+				m.ValidFrom = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidTo == nil {
+				m.ValidTo = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidTo, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDevice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDevice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDevice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
@@ -14469,7 +16051,185 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			}
 		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDevStatusReceivedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDevStatusReceivedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastDevStatusReceivedAt == nil {
+				m.LastDevStatusReceivedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDevStatusReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerState", wireType)
+			}
+			m.PowerState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerState |= PowerState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatteryPercentage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BatteryPercentage == nil {
+				m.BatteryPercentage = &types.FloatValue{}
+			}
+			if err := m.BatteryPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMargin", wireType)
+			}
+			m.DownlinkMargin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkMargin |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentADRUplinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentADRUplinks = append(m.RecentADRUplinks, &UplinkMessage{})
+			if err := m.RecentADRUplinks[len(m.RecentADRUplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentUplinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentUplinks = append(m.RecentUplinks, &UplinkMessage{})
+			if err := m.RecentUplinks[len(m.RecentUplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentDownlinks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14496,18 +16256,16 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastDevStatusReceivedAt == nil {
-				m.LastDevStatusReceivedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDevStatusReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.RecentDownlinks = append(m.RecentDownlinks, &DownlinkMessage{})
+			if err := m.RecentDownlinks[len(m.RecentDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 34:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerState", wireType)
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedApplicationDownlinks", wireType)
 			}
-			m.PowerState = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -14517,14 +16275,29 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PowerState |= PowerState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 35:
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedApplicationDownlinks = append(m.QueuedApplicationDownlinks, &ApplicationDownlink{})
+			if err := m.QueuedApplicationDownlinks[len(m.QueuedApplicationDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatteryPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Formatters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14551,18 +16324,18 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BatteryPercentage == nil {
-				m.BatteryPercentage = &types.FloatValue{}
+			if m.Formatters == nil {
+				m.Formatters = &MessagePayloadFormatters{}
 			}
-			if err := m.BatteryPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Formatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 36:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMargin", wireType)
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionerID", wireType)
 			}
-			m.DownlinkMargin = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -14572,14 +16345,27 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DownlinkMargin |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 37:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentADRUplinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14606,14 +16392,16 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecentADRUplinks = append(m.RecentADRUplinks, &UplinkMessage{})
-			if err := m.RecentADRUplinks[len(m.RecentADRUplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ProvisioningData == nil {
+				m.ProvisioningData = &types.Struct{}
+			}
+			if err := m.ProvisioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 38:
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentUplinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMACState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14640,14 +16428,36 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecentUplinks = append(m.RecentUplinks, &UplinkMessage{})
-			if err := m.RecentUplinks[len(m.RecentUplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PendingMACState == nil {
+				m.PendingMACState = &MACState{}
+			}
+			if err := m.PendingMACState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 39:
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multicast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Multicast = bool(v != 0)
+		case 46:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentDownlinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAuthenticationCode", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14674,16 +16484,18 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecentDownlinks = append(m.RecentDownlinks, &DownlinkMessage{})
-			if err := m.RecentDownlinks[len(m.RecentDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClaimAuthenticationCode == nil {
+				m.ClaimAuthenticationCode = &EndDeviceAuthenticationCode{}
+			}
+			if err := m.ClaimAuthenticationCode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 40:
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedApplicationDownlinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerKEKLabel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -14693,31 +16505,29 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueuedApplicationDownlinks = append(m.QueuedApplicationDownlinks, &ApplicationDownlink{})
-			if err := m.QueuedApplicationDownlinks[len(m.QueuedApplicationDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NetworkServerKEKLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 41:
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Formatters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationServerKEKLabel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -14727,31 +16537,27 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Formatters == nil {
-				m.Formatters = &MessagePayloadFormatters{}
-			}
-			if err := m.Formatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ApplicationServerKEKLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 42:
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationServerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14779,11 +16585,11 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
+			m.ApplicationServerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 43:
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Picture", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14810,16 +16616,36 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProvisioningData == nil {
-				m.ProvisioningData = &types.Struct{}
+			if m.Picture == nil {
+				m.Picture = &Picture{}
 			}
-			if err := m.ProvisioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Picture.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 44:
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipPayloadCrypto", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipPayloadCrypto = bool(v != 0)
+		case 52:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingMACState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipPayloadCryptoOverride", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14846,36 +16672,69 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingMACState == nil {
-				m.PendingMACState = &MACState{}
+			if m.SkipPayloadCryptoOverride == nil {
+				m.SkipPayloadCryptoOverride = &types.BoolValue{}
 			}
-			if err := m.PendingMACState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SkipPayloadCryptoOverride.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 45:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multicast", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDevices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Multicast = bool(v != 0)
-		case 46:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDevices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDevices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAuthenticationCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDevices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14902,50 +16761,69 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClaimAuthenticationCode == nil {
-				m.ClaimAuthenticationCode = &EndDeviceAuthenticationCode{}
-			}
-			if err := m.ClaimAuthenticationCode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EndDevices = append(m.EndDevices, &EndDevice{})
+			if err := m.EndDevices[len(m.EndDevices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 47:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerKEKLabel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkServerKEKLabel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 48:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateEndDeviceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateEndDeviceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationServerKEKLabel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -14955,59 +16833,81 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApplicationServerKEKLabel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 49:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationServerID", wireType)
+			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApplicationServerID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 50:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateEndDeviceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateEndDeviceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Picture", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15034,36 +16934,13 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Picture == nil {
-				m.Picture = &Picture{}
-			}
-			if err := m.Picture.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 51:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipPayloadCrypto", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SkipPayloadCrypto = bool(v != 0)
-		case 52:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipPayloadCryptoOverride", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15090,10 +16967,7 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SkipPayloadCryptoOverride == nil {
-				m.SkipPayloadCryptoOverride = &types.BoolValue{}
-			}
-			if err := m.SkipPayloadCryptoOverride.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15121,7 +16995,7 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndDevices) Unmarshal(dAtA []byte) error {
+func (m *GetEndDeviceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15144,15 +17018,48 @@ func (m *EndDevices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndDevices: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetEndDeviceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDevices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDevices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15179,8 +17086,7 @@ func (m *EndDevices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndDevices = append(m.EndDevices, &EndDevice{})
-			if err := m.EndDevices[len(m.EndDevices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15208,7 +17114,7 @@ func (m *EndDevices) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateEndDeviceRequest) Unmarshal(dAtA []byte) error {
+func (m *GetEndDeviceIdentifiersForEUIsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15231,17 +17137,17 @@ func (m *CreateEndDeviceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateEndDeviceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetEndDeviceIdentifiersForEUIsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetEndDeviceIdentifiersForEUIsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -15251,22 +17157,55 @@ func (m *CreateEndDeviceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15294,7 +17233,7 @@ func (m *CreateEndDeviceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateEndDeviceRequest) Unmarshal(dAtA []byte) error {
+func (m *ListEndDevicesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15317,15 +17256,15 @@ func (m *UpdateEndDeviceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateEndDeviceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListEndDevicesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15352,7 +17291,7 @@ func (m *UpdateEndDeviceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15389,6 +17328,76 @@ func (m *UpdateEndDeviceRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -15413,7 +17422,7 @@ func (m *UpdateEndDeviceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetEndDeviceRequest) Unmarshal(dAtA []byte) error {
+func (m *SetEndDeviceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15436,15 +17445,15 @@ func (m *GetEndDeviceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetEndDeviceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetEndDeviceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15471,7 +17480,7 @@ func (m *GetEndDeviceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15532,7 +17541,7 @@ func (m *GetEndDeviceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetEndDeviceIdentifiersForEUIsRequest) Unmarshal(dAtA []byte) error {
+func (m *EndDeviceTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15555,17 +17564,17 @@ func (m *GetEndDeviceIdentifiersForEUIsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetEndDeviceIdentifiersForEUIsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EndDeviceTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetEndDeviceIdentifiersForEUIsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EndDeviceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -15575,30 +17584,30 @@ func (m *GetEndDeviceIdentifiersForEUIsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -15608,25 +17617,57 @@ func (m *GetEndDeviceIdentifiersForEUIsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MappingKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MappingKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -15651,7 +17692,7 @@ func (m *GetEndDeviceIdentifiersForEUIsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListEndDevicesRequest) Unmarshal(dAtA []byte) error {
+func (m *EndDeviceTemplateFormat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15674,50 +17715,17 @@ func (m *ListEndDevicesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListEndDevicesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EndDeviceTemplateFormat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EndDeviceTemplateFormat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -15727,28 +17735,27 @@ func (m *ListEndDevicesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15776,13 +17783,13 @@ func (m *ListEndDevicesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Order = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileExtensions", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -15792,30 +17799,24 @@ func (m *ListEndDevicesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileExtensions = append(m.FileExtensions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -15840,7 +17841,7 @@ func (m *ListEndDevicesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetEndDeviceRequest) Unmarshal(dAtA []byte) error {
+func (m *EndDeviceTemplateFormats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15863,15 +17864,15 @@ func (m *SetEndDeviceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetEndDeviceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EndDeviceTemplateFormats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EndDeviceTemplateFormats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Formats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15898,42 +17899,105 @@ func (m *SetEndDeviceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			if m.Formats == nil {
+				m.Formats = make(map[string]*EndDeviceTemplateFormat)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *EndDeviceTemplateFormat
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &EndDeviceTemplateFormat{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEndDevice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Formats[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15959,7 +18023,7 @@ func (m *SetEndDeviceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndDeviceTemplate) Unmarshal(dAtA []byte) error {
+func (m *ConvertEndDeviceTemplateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15982,17 +18046,17 @@ func (m *EndDeviceTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndDeviceTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConvertEndDeviceTemplateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDeviceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConvertEndDeviceTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FormatID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -16002,30 +18066,29 @@ func (m *EndDeviceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FormatID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -16035,56 +18098,25 @@ func (m *EndDeviceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MappingKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			m.MappingKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16110,7 +18142,7 @@ func (m *EndDeviceTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndDeviceTemplateFormat) Unmarshal(dAtA []byte) error {
+func (m *EndDeviceArchive) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16133,17 +18165,36 @@ func (m *EndDeviceTemplateFormat) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndDeviceTemplateFormat: wiretype end group for non-group")
+			return fmt.Errorf("proto: EndDeviceArchive: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDeviceTemplateFormat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EndDeviceArchive: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -16153,29 +18204,33 @@ func (m *EndDeviceTemplateFormat) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.DataKey == nil {
+				m.DataKey = &KeyEnvelope{}
+			}
+			if err := m.DataKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDevices", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -16185,29 +18240,31 @@ func (m *EndDeviceTemplateFormat) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.EndDevices = append(m.EndDevices[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndDevices == nil {
+				m.EndDevices = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileExtensions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -16217,23 +18274,27 @@ func (m *EndDeviceTemplateFormat) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileExtensions = append(m.FileExtensions, string(dAtA[iNdEx:postIndex]))
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16259,7 +18320,7 @@ func (m *EndDeviceTemplateFormat) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndDeviceTemplateFormats) Unmarshal(dAtA []byte) error {
+func (m *ExportEndDevicesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16282,15 +18343,15 @@ func (m *EndDeviceTemplateFormats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndDeviceTemplateFormats: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportEndDevicesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDeviceTemplateFormats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Formats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16317,105 +18378,73 @@ func (m *EndDeviceTemplateFormats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Formats == nil {
-				m.Formats = make(map[string]*EndDeviceTemplateFormat)
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue *EndDeviceTemplateFormat
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEndDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEndDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &EndDeviceTemplateFormat{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEndDevice(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Formats[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceIDs = append(m.DeviceIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KEKLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KEKLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16441,7 +18470,7 @@ func (m *EndDeviceTemplateFormats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConvertEndDeviceTemplateRequest) Unmarshal(dAtA []byte) error {
+func (m *ImportEndDevicesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16464,17 +18493,17 @@ func (m *ConvertEndDeviceTemplateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConvertEndDeviceTemplateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportEndDevicesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConvertEndDeviceTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FormatID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -16484,29 +18513,30 @@ func (m *ConvertEndDeviceTemplateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FormatID = string(dAtA[iNdEx:postIndex])
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -16516,24 +18546,26 @@ func (m *ConvertEndDeviceTemplateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if m.Archive == nil {
+				m.Archive = &EndDeviceArchive{}
+			}
+			if err := m.Archive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -117,6 +117,7 @@ var EndDeviceModelFieldPathsTopLevel = []string{
 	"name",
 }
 var EndDeviceVersionIdentifiersFieldPathsNested = []string{
+	"band_id",
 	"brand_id",
 	"firmware_version",
 	"hardware_version",
@@ -124,6 +125,7 @@ var EndDeviceVersionIdentifiersFieldPathsNested = []string{
 }
 
 var EndDeviceVersionIdentifiersFieldPathsTopLevel = []string{
+	"band_id",
 	"brand_id",
 	"firmware_version",
 	"hardware_version",
@@ -136,6 +138,8 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_formatters.up_formatter",
 	"default_formatters.up_formatter_parameter",
 	"default_mac_settings",
+	"default_mac_settings.adr_algorithm",
+	"default_mac_settings.adr_algorithm.value",
 	"default_mac_settings.adr_margin",
 	"default_mac_settings.beacon_frequency",
 	"default_mac_settings.class_b_timeout",
@@ -177,6 +181,7 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_mac_settings.use_adr",
 	"frequency_plan_id",
 	"ids",
+	"ids.band_id",
 	"ids.brand_id",
 	"ids.firmware_version",
 	"ids.hardware_version",
@@ -208,6 +213,8 @@ var EndDeviceVersionFieldPathsTopLevel = []string{
 	"supports_join",
 }
 var MACSettingsFieldPathsNested = []string{
+	"adr_algorithm",
+	"adr_algorithm.value",
 	"adr_margin",
 	"beacon_frequency",
 	"class_b_timeout",
@@ -250,6 +257,7 @@ var MACSettingsFieldPathsNested = []string{
 }
 
 var MACSettingsFieldPathsTopLevel = []string{
+	"adr_algorithm",
 	"adr_margin",
 	"beacon_frequency",
 	"class_b_timeout",
@@ -530,6 +538,44 @@ var MACStateFieldPathsTopLevel = []string{
 	"rejected_frequencies",
 	"rx_windows_available",
 }
+var ADRAlgorithmValueFieldPathsNested = []string{
+	"value",
+}
+
+var ADRAlgorithmValueFieldPathsTopLevel = []string{
+	"value",
+}
+
+var ADRDecisionFieldPathsNested = []string{
+	"algorithm",
+	"current_data_rate_index",
+	"current_nb_trans",
+	"current_tx_power_index",
+	"desired_data_rate_index",
+	"desired_nb_trans",
+	"desired_tx_power_index",
+	"loss_rate",
+	"margin",
+	"max_snr",
+	"uplinks",
+	"worst_snr",
+}
+
+var ADRDecisionFieldPathsTopLevel = []string{
+	"algorithm",
+	"current_data_rate_index",
+	"current_nb_trans",
+	"current_tx_power_index",
+	"desired_data_rate_index",
+	"desired_nb_trans",
+	"desired_tx_power_index",
+	"loss_rate",
+	"margin",
+	"max_snr",
+	"uplinks",
+	"worst_snr",
+}
+
 var EndDeviceAuthenticationCodeFieldPathsNested = []string{
 	"valid_from",
 	"valid_to",
@@ -577,6 +623,8 @@ var EndDeviceFieldPathsNested = []string{
 	"lorawan_phy_version",
 	"lorawan_version",
 	"mac_settings",
+	"mac_settings.adr_algorithm",
+	"mac_settings.adr_algorithm.value",
 	"mac_settings.adr_margin",
 	"mac_settings.beacon_frequency",
 	"mac_settings.class_b_timeout",
@@ -1159,6 +1207,7 @@ var EndDeviceFieldPathsNested = []string{
 	"updated_at",
 	"used_dev_nonces",
 	"version_ids",
+	"version_ids.band_id",
 	"version_ids.brand_id",
 	"version_ids.firmware_version",
 	"version_ids.hardware_version",
@@ -1263,6 +1312,8 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
+	"end_device.mac_settings.adr_algorithm.value",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.class_b_timeout",
@@ -1845,6 +1896,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.updated_at",
 	"end_device.used_dev_nonces",
 	"end_device.version_ids",
+	"end_device.version_ids.band_id",
 	"end_device.version_ids.brand_id",
 	"end_device.version_ids.firmware_version",
 	"end_device.version_ids.hardware_version",
@@ -1891,6 +1943,8 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
+	"end_device.mac_settings.adr_algorithm.value",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.class_b_timeout",
@@ -2473,6 +2527,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.updated_at",
 	"end_device.used_dev_nonces",
 	"end_device.version_ids",
+	"end_device.version_ids.band_id",
 	"end_device.version_ids.brand_id",
 	"end_device.version_ids.firmware_version",
 	"end_device.version_ids.hardware_version",
@@ -2561,6 +2616,8 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
+	"end_device.mac_settings.adr_algorithm.value",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.class_b_timeout",
@@ -3143,6 +3200,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.updated_at",
 	"end_device.used_dev_nonces",
 	"end_device.version_ids",
+	"end_device.version_ids.band_id",
 	"end_device.version_ids.brand_id",
 	"end_device.version_ids.firmware_version",
 	"end_device.version_ids.hardware_version",
@@ -3191,6 +3249,8 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
+	"end_device.mac_settings.adr_algorithm.value",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.class_b_timeout",
@@ -3773,6 +3833,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.updated_at",
 	"end_device.used_dev_nonces",
 	"end_device.version_ids",
+	"end_device.version_ids.band_id",
 	"end_device.version_ids.brand_id",
 	"end_device.version_ids.firmware_version",
 	"end_device.version_ids.hardware_version",
@@ -3813,6 +3874,51 @@ var ConvertEndDeviceTemplateRequestFieldPathsTopLevel = []string{
 	"data",
 	"format_id",
 }
+var EndDeviceArchiveFieldPathsNested = []string{
+	"created_at",
+	"data_key",
+	"data_key.encrypted_key",
+	"data_key.kek_label",
+	"data_key.key",
+	"end_devices",
+	"version",
+}
+
+var EndDeviceArchiveFieldPathsTopLevel = []string{
+	"created_at",
+	"data_key",
+	"end_devices",
+	"version",
+}
+var ExportEndDevicesRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"device_ids",
+	"kek_label",
+}
+
+var ExportEndDevicesRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"device_ids",
+	"kek_label",
+}
+var ImportEndDevicesRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"archive",
+	"archive.created_at",
+	"archive.data_key",
+	"archive.data_key.encrypted_key",
+	"archive.data_key.kek_label",
+	"archive.data_key.key",
+	"archive.end_devices",
+	"archive.version",
+}
+
+var ImportEndDevicesRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"archive",
+}
 var MACParameters_ChannelFieldPathsNested = []string{
 	"downlink_frequency",
 	"enable_uplink",
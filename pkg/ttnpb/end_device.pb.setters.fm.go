@@ -515,6 +515,16 @@ func (dst *EndDeviceVersionIdentifiers) SetFields(src *EndDeviceVersionIdentifie
 				var zero string
 				dst.FirmwareVersion = zero
 			}
+		case "band_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'band_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BandID = src.BandID
+			} else {
+				var zero string
+				dst.BandID = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1125,6 +1135,31 @@ func (dst *MACSettings) SetFields(src *MACSettings, paths ...string) error {
 			} else {
 				dst.DesiredBeaconFrequency = nil
 			}
+		case "adr_algorithm":
+			if len(subs) > 0 {
+				var newDst, newSrc *ADRAlgorithmValue
+				if (src == nil || src.ADRAlgorithm == nil) && dst.ADRAlgorithm == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ADRAlgorithm
+				}
+				if dst.ADRAlgorithm != nil {
+					newDst = dst.ADRAlgorithm
+				} else {
+					newDst = &ADRAlgorithmValue{}
+					dst.ADRAlgorithm = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ADRAlgorithm = src.ADRAlgorithm
+				} else {
+					dst.ADRAlgorithm = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1419,6 +1454,158 @@ func (dst *MACState) SetFields(src *MACState, paths ...string) error {
 	return nil
 }
 
+func (dst *ADRAlgorithmValue) SetFields(src *ADRAlgorithmValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero ADRAlgorithm
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ADRDecision) SetFields(src *ADRDecision, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "algorithm":
+			if len(subs) > 0 {
+				return fmt.Errorf("'algorithm' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Algorithm = src.Algorithm
+			} else {
+				var zero ADRAlgorithm
+				dst.Algorithm = zero
+			}
+		case "uplinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Uplinks = src.Uplinks
+			} else {
+				var zero uint32
+				dst.Uplinks = zero
+			}
+		case "max_snr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_snr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxSNR = src.MaxSNR
+			} else {
+				var zero float32
+				dst.MaxSNR = zero
+			}
+		case "worst_snr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'worst_snr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WorstSNR = src.WorstSNR
+			} else {
+				var zero float32
+				dst.WorstSNR = zero
+			}
+		case "margin":
+			if len(subs) > 0 {
+				return fmt.Errorf("'margin' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Margin = src.Margin
+			} else {
+				var zero float32
+				dst.Margin = zero
+			}
+		case "loss_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'loss_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LossRate = src.LossRate
+			} else {
+				var zero float32
+				dst.LossRate = zero
+			}
+		case "current_data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'current_data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CurrentDataRateIndex = src.CurrentDataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.CurrentDataRateIndex = zero
+			}
+		case "current_tx_power_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'current_tx_power_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CurrentTxPowerIndex = src.CurrentTxPowerIndex
+			} else {
+				var zero uint32
+				dst.CurrentTxPowerIndex = zero
+			}
+		case "current_nb_trans":
+			if len(subs) > 0 {
+				return fmt.Errorf("'current_nb_trans' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CurrentNbTrans = src.CurrentNbTrans
+			} else {
+				var zero uint32
+				dst.CurrentNbTrans = zero
+			}
+		case "desired_data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'desired_data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DesiredDataRateIndex = src.DesiredDataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DesiredDataRateIndex = zero
+			}
+		case "desired_tx_power_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'desired_tx_power_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DesiredTxPowerIndex = src.DesiredTxPowerIndex
+			} else {
+				var zero uint32
+				dst.DesiredTxPowerIndex = zero
+			}
+		case "desired_nb_trans":
+			if len(subs) > 0 {
+				return fmt.Errorf("'desired_nb_trans' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DesiredNbTrans = src.DesiredNbTrans
+			} else {
+				var zero uint32
+				dst.DesiredNbTrans = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *EndDeviceAuthenticationCode) SetFields(src *EndDeviceAuthenticationCode, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -2540,6 +2727,172 @@ func (dst *ConvertEndDeviceTemplateRequest) SetFields(src *ConvertEndDeviceTempl
 	return nil
 }
 
+func (dst *EndDeviceArchive) SetFields(src *EndDeviceArchive, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Version = src.Version
+			} else {
+				var zero uint32
+				dst.Version = zero
+			}
+		case "data_key":
+			if len(subs) > 0 {
+				var newDst, newSrc *KeyEnvelope
+				if (src == nil || src.DataKey == nil) && dst.DataKey == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DataKey
+				}
+				if dst.DataKey != nil {
+					newDst = dst.DataKey
+				} else {
+					newDst = &KeyEnvelope{}
+					dst.DataKey = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DataKey = src.DataKey
+				} else {
+					dst.DataKey = nil
+				}
+			}
+		case "end_devices":
+			if len(subs) > 0 {
+				return fmt.Errorf("'end_devices' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EndDevices = src.EndDevices
+			} else {
+				dst.EndDevices = nil
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				dst.CreatedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ExportEndDevicesRequest) SetFields(src *ExportEndDevicesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIdentifiers
+				}
+				newDst = &dst.ApplicationIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIdentifiers = src.ApplicationIdentifiers
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIdentifiers = zero
+				}
+			}
+		case "device_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceIDs = src.DeviceIDs
+			} else {
+				dst.DeviceIDs = nil
+			}
+		case "kek_label":
+			if len(subs) > 0 {
+				return fmt.Errorf("'kek_label' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.KEKLabel = src.KEKLabel
+			} else {
+				var zero string
+				dst.KEKLabel = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ImportEndDevicesRequest) SetFields(src *ImportEndDevicesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIdentifiers
+				}
+				newDst = &dst.ApplicationIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIdentifiers = src.ApplicationIdentifiers
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIdentifiers = zero
+				}
+			}
+		case "archive":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceArchive
+				if (src == nil || src.Archive == nil) && dst.Archive == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Archive
+				}
+				if dst.Archive != nil {
+					newDst = dst.Archive
+				} else {
+					newDst = &EndDeviceArchive{}
+					dst.Archive = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Archive = src.Archive
+				} else {
+					dst.Archive = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *MACParameters_Channel) SetFields(src *MACParameters_Channel, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
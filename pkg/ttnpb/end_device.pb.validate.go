@@ -699,6 +699,15 @@ func (m *EndDeviceVersionIdentifiers) ValidateFields(paths ...string) error {
 			// no validation rules for HardwareVersion
 		case "firmware_version":
 			// no validation rules for FirmwareVersion
+		case "band_id":
+
+			if utf8.RuneCountInString(m.GetBandID()) > 32 {
+				return EndDeviceVersionIdentifiersValidationError{
+					field:  "band_id",
+					reason: "value length must be at most 32 runes",
+				}
+			}
+
 		default:
 			return EndDeviceVersionIdentifiersValidationError{
 				field:  name,
@@ -1274,6 +1283,18 @@ func (m *MACSettings) ValidateFields(paths ...string) error {
 
 			}
 
+		case "adr_algorithm":
+
+			if v, ok := interface{}(m.GetADRAlgorithm()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSettingsValidationError{
+						field:  "adr_algorithm",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return MACSettingsValidationError{
 				field:  name,
@@ -1690,6 +1711,250 @@ var _ interface {
 	ErrorName() string
 } = MACStateValidationError{}
 
+// ValidateFields checks the field values on ADRAlgorithmValue with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *ADRAlgorithmValue) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ADRAlgorithmValueFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "value":
+
+			if _, ok := ADRAlgorithm_name[int32(m.GetValue())]; !ok {
+				return ADRAlgorithmValueValidationError{
+					field:  "value",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return ADRAlgorithmValueValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ADRAlgorithmValueValidationError is the validation error returned by
+// ADRAlgorithmValue.ValidateFields if the designated constraints aren't met.
+type ADRAlgorithmValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ADRAlgorithmValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ADRAlgorithmValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ADRAlgorithmValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ADRAlgorithmValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ADRAlgorithmValueValidationError) ErrorName() string {
+	return "ADRAlgorithmValueValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ADRAlgorithmValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sADRAlgorithmValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ADRAlgorithmValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ADRAlgorithmValueValidationError{}
+
+// ValidateFields checks the field values on ADRDecision with the rules defined
+// in the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ADRDecision) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ADRDecisionFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "algorithm":
+
+			if _, ok := ADRAlgorithm_name[int32(m.GetAlgorithm())]; !ok {
+				return ADRDecisionValidationError{
+					field:  "algorithm",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "uplinks":
+			// no validation rules for Uplinks
+		case "max_snr":
+			// no validation rules for MaxSNR
+		case "worst_snr":
+			// no validation rules for WorstSNR
+		case "margin":
+			// no validation rules for Margin
+		case "loss_rate":
+			// no validation rules for LossRate
+		case "current_data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetCurrentDataRateIndex())]; !ok {
+				return ADRDecisionValidationError{
+					field:  "current_data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "current_tx_power_index":
+
+			if m.GetCurrentTxPowerIndex() > 15 {
+				return ADRDecisionValidationError{
+					field:  "current_tx_power_index",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "current_nb_trans":
+
+			if m.GetCurrentNbTrans() > 15 {
+				return ADRDecisionValidationError{
+					field:  "current_nb_trans",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "desired_data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetDesiredDataRateIndex())]; !ok {
+				return ADRDecisionValidationError{
+					field:  "desired_data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "desired_tx_power_index":
+
+			if m.GetDesiredTxPowerIndex() > 15 {
+				return ADRDecisionValidationError{
+					field:  "desired_tx_power_index",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "desired_nb_trans":
+
+			if m.GetDesiredNbTrans() > 15 {
+				return ADRDecisionValidationError{
+					field:  "desired_nb_trans",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		default:
+			return ADRDecisionValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ADRDecisionValidationError is the validation error returned by
+// ADRDecision.ValidateFields if the designated constraints aren't met.
+type ADRDecisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ADRDecisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ADRDecisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ADRDecisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ADRDecisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ADRDecisionValidationError) ErrorName() string {
+	return "ADRDecisionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ADRDecisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sADRDecision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ADRDecisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ADRDecisionValidationError{}
+
 // ValidateFields checks the field values on EndDeviceAuthenticationCode with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
@@ -3582,6 +3847,379 @@ var _ interface {
 
 var _ConvertEndDeviceTemplateRequest_FormatID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
 
+// ValidateFields checks the field values on EndDeviceArchive with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *EndDeviceArchive) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceArchiveFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "version":
+			// no validation rules for Version
+		case "data_key":
+
+			if v, ok := interface{}(m.GetDataKey()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceArchiveValidationError{
+						field:  "data_key",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "end_devices":
+			// no validation rules for EndDevices
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceArchiveValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EndDeviceArchiveValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceArchiveValidationError is the validation error returned by
+// EndDeviceArchive.ValidateFields if the designated constraints aren't met.
+type EndDeviceArchiveValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceArchiveValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceArchiveValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceArchiveValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceArchiveValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceArchiveValidationError) ErrorName() string {
+	return "EndDeviceArchiveValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceArchiveValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceArchive.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceArchiveValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceArchiveValidationError{}
+
+// ValidateFields checks the field values on ExportEndDevicesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportEndDevicesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ExportEndDevicesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ExportEndDevicesRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "device_ids":
+
+			if len(m.GetDeviceIDs()) < 1 {
+				return ExportEndDevicesRequestValidationError{
+					field:  "device_ids",
+					reason: "value must contain at least 1 item(s)",
+				}
+			}
+
+			if len(m.GetDeviceIDs()) > 1000 {
+				return ExportEndDevicesRequestValidationError{
+					field:  "device_ids",
+					reason: "value must contain no more than 1000 item(s)",
+				}
+			}
+
+			_ExportEndDevicesRequest_DeviceIDs_Unique := make(map[string]struct{}, len(m.GetDeviceIDs()))
+
+			for idx, item := range m.GetDeviceIDs() {
+				_, _ = idx, item
+
+				if _, exists := _ExportEndDevicesRequest_DeviceIDs_Unique[item]; exists {
+					return ExportEndDevicesRequestValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "repeated value must contain unique items",
+					}
+				} else {
+					_ExportEndDevicesRequest_DeviceIDs_Unique[item] = struct{}{}
+				}
+
+				if utf8.RuneCountInString(item) > 36 {
+					return ExportEndDevicesRequestValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_ExportEndDevicesRequest_DeviceIDs_Pattern.MatchString(item) {
+					return ExportEndDevicesRequestValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+			}
+
+		case "kek_label":
+
+			if utf8.RuneCountInString(m.GetKEKLabel()) > 2048 {
+				return ExportEndDevicesRequestValidationError{
+					field:  "kek_label",
+					reason: "value length must be at most 2048 runes",
+				}
+			}
+
+		default:
+			return ExportEndDevicesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ExportEndDevicesRequestValidationError is the validation error returned by
+// ExportEndDevicesRequest.ValidateFields if the designated constraints aren't
+// met.
+type ExportEndDevicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportEndDevicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportEndDevicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportEndDevicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportEndDevicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportEndDevicesRequestValidationError) ErrorName() string {
+	return "ExportEndDevicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportEndDevicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportEndDevicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportEndDevicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportEndDevicesRequestValidationError{}
+
+var _ExportEndDevicesRequest_DeviceIDs_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on ImportEndDevicesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportEndDevicesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ImportEndDevicesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ImportEndDevicesRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "archive":
+
+			if m.GetArchive() == nil {
+				return ImportEndDevicesRequestValidationError{
+					field:  "archive",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetArchive()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ImportEndDevicesRequestValidationError{
+						field:  "archive",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ImportEndDevicesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ImportEndDevicesRequestValidationError is the validation error returned by
+// ImportEndDevicesRequest.ValidateFields if the designated constraints aren't
+// met.
+type ImportEndDevicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportEndDevicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportEndDevicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportEndDevicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportEndDevicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportEndDevicesRequestValidationError) ErrorName() string {
+	return "ImportEndDevicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportEndDevicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportEndDevicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportEndDevicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportEndDevicesRequestValidationError{}
+
 // ValidateFields checks the field values on MACParameters_Channel with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -258,9 +258,18 @@ type StreamEventsRequest struct {
 	// If not empty, this will return historical events after the given time when the stream starts.
 	// If used in combination with "tail", the limit that is reached first, is used.
 	// The availability of historical events depends on server support and retention policy.
-	After                *time.Time `protobuf:"bytes,3,opt,name=after,proto3,stdtime" json:"after,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	After *time.Time `protobuf:"bytes,3,opt,name=after,proto3,stdtime" json:"after,omitempty"`
+	// If not empty, only events with any of these names are returned.
+	// The names may contain glob patterns, where "*" matches one part and "**" matches any number of parts of the name
+	// (e.g. "as.up.*" or "ns.mac.**").
+	// If the identifiers are empty, the events with these names of all entities are returned. This requires admin rights.
+	Names []string `protobuf:"bytes,4,rep,name=names,proto3" json:"names,omitempty"`
+	// Event names that are excluded, after matching the names above. The names may contain glob patterns.
+	ExcludeNames []string `protobuf:"bytes,5,rep,name=exclude_names,json=excludeNames,proto3" json:"exclude_names,omitempty"`
+	// If not empty, only events with any of these correlation IDs are returned.
+	CorrelationIDs       []string `protobuf:"bytes,6,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StreamEventsRequest) Reset()      { *m = StreamEventsRequest{} }
@@ -316,6 +325,27 @@ func (m *StreamEventsRequest) GetAfter() *time.Time {
 	return nil
 }
 
+func (m *StreamEventsRequest) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *StreamEventsRequest) GetExcludeNames() []string {
+	if m != nil {
+		return m.ExcludeNames
+	}
+	return nil
+}
+
+func (m *StreamEventsRequest) GetCorrelationIDs() []string {
+	if m != nil {
+		return m.CorrelationIDs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Event)(nil), "ttn.lorawan.v3.Event")
 	golang_proto.RegisterType((*Event)(nil), "ttn.lorawan.v3.Event")
@@ -333,61 +363,65 @@ func init() {
 }
 
 var fileDescriptor_4fd8551d68f51e44 = []byte{
-	// 858 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3d, 0x8c, 0x1b, 0x45,
-	0x14, 0xde, 0xf1, 0xbf, 0xc7, 0xc6, 0x44, 0x43, 0x88, 0x16, 0x0b, 0xc6, 0x87, 0x83, 0x90, 0x41,
-	0xba, 0x5d, 0x74, 0x27, 0x45, 0xe8, 0x44, 0xc1, 0xf9, 0xee, 0x84, 0x56, 0xa2, 0x40, 0xcb, 0xd1,
-	0xa4, 0xb1, 0xc6, 0xde, 0xb9, 0xf5, 0xc8, 0xf6, 0xcc, 0x66, 0x77, 0xec, 0x64, 0xbb, 0x88, 0x2a,
-	0x1d, 0x11, 0x34, 0x94, 0x34, 0xa0, 0x94, 0x11, 0x55, 0xca, 0x94, 0x57, 0x46, 0xa2, 0x49, 0x75,
-	0xc4, 0xb3, 0x14, 0x29, 0x53, 0x46, 0xa9, 0xd0, 0xce, 0xae, 0x73, 0x3e, 0xdf, 0x89, 0x82, 0xee,
-	0xcd, 0xfb, 0xbe, 0xf7, 0xe6, 0x7b, 0xdf, 0xbe, 0x1d, 0x88, 0xa7, 0x22, 0x24, 0x77, 0x09, 0xdf,
-	0x8e, 0x24, 0x19, 0x4d, 0x6c, 0x12, 0x30, 0x9b, 0x2e, 0x28, 0x97, 0x91, 0x15, 0x84, 0x42, 0x0a,
-	0xd4, 0x92, 0x92, 0x5b, 0x39, 0xc7, 0x5a, 0xec, 0xb6, 0xf7, 0x7d, 0x26, 0xc7, 0xf3, 0xa1, 0x35,
-	0x12, 0x33, 0x9b, 0xf2, 0x85, 0x88, 0x83, 0x50, 0xdc, 0x8b, 0x6d, 0x4d, 0x1e, 0x6d, 0xfb, 0x94,
-	0x6f, 0x2f, 0xc8, 0x94, 0x79, 0x44, 0x52, 0xfb, 0x52, 0x90, 0xb5, 0x6c, 0x6f, 0xaf, 0xb5, 0xf0,
-	0x85, 0x2f, 0xb2, 0xe2, 0xe1, 0xfc, 0x44, 0x9f, 0xf4, 0x41, 0x47, 0x39, 0xfd, 0x43, 0x5f, 0x08,
-	0x7f, 0x4a, 0xb5, 0x34, 0xc2, 0xb9, 0x90, 0x44, 0x32, 0xc1, 0x73, 0x7d, 0xed, 0x0f, 0x72, 0xf4,
-	0x6d, 0x0f, 0xc2, 0xe3, 0x1c, 0xea, 0x6c, 0x42, 0x92, 0xcd, 0x68, 0x24, 0xc9, 0x2c, 0xc8, 0x09,
-	0x37, 0x2f, 0xcf, 0xce, 0x3c, 0xca, 0x25, 0x3b, 0x61, 0x34, 0x5c, 0x5d, 0x70, 0x85, 0x41, 0x21,
-	0xf3, 0xc7, 0x2b, 0x83, 0xba, 0x3f, 0x55, 0x60, 0xf9, 0x28, 0x75, 0x0c, 0x21, 0x58, 0xe2, 0x64,
-	0x46, 0x4d, 0xb0, 0x05, 0x7a, 0x75, 0x57, 0xc7, 0xe8, 0x6b, 0x58, 0x4a, 0x6f, 0x35, 0x0b, 0x5b,
-	0xa0, 0xd7, 0xd8, 0x69, 0x5b, 0x99, 0x24, 0x6b, 0x25, 0xc9, 0x3a, 0x5e, 0x49, 0xea, 0x5f, 0x7b,
-	0xd3, 0x2f, 0xff, 0x09, 0x0a, 0x35, 0x70, 0x7a, 0xd6, 0x31, 0x1e, 0xfe, 0xdd, 0x01, 0xae, 0xae,
-	0x44, 0x07, 0xb0, 0xb1, 0x26, 0xca, 0x2c, 0x6e, 0x15, 0x7b, 0x8d, 0x9d, 0x8f, 0xad, 0x8b, 0x9f,
-	0xc5, 0x3a, 0xe2, 0x92, 0xc9, 0xd8, 0x39, 0x27, 0xba, 0xeb, 0x55, 0xa8, 0x07, 0x4b, 0x1e, 0x91,
-	0xc4, 0x2c, 0x69, 0x19, 0xd7, 0x2f, 0xc9, 0xd8, 0xe7, 0xb1, 0xab, 0x19, 0xe8, 0x1b, 0xf8, 0xee,
-	0x48, 0x84, 0x21, 0x9d, 0x6a, 0x97, 0x07, 0xcc, 0x8b, 0xcc, 0xf2, 0x56, 0xb1, 0x57, 0xef, 0xe3,
-	0x37, 0xfd, 0xfa, 0xcf, 0xa0, 0xd2, 0x2d, 0x85, 0x05, 0xd3, 0x53, 0x67, 0x9d, 0xd6, 0xc1, 0x39,
-	0xcd, 0x39, 0x8c, 0xdc, 0xd6, 0x5a, 0x99, 0xe3, 0x45, 0xe8, 0x06, 0xac, 0x88, 0x90, 0xf9, 0x8c,
-	0x9b, 0x15, 0xed, 0x47, 0x7e, 0x42, 0x5f, 0xc1, 0xea, 0x48, 0x70, 0x49, 0xef, 0x49, 0xb3, 0xaa,
-	0x67, 0xe9, 0x5e, 0x9a, 0x25, 0x75, 0xd3, 0x3a, 0xc8, 0x48, 0x47, 0x5c, 0x86, 0xb1, 0xbb, 0x2a,
-	0x41, 0xb7, 0x20, 0x5c, 0xb0, 0x88, 0x0d, 0xd9, 0x94, 0xc9, 0xd8, 0xac, 0xe9, 0x71, 0x6e, 0x6c,
-	0x36, 0x70, 0xf5, 0xf7, 0x71, 0xd7, 0x98, 0xe8, 0x5b, 0xd8, 0x22, 0x73, 0x39, 0x4e, 0x1d, 0x19,
-	0x69, 0x89, 0x66, 0x5d, 0xd7, 0x7e, 0x72, 0xf5, 0xe5, 0xfb, 0x17, 0xb8, 0xee, 0x46, 0x2d, 0xfa,
-	0x0c, 0xd6, 0x43, 0x3a, 0x13, 0x92, 0x0e, 0x58, 0x60, 0xc2, 0x74, 0xbc, 0x7e, 0x53, 0x9d, 0x75,
-	0x6a, 0xae, 0x4e, 0x3a, 0xdf, 0xb9, 0xb5, 0x0c, 0x76, 0x02, 0xf4, 0x11, 0x84, 0xf3, 0x88, 0x86,
-	0x03, 0xe2, 0x53, 0x2e, 0xcd, 0x86, 0xb6, 0xa2, 0x9e, 0x66, 0xf6, 0xd3, 0x44, 0xda, 0x69, 0xce,
-	0xd9, 0x9d, 0x39, 0x1d, 0x30, 0xcf, 0x6c, 0x9e, 0x77, 0xfa, 0x41, 0x27, 0x9d, 0x43, 0xb7, 0x96,
-	0xc1, 0x8e, 0xd7, 0xde, 0x83, 0xcd, 0x75, 0x4f, 0xd0, 0x35, 0x58, 0x9c, 0xd0, 0x38, 0xdf, 0xb6,
-	0x34, 0x44, 0xd7, 0x61, 0x79, 0x41, 0xa6, 0xf3, 0x6c, 0xdb, 0x9a, 0x6e, 0x76, 0xd8, 0x2b, 0x7c,
-	0x09, 0xda, 0x13, 0xd8, 0xba, 0x38, 0x52, 0xba, 0xac, 0x32, 0x0e, 0xde, 0x2e, 0x6b, 0x1a, 0xa7,
-	0x5a, 0xa5, 0x98, 0x50, 0x3e, 0xd0, 0x48, 0x21, 0xd3, 0xaa, 0x33, 0xc7, 0x29, 0xfc, 0x29, 0xac,
-	0x65, 0x30, 0xf3, 0xcc, 0xa2, 0x96, 0xda, 0x50, 0x67, 0x9d, 0xea, 0x71, 0x9a, 0x73, 0x0e, 0xdd,
-	0xaa, 0x06, 0x1d, 0xaf, 0xfb, 0x07, 0x80, 0xef, 0x7d, 0x2f, 0x43, 0x4a, 0x66, 0xda, 0xcc, 0xc8,
-	0xa5, 0x77, 0xe6, 0x34, 0x92, 0x9b, 0x9b, 0x0c, 0xfe, 0xd7, 0x26, 0xa7, 0xba, 0x09, 0x9b, 0x6a,
-	0x75, 0xef, 0xb8, 0x3a, 0x46, 0xb7, 0x60, 0x99, 0x9c, 0x48, 0x1a, 0x6a, 0x55, 0xff, 0xfd, 0x97,
-	0x95, 0xf4, 0x9f, 0x95, 0xd1, 0x77, 0x3c, 0x58, 0xc9, 0x14, 0xa2, 0xdb, 0xb0, 0x92, 0x29, 0x46,
-	0x37, 0x37, 0xf5, 0x5c, 0x31, 0x49, 0xfb, 0xfd, 0x2b, 0xb7, 0xa6, 0x8b, 0x7e, 0xfc, 0xeb, 0x9f,
-	0x5f, 0x0a, 0xcd, 0x6e, 0x35, 0x7f, 0x42, 0xf7, 0xc0, 0xe7, 0x5f, 0x80, 0xfe, 0xef, 0xe0, 0x74,
-	0x89, 0xc1, 0xb3, 0x25, 0x06, 0xcf, 0x97, 0xd8, 0x78, 0xb1, 0xc4, 0xc6, 0xcb, 0x25, 0x36, 0x5e,
-	0x2d, 0xb1, 0xf1, 0x7a, 0x89, 0xc1, 0x7d, 0x85, 0xc1, 0x03, 0x85, 0x8d, 0x47, 0x0a, 0x83, 0xc7,
-	0x0a, 0x1b, 0x4f, 0x14, 0x36, 0x9e, 0x2a, 0x6c, 0x9c, 0x2a, 0x0c, 0x9e, 0x29, 0x0c, 0x9e, 0x2b,
-	0x6c, 0xbc, 0x50, 0x18, 0xbc, 0x54, 0xd8, 0x78, 0xa5, 0x30, 0x78, 0xad, 0xb0, 0x71, 0x3f, 0xc1,
-	0xc6, 0x83, 0x04, 0x83, 0x87, 0x09, 0x36, 0x7e, 0x4d, 0x30, 0xf8, 0x2d, 0xc1, 0xc6, 0xa3, 0x04,
-	0x1b, 0x8f, 0x13, 0x0c, 0x9e, 0x24, 0x18, 0x3c, 0x4d, 0x30, 0xb8, 0x6d, 0xfb, 0xc2, 0x92, 0x63,
-	0x2a, 0xc7, 0x8c, 0xfb, 0x91, 0xc5, 0xa9, 0xbc, 0x2b, 0xc2, 0x89, 0x7d, 0xf1, 0x31, 0x5b, 0xec,
-	0xda, 0xc1, 0xc4, 0xb7, 0xa5, 0xe4, 0xc1, 0x70, 0x58, 0xd1, 0x76, 0xed, 0xfe, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x7a, 0x22, 0xd6, 0xa7, 0x12, 0x06, 0x00, 0x00,
+	// 915 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3d, 0x88, 0x1b, 0x47,
+	0x14, 0xde, 0xd1, 0xbf, 0x46, 0xb2, 0x72, 0x4c, 0x2e, 0x66, 0x23, 0x92, 0x91, 0x22, 0x9b, 0xa0,
+	0x04, 0x6e, 0x15, 0xee, 0xc0, 0x84, 0x23, 0x45, 0x4e, 0x77, 0x47, 0x58, 0x08, 0x21, 0x6c, 0x2e,
+	0x8d, 0x1b, 0x31, 0xd2, 0xce, 0xad, 0x06, 0x49, 0x33, 0xf2, 0xee, 0x48, 0xbe, 0xed, 0x4c, 0x2a,
+	0x77, 0x31, 0x4e, 0x93, 0x32, 0x4d, 0xc0, 0xa5, 0x49, 0xe5, 0xd2, 0xe5, 0x15, 0x29, 0x0c, 0x69,
+	0x5c, 0x5d, 0xac, 0xdd, 0x14, 0x2e, 0x5d, 0x9a, 0xab, 0xc2, 0xce, 0xae, 0x7c, 0xfa, 0x83, 0x03,
+	0x77, 0x6f, 0xde, 0xfb, 0xbe, 0xb7, 0xdf, 0xfb, 0xe6, 0xcd, 0x42, 0x3c, 0x14, 0x2e, 0xb9, 0x4f,
+	0xf8, 0x8e, 0x27, 0x49, 0x6f, 0xd0, 0x22, 0x63, 0xd6, 0xa2, 0x53, 0xca, 0xa5, 0x67, 0x8c, 0x5d,
+	0x21, 0x05, 0xaa, 0x48, 0xc9, 0x8d, 0x04, 0x63, 0x4c, 0xf7, 0xaa, 0x07, 0x0e, 0x93, 0xfd, 0x49,
+	0xd7, 0xe8, 0x89, 0x51, 0x8b, 0xf2, 0xa9, 0xf0, 0xc7, 0xae, 0x38, 0xf3, 0x5b, 0x0a, 0xdc, 0xdb,
+	0x71, 0x28, 0xdf, 0x99, 0x92, 0x21, 0xb3, 0x89, 0xa4, 0xad, 0xb5, 0x20, 0x6e, 0x59, 0xdd, 0x59,
+	0x68, 0xe1, 0x08, 0x47, 0xc4, 0xe4, 0xee, 0xe4, 0x54, 0x9d, 0xd4, 0x41, 0x45, 0x09, 0xfc, 0x13,
+	0x47, 0x08, 0x67, 0x48, 0x95, 0x34, 0xc2, 0xb9, 0x90, 0x44, 0x32, 0xc1, 0x13, 0x7d, 0xd5, 0x8f,
+	0x93, 0xea, 0xbb, 0x1e, 0x84, 0xfb, 0x49, 0xa9, 0xb6, 0x5a, 0x92, 0x6c, 0x44, 0x3d, 0x49, 0x46,
+	0xe3, 0x04, 0x70, 0x6b, 0x7d, 0x76, 0x66, 0x53, 0x2e, 0xd9, 0x29, 0xa3, 0xee, 0xfc, 0x03, 0x1b,
+	0x0c, 0x72, 0x99, 0xd3, 0x9f, 0x1b, 0xd4, 0xf8, 0x35, 0x07, 0xb3, 0xc7, 0x91, 0x63, 0x08, 0xc1,
+	0x0c, 0x27, 0x23, 0xaa, 0x83, 0x3a, 0x68, 0x16, 0x2d, 0x15, 0xa3, 0x6f, 0x61, 0x26, 0xfa, 0xaa,
+	0x9e, 0xaa, 0x83, 0x66, 0x69, 0xb7, 0x6a, 0xc4, 0x92, 0x8c, 0xb9, 0x24, 0xe3, 0x64, 0x2e, 0xa9,
+	0xbd, 0x75, 0xd9, 0xce, 0xfe, 0x05, 0x52, 0x05, 0x70, 0x7e, 0x51, 0xd3, 0x1e, 0xfd, 0x5b, 0x03,
+	0x96, 0x62, 0xa2, 0x43, 0x58, 0x5a, 0x10, 0xa5, 0xa7, 0xeb, 0xe9, 0x66, 0x69, 0xf7, 0x33, 0x63,
+	0xf9, 0x5a, 0x8c, 0x63, 0x2e, 0x99, 0xf4, 0xcd, 0x2b, 0xa0, 0xb5, 0xc8, 0x42, 0x4d, 0x98, 0xb1,
+	0x89, 0x24, 0x7a, 0x46, 0xc9, 0xd8, 0x5e, 0x93, 0x71, 0xc0, 0x7d, 0x4b, 0x21, 0xd0, 0x77, 0xf0,
+	0x83, 0x9e, 0x70, 0x5d, 0x3a, 0x54, 0x2e, 0x77, 0x98, 0xed, 0xe9, 0xd9, 0x7a, 0xba, 0x59, 0x6c,
+	0xe3, 0xcb, 0x76, 0xf1, 0x31, 0xc8, 0x35, 0x32, 0x6e, 0x4a, 0xb7, 0x83, 0x8b, 0x5a, 0xe5, 0xf0,
+	0x0a, 0x66, 0x1e, 0x79, 0x56, 0x65, 0x81, 0x66, 0xda, 0x1e, 0xba, 0x09, 0x73, 0xc2, 0x65, 0x0e,
+	0xe3, 0x7a, 0x4e, 0xf9, 0x91, 0x9c, 0xd0, 0x37, 0x30, 0xdf, 0x13, 0x5c, 0xd2, 0x33, 0xa9, 0xe7,
+	0xd5, 0x2c, 0x8d, 0xb5, 0x59, 0x22, 0x37, 0x8d, 0xc3, 0x18, 0x74, 0xcc, 0xa5, 0xeb, 0x5b, 0x73,
+	0x0a, 0xba, 0x03, 0xe1, 0x94, 0x79, 0xac, 0xcb, 0x86, 0x4c, 0xfa, 0x7a, 0x41, 0x8d, 0x73, 0x73,
+	0xb5, 0x81, 0xa5, 0xee, 0xc7, 0x5a, 0x40, 0xa2, 0xef, 0x61, 0x85, 0x4c, 0x64, 0x3f, 0x72, 0xa4,
+	0xa7, 0x24, 0xea, 0x45, 0xc5, 0xbd, 0xbd, 0xf9, 0xe3, 0x07, 0x4b, 0x58, 0x6b, 0x85, 0x8b, 0xbe,
+	0x80, 0x45, 0x97, 0x8e, 0x84, 0xa4, 0x1d, 0x36, 0xd6, 0x61, 0x34, 0x5e, 0xbb, 0x1c, 0x5c, 0xd4,
+	0x0a, 0x96, 0x4a, 0x9a, 0x3f, 0x5a, 0x85, 0xb8, 0x6c, 0x8e, 0xd1, 0xa7, 0x10, 0x4e, 0x3c, 0xea,
+	0x76, 0x88, 0x43, 0xb9, 0xd4, 0x4b, 0xca, 0x8a, 0x62, 0x94, 0x39, 0x88, 0x12, 0x51, 0xa7, 0x09,
+	0x67, 0xf7, 0x26, 0xb4, 0xc3, 0x6c, 0xbd, 0x7c, 0xd5, 0xe9, 0x67, 0x95, 0x34, 0x8f, 0xac, 0x42,
+	0x5c, 0x36, 0xed, 0xea, 0x3e, 0x2c, 0x2f, 0x7a, 0x82, 0xb6, 0x60, 0x7a, 0x40, 0xfd, 0x64, 0xdb,
+	0xa2, 0x10, 0x6d, 0xc3, 0xec, 0x94, 0x0c, 0x27, 0xf1, 0xb6, 0x95, 0xad, 0xf8, 0xb0, 0x9f, 0xfa,
+	0x1a, 0x54, 0x07, 0xb0, 0xb2, 0x3c, 0x52, 0xb4, 0xac, 0xd2, 0x1f, 0xbf, 0x5b, 0xd6, 0x28, 0x8e,
+	0xb4, 0x4a, 0x31, 0xa0, 0xbc, 0xa3, 0x2a, 0xa9, 0x58, 0xab, 0xca, 0x9c, 0x44, 0xe5, 0xcf, 0x61,
+	0x21, 0x2e, 0x33, 0x5b, 0x4f, 0x2b, 0xa9, 0xa5, 0xe0, 0xa2, 0x96, 0x3f, 0x89, 0x72, 0xe6, 0x91,
+	0x95, 0x57, 0x45, 0xd3, 0x6e, 0xfc, 0x9d, 0x82, 0x1f, 0xfe, 0x24, 0x5d, 0x4a, 0x46, 0xca, 0x4c,
+	0xcf, 0xa2, 0xf7, 0x26, 0xd4, 0x93, 0xab, 0x9b, 0x0c, 0xde, 0x6b, 0x93, 0x23, 0xdd, 0x84, 0x0d,
+	0x95, 0xba, 0x1b, 0x96, 0x8a, 0xd1, 0x1d, 0x98, 0x25, 0xa7, 0x92, 0xba, 0x7a, 0xfa, 0xda, 0x57,
+	0x96, 0x51, 0x2f, 0x2b, 0x86, 0xa3, 0xdb, 0x30, 0x1b, 0x3d, 0x52, 0x4f, 0xcf, 0xa8, 0x0d, 0xaf,
+	0x5c, 0xb6, 0x4b, 0x8f, 0x41, 0x61, 0x6b, 0x3b, 0xde, 0x71, 0x2b, 0x2e, 0xa2, 0x3d, 0x78, 0x83,
+	0x9e, 0xf5, 0x86, 0x13, 0x9b, 0x76, 0x62, 0x74, 0x76, 0x23, 0xba, 0x9c, 0x80, 0x7e, 0x50, 0x24,
+	0x73, 0xfd, 0x19, 0xe5, 0x14, 0xad, 0xbe, 0x4c, 0xbb, 0xfe, 0x21, 0xed, 0xda, 0x30, 0x17, 0xfb,
+	0x88, 0xee, 0xc2, 0x5c, 0xec, 0x2b, 0xba, 0xb5, 0xea, 0xda, 0x06, 0xbf, 0xab, 0x1f, 0x6d, 0xdc,
+	0xed, 0x06, 0xfa, 0xe5, 0x9f, 0xff, 0x7e, 0x4b, 0x95, 0x1b, 0xf9, 0xe4, 0x47, 0xbf, 0x0f, 0xbe,
+	0xfc, 0x0a, 0xb4, 0xff, 0x04, 0xe7, 0x33, 0x0c, 0x5e, 0xcc, 0x30, 0x78, 0x39, 0xc3, 0xda, 0xab,
+	0x19, 0xd6, 0x5e, 0xcf, 0xb0, 0xf6, 0x66, 0x86, 0xb5, 0xb7, 0x33, 0x0c, 0x1e, 0x04, 0x18, 0x3c,
+	0x0c, 0xb0, 0xf6, 0x24, 0xc0, 0xe0, 0x69, 0x80, 0xb5, 0x67, 0x01, 0xd6, 0x9e, 0x07, 0x58, 0x3b,
+	0x0f, 0x30, 0x78, 0x11, 0x60, 0xf0, 0x32, 0xc0, 0xda, 0xab, 0x00, 0x83, 0xd7, 0x01, 0xd6, 0xde,
+	0x04, 0x18, 0xbc, 0x0d, 0xb0, 0xf6, 0x20, 0xc4, 0xda, 0xc3, 0x10, 0x83, 0x47, 0x21, 0xd6, 0x7e,
+	0x0f, 0x31, 0xf8, 0x23, 0xc4, 0xda, 0x93, 0x10, 0x6b, 0x4f, 0x43, 0x0c, 0x9e, 0x85, 0x18, 0x3c,
+	0x0f, 0x31, 0xb8, 0xdb, 0x72, 0x84, 0x21, 0xfb, 0x54, 0xf6, 0x19, 0x77, 0x3c, 0x83, 0x53, 0x79,
+	0x5f, 0xb8, 0x83, 0xd6, 0xf2, 0x2f, 0x77, 0xba, 0xd7, 0x1a, 0x0f, 0x9c, 0x96, 0x94, 0x7c, 0xdc,
+	0xed, 0xe6, 0xd4, 0xa5, 0xee, 0xfd, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x8f, 0x3c, 0x37, 0xb8,
+	0x06, 0x00, 0x00,
 }
 
 func (this *Event) Equal(that interface{}) bool {
@@ -529,6 +563,30 @@ func (this *StreamEventsRequest) Equal(that interface{}) bool {
 	} else if !this.After.Equal(*that1.After) {
 		return false
 	}
+	if len(this.Names) != len(that1.Names) {
+		return false
+	}
+	for i := range this.Names {
+		if this.Names[i] != that1.Names[i] {
+			return false
+		}
+	}
+	if len(this.ExcludeNames) != len(that1.ExcludeNames) {
+		return false
+	}
+	for i := range this.ExcludeNames {
+		if this.ExcludeNames[i] != that1.ExcludeNames[i] {
+			return false
+		}
+	}
+	if len(this.CorrelationIDs) != len(that1.CorrelationIDs) {
+		return false
+	}
+	for i := range this.CorrelationIDs {
+		if this.CorrelationIDs[i] != that1.CorrelationIDs[i] {
+			return false
+		}
+	}
 	return true
 }
 
@@ -853,6 +911,33 @@ func (m *StreamEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CorrelationIDs) > 0 {
+		for iNdEx := len(m.CorrelationIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CorrelationIDs[iNdEx])
+			copy(dAtA[i:], m.CorrelationIDs[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.CorrelationIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ExcludeNames) > 0 {
+		for iNdEx := len(m.ExcludeNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludeNames[iNdEx])
+			copy(dAtA[i:], m.ExcludeNames[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ExcludeNames[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.After != nil {
 		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.After, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.After):])
 		if err5 != nil {
@@ -966,6 +1051,21 @@ func NewPopulatedStreamEventsRequest(r randyEvents, easy bool) *StreamEventsRequ
 	if r.Intn(5) != 0 {
 		this.After = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	}
+	v8 := r.Intn(10)
+	this.Names = make([]string, v8)
+	for i := 0; i < v8; i++ {
+		this.Names[i] = randStringEvents(r)
+	}
+	v9 := r.Intn(10)
+	this.ExcludeNames = make([]string, v9)
+	for i := 0; i < v9; i++ {
+		this.ExcludeNames[i] = randStringEvents(r)
+	}
+	v10 := r.Intn(10)
+	this.CorrelationIDs = make([]string, v10)
+	for i := 0; i < v10; i++ {
+		this.CorrelationIDs[i] = randStringEvents(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -990,9 +1090,9 @@ func randUTF8RuneEvents(r randyEvents) rune {
 	return rune(ru + 61)
 }
 func randStringEvents(r randyEvents) string {
-	v8 := r.Intn(100)
-	tmps := make([]rune, v8)
-	for i := 0; i < v8; i++ {
+	v11 := r.Intn(100)
+	tmps := make([]rune, v11)
+	for i := 0; i < v11; i++ {
 		tmps[i] = randUTF8RuneEvents(r)
 	}
 	return string(tmps)
@@ -1014,11 +1114,11 @@ func randFieldEvents(dAtA []byte, r randyEvents, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateEvents(dAtA, uint64(key))
-		v9 := r.Int63()
+		v12 := r.Int63()
 		if r.Intn(2) == 0 {
-			v9 *= -1
+			v12 *= -1
 		}
-		dAtA = encodeVarintPopulateEvents(dAtA, uint64(v9))
+		dAtA = encodeVarintPopulateEvents(dAtA, uint64(v12))
 	case 1:
 		dAtA = encodeVarintPopulateEvents(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1150,6 +1250,24 @@ func (m *StreamEventsRequest) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.After)
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.ExcludeNames) > 0 {
+		for _, s := range m.ExcludeNames {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.CorrelationIDs) > 0 {
+		for _, s := range m.CorrelationIDs {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1220,6 +1338,9 @@ func (this *StreamEventsRequest) String() string {
 		`Identifiers:` + repeatedStringForIdentifiers + `,`,
 		`Tail:` + fmt.Sprintf("%v", this.Tail) + `,`,
 		`After:` + strings.Replace(fmt.Sprintf("%v", this.After), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Names:` + fmt.Sprintf("%v", this.Names) + `,`,
+		`ExcludeNames:` + fmt.Sprintf("%v", this.ExcludeNames) + `,`,
+		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2047,6 +2168,102 @@ func (m *StreamEventsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludeNames = append(m.ExcludeNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorrelationIDs = append(m.CorrelationIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -37,13 +37,19 @@ var EventFieldPathsTopLevel = []string{
 }
 var StreamEventsRequestFieldPathsNested = []string{
 	"after",
+	"correlation_ids",
+	"exclude_names",
 	"identifiers",
+	"names",
 	"tail",
 }
 
 var StreamEventsRequestFieldPathsTopLevel = []string{
 	"after",
+	"correlation_ids",
+	"exclude_names",
 	"identifiers",
+	"names",
 	"tail",
 }
 var Event_AuthenticationFieldPathsNested = []string{
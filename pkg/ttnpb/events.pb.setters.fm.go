@@ -195,6 +195,33 @@ func (dst *StreamEventsRequest) SetFields(src *StreamEventsRequest, paths ...str
 			} else {
 				dst.After = nil
 			}
+		case "names":
+			if len(subs) > 0 {
+				return fmt.Errorf("'names' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Names = src.Names
+			} else {
+				dst.Names = nil
+			}
+		case "exclude_names":
+			if len(subs) > 0 {
+				return fmt.Errorf("'exclude_names' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExcludeNames = src.ExcludeNames
+			} else {
+				dst.ExcludeNames = nil
+			}
+		case "correlation_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'correlation_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CorrelationIDs = src.CorrelationIDs
+			} else {
+				dst.CorrelationIDs = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -241,6 +241,69 @@ func (m *StreamEventsRequest) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "names":
+
+			if len(m.GetNames()) > 20 {
+				return StreamEventsRequestValidationError{
+					field:  "names",
+					reason: "value must contain no more than 20 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetNames() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 100 {
+					return StreamEventsRequestValidationError{
+						field:  fmt.Sprintf("names[%v]", idx),
+						reason: "value length must be at most 100 runes",
+					}
+				}
+
+			}
+
+		case "exclude_names":
+
+			if len(m.GetExcludeNames()) > 20 {
+				return StreamEventsRequestValidationError{
+					field:  "exclude_names",
+					reason: "value must contain no more than 20 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetExcludeNames() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 100 {
+					return StreamEventsRequestValidationError{
+						field:  fmt.Sprintf("exclude_names[%v]", idx),
+						reason: "value length must be at most 100 runes",
+					}
+				}
+
+			}
+
+		case "correlation_ids":
+
+			if len(m.GetCorrelationIDs()) > 20 {
+				return StreamEventsRequestValidationError{
+					field:  "correlation_ids",
+					reason: "value must contain no more than 20 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetCorrelationIDs() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 100 {
+					return StreamEventsRequestValidationError{
+						field:  fmt.Sprintf("correlation_ids[%v]", idx),
+						reason: "value length must be at most 100 runes",
+					}
+				}
+
+			}
+
 		default:
 			return StreamEventsRequestValidationError{
 				field:  name,
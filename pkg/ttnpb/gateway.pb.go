@@ -487,9 +487,12 @@ type Gateway struct {
 	// The LoRa Basics Station LNS secret.
 	// This is either an auth token (such as an API Key) or a TLS private certificate.
 	// Requires the RIGHT_GATEWAY_READ_SECRETS for reading and RIGHT_GATEWAY_WRITE_SECRETS for updating this value.
-	LBSLNSSecret         *Secret  `protobuf:"bytes,22,opt,name=lbs_lns_secret,json=lbsLnsSecret,proto3" json:"lbs_lns_secret,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	LBSLNSSecret *Secret `protobuf:"bytes,22,opt,name=lbs_lns_secret,json=lbsLnsSecret,proto3" json:"lbs_lns_secret,omitempty"`
+	// Source address ranges (CIDR notation) from which the Gateway Server accepts Semtech UDP packets of this gateway.
+	// If empty, packets are accepted from any source address.
+	UDPAllowedSourceCIDRs []string `protobuf:"bytes,23,rep,name=udp_allowed_source_cidrs,json=udpAllowedSourceCidrs,proto3" json:"udp_allowed_source_cidrs,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *Gateway) Reset()      { *m = Gateway{} }
@@ -664,6 +667,13 @@ func (m *Gateway) GetLBSLNSSecret() *Secret {
 	return nil
 }
 
+func (m *Gateway) GetUDPAllowedSourceCIDRs() []string {
+	if m != nil {
+		return m.UDPAllowedSourceCIDRs
+	}
+	return nil
+}
+
 type Gateways struct {
 	Gateways             []*Gateway `protobuf:"bytes,1,rep,name=gateways,proto3" json:"gateways,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -1501,9 +1511,13 @@ type GatewayConnectionStats struct {
 	DownlinkCount          uint64                                 `protobuf:"varint,8,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
 	RoundTripTimes         *GatewayConnectionStats_RoundTripTimes `protobuf:"bytes,9,opt,name=round_trip_times,json=roundTripTimes,proto3" json:"round_trip_times,omitempty"`
 	// Statistics for each sub band.
-	SubBands             []*GatewayConnectionStats_SubBand `protobuf:"bytes,10,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+	SubBands []*GatewayConnectionStats_SubBand `protobuf:"bytes,10,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
+	// Downlink channel occupancy for each frequency, as observed from transmission acknowledgments.
+	ChannelOccupancy []*GatewayConnectionStats_ChannelOccupancy `protobuf:"bytes,11,rep,name=channel_occupancy,json=channelOccupancy,proto3" json:"channel_occupancy,omitempty"`
+	// Downlink load of the gateway, including the queue depth and recent transmission failures.
+	DownlinkLoad         *GatewayDownlinkLoad `protobuf:"bytes,12,opt,name=downlink_load,json=downlinkLoad,proto3" json:"downlink_load,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *GatewayConnectionStats) Reset()      { *m = GatewayConnectionStats{} }
@@ -1608,6 +1622,20 @@ func (m *GatewayConnectionStats) GetSubBands() []*GatewayConnectionStats_SubBand
 	return nil
 }
 
+func (m *GatewayConnectionStats) GetChannelOccupancy() []*GatewayConnectionStats_ChannelOccupancy {
+	if m != nil {
+		return m.ChannelOccupancy
+	}
+	return nil
+}
+
+func (m *GatewayConnectionStats) GetDownlinkLoad() *GatewayDownlinkLoad {
+	if m != nil {
+		return m.DownlinkLoad
+	}
+	return nil
+}
+
 type GatewayConnectionStats_RoundTripTimes struct {
 	Min                  time.Duration `protobuf:"bytes,1,opt,name=min,proto3,stdduration" json:"min"`
 	Max                  time.Duration `protobuf:"bytes,2,opt,name=max,proto3,stdduration" json:"max"`
@@ -1746,6 +1774,88 @@ func (m *GatewayConnectionStats_SubBand) GetDownlinkUtilization() float32 {
 	return 0
 }
 
+type GatewayConnectionStats_ChannelOccupancy struct {
+	Frequency uint64 `protobuf:"varint,1,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	// Fraction of recent downlink transmissions that the gateway could not transmit because the channel was busy.
+	Occupancy float32 `protobuf:"fixed32,2,opt,name=occupancy,proto3" json:"occupancy,omitempty"`
+	// Number of acknowledged downlink transmissions.
+	TxCount uint64 `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// Number of downlink transmissions that the gateway could not transmit because the channel was busy.
+	BusyCount            uint64     `protobuf:"varint,4,opt,name=busy_count,json=busyCount,proto3" json:"busy_count,omitempty"`
+	LastBusyAt           *time.Time `protobuf:"bytes,5,opt,name=last_busy_at,json=lastBusyAt,proto3,stdtime" json:"last_busy_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GatewayConnectionStats_ChannelOccupancy) Reset() {
+	*m = GatewayConnectionStats_ChannelOccupancy{}
+}
+func (*GatewayConnectionStats_ChannelOccupancy) ProtoMessage() {}
+func (*GatewayConnectionStats_ChannelOccupancy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1df6bae1ac946b39, []int{21, 2}
+}
+func (m *GatewayConnectionStats_ChannelOccupancy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayConnectionStats_ChannelOccupancy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayConnectionStats_ChannelOccupancy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayConnectionStats_ChannelOccupancy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayConnectionStats_ChannelOccupancy.Merge(m, src)
+}
+func (m *GatewayConnectionStats_ChannelOccupancy) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayConnectionStats_ChannelOccupancy) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayConnectionStats_ChannelOccupancy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayConnectionStats_ChannelOccupancy proto.InternalMessageInfo
+
+func (m *GatewayConnectionStats_ChannelOccupancy) GetFrequency() uint64 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *GatewayConnectionStats_ChannelOccupancy) GetOccupancy() float32 {
+	if m != nil {
+		return m.Occupancy
+	}
+	return 0
+}
+
+func (m *GatewayConnectionStats_ChannelOccupancy) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *GatewayConnectionStats_ChannelOccupancy) GetBusyCount() uint64 {
+	if m != nil {
+		return m.BusyCount
+	}
+	return 0
+}
+
+func (m *GatewayConnectionStats_ChannelOccupancy) GetLastBusyAt() *time.Time {
+	if m != nil {
+		return m.LastBusyAt
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GatewayBrand)(nil), "ttn.lorawan.v3.GatewayBrand")
 	golang_proto.RegisterType((*GatewayBrand)(nil), "ttn.lorawan.v3.GatewayBrand")
@@ -1805,6 +1915,8 @@ func init() {
 	golang_proto.RegisterType((*GatewayConnectionStats_RoundTripTimes)(nil), "ttn.lorawan.v3.GatewayConnectionStats.RoundTripTimes")
 	proto.RegisterType((*GatewayConnectionStats_SubBand)(nil), "ttn.lorawan.v3.GatewayConnectionStats.SubBand")
 	golang_proto.RegisterType((*GatewayConnectionStats_SubBand)(nil), "ttn.lorawan.v3.GatewayConnectionStats.SubBand")
+	proto.RegisterType((*GatewayConnectionStats_ChannelOccupancy)(nil), "ttn.lorawan.v3.GatewayConnectionStats.ChannelOccupancy")
+	golang_proto.RegisterType((*GatewayConnectionStats_ChannelOccupancy)(nil), "ttn.lorawan.v3.GatewayConnectionStats.ChannelOccupancy")
 }
 
 func init() { proto.RegisterFile("lorawan-stack/api/gateway.proto", fileDescriptor_1df6bae1ac946b39) }
@@ -1813,178 +1925,189 @@ func init() {
 }
 
 var fileDescriptor_1df6bae1ac946b39 = []byte{
-	// 2733 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xf5, 0xe7, 0x90, 0xfa, 0xa0, 0x86, 0xd4, 0x87, 0x27, 0x8a, 0xb2, 0xa6, 0xed, 0xa5, 0xc2, 0x28,
-	0x89, 0xe4, 0xbf, 0x49, 0xfd, 0x43, 0x27, 0x45, 0xab, 0xc6, 0x51, 0xb8, 0x92, 0x1d, 0x08, 0x96,
-	0x13, 0x77, 0x65, 0x25, 0x68, 0xec, 0x78, 0x31, 0xdc, 0x1d, 0x52, 0x5b, 0x2d, 0x77, 0xd9, 0xdd,
-	0x59, 0x59, 0x4c, 0x1c, 0x20, 0x28, 0x02, 0x34, 0xc8, 0x21, 0x0d, 0x72, 0x0a, 0x82, 0x1e, 0x72,
-	0x49, 0x11, 0xb4, 0x3d, 0x04, 0x3d, 0x14, 0x39, 0xf4, 0x90, 0x43, 0x5b, 0xe4, 0x54, 0xf8, 0x14,
-	0x04, 0x2d, 0xa0, 0x46, 0xd4, 0x25, 0x05, 0x7a, 0x08, 0xda, 0x4b, 0xa0, 0x53, 0x31, 0xb3, 0xb3,
-	0xcb, 0x25, 0xf5, 0x11, 0x2b, 0xb6, 0xd3, 0x9e, 0x38, 0xf3, 0xe6, 0xf7, 0x3e, 0xe6, 0xcd, 0xdb,
-	0x37, 0x6f, 0x1e, 0x61, 0xde, 0x72, 0x5c, 0x7c, 0x03, 0xdb, 0x45, 0x8f, 0x62, 0x7d, 0x7d, 0x16,
-	0x37, 0xcd, 0xd9, 0x3a, 0xa6, 0xe4, 0x06, 0x6e, 0x95, 0x9a, 0xae, 0x43, 0x1d, 0x34, 0x42, 0xa9,
-	0x5d, 0x12, 0xa0, 0xd2, 0xc6, 0xd9, 0x5c, 0xa5, 0x6e, 0xd2, 0x35, 0xbf, 0x5a, 0xd2, 0x9d, 0xc6,
-	0x2c, 0xb1, 0x37, 0x9c, 0x56, 0xd3, 0x75, 0x36, 0x5b, 0xb3, 0x1c, 0xac, 0x17, 0xeb, 0xc4, 0x2e,
-	0x6e, 0x60, 0xcb, 0x34, 0x30, 0x25, 0xb3, 0x7b, 0x06, 0x81, 0xc8, 0x5c, 0x31, 0x26, 0xa2, 0xee,
-	0xd4, 0x9d, 0x80, 0xb9, 0xea, 0xd7, 0xf8, 0x8c, 0x4f, 0xf8, 0x48, 0xc0, 0xe5, 0xba, 0xe3, 0xd4,
-	0x2d, 0xd2, 0x41, 0x19, 0xbe, 0x8b, 0xa9, 0xe9, 0xd8, 0x62, 0x7d, 0xb2, 0x77, 0xbd, 0x66, 0x12,
-	0xcb, 0xd0, 0x1a, 0xd8, 0x5b, 0x17, 0x88, 0x93, 0xbd, 0x08, 0x8f, 0xba, 0xbe, 0x4e, 0xc5, 0x6a,
-	0xbe, 0x77, 0x95, 0x9a, 0x0d, 0xe2, 0x51, 0xdc, 0x68, 0x0a, 0xc0, 0xd4, 0x5e, 0x1f, 0xe9, 0x8e,
-	0x4d, 0xb1, 0x4e, 0x35, 0xd3, 0xae, 0x85, 0x66, 0x9e, 0xda, 0x8b, 0x22, 0xb6, 0xdf, 0xf0, 0xc4,
-	0xf2, 0x43, 0x7b, 0x97, 0x4d, 0x83, 0xd8, 0xd4, 0xac, 0x99, 0xc4, 0x0d, 0x41, 0x93, 0x7b, 0x41,
-	0x0d, 0x42, 0xb1, 0x81, 0x29, 0x0e, 0x9d, 0xb1, 0x17, 0xe1, 0x9a, 0xf5, 0x35, 0x1a, 0x4a, 0xd8,
-	0xe7, 0x3c, 0x3d, 0xa2, 0xbb, 0x24, 0x04, 0x14, 0xd6, 0x61, 0xf6, 0x99, 0xe0, 0x80, 0x15, 0x17,
-	0xdb, 0x06, 0x9a, 0x80, 0x49, 0xd3, 0x90, 0xc0, 0x24, 0x98, 0x1e, 0x52, 0x06, 0xda, 0x5b, 0xf9,
-	0xe4, 0xd2, 0xa2, 0x9a, 0x34, 0x0d, 0x84, 0x60, 0x9f, 0x8d, 0x1b, 0x44, 0x4a, 0xb2, 0x15, 0x95,
-	0x8f, 0xd1, 0x71, 0x98, 0xf2, 0x5d, 0x4b, 0x4a, 0x71, 0xf0, 0x60, 0x7b, 0x2b, 0x9f, 0x5a, 0x55,
-	0x97, 0x55, 0x46, 0x43, 0xe3, 0xb0, 0xdf, 0x72, 0xea, 0x8e, 0x27, 0xf5, 0x4d, 0xa6, 0xa6, 0x87,
-	0xd4, 0x60, 0x52, 0xf8, 0x08, 0x44, 0xda, 0x2e, 0x39, 0x06, 0xb1, 0xd0, 0x25, 0x98, 0xae, 0x32,
-	0xb5, 0x5a, 0xa4, 0xb3, 0xbc, 0xab, 0x4c, 0xb9, 0x05, 0x69, 0xaa, 0x2c, 0x5f, 0xbf, 0x8a, 0x8b,
-	0x2f, 0xff, 0x7f, 0xf1, 0x07, 0x2f, 0x4d, 0xcf, 0xcf, 0x5d, 0x2d, 0xbe, 0x34, 0x1f, 0x4e, 0x67,
-	0x5e, 0x29, 0x9f, 0x79, 0x75, 0xaa, 0xbd, 0x95, 0x1f, 0xe4, 0x16, 0x2f, 0x2d, 0xaa, 0x83, 0x5c,
-	0xc6, 0x92, 0x81, 0xce, 0x71, 0xe3, 0xb9, 0x89, 0x4a, 0xf1, 0xf6, 0x05, 0xf5, 0xee, 0x31, 0xd5,
-	0xd9, 0x63, 0xe1, 0x17, 0x49, 0x78, 0x5c, 0x98, 0xfc, 0x3c, 0x71, 0x3d, 0xd3, 0xb1, 0x97, 0x3a,
-	0xc7, 0x74, 0xb7, 0xed, 0xbf, 0x04, 0xd3, 0x0d, 0xe6, 0x17, 0x2d, 0xda, 0xc5, 0x51, 0xc4, 0x71,
-	0x97, 0x32, 0x71, 0x5c, 0xc6, 0x92, 0x81, 0x66, 0xe0, 0xd8, 0x1a, 0x76, 0x8d, 0x1b, 0xd8, 0x25,
-	0xda, 0x46, 0x60, 0xbc, 0xd8, 0xdb, 0x68, 0x48, 0x17, 0x7b, 0x62, 0xd0, 0x9a, 0xe9, 0x36, 0xba,
-	0xa0, 0x7d, 0x01, 0x34, 0xa4, 0x0b, 0x68, 0xe1, 0x5f, 0xc9, 0xe8, 0x10, 0x55, 0x6c, 0x98, 0x0e,
-	0x9a, 0x80, 0x03, 0xc4, 0xc6, 0x55, 0x8b, 0x70, 0x17, 0xa4, 0x55, 0x31, 0x43, 0x27, 0xe0, 0x90,
-	0xbe, 0x66, 0x36, 0x35, 0xda, 0x6a, 0x86, 0x71, 0x93, 0x66, 0x84, 0x2b, 0xad, 0x26, 0x41, 0x27,
-	0xe1, 0x50, 0xcd, 0x25, 0x3f, 0xf5, 0x89, 0xad, 0xb7, 0xb8, 0x51, 0x7d, 0x6a, 0x87, 0x80, 0x66,
-	0x61, 0xc6, 0xf5, 0x3c, 0x53, 0x73, 0x6a, 0x35, 0x8f, 0x50, 0x6e, 0x49, 0x52, 0x19, 0x69, 0x6f,
-	0xe5, 0xa1, 0xba, 0xb2, 0xb2, 0xf4, 0x1c, 0xa7, 0xaa, 0x90, 0x41, 0x82, 0x31, 0x7a, 0x01, 0x8e,
-	0xd1, 0x4d, 0x4d, 0x77, 0xec, 0x9a, 0x59, 0x17, 0xe9, 0x40, 0xea, 0x9f, 0x04, 0xd3, 0x99, 0xf2,
-	0x99, 0x52, 0x77, 0xc6, 0x2a, 0xc5, 0x6d, 0x2f, 0x5d, 0xd9, 0x5c, 0x88, 0xf3, 0xa8, 0xa3, 0xb4,
-	0x9b, 0x90, 0x7b, 0x1d, 0xc0, 0xd1, 0x1e, 0x10, 0x7a, 0x08, 0x0e, 0x37, 0x4c, 0x5b, 0xeb, 0xd8,
-	0x0f, 0xb8, 0xfd, 0xd9, 0x86, 0x69, 0x5f, 0x88, 0xb6, 0xc0, 0x40, 0x78, 0x33, 0x06, 0x4a, 0x0a,
-	0x10, 0xde, 0xec, 0x80, 0x1e, 0x85, 0xa3, 0xb6, 0x43, 0xf5, 0x35, 0xad, 0xd7, 0x17, 0x23, 0x9c,
-	0x1c, 0x01, 0x0b, 0x9f, 0x01, 0x38, 0xd2, 0x1d, 0x86, 0xe8, 0x12, 0x4c, 0x99, 0x86, 0xc7, 0x75,
-	0x67, 0xca, 0x33, 0x07, 0xec, 0x72, 0x6f, 0xcc, 0x2a, 0x63, 0xbb, 0x4a, 0xff, 0x9b, 0x20, 0x39,
-	0x06, 0x3e, 0xdd, 0xca, 0x27, 0x6e, 0x6d, 0xe5, 0x81, 0xca, 0xe4, 0xb0, 0x53, 0x6c, 0xae, 0x39,
-	0xd4, 0xf1, 0xa4, 0x24, 0xff, 0x64, 0xc5, 0x0c, 0x3d, 0x0e, 0x07, 0x5c, 0xe6, 0x2a, 0x4f, 0x4a,
-	0x4d, 0xa6, 0xa6, 0x33, 0xe5, 0x93, 0x87, 0xf9, 0x53, 0x15, 0x58, 0xf4, 0x20, 0xcc, 0xea, 0x96,
-	0xa3, 0xaf, 0x6b, 0x9e, 0xe3, 0xbb, 0x3a, 0x91, 0x06, 0x27, 0xc1, 0xf4, 0xb0, 0x9a, 0xe1, 0xb4,
-	0x15, 0x4e, 0x9a, 0xeb, 0xfb, 0xf8, 0xfd, 0x7c, 0xa2, 0xf0, 0xcf, 0x2c, 0x1c, 0x14, 0x12, 0xd0,
-	0x85, 0xf8, 0x8e, 0x0a, 0x07, 0xe8, 0xb9, 0x8d, 0xad, 0x2c, 0x40, 0xa8, 0xbb, 0x04, 0x53, 0x62,
-	0x68, 0x98, 0x72, 0xbf, 0x67, 0xca, 0xb9, 0x52, 0x90, 0xd6, 0x4b, 0x61, 0x5a, 0x2f, 0x5d, 0x09,
-	0xd3, 0xba, 0x92, 0x66, 0xec, 0x6f, 0xff, 0x3d, 0x0f, 0xd4, 0x21, 0xc1, 0x57, 0xa1, 0x4c, 0x88,
-	0xdf, 0x34, 0x42, 0x21, 0xa9, 0xa3, 0x08, 0x11, 0x7c, 0x15, 0x8a, 0x4e, 0x88, 0x8c, 0xd2, 0x17,
-	0xa4, 0xc8, 0x5d, 0xa5, 0xcf, 0x4d, 0x4a, 0x65, 0x91, 0x3e, 0x4f, 0xc3, 0x8c, 0x41, 0x3c, 0xdd,
-	0x35, 0x9b, 0x51, 0xb8, 0x0e, 0x29, 0xe9, 0x5d, 0xa5, 0xdf, 0x4d, 0x49, 0xb7, 0x46, 0xd5, 0xf8,
-	0x22, 0xf2, 0x21, 0xc4, 0x94, 0xba, 0x66, 0xd5, 0xa7, 0xc4, 0x93, 0x06, 0xf8, 0x49, 0x3c, 0x7a,
-	0x80, 0x87, 0x4a, 0x95, 0x08, 0x79, 0xde, 0xa6, 0x6e, 0x4b, 0x39, 0xb3, 0xab, 0xcc, 0xbc, 0x07,
-	0x1e, 0x29, 0xdc, 0x56, 0x26, 0x51, 0x63, 0x8a, 0xd0, 0x53, 0x30, 0x1b, 0xbf, 0xda, 0xa4, 0x41,
-	0xae, 0xf8, 0x44, 0xaf, 0xe2, 0x85, 0x00, 0xb3, 0x64, 0xd7, 0x1c, 0x35, 0xa3, 0x77, 0x26, 0xe8,
-	0x1a, 0xcc, 0x88, 0x6c, 0xa2, 0xb1, 0x93, 0x4d, 0xdf, 0x79, 0xac, 0xc2, 0x8d, 0x10, 0xe5, 0xa1,
-	0x3f, 0x01, 0x38, 0x21, 0xaa, 0x13, 0xcd, 0x23, 0xee, 0x06, 0x71, 0x35, 0x6c, 0x18, 0x2e, 0xf1,
-	0x3c, 0x69, 0x88, 0x3b, 0xf3, 0x2d, 0xb0, 0xab, 0xbc, 0x09, 0xdc, 0x9f, 0x83, 0xf2, 0xeb, 0xe0,
-	0xfa, 0xf4, 0xfc, 0x1c, 0xdb, 0x30, 0x2e, 0xbe, 0x5c, 0x29, 0xbe, 0xc8, 0xf6, 0x7b, 0x33, 0x36,
-	0xee, 0x0c, 0xaf, 0x15, 0x5f, 0x3a, 0x1d, 0x5b, 0x98, 0xb9, 0x56, 0x9a, 0x39, 0xcd, 0xf8, 0x2a,
-	0xc5, 0x17, 0x85, 0x9f, 0x6e, 0xc6, 0xc6, 0x9d, 0x21, 0xe7, 0xeb, 0x2c, 0xcc, 0x4c, 0xcf, 0xcf,
-	0xcd, 0x5d, 0x65, 0xa3, 0x57, 0x1e, 0x3b, 0xf3, 0xc4, 0xab, 0x33, 0xf3, 0x53, 0x37, 0xaf, 0x4f,
-	0xa9, 0xe3, 0xc2, 0xdc, 0x15, 0x6e, 0x6d, 0x25, 0x30, 0x16, 0xe5, 0x61, 0x06, 0xfb, 0xd4, 0xd1,
-	0x82, 0xb8, 0x91, 0x20, 0xcf, 0xa2, 0x90, 0x91, 0x56, 0x39, 0x05, 0x3d, 0x0c, 0x47, 0x82, 0x35,
-	0x4d, 0x5f, 0xc3, 0xb6, 0x4d, 0x2c, 0x29, 0xc3, 0xd3, 0xe9, 0x70, 0x40, 0x5d, 0x08, 0x88, 0xe8,
-	0x02, 0x3c, 0x16, 0xe5, 0x11, 0xad, 0x69, 0x61, 0xe6, 0x74, 0x29, 0xcb, 0x3d, 0x91, 0x0b, 0x42,
-	0xef, 0xe9, 0xf6, 0x56, 0x7e, 0x34, 0xca, 0x2a, 0x97, 0x2d, 0x6c, 0x2f, 0x2d, 0xaa, 0xa3, 0xb5,
-	0x2e, 0x82, 0x81, 0x2e, 0x43, 0xb4, 0x47, 0x8e, 0x27, 0x8d, 0xb3, 0xb4, 0xa0, 0x14, 0x76, 0x95,
-	0xcc, 0x3b, 0x20, 0x3d, 0x96, 0x2e, 0x84, 0xf2, 0xc6, 0x7a, 0xe4, 0x79, 0xea, 0x58, 0x8f, 0x40,
-	0x0f, 0x3d, 0x0d, 0xd3, 0xd8, 0xa6, 0xc4, 0xb6, 0xb1, 0x27, 0x0d, 0xf3, 0x18, 0x92, 0x0f, 0x08,
-	0x82, 0x4a, 0x00, 0x53, 0xfa, 0xd8, 0x89, 0xab, 0x11, 0x17, 0x4b, 0xa7, 0x1e, 0xc5, 0xd4, 0xf7,
-	0xb4, 0xa6, 0x5f, 0xb5, 0x4c, 0x5d, 0x1a, 0xe1, 0x5e, 0xca, 0x06, 0xc4, 0xcb, 0x9c, 0xc6, 0xd2,
-	0xa9, 0xe5, 0xe8, 0x3c, 0x49, 0x87, 0xb0, 0x51, 0x0e, 0x1b, 0x09, 0xc9, 0x02, 0xf8, 0x38, 0x9c,
-	0xf0, 0xf4, 0x35, 0x62, 0xf8, 0x16, 0xd1, 0x0c, 0xe7, 0x86, 0x6d, 0x99, 0xf6, 0xba, 0x66, 0x31,
-	0xe7, 0x8f, 0x71, 0xfc, 0x78, 0xb8, 0xba, 0x28, 0x16, 0x97, 0xd9, 0x31, 0x9c, 0x81, 0x88, 0xd8,
-	0x35, 0xc7, 0xd5, 0x89, 0x66, 0xf8, 0xb4, 0xa5, 0xe9, 0x2d, 0xdd, 0x22, 0xd2, 0x31, 0xce, 0x31,
-	0x26, 0x56, 0x16, 0x7d, 0xda, 0x5a, 0x60, 0x74, 0xf4, 0x13, 0x28, 0x45, 0xa2, 0x9b, 0x98, 0xae,
-	0xb1, 0xdb, 0xc9, 0xa3, 0x2e, 0x36, 0x6d, 0x2a, 0xa1, 0x49, 0x30, 0x3d, 0x52, 0x7e, 0xa4, 0xd7,
-	0x07, 0xa1, 0xb6, 0xcb, 0x98, 0xae, 0x2d, 0x44, 0x68, 0x9e, 0x13, 0x7e, 0xc6, 0xbe, 0x02, 0x75,
-	0xc2, 0xd8, 0x17, 0x81, 0x7e, 0x1c, 0xdb, 0x0f, 0xb6, 0x5b, 0xac, 0x62, 0xd5, 0x0c, 0x62, 0xe1,
-	0x96, 0x74, 0x1f, 0xff, 0xe4, 0x8e, 0xef, 0x49, 0x5c, 0x8b, 0xe2, 0x32, 0xe3, 0x79, 0x0b, 0xbc,
-	0xcb, 0xf2, 0x56, 0xb4, 0xe9, 0x4a, 0x20, 0x61, 0x91, 0x09, 0x40, 0xe7, 0xe0, 0x09, 0x11, 0x7b,
-	0x91, 0x6b, 0x6b, 0xae, 0xd3, 0xd0, 0x02, 0xc7, 0x4b, 0xf7, 0xf3, 0xdd, 0x4b, 0x01, 0x64, 0x59,
-	0x20, 0x2e, 0xb8, 0x4e, 0x63, 0x85, 0xaf, 0xa3, 0x67, 0xe1, 0x88, 0x55, 0xf5, 0x34, 0xcb, 0xf6,
-	0xb4, 0xa0, 0xf0, 0x94, 0x26, 0xb8, 0x45, 0x13, 0xbd, 0x7b, 0x5f, 0xe1, 0xab, 0xca, 0x58, 0x7b,
-	0x2b, 0x9f, 0x5d, 0x56, 0x56, 0x96, 0x9f, 0x5d, 0x09, 0x28, 0x6a, 0xd6, 0xaa, 0x7a, 0xcb, 0xb6,
-	0x17, 0xcc, 0x72, 0xe7, 0xe0, 0x68, 0x4f, 0x7a, 0x43, 0x63, 0x30, 0xb5, 0x4e, 0x82, 0x4b, 0x78,
-	0x48, 0x65, 0x43, 0x56, 0x7d, 0x6e, 0x60, 0xcb, 0x0f, 0xab, 0x8e, 0x60, 0x32, 0x97, 0xfc, 0x3e,
-	0x28, 0xcc, 0xc3, 0xb4, 0x08, 0x34, 0x0f, 0x9d, 0x85, 0x69, 0xf1, 0x39, 0xb2, 0x3b, 0x87, 0x05,
-	0xe5, 0x03, 0x07, 0xdd, 0x6d, 0x11, 0xb0, 0xf0, 0x1b, 0x00, 0x8f, 0x3d, 0x43, 0x68, 0xb8, 0xc0,
-	0xe2, 0xdc, 0xa3, 0x68, 0x15, 0x66, 0xc2, 0x44, 0x74, 0xa7, 0x37, 0x18, 0xac, 0x87, 0x28, 0x0f,
-	0xcd, 0x43, 0xd8, 0x79, 0xbc, 0x1c, 0x78, 0x91, 0x5d, 0x60, 0x90, 0x4b, 0xd8, 0x5b, 0x17, 0x1f,
-	0xcd, 0x50, 0x2d, 0x24, 0x14, 0x6e, 0xc2, 0x42, 0xc7, 0xd8, 0x98, 0xde, 0x0b, 0x8e, 0x7b, 0x7e,
-	0x75, 0x29, 0xb4, 0xfe, 0x79, 0x98, 0x22, 0xbe, 0xc9, 0xad, 0xce, 0x2a, 0x8b, 0x4c, 0xc6, 0x5f,
-	0xb7, 0xf2, 0x4f, 0xd4, 0x9d, 0x12, 0x5d, 0x23, 0x74, 0xcd, 0xb4, 0xeb, 0x5e, 0xc9, 0x26, 0xf4,
-	0x86, 0xe3, 0xae, 0xcf, 0x76, 0x3f, 0x27, 0x36, 0xce, 0xce, 0x36, 0xd7, 0xeb, 0xb3, 0xac, 0xc0,
-	0xf3, 0x4a, 0xe7, 0x57, 0x97, 0xbe, 0xf7, 0x38, 0x7b, 0x04, 0x30, 0xc9, 0x4c, 0x60, 0xe1, 0xb3,
-	0x24, 0xbc, 0x6f, 0xd9, 0xf4, 0x42, 0xfd, 0x5e, 0xa8, 0xef, 0x47, 0xec, 0x56, 0xb1, 0x2c, 0x5c,
-	0x75, 0x5c, 0x4c, 0x1d, 0x57, 0xb8, 0xab, 0xd8, 0xeb, 0xae, 0xe7, 0xdc, 0x3a, 0xb6, 0xcd, 0x97,
-	0x79, 0x44, 0x3d, 0xe7, 0xae, 0x7a, 0xc4, 0x8d, 0xed, 0x40, 0xed, 0x12, 0x71, 0xc7, 0x9e, 0x42,
-	0x37, 0x60, 0xbf, 0xe3, 0x1a, 0xc4, 0x15, 0xaf, 0x19, 0xbc, 0xab, 0x5c, 0x77, 0xaf, 0xa9, 0x89,
-	0xe8, 0x38, 0x34, 0xd3, 0x50, 0x33, 0xc5, 0xf8, 0x24, 0x1c, 0x13, 0xdf, 0x54, 0xb3, 0xc5, 0xf8,
-	0x8c, 0x5f, 0xef, 0x6a, 0x7f, 0x91, 0xff, 0xc4, 0x4a, 0x11, 0x35, 0x53, 0x8c, 0x4d, 0x02, 0x7d,
-	0x48, 0x86, 0xfd, 0x96, 0xd9, 0x30, 0x83, 0x22, 0x77, 0x98, 0x7f, 0xeb, 0xa7, 0x53, 0xd2, 0x97,
-	0x83, 0x6a, 0x40, 0x66, 0x8f, 0x92, 0x26, 0xae, 0x13, 0x5e, 0x1e, 0x0c, 0xab, 0x7c, 0x5c, 0xf8,
-	0x03, 0x80, 0xe3, 0x0b, 0x5c, 0x52, 0x4f, 0x1c, 0x2e, 0xc0, 0x41, 0x61, 0x88, 0x70, 0xea, 0x41,
-	0x11, 0xbd, 0x4f, 0xe0, 0x85, 0x9c, 0x48, 0xeb, 0x39, 0x9e, 0xe4, 0xb7, 0x38, 0x1e, 0x25, 0x1b,
-	0x97, 0xdf, 0x7d, 0x58, 0x85, 0x5f, 0x02, 0x38, 0x1e, 0xdc, 0x6c, 0xf7, 0xc2, 0xfc, 0x3b, 0xfe,
-	0x68, 0x7e, 0x05, 0xe0, 0xf1, 0x58, 0xd8, 0x56, 0x2e, 0x2f, 0x5d, 0x24, 0x9d, 0xe0, 0xbd, 0x47,
-	0x9f, 0x7a, 0x14, 0x06, 0xc9, 0xc3, 0xc3, 0x20, 0x15, 0x0b, 0x83, 0x77, 0x00, 0x7c, 0xa0, 0xf3,
-	0x79, 0x07, 0x76, 0xde, 0x63, 0x33, 0x27, 0xe1, 0xc0, 0x3a, 0x69, 0x75, 0xde, 0xa7, 0x43, 0xed,
-	0xad, 0x7c, 0xff, 0x45, 0xd2, 0x5a, 0x5a, 0x54, 0xfb, 0xd7, 0x49, 0x6b, 0xc9, 0x28, 0xfc, 0x05,
-	0xc0, 0x5c, 0x57, 0x6c, 0x7e, 0x27, 0x76, 0x9d, 0x88, 0xb7, 0x27, 0x7a, 0x0b, 0xed, 0x27, 0xe1,
-	0x40, 0xd0, 0x14, 0xe1, 0x4f, 0x98, 0x91, 0xf2, 0xfd, 0xbd, 0xea, 0x54, 0xb6, 0xaa, 0x0c, 0xef,
-	0x2a, 0xf0, 0x1d, 0x30, 0x58, 0x10, 0x77, 0xad, 0xe0, 0x29, 0xfc, 0x1e, 0xc0, 0x5c, 0x57, 0xb4,
-	0x7e, 0x27, 0x1b, 0xaa, 0xc0, 0x41, 0xdc, 0x34, 0x35, 0x76, 0xb1, 0x25, 0xf7, 0xbf, 0x30, 0x03,
-	0x33, 0xf6, 0x11, 0x33, 0x80, 0x9b, 0xe6, 0x45, 0xd2, 0x2a, 0xfc, 0x16, 0xc0, 0x7c, 0x2c, 0x8e,
-	0x17, 0x62, 0x9f, 0xe0, 0xff, 0x62, 0x34, 0xff, 0x0d, 0xc0, 0x53, 0x9d, 0x68, 0x8e, 0x5b, 0x7b,
-	0x8f, 0x8d, 0xd5, 0xef, 0x46, 0xbe, 0xdb, 0xab, 0xa2, 0x3b, 0xe7, 0xfd, 0x19, 0xc0, 0x53, 0x2b,
-	0xff, 0x8d, 0xdd, 0x3d, 0xbb, 0xef, 0xee, 0x4e, 0xee, 0x7d, 0xc2, 0x75, 0x30, 0x87, 0x26, 0xef,
-	0x0f, 0x92, 0x51, 0x27, 0x42, 0xd4, 0xea, 0xec, 0x34, 0xeb, 0xd8, 0xb4, 0xb9, 0xc9, 0x49, 0x95,
-	0x8f, 0x91, 0x02, 0xd3, 0x61, 0xbd, 0x28, 0x54, 0x4a, 0xbd, 0x2a, 0xc3, 0x6a, 0xb1, 0x47, 0x5d,
-	0xc4, 0x87, 0x6e, 0x76, 0x3d, 0x7a, 0x83, 0xf6, 0x43, 0xe9, 0xf0, 0x77, 0xc3, 0xdd, 0x7b, 0xfb,
-	0xde, 0x69, 0xa5, 0xf9, 0x56, 0x3f, 0x1c, 0x16, 0xb6, 0x89, 0x52, 0xf8, 0x69, 0xd8, 0xc7, 0xca,
-	0x6a, 0x71, 0xb2, 0x87, 0xf5, 0x12, 0xd8, 0x89, 0xfe, 0x0e, 0x24, 0xd3, 0x20, 0xea, 0x29, 0x70,
-	0x4e, 0x54, 0x81, 0x43, 0x55, 0xc7, 0xa1, 0x1a, 0x17, 0x73, 0x94, 0xbe, 0x46, 0x9a, 0xb1, 0xb1,
-	0x05, 0xb4, 0x01, 0xd3, 0xe2, 0x05, 0x1d, 0x7a, 0xf4, 0xff, 0x0e, 0xf0, 0x68, 0x60, 0x75, 0x49,
-	0xbc, 0xca, 0x85, 0x3b, 0x8b, 0xbb, 0xca, 0xe9, 0xf7, 0xc0, 0xa3, 0x85, 0x87, 0xdd, 0x87, 0xa4,
-	0xa9, 0x72, 0xbe, 0xcb, 0x9d, 0xda, 0x5e, 0x7f, 0x46, 0xba, 0xd0, 0x79, 0x78, 0x4c, 0xbc, 0xe5,
-	0xa2, 0x77, 0x44, 0xd0, 0x1c, 0x3e, 0x24, 0x30, 0xd4, 0x31, 0xc1, 0x12, 0x12, 0x3c, 0xde, 0x9e,
-	0x6e, 0x4a, 0xfd, 0xfc, 0x29, 0x1a, 0xb4, 0xa7, 0x2f, 0xab, 0x49, 0xb3, 0x89, 0x3c, 0x38, 0xd8,
-	0x20, 0xd4, 0x35, 0xf5, 0xb0, 0x39, 0x72, 0xfa, 0xf0, 0x5d, 0x5d, 0x0a, 0xc0, 0xdf, 0x6a, 0x53,
-	0xa1, 0x26, 0xf6, 0x80, 0xc0, 0xc6, 0x06, 0xb6, 0x75, 0x62, 0x48, 0xba, 0xa8, 0x57, 0x7a, 0x4f,
-	0x63, 0x85, 0xff, 0xb5, 0xa0, 0x46, 0xc0, 0xdc, 0x0f, 0xe1, 0x70, 0x97, 0x4b, 0x8f, 0x12, 0x54,
-	0xb9, 0x39, 0x98, 0x8d, 0x5b, 0xfe, 0x4d, 0xbc, 0xc9, 0x78, 0x40, 0xfe, 0x3b, 0x0d, 0x27, 0xa2,
-	0xf4, 0x63, 0xdb, 0x44, 0x67, 0x1e, 0x65, 0xee, 0xf0, 0xd0, 0x02, 0x6f, 0xf3, 0x30, 0x52, 0xd0,
-	0xed, 0xfa, 0xe6, 0x08, 0xed, 0xe3, 0x61, 0x95, 0x89, 0xb8, 0x2a, 0x14, 0xe5, 0x60, 0x3a, 0xf8,
-	0xd7, 0xc7, 0xb1, 0xc2, 0x6e, 0x6f, 0x38, 0x47, 0x2f, 0xc0, 0x07, 0x2c, 0xec, 0x51, 0xf1, 0x68,
-	0xd4, 0x5c, 0xa2, 0x13, 0x73, 0xe3, 0x76, 0x3b, 0x6b, 0x81, 0xae, 0x71, 0x26, 0x20, 0x38, 0x3d,
-	0x55, 0xb0, 0x57, 0x28, 0x7a, 0x0a, 0x66, 0x62, 0x82, 0x79, 0x0d, 0x9d, 0x29, 0x9f, 0x3a, 0xf4,
-	0xec, 0x55, 0xd8, 0x91, 0x14, 0x19, 0xe6, 0x37, 0xf9, 0x3b, 0x3d, 0x6e, 0x58, 0xff, 0x51, 0x0c,
-	0x5b, 0xe5, 0xfc, 0x31, 0xc3, 0x1e, 0x84, 0x59, 0x21, 0x53, 0x77, 0x7c, 0x9b, 0x4a, 0x03, 0xbc,
-	0xad, 0x9b, 0x09, 0x68, 0x0b, 0x8c, 0x84, 0xae, 0xc2, 0xe3, 0x5c, 0x77, 0xd4, 0x25, 0x88, 0x6b,
-	0x1f, 0xbc, 0x4d, 0xed, 0x13, 0x4c, 0x44, 0xd8, 0x37, 0x88, 0xe9, 0x7f, 0x18, 0x8e, 0x44, 0x72,
-	0x03, 0x0b, 0xd2, 0xdc, 0x82, 0xe1, 0x90, 0x1a, 0xd8, 0xa0, 0xc1, 0x31, 0xd7, 0xf1, 0x6d, 0x43,
-	0xa3, 0xae, 0xd9, 0xe4, 0x79, 0x25, 0xe8, 0x9d, 0x65, 0xca, 0x4f, 0x1c, 0xe0, 0xc4, 0x9e, 0xd8,
-	0x29, 0xa9, 0x8c, 0xfd, 0x8a, 0x6b, 0x36, 0xb9, 0x65, 0xea, 0x88, 0xdb, 0x35, 0x47, 0x17, 0xe1,
-	0x90, 0xe7, 0x57, 0xb5, 0x2a, 0xb6, 0x0d, 0x4f, 0x82, 0x87, 0xa6, 0xf0, 0x5e, 0xc9, 0x2b, 0x7e,
-	0x55, 0xc1, 0xb6, 0xa1, 0xa6, 0xbd, 0x60, 0xe0, 0xe5, 0xfe, 0x01, 0xe0, 0x48, 0xb7, 0x3e, 0x74,
-	0x0e, 0xa6, 0x1a, 0xe2, 0xea, 0x39, 0xb4, 0xcd, 0xc1, 0x52, 0xea, 0xaf, 0xc3, 0x94, 0xca, 0xdb,
-	0x1d, 0x8c, 0x8f, 0xb3, 0xe3, 0x4d, 0x91, 0x4b, 0x8f, 0xc8, 0x8e, 0x37, 0xd1, 0x02, 0x1c, 0x68,
-	0x10, 0xc3, 0xc4, 0xb6, 0x08, 0xe3, 0x23, 0x49, 0x10, 0xac, 0xec, 0x93, 0x0d, 0x4e, 0x88, 0xbf,
-	0x00, 0xd5, 0x60, 0x92, 0xfb, 0x23, 0x80, 0x83, 0xc2, 0x03, 0x77, 0xf1, 0x0f, 0x87, 0x27, 0x61,
-	0x2e, 0x0a, 0x0b, 0x9f, 0x9a, 0x96, 0xa8, 0x67, 0xb4, 0xa0, 0x5a, 0x4b, 0xf1, 0x9c, 0x11, 0xb5,
-	0xad, 0x56, 0x3b, 0x80, 0x65, 0x5e, 0xb6, 0x3d, 0x06, 0xc7, 0xf7, 0xe3, 0x0e, 0xfe, 0x9f, 0x51,
-	0xef, 0xdb, 0x87, 0x4f, 0xf9, 0x00, 0x7c, 0xba, 0x2d, 0x83, 0x5b, 0xdb, 0x32, 0xf8, 0x7c, 0x5b,
-	0x4e, 0x7c, 0xb1, 0x2d, 0x27, 0xbe, 0xdc, 0x96, 0x13, 0x5f, 0x6d, 0xcb, 0x89, 0xaf, 0xb7, 0x65,
-	0xf0, 0x5a, 0x5b, 0x06, 0x6f, 0xb4, 0xe5, 0xc4, 0x87, 0x6d, 0x19, 0x7c, 0xd4, 0x96, 0x13, 0x1f,
-	0xb7, 0xe5, 0xc4, 0x27, 0x6d, 0x39, 0xf1, 0x69, 0x5b, 0x06, 0xb7, 0xda, 0x32, 0xf8, 0xbc, 0x2d,
-	0x27, 0xbe, 0x68, 0xcb, 0xe0, 0xcb, 0xb6, 0x9c, 0xf8, 0xaa, 0x2d, 0x83, 0xaf, 0xdb, 0x72, 0xe2,
-	0xb5, 0x1d, 0x39, 0xf1, 0xc6, 0x8e, 0x0c, 0xde, 0xde, 0x91, 0x13, 0xef, 0xee, 0xc8, 0xe0, 0xfd,
-	0x1d, 0x39, 0xf1, 0xe1, 0x8e, 0x9c, 0xf8, 0x68, 0x47, 0x06, 0x1f, 0xef, 0xc8, 0xe0, 0x93, 0x1d,
-	0x19, 0xbc, 0x38, 0x7b, 0x84, 0xee, 0x05, 0xb5, 0x9b, 0xd5, 0xea, 0x00, 0x3f, 0xb1, 0xb3, 0xff,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x2a, 0xe3, 0x4f, 0x01, 0x1f, 0x00, 0x00,
+	// 2907 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6c, 0x1b, 0xc7,
+	0xd5, 0xe7, 0x90, 0xfa, 0x43, 0x0d, 0x29, 0x89, 0x9e, 0xc8, 0xf2, 0x9a, 0xb6, 0x97, 0x0a, 0xed,
+	0xc4, 0x92, 0x63, 0x52, 0x5f, 0xe8, 0xe4, 0xfb, 0xbe, 0xba, 0x71, 0x1c, 0xae, 0x64, 0xbb, 0x82,
+	0xe5, 0xd8, 0x5d, 0x59, 0x09, 0x1a, 0x3b, 0xde, 0x0e, 0x77, 0x47, 0xd4, 0x56, 0xcb, 0x5d, 0x76,
+	0x77, 0x56, 0x16, 0x13, 0x07, 0x08, 0x8a, 0x00, 0x0d, 0x72, 0x48, 0x83, 0x9c, 0x82, 0xa0, 0x87,
+	0x5c, 0x52, 0x04, 0x6d, 0x0f, 0x41, 0x0f, 0x45, 0x0e, 0x3d, 0xe4, 0xd0, 0x16, 0x39, 0x14, 0x81,
+	0x4f, 0x41, 0xd0, 0x02, 0x6a, 0x4c, 0x5d, 0xd2, 0x5b, 0x90, 0x53, 0xa0, 0x53, 0x31, 0xb3, 0xb3,
+	0xcb, 0x25, 0xf5, 0x27, 0x56, 0x6c, 0xa7, 0x3d, 0x71, 0xe6, 0xcd, 0xef, 0xfd, 0x9d, 0xb7, 0x6f,
+	0x66, 0x1e, 0x61, 0xc1, 0x72, 0x5c, 0x7c, 0x13, 0xdb, 0x25, 0x8f, 0x62, 0x7d, 0x65, 0x1a, 0x37,
+	0xcd, 0xe9, 0x3a, 0xa6, 0xe4, 0x26, 0x6e, 0x95, 0x9b, 0xae, 0x43, 0x1d, 0x34, 0x42, 0xa9, 0x5d,
+	0x16, 0xa0, 0xf2, 0xea, 0xa9, 0x7c, 0xb5, 0x6e, 0xd2, 0x65, 0xbf, 0x56, 0xd6, 0x9d, 0xc6, 0x34,
+	0xb1, 0x57, 0x9d, 0x56, 0xd3, 0x75, 0xd6, 0x5a, 0xd3, 0x1c, 0xac, 0x97, 0xea, 0xc4, 0x2e, 0xad,
+	0x62, 0xcb, 0x34, 0x30, 0x25, 0xd3, 0x5b, 0x06, 0x81, 0xc8, 0x7c, 0x29, 0x26, 0xa2, 0xee, 0xd4,
+	0x9d, 0x80, 0xb9, 0xe6, 0x2f, 0xf1, 0x19, 0x9f, 0xf0, 0x91, 0x80, 0xcb, 0x75, 0xc7, 0xa9, 0x5b,
+	0xa4, 0x83, 0x32, 0x7c, 0x17, 0x53, 0xd3, 0xb1, 0xc5, 0xfa, 0x44, 0xef, 0xfa, 0x92, 0x49, 0x2c,
+	0x43, 0x6b, 0x60, 0x6f, 0x45, 0x20, 0x0e, 0xf7, 0x22, 0x3c, 0xea, 0xfa, 0x3a, 0x15, 0xab, 0x85,
+	0xde, 0x55, 0x6a, 0x36, 0x88, 0x47, 0x71, 0xa3, 0x29, 0x00, 0xc7, 0xb6, 0xc6, 0x48, 0x77, 0x6c,
+	0x8a, 0x75, 0xaa, 0x99, 0xf6, 0x52, 0x68, 0xe6, 0x91, 0xad, 0x28, 0x62, 0xfb, 0x0d, 0x4f, 0x2c,
+	0x1f, 0xdd, 0xba, 0x6c, 0x1a, 0xc4, 0xa6, 0xe6, 0x92, 0x49, 0xdc, 0x10, 0x34, 0xb1, 0x15, 0xd4,
+	0x20, 0x14, 0x1b, 0x98, 0xe2, 0x30, 0x18, 0x5b, 0x11, 0xae, 0x59, 0x5f, 0xa6, 0xa1, 0x84, 0x6d,
+	0xf6, 0xd3, 0x23, 0xba, 0x4b, 0x42, 0x40, 0x71, 0x05, 0x66, 0x2f, 0x04, 0x1b, 0xac, 0xb8, 0xd8,
+	0x36, 0xd0, 0x38, 0x4c, 0x9a, 0x86, 0x04, 0x26, 0xc0, 0xe4, 0x90, 0x32, 0xd0, 0x5e, 0x2f, 0x24,
+	0xe7, 0x66, 0xd5, 0xa4, 0x69, 0x20, 0x04, 0xfb, 0x6c, 0xdc, 0x20, 0x52, 0x92, 0xad, 0xa8, 0x7c,
+	0x8c, 0x0e, 0xc2, 0x94, 0xef, 0x5a, 0x52, 0x8a, 0x83, 0x07, 0xdb, 0xeb, 0x85, 0xd4, 0xa2, 0x3a,
+	0xaf, 0x32, 0x1a, 0x1a, 0x83, 0xfd, 0x96, 0x53, 0x77, 0x3c, 0xa9, 0x6f, 0x22, 0x35, 0x39, 0xa4,
+	0x06, 0x93, 0xe2, 0x87, 0x20, 0xd2, 0x76, 0xc9, 0x31, 0x88, 0x85, 0x2e, 0xc1, 0x74, 0x8d, 0xa9,
+	0xd5, 0x22, 0x9d, 0x95, 0x4d, 0xe5, 0x98, 0x5b, 0x94, 0x8e, 0x55, 0xe4, 0x1b, 0xd7, 0x70, 0xe9,
+	0xa5, 0xff, 0x29, 0xfd, 0xe0, 0xc5, 0xc9, 0xb3, 0xa7, 0xaf, 0x95, 0x5e, 0x3c, 0x1b, 0x4e, 0xa7,
+	0x5e, 0xae, 0x9c, 0x7c, 0xe5, 0x58, 0x7b, 0xbd, 0x30, 0xc8, 0x2d, 0x9e, 0x9b, 0x55, 0x07, 0xb9,
+	0x8c, 0x39, 0x03, 0x9d, 0xe1, 0xc6, 0x73, 0x13, 0x95, 0xd2, 0xdd, 0x0b, 0xea, 0xf5, 0x31, 0xd5,
+	0xf1, 0xb1, 0xf8, 0xab, 0x24, 0x3c, 0x28, 0x4c, 0x7e, 0x8e, 0xb8, 0x9e, 0xe9, 0xd8, 0x73, 0x9d,
+	0x6d, 0xba, 0xdf, 0xf6, 0x5f, 0x82, 0xe9, 0x06, 0x8b, 0x8b, 0x16, 0x79, 0xb1, 0x17, 0x71, 0x3c,
+	0xa4, 0x4c, 0x1c, 0x97, 0x31, 0x67, 0xa0, 0x29, 0x98, 0x5b, 0xc6, 0xae, 0x71, 0x13, 0xbb, 0x44,
+	0x5b, 0x0d, 0x8c, 0x17, 0xbe, 0x8d, 0x86, 0x74, 0xe1, 0x13, 0x83, 0x2e, 0x99, 0x6e, 0xa3, 0x0b,
+	0xda, 0x17, 0x40, 0x43, 0xba, 0x80, 0x16, 0xbf, 0x4e, 0x46, 0x9b, 0xa8, 0x62, 0xc3, 0x74, 0xd0,
+	0x38, 0x1c, 0x20, 0x36, 0xae, 0x59, 0x84, 0x87, 0x20, 0xad, 0x8a, 0x19, 0x3a, 0x04, 0x87, 0xf4,
+	0x65, 0xb3, 0xa9, 0xd1, 0x56, 0x33, 0xcc, 0x9b, 0x34, 0x23, 0x5c, 0x6d, 0x35, 0x09, 0x3a, 0x0c,
+	0x87, 0x96, 0x5c, 0xf2, 0x73, 0x9f, 0xd8, 0x7a, 0x8b, 0x1b, 0xd5, 0xa7, 0x76, 0x08, 0x68, 0x1a,
+	0x66, 0x5c, 0xcf, 0x33, 0x35, 0x67, 0x69, 0xc9, 0x23, 0x94, 0x5b, 0x92, 0x54, 0x46, 0xda, 0xeb,
+	0x05, 0xa8, 0x2e, 0x2c, 0xcc, 0x5d, 0xe6, 0x54, 0x15, 0x32, 0x48, 0x30, 0x46, 0xcf, 0xc3, 0x1c,
+	0x5d, 0xd3, 0x74, 0xc7, 0x5e, 0x32, 0xeb, 0xa2, 0x1c, 0x48, 0xfd, 0x13, 0x60, 0x32, 0x53, 0x39,
+	0x59, 0xee, 0xae, 0x58, 0xe5, 0xb8, 0xed, 0xe5, 0xab, 0x6b, 0x33, 0x71, 0x1e, 0x75, 0x94, 0x76,
+	0x13, 0xf2, 0xaf, 0x01, 0x38, 0xda, 0x03, 0x42, 0x47, 0xe1, 0x70, 0xc3, 0xb4, 0xb5, 0x8e, 0xfd,
+	0x80, 0xdb, 0x9f, 0x6d, 0x98, 0xf6, 0xf9, 0xc8, 0x05, 0x06, 0xc2, 0x6b, 0x31, 0x50, 0x52, 0x80,
+	0xf0, 0x5a, 0x07, 0x74, 0x1c, 0x8e, 0xda, 0x0e, 0xd5, 0x97, 0xb5, 0xde, 0x58, 0x8c, 0x70, 0x72,
+	0x04, 0x2c, 0x7e, 0x06, 0xe0, 0x48, 0x77, 0x1a, 0xa2, 0x4b, 0x30, 0x65, 0x1a, 0x1e, 0xd7, 0x9d,
+	0xa9, 0x4c, 0xed, 0xe0, 0xe5, 0xd6, 0x9c, 0x55, 0x72, 0x9b, 0x4a, 0xff, 0x1b, 0x20, 0x99, 0x03,
+	0x9f, 0xac, 0x17, 0x12, 0xb7, 0xd7, 0x0b, 0x40, 0x65, 0x72, 0xd8, 0x2e, 0x36, 0x97, 0x1d, 0xea,
+	0x78, 0x52, 0x92, 0x7f, 0xb2, 0x62, 0x86, 0x9e, 0x80, 0x03, 0x2e, 0x0b, 0x95, 0x27, 0xa5, 0x26,
+	0x52, 0x93, 0x99, 0xca, 0xe1, 0xdd, 0xe2, 0xa9, 0x0a, 0x2c, 0x7a, 0x18, 0x66, 0x75, 0xcb, 0xd1,
+	0x57, 0x34, 0xcf, 0xf1, 0x5d, 0x9d, 0x48, 0x83, 0x13, 0x60, 0x72, 0x58, 0xcd, 0x70, 0xda, 0x02,
+	0x27, 0x9d, 0xee, 0xfb, 0xe8, 0xbd, 0x42, 0xa2, 0xf8, 0xe9, 0x30, 0x1c, 0x14, 0x12, 0xd0, 0xf9,
+	0xb8, 0x47, 0xc5, 0x1d, 0xf4, 0xdc, 0x85, 0x2b, 0x33, 0x10, 0xea, 0x2e, 0xc1, 0x94, 0x18, 0x1a,
+	0xa6, 0x3c, 0xee, 0x99, 0x4a, 0xbe, 0x1c, 0x94, 0xf5, 0x72, 0x58, 0xd6, 0xcb, 0x57, 0xc3, 0xb2,
+	0xae, 0xa4, 0x19, 0xfb, 0x5b, 0xff, 0x2c, 0x00, 0x75, 0x48, 0xf0, 0x55, 0x29, 0x13, 0xe2, 0x37,
+	0x8d, 0x50, 0x48, 0x6a, 0x2f, 0x42, 0x04, 0x5f, 0x95, 0xa2, 0x43, 0xa2, 0xa2, 0xf4, 0x05, 0x25,
+	0x72, 0x53, 0xe9, 0x73, 0x93, 0x52, 0x45, 0x94, 0xcf, 0x13, 0x30, 0x63, 0x10, 0x4f, 0x77, 0xcd,
+	0x66, 0x94, 0xae, 0x43, 0x4a, 0x7a, 0x53, 0xe9, 0x77, 0x53, 0xd2, 0xed, 0x51, 0x35, 0xbe, 0x88,
+	0x7c, 0x08, 0x31, 0xa5, 0xae, 0x59, 0xf3, 0x29, 0xf1, 0xa4, 0x01, 0xbe, 0x13, 0xc7, 0x77, 0x88,
+	0x50, 0xb9, 0x1a, 0x21, 0xcf, 0xd9, 0xd4, 0x6d, 0x29, 0x27, 0x37, 0x95, 0xa9, 0x77, 0xc1, 0xa3,
+	0xc5, 0xbb, 0xaa, 0x24, 0x6a, 0x4c, 0x11, 0x7a, 0x1a, 0x66, 0xe3, 0x47, 0x9b, 0x34, 0xc8, 0x15,
+	0x1f, 0xea, 0x55, 0x3c, 0x13, 0x60, 0xe6, 0xec, 0x25, 0x47, 0xcd, 0xe8, 0x9d, 0x09, 0xba, 0x0e,
+	0x33, 0xa2, 0x9a, 0x68, 0x6c, 0x67, 0xd3, 0xf7, 0x9e, 0xab, 0x70, 0x35, 0x44, 0x79, 0xe8, 0x2f,
+	0x00, 0x8e, 0x8b, 0xdb, 0x89, 0xe6, 0x11, 0x77, 0x95, 0xb8, 0x1a, 0x36, 0x0c, 0x97, 0x78, 0x9e,
+	0x34, 0xc4, 0x83, 0xf9, 0x26, 0xd8, 0x54, 0xde, 0x00, 0xee, 0x2f, 0x41, 0xe5, 0x35, 0x70, 0x63,
+	0xf2, 0xec, 0x69, 0xe6, 0x30, 0x2e, 0xbd, 0x54, 0x2d, 0xbd, 0xc0, 0xfc, 0xbd, 0x15, 0x1b, 0x77,
+	0x86, 0xd7, 0x4b, 0x2f, 0x9e, 0x88, 0x2d, 0x4c, 0x5d, 0x2f, 0x4f, 0x9d, 0x60, 0x7c, 0xd5, 0xd2,
+	0x0b, 0x22, 0x4e, 0xb7, 0x62, 0xe3, 0xce, 0x90, 0xf3, 0x75, 0x16, 0xa6, 0x26, 0xcf, 0x9e, 0x3e,
+	0x7d, 0x8d, 0x8d, 0x5e, 0x7e, 0xfc, 0xe4, 0x93, 0xaf, 0x4c, 0x9d, 0x3d, 0x76, 0xeb, 0xc6, 0x31,
+	0x75, 0x4c, 0x98, 0xbb, 0xc0, 0xad, 0xad, 0x06, 0xc6, 0xa2, 0x02, 0xcc, 0x60, 0x9f, 0x3a, 0x5a,
+	0x90, 0x37, 0x12, 0xe4, 0x55, 0x14, 0x32, 0xd2, 0x22, 0xa7, 0xa0, 0x47, 0xe0, 0x48, 0xb0, 0xa6,
+	0xe9, 0xcb, 0xd8, 0xb6, 0x89, 0x25, 0x65, 0x78, 0x39, 0x1d, 0x0e, 0xa8, 0x33, 0x01, 0x11, 0x9d,
+	0x87, 0xfb, 0xa2, 0x3a, 0xa2, 0x35, 0x2d, 0xcc, 0x82, 0x2e, 0x65, 0x79, 0x24, 0xf2, 0x41, 0xea,
+	0x3d, 0xd3, 0x5e, 0x2f, 0x8c, 0x46, 0x55, 0xe5, 0x8a, 0x85, 0xed, 0xb9, 0x59, 0x75, 0x74, 0xa9,
+	0x8b, 0x60, 0xa0, 0x2b, 0x10, 0x6d, 0x91, 0xe3, 0x49, 0x63, 0xac, 0x2c, 0x28, 0xc5, 0x4d, 0x25,
+	0xf3, 0x36, 0x48, 0xe7, 0xd2, 0xc5, 0x50, 0x5e, 0xae, 0x47, 0x9e, 0xa7, 0xe6, 0x7a, 0x04, 0x7a,
+	0xe8, 0x19, 0x98, 0xc6, 0x36, 0x25, 0xb6, 0x8d, 0x3d, 0x69, 0x98, 0xe7, 0x90, 0xbc, 0x43, 0x12,
+	0x54, 0x03, 0x98, 0xd2, 0xc7, 0x76, 0x5c, 0x8d, 0xb8, 0x58, 0x39, 0xf5, 0x28, 0xa6, 0xbe, 0xa7,
+	0x35, 0xfd, 0x9a, 0x65, 0xea, 0xd2, 0x08, 0x8f, 0x52, 0x36, 0x20, 0x5e, 0xe1, 0x34, 0x56, 0x4e,
+	0x2d, 0x47, 0xe7, 0x45, 0x3a, 0x84, 0x8d, 0x72, 0xd8, 0x48, 0x48, 0x16, 0xc0, 0x27, 0xe0, 0xb8,
+	0xa7, 0x2f, 0x13, 0xc3, 0xb7, 0x88, 0x66, 0x38, 0x37, 0x6d, 0xcb, 0xb4, 0x57, 0x34, 0x8b, 0x05,
+	0x3f, 0xc7, 0xf1, 0x63, 0xe1, 0xea, 0xac, 0x58, 0x9c, 0x67, 0xdb, 0x70, 0x12, 0x22, 0x62, 0x2f,
+	0x39, 0xae, 0x4e, 0x34, 0xc3, 0xa7, 0x2d, 0x4d, 0x6f, 0xe9, 0x16, 0x91, 0xf6, 0x71, 0x8e, 0x9c,
+	0x58, 0x99, 0xf5, 0x69, 0x6b, 0x86, 0xd1, 0xd1, 0xcf, 0xa0, 0x14, 0x89, 0x6e, 0x62, 0xba, 0xcc,
+	0x4e, 0x27, 0x8f, 0xba, 0xd8, 0xb4, 0xa9, 0x84, 0x26, 0xc0, 0xe4, 0x48, 0xe5, 0xd1, 0xde, 0x18,
+	0x84, 0xda, 0xae, 0x60, 0xba, 0x3c, 0x13, 0xa1, 0x79, 0x4d, 0xf8, 0x05, 0xfb, 0x0a, 0xd4, 0x71,
+	0x63, 0x5b, 0x04, 0xfa, 0x49, 0xcc, 0x1f, 0x6c, 0xb7, 0xd8, 0x8d, 0x55, 0x33, 0x88, 0x85, 0x5b,
+	0xd2, 0x43, 0xfc, 0x93, 0x3b, 0xb8, 0xa5, 0x70, 0xcd, 0x8a, 0xc3, 0x8c, 0xd7, 0x2d, 0xf0, 0x0e,
+	0xab, 0x5b, 0x91, 0xd3, 0xd5, 0x40, 0xc2, 0x2c, 0x13, 0x80, 0xce, 0xc0, 0x43, 0x22, 0xf7, 0xa2,
+	0xd0, 0x2e, 0xb9, 0x4e, 0x43, 0x0b, 0x02, 0x2f, 0xed, 0xe7, 0xde, 0x4b, 0x01, 0x64, 0x5e, 0x20,
+	0xce, 0xbb, 0x4e, 0x63, 0x81, 0xaf, 0xa3, 0x67, 0xe1, 0x88, 0x55, 0xf3, 0x34, 0xcb, 0xf6, 0xb4,
+	0xe0, 0xe2, 0x29, 0x8d, 0x73, 0x8b, 0xc6, 0x7b, 0x7d, 0x5f, 0xe0, 0xab, 0x4a, 0xae, 0xbd, 0x5e,
+	0xc8, 0xce, 0x2b, 0x0b, 0xf3, 0xcf, 0x2e, 0x04, 0x14, 0x35, 0x6b, 0xd5, 0xbc, 0x79, 0xdb, 0x0b,
+	0x66, 0xe8, 0xa7, 0x50, 0xf2, 0x8d, 0xa6, 0x86, 0x2d, 0xcb, 0xb9, 0x49, 0x0c, 0x71, 0xbc, 0x68,
+	0xba, 0x69, 0xb8, 0x9e, 0x74, 0x80, 0x67, 0xe8, 0x71, 0x91, 0xa1, 0x39, 0x9e, 0xa1, 0x8f, 0xb5,
+	0xd7, 0x0b, 0xfb, 0x17, 0x67, 0xaf, 0x54, 0x03, 0x86, 0xe0, 0xec, 0x99, 0x99, 0x9b, 0x55, 0x3d,
+	0x75, 0xbf, 0x6f, 0x34, 0xbb, 0xc9, 0x4c, 0x4a, 0xfe, 0x0c, 0x1c, 0xed, 0x29, 0xa0, 0x28, 0x07,
+	0x53, 0x2b, 0x24, 0x38, 0xe6, 0x87, 0x54, 0x36, 0x64, 0xf7, 0xdb, 0x55, 0x6c, 0xf9, 0xe1, 0xbd,
+	0x26, 0x98, 0x9c, 0x4e, 0xfe, 0x3f, 0x28, 0x9e, 0x85, 0x69, 0x91, 0xca, 0x1e, 0x3a, 0x05, 0xd3,
+	0xe2, 0x83, 0x67, 0xa7, 0x1a, 0x4b, 0xfb, 0x03, 0x3b, 0x9d, 0x9e, 0x11, 0xb0, 0xf8, 0x3b, 0x00,
+	0xf7, 0x5d, 0x20, 0x34, 0x5c, 0x60, 0x5f, 0x92, 0x47, 0xd1, 0x22, 0xcc, 0x84, 0xa5, 0xee, 0x5e,
+	0xcf, 0x48, 0x58, 0x0f, 0x51, 0x1e, 0x3a, 0x0b, 0x61, 0xe7, 0x79, 0xb4, 0xe3, 0x51, 0x79, 0x9e,
+	0x41, 0x2e, 0x61, 0x6f, 0x45, 0x7c, 0x96, 0x43, 0x4b, 0x21, 0xa1, 0x78, 0x0b, 0x16, 0x3b, 0xc6,
+	0xc6, 0xf4, 0x9e, 0x77, 0xdc, 0x73, 0x8b, 0x73, 0xa1, 0xf5, 0xcf, 0xc1, 0x14, 0xf1, 0x4d, 0x6e,
+	0x75, 0x56, 0x99, 0x65, 0x32, 0xfe, 0xbe, 0x5e, 0x78, 0xb2, 0xee, 0x94, 0xe9, 0x32, 0xa1, 0xcb,
+	0xa6, 0x5d, 0xf7, 0xca, 0x36, 0xa1, 0x37, 0x1d, 0x77, 0x65, 0xba, 0xfb, 0xc1, 0xb2, 0x7a, 0x6a,
+	0xba, 0xb9, 0x52, 0x9f, 0x66, 0x57, 0x48, 0xaf, 0x7c, 0x6e, 0x71, 0xee, 0x7f, 0x9f, 0x60, 0xcf,
+	0x0c, 0x26, 0x99, 0x09, 0x2c, 0x7e, 0x96, 0x84, 0x0f, 0xcd, 0x9b, 0x5e, 0xa8, 0xdf, 0x0b, 0xf5,
+	0xfd, 0x98, 0x9d, 0x5b, 0x96, 0x85, 0x6b, 0x8e, 0x8b, 0xa9, 0xe3, 0x8a, 0x70, 0x95, 0x7a, 0xc3,
+	0x75, 0xd9, 0xad, 0x63, 0xdb, 0x7c, 0x89, 0xe7, 0xec, 0x65, 0x77, 0xd1, 0x23, 0x6e, 0xcc, 0x03,
+	0xb5, 0x4b, 0xc4, 0x3d, 0x47, 0x0a, 0xdd, 0x84, 0xfd, 0x8e, 0x6b, 0x10, 0x57, 0xbc, 0x97, 0xf0,
+	0xa6, 0x72, 0xc3, 0xbd, 0xae, 0x26, 0xa2, 0xed, 0xd0, 0x4c, 0x43, 0xcd, 0x94, 0xe2, 0x93, 0x70,
+	0x4c, 0x7c, 0x53, 0xcd, 0x96, 0xe2, 0x33, 0x7e, 0x81, 0x50, 0xfb, 0x4b, 0xfc, 0x27, 0x76, 0xd9,
+	0x51, 0x33, 0xa5, 0xd8, 0x24, 0xd0, 0x87, 0x64, 0xd8, 0x6f, 0x99, 0x0d, 0x33, 0xb8, 0x46, 0x0f,
+	0xf3, 0x6a, 0x72, 0x22, 0x25, 0x7d, 0x39, 0xa8, 0x06, 0x64, 0xf6, 0xec, 0x69, 0xe2, 0x3a, 0xe1,
+	0x17, 0x90, 0x61, 0x95, 0x8f, 0x8b, 0x7f, 0x02, 0x70, 0x6c, 0x86, 0x4b, 0xea, 0xc9, 0xc3, 0x19,
+	0x38, 0x28, 0x0c, 0x11, 0x41, 0xdd, 0x29, 0xa3, 0xb7, 0x49, 0xbc, 0x90, 0x13, 0x69, 0x3d, 0xdb,
+	0x93, 0xfc, 0x0e, 0xdb, 0xa3, 0x64, 0xe3, 0xf2, 0xbb, 0x37, 0xab, 0xf8, 0x6b, 0x00, 0xc7, 0x82,
+	0xb3, 0xf3, 0x41, 0x98, 0x7f, 0xcf, 0x1f, 0xcd, 0x6f, 0x00, 0x3c, 0x18, 0x4b, 0xdb, 0xea, 0x95,
+	0xb9, 0x8b, 0xa4, 0xe5, 0x3d, 0xe0, 0x4f, 0x3d, 0x4a, 0x83, 0xe4, 0xee, 0x69, 0x90, 0x8a, 0xa5,
+	0xc1, 0xdb, 0x00, 0x1e, 0xb8, 0x40, 0xba, 0xed, 0x7c, 0xc0, 0x66, 0x4e, 0xc0, 0x81, 0x15, 0xd2,
+	0xea, 0xbc, 0x80, 0x87, 0xda, 0xeb, 0x85, 0xfe, 0x8b, 0xa4, 0x35, 0x37, 0xab, 0xf6, 0xaf, 0x90,
+	0xd6, 0x9c, 0x51, 0xfc, 0x14, 0xc0, 0x7c, 0x57, 0x6e, 0x7e, 0x2f, 0x76, 0x1d, 0x8a, 0x37, 0x40,
+	0x7a, 0xaf, 0xf2, 0x4f, 0xc1, 0x81, 0xa0, 0xed, 0xc2, 0x1f, 0x49, 0x23, 0x95, 0xfd, 0xbd, 0xea,
+	0x54, 0xb6, 0xaa, 0x0c, 0x6f, 0x2a, 0xf0, 0x6d, 0x30, 0x58, 0x14, 0xa7, 0xb9, 0xe0, 0x29, 0xfe,
+	0x11, 0xc0, 0x7c, 0x57, 0xb6, 0x7e, 0x2f, 0x0e, 0x55, 0xe1, 0x20, 0x6e, 0x9a, 0x1a, 0x3b, 0xd8,
+	0x92, 0xdb, 0x1f, 0xc9, 0x81, 0x19, 0xdb, 0x88, 0x19, 0xc0, 0x4d, 0xf3, 0x22, 0x69, 0x15, 0x7f,
+	0x0f, 0x60, 0x21, 0x96, 0xc7, 0x33, 0xb1, 0x4f, 0xf0, 0xbf, 0x31, 0x9b, 0xff, 0x01, 0xe0, 0x91,
+	0x0b, 0x64, 0x3b, 0x6b, 0x1f, 0xb0, 0xb1, 0xfa, 0xfd, 0xa8, 0x77, 0x5b, 0x55, 0x74, 0xd7, 0xbc,
+	0xbf, 0x02, 0x78, 0x64, 0xe1, 0x3f, 0xe1, 0xdd, 0xb3, 0xdb, 0x7a, 0x77, 0x78, 0xeb, 0x23, 0xb1,
+	0x83, 0xd9, 0xb5, 0x78, 0xbf, 0x9f, 0x84, 0x23, 0xdd, 0xaf, 0x01, 0xb6, 0x9b, 0x75, 0x6c, 0xda,
+	0xdc, 0xe4, 0xa4, 0xca, 0xc7, 0x48, 0x81, 0xe9, 0xf0, 0x46, 0x2a, 0x54, 0x4a, 0xbd, 0x2a, 0xc3,
+	0xfb, 0x68, 0x8f, 0xba, 0x88, 0x0f, 0xdd, 0xea, 0x7a, 0x56, 0x07, 0x0d, 0x8e, 0xf2, 0xee, 0x2f,
+	0x93, 0xfb, 0xf7, 0xba, 0xbe, 0xd7, 0x9b, 0xe6, 0x9b, 0xfd, 0x70, 0x58, 0xd8, 0x26, 0x2e, 0xdb,
+	0xcf, 0xc0, 0x3e, 0x76, 0x71, 0x97, 0xc0, 0x0e, 0x47, 0x52, 0xa7, 0x5b, 0xc1, 0x76, 0xf4, 0x0f,
+	0x20, 0x99, 0x06, 0x51, 0xd7, 0x82, 0x73, 0xa2, 0x2a, 0x1c, 0xaa, 0x39, 0x0e, 0xd5, 0xb8, 0x98,
+	0xbd, 0x74, 0x4e, 0xd2, 0x8c, 0x8d, 0x2d, 0xa0, 0x55, 0x98, 0x16, 0x6f, 0xf4, 0x30, 0xa2, 0x8f,
+	0xed, 0x10, 0xd1, 0xc0, 0xea, 0xb2, 0x78, 0xf7, 0x8b, 0x70, 0x96, 0x36, 0x95, 0x13, 0xef, 0x82,
+	0xe3, 0xc5, 0x47, 0xdc, 0xa3, 0xd2, 0xb1, 0x4a, 0xa1, 0x2b, 0x9c, 0xda, 0xd6, 0x78, 0x46, 0xba,
+	0xd0, 0x39, 0xb8, 0x4f, 0xbc, 0x16, 0xa3, 0x97, 0x4a, 0xd0, 0x7e, 0xde, 0x25, 0x31, 0xd4, 0x9c,
+	0x60, 0x09, 0x09, 0x1e, 0x6f, 0x80, 0x37, 0xa5, 0xfe, 0x89, 0x54, 0xd4, 0x00, 0xbf, 0xa2, 0x26,
+	0xcd, 0x26, 0xf2, 0xe0, 0x60, 0x83, 0x50, 0xd7, 0xd4, 0xc3, 0xf6, 0xcb, 0x89, 0xdd, 0xbd, 0xba,
+	0x14, 0x80, 0xbf, 0x93, 0x53, 0xa1, 0x26, 0xf6, 0x80, 0xc0, 0xc6, 0x2a, 0xb6, 0x75, 0x62, 0x48,
+	0xba, 0xb8, 0xaf, 0xf4, 0xee, 0xc6, 0x02, 0xff, 0xf3, 0x42, 0x8d, 0x80, 0xf9, 0x1f, 0xc2, 0xe1,
+	0xae, 0x90, 0xee, 0x25, 0xa9, 0xf2, 0xa7, 0x61, 0x36, 0x6e, 0xf9, 0xb7, 0xf1, 0x26, 0xe3, 0x09,
+	0xf9, 0x75, 0x06, 0x8e, 0x47, 0xe5, 0xc7, 0xb6, 0x89, 0xce, 0x22, 0xca, 0xc2, 0xc1, 0x5a, 0x72,
+	0x59, 0x3d, 0x20, 0x05, 0xfd, 0xb4, 0x6f, 0xcf, 0xd0, 0x3e, 0x9e, 0x56, 0x99, 0x88, 0xab, 0x4a,
+	0x51, 0x1e, 0xa6, 0x39, 0x50, 0x77, 0xac, 0xb0, 0x9f, 0x1c, 0xce, 0xd1, 0xf3, 0xf0, 0x80, 0x85,
+	0x3d, 0x2a, 0x9e, 0xa5, 0x9a, 0x4b, 0x74, 0x62, 0xae, 0xde, 0x6d, 0xef, 0x2e, 0xd0, 0x35, 0xc6,
+	0x04, 0x04, 0xbb, 0xa7, 0x0a, 0xf6, 0x2a, 0x45, 0x4f, 0xc3, 0x4c, 0x4c, 0x30, 0xbf, 0x43, 0x67,
+	0x2a, 0x47, 0x76, 0xdd, 0x7b, 0x15, 0x76, 0x24, 0x45, 0x86, 0xf9, 0x4d, 0xde, 0x09, 0x88, 0x1b,
+	0xd6, 0xbf, 0x17, 0xc3, 0x16, 0x39, 0x7f, 0xcc, 0xb0, 0x87, 0x61, 0x56, 0xc8, 0xd4, 0x1d, 0xdf,
+	0xa6, 0xd2, 0x00, 0x6f, 0x1c, 0x67, 0x02, 0xda, 0x0c, 0x23, 0xa1, 0x6b, 0xf0, 0x20, 0xd7, 0x1d,
+	0xf5, 0x21, 0xe2, 0xda, 0x07, 0xef, 0x52, 0xfb, 0x38, 0x13, 0x11, 0x76, 0x26, 0x62, 0xfa, 0x1f,
+	0x81, 0x23, 0x91, 0xdc, 0xc0, 0x82, 0x34, 0xb7, 0x60, 0x38, 0xa4, 0x06, 0x36, 0x68, 0x30, 0xe7,
+	0x3a, 0xbe, 0x6d, 0x68, 0xd4, 0x65, 0xff, 0x05, 0x30, 0xe1, 0xbc, 0x3b, 0x97, 0xa9, 0x3c, 0xb9,
+	0x43, 0x10, 0x7b, 0x72, 0xa7, 0xac, 0x32, 0xf6, 0xab, 0xae, 0xd9, 0xe4, 0x96, 0xa9, 0x23, 0x6e,
+	0xd7, 0x1c, 0x5d, 0x84, 0x43, 0x9e, 0x5f, 0xd3, 0x6a, 0xd8, 0x36, 0x3c, 0x09, 0xee, 0x5a, 0xc2,
+	0x7b, 0x25, 0x2f, 0xf8, 0x35, 0x05, 0xdb, 0x86, 0x9a, 0xf6, 0x82, 0x81, 0x87, 0x0c, 0xb8, 0x4f,
+	0xb4, 0xd8, 0x34, 0x47, 0xd7, 0xfd, 0x26, 0x66, 0x2d, 0xf9, 0x0c, 0x17, 0xfa, 0x7f, 0x77, 0x29,
+	0x54, 0x74, 0xe3, 0x2e, 0x87, 0xec, 0x6a, 0x4e, 0xef, 0xa1, 0xa0, 0x1f, 0xc1, 0xe1, 0x4e, 0xd7,
+	0xc9, 0xc1, 0x41, 0x93, 0x2e, 0x53, 0x39, 0xba, 0x83, 0x86, 0xa8, 0x09, 0xe5, 0x60, 0x43, 0xcd,
+	0x1a, 0xb1, 0x59, 0xfe, 0x5f, 0x00, 0x8e, 0x74, 0xc7, 0x07, 0x9d, 0x81, 0xa9, 0x86, 0x38, 0x2a,
+	0x77, 0x6d, 0xfc, 0xb0, 0x23, 0xe0, 0xb7, 0xe1, 0x11, 0xc0, 0x1b, 0x40, 0x8c, 0x8f, 0xb3, 0xe3,
+	0x35, 0x29, 0xf9, 0x5d, 0xd8, 0xf1, 0x1a, 0x9a, 0x81, 0x03, 0x0d, 0x62, 0x98, 0xd8, 0x96, 0x52,
+	0x7b, 0x97, 0x20, 0x58, 0x59, 0x89, 0x09, 0x32, 0x8a, 0xbf, 0x58, 0xd5, 0x60, 0x92, 0xff, 0x33,
+	0x80, 0x83, 0x62, 0xc7, 0xee, 0xe3, 0x5f, 0x30, 0x4f, 0xc1, 0x7c, 0xb4, 0x17, 0x3e, 0x35, 0x2d,
+	0x71, 0xff, 0xd2, 0x82, 0xdb, 0x65, 0x8a, 0xd7, 0xb8, 0xa8, 0x91, 0xb7, 0xd8, 0x01, 0xcc, 0xb3,
+	0x75, 0xf4, 0x38, 0x1c, 0xdb, 0x8e, 0x3b, 0xf8, 0xc7, 0x4a, 0x7d, 0x68, 0x1b, 0xbe, 0xfc, 0xdf,
+	0x00, 0xcc, 0xf5, 0xe6, 0x48, 0xf7, 0xdf, 0x61, 0xa0, 0xf7, 0xef, 0xb0, 0xc3, 0x70, 0xa8, 0x93,
+	0x8d, 0x41, 0xd9, 0xed, 0x10, 0xd0, 0x41, 0x98, 0xe6, 0xff, 0x7d, 0xf9, 0x76, 0x60, 0x6f, 0x9f,
+	0x3a, 0x48, 0xd7, 0x82, 0x8f, 0xef, 0x08, 0x84, 0x35, 0xdf, 0x6b, 0x69, 0x9d, 0x68, 0xf6, 0xa9,
+	0x43, 0x8c, 0x12, 0x2c, 0x2b, 0x30, 0xcb, 0xeb, 0x03, 0xc7, 0xec, 0xa1, 0x20, 0xf1, 0xfa, 0xa6,
+	0xf8, 0x5e, 0xab, 0x4a, 0x95, 0xf7, 0xc1, 0x0b, 0xd3, 0x7b, 0x68, 0xe4, 0x50, 0xbb, 0x59, 0xfb,
+	0xe4, 0x8e, 0x0c, 0x6e, 0xdf, 0x91, 0xc1, 0xe7, 0x77, 0xe4, 0xc4, 0x17, 0x77, 0xe4, 0xc4, 0x97,
+	0x77, 0xe4, 0xc4, 0x57, 0x77, 0xe4, 0xc4, 0x37, 0x77, 0x64, 0xf0, 0x6a, 0x5b, 0x06, 0xaf, 0xb7,
+	0xe5, 0xc4, 0x07, 0x6d, 0x19, 0x7c, 0xd8, 0x96, 0x13, 0x1f, 0xb5, 0xe5, 0xc4, 0xc7, 0x6d, 0x39,
+	0xf1, 0x49, 0x5b, 0x06, 0xb7, 0xdb, 0x32, 0xf8, 0xbc, 0x2d, 0x27, 0xbe, 0x68, 0xcb, 0xe0, 0xcb,
+	0xb6, 0x9c, 0xf8, 0xaa, 0x2d, 0x83, 0x6f, 0xda, 0x72, 0xe2, 0xd5, 0x0d, 0x39, 0xf1, 0xfa, 0x86,
+	0x0c, 0xde, 0xda, 0x90, 0x13, 0xef, 0x6c, 0xc8, 0xe0, 0xbd, 0x0d, 0x39, 0xf1, 0xc1, 0x86, 0x9c,
+	0xf8, 0x70, 0x43, 0x06, 0x1f, 0x6d, 0xc8, 0xe0, 0xe3, 0x0d, 0x19, 0xd4, 0x06, 0xb8, 0x37, 0xa7,
+	0xfe, 0x3d, 0x00, 0x8a, 0xd7, 0xcb, 0x19, 0xe2, 0x20, 0x00, 0x00,
 }
 
 func (this *GatewayBrand) Equal(that interface{}) bool {
@@ -2308,6 +2431,14 @@ func (this *Gateway) Equal(that interface{}) bool {
 	if !this.LBSLNSSecret.Equal(that1.LBSLNSSecret) {
 		return false
 	}
+	if len(this.UDPAllowedSourceCIDRs) != len(that1.UDPAllowedSourceCIDRs) {
+		return false
+	}
+	for i := range this.UDPAllowedSourceCIDRs {
+		if this.UDPAllowedSourceCIDRs[i] != that1.UDPAllowedSourceCIDRs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *Gateways) Equal(that interface{}) bool {
@@ -2850,6 +2981,17 @@ func (this *GatewayConnectionStats) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.ChannelOccupancy) != len(that1.ChannelOccupancy) {
+		return false
+	}
+	for i := range this.ChannelOccupancy {
+		if !this.ChannelOccupancy[i].Equal(that1.ChannelOccupancy[i]) {
+			return false
+		}
+	}
+	if !this.DownlinkLoad.Equal(that1.DownlinkLoad) {
+		return false
+	}
 	return true
 }
 func (this *GatewayConnectionStats_RoundTripTimes) Equal(that interface{}) bool {
@@ -2918,6 +3060,46 @@ func (this *GatewayConnectionStats_SubBand) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GatewayConnectionStats_ChannelOccupancy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayConnectionStats_ChannelOccupancy)
+	if !ok {
+		that2, ok := that.(GatewayConnectionStats_ChannelOccupancy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	if this.Occupancy != that1.Occupancy {
+		return false
+	}
+	if this.TxCount != that1.TxCount {
+		return false
+	}
+	if this.BusyCount != that1.BusyCount {
+		return false
+	}
+	if that1.LastBusyAt == nil {
+		if this.LastBusyAt != nil {
+			return false
+		}
+	} else if !this.LastBusyAt.Equal(*that1.LastBusyAt) {
+		return false
+	}
+	return true
+}
 func (m *GatewayBrand) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3248,6 +3430,17 @@ func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UDPAllowedSourceCIDRs) > 0 {
+		for iNdEx := len(m.UDPAllowedSourceCIDRs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UDPAllowedSourceCIDRs[iNdEx])
+			copy(dAtA[i:], m.UDPAllowedSourceCIDRs[iNdEx])
+			i = encodeVarintGateway(dAtA, i, uint64(len(m.UDPAllowedSourceCIDRs[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
 	if m.LBSLNSSecret != nil {
 		{
 			size, err := m.LBSLNSSecret.MarshalToSizedBuffer(dAtA[:i])
@@ -4244,6 +4437,32 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.DownlinkLoad != nil {
+		{
+			size, err := m.DownlinkLoad.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ChannelOccupancy) > 0 {
+		for iNdEx := len(m.ChannelOccupancy) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelOccupancy[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGateway(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.SubBands) > 0 {
 		for iNdEx := len(m.SubBands) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4439,6 +4658,60 @@ func (m *GatewayConnectionStats_SubBand) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *GatewayConnectionStats_ChannelOccupancy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayConnectionStats_ChannelOccupancy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayConnectionStats_ChannelOccupancy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastBusyAt != nil {
+		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastBusyAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastBusyAt):])
+		if err42 != nil {
+			return 0, err42
+		}
+		i -= n42
+		i = encodeVarintGateway(dAtA, i, uint64(n42))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BusyCount != 0 {
+		i = encodeVarintGateway(dAtA, i, m.BusyCount)
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintGateway(dAtA, i, m.TxCount)
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Occupancy != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Occupancy)))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.Frequency != 0 {
+		i = encodeVarintGateway(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGateway(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGateway(v)
 	base := offset
@@ -4568,6 +4841,11 @@ func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
 	if r.Intn(5) != 0 {
 		this.LBSLNSSecret = NewPopulatedSecret(r, easy)
 	}
+	v11 := r.Intn(10)
+	this.UDPAllowedSourceCIDRs = make([]string, v11)
+	for i := 0; i < v11; i++ {
+		this.UDPAllowedSourceCIDRs[i] = randStringGateway(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4576,9 +4854,9 @@ func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
 func NewPopulatedGateways(r randyGateway, easy bool) *Gateways {
 	this := &Gateways{}
 	if r.Intn(5) != 0 {
-		v11 := r.Intn(5)
-		this.Gateways = make([]*Gateway, v11)
-		for i := 0; i < v11; i++ {
+		v12 := r.Intn(5)
+		this.Gateways = make([]*Gateway, v12)
+		for i := 0; i < v12; i++ {
 			this.Gateways[i] = NewPopulatedGateway(r, easy)
 		}
 	}
@@ -4589,10 +4867,10 @@ func NewPopulatedGateways(r randyGateway, easy bool) *Gateways {
 
 func NewPopulatedGetGatewayRequest(r randyGateway, easy bool) *GetGatewayRequest {
 	this := &GetGatewayRequest{}
-	v12 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v12
-	v13 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v13
+	v13 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v13
+	v14 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v14
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4600,8 +4878,8 @@ func NewPopulatedGetGatewayRequest(r randyGateway, easy bool) *GetGatewayRequest
 
 func NewPopulatedGetGatewayIdentifiersForEUIRequest(r randyGateway, easy bool) *GetGatewayIdentifiersForEUIRequest {
 	this := &GetGatewayIdentifiersForEUIRequest{}
-	v14 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
-	this.EUI = *v14
+	v15 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
+	this.EUI = *v15
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4612,8 +4890,8 @@ func NewPopulatedListGatewaysRequest(r randyGateway, easy bool) *ListGatewaysReq
 	if r.Intn(5) != 0 {
 		this.Collaborator = NewPopulatedOrganizationOrUserIdentifiers(r, easy)
 	}
-	v15 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v15
+	v16 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v16
 	this.Order = randStringGateway(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -4624,10 +4902,10 @@ func NewPopulatedListGatewaysRequest(r randyGateway, easy bool) *ListGatewaysReq
 
 func NewPopulatedCreateGatewayRequest(r randyGateway, easy bool) *CreateGatewayRequest {
 	this := &CreateGatewayRequest{}
-	v16 := NewPopulatedGateway(r, easy)
-	this.Gateway = *v16
-	v17 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.Collaborator = *v17
+	v17 := NewPopulatedGateway(r, easy)
+	this.Gateway = *v17
+	v18 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.Collaborator = *v18
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4635,10 +4913,10 @@ func NewPopulatedCreateGatewayRequest(r randyGateway, easy bool) *CreateGatewayR
 
 func NewPopulatedUpdateGatewayRequest(r randyGateway, easy bool) *UpdateGatewayRequest {
 	this := &UpdateGatewayRequest{}
-	v18 := NewPopulatedGateway(r, easy)
-	this.Gateway = *v18
-	v19 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v19
+	v19 := NewPopulatedGateway(r, easy)
+	this.Gateway = *v19
+	v20 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v20
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4646,8 +4924,8 @@ func NewPopulatedUpdateGatewayRequest(r randyGateway, easy bool) *UpdateGatewayR
 
 func NewPopulatedListGatewayAPIKeysRequest(r randyGateway, easy bool) *ListGatewayAPIKeysRequest {
 	this := &ListGatewayAPIKeysRequest{}
-	v20 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v20
+	v21 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v21
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -4657,8 +4935,8 @@ func NewPopulatedListGatewayAPIKeysRequest(r randyGateway, easy bool) *ListGatew
 
 func NewPopulatedGetGatewayAPIKeyRequest(r randyGateway, easy bool) *GetGatewayAPIKeyRequest {
 	this := &GetGatewayAPIKeyRequest{}
-	v21 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v21
+	v22 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v22
 	this.KeyID = randStringGateway(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -4667,12 +4945,12 @@ func NewPopulatedGetGatewayAPIKeyRequest(r randyGateway, easy bool) *GetGatewayA
 
 func NewPopulatedCreateGatewayAPIKeyRequest(r randyGateway, easy bool) *CreateGatewayAPIKeyRequest {
 	this := &CreateGatewayAPIKeyRequest{}
-	v22 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v22
+	v23 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v23
 	this.Name = randStringGateway(r)
-	v23 := r.Intn(10)
-	this.Rights = make([]Right, v23)
-	for i := 0; i < v23; i++ {
+	v24 := r.Intn(10)
+	this.Rights = make([]Right, v24)
+	for i := 0; i < v24; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(59)])
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -4682,10 +4960,10 @@ func NewPopulatedCreateGatewayAPIKeyRequest(r randyGateway, easy bool) *CreateGa
 
 func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGatewayAPIKeyRequest {
 	this := &UpdateGatewayAPIKeyRequest{}
-	v24 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v24
-	v25 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v25
+	v25 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v25
+	v26 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v26
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4693,8 +4971,8 @@ func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGa
 
 func NewPopulatedListGatewayCollaboratorsRequest(r randyGateway, easy bool) *ListGatewayCollaboratorsRequest {
 	this := &ListGatewayCollaboratorsRequest{}
-	v26 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v26
+	v27 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v27
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -4704,10 +4982,10 @@ func NewPopulatedListGatewayCollaboratorsRequest(r randyGateway, easy bool) *Lis
 
 func NewPopulatedGetGatewayCollaboratorRequest(r randyGateway, easy bool) *GetGatewayCollaboratorRequest {
 	this := &GetGatewayCollaboratorRequest{}
-	v27 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v27
-	v28 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v28
+	v28 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v28
+	v29 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v29
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4715,10 +4993,10 @@ func NewPopulatedGetGatewayCollaboratorRequest(r randyGateway, easy bool) *GetGa
 
 func NewPopulatedSetGatewayCollaboratorRequest(r randyGateway, easy bool) *SetGatewayCollaboratorRequest {
 	this := &SetGatewayCollaboratorRequest{}
-	v29 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v29
-	v30 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v30
+	v30 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v30
+	v31 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v31
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4730,12 +5008,12 @@ func NewPopulatedGatewayAntenna(r randyGateway, easy bool) *GatewayAntenna {
 	if r.Intn(2) == 0 {
 		this.Gain *= -1
 	}
-	v31 := NewPopulatedLocation(r, easy)
-	this.Location = *v31
+	v32 := NewPopulatedLocation(r, easy)
+	this.Location = *v32
 	if r.Intn(5) != 0 {
-		v32 := r.Intn(10)
+		v33 := r.Intn(10)
 		this.Attributes = make(map[string]string)
-		for i := 0; i < v32; i++ {
+		for i := 0; i < v33; i++ {
 			this.Attributes[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
@@ -4746,37 +5024,37 @@ func NewPopulatedGatewayAntenna(r randyGateway, easy bool) *GatewayAntenna {
 
 func NewPopulatedGatewayStatus(r randyGateway, easy bool) *GatewayStatus {
 	this := &GatewayStatus{}
-	v33 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.Time = *v33
 	v34 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.BootTime = *v34
+	this.Time = *v34
+	v35 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.BootTime = *v35
 	if r.Intn(5) != 0 {
-		v35 := r.Intn(10)
+		v36 := r.Intn(10)
 		this.Versions = make(map[string]string)
-		for i := 0; i < v35; i++ {
+		for i := 0; i < v36; i++ {
 			this.Versions[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
 	if r.Intn(5) != 0 {
-		v36 := r.Intn(5)
-		this.AntennaLocations = make([]*Location, v36)
-		for i := 0; i < v36; i++ {
+		v37 := r.Intn(5)
+		this.AntennaLocations = make([]*Location, v37)
+		for i := 0; i < v37; i++ {
 			this.AntennaLocations[i] = NewPopulatedLocation(r, easy)
 		}
 	}
-	v37 := r.Intn(10)
-	this.IP = make([]string, v37)
-	for i := 0; i < v37; i++ {
+	v38 := r.Intn(10)
+	this.IP = make([]string, v38)
+	for i := 0; i < v38; i++ {
 		this.IP[i] = randStringGateway(r)
 	}
 	if r.Intn(5) != 0 {
-		v38 := r.Intn(10)
+		v39 := r.Intn(10)
 		this.Metrics = make(map[string]float32)
-		for i := 0; i < v38; i++ {
-			v39 := randStringGateway(r)
-			this.Metrics[v39] = float32(r.Float32())
+		for i := 0; i < v39; i++ {
+			v40 := randStringGateway(r)
+			this.Metrics[v40] = float32(r.Float32())
 			if r.Intn(2) == 0 {
-				this.Metrics[v39] *= -1
+				this.Metrics[v40] *= -1
 			}
 		}
 	}
@@ -4812,12 +5090,22 @@ func NewPopulatedGatewayConnectionStats(r randyGateway, easy bool) *GatewayConne
 		this.RoundTripTimes = NewPopulatedGatewayConnectionStats_RoundTripTimes(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		v40 := r.Intn(5)
-		this.SubBands = make([]*GatewayConnectionStats_SubBand, v40)
-		for i := 0; i < v40; i++ {
+		v41 := r.Intn(5)
+		this.SubBands = make([]*GatewayConnectionStats_SubBand, v41)
+		for i := 0; i < v41; i++ {
 			this.SubBands[i] = NewPopulatedGatewayConnectionStats_SubBand(r, easy)
 		}
 	}
+	if r.Intn(5) != 0 {
+		v42 := r.Intn(5)
+		this.ChannelOccupancy = make([]*GatewayConnectionStats_ChannelOccupancy, v42)
+		for i := 0; i < v42; i++ {
+			this.ChannelOccupancy[i] = NewPopulatedGatewayConnectionStats_ChannelOccupancy(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkLoad = NewPopulatedGatewayDownlinkLoad(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4825,12 +5113,12 @@ func NewPopulatedGatewayConnectionStats(r randyGateway, easy bool) *GatewayConne
 
 func NewPopulatedGatewayConnectionStats_RoundTripTimes(r randyGateway, easy bool) *GatewayConnectionStats_RoundTripTimes {
 	this := &GatewayConnectionStats_RoundTripTimes{}
-	v41 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Min = *v41
-	v42 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Max = *v42
 	v43 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Median = *v43
+	this.Min = *v43
+	v44 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Max = *v44
+	v45 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Median = *v45
 	this.Count = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -4854,6 +5142,23 @@ func NewPopulatedGatewayConnectionStats_SubBand(r randyGateway, easy bool) *Gate
 	return this
 }
 
+func NewPopulatedGatewayConnectionStats_ChannelOccupancy(r randyGateway, easy bool) *GatewayConnectionStats_ChannelOccupancy {
+	this := &GatewayConnectionStats_ChannelOccupancy{}
+	this.Frequency = uint64(r.Uint32())
+	this.Occupancy = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Occupancy *= -1
+	}
+	this.TxCount = uint64(r.Uint32())
+	this.BusyCount = uint64(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.LastBusyAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyGateway interface {
 	Float32() float32
 	Float64() float64
@@ -4873,9 +5178,9 @@ func randUTF8RuneGateway(r randyGateway) rune {
 	return rune(ru + 61)
 }
 func randStringGateway(r randyGateway) string {
-	v44 := r.Intn(100)
-	tmps := make([]rune, v44)
-	for i := 0; i < v44; i++ {
+	v46 := r.Intn(100)
+	tmps := make([]rune, v46)
+	for i := 0; i < v46; i++ {
 		tmps[i] = randUTF8RuneGateway(r)
 	}
 	return string(tmps)
@@ -4897,11 +5202,11 @@ func randFieldGateway(dAtA []byte, r randyGateway, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateGateway(dAtA, uint64(key))
-		v45 := r.Int63()
+		v47 := r.Int63()
 		if r.Intn(2) == 0 {
-			v45 *= -1
+			v47 *= -1
 		}
-		dAtA = encodeVarintPopulateGateway(dAtA, uint64(v45))
+		dAtA = encodeVarintPopulateGateway(dAtA, uint64(v47))
 	case 1:
 		dAtA = encodeVarintPopulateGateway(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -5158,6 +5463,12 @@ func (m *Gateway) Size() (n int) {
 		l = m.LBSLNSSecret.Size()
 		n += 2 + l + sovGateway(uint64(l))
 	}
+	if len(m.UDPAllowedSourceCIDRs) > 0 {
+		for _, s := range m.UDPAllowedSourceCIDRs {
+			l = len(s)
+			n += 2 + l + sovGateway(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5474,6 +5785,16 @@ func (m *GatewayConnectionStats) Size() (n int) {
 			n += 1 + l + sovGateway(uint64(l))
 		}
 	}
+	if len(m.ChannelOccupancy) > 0 {
+		for _, e := range m.ChannelOccupancy {
+			l = e.Size()
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
+	if m.DownlinkLoad != nil {
+		l = m.DownlinkLoad.Size()
+		n += 1 + l + sovGateway(uint64(l))
+	}
 	return n
 }
 
@@ -5516,6 +5837,31 @@ func (m *GatewayConnectionStats_SubBand) Size() (n int) {
 	return n
 }
 
+func (m *GatewayConnectionStats_ChannelOccupancy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frequency != 0 {
+		n += 1 + sovGateway(m.Frequency)
+	}
+	if m.Occupancy != 0 {
+		n += 5
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovGateway(m.TxCount)
+	}
+	if m.BusyCount != 0 {
+		n += 1 + sovGateway(m.BusyCount)
+	}
+	if m.LastBusyAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastBusyAt)
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	return n
+}
+
 func sovGateway(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5651,6 +5997,7 @@ func (this *Gateway) String() string {
 		`FrequencyPlanIDs:` + fmt.Sprintf("%v", this.FrequencyPlanIDs) + `,`,
 		`UpdateLocationFromStatus:` + fmt.Sprintf("%v", this.UpdateLocationFromStatus) + `,`,
 		`LBSLNSSecret:` + strings.Replace(fmt.Sprintf("%v", this.LBSLNSSecret), "Secret", "Secret", 1) + `,`,
+		`UDPAllowedSourceCIDRs:` + fmt.Sprintf("%v", this.UDPAllowedSourceCIDRs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5879,6 +6226,11 @@ func (this *GatewayConnectionStats) String() string {
 		repeatedStringForSubBands += strings.Replace(fmt.Sprintf("%v", f), "GatewayConnectionStats_SubBand", "GatewayConnectionStats_SubBand", 1) + ","
 	}
 	repeatedStringForSubBands += "}"
+	repeatedStringForChannelOccupancy := "[]*GatewayConnectionStats_ChannelOccupancy{"
+	for _, f := range this.ChannelOccupancy {
+		repeatedStringForChannelOccupancy += strings.Replace(fmt.Sprintf("%v", f), "GatewayConnectionStats_ChannelOccupancy", "GatewayConnectionStats_ChannelOccupancy", 1) + ","
+	}
+	repeatedStringForChannelOccupancy += "}"
 	s := strings.Join([]string{`&GatewayConnectionStats{`,
 		`ConnectedAt:` + strings.Replace(fmt.Sprintf("%v", this.ConnectedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
@@ -5890,6 +6242,8 @@ func (this *GatewayConnectionStats) String() string {
 		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
 		`RoundTripTimes:` + strings.Replace(fmt.Sprintf("%v", this.RoundTripTimes), "GatewayConnectionStats_RoundTripTimes", "GatewayConnectionStats_RoundTripTimes", 1) + `,`,
 		`SubBands:` + repeatedStringForSubBands + `,`,
+		`ChannelOccupancy:` + repeatedStringForChannelOccupancy + `,`,
+		`DownlinkLoad:` + strings.Replace(this.DownlinkLoad.String(), "GatewayDownlinkLoad", "GatewayDownlinkLoad", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5920,6 +6274,20 @@ func (this *GatewayConnectionStats_SubBand) String() string {
 	}, "")
 	return s
 }
+func (this *GatewayConnectionStats_ChannelOccupancy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayConnectionStats_ChannelOccupancy{`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`Occupancy:` + fmt.Sprintf("%v", this.Occupancy) + `,`,
+		`TxCount:` + fmt.Sprintf("%v", this.TxCount) + `,`,
+		`BusyCount:` + fmt.Sprintf("%v", this.BusyCount) + `,`,
+		`LastBusyAt:` + strings.Replace(fmt.Sprintf("%v", this.LastBusyAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGateway(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -7650,6 +8018,38 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UDPAllowedSourceCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UDPAllowedSourceCIDRs = append(m.UDPAllowedSourceCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -10333,6 +10733,76 @@ func (m *GatewayConnectionStats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOccupancy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelOccupancy = append(m.ChannelOccupancy, &GatewayConnectionStats_ChannelOccupancy{})
+			if err := m.ChannelOccupancy[len(m.ChannelOccupancy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkLoad", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkLoad == nil {
+				m.DownlinkLoad = &GatewayDownlinkLoad{}
+			}
+			if err := m.DownlinkLoad.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -10641,6 +11111,163 @@ func (m *GatewayConnectionStats_SubBand) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GatewayConnectionStats_ChannelOccupancy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelOccupancy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelOccupancy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			m.Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Occupancy", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.Occupancy = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BusyCount", wireType)
+			}
+			m.BusyCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BusyCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBusyAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastBusyAt == nil {
+				m.LastBusyAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastBusyAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGateway(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -97,6 +97,7 @@ var GatewayFieldPathsNested = []string{
 	"schedule_anytime_delay",
 	"schedule_downlink_late",
 	"status_public",
+	"udp_allowed_source_cidrs",
 	"update_channel",
 	"update_location_from_status",
 	"updated_at",
@@ -126,6 +127,7 @@ var GatewayFieldPathsTopLevel = []string{
 	"schedule_anytime_delay",
 	"schedule_downlink_late",
 	"status_public",
+	"udp_allowed_source_cidrs",
 	"update_channel",
 	"update_location_from_status",
 	"updated_at",
@@ -208,6 +210,7 @@ var CreateGatewayRequestFieldPathsNested = []string{
 	"gateway.schedule_anytime_delay",
 	"gateway.schedule_downlink_late",
 	"gateway.status_public",
+	"gateway.udp_allowed_source_cidrs",
 	"gateway.update_channel",
 	"gateway.update_location_from_status",
 	"gateway.updated_at",
@@ -247,6 +250,7 @@ var UpdateGatewayRequestFieldPathsNested = []string{
 	"gateway.schedule_anytime_delay",
 	"gateway.schedule_downlink_late",
 	"gateway.status_public",
+	"gateway.udp_allowed_source_cidrs",
 	"gateway.update_channel",
 	"gateway.update_location_from_status",
 	"gateway.updated_at",
@@ -398,8 +402,13 @@ var GatewayStatusFieldPathsTopLevel = []string{
 	"versions",
 }
 var GatewayConnectionStatsFieldPathsNested = []string{
+	"channel_occupancy",
 	"connected_at",
 	"downlink_count",
+	"downlink_load",
+	"downlink_load.queue_depth",
+	"downlink_load.tx_failure_rate",
+	"downlink_load.utilization",
 	"last_downlink_received_at",
 	"last_status",
 	"last_status.advanced",
@@ -422,8 +431,10 @@ var GatewayConnectionStatsFieldPathsNested = []string{
 }
 
 var GatewayConnectionStatsFieldPathsTopLevel = []string{
+	"channel_occupancy",
 	"connected_at",
 	"downlink_count",
+	"downlink_load",
 	"last_downlink_received_at",
 	"last_status",
 	"last_status_received_at",
@@ -470,3 +481,18 @@ var GatewayConnectionStats_SubBandFieldPathsTopLevel = []string{
 	"max_frequency",
 	"min_frequency",
 }
+var GatewayConnectionStats_ChannelOccupancyFieldPathsNested = []string{
+	"busy_count",
+	"frequency",
+	"last_busy_at",
+	"occupancy",
+	"tx_count",
+}
+
+var GatewayConnectionStats_ChannelOccupancyFieldPathsTopLevel = []string{
+	"busy_count",
+	"frequency",
+	"last_busy_at",
+	"occupancy",
+	"tx_count",
+}
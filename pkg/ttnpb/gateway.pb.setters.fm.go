@@ -534,6 +534,15 @@ func (dst *Gateway) SetFields(src *Gateway, paths ...string) error {
 					dst.LBSLNSSecret = nil
 				}
 			}
+		case "udp_allowed_source_cidrs":
+			if len(subs) > 0 {
+				return fmt.Errorf("'udp_allowed_source_cidrs' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UDPAllowedSourceCIDRs = src.UDPAllowedSourceCIDRs
+			} else {
+				dst.UDPAllowedSourceCIDRs = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1362,6 +1371,40 @@ func (dst *GatewayConnectionStats) SetFields(src *GatewayConnectionStats, paths
 			} else {
 				dst.SubBands = nil
 			}
+		case "channel_occupancy":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channel_occupancy' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChannelOccupancy = src.ChannelOccupancy
+			} else {
+				dst.ChannelOccupancy = nil
+			}
+		case "downlink_load":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayDownlinkLoad
+				if (src == nil || src.DownlinkLoad == nil) && dst.DownlinkLoad == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DownlinkLoad
+				}
+				if dst.DownlinkLoad != nil {
+					newDst = dst.DownlinkLoad
+				} else {
+					newDst = &GatewayDownlinkLoad{}
+					dst.DownlinkLoad = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkLoad = src.DownlinkLoad
+				} else {
+					dst.DownlinkLoad = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1512,3 +1555,63 @@ func (dst *GatewayConnectionStats_SubBand) SetFields(src *GatewayConnectionStats
 	}
 	return nil
 }
+
+func (dst *GatewayConnectionStats_ChannelOccupancy) SetFields(src *GatewayConnectionStats_ChannelOccupancy, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Frequency = src.Frequency
+			} else {
+				var zero uint64
+				dst.Frequency = zero
+			}
+		case "occupancy":
+			if len(subs) > 0 {
+				return fmt.Errorf("'occupancy' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Occupancy = src.Occupancy
+			} else {
+				var zero float32
+				dst.Occupancy = zero
+			}
+		case "tx_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxCount = src.TxCount
+			} else {
+				var zero uint64
+				dst.TxCount = zero
+			}
+		case "busy_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'busy_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BusyCount = src.BusyCount
+			} else {
+				var zero uint64
+				dst.BusyCount = zero
+			}
+		case "last_busy_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_busy_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastBusyAt = src.LastBusyAt
+			} else {
+				dst.LastBusyAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
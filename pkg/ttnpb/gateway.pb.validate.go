@@ -795,6 +795,27 @@ func (m *Gateway) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "udp_allowed_source_cidrs":
+
+			if len(m.GetUDPAllowedSourceCIDRs()) > 16 {
+				return GatewayValidationError{
+					field:  "udp_allowed_source_cidrs",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetUDPAllowedSourceCIDRs() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 43 {
+					return GatewayValidationError{
+						field:  fmt.Sprintf("udp_allowed_source_cidrs[%v]", idx),
+						reason: "value length must be at most 43 runes",
+					}
+				}
+
+			}
+
 		default:
 			return GatewayValidationError{
 				field:  name,
@@ -2634,6 +2655,35 @@ func (m *GatewayConnectionStats) ValidateFields(paths ...string) error {
 
 			}
 
+		case "channel_occupancy":
+
+			for idx, item := range m.GetChannelOccupancy() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConnectionStatsValidationError{
+							field:  fmt.Sprintf("channel_occupancy[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "downlink_load":
+
+			if v, ok := interface{}(m.GetDownlinkLoad()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsValidationError{
+						field:  "downlink_load",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return GatewayConnectionStatsValidationError{
 				field:  name,
@@ -2967,3 +3017,105 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GatewayConnectionStats_SubBandValidationError{}
+
+// ValidateFields checks the field values on
+// GatewayConnectionStats_ChannelOccupancy with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *GatewayConnectionStats_ChannelOccupancy) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionStats_ChannelOccupancyFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "frequency":
+			// no validation rules for Frequency
+		case "occupancy":
+			// no validation rules for Occupancy
+		case "tx_count":
+			// no validation rules for TxCount
+		case "busy_count":
+			// no validation rules for BusyCount
+		case "last_busy_at":
+
+			if v, ok := interface{}(m.GetLastBusyAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStats_ChannelOccupancyValidationError{
+						field:  "last_busy_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayConnectionStats_ChannelOccupancyValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionStats_ChannelOccupancyValidationError is the validation
+// error returned by GatewayConnectionStats_ChannelOccupancy.ValidateFields if
+// the designated constraints aren't met.
+type GatewayConnectionStats_ChannelOccupancyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionStats_ChannelOccupancyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionStats_ChannelOccupancyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionStats_ChannelOccupancyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionStats_ChannelOccupancyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionStats_ChannelOccupancyValidationError) ErrorName() string {
+	return "GatewayConnectionStats_ChannelOccupancyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionStats_ChannelOccupancyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionStats_ChannelOccupancy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionStats_ChannelOccupancyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionStats_ChannelOccupancyValidationError{}
@@ -4,12 +4,14 @@
 package ttnpb
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 	time "time"
 
@@ -38,6 +40,27 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type GatewayTrafficCaptureRecord_Direction int32
+
+const (
+	GatewayTrafficCaptureRecord_UPSTREAM   GatewayTrafficCaptureRecord_Direction = 0
+	GatewayTrafficCaptureRecord_DOWNSTREAM GatewayTrafficCaptureRecord_Direction = 1
+)
+
+var GatewayTrafficCaptureRecord_Direction_name = map[int32]string{
+	0: "UPSTREAM",
+	1: "DOWNSTREAM",
+}
+
+var GatewayTrafficCaptureRecord_Direction_value = map[string]int32{
+	"UPSTREAM":   0,
+	"DOWNSTREAM": 1,
+}
+
+func (GatewayTrafficCaptureRecord_Direction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{7, 0}
+}
+
 // GatewayUp may contain zero or more uplink messages and/or a status message for the gateway.
 type GatewayUp struct {
 	// Uplink messages received by the gateway.
@@ -198,9 +221,11 @@ func (m *ScheduleDownlinkResponse) GetDelay() time.Duration {
 
 type ScheduleDownlinkErrorDetails struct {
 	// Errors per path when downlink scheduling failed.
-	PathErrors           []*ErrorDetails `protobuf:"bytes,1,rep,name=path_errors,json=pathErrors,proto3" json:"path_errors,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	PathErrors []*ErrorDetails `protobuf:"bytes,1,rep,name=path_errors,json=pathErrors,proto3" json:"path_errors,omitempty"`
+	// Scheduling attempts in the receive windows of the path.
+	Attempts             []*GatewayTrafficCaptureRecord_SchedulingAttempt `protobuf:"bytes,2,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                         `json:"-"`
+	XXX_sizecache        int32                                            `json:"-"`
 }
 
 func (m *ScheduleDownlinkErrorDetails) Reset()      { *m = ScheduleDownlinkErrorDetails{} }
@@ -242,1040 +267,3848 @@ func (m *ScheduleDownlinkErrorDetails) GetPathErrors() []*ErrorDetails {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
-	golang_proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
-	proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
-	golang_proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
-	proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
-	golang_proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
-	proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
-	golang_proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
+func (m *ScheduleDownlinkErrorDetails) GetAttempts() []*GatewayTrafficCaptureRecord_SchedulingAttempt {
+	if m != nil {
+		return m.Attempts
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
-}
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
+// DownlinkSchedulingTrace is a trace of the Gateway Server scheduler for scheduling a downlink message.
+type DownlinkSchedulingTrace struct {
+	// Emission of the downlink message, as attempted by the scheduler.
+	Emission *DownlinkSchedulingTrace_Emission `protobuf:"bytes,1,opt,name=emission,proto3" json:"emission,omitempty"`
+	// Duty-cycle utilization of the sub-band of the emission, before scheduling the emission.
+	SubBand *GatewayConnectionStats_SubBand `protobuf:"bytes,2,opt,name=sub_band,json=subBand,proto3" json:"sub_band,omitempty"`
+	// Emission that conflicts with the downlink message, if any.
+	Conflict *DownlinkSchedulingTrace_Emission `protobuf:"bytes,3,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	// Minimum time between the scheduling time and the emission, based on the round-trip times if available.
+	MinScheduleTime time.Duration `protobuf:"bytes,4,opt,name=min_schedule_time,json=minScheduleTime,proto3,stdduration" json:"min_schedule_time"`
+	// Median round-trip time, if available.
+	MedianRTT *time.Duration `protobuf:"bytes,5,opt,name=median_rtt,json=medianRtt,proto3,stdduration" json:"median_rtt,omitempty"`
+	// Time between the scheduling time and the emission, minus the minimum schedule time.
+	// A negative margin means that it is too late to schedule the emission.
+	// This is only set if the server time is available.
+	RTTMargin            *time.Duration `protobuf:"bytes,6,opt,name=rtt_margin,json=rttMargin,proto3,stdduration" json:"rtt_margin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-var fileDescriptor_62b07a36420f2d6d = []byte{
-	// 856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x41, 0x68, 0x24, 0x45,
-	0x14, 0xad, 0x4a, 0x8c, 0x68, 0x05, 0xb3, 0xb1, 0x40, 0x9d, 0xcc, 0x66, 0x2b, 0x61, 0x44, 0x09,
-	0xe2, 0x74, 0x87, 0x09, 0x88, 0x17, 0x0f, 0x49, 0x66, 0x1d, 0x22, 0x46, 0x70, 0x92, 0x08, 0x0a,
-	0x21, 0x54, 0xa6, 0x2b, 0x35, 0xcd, 0x74, 0xaa, 0xda, 0xae, 0x9a, 0x99, 0x0c, 0x22, 0x2c, 0x9e,
-	0xf6, 0x28, 0x78, 0x70, 0xc1, 0x8b, 0x08, 0xc2, 0xe2, 0x69, 0x8f, 0x7b, 0xdc, 0x63, 0x8e, 0x0b,
-	0x5e, 0xf6, 0xe4, 0xee, 0x74, 0x7b, 0xc8, 0x71, 0x8f, 0x8b, 0x27, 0xe9, 0x9a, 0xea, 0x64, 0xa6,
-	0x27, 0xad, 0x5e, 0xbc, 0x75, 0xf5, 0x7f, 0xff, 0xfd, 0xf7, 0x5f, 0xfd, 0x5f, 0xe8, 0x9d, 0x40,
-	0x46, 0xb4, 0x4f, 0x45, 0x55, 0x69, 0xda, 0xea, 0xb8, 0x34, 0xf4, 0x5d, 0x4e, 0x35, 0xeb, 0xd3,
-	0x81, 0x62, 0x51, 0x8f, 0x45, 0x4e, 0x18, 0x49, 0x2d, 0xf1, 0x82, 0xd6, 0xc2, 0xb1, 0x50, 0xa7,
-	0xb7, 0x51, 0xde, 0xe4, 0xbe, 0x6e, 0x77, 0x8f, 0x9d, 0x96, 0x3c, 0x75, 0x99, 0xe8, 0xc9, 0x41,
-	0x18, 0xc9, 0xb3, 0x81, 0x6b, 0xc0, 0xad, 0x2a, 0x67, 0xa2, 0xda, 0xa3, 0x81, 0xef, 0x51, 0xcd,
-	0xdc, 0xa9, 0x8f, 0x11, 0x65, 0xb9, 0x3a, 0x46, 0xc1, 0x25, 0x97, 0xa3, 0xe4, 0xe3, 0xee, 0x89,
-	0x39, 0x99, 0x83, 0xf9, 0xb2, 0xf0, 0x65, 0x2e, 0x25, 0x0f, 0x98, 0x51, 0x48, 0x85, 0x90, 0x9a,
-	0x6a, 0x5f, 0x0a, 0x65, 0xa3, 0xc4, 0x46, 0x2f, 0x39, 0xbc, 0x6e, 0x64, 0x00, 0x36, 0x7e, 0x33,
-	0x1f, 0x67, 0xa7, 0xa1, 0x1e, 0xd8, 0xe0, 0xad, 0x69, 0x0f, 0x58, 0x14, 0x49, 0xdb, 0x7b, 0x79,
-	0xa5, 0xd0, 0x22, 0x0b, 0x78, 0x7b, 0x1a, 0xe0, 0x7b, 0x4c, 0x68, 0xff, 0xc4, 0x67, 0x51, 0xa6,
-	0x70, 0x75, 0x1a, 0x74, 0xca, 0x94, 0xa2, 0x9c, 0x65, 0x88, 0xe5, 0x6b, 0x10, 0x5f, 0x6b, 0x5d,
-	0x9c, 0x1f, 0x31, 0xee, 0x4b, 0x41, 0x83, 0x11, 0xa2, 0x72, 0x01, 0xd1, 0xab, 0x8d, 0x91, 0xb0,
-	0x83, 0x10, 0x7f, 0x8c, 0x6e, 0x74, 0xc3, 0xc0, 0x17, 0x9d, 0xa3, 0xac, 0x4c, 0x09, 0xae, 0xce,
-	0xae, 0xcd, 0xd7, 0x6e, 0x39, 0x93, 0x77, 0xe9, 0x1c, 0x18, 0xd8, 0xee, 0x08, 0xd5, 0x5c, 0xe8,
-	0x8e, 0x1f, 0x15, 0xae, 0xa3, 0x05, 0xdb, 0xed, 0x91, 0xd2, 0x54, 0x77, 0x55, 0x69, 0x66, 0x15,
-	0x5e, 0x47, 0x63, 0x4b, 0xef, 0x19, 0x50, 0xf3, 0x35, 0x3e, 0x7e, 0xc4, 0xbb, 0xe8, 0x75, 0x7d,
-	0x76, 0x44, 0x5b, 0x1d, 0x21, 0xfb, 0x01, 0xf3, 0xf8, 0x29, 0x13, 0xba, 0x34, 0x6b, 0x88, 0x56,
-	0xf3, 0x44, 0xfb, 0x67, 0x9b, 0x13, 0xb8, 0xe6, 0xa2, 0xce, 0xfd, 0xa9, 0x7c, 0x89, 0xe6, 0x6d,
-	0xb9, 0xba, 0xec, 0x0b, 0xfc, 0x09, 0x5a, 0xf4, 0x64, 0x5f, 0x8c, 0x77, 0x5b, 0x82, 0x86, 0x7c,
-	0x25, 0x4f, 0x5e, 0xb7, 0xb8, 0xac, 0xdd, 0x1b, 0xde, 0xe4, 0x8f, 0xca, 0x21, 0x2a, 0xed, 0xb5,
-	0xda, 0xcc, 0xeb, 0x06, 0x2c, 0xc3, 0x36, 0x99, 0x0a, 0xa5, 0x50, 0x0c, 0x6f, 0xa2, 0x39, 0x8f,
-	0x05, 0x74, 0x60, 0xc9, 0x97, 0x9c, 0xd1, 0x54, 0x39, 0xd9, 0x54, 0x39, 0x75, 0x3b, 0x75, 0x5b,
-	0x8b, 0x7f, 0x6d, 0xcd, 0xfd, 0x06, 0x67, 0x5e, 0x81, 0xe7, 0x7f, 0xac, 0x80, 0x7b, 0x4f, 0x57,
-	0x60, 0x73, 0x94, 0x59, 0x39, 0x44, 0xcb, 0x79, 0xfa, 0xdb, 0xe9, 0xac, 0xd5, 0x99, 0xa6, 0x7e,
-	0xa0, 0xf0, 0x47, 0x68, 0x3e, 0xa4, 0xba, 0x7d, 0x64, 0x06, 0x30, 0xbb, 0xb2, 0xe5, 0x7c, 0x17,
-	0xe3, 0x29, 0x4d, 0x94, 0x26, 0x98, 0x3f, 0xaa, 0xf6, 0x74, 0x16, 0xcd, 0x35, 0x74, 0xbf, 0xa1,
-	0xf0, 0x0e, 0x9a, 0xff, 0xd4, 0x17, 0x1d, 0x6b, 0x13, 0x5e, 0x2a, 0xb8, 0xae, 0x83, 0xb0, 0x7c,
-	0xb3, 0x20, 0x94, 0xea, 0x5b, 0x83, 0xeb, 0x10, 0xef, 0xa1, 0x37, 0x1a, 0x4c, 0x6f, 0x4b, 0xd1,
-	0x62, 0x42, 0x47, 0x54, 0xcb, 0x68, 0x5b, 0x8a, 0x13, 0x9f, 0xe3, 0x37, 0xa7, 0x0c, 0xb8, 0x9d,
-	0xae, 0x55, 0xb9, 0x92, 0x67, 0xbc, 0x26, 0xf7, 0x47, 0x68, 0x58, 0x77, 0x3f, 0xdf, 0xdf, 0xdf,
-	0x96, 0x42, 0xb0, 0x56, 0xea, 0xdb, 0x8e, 0x38, 0x91, 0xb8, 0x52, 0xa0, 0x67, 0xe7, 0x6a, 0xa7,
-	0xa6, 0x2b, 0x4c, 0xf3, 0x54, 0x3e, 0xf8, 0xee, 0xf7, 0x3f, 0x7f, 0x98, 0x59, 0xc7, 0x8e, 0xcb,
-	0xd5, 0xe5, 0xa3, 0xe6, 0x7e, 0x93, 0x4d, 0xb3, 0xef, 0x7d, 0x6b, 0xf6, 0xab, 0xda, 0xba, 0x4c,
-	0xab, 0xfa, 0x69, 0xfd, 0x9f, 0x20, 0x7a, 0xcb, 0x2a, 0xfb, 0xa2, 0xf6, 0x3f, 0x69, 0xfb, 0xd0,
-	0x68, 0xab, 0xe1, 0xf5, 0x7f, 0xd6, 0xd6, 0xab, 0xe5, 0xd5, 0xd5, 0x18, 0x7a, 0xe9, 0x33, 0xd5,
-	0x50, 0xf8, 0x10, 0x2d, 0xe6, 0x07, 0x09, 0xff, 0xdb, 0xb4, 0x97, 0xd7, 0xf2, 0x80, 0xa2, 0x51,
-	0xaf, 0xfd, 0x02, 0xd1, 0x4c, 0x43, 0xa5, 0x5e, 0x2c, 0x35, 0x98, 0xb6, 0x5d, 0x5e, 0x35, 0x91,
-	0xae, 0xb5, 0xfa, 0x4f, 0x6e, 0xbc, 0x5b, 0x80, 0xc9, 0x71, 0x55, 0x6a, 0xc6, 0x91, 0xf7, 0xf1,
-	0x7b, 0xc5, 0x8e, 0x5c, 0x59, 0xe1, 0xa6, 0x4f, 0x91, 0xda, 0xfa, 0x15, 0x9e, 0x0f, 0x09, 0x7c,
-	0x3c, 0x24, 0xf0, 0xc9, 0x90, 0x80, 0x67, 0x43, 0x02, 0x2e, 0x86, 0x04, 0x3c, 0x1f, 0x12, 0xf0,
-	0x62, 0x48, 0xe0, 0x9d, 0x98, 0xc0, 0xbb, 0x31, 0x01, 0xf7, 0x63, 0x02, 0x1f, 0xc4, 0x04, 0x3c,
-	0x8c, 0x09, 0x78, 0x14, 0x13, 0x70, 0x1e, 0x13, 0xf8, 0x38, 0x26, 0xf0, 0x49, 0x4c, 0xc0, 0xb3,
-	0x98, 0xc0, 0x8b, 0x98, 0x80, 0xe7, 0x31, 0x81, 0x2f, 0x62, 0x02, 0xee, 0x24, 0x04, 0xdc, 0x4d,
-	0x08, 0xfc, 0x3e, 0x21, 0xe0, 0x5e, 0x42, 0xe0, 0xcf, 0x09, 0x01, 0xf7, 0x13, 0x02, 0x1e, 0x24,
-	0x04, 0x3e, 0x4c, 0x08, 0x7c, 0x94, 0x10, 0xf8, 0x95, 0xcb, 0xa5, 0xa3, 0xdb, 0x4c, 0xb7, 0x7d,
-	0xc1, 0x95, 0x23, 0x98, 0xee, 0xcb, 0xa8, 0xe3, 0x4e, 0xbe, 0xcf, 0xbd, 0x0d, 0x37, 0xec, 0x70,
-	0x57, 0x6b, 0x11, 0x1e, 0x1f, 0xbf, 0x6c, 0xf6, 0x63, 0xe3, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x90, 0xb7, 0x04, 0xec, 0x6d, 0x07, 0x00, 0x00,
+func (m *DownlinkSchedulingTrace) Reset()      { *m = DownlinkSchedulingTrace{} }
+func (*DownlinkSchedulingTrace) ProtoMessage() {}
+func (*DownlinkSchedulingTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{4}
 }
-
-func (this *GatewayUp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*GatewayUp)
-	if !ok {
-		that2, ok := that.(GatewayUp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.UplinkMessages) != len(that1.UplinkMessages) {
-		return false
-	}
-	for i := range this.UplinkMessages {
-		if !this.UplinkMessages[i].Equal(that1.UplinkMessages[i]) {
-			return false
+func (m *DownlinkSchedulingTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DownlinkSchedulingTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DownlinkSchedulingTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !this.GatewayStatus.Equal(that1.GatewayStatus) {
-		return false
-	}
-	if !this.TxAcknowledgment.Equal(that1.TxAcknowledgment) {
-		return false
-	}
-	return true
 }
-func (this *GatewayDown) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DownlinkSchedulingTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownlinkSchedulingTrace.Merge(m, src)
+}
+func (m *DownlinkSchedulingTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *DownlinkSchedulingTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownlinkSchedulingTrace.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GatewayDown)
-	if !ok {
-		that2, ok := that.(GatewayDown)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.DownlinkMessage.Equal(that1.DownlinkMessage) {
-		return false
+var xxx_messageInfo_DownlinkSchedulingTrace proto.InternalMessageInfo
+
+func (m *DownlinkSchedulingTrace) GetEmission() *DownlinkSchedulingTrace_Emission {
+	if m != nil {
+		return m.Emission
 	}
-	return true
+	return nil
 }
-func (this *ScheduleDownlinkResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ScheduleDownlinkResponse)
-	if !ok {
-		that2, ok := that.(ScheduleDownlinkResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Delay != that1.Delay {
-		return false
+func (m *DownlinkSchedulingTrace) GetSubBand() *GatewayConnectionStats_SubBand {
+	if m != nil {
+		return m.SubBand
 	}
-	return true
+	return nil
 }
-func (this *ScheduleDownlinkErrorDetails) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ScheduleDownlinkErrorDetails)
-	if !ok {
-		that2, ok := that.(ScheduleDownlinkErrorDetails)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.PathErrors) != len(that1.PathErrors) {
-		return false
-	}
-	for i := range this.PathErrors {
-		if !this.PathErrors[i].Equal(that1.PathErrors[i]) {
-			return false
-		}
+func (m *DownlinkSchedulingTrace) GetConflict() *DownlinkSchedulingTrace_Emission {
+	if m != nil {
+		return m.Conflict
 	}
-	return true
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *DownlinkSchedulingTrace) GetMinScheduleTime() time.Duration {
+	if m != nil {
+		return m.MinScheduleTime
+	}
+	return 0
+}
 
-// GtwGsClient is the client API for GtwGs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GtwGsClient interface {
-	// Link a gateway to the Gateway Server for streaming upstream messages and downstream messages.
-	LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error)
-	// Get configuration for the concentrator.
-	GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error)
-	// Get connection information to connect an MQTT gateway.
-	GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
-	// Get legacy connection information to connect a The Things Network Stack V2 MQTT gateway.
-	GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+func (m *DownlinkSchedulingTrace) GetMedianRTT() *time.Duration {
+	if m != nil {
+		return m.MedianRTT
+	}
+	return nil
 }
 
-type gtwGsClient struct {
-	cc *grpc.ClientConn
+func (m *DownlinkSchedulingTrace) GetRTTMargin() *time.Duration {
+	if m != nil {
+		return m.RTTMargin
+	}
+	return nil
 }
 
-func NewGtwGsClient(cc *grpc.ClientConn) GtwGsClient {
-	return &gtwGsClient{cc}
+type DownlinkSchedulingTrace_Emission struct {
+	// Concentrator time at which the emission starts.
+	Starts int64 `protobuf:"varint,1,opt,name=starts,proto3" json:"starts,omitempty"`
+	// Time on air of the emission.
+	Duration             time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (c *gtwGsClient) LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GtwGs_serviceDesc.Streams[0], "/ttn.lorawan.v3.GtwGs/LinkGateway", opts...)
-	if err != nil {
-		return nil, err
+func (m *DownlinkSchedulingTrace_Emission) Reset()      { *m = DownlinkSchedulingTrace_Emission{} }
+func (*DownlinkSchedulingTrace_Emission) ProtoMessage() {}
+func (*DownlinkSchedulingTrace_Emission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{4, 0}
+}
+func (m *DownlinkSchedulingTrace_Emission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DownlinkSchedulingTrace_Emission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DownlinkSchedulingTrace_Emission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &gtwGsLinkGatewayClient{stream}
-	return x, nil
+}
+func (m *DownlinkSchedulingTrace_Emission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownlinkSchedulingTrace_Emission.Merge(m, src)
+}
+func (m *DownlinkSchedulingTrace_Emission) XXX_Size() int {
+	return m.Size()
+}
+func (m *DownlinkSchedulingTrace_Emission) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownlinkSchedulingTrace_Emission.DiscardUnknown(m)
 }
 
-type GtwGs_LinkGatewayClient interface {
-	Send(*GatewayUp) error
-	Recv() (*GatewayDown, error)
-	grpc.ClientStream
+var xxx_messageInfo_DownlinkSchedulingTrace_Emission proto.InternalMessageInfo
+
+func (m *DownlinkSchedulingTrace_Emission) GetStarts() int64 {
+	if m != nil {
+		return m.Starts
+	}
+	return 0
 }
 
-type gtwGsLinkGatewayClient struct {
-	grpc.ClientStream
+func (m *DownlinkSchedulingTrace_Emission) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
 }
 
-func (x *gtwGsLinkGatewayClient) Send(m *GatewayUp) error {
-	return x.ClientStream.SendMsg(m)
+type RunGatewayRemoteCommandRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// The command to run on the gateway.
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	// The arguments of the command.
+	Arguments            []string `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *gtwGsLinkGatewayClient) Recv() (*GatewayDown, error) {
-	m := new(GatewayDown)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *RunGatewayRemoteCommandRequest) Reset()      { *m = RunGatewayRemoteCommandRequest{} }
+func (*RunGatewayRemoteCommandRequest) ProtoMessage() {}
+func (*RunGatewayRemoteCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{5}
+}
+func (m *RunGatewayRemoteCommandRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RunGatewayRemoteCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RunGatewayRemoteCommandRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *RunGatewayRemoteCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunGatewayRemoteCommandRequest.Merge(m, src)
+}
+func (m *RunGatewayRemoteCommandRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RunGatewayRemoteCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunGatewayRemoteCommandRequest.DiscardUnknown(m)
 }
 
-func (c *gtwGsClient) GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error) {
-	out := new(ConcentratorConfig)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RunGatewayRemoteCommandRequest proto.InternalMessageInfo
+
+func (m *RunGatewayRemoteCommandRequest) GetCommand() string {
+	if m != nil {
+		return m.Command
 	}
-	return out, nil
+	return ""
 }
 
-func (c *gtwGsClient) GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
-	out := new(MQTTConnectionInfo)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RunGatewayRemoteCommandRequest) GetArguments() []string {
+	if m != nil {
+		return m.Arguments
 	}
-	return out, nil
+	return nil
 }
 
-func (c *gtwGsClient) GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
-	out := new(MQTTConnectionInfo)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CaptureGatewayTrafficRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// Duration of the traffic capture.
+	Duration             time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-// GtwGsServer is the server API for GtwGs service.
-type GtwGsServer interface {
-	// Link a gateway to the Gateway Server for streaming upstream messages and downstream messages.
-	LinkGateway(GtwGs_LinkGatewayServer) error
-	// Get configuration for the concentrator.
-	GetConcentratorConfig(context.Context, *types.Empty) (*ConcentratorConfig, error)
-	// Get connection information to connect an MQTT gateway.
-	GetMQTTConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
-	// Get legacy connection information to connect a The Things Network Stack V2 MQTT gateway.
-	GetMQTTV2ConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
+func (m *CaptureGatewayTrafficRequest) Reset()      { *m = CaptureGatewayTrafficRequest{} }
+func (*CaptureGatewayTrafficRequest) ProtoMessage() {}
+func (*CaptureGatewayTrafficRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{6}
 }
-
-// UnimplementedGtwGsServer can be embedded to have forward compatible implementations.
-type UnimplementedGtwGsServer struct {
+func (m *CaptureGatewayTrafficRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedGtwGsServer) LinkGateway(srv GtwGs_LinkGatewayServer) error {
-	return status.Errorf(codes.Unimplemented, "method LinkGateway not implemented")
+func (m *CaptureGatewayTrafficRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CaptureGatewayTrafficRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedGtwGsServer) GetConcentratorConfig(ctx context.Context, req *types.Empty) (*ConcentratorConfig, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConcentratorConfig not implemented")
+func (m *CaptureGatewayTrafficRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaptureGatewayTrafficRequest.Merge(m, src)
 }
-func (*UnimplementedGtwGsServer) GetMQTTConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTConnectionInfo not implemented")
+func (m *CaptureGatewayTrafficRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedGtwGsServer) GetMQTTV2ConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTV2ConnectionInfo not implemented")
+func (m *CaptureGatewayTrafficRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaptureGatewayTrafficRequest.DiscardUnknown(m)
 }
 
-func RegisterGtwGsServer(s *grpc.Server, srv GtwGsServer) {
-	s.RegisterService(&_GtwGs_serviceDesc, srv)
-}
+var xxx_messageInfo_CaptureGatewayTrafficRequest proto.InternalMessageInfo
 
-func _GtwGs_LinkGateway_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(GtwGsServer).LinkGateway(&gtwGsLinkGatewayServer{stream})
+func (m *CaptureGatewayTrafficRequest) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
 }
 
-type GtwGs_LinkGatewayServer interface {
-	Send(*GatewayDown) error
-	Recv() (*GatewayUp, error)
-	grpc.ServerStream
+// GatewayTrafficCaptureRecord is a record of gateway traffic captured by the Gateway Server.
+// A record contains either a raw frontend message, or the scheduling attempts for a downlink message.
+type GatewayTrafficCaptureRecord struct {
+	// Time when the record was captured.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// Protocol of the gateway frontend.
+	Protocol  string                                `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Direction GatewayTrafficCaptureRecord_Direction `protobuf:"varint,3,opt,name=direction,proto3,enum=ttn.lorawan.v3.GatewayTrafficCaptureRecord_Direction" json:"direction,omitempty"`
+	// Remote address of the gateway, if known.
+	RemoteAddr string `protobuf:"bytes,4,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	// Raw frontend message.
+	Raw []byte `protobuf:"bytes,5,opt,name=raw,proto3" json:"raw,omitempty"`
+	// Scheduling attempts for a downlink message.
+	SchedulingAttempts   []*GatewayTrafficCaptureRecord_SchedulingAttempt `protobuf:"bytes,6,rep,name=scheduling_attempts,json=schedulingAttempts,proto3" json:"scheduling_attempts,omitempty"`
+	CorrelationIDs       []string                                         `protobuf:"bytes,7,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                         `json:"-"`
+	XXX_sizecache        int32                                            `json:"-"`
 }
 
-type gtwGsLinkGatewayServer struct {
-	grpc.ServerStream
+func (m *GatewayTrafficCaptureRecord) Reset()      { *m = GatewayTrafficCaptureRecord{} }
+func (*GatewayTrafficCaptureRecord) ProtoMessage() {}
+func (*GatewayTrafficCaptureRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{7}
 }
-
-func (x *gtwGsLinkGatewayServer) Send(m *GatewayDown) error {
-	return x.ServerStream.SendMsg(m)
+func (m *GatewayTrafficCaptureRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *gtwGsLinkGatewayServer) Recv() (*GatewayUp, error) {
-	m := new(GatewayUp)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GatewayTrafficCaptureRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayTrafficCaptureRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *GatewayTrafficCaptureRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayTrafficCaptureRecord.Merge(m, src)
+}
+func (m *GatewayTrafficCaptureRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayTrafficCaptureRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayTrafficCaptureRecord.DiscardUnknown(m)
 }
 
-func _GtwGs_GetConcentratorConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GtwGsServer).GetConcentratorConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GtwGsServer).GetConcentratorConfig(ctx, req.(*types.Empty))
+var xxx_messageInfo_GatewayTrafficCaptureRecord proto.InternalMessageInfo
+
+func (m *GatewayTrafficCaptureRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _GtwGs_GetMQTTConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GatewayIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, req.(*GatewayIdentifiers))
+func (m *GatewayTrafficCaptureRecord) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _GtwGs_GetMQTTV2ConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GatewayIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo",
+func (m *GatewayTrafficCaptureRecord) GetDirection() GatewayTrafficCaptureRecord_Direction {
+	if m != nil {
+		return m.Direction
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, req.(*GatewayIdentifiers))
+	return GatewayTrafficCaptureRecord_UPSTREAM
+}
+
+func (m *GatewayTrafficCaptureRecord) GetRemoteAddr() string {
+	if m != nil {
+		return m.RemoteAddr
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _GtwGs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.GtwGs",
-	HandlerType: (*GtwGsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetConcentratorConfig",
-			Handler:    _GtwGs_GetConcentratorConfig_Handler,
-		},
-		{
-			MethodName: "GetMQTTConnectionInfo",
-			Handler:    _GtwGs_GetMQTTConnectionInfo_Handler,
-		},
-		{
-			MethodName: "GetMQTTV2ConnectionInfo",
-			Handler:    _GtwGs_GetMQTTV2ConnectionInfo_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "LinkGateway",
-			Handler:       _GtwGs_LinkGateway_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "lorawan-stack/api/gatewayserver.proto",
+func (m *GatewayTrafficCaptureRecord) GetRaw() []byte {
+	if m != nil {
+		return m.Raw
+	}
+	return nil
 }
 
-// NsGsClient is the client API for NsGs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NsGsClient interface {
-	// Instructs the Gateway Server to schedule a downlink message.
-	// The Gateway Server may refuse if there are any conflicts in the schedule or
-	// if a duty cycle prevents the gateway from transmitting.
-	ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error)
+func (m *GatewayTrafficCaptureRecord) GetSchedulingAttempts() []*GatewayTrafficCaptureRecord_SchedulingAttempt {
+	if m != nil {
+		return m.SchedulingAttempts
+	}
+	return nil
 }
 
-type nsGsClient struct {
-	cc *grpc.ClientConn
+func (m *GatewayTrafficCaptureRecord) GetCorrelationIDs() []string {
+	if m != nil {
+		return m.CorrelationIDs
+	}
+	return nil
 }
 
-func NewNsGsClient(cc *grpc.ClientConn) NsGsClient {
-	return &nsGsClient{cc}
+type GatewayTrafficCaptureRecord_SchedulingAttempt struct {
+	// Receive window of the attempt; 0 for absolute time and anytime scheduling.
+	RxWindow      uint32        `protobuf:"varint,1,opt,name=rx_window,json=rxWindow,proto3" json:"rx_window,omitempty"`
+	Frequency     uint64        `protobuf:"varint,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	DataRateIndex DataRateIndex `protobuf:"varint,3,opt,name=data_rate_index,json=dataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"data_rate_index,omitempty"`
+	// Concentrator time at which the emission starts, if the downlink message is scheduled.
+	Starts int64 `protobuf:"varint,4,opt,name=starts,proto3" json:"starts,omitempty"`
+	// Time on air of the emission, if the downlink message is scheduled.
+	Duration time.Duration `protobuf:"bytes,5,opt,name=duration,proto3,stdduration" json:"duration"`
+	// Error that caused the attempt to fail, if any.
+	Error *ErrorDetails `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// Trace of the scheduler, if available.
+	Trace                *DownlinkSchedulingTrace `protobuf:"bytes,7,opt,name=trace,proto3" json:"trace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (c *nsGsClient) ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error) {
-	out := new(ScheduleDownlinkResponse)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsGs/ScheduleDownlink", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) Reset() {
+	*m = GatewayTrafficCaptureRecord_SchedulingAttempt{}
+}
+func (*GatewayTrafficCaptureRecord_SchedulingAttempt) ProtoMessage() {}
+func (*GatewayTrafficCaptureRecord_SchedulingAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{7, 0}
+}
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayTrafficCaptureRecord_SchedulingAttempt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// NsGsServer is the server API for NsGs service.
-type NsGsServer interface {
-	// Instructs the Gateway Server to schedule a downlink message.
-	// The Gateway Server may refuse if there are any conflicts in the schedule or
-	// if a duty cycle prevents the gateway from transmitting.
-	ScheduleDownlink(context.Context, *DownlinkMessage) (*ScheduleDownlinkResponse, error)
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayTrafficCaptureRecord_SchedulingAttempt.Merge(m, src)
 }
-
-// UnimplementedNsGsServer can be embedded to have forward compatible implementations.
-type UnimplementedNsGsServer struct {
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayTrafficCaptureRecord_SchedulingAttempt.DiscardUnknown(m)
 }
 
-func (*UnimplementedNsGsServer) ScheduleDownlink(ctx context.Context, req *DownlinkMessage) (*ScheduleDownlinkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDownlink not implemented")
+var xxx_messageInfo_GatewayTrafficCaptureRecord_SchedulingAttempt proto.InternalMessageInfo
+
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) GetRxWindow() uint32 {
+	if m != nil {
+		return m.RxWindow
+	}
+	return 0
 }
 
-func RegisterNsGsServer(s *grpc.Server, srv NsGsServer) {
-	s.RegisterService(&_NsGs_serviceDesc, srv)
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) GetFrequency() uint64 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
 }
 
-func _NsGs_ScheduleDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownlinkMessage)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) GetDataRateIndex() DataRateIndex {
+	if m != nil {
+		return m.DataRateIndex
 	}
-	if interceptor == nil {
-		return srv.(NsGsServer).ScheduleDownlink(ctx, in)
+	return DATA_RATE_0
+}
+
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) GetStarts() int64 {
+	if m != nil {
+		return m.Starts
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.NsGs/ScheduleDownlink",
+	return 0
+}
+
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsGsServer).ScheduleDownlink(ctx, req.(*DownlinkMessage))
+	return 0
+}
+
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _NsGs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.NsGs",
-	HandlerType: (*NsGsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ScheduleDownlink",
-			Handler:    _NsGs_ScheduleDownlink_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/gatewayserver.proto",
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) GetTrace() *DownlinkSchedulingTrace {
+	if m != nil {
+		return m.Trace
+	}
+	return nil
 }
 
-// GsClient is the client API for Gs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GsClient interface {
-	// Get statistics about the current gateway connection to the Gateway Server.
-	// This is not persisted between reconnects.
-	GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error)
-}
-
-type gsClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewGsClient(cc *grpc.ClientConn) GsClient {
-	return &gsClient{cc}
-}
-
-func (c *gsClient) GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error) {
-	out := new(GatewayConnectionStats)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.GatewayTrafficCaptureRecord_Direction", GatewayTrafficCaptureRecord_Direction_name, GatewayTrafficCaptureRecord_Direction_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.GatewayTrafficCaptureRecord_Direction", GatewayTrafficCaptureRecord_Direction_name, GatewayTrafficCaptureRecord_Direction_value)
+	proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
+	golang_proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
+	proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
+	golang_proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
+	proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
+	golang_proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
+	proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
+	golang_proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
+	proto.RegisterType((*DownlinkSchedulingTrace)(nil), "ttn.lorawan.v3.DownlinkSchedulingTrace")
+	golang_proto.RegisterType((*DownlinkSchedulingTrace)(nil), "ttn.lorawan.v3.DownlinkSchedulingTrace")
+	proto.RegisterType((*DownlinkSchedulingTrace_Emission)(nil), "ttn.lorawan.v3.DownlinkSchedulingTrace.Emission")
+	golang_proto.RegisterType((*DownlinkSchedulingTrace_Emission)(nil), "ttn.lorawan.v3.DownlinkSchedulingTrace.Emission")
+	proto.RegisterType((*RunGatewayRemoteCommandRequest)(nil), "ttn.lorawan.v3.RunGatewayRemoteCommandRequest")
+	golang_proto.RegisterType((*RunGatewayRemoteCommandRequest)(nil), "ttn.lorawan.v3.RunGatewayRemoteCommandRequest")
+	proto.RegisterType((*CaptureGatewayTrafficRequest)(nil), "ttn.lorawan.v3.CaptureGatewayTrafficRequest")
+	golang_proto.RegisterType((*CaptureGatewayTrafficRequest)(nil), "ttn.lorawan.v3.CaptureGatewayTrafficRequest")
+	proto.RegisterType((*GatewayTrafficCaptureRecord)(nil), "ttn.lorawan.v3.GatewayTrafficCaptureRecord")
+	golang_proto.RegisterType((*GatewayTrafficCaptureRecord)(nil), "ttn.lorawan.v3.GatewayTrafficCaptureRecord")
+	proto.RegisterType((*GatewayTrafficCaptureRecord_SchedulingAttempt)(nil), "ttn.lorawan.v3.GatewayTrafficCaptureRecord.SchedulingAttempt")
+	golang_proto.RegisterType((*GatewayTrafficCaptureRecord_SchedulingAttempt)(nil), "ttn.lorawan.v3.GatewayTrafficCaptureRecord.SchedulingAttempt")
 }
 
-// GsServer is the server API for Gs service.
-type GsServer interface {
-	// Get statistics about the current gateway connection to the Gateway Server.
-	// This is not persisted between reconnects.
-	GetGatewayConnectionStats(context.Context, *GatewayIdentifiers) (*GatewayConnectionStats, error)
+func init() {
+	proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
 }
-
-// UnimplementedGsServer can be embedded to have forward compatible implementations.
-type UnimplementedGsServer struct {
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
 }
 
-func (*UnimplementedGsServer) GetGatewayConnectionStats(ctx context.Context, req *GatewayIdentifiers) (*GatewayConnectionStats, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionStats not implemented")
+var fileDescriptor_62b07a36420f2d6d = []byte{
+	// 1615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6c, 0x23, 0xb7,
+	0x15, 0x1e, 0x5a, 0x96, 0x2d, 0xd1, 0x5e, 0x5b, 0x66, 0x91, 0x58, 0x2b, 0x3b, 0x23, 0x63, 0xfa,
+	0xe7, 0xba, 0xd1, 0xc8, 0xd0, 0xb6, 0x45, 0x9a, 0x62, 0x91, 0x5a, 0x96, 0x6b, 0xb8, 0x8d, 0x37,
+	0xed, 0x58, 0x6e, 0x90, 0x02, 0x81, 0x40, 0xcf, 0x50, 0xe3, 0x81, 0x25, 0x52, 0x21, 0x29, 0xcb,
+	0x46, 0x51, 0x60, 0xd1, 0x53, 0xd0, 0xd3, 0xa2, 0x3d, 0x34, 0x40, 0x2f, 0x45, 0x81, 0x02, 0x41,
+	0x0e, 0x45, 0x6e, 0xdd, 0x63, 0x2e, 0x05, 0xf6, 0xd0, 0x83, 0x81, 0x5e, 0x72, 0x72, 0x22, 0xa9,
+	0x87, 0x3d, 0xee, 0x71, 0xe1, 0x53, 0x31, 0x9c, 0x19, 0x49, 0x96, 0x2c, 0xdb, 0xbb, 0xe8, 0xde,
+	0x86, 0xe4, 0xf7, 0x3e, 0x3e, 0xbe, 0xf7, 0xf1, 0xf1, 0x0d, 0xfc, 0x76, 0x8d, 0x71, 0xdc, 0xc2,
+	0x34, 0x27, 0x24, 0xb6, 0x8f, 0xf2, 0xb8, 0xe1, 0xe5, 0x5d, 0x2c, 0x49, 0x0b, 0x9f, 0x0a, 0xc2,
+	0x8f, 0x09, 0x37, 0x1b, 0x9c, 0x49, 0x86, 0xe6, 0xa4, 0xa4, 0x66, 0x08, 0x35, 0x8f, 0xef, 0x65,
+	0x36, 0x5c, 0x4f, 0x1e, 0x36, 0x0f, 0x4c, 0x9b, 0xd5, 0xf3, 0x84, 0x1e, 0xb3, 0xd3, 0x06, 0x67,
+	0x27, 0xa7, 0x79, 0x05, 0xb6, 0x73, 0x2e, 0xa1, 0xb9, 0x63, 0x5c, 0xf3, 0x1c, 0x2c, 0x49, 0x7e,
+	0xe4, 0x23, 0xa0, 0xcc, 0xe4, 0x06, 0x28, 0x5c, 0xe6, 0xb2, 0xc0, 0xf8, 0xa0, 0x59, 0x55, 0x23,
+	0x35, 0x50, 0x5f, 0x21, 0x7c, 0xd9, 0x65, 0xcc, 0xad, 0x11, 0xe5, 0x21, 0xa6, 0x94, 0x49, 0x2c,
+	0x3d, 0x46, 0x45, 0xb8, 0xaa, 0x87, 0xab, 0x3d, 0x0e, 0xa7, 0xc9, 0x15, 0x20, 0x5c, 0x5f, 0x1a,
+	0x5e, 0x27, 0xf5, 0x86, 0x3c, 0x0d, 0x17, 0xb3, 0xc3, 0x8b, 0xd2, 0xab, 0x13, 0x21, 0x71, 0xbd,
+	0x11, 0x02, 0xde, 0x18, 0x0d, 0x12, 0xe1, 0x9c, 0xf1, 0xc8, 0x7e, 0x6c, 0x0c, 0x43, 0xc0, 0x37,
+	0x47, 0x01, 0x9e, 0x43, 0xa8, 0xf4, 0xaa, 0x1e, 0xe1, 0x62, 0x3c, 0x4b, 0x14, 0xf0, 0x00, 0xb0,
+	0x32, 0x0a, 0xa8, 0x13, 0x21, 0xb0, 0x4b, 0x22, 0x8a, 0xe5, 0x2b, 0x10, 0x1f, 0x49, 0x39, 0xde,
+	0x9e, 0x13, 0xd7, 0x63, 0x14, 0xd7, 0x02, 0x84, 0xf1, 0x14, 0xc0, 0xe4, 0x76, 0xe0, 0xf9, 0x7e,
+	0x03, 0xfd, 0x0c, 0xce, 0x37, 0x1b, 0x35, 0x8f, 0x1e, 0x55, 0xa2, 0x6d, 0xd2, 0x60, 0x25, 0xb6,
+	0x3a, 0x53, 0x78, 0xc3, 0xbc, 0xac, 0x06, 0x73, 0x5f, 0xc1, 0x76, 0x03, 0x94, 0x35, 0xd7, 0x1c,
+	0x1c, 0x0a, 0x54, 0x82, 0x73, 0x61, 0x38, 0x2a, 0x42, 0x62, 0xd9, 0x14, 0xe9, 0x89, 0x15, 0x70,
+	0x15, 0x4d, 0xb8, 0xf5, 0x9e, 0x02, 0x59, 0x77, 0xdc, 0xc1, 0x21, 0xda, 0x85, 0x0b, 0xf2, 0xa4,
+	0x82, 0xed, 0x23, 0xca, 0x5a, 0x35, 0xe2, 0xb8, 0x75, 0x42, 0x65, 0x3a, 0xa6, 0x88, 0x56, 0x86,
+	0x89, 0xca, 0x27, 0x1b, 0x97, 0x70, 0x56, 0x4a, 0x0e, 0xcd, 0x18, 0x1f, 0xc0, 0x99, 0x70, 0xbb,
+	0x12, 0x6b, 0x51, 0xf4, 0x73, 0x98, 0x72, 0x58, 0x8b, 0x0e, 0x9e, 0x36, 0x0d, 0x14, 0x79, 0x76,
+	0x98, 0xbc, 0x14, 0xe2, 0xa2, 0xe3, 0xce, 0x3b, 0x97, 0x27, 0x8c, 0x0f, 0x61, 0x7a, 0xcf, 0x3e,
+	0x24, 0x4e, 0xb3, 0x46, 0x22, 0xac, 0x45, 0x44, 0x83, 0x51, 0x41, 0xd0, 0x06, 0x8c, 0x3b, 0xa4,
+	0x86, 0x4f, 0x43, 0xf2, 0xbb, 0x66, 0x20, 0x3d, 0x33, 0x92, 0x9e, 0x59, 0x0a, 0x75, 0x5b, 0x4c,
+	0x5d, 0x14, 0xe3, 0x9f, 0x81, 0x89, 0x04, 0x78, 0x72, 0x9e, 0xd5, 0x3e, 0xf9, 0x2a, 0x0b, 0xac,
+	0xc0, 0xd2, 0x78, 0x0c, 0xe0, 0xf2, 0x30, 0xff, 0x96, 0xaf, 0xc6, 0x12, 0x91, 0xd8, 0xab, 0x09,
+	0x74, 0x1f, 0xce, 0x34, 0xb0, 0x3c, 0xac, 0x28, 0x89, 0x46, 0x39, 0x5b, 0x1e, 0x3e, 0xc6, 0xa0,
+	0x89, 0x05, 0x7d, 0x03, 0x35, 0x23, 0xd0, 0x07, 0x30, 0x81, 0xa5, 0xf4, 0xef, 0x87, 0x9f, 0x28,
+	0xdf, 0xf6, 0xfe, 0x98, 0x44, 0x95, 0x39, 0xae, 0x56, 0x3d, 0x7b, 0x13, 0x37, 0x64, 0x93, 0x13,
+	0x8b, 0xd8, 0x8c, 0x3b, 0x66, 0xe8, 0x9a, 0x47, 0xdd, 0x8d, 0x80, 0xc5, 0xea, 0xd1, 0x19, 0x67,
+	0x93, 0x70, 0x31, 0x72, 0xb9, 0x8f, 0x2b, 0x73, 0x6c, 0x13, 0xf4, 0x2e, 0x4c, 0x90, 0xba, 0x27,
+	0x84, 0xc7, 0x68, 0x18, 0x9c, 0xf5, 0x71, 0x91, 0x1f, 0x32, 0x35, 0xb7, 0x42, 0x3b, 0xab, 0xc7,
+	0x80, 0x76, 0x60, 0x42, 0x34, 0x0f, 0x2a, 0x07, 0x98, 0x3a, 0xa1, 0xda, 0xcc, 0x31, 0x87, 0xd8,
+	0x64, 0x94, 0x12, 0xdb, 0x0f, 0xb9, 0x2f, 0x34, 0x61, 0xee, 0x35, 0x0f, 0x8a, 0x98, 0x3a, 0xd6,
+	0xb4, 0x08, 0x3e, 0x7c, 0xc7, 0x6c, 0x46, 0xab, 0x35, 0xcf, 0x8e, 0xf4, 0xf6, 0x12, 0x8e, 0x45,
+	0x0c, 0xe8, 0x3d, 0xb8, 0x50, 0xf7, 0x68, 0x45, 0x84, 0x09, 0xac, 0xf8, 0xa5, 0x26, 0x3d, 0x79,
+	0x93, 0x18, 0x12, 0x3d, 0x11, 0xcc, 0xd7, 0x3d, 0x1a, 0x65, 0xbf, 0xec, 0xd5, 0x09, 0xfa, 0x05,
+	0x84, 0x75, 0xe2, 0x78, 0x98, 0x56, 0xb8, 0x94, 0xe9, 0xf8, 0x4d, 0x4c, 0x0b, 0x9d, 0xf3, 0x6c,
+	0x72, 0x57, 0x19, 0x58, 0xe5, 0xb2, 0xa2, 0x4c, 0x06, 0xf6, 0x96, 0x94, 0x3e, 0x19, 0x97, 0xb2,
+	0x52, 0xc7, 0xdc, 0xf5, 0x68, 0x7a, 0xea, 0x56, 0x64, 0x56, 0xb9, 0xbc, 0xab, 0xf0, 0x01, 0x19,
+	0x97, 0x32, 0x18, 0x66, 0x6c, 0x98, 0x88, 0x02, 0x80, 0x5e, 0x87, 0x53, 0x42, 0x62, 0x2e, 0x85,
+	0xca, 0x6d, 0xcc, 0x0a, 0x47, 0xe8, 0x1d, 0x98, 0x88, 0x2a, 0x75, 0x7a, 0xe2, 0xa6, 0xed, 0xfa,
+	0x51, 0xe8, 0x19, 0x19, 0xff, 0x06, 0x50, 0xb7, 0x9a, 0x34, 0x4c, 0xa6, 0x45, 0xea, 0x4c, 0x92,
+	0x4d, 0x56, 0xaf, 0xfb, 0x29, 0x24, 0x1f, 0x35, 0x89, 0x90, 0x68, 0x1f, 0xce, 0x44, 0xf5, 0xc7,
+	0x73, 0x44, 0x28, 0x2e, 0x63, 0x8c, 0x1c, 0x76, 0xfa, 0x75, 0x59, 0x5d, 0xc1, 0x3f, 0x80, 0x89,
+	0x94, 0xba, 0x82, 0x67, 0xe7, 0x59, 0x60, 0x41, 0x37, 0x42, 0x09, 0xf4, 0x2d, 0x38, 0x6d, 0x07,
+	0x1b, 0x29, 0xcf, 0x93, 0x45, 0x78, 0x51, 0x9c, 0xe6, 0xf1, 0x14, 0x48, 0x3f, 0x4c, 0x58, 0xd1,
+	0x12, 0xca, 0xc1, 0x24, 0xe6, 0x6e, 0xd3, 0xaf, 0x39, 0x22, 0x1d, 0x5b, 0x89, 0xad, 0x26, 0x8b,
+	0xf3, 0x17, 0xc5, 0xd9, 0x3f, 0x82, 0x64, 0xea, 0xa7, 0x46, 0x9c, 0xc7, 0x7c, 0x70, 0x1f, 0x61,
+	0xfc, 0x0b, 0xc0, 0xe5, 0xf0, 0x3e, 0x5d, 0xbe, 0x64, 0xaf, 0xf8, 0x30, 0x0f, 0x5e, 0x24, 0x0f,
+	0x8b, 0x17, 0xc5, 0xd9, 0xcf, 0x40, 0x32, 0x01, 0x8c, 0x58, 0xe2, 0xd1, 0xf2, 0x9a, 0x76, 0x45,
+	0x5a, 0xfe, 0x39, 0x05, 0x97, 0xae, 0xa9, 0x12, 0xe8, 0x2d, 0x38, 0xa9, 0x94, 0x1f, 0xf8, 0x9f,
+	0x19, 0xd9, 0xab, 0x1c, 0xbd, 0xc0, 0x41, 0xd2, 0x1f, 0xf9, 0xec, 0xca, 0x02, 0x65, 0x60, 0x42,
+	0xa1, 0x6c, 0x56, 0x0b, 0xe2, 0x6e, 0xf5, 0xc6, 0x68, 0x0f, 0x26, 0x1d, 0x8f, 0x07, 0xb7, 0x59,
+	0xdd, 0xd5, 0xb9, 0xc2, 0x0f, 0x5f, 0xa4, 0x76, 0x95, 0x22, 0x63, 0xab, 0xcf, 0x83, 0xb2, 0x70,
+	0x86, 0x2b, 0x59, 0x55, 0xb0, 0xe3, 0x70, 0x75, 0x57, 0x93, 0x16, 0x0c, 0xa6, 0x36, 0x1c, 0x87,
+	0xa3, 0x14, 0x8c, 0x71, 0xdc, 0x52, 0x57, 0x6f, 0xd6, 0xf2, 0x3f, 0x11, 0x85, 0xdf, 0x10, 0xbd,
+	0x52, 0x50, 0xe9, 0x55, 0xd3, 0xa9, 0xff, 0x47, 0x35, 0x45, 0x62, 0x78, 0x4a, 0xa0, 0x9f, 0xc0,
+	0x79, 0x9b, 0x71, 0x4e, 0x6a, 0x2a, 0xf8, 0x4a, 0x18, 0xd3, 0x4a, 0x6a, 0xa8, 0x73, 0x9e, 0x9d,
+	0xdb, 0xec, 0x2f, 0xed, 0x94, 0x84, 0x35, 0x37, 0x00, 0xdd, 0x71, 0x44, 0xa6, 0x3d, 0x01, 0x17,
+	0x46, 0xb6, 0x41, 0x4b, 0x30, 0xc9, 0x4f, 0x2a, 0x2d, 0x8f, 0x3a, 0xac, 0xa5, 0xb2, 0x74, 0xc7,
+	0x4a, 0xf0, 0x93, 0xf7, 0xd5, 0x18, 0x2d, 0xc3, 0x64, 0x95, 0xfb, 0x82, 0xa4, 0xf6, 0xa9, 0x4a,
+	0xc2, 0xa4, 0xd5, 0x9f, 0x40, 0x5b, 0x70, 0xde, 0xc1, 0x12, 0x57, 0x38, 0x96, 0xa4, 0xe2, 0x51,
+	0x87, 0x9c, 0x84, 0xb9, 0x18, 0x79, 0xf0, 0x4b, 0x58, 0x62, 0x0b, 0x4b, 0xb2, 0xe3, 0x83, 0xac,
+	0x3b, 0xce, 0xe0, 0x70, 0xa0, 0x64, 0x4c, 0x8e, 0x2d, 0x19, 0xf1, 0x97, 0x28, 0x19, 0xa8, 0x00,
+	0xe3, 0xea, 0x69, 0x0c, 0xeb, 0xdb, 0xf5, 0x2f, 0x63, 0x00, 0x45, 0xf7, 0x61, 0x5c, 0xfa, 0x25,
+	0x3d, 0x3d, 0xad, 0x6c, 0xbe, 0x7b, 0xcb, 0x17, 0xc0, 0x0a, 0xac, 0x8c, 0xef, 0xc1, 0x64, 0x4f,
+	0x5b, 0x68, 0x16, 0x26, 0xf6, 0x7f, 0xb9, 0x57, 0xb6, 0xb6, 0x36, 0x76, 0x53, 0x1a, 0x9a, 0x83,
+	0xb0, 0xf4, 0xde, 0xfb, 0x0f, 0xc2, 0x31, 0x28, 0x7c, 0x15, 0x83, 0xf1, 0x6d, 0xd9, 0xda, 0x16,
+	0x68, 0x07, 0xce, 0xbc, 0xeb, 0xd1, 0xa3, 0x50, 0x1e, 0xe8, 0xee, 0x18, 0xdd, 0xec, 0x37, 0x32,
+	0x4b, 0x63, 0x96, 0x7c, 0xaf, 0x56, 0xc1, 0x3a, 0x40, 0x7b, 0xf0, 0xb5, 0x6d, 0x22, 0x37, 0x19,
+	0xb5, 0x09, 0x95, 0x1c, 0x4b, 0xc6, 0x37, 0x19, 0xad, 0x7a, 0x2e, 0x7a, 0x7d, 0x24, 0x74, 0x5b,
+	0x7e, 0x63, 0x9c, 0x19, 0xa9, 0x28, 0x57, 0xd8, 0xfe, 0x19, 0x28, 0xd6, 0xdd, 0x5f, 0x95, 0xcb,
+	0xfd, 0x47, 0x74, 0x87, 0x56, 0x19, 0xba, 0x45, 0x3d, 0x1a, 0xdd, 0x61, 0x94, 0xc7, 0xf8, 0xd1,
+	0xef, 0xff, 0xf3, 0xdf, 0x3f, 0x4d, 0xac, 0x23, 0x33, 0xef, 0x8a, 0xde, 0x6f, 0x49, 0xfe, 0xb7,
+	0xfd, 0x02, 0xf8, 0x3b, 0xd5, 0xdf, 0xe6, 0xec, 0x9e, 0x59, 0xce, 0xf3, 0xf7, 0xff, 0x0b, 0x80,
+	0x8b, 0xa1, 0x67, 0xbf, 0x2e, 0xbc, 0x22, 0xdf, 0xde, 0x52, 0xbe, 0x15, 0xd0, 0xfa, 0xf5, 0xbe,
+	0x1d, 0x17, 0x86, 0xbd, 0x2b, 0x10, 0x38, 0xf9, 0x40, 0x6c, 0x0b, 0xf4, 0x21, 0x4c, 0x0d, 0xf7,
+	0x71, 0xe8, 0xa6, 0x6e, 0x33, 0xb3, 0x3a, 0x0c, 0x18, 0xd7, 0x6a, 0x16, 0x9e, 0xc7, 0xe0, 0xc4,
+	0xb6, 0xf0, 0x63, 0x71, 0x77, 0x9b, 0xc8, 0xab, 0xbb, 0x9d, 0x5b, 0x45, 0xe3, 0x3b, 0xb7, 0xeb,
+	0x9c, 0x8c, 0x82, 0x8a, 0xc8, 0x9b, 0x68, 0x6d, 0x7c, 0x44, 0xfa, 0xa1, 0xc8, 0x0b, 0xb5, 0xff,
+	0x3f, 0x00, 0x7c, 0xed, 0xca, 0xf7, 0x0e, 0xbd, 0x39, 0xa2, 0xc0, 0x6b, 0x9e, 0xc5, 0xcc, 0xf7,
+	0x5f, 0xa0, 0xa8, 0x46, 0xa9, 0x33, 0x72, 0xe3, 0x1c, 0x15, 0xe6, 0x25, 0xa7, 0x03, 0xe3, 0xb7,
+	0xc1, 0xda, 0x3a, 0x40, 0x7f, 0x03, 0x70, 0x71, 0x4c, 0xbf, 0x81, 0x46, 0x5a, 0xcc, 0xeb, 0x1b,
+	0x93, 0xcc, 0x98, 0xcb, 0x67, 0xbc, 0xa3, 0xfc, 0xfb, 0xb1, 0xf1, 0x83, 0xdb, 0xf9, 0x17, 0x3c,
+	0x45, 0xb9, 0xb0, 0xe3, 0x78, 0x1b, 0xac, 0x15, 0xff, 0x0e, 0x9e, 0xb4, 0x75, 0x70, 0xd6, 0xd6,
+	0xc1, 0x97, 0x6d, 0x5d, 0xfb, 0xba, 0xad, 0x6b, 0x4f, 0xdb, 0xba, 0xf6, 0xac, 0xad, 0x6b, 0xcf,
+	0xdb, 0x3a, 0x78, 0xd8, 0xd1, 0xc1, 0xc7, 0x1d, 0x5d, 0xfb, 0xb4, 0xa3, 0x83, 0xcf, 0x3b, 0xba,
+	0xf6, 0xb8, 0xa3, 0x6b, 0x5f, 0x74, 0x74, 0xed, 0x49, 0x47, 0x07, 0x67, 0x1d, 0x1d, 0x7c, 0xd9,
+	0xd1, 0xb5, 0xaf, 0x3b, 0x3a, 0x78, 0xda, 0xd1, 0xb5, 0x67, 0x1d, 0x1d, 0x3c, 0xef, 0xe8, 0xda,
+	0xc3, 0xae, 0xae, 0x7d, 0xdc, 0xd5, 0xc1, 0xa3, 0xae, 0xae, 0x7d, 0xd2, 0xd5, 0xc1, 0x5f, 0xbb,
+	0xba, 0xf6, 0x69, 0x57, 0xd7, 0x3e, 0xef, 0xea, 0xe0, 0x71, 0x57, 0x07, 0x5f, 0x74, 0x75, 0xf0,
+	0x9b, 0xbc, 0xcb, 0x4c, 0x79, 0x48, 0xe4, 0xa1, 0x47, 0x5d, 0x61, 0x52, 0x22, 0x5b, 0x8c, 0x1f,
+	0xe5, 0x2f, 0xff, 0x75, 0x1e, 0xdf, 0xcb, 0x37, 0x8e, 0xdc, 0xbc, 0x94, 0xb4, 0x71, 0x70, 0x30,
+	0xa5, 0x0e, 0x7e, 0xef, 0x7f, 0x03, 0x00, 0x16, 0x14, 0x12, 0xe4, 0x85, 0x10, 0x00, 0x00,
 }
 
-func RegisterGsServer(s *grpc.Server, srv GsServer) {
-	s.RegisterService(&_Gs_serviceDesc, srv)
+func (x GatewayTrafficCaptureRecord_Direction) String() string {
+	s, ok := GatewayTrafficCaptureRecord_Direction_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
 }
+func (this *GatewayUp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Gs_GetGatewayConnectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GatewayIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*GatewayUp)
+	if !ok {
+		that2, ok := that.(GatewayUp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(GsServer).GetGatewayConnectionStats(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats",
+	if len(this.UplinkMessages) != len(that1.UplinkMessages) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GsServer).GetGatewayConnectionStats(ctx, req.(*GatewayIdentifiers))
+	for i := range this.UplinkMessages {
+		if !this.UplinkMessages[i].Equal(that1.UplinkMessages[i]) {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Gs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.Gs",
-	HandlerType: (*GsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetGatewayConnectionStats",
-			Handler:    _Gs_GetGatewayConnectionStats_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/gatewayserver.proto",
+	if !this.GatewayStatus.Equal(that1.GatewayStatus) {
+		return false
+	}
+	if !this.TxAcknowledgment.Equal(that1.TxAcknowledgment) {
+		return false
+	}
+	return true
 }
+func (this *GatewayDown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GatewayUp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*GatewayDown)
+	if !ok {
+		that2, ok := that.(GatewayDown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DownlinkMessage.Equal(that1.DownlinkMessage) {
+		return false
+	}
+	return true
 }
+func (this *ScheduleDownlinkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GatewayUp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ScheduleDownlinkResponse)
+	if !ok {
+		that2, ok := that.(ScheduleDownlinkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Delay != that1.Delay {
+		return false
+	}
+	return true
 }
+func (this *ScheduleDownlinkErrorDetails) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GatewayUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxAcknowledgment != nil {
-		{
-			size, err := m.TxAcknowledgment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	that1, ok := that.(*ScheduleDownlinkErrorDetails)
+	if !ok {
+		that2, ok := that.(ScheduleDownlinkErrorDetails)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.GatewayStatus != nil {
-		{
-			size, err := m.GatewayStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.UplinkMessages) > 0 {
-		for iNdEx := len(m.UplinkMessages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UplinkMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if len(this.PathErrors) != len(that1.PathErrors) {
+		return false
+	}
+	for i := range this.PathErrors {
+		if !this.PathErrors[i].Equal(that1.PathErrors[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GatewayDown) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.Attempts) != len(that1.Attempts) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GatewayDown) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GatewayDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DownlinkMessage != nil {
-		{
-			size, err := m.DownlinkMessage.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	for i := range this.Attempts {
+		if !this.Attempts[i].Equal(that1.Attempts[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *DownlinkSchedulingTrace) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ScheduleDownlinkResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DownlinkSchedulingTrace)
+	if !ok {
+		that2, ok := that.(DownlinkSchedulingTrace)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ScheduleDownlinkResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ScheduleDownlinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay):])
-	if err4 != nil {
-		return 0, err4
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i -= n4
-	i = encodeVarintGatewayserver(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ScheduleDownlinkErrorDetails) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Emission.Equal(that1.Emission) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ScheduleDownlinkErrorDetails) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ScheduleDownlinkErrorDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PathErrors) > 0 {
-		for iNdEx := len(m.PathErrors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PathErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if !this.SubBand.Equal(that1.SubBand) {
+		return false
+	}
+	if !this.Conflict.Equal(that1.Conflict) {
+		return false
+	}
+	if this.MinScheduleTime != that1.MinScheduleTime {
+		return false
+	}
+	if this.MedianRTT != nil && that1.MedianRTT != nil {
+		if *this.MedianRTT != *that1.MedianRTT {
+			return false
 		}
+	} else if this.MedianRTT != nil {
+		return false
+	} else if that1.MedianRTT != nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGatewayserver(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGatewayserver(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if this.RTTMargin != nil && that1.RTTMargin != nil {
+		if *this.RTTMargin != *that1.RTTMargin {
+			return false
+		}
+	} else if this.RTTMargin != nil {
+		return false
+	} else if that1.RTTMargin != nil {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return true
 }
-func NewPopulatedGatewayUp(r randyGatewayserver, easy bool) *GatewayUp {
-	this := &GatewayUp{}
-	if r.Intn(5) != 0 {
-		v1 := r.Intn(5)
-		this.UplinkMessages = make([]*UplinkMessage, v1)
-		for i := 0; i < v1; i++ {
-			this.UplinkMessages[i] = NewPopulatedUplinkMessage(r, easy)
+func (this *DownlinkSchedulingTrace_Emission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DownlinkSchedulingTrace_Emission)
+	if !ok {
+		that2, ok := that.(DownlinkSchedulingTrace_Emission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if r.Intn(5) != 0 {
-		this.GatewayStatus = NewPopulatedGatewayStatus(r, easy)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		this.TxAcknowledgment = NewPopulatedTxAcknowledgment(r, easy)
+	if this.Starts != that1.Starts {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if this.Duration != that1.Duration {
+		return false
 	}
-	return this
+	return true
 }
+func (this *RunGatewayRemoteCommandRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedGatewayDown(r randyGatewayserver, easy bool) *GatewayDown {
-	this := &GatewayDown{}
-	if r.Intn(5) != 0 {
-		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
+	that1, ok := that.(*RunGatewayRemoteCommandRequest)
+	if !ok {
+		that2, ok := that.(RunGatewayRemoteCommandRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedScheduleDownlinkResponse(r randyGatewayserver, easy bool) *ScheduleDownlinkResponse {
-	this := &ScheduleDownlinkResponse{}
-	v2 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Delay = *v2
-	if !easy && r.Intn(10) != 0 {
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
 	}
-	return this
+	if this.Command != that1.Command {
+		return false
+	}
+	if len(this.Arguments) != len(that1.Arguments) {
+		return false
+	}
+	for i := range this.Arguments {
+		if this.Arguments[i] != that1.Arguments[i] {
+			return false
+		}
+	}
+	return true
 }
+func (this *CaptureGatewayTrafficRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedScheduleDownlinkErrorDetails(r randyGatewayserver, easy bool) *ScheduleDownlinkErrorDetails {
-	this := &ScheduleDownlinkErrorDetails{}
-	if r.Intn(5) == 0 {
-		v3 := r.Intn(5)
-		this.PathErrors = make([]*ErrorDetails, v3)
-		for i := 0; i < v3; i++ {
-			this.PathErrors[i] = NewPopulatedErrorDetails(r, easy)
+	that1, ok := that.(*CaptureGatewayTrafficRequest)
+	if !ok {
+		that2, ok := that.(CaptureGatewayTrafficRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-type randyGatewayserver interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
-}
-
-func randUTF8RuneGatewayserver(r randyGatewayserver) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
 	}
-	return rune(ru + 61)
-}
-func randStringGatewayserver(r randyGatewayserver) string {
-	v4 := r.Intn(100)
-	tmps := make([]rune, v4)
-	for i := 0; i < v4; i++ {
-		tmps[i] = randUTF8RuneGatewayserver(r)
+	if this.Duration != that1.Duration {
+		return false
 	}
-	return string(tmps)
+	return true
 }
-func randUnrecognizedGatewayserver(r randyGatewayserver, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
-		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldGatewayserver(dAtA, r, fieldNumber, wire)
+func (this *GatewayTrafficCaptureRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA
-}
-func randFieldGatewayserver(dAtA []byte, r randyGatewayserver, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		v5 := r.Int63()
-		if r.Intn(2) == 0 {
-			v5 *= -1
-		}
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(v5))
-	case 1:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
+
+	that1, ok := that.(*GatewayTrafficCaptureRecord)
+	if !ok {
+		that2, ok := that.(GatewayTrafficCaptureRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-	default:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
 	}
-	return dAtA
-}
-func encodeVarintPopulateGatewayserver(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
-}
-func (m *GatewayUp) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.Time.Equal(that1.Time) {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.UplinkMessages) > 0 {
-		for _, e := range m.UplinkMessages {
-			l = e.Size()
-			n += 1 + l + sovGatewayserver(uint64(l))
+	if this.Protocol != that1.Protocol {
+		return false
+	}
+	if this.Direction != that1.Direction {
+		return false
+	}
+	if this.RemoteAddr != that1.RemoteAddr {
+		return false
+	}
+	if !bytes.Equal(this.Raw, that1.Raw) {
+		return false
+	}
+	if len(this.SchedulingAttempts) != len(that1.SchedulingAttempts) {
+		return false
+	}
+	for i := range this.SchedulingAttempts {
+		if !this.SchedulingAttempts[i].Equal(that1.SchedulingAttempts[i]) {
+			return false
 		}
 	}
-	if m.GatewayStatus != nil {
-		l = m.GatewayStatus.Size()
-		n += 1 + l + sovGatewayserver(uint64(l))
+	if len(this.CorrelationIDs) != len(that1.CorrelationIDs) {
+		return false
 	}
-	if m.TxAcknowledgment != nil {
-		l = m.TxAcknowledgment.Size()
-		n += 1 + l + sovGatewayserver(uint64(l))
+	for i := range this.CorrelationIDs {
+		if this.CorrelationIDs[i] != that1.CorrelationIDs[i] {
+			return false
+		}
 	}
-	return n
+	return true
 }
 
-func (m *GatewayDown) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *GatewayTrafficCaptureRecord_SchedulingAttempt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.DownlinkMessage != nil {
-		l = m.DownlinkMessage.Size()
-		n += 1 + l + sovGatewayserver(uint64(l))
+
+	that1, ok := that.(*GatewayTrafficCaptureRecord_SchedulingAttempt)
+	if !ok {
+		that2, ok := that.(GatewayTrafficCaptureRecord_SchedulingAttempt)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RxWindow != that1.RxWindow {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.Starts != that1.Starts {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Trace.Equal(that1.Trace) {
+		return false
+	}
+	return true
 }
 
-func (m *ScheduleDownlinkResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
-	n += 1 + l + sovGatewayserver(uint64(l))
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GtwGsClient is the client API for GtwGs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GtwGsClient interface {
+	// Link a gateway to the Gateway Server for streaming upstream messages and downstream messages.
+	LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error)
+	// Get configuration for the concentrator.
+	GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error)
+	// Get connection information to connect an MQTT gateway.
+	GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+	// Get legacy connection information to connect a The Things Network Stack V2 MQTT gateway.
+	GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
 }
 
-func (m *ScheduleDownlinkErrorDetails) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PathErrors) > 0 {
-		for _, e := range m.PathErrors {
-			l = e.Size()
-			n += 1 + l + sovGatewayserver(uint64(l))
-		}
+type gtwGsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGtwGsClient(cc *grpc.ClientConn) GtwGsClient {
+	return &gtwGsClient{cc}
+}
+
+func (c *gtwGsClient) LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GtwGs_serviceDesc.Streams[0], "/ttn.lorawan.v3.GtwGs/LinkGateway", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	x := &gtwGsLinkGatewayClient{stream}
+	return x, nil
 }
 
-func sovGatewayserver(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+type GtwGs_LinkGatewayClient interface {
+	Send(*GatewayUp) error
+	Recv() (*GatewayDown, error)
+	grpc.ClientStream
 }
-func sozGatewayserver(x uint64) (n int) {
-	return sovGatewayserver((x << 1) ^ uint64((int64(x) >> 63)))
+
+type gtwGsLinkGatewayClient struct {
+	grpc.ClientStream
 }
-func (this *GatewayUp) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForUplinkMessages := "[]*UplinkMessage{"
-	for _, f := range this.UplinkMessages {
-		repeatedStringForUplinkMessages += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
-	}
-	repeatedStringForUplinkMessages += "}"
-	s := strings.Join([]string{`&GatewayUp{`,
-		`UplinkMessages:` + repeatedStringForUplinkMessages + `,`,
-		`GatewayStatus:` + strings.Replace(fmt.Sprintf("%v", this.GatewayStatus), "GatewayStatus", "GatewayStatus", 1) + `,`,
-		`TxAcknowledgment:` + strings.Replace(fmt.Sprintf("%v", this.TxAcknowledgment), "TxAcknowledgment", "TxAcknowledgment", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (x *gtwGsLinkGatewayClient) Send(m *GatewayUp) error {
+	return x.ClientStream.SendMsg(m)
 }
-func (this *GatewayDown) String() string {
-	if this == nil {
-		return "nil"
+
+func (x *gtwGsLinkGatewayClient) Recv() (*GatewayDown, error) {
+	m := new(GatewayDown)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GatewayDown{`,
-		`DownlinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkMessage), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return m, nil
 }
-func (this *ScheduleDownlinkResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *gtwGsClient) GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error) {
+	out := new(ConcentratorConfig)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ScheduleDownlinkResponse{`,
-		`Delay:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Delay), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *ScheduleDownlinkErrorDetails) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForPathErrors := "[]*ErrorDetails{"
-	for _, f := range this.PathErrors {
-		repeatedStringForPathErrors += strings.Replace(fmt.Sprintf("%v", f), "ErrorDetails", "ErrorDetails", 1) + ","
+
+func (c *gtwGsClient) GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
+	out := new(MQTTConnectionInfo)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForPathErrors += "}"
-	s := strings.Join([]string{`&ScheduleDownlinkErrorDetails{`,
-		`PathErrors:` + repeatedStringForPathErrors + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func valueToStringGatewayserver(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (c *gtwGsClient) GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
+	out := new(MQTTConnectionInfo)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return out, nil
 }
-func (m *GatewayUp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGatewayserver
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+// GtwGsServer is the server API for GtwGs service.
+type GtwGsServer interface {
+	// Link a gateway to the Gateway Server for streaming upstream messages and downstream messages.
+	LinkGateway(GtwGs_LinkGatewayServer) error
+	// Get configuration for the concentrator.
+	GetConcentratorConfig(context.Context, *types.Empty) (*ConcentratorConfig, error)
+	// Get connection information to connect an MQTT gateway.
+	GetMQTTConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
+	// Get legacy connection information to connect a The Things Network Stack V2 MQTT gateway.
+	GetMQTTV2ConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
+}
+
+// UnimplementedGtwGsServer can be embedded to have forward compatible implementations.
+type UnimplementedGtwGsServer struct {
+}
+
+func (*UnimplementedGtwGsServer) LinkGateway(srv GtwGs_LinkGatewayServer) error {
+	return status.Errorf(codes.Unimplemented, "method LinkGateway not implemented")
+}
+func (*UnimplementedGtwGsServer) GetConcentratorConfig(ctx context.Context, req *types.Empty) (*ConcentratorConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConcentratorConfig not implemented")
+}
+func (*UnimplementedGtwGsServer) GetMQTTConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTConnectionInfo not implemented")
+}
+func (*UnimplementedGtwGsServer) GetMQTTV2ConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTV2ConnectionInfo not implemented")
+}
+
+func RegisterGtwGsServer(s *grpc.Server, srv GtwGsServer) {
+	s.RegisterService(&_GtwGs_serviceDesc, srv)
+}
+
+func _GtwGs_LinkGateway_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GtwGsServer).LinkGateway(&gtwGsLinkGatewayServer{stream})
+}
+
+type GtwGs_LinkGatewayServer interface {
+	Send(*GatewayDown) error
+	Recv() (*GatewayUp, error)
+	grpc.ServerStream
+}
+
+type gtwGsLinkGatewayServer struct {
+	grpc.ServerStream
+}
+
+func (x *gtwGsLinkGatewayServer) Send(m *GatewayDown) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gtwGsLinkGatewayServer) Recv() (*GatewayUp, error) {
+	m := new(GatewayUp)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GtwGs_GetConcentratorConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GtwGsServer).GetConcentratorConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GtwGsServer).GetConcentratorConfig(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GtwGs_GetMQTTConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GtwGs_GetMQTTV2ConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GtwGs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.GtwGs",
+	HandlerType: (*GtwGsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetConcentratorConfig",
+			Handler:    _GtwGs_GetConcentratorConfig_Handler,
+		},
+		{
+			MethodName: "GetMQTTConnectionInfo",
+			Handler:    _GtwGs_GetMQTTConnectionInfo_Handler,
+		},
+		{
+			MethodName: "GetMQTTV2ConnectionInfo",
+			Handler:    _GtwGs_GetMQTTV2ConnectionInfo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "LinkGateway",
+			Handler:       _GtwGs_LinkGateway_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "lorawan-stack/api/gatewayserver.proto",
+}
+
+// NsGsClient is the client API for NsGs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NsGsClient interface {
+	// Instructs the Gateway Server to schedule a downlink message.
+	// The Gateway Server may refuse if there are any conflicts in the schedule or
+	// if a duty cycle prevents the gateway from transmitting.
+	ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error)
+}
+
+type nsGsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNsGsClient(cc *grpc.ClientConn) NsGsClient {
+	return &nsGsClient{cc}
+}
+
+func (c *nsGsClient) ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error) {
+	out := new(ScheduleDownlinkResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsGs/ScheduleDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsGsServer is the server API for NsGs service.
+type NsGsServer interface {
+	// Instructs the Gateway Server to schedule a downlink message.
+	// The Gateway Server may refuse if there are any conflicts in the schedule or
+	// if a duty cycle prevents the gateway from transmitting.
+	ScheduleDownlink(context.Context, *DownlinkMessage) (*ScheduleDownlinkResponse, error)
+}
+
+// UnimplementedNsGsServer can be embedded to have forward compatible implementations.
+type UnimplementedNsGsServer struct {
+}
+
+func (*UnimplementedNsGsServer) ScheduleDownlink(ctx context.Context, req *DownlinkMessage) (*ScheduleDownlinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDownlink not implemented")
+}
+
+func RegisterNsGsServer(s *grpc.Server, srv NsGsServer) {
+	s.RegisterService(&_NsGs_serviceDesc, srv)
+}
+
+func _NsGs_ScheduleDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownlinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsGsServer).ScheduleDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsGs/ScheduleDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsGsServer).ScheduleDownlink(ctx, req.(*DownlinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NsGs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsGs",
+	HandlerType: (*NsGsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleDownlink",
+			Handler:    _NsGs_ScheduleDownlink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/gatewayserver.proto",
+}
+
+// GsClient is the client API for Gs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GsClient interface {
+	// Get statistics about the current gateway connection to the Gateway Server.
+	// This is not persisted between reconnects.
+	GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error)
+	// Capture the traffic of the connected gateway for the given duration.
+	// The captured records contain the raw frontend messages and the downlink scheduling attempts.
+	CaptureGatewayTraffic(ctx context.Context, in *CaptureGatewayTrafficRequest, opts ...grpc.CallOption) (Gs_CaptureGatewayTrafficClient, error)
+	// Run a remote command on the connected gateway.
+	// This is only supported for gateways that are connected with a frontend that supports remote commands,
+	// and only if remote commands are enabled in the Gateway Server.
+	RunGatewayRemoteCommand(ctx context.Context, in *RunGatewayRemoteCommandRequest, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type gsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGsClient(cc *grpc.ClientConn) GsClient {
+	return &gsClient{cc}
+}
+
+func (c *gsClient) GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error) {
+	out := new(GatewayConnectionStats)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) CaptureGatewayTraffic(ctx context.Context, in *CaptureGatewayTrafficRequest, opts ...grpc.CallOption) (Gs_CaptureGatewayTrafficClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Gs_serviceDesc.Streams[0], "/ttn.lorawan.v3.Gs/CaptureGatewayTraffic", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gsCaptureGatewayTrafficClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gs_CaptureGatewayTrafficClient interface {
+	Recv() (*GatewayTrafficCaptureRecord, error)
+	grpc.ClientStream
+}
+
+type gsCaptureGatewayTrafficClient struct {
+	grpc.ClientStream
+}
+
+func (x *gsCaptureGatewayTrafficClient) Recv() (*GatewayTrafficCaptureRecord, error) {
+	m := new(GatewayTrafficCaptureRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gsClient) RunGatewayRemoteCommand(ctx context.Context, in *RunGatewayRemoteCommandRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/RunGatewayRemoteCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GsServer is the server API for Gs service.
+type GsServer interface {
+	// Get statistics about the current gateway connection to the Gateway Server.
+	// This is not persisted between reconnects.
+	GetGatewayConnectionStats(context.Context, *GatewayIdentifiers) (*GatewayConnectionStats, error)
+	// Capture the traffic of the connected gateway for the given duration.
+	// The captured records contain the raw frontend messages and the downlink scheduling attempts.
+	CaptureGatewayTraffic(*CaptureGatewayTrafficRequest, Gs_CaptureGatewayTrafficServer) error
+	// Run a remote command on the connected gateway.
+	// This is only supported for gateways that are connected with a frontend that supports remote commands,
+	// and only if remote commands are enabled in the Gateway Server.
+	RunGatewayRemoteCommand(context.Context, *RunGatewayRemoteCommandRequest) (*types.Empty, error)
+}
+
+// UnimplementedGsServer can be embedded to have forward compatible implementations.
+type UnimplementedGsServer struct {
+}
+
+func (*UnimplementedGsServer) GetGatewayConnectionStats(ctx context.Context, req *GatewayIdentifiers) (*GatewayConnectionStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionStats not implemented")
+}
+func (*UnimplementedGsServer) CaptureGatewayTraffic(req *CaptureGatewayTrafficRequest, srv Gs_CaptureGatewayTrafficServer) error {
+	return status.Errorf(codes.Unimplemented, "method CaptureGatewayTraffic not implemented")
+}
+func (*UnimplementedGsServer) RunGatewayRemoteCommand(ctx context.Context, req *RunGatewayRemoteCommandRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunGatewayRemoteCommand not implemented")
+}
+
+func RegisterGsServer(s *grpc.Server, srv GsServer) {
+	s.RegisterService(&_Gs_serviceDesc, srv)
+}
+
+func _Gs_GetGatewayConnectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).GetGatewayConnectionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).GetGatewayConnectionStats(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_CaptureGatewayTraffic_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CaptureGatewayTrafficRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GsServer).CaptureGatewayTraffic(m, &gsCaptureGatewayTrafficServer{stream})
+}
+
+type Gs_CaptureGatewayTrafficServer interface {
+	Send(*GatewayTrafficCaptureRecord) error
+	grpc.ServerStream
+}
+
+type gsCaptureGatewayTrafficServer struct {
+	grpc.ServerStream
+}
+
+func (x *gsCaptureGatewayTrafficServer) Send(m *GatewayTrafficCaptureRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Gs_RunGatewayRemoteCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunGatewayRemoteCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).RunGatewayRemoteCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/RunGatewayRemoteCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).RunGatewayRemoteCommand(ctx, req.(*RunGatewayRemoteCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Gs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.Gs",
+	HandlerType: (*GsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGatewayConnectionStats",
+			Handler:    _Gs_GetGatewayConnectionStats_Handler,
+		},
+		{
+			MethodName: "RunGatewayRemoteCommand",
+			Handler:    _Gs_RunGatewayRemoteCommand_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CaptureGatewayTraffic",
+			Handler:       _Gs_CaptureGatewayTraffic_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "lorawan-stack/api/gatewayserver.proto",
+}
+
+func (m *GatewayUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxAcknowledgment != nil {
+		{
+			size, err := m.TxAcknowledgment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GatewayStatus != nil {
+		{
+			size, err := m.GatewayStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UplinkMessages) > 0 {
+		for iNdEx := len(m.UplinkMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UplinkMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayDown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayDown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DownlinkMessage != nil {
+		{
+			size, err := m.DownlinkMessage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleDownlinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleDownlinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleDownlinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleDownlinkErrorDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleDownlinkErrorDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleDownlinkErrorDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attempts) > 0 {
+		for iNdEx := len(m.Attempts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attempts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PathErrors) > 0 {
+		for iNdEx := len(m.PathErrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PathErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DownlinkSchedulingTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownlinkSchedulingTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DownlinkSchedulingTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RTTMargin != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RTTMargin, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RTTMargin):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MedianRTT != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MedianRTT, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MedianRTT):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinScheduleTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinScheduleTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if m.Conflict != nil {
+		{
+			size, err := m.Conflict.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SubBand != nil {
+		{
+			size, err := m.SubBand.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Emission != nil {
+		{
+			size, err := m.Emission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DownlinkSchedulingTrace_Emission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownlinkSchedulingTrace_Emission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DownlinkSchedulingTrace_Emission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x12
+	if m.Starts != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Starts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RunGatewayRemoteCommandRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunGatewayRemoteCommandRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunGatewayRemoteCommandRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Arguments) > 0 {
+		for iNdEx := len(m.Arguments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Arguments[iNdEx])
+			copy(dAtA[i:], m.Arguments[iNdEx])
+			i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Arguments[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Command) > 0 {
+		i -= len(m.Command)
+		copy(dAtA[i:], m.Command)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Command)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CaptureGatewayTrafficRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CaptureGatewayTrafficRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CaptureGatewayTrafficRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayTrafficCaptureRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayTrafficCaptureRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTrafficCaptureRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CorrelationIDs) > 0 {
+		for iNdEx := len(m.CorrelationIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CorrelationIDs[iNdEx])
+			copy(dAtA[i:], m.CorrelationIDs[iNdEx])
+			i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.CorrelationIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SchedulingAttempts) > 0 {
+		for iNdEx := len(m.SchedulingAttempts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SchedulingAttempts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Raw) > 0 {
+		i -= len(m.Raw)
+		copy(dAtA[i:], m.Raw)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Raw)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RemoteAddr) > 0 {
+		i -= len(m.RemoteAddr)
+		copy(dAtA[i:], m.RemoteAddr)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.RemoteAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Direction != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Protocol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Trace != nil {
+		{
+			size, err := m.Trace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x2a
+	if m.Starts != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Starts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DataRateIndex != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Frequency != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RxWindow != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.RxWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGatewayserver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGatewayserver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedGatewayUp(r randyGatewayserver, easy bool) *GatewayUp {
+	this := &GatewayUp{}
+	if r.Intn(5) != 0 {
+		v1 := r.Intn(5)
+		this.UplinkMessages = make([]*UplinkMessage, v1)
+		for i := 0; i < v1; i++ {
+			this.UplinkMessages[i] = NewPopulatedUplinkMessage(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.GatewayStatus = NewPopulatedGatewayStatus(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.TxAcknowledgment = NewPopulatedTxAcknowledgment(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayDown(r randyGatewayserver, easy bool) *GatewayDown {
+	this := &GatewayDown{}
+	if r.Intn(5) != 0 {
+		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScheduleDownlinkResponse(r randyGatewayserver, easy bool) *ScheduleDownlinkResponse {
+	this := &ScheduleDownlinkResponse{}
+	v2 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Delay = *v2
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScheduleDownlinkErrorDetails(r randyGatewayserver, easy bool) *ScheduleDownlinkErrorDetails {
+	this := &ScheduleDownlinkErrorDetails{}
+	if r.Intn(5) == 0 {
+		v3 := r.Intn(5)
+		this.PathErrors = make([]*ErrorDetails, v3)
+		for i := 0; i < v3; i++ {
+			this.PathErrors[i] = NewPopulatedErrorDetails(r, easy)
+		}
+	}
+	if r.Intn(5) == 0 {
+		v4 := r.Intn(5)
+		this.Attempts = make([]*GatewayTrafficCaptureRecord_SchedulingAttempt, v4)
+		for i := 0; i < v4; i++ {
+			this.Attempts[i] = NewPopulatedGatewayTrafficCaptureRecord_SchedulingAttempt(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDownlinkSchedulingTrace(r randyGatewayserver, easy bool) *DownlinkSchedulingTrace {
+	this := &DownlinkSchedulingTrace{}
+	if r.Intn(5) != 0 {
+		this.Emission = NewPopulatedDownlinkSchedulingTrace_Emission(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.SubBand = NewPopulatedGatewayConnectionStats_SubBand(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Conflict = NewPopulatedDownlinkSchedulingTrace_Emission(r, easy)
+	}
+	v5 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.MinScheduleTime = *v5
+	if r.Intn(5) != 0 {
+		this.MedianRTT = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.RTTMargin = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDownlinkSchedulingTrace_Emission(r randyGatewayserver, easy bool) *DownlinkSchedulingTrace_Emission {
+	this := &DownlinkSchedulingTrace_Emission{}
+	this.Starts = r.Int63()
+	if r.Intn(2) == 0 {
+		this.Starts *= -1
+	}
+	v6 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Duration = *v6
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRunGatewayRemoteCommandRequest(r randyGatewayserver, easy bool) *RunGatewayRemoteCommandRequest {
+	this := &RunGatewayRemoteCommandRequest{}
+	v8 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v8
+	this.Command = randStringGatewayserver(r)
+	v9 := r.Intn(10)
+	this.Arguments = make([]string, v9)
+	for i := 0; i < v9; i++ {
+		this.Arguments[i] = randStringGatewayserver(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCaptureGatewayTrafficRequest(r randyGatewayserver, easy bool) *CaptureGatewayTrafficRequest {
+	this := &CaptureGatewayTrafficRequest{}
+	v10 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v10
+	v11 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Duration = *v11
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayTrafficCaptureRecord(r randyGatewayserver, easy bool) *GatewayTrafficCaptureRecord {
+	this := &GatewayTrafficCaptureRecord{}
+	v12 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v12
+	this.Protocol = randStringGatewayserver(r)
+	this.Direction = GatewayTrafficCaptureRecord_Direction([]int32{0, 1}[r.Intn(2)])
+	this.RemoteAddr = randStringGatewayserver(r)
+	v13 := r.Intn(100)
+	this.Raw = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.Raw[i] = byte(r.Intn(256))
+	}
+	if r.Intn(5) == 0 {
+		v14 := r.Intn(5)
+		this.SchedulingAttempts = make([]*GatewayTrafficCaptureRecord_SchedulingAttempt, v14)
+		for i := 0; i < v14; i++ {
+			this.SchedulingAttempts[i] = NewPopulatedGatewayTrafficCaptureRecord_SchedulingAttempt(r, easy)
+		}
+	}
+	v15 := r.Intn(10)
+	this.CorrelationIDs = make([]string, v15)
+	for i := 0; i < v15; i++ {
+		this.CorrelationIDs[i] = randStringGatewayserver(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayTrafficCaptureRecord_SchedulingAttempt(r randyGatewayserver, easy bool) *GatewayTrafficCaptureRecord_SchedulingAttempt {
+	this := &GatewayTrafficCaptureRecord_SchedulingAttempt{}
+	this.RxWindow = r.Uint32()
+	this.Frequency = uint64(r.Uint32())
+	this.DataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.Starts = r.Int63()
+	if r.Intn(2) == 0 {
+		this.Starts *= -1
+	}
+	v7 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Duration = *v7
+	if r.Intn(5) == 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Trace = NewPopulatedDownlinkSchedulingTrace(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyGatewayserver interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneGatewayserver(r randyGatewayserver) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringGatewayserver(r randyGatewayserver) string {
+	v16 := r.Intn(100)
+	tmps := make([]rune, v16)
+	for i := 0; i < v16; i++ {
+		tmps[i] = randUTF8RuneGatewayserver(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedGatewayserver(r randyGatewayserver, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldGatewayserver(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldGatewayserver(dAtA []byte, r randyGatewayserver, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		v17 := r.Int63()
+		if r.Intn(2) == 0 {
+			v17 *= -1
+		}
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(v17))
+	case 1:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateGatewayserver(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *GatewayUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UplinkMessages) > 0 {
+		for _, e := range m.UplinkMessages {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	if m.GatewayStatus != nil {
+		l = m.GatewayStatus.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.TxAcknowledgment != nil {
+		l = m.TxAcknowledgment.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayDown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DownlinkMessage != nil {
+		l = m.DownlinkMessage.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleDownlinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+
+func (m *ScheduleDownlinkErrorDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PathErrors) > 0 {
+		for _, e := range m.PathErrors {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	if len(m.Attempts) > 0 {
+		for _, e := range m.Attempts {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DownlinkSchedulingTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Emission != nil {
+		l = m.Emission.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.SubBand != nil {
+		l = m.SubBand.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.Conflict != nil {
+		l = m.Conflict.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinScheduleTime)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.MedianRTT != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MedianRTT)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.RTTMargin != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RTTMargin)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *DownlinkSchedulingTrace_Emission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Starts != 0 {
+		n += 1 + sovGatewayserver(uint64(m.Starts))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+
+func (m *RunGatewayRemoteCommandRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = len(m.Command)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if len(m.Arguments) > 0 {
+		for _, s := range m.Arguments {
+			l = len(s)
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CaptureGatewayTrafficRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+
+func (m *GatewayTrafficCaptureRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovGatewayserver(uint64(m.Direction))
+	}
+	l = len(m.RemoteAddr)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = len(m.Raw)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if len(m.SchedulingAttempts) > 0 {
+		for _, e := range m.SchedulingAttempts {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	if len(m.CorrelationIDs) > 0 {
+		for _, s := range m.CorrelationIDs {
+			l = len(s)
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RxWindow != 0 {
+		n += 1 + sovGatewayserver(uint64(m.RxWindow))
+	}
+	if m.Frequency != 0 {
+		n += 1 + sovGatewayserver(m.Frequency)
+	}
+	if m.DataRateIndex != 0 {
+		n += 1 + sovGatewayserver(uint64(m.DataRateIndex))
+	}
+	if m.Starts != 0 {
+		n += 1 + sovGatewayserver(uint64(m.Starts))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.Trace != nil {
+		l = m.Trace.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func sovGatewayserver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGatewayserver(x uint64) (n int) {
+	return sovGatewayserver((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *GatewayUp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUplinkMessages := "[]*UplinkMessage{"
+	for _, f := range this.UplinkMessages {
+		repeatedStringForUplinkMessages += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
+	}
+	repeatedStringForUplinkMessages += "}"
+	s := strings.Join([]string{`&GatewayUp{`,
+		`UplinkMessages:` + repeatedStringForUplinkMessages + `,`,
+		`GatewayStatus:` + strings.Replace(fmt.Sprintf("%v", this.GatewayStatus), "GatewayStatus", "GatewayStatus", 1) + `,`,
+		`TxAcknowledgment:` + strings.Replace(fmt.Sprintf("%v", this.TxAcknowledgment), "TxAcknowledgment", "TxAcknowledgment", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayDown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayDown{`,
+		`DownlinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkMessage), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleDownlinkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleDownlinkResponse{`,
+		`Delay:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Delay), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleDownlinkErrorDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPathErrors := "[]*ErrorDetails{"
+	for _, f := range this.PathErrors {
+		repeatedStringForPathErrors += strings.Replace(fmt.Sprintf("%v", f), "ErrorDetails", "ErrorDetails", 1) + ","
+	}
+	repeatedStringForPathErrors += "}"
+	repeatedStringForAttempts := "[]*GatewayTrafficCaptureRecord_SchedulingAttempt{"
+	for _, f := range this.Attempts {
+		repeatedStringForAttempts += strings.Replace(f.String(), "GatewayTrafficCaptureRecord_SchedulingAttempt", "GatewayTrafficCaptureRecord_SchedulingAttempt", 1) + ","
+	}
+	repeatedStringForAttempts += "}"
+	s := strings.Join([]string{`&ScheduleDownlinkErrorDetails{`,
+		`PathErrors:` + repeatedStringForPathErrors + `,`,
+		`Attempts:` + repeatedStringForAttempts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkSchedulingTrace) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DownlinkSchedulingTrace{`,
+		`Emission:` + strings.Replace(fmt.Sprintf("%v", this.Emission), "DownlinkSchedulingTrace_Emission", "DownlinkSchedulingTrace_Emission", 1) + `,`,
+		`SubBand:` + strings.Replace(fmt.Sprintf("%v", this.SubBand), "GatewayConnectionStats_SubBand", "GatewayConnectionStats_SubBand", 1) + `,`,
+		`Conflict:` + strings.Replace(fmt.Sprintf("%v", this.Conflict), "DownlinkSchedulingTrace_Emission", "DownlinkSchedulingTrace_Emission", 1) + `,`,
+		`MinScheduleTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.MinScheduleTime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`MedianRTT:` + strings.Replace(fmt.Sprintf("%v", this.MedianRTT), "Duration", "types.Duration", 1) + `,`,
+		`RTTMargin:` + strings.Replace(fmt.Sprintf("%v", this.RTTMargin), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkSchedulingTrace_Emission) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DownlinkSchedulingTrace_Emission{`,
+		`Starts:` + fmt.Sprintf("%v", this.Starts) + `,`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RunGatewayRemoteCommandRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RunGatewayRemoteCommandRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
+		`Arguments:` + fmt.Sprintf("%v", this.Arguments) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CaptureGatewayTrafficRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CaptureGatewayTrafficRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTrafficCaptureRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSchedulingAttempts := "[]*GatewayTrafficCaptureRecord_SchedulingAttempt{"
+	for _, f := range this.SchedulingAttempts {
+		repeatedStringForSchedulingAttempts += strings.Replace(f.String(), "GatewayTrafficCaptureRecord_SchedulingAttempt", "GatewayTrafficCaptureRecord_SchedulingAttempt", 1) + ","
+	}
+	repeatedStringForSchedulingAttempts += "}"
+	s := strings.Join([]string{`&GatewayTrafficCaptureRecord{`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
+		`Direction:` + fmt.Sprintf("%v", this.Direction) + `,`,
+		`RemoteAddr:` + fmt.Sprintf("%v", this.RemoteAddr) + `,`,
+		`Raw:` + fmt.Sprintf("%v", this.Raw) + `,`,
+		`SchedulingAttempts:` + repeatedStringForSchedulingAttempts + `,`,
+		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTrafficCaptureRecord_SchedulingAttempt) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayTrafficCaptureRecord_SchedulingAttempt{`,
+		`RxWindow:` + fmt.Sprintf("%v", this.RxWindow) + `,`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`Starts:` + fmt.Sprintf("%v", this.Starts) + `,`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`Trace:` + strings.Replace(this.Trace.String(), "DownlinkSchedulingTrace", "DownlinkSchedulingTrace", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGatewayserver(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GatewayUp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayUp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayUp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UplinkMessages = append(m.UplinkMessages, &UplinkMessage{})
+			if err := m.UplinkMessages[len(m.UplinkMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GatewayStatus == nil {
+				m.GatewayStatus = &GatewayStatus{}
+			}
+			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxAcknowledgment == nil {
+				m.TxAcknowledgment = &TxAcknowledgment{}
+			}
+			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayDown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayDown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayDown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkMessage == nil {
+				m.DownlinkMessage = &DownlinkMessage{}
+			}
+			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleDownlinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathErrors = append(m.PathErrors, &ErrorDetails{})
+			if err := m.PathErrors[len(m.PathErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attempts = append(m.Attempts, &GatewayTrafficCaptureRecord_SchedulingAttempt{})
+			if err := m.Attempts[len(m.Attempts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DownlinkSchedulingTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownlinkSchedulingTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownlinkSchedulingTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Emission == nil {
+				m.Emission = &DownlinkSchedulingTrace_Emission{}
+			}
+			if err := m.Emission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubBand", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubBand == nil {
+				m.SubBand = &GatewayConnectionStats_SubBand{}
+			}
+			if err := m.SubBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Conflict == nil {
+				m.Conflict = &DownlinkSchedulingTrace_Emission{}
+			}
+			if err := m.Conflict.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinScheduleTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinScheduleTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianRTT", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MedianRTT == nil {
+				m.MedianRTT = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MedianRTT, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RTTMargin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RTTMargin == nil {
+				m.RTTMargin = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.RTTMargin, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DownlinkSchedulingTrace_Emission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Emission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Emission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Starts", wireType)
+			}
+			m.Starts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Starts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunGatewayRemoteCommandRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunGatewayRemoteCommandRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunGatewayRemoteCommandRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arguments = append(m.Arguments, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CaptureGatewayTrafficRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CaptureGatewayTrafficRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CaptureGatewayTrafficRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayTrafficCaptureRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayTrafficCaptureRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayTrafficCaptureRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayUp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayUp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= GatewayTrafficCaptureRecord_Direction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGatewayserver
@@ -1285,31 +4118,29 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UplinkMessages = append(m.UplinkMessages, &UplinkMessage{})
-			if err := m.UplinkMessages[len(m.UplinkMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RemoteAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGatewayserver
@@ -1319,31 +4150,29 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GatewayStatus == nil {
-				m.GatewayStatus = &GatewayStatus{}
-			}
-			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Raw = append(m.Raw[:0], dAtA[iNdEx:postIndex]...)
+			if m.Raw == nil {
+				m.Raw = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingAttempts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1370,71 +4199,16 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxAcknowledgment == nil {
-				m.TxAcknowledgment = &TxAcknowledgment{}
-			}
-			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SchedulingAttempts = append(m.SchedulingAttempts, &GatewayTrafficCaptureRecord_SchedulingAttempt{})
+			if err := m.SchedulingAttempts[len(m.SchedulingAttempts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGatewayserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGatewayserver
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGatewayserver
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GatewayDown) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGatewayserver
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayDown: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayDown: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationIDs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGatewayserver
@@ -1444,27 +4218,23 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkMessage == nil {
-				m.DownlinkMessage = &DownlinkMessage{}
-			}
-			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CorrelationIDs = append(m.CorrelationIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1490,7 +4260,7 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1513,15 +4283,91 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDownlinkResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayTrafficCaptureRecord_SchedulingAttempt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayTrafficCaptureRecord_SchedulingAttempt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxWindow", wireType)
+			}
+			m.RxWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RxWindow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			m.Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+			}
+			m.DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Starts", wireType)
+			}
+			m.Starts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Starts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1548,66 +4394,49 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGatewayserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGatewayserver
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathErrors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1634,8 +4463,10 @@ func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathErrors = append(m.PathErrors, &ErrorDetails{})
-			if err := m.PathErrors[len(m.PathErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Trace == nil {
+				m.Trace = &DownlinkSchedulingTrace{}
+			}
+			if err := m.Trace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -100,6 +100,10 @@ var GatewayDownFieldPathsNested = []string{
 	"downlink_message.settings.scheduled.data_rate.modulation.lora",
 	"downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
 	"downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"downlink_message.settings.scheduled.data_rate.modulation.lrfhss",
+	"downlink_message.settings.scheduled.data_rate.modulation.lrfhss.coding_rate",
+	"downlink_message.settings.scheduled.data_rate.modulation.lrfhss.modulation_type",
+	"downlink_message.settings.scheduled.data_rate.modulation.lrfhss.operating_channel_width",
 	"downlink_message.settings.scheduled.data_rate_index",
 	"downlink_message.settings.scheduled.downlink",
 	"downlink_message.settings.scheduled.downlink.antenna_index",
@@ -122,9 +126,134 @@ var ScheduleDownlinkResponseFieldPathsTopLevel = []string{
 	"delay",
 }
 var ScheduleDownlinkErrorDetailsFieldPathsNested = []string{
+	"attempts",
 	"path_errors",
 }
 
 var ScheduleDownlinkErrorDetailsFieldPathsTopLevel = []string{
+	"attempts",
 	"path_errors",
 }
+var DownlinkSchedulingTraceFieldPathsNested = []string{
+	"conflict",
+	"conflict.duration",
+	"conflict.starts",
+	"emission",
+	"emission.duration",
+	"emission.starts",
+	"median_rtt",
+	"min_schedule_time",
+	"rtt_margin",
+	"sub_band",
+	"sub_band.downlink_utilization",
+	"sub_band.downlink_utilization_limit",
+	"sub_band.max_frequency",
+	"sub_band.min_frequency",
+}
+
+var DownlinkSchedulingTraceFieldPathsTopLevel = []string{
+	"conflict",
+	"emission",
+	"median_rtt",
+	"min_schedule_time",
+	"rtt_margin",
+	"sub_band",
+}
+var RunGatewayRemoteCommandRequestFieldPathsNested = []string{
+	"arguments",
+	"command",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var RunGatewayRemoteCommandRequestFieldPathsTopLevel = []string{
+	"arguments",
+	"command",
+	"gateway_ids",
+}
+var CaptureGatewayTrafficRequestFieldPathsNested = []string{
+	"duration",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var CaptureGatewayTrafficRequestFieldPathsTopLevel = []string{
+	"duration",
+	"gateway_ids",
+}
+var GatewayTrafficCaptureRecordFieldPathsNested = []string{
+	"correlation_ids",
+	"direction",
+	"protocol",
+	"raw",
+	"remote_addr",
+	"scheduling_attempts",
+	"time",
+}
+
+var GatewayTrafficCaptureRecordFieldPathsTopLevel = []string{
+	"correlation_ids",
+	"direction",
+	"protocol",
+	"raw",
+	"remote_addr",
+	"scheduling_attempts",
+	"time",
+}
+var DownlinkSchedulingTrace_EmissionFieldPathsNested = []string{
+	"duration",
+	"starts",
+}
+
+var DownlinkSchedulingTrace_EmissionFieldPathsTopLevel = []string{
+	"duration",
+	"starts",
+}
+var GatewayTrafficCaptureRecord_SchedulingAttemptFieldPathsNested = []string{
+	"data_rate_index",
+	"duration",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+	"frequency",
+	"rx_window",
+	"starts",
+	"trace",
+	"trace.conflict",
+	"trace.conflict.duration",
+	"trace.conflict.starts",
+	"trace.emission",
+	"trace.emission.duration",
+	"trace.emission.starts",
+	"trace.median_rtt",
+	"trace.min_schedule_time",
+	"trace.rtt_margin",
+	"trace.sub_band",
+	"trace.sub_band.downlink_utilization",
+	"trace.sub_band.downlink_utilization_limit",
+	"trace.sub_band.max_frequency",
+	"trace.sub_band.min_frequency",
+}
+
+var GatewayTrafficCaptureRecord_SchedulingAttemptFieldPathsTopLevel = []string{
+	"data_rate_index",
+	"duration",
+	"error",
+	"frequency",
+	"rx_window",
+	"starts",
+	"trace",
+}
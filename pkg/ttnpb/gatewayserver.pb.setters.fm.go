@@ -146,6 +146,435 @@ func (dst *ScheduleDownlinkErrorDetails) SetFields(src *ScheduleDownlinkErrorDet
 			} else {
 				dst.PathErrors = nil
 			}
+		case "attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Attempts = src.Attempts
+			} else {
+				dst.Attempts = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DownlinkSchedulingTrace) SetFields(src *DownlinkSchedulingTrace, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "emission":
+			if len(subs) > 0 {
+				var newDst, newSrc *DownlinkSchedulingTrace_Emission
+				if (src == nil || src.Emission == nil) && dst.Emission == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Emission
+				}
+				if dst.Emission != nil {
+					newDst = dst.Emission
+				} else {
+					newDst = &DownlinkSchedulingTrace_Emission{}
+					dst.Emission = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Emission = src.Emission
+				} else {
+					dst.Emission = nil
+				}
+			}
+		case "sub_band":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayConnectionStats_SubBand
+				if (src == nil || src.SubBand == nil) && dst.SubBand == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SubBand
+				}
+				if dst.SubBand != nil {
+					newDst = dst.SubBand
+				} else {
+					newDst = &GatewayConnectionStats_SubBand{}
+					dst.SubBand = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SubBand = src.SubBand
+				} else {
+					dst.SubBand = nil
+				}
+			}
+		case "conflict":
+			if len(subs) > 0 {
+				var newDst, newSrc *DownlinkSchedulingTrace_Emission
+				if (src == nil || src.Conflict == nil) && dst.Conflict == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Conflict
+				}
+				if dst.Conflict != nil {
+					newDst = dst.Conflict
+				} else {
+					newDst = &DownlinkSchedulingTrace_Emission{}
+					dst.Conflict = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Conflict = src.Conflict
+				} else {
+					dst.Conflict = nil
+				}
+			}
+		case "min_schedule_time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_schedule_time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinScheduleTime = src.MinScheduleTime
+			} else {
+				var zero time.Duration
+				dst.MinScheduleTime = zero
+			}
+		case "median_rtt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'median_rtt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MedianRTT = src.MedianRTT
+			} else {
+				dst.MedianRTT = nil
+			}
+		case "rtt_margin":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rtt_margin' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RTTMargin = src.RTTMargin
+			} else {
+				dst.RTTMargin = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RunGatewayRemoteCommandRequest) SetFields(src *RunGatewayRemoteCommandRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "command":
+			if len(subs) > 0 {
+				return fmt.Errorf("'command' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Command = src.Command
+			} else {
+				var zero string
+				dst.Command = zero
+			}
+		case "arguments":
+			if len(subs) > 0 {
+				return fmt.Errorf("'arguments' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Arguments = src.Arguments
+			} else {
+				dst.Arguments = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *CaptureGatewayTrafficRequest) SetFields(src *CaptureGatewayTrafficRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Duration = src.Duration
+			} else {
+				var zero time.Duration
+				dst.Duration = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayTrafficCaptureRecord) SetFields(src *GatewayTrafficCaptureRecord, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				var zero time.Time
+				dst.Time = zero
+			}
+		case "protocol":
+			if len(subs) > 0 {
+				return fmt.Errorf("'protocol' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Protocol = src.Protocol
+			} else {
+				var zero string
+				dst.Protocol = zero
+			}
+		case "direction":
+			if len(subs) > 0 {
+				return fmt.Errorf("'direction' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Direction = src.Direction
+			} else {
+				var zero GatewayTrafficCaptureRecord_Direction
+				dst.Direction = zero
+			}
+		case "remote_addr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'remote_addr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RemoteAddr = src.RemoteAddr
+			} else {
+				var zero string
+				dst.RemoteAddr = zero
+			}
+		case "raw":
+			if len(subs) > 0 {
+				return fmt.Errorf("'raw' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Raw = src.Raw
+			} else {
+				dst.Raw = nil
+			}
+		case "scheduling_attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'scheduling_attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SchedulingAttempts = src.SchedulingAttempts
+			} else {
+				dst.SchedulingAttempts = nil
+			}
+		case "correlation_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'correlation_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CorrelationIDs = src.CorrelationIDs
+			} else {
+				dst.CorrelationIDs = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DownlinkSchedulingTrace_Emission) SetFields(src *DownlinkSchedulingTrace_Emission, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "starts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'starts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Starts = src.Starts
+			} else {
+				var zero int64
+				dst.Starts = zero
+			}
+		case "duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Duration = src.Duration
+			} else {
+				var zero time.Duration
+				dst.Duration = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+func (dst *GatewayTrafficCaptureRecord_SchedulingAttempt) SetFields(src *GatewayTrafficCaptureRecord_SchedulingAttempt, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "rx_window":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rx_window' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RxWindow = src.RxWindow
+			} else {
+				var zero uint32
+				dst.RxWindow = zero
+			}
+		case "frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Frequency = src.Frequency
+			} else {
+				var zero uint64
+				dst.Frequency = zero
+			}
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
+			}
+		case "starts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'starts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Starts = src.Starts
+			} else {
+				var zero int64
+				dst.Starts = zero
+			}
+		case "duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Duration = src.Duration
+			} else {
+				var zero time.Duration
+				dst.Duration = zero
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+		case "trace":
+			if len(subs) > 0 {
+				var newDst, newSrc *DownlinkSchedulingTrace
+				if (src == nil || src.Trace == nil) && dst.Trace == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Trace
+				}
+				if dst.Trace != nil {
+					newDst = dst.Trace
+				} else {
+					newDst = &DownlinkSchedulingTrace{}
+					dst.Trace = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Trace = src.Trace
+				} else {
+					dst.Trace = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
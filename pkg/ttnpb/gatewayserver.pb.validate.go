@@ -362,6 +362,23 @@ func (m *ScheduleDownlinkErrorDetails) ValidateFields(paths ...string) error {
 
 			}
 
+		case "attempts":
+
+			for idx, item := range m.GetAttempts() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ScheduleDownlinkErrorDetailsValidationError{
+							field:  fmt.Sprintf("attempts[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
 		default:
 			return ScheduleDownlinkErrorDetailsValidationError{
 				field:  name,
@@ -428,3 +445,722 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ScheduleDownlinkErrorDetailsValidationError{}
+
+// ValidateFields checks the field values on DownlinkSchedulingTrace with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DownlinkSchedulingTrace) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DownlinkSchedulingTraceFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "emission":
+
+			if v, ok := interface{}(m.GetEmission()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkSchedulingTraceValidationError{
+						field:  "emission",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "sub_band":
+
+			if v, ok := interface{}(m.GetSubBand()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkSchedulingTraceValidationError{
+						field:  "sub_band",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "conflict":
+
+			if v, ok := interface{}(m.GetConflict()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkSchedulingTraceValidationError{
+						field:  "conflict",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "min_schedule_time":
+
+			if v, ok := interface{}(&m.MinScheduleTime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkSchedulingTraceValidationError{
+						field:  "min_schedule_time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "median_rtt":
+
+			if v, ok := interface{}(m.GetMedianRTT()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkSchedulingTraceValidationError{
+						field:  "median_rtt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "rtt_margin":
+
+			if v, ok := interface{}(m.GetRTTMargin()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkSchedulingTraceValidationError{
+						field:  "rtt_margin",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return DownlinkSchedulingTraceValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DownlinkSchedulingTraceValidationError is the validation error returned by
+// DownlinkSchedulingTrace.ValidateFields if the designated constraints aren't met.
+type DownlinkSchedulingTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownlinkSchedulingTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownlinkSchedulingTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownlinkSchedulingTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownlinkSchedulingTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownlinkSchedulingTraceValidationError) ErrorName() string {
+	return "DownlinkSchedulingTraceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownlinkSchedulingTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownlinkSchedulingTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownlinkSchedulingTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownlinkSchedulingTraceValidationError{}
+
+// ValidateFields checks the field values on RunGatewayRemoteCommandRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *RunGatewayRemoteCommandRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RunGatewayRemoteCommandRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RunGatewayRemoteCommandRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "command":
+
+			if l := utf8.RuneCountInString(m.GetCommand()); l < 1 || l > 1024 {
+				return RunGatewayRemoteCommandRequestValidationError{
+					field:  "command",
+					reason: "value length must be between 1 and 1024 runes, inclusive",
+				}
+			}
+
+		case "arguments":
+
+			if len(m.GetArguments()) > 64 {
+				return RunGatewayRemoteCommandRequestValidationError{
+					field:  "arguments",
+					reason: "value must contain no more than 64 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetArguments() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 1024 {
+					return RunGatewayRemoteCommandRequestValidationError{
+						field:  fmt.Sprintf("arguments[%v]", idx),
+						reason: "value length must be at most 1024 runes",
+					}
+				}
+
+			}
+
+		default:
+			return RunGatewayRemoteCommandRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RunGatewayRemoteCommandRequestValidationError is the validation error
+// returned by RunGatewayRemoteCommandRequest.ValidateFields if the designated
+// constraints aren't met.
+type RunGatewayRemoteCommandRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunGatewayRemoteCommandRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunGatewayRemoteCommandRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunGatewayRemoteCommandRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunGatewayRemoteCommandRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunGatewayRemoteCommandRequestValidationError) ErrorName() string {
+	return "RunGatewayRemoteCommandRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RunGatewayRemoteCommandRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunGatewayRemoteCommandRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunGatewayRemoteCommandRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunGatewayRemoteCommandRequestValidationError{}
+
+// ValidateFields checks the field values on CaptureGatewayTrafficRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *CaptureGatewayTrafficRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CaptureGatewayTrafficRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CaptureGatewayTrafficRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "duration":
+
+		default:
+			return CaptureGatewayTrafficRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CaptureGatewayTrafficRequestValidationError is the validation error returned
+// by CaptureGatewayTrafficRequest.ValidateFields if the designated
+// constraints aren't met.
+type CaptureGatewayTrafficRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CaptureGatewayTrafficRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CaptureGatewayTrafficRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CaptureGatewayTrafficRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CaptureGatewayTrafficRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CaptureGatewayTrafficRequestValidationError) ErrorName() string {
+	return "CaptureGatewayTrafficRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CaptureGatewayTrafficRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCaptureGatewayTrafficRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CaptureGatewayTrafficRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CaptureGatewayTrafficRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayTrafficCaptureRecord with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayTrafficCaptureRecord) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayTrafficCaptureRecordFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "time":
+
+			if v, ok := interface{}(&m.Time).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficCaptureRecordValidationError{
+						field:  "time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "protocol":
+			// no validation rules for Protocol
+		case "direction":
+			// no validation rules for Direction
+		case "remote_addr":
+			// no validation rules for RemoteAddr
+		case "raw":
+			// no validation rules for Raw
+		case "scheduling_attempts":
+
+			for idx, item := range m.GetSchedulingAttempts() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayTrafficCaptureRecordValidationError{
+							field:  fmt.Sprintf("scheduling_attempts[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "correlation_ids":
+
+		default:
+			return GatewayTrafficCaptureRecordValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayTrafficCaptureRecordValidationError is the validation error returned
+// by GatewayTrafficCaptureRecord.ValidateFields if the designated constraints
+// aren't met.
+type GatewayTrafficCaptureRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayTrafficCaptureRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayTrafficCaptureRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayTrafficCaptureRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayTrafficCaptureRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayTrafficCaptureRecordValidationError) ErrorName() string {
+	return "GatewayTrafficCaptureRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayTrafficCaptureRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayTrafficCaptureRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayTrafficCaptureRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayTrafficCaptureRecordValidationError{}
+
+// ValidateFields checks the field values on DownlinkSchedulingTrace_Emission
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *DownlinkSchedulingTrace_Emission) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DownlinkSchedulingTrace_EmissionFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "starts":
+			// no validation rules for Starts
+		case "duration":
+
+			if v, ok := interface{}(&m.Duration).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkSchedulingTrace_EmissionValidationError{
+						field:  "duration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return DownlinkSchedulingTrace_EmissionValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DownlinkSchedulingTrace_EmissionValidationError is the validation error
+// returned by DownlinkSchedulingTrace_Emission.ValidateFields if the
+// designated constraints aren't met.
+type DownlinkSchedulingTrace_EmissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownlinkSchedulingTrace_EmissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownlinkSchedulingTrace_EmissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownlinkSchedulingTrace_EmissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownlinkSchedulingTrace_EmissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownlinkSchedulingTrace_EmissionValidationError) ErrorName() string {
+	return "DownlinkSchedulingTrace_EmissionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownlinkSchedulingTrace_EmissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownlinkSchedulingTrace_Emission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownlinkSchedulingTrace_EmissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownlinkSchedulingTrace_EmissionValidationError{}
+
+// ValidateFields checks the field values on
+// GatewayTrafficCaptureRecord_SchedulingAttempt with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *GatewayTrafficCaptureRecord_SchedulingAttempt) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayTrafficCaptureRecord_SchedulingAttemptFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "rx_window":
+			// no validation rules for RxWindow
+		case "frequency":
+			// no validation rules for Frequency
+		case "data_rate_index":
+			// no validation rules for DataRateIndex
+		case "starts":
+			// no validation rules for Starts
+		case "duration":
+
+			if v, ok := interface{}(&m.Duration).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficCaptureRecord_SchedulingAttemptValidationError{
+						field:  "duration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficCaptureRecord_SchedulingAttemptValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "trace":
+
+			if v, ok := interface{}(m.GetTrace()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficCaptureRecord_SchedulingAttemptValidationError{
+						field:  "trace",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayTrafficCaptureRecord_SchedulingAttemptValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayTrafficCaptureRecord_SchedulingAttemptValidationError is the
+// validation error returned by
+// GatewayTrafficCaptureRecord_SchedulingAttempt.ValidateFields if the
+// designated constraints aren't met.
+type GatewayTrafficCaptureRecord_SchedulingAttemptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayTrafficCaptureRecord_SchedulingAttemptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayTrafficCaptureRecord_SchedulingAttemptValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e GatewayTrafficCaptureRecord_SchedulingAttemptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayTrafficCaptureRecord_SchedulingAttemptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayTrafficCaptureRecord_SchedulingAttemptValidationError) ErrorName() string {
+	return "GatewayTrafficCaptureRecord_SchedulingAttemptValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayTrafficCaptureRecord_SchedulingAttemptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayTrafficCaptureRecord_SchedulingAttempt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayTrafficCaptureRecord_SchedulingAttemptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayTrafficCaptureRecord_SchedulingAttemptValidationError{}
@@ -1069,141 +1069,144 @@ func init() {
 }
 
 var fileDescriptor_1b695d5f526759a7 = []byte{
-	// 2131 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x90, 0xa2, 0x44, 0x8e, 0x24, 0x4a, 0x1e, 0x3b, 0x09, 0x4b, 0x3b, 0x4b, 0x65, 0xa3,
-	0xd6, 0xae, 0x12, 0x91, 0x29, 0xdd, 0x26, 0x8e, 0x52, 0xc4, 0x25, 0x45, 0x5a, 0xa2, 0xf5, 0x53,
-	0x75, 0xd9, 0xb4, 0xa9, 0x53, 0x67, 0xbd, 0x22, 0x87, 0xd4, 0x9a, 0xd4, 0xee, 0x76, 0x77, 0x44,
-	0x85, 0x49, 0x0d, 0x18, 0x3e, 0xb4, 0x46, 0x11, 0xa0, 0x05, 0x8a, 0x00, 0x45, 0x4f, 0x01, 0x8a,
-	0xa2, 0xb9, 0x35, 0x2d, 0x02, 0x34, 0xa7, 0x22, 0x87, 0xb6, 0xf0, 0xd1, 0x40, 0x5b, 0x20, 0xe8,
-	0x81, 0x88, 0x56, 0x2d, 0x90, 0x63, 0x4e, 0x45, 0xa0, 0x53, 0x31, 0xb3, 0x43, 0x72, 0xb9, 0xa4,
-	0x64, 0x51, 0xb2, 0x1c, 0xf4, 0x36, 0xbb, 0xf3, 0xe6, 0x9b, 0xf7, 0xbe, 0x79, 0xef, 0xcd, 0x7b,
-	0x03, 0xc5, 0x9a, 0x6e, 0x2a, 0xdb, 0x8a, 0x36, 0x6b, 0x11, 0xa5, 0x58, 0x4d, 0x2a, 0x86, 0x9a,
-	0xbc, 0xa9, 0xab, 0x9a, 0x85, 0xcd, 0x3a, 0x36, 0x13, 0x86, 0xa9, 0x13, 0x1d, 0x45, 0x08, 0xd1,
-	0x12, 0x5c, 0x2e, 0x51, 0xbf, 0x18, 0x4b, 0x57, 0x54, 0xb2, 0xb1, 0xb5, 0x9e, 0x28, 0xea, 0x9b,
-	0x49, 0xac, 0xd5, 0xf5, 0x86, 0x61, 0xea, 0x6f, 0x34, 0x92, 0x4c, 0xb8, 0x38, 0x5b, 0xc1, 0xda,
-	0x6c, 0x5d, 0xa9, 0xa9, 0x25, 0x85, 0xe0, 0x64, 0xcf, 0xc0, 0x81, 0x8c, 0xcd, 0xba, 0x20, 0x2a,
-	0x7a, 0x45, 0x77, 0x16, 0xaf, 0x6f, 0x95, 0xd9, 0x17, 0xfb, 0x60, 0x23, 0x2e, 0x7e, 0xae, 0xa2,
-	0xeb, 0x95, 0x1a, 0x66, 0xea, 0x29, 0x9a, 0xa6, 0x13, 0x85, 0xa8, 0xba, 0x66, 0xf1, 0xd9, 0xb3,
-	0x7c, 0xb6, 0x8d, 0x81, 0x37, 0x0d, 0xd2, 0xe0, 0x93, 0x53, 0xde, 0xc9, 0xb2, 0x8a, 0x6b, 0x25,
-	0x79, 0x53, 0xb1, 0xaa, 0x1e, 0xf0, 0xb6, 0x84, 0x45, 0xcc, 0xad, 0x22, 0xe1, 0xb3, 0x7d, 0x08,
-	0xc2, 0x5a, 0x49, 0x2e, 0xe1, 0xba, 0x5a, 0x6c, 0x59, 0xf3, 0x74, 0xaf, 0x8c, 0x5a, 0xc2, 0x1a,
-	0x51, 0xcb, 0x2a, 0x36, 0x5b, 0x5a, 0x9e, 0xeb, 0xcf, 0xf4, 0xfe, 0xb3, 0x55, 0xdc, 0x68, 0xad,
-	0x8d, 0xf7, 0xce, 0xb6, 0xce, 0x83, 0x09, 0x88, 0xbf, 0xf6, 0xc3, 0x53, 0x05, 0x6c, 0x59, 0xaa,
-	0xae, 0x2d, 0xe1, 0x86, 0x84, 0x7f, 0xb4, 0x85, 0x2d, 0x82, 0x5e, 0x86, 0x11, 0xcb, 0xf9, 0x29,
-	0x57, 0x71, 0x43, 0x56, 0x4b, 0x51, 0x30, 0x05, 0x2e, 0x8c, 0x65, 0xa2, 0x7b, 0x99, 0xe0, 0x9b,
-	0x81, 0xe8, 0xed, 0x49, 0xbb, 0x19, 0x1f, 0xeb, 0x2c, 0xcb, 0x67, 0xa5, 0x31, 0xab, 0xf3, 0x55,
-	0x42, 0x37, 0xe0, 0x48, 0x09, 0xd7, 0x65, 0xbc, 0xa5, 0x46, 0xfd, 0x6c, 0xe1, 0xc2, 0xbd, 0x66,
-	0xdc, 0xf7, 0xaf, 0x66, 0xfc, 0x1b, 0x15, 0x3d, 0x41, 0x36, 0x30, 0xd9, 0x50, 0xb5, 0x8a, 0x95,
-	0xd0, 0x30, 0xd9, 0xd6, 0xcd, 0x6a, 0xb2, 0x5b, 0xc9, 0xfa, 0xc5, 0xa4, 0x51, 0xad, 0x24, 0x49,
-	0xc3, 0xc0, 0x56, 0x22, 0xf7, 0x4a, 0xfe, 0xf9, 0xaf, 0xdb, 0xcd, 0xf8, 0x70, 0x16, 0xd7, 0x73,
-	0xaf, 0xe4, 0xa5, 0xe1, 0x12, 0xae, 0xe7, 0xb6, 0x54, 0x54, 0x84, 0x21, 0x4a, 0x02, 0xdb, 0x22,
-	0xc0, 0xb6, 0x58, 0x3c, 0xee, 0x16, 0x23, 0x57, 0x75, 0x55, 0xa3, 0x7b, 0x8c, 0x50, 0xe4, 0xdc,
-	0x96, 0x2a, 0xde, 0xf1, 0xc3, 0xc9, 0xd5, 0xed, 0x6a, 0x61, 0x09, 0x37, 0x2c, 0x09, 0x5b, 0x86,
-	0xae, 0x59, 0x18, 0x7d, 0x1b, 0x4e, 0x94, 0x65, 0x6d, 0xbb, 0x2a, 0x5b, 0xb2, 0xaa, 0x11, 0xca,
-	0x0f, 0x23, 0x67, 0x34, 0x75, 0x36, 0xd1, 0xed, 0xee, 0x89, 0x25, 0xdc, 0xc8, 0x69, 0x75, 0x5c,
-	0xd3, 0x0d, 0x9c, 0x19, 0xdb, 0xcb, 0x04, 0x7f, 0x06, 0xfc, 0x93, 0x80, 0x6a, 0x29, 0x8d, 0x96,
-	0x29, 0x6c, 0x5e, 0x23, 0x4b, 0xb8, 0x41, 0x01, 0x2d, 0x0f, 0xa0, 0x7f, 0x60, 0x40, 0xcb, 0x05,
-	0xb8, 0x0c, 0xc7, 0x1d, 0x38, 0xac, 0x15, 0x19, 0x5c, 0x60, 0x50, 0x38, 0xa8, 0x6d, 0x57, 0x0b,
-	0x39, 0xad, 0xb8, 0x84, 0x1b, 0xe2, 0xab, 0x70, 0x22, 0x6d, 0x18, 0x05, 0xe6, 0x1d, 0x9c, 0x82,
-	0x1c, 0x0c, 0x2b, 0x86, 0x21, 0x5b, 0x47, 0x33, 0x7e, 0x44, 0x71, 0xe0, 0xc4, 0xb7, 0x03, 0xf0,
-	0xec, 0xbc, 0xd9, 0x30, 0x88, 0x5e, 0xc0, 0x26, 0x8d, 0x8a, 0x35, 0xa5, 0x51, 0xd3, 0x95, 0x52,
-	0xcb, 0x0b, 0x17, 0x61, 0x40, 0x2d, 0x59, 0x7c, 0x83, 0x69, 0xef, 0x06, 0x39, 0xad, 0x94, 0x65,
-	0xb1, 0x94, 0xef, 0x44, 0x4c, 0x66, 0xd2, 0xbd, 0xd3, 0xfd, 0x66, 0x1c, 0x48, 0x14, 0x02, 0xc9,
-	0x70, 0x82, 0xaf, 0x94, 0xeb, 0xd8, 0xa4, 0x7e, 0xca, 0x28, 0x8e, 0xa4, 0x62, 0x5e, 0xd4, 0x95,
-	0xf4, 0xfc, 0xf7, 0x1c, 0x89, 0x4c, 0x6c, 0x2f, 0x13, 0xbc, 0x43, 0xb1, 0xec, 0x66, 0x3c, 0xb2,
-	0xac, 0x4b, 0xca, 0xf7, 0xd3, 0xab, 0x7c, 0x4e, 0x8a, 0xf0, 0x25, 0xfc, 0x1b, 0x45, 0xe1, 0x88,
-	0xe1, 0x28, 0xef, 0x78, 0xa3, 0xd4, 0xfa, 0x44, 0xeb, 0x30, 0x62, 0x98, 0x7a, 0x5d, 0xa5, 0x62,
-	0xd8, 0xa4, 0xa1, 0x34, 0x34, 0x05, 0x2e, 0x84, 0x33, 0x2f, 0xed, 0x65, 0xce, 0x9b, 0x5f, 0x8e,
-	0x4e, 0xa7, 0x9e, 0x7a, 0xfd, 0x35, 0x65, 0xf6, 0xcd, 0xe7, 0x66, 0x5f, 0xbc, 0x7e, 0xe1, 0xf2,
-	0xdc, 0x6b, 0xb3, 0xd7, 0x2f, 0xb7, 0x3e, 0xbf, 0xfa, 0x56, 0xea, 0xd9, 0x5b, 0xd3, 0x3f, 0x7e,
-	0x7d, 0xda, 0x6e, 0xc6, 0xc7, 0xd7, 0x3a, 0x18, 0xf9, 0xac, 0x34, 0xee, 0x82, 0xcc, 0x97, 0x50,
-	0x16, 0x9e, 0x6a, 0xff, 0x50, 0xb5, 0x8a, 0x5c, 0x52, 0x88, 0x12, 0x0d, 0x32, 0xda, 0x9e, 0x48,
-	0x38, 0x49, 0x2a, 0xd1, 0x4a, 0x52, 0x89, 0x02, 0x4b, 0x52, 0xd2, 0xa4, 0x7b, 0x45, 0x56, 0x21,
-	0x8a, 0x78, 0x09, 0x9e, 0xeb, 0x7f, 0x1a, 0xfc, 0xd4, 0x5d, 0x36, 0x82, 0x2e, 0x1b, 0xc5, 0xdf,
-	0xfb, 0xe1, 0x19, 0x1a, 0x3c, 0xe9, 0x62, 0x11, 0x1b, 0x64, 0x25, 0x3f, 0xdf, 0x3a, 0xc1, 0x32,
-	0x9c, 0xe0, 0x32, 0xb2, 0xe9, 0xfc, 0xe2, 0xa7, 0xf9, 0x8c, 0x97, 0xf7, 0x03, 0xfc, 0xa0, 0xcf,
-	0xa1, 0x46, 0x8c, 0x6e, 0x4f, 0x59, 0x83, 0xa7, 0x58, 0x36, 0xe0, 0x9b, 0xc8, 0x34, 0xb0, 0xf7,
-	0x3b, 0x61, 0x09, 0x53, 0xd1, 0xef, 0x36, 0x0c, 0x9c, 0x09, 0xb5, 0x4e, 0x58, 0x9a, 0xa0, 0xff,
-	0x38, 0x1a, 0x9d, 0x42, 0xd7, 0x61, 0x98, 0x66, 0x30, 0x4d, 0xd7, 0x8a, 0x98, 0x27, 0x98, 0x6f,
-	0xf1, 0x04, 0x73, 0x69, 0xd0, 0x04, 0x93, 0xc5, 0xf5, 0x55, 0x8a, 0x23, 0x85, 0x4a, 0x7c, 0x24,
-	0xfe, 0x3c, 0x08, 0xa3, 0x59, 0x6c, 0xaa, 0x75, 0xdc, 0xc9, 0xa2, 0xd6, 0xff, 0xa1, 0xdf, 0xdf,
-	0x80, 0x90, 0x11, 0xef, 0xe6, 0x29, 0xcd, 0x79, 0x7a, 0x71, 0x50, 0x9e, 0xa8, 0x0b, 0x39, 0x44,
-	0x85, 0x6f, 0xb6, 0x86, 0xdd, 0x07, 0x31, 0xf4, 0xb0, 0x0f, 0x02, 0x5d, 0x87, 0xc3, 0x1a, 0x26,
-	0x34, 0x2c, 0x83, 0x0c, 0xfb, 0xca, 0x51, 0x6f, 0x91, 0x55, 0x4c, 0xf2, 0x59, 0xbb, 0x19, 0x0f,
-	0xb2, 0x81, 0x14, 0xd4, 0x30, 0xc9, 0xf7, 0x8b, 0xfe, 0xe1, 0x47, 0x13, 0xfd, 0x23, 0x83, 0x46,
-	0xff, 0x5d, 0x3f, 0x44, 0x0b, 0x98, 0x48, 0xba, 0x4e, 0x4e, 0xc6, 0x17, 0x7b, 0xa9, 0xf0, 0x3f,
-	0x1a, 0x2a, 0x02, 0x83, 0x52, 0xf1, 0xcf, 0x10, 0x8c, 0xb5, 0xb7, 0x69, 0x9b, 0xd8, 0xa6, 0xe4,
-	0x07, 0x70, 0x42, 0x31, 0x8c, 0x9a, 0x5a, 0x64, 0xb5, 0xa4, 0xdc, 0xa1, 0xe7, 0x2b, 0x5e, 0x7a,
-	0xd2, 0x1d, 0x31, 0x37, 0x41, 0xa1, 0x4e, 0x1e, 0x53, 0xdc, 0x12, 0x34, 0x5e, 0xfb, 0x73, 0x74,
-	0x69, 0x2f, 0x33, 0x6d, 0x8a, 0xd1, 0xe9, 0x94, 0x70, 0x30, 0x47, 0x0f, 0x24, 0xe8, 0x99, 0xfd,
-	0x08, 0x1a, 0xeb, 0xe5, 0x01, 0xad, 0xc1, 0xa1, 0x9a, 0x6a, 0x11, 0x16, 0x75, 0xa3, 0xa9, 0x39,
-	0xaf, 0x75, 0xfb, 0x53, 0x94, 0x70, 0x59, 0xbb, 0xac, 0x5a, 0x64, 0xd1, 0x27, 0x31, 0x24, 0x54,
-	0x80, 0x41, 0x53, 0xd1, 0x2a, 0x98, 0x5f, 0x4e, 0x2f, 0x1d, 0x0d, 0x52, 0xa2, 0x10, 0x8b, 0x3e,
-	0xc9, 0xc1, 0xa2, 0x19, 0xa2, 0x6c, 0xea, 0x9b, 0x8e, 0x2d, 0xc3, 0x0c, 0xf8, 0xe5, 0xa3, 0x01,
-	0x5f, 0x31, 0xf5, 0x4d, 0x6a, 0xf9, 0xa2, 0x4f, 0x0a, 0x95, 0xf9, 0x38, 0xf6, 0x0f, 0x00, 0x27,
-	0x3c, 0xf6, 0x20, 0xd9, 0x55, 0x7d, 0x3a, 0x95, 0x71, 0xf6, 0xa1, 0x56, 0x9e, 0xe8, 0x06, 0x8c,
-	0x74, 0x9a, 0x05, 0xe6, 0x62, 0xfe, 0xa9, 0xc0, 0xa1, 0x23, 0xf0, 0x0c, 0x75, 0x30, 0x5a, 0xa2,
-	0x77, 0x66, 0xb3, 0x96, 0x34, 0x86, 0x3b, 0xb2, 0x56, 0xec, 0x3f, 0x00, 0x4e, 0x7a, 0x39, 0x3d,
-	0x79, 0xbb, 0x0c, 0x38, 0x6e, 0x11, 0xc5, 0x24, 0x72, 0x77, 0x7b, 0xb0, 0x7c, 0xdc, 0xda, 0x7d,
-	0xb4, 0x40, 0x51, 0x79, 0x8f, 0x30, 0x6a, 0xb5, 0x3e, 0xb6, 0xd4, 0x58, 0x1d, 0x9e, 0xee, 0x73,
-	0xc2, 0x27, 0x6e, 0xe9, 0x9c, 0x3f, 0x0a, 0x32, 0xe3, 0x70, 0xb4, 0x73, 0x8a, 0x96, 0xf8, 0x37,
-	0x3f, 0x7c, 0xd2, 0x95, 0x12, 0xd2, 0x45, 0xa2, 0xd6, 0xd9, 0xa8, 0x80, 0x09, 0xa1, 0x3b, 0xa2,
-	0xaf, 0xc1, 0x70, 0x15, 0x57, 0xe5, 0x9a, 0xb2, 0x8e, 0x6b, 0x4c, 0xad, 0x70, 0xe6, 0xcc, 0x5e,
-	0x26, 0x68, 0xf2, 0x96, 0x2b, 0xb4, 0x94, 0x5b, 0x5a, 0xa6, 0x73, 0x52, 0xa8, 0x8a, 0xab, 0x6c,
-	0x84, 0x9e, 0x87, 0x81, 0x2a, 0xae, 0x1e, 0xa6, 0x63, 0x18, 0xb1, 0x9b, 0xf1, 0xc0, 0x52, 0x6e,
-	0x49, 0xa2, 0x0b, 0x50, 0x19, 0x8e, 0x6e, 0xe8, 0x9b, 0x58, 0xe6, 0xb7, 0x9f, 0x73, 0x75, 0x5f,
-	0x39, 0xce, 0xcd, 0x17, 0x5e, 0xd4, 0x37, 0xb1, 0x73, 0xfb, 0x85, 0x37, 0xf8, 0xb0, 0x84, 0x24,
-	0xf8, 0x98, 0x3b, 0x5b, 0x3a, 0xef, 0x03, 0x9d, 0x32, 0x58, 0xd8, 0xcb, 0x0c, 0x99, 0xfe, 0x68,
-	0xc9, 0x6e, 0xc6, 0x4f, 0xbb, 0xb8, 0x29, 0x30, 0xb1, 0x7c, 0x56, 0x3a, 0xad, 0xf4, 0xfc, 0x2c,
-	0x89, 0x7f, 0x05, 0xf0, 0xfc, 0x02, 0x26, 0x07, 0x72, 0xf9, 0x08, 0xb2, 0xf5, 0x65, 0x08, 0x3b,
-	0x6f, 0x02, 0xfc, 0x04, 0x62, 0x3d, 0xd7, 0xcc, 0x15, 0x2a, 0xb2, 0xa2, 0x58, 0xd5, 0xcc, 0x10,
-	0x6b, 0x7f, 0xc2, 0xe5, 0xd6, 0x0f, 0xda, 0x7c, 0x9f, 0x2f, 0x7c, 0xf1, 0x76, 0x14, 0x60, 0xc8,
-	0xe2, 0xbb, 0x71, 0x2b, 0x66, 0x0f, 0xc0, 0xec, 0x55, 0xd1, 0x05, 0xdd, 0x06, 0xf2, 0x90, 0x13,
-	0x18, 0x9c, 0x9c, 0x9f, 0x02, 0x38, 0x93, 0xc5, 0x35, 0x4c, 0xf0, 0x17, 0xcc, 0x8f, 0xf8, 0x36,
-	0x80, 0xe3, 0x3c, 0xbe, 0xd7, 0x4c, 0x5c, 0x56, 0xdf, 0xe8, 0x7a, 0x7d, 0x00, 0x27, 0xf4, 0xfa,
-	0x80, 0x1e, 0x87, 0xc3, 0x35, 0xac, 0x55, 0xc8, 0x06, 0x3b, 0x94, 0x71, 0x89, 0x7f, 0x89, 0x12,
-	0x9c, 0xe8, 0xd2, 0x06, 0x53, 0xb2, 0x43, 0x06, 0x1f, 0x47, 0x01, 0xbb, 0x28, 0x9e, 0xf4, 0x5a,
-	0xdd, 0xb5, 0x84, 0xb3, 0xdd, 0x5e, 0x94, 0xfa, 0x0d, 0x80, 0x43, 0xab, 0xd6, 0x55, 0x0b, 0x2d,
-	0x40, 0xb8, 0xa8, 0x68, 0xa5, 0x1a, 0xa6, 0xf2, 0xe8, 0x6c, 0x3f, 0x14, 0xce, 0x78, 0xec, 0x5c,
-	0xff, 0x49, 0xde, 0x2d, 0x4a, 0x70, 0x74, 0x01, 0x93, 0xd6, 0xeb, 0x09, 0x7a, 0xca, 0x2b, 0xdc,
-	0xf3, 0xe8, 0x14, 0x9b, 0xf2, 0x8a, 0x78, 0x9f, 0x5e, 0x52, 0xaf, 0xc2, 0xa1, 0x34, 0x55, 0x72,
-	0x0d, 0x42, 0x27, 0xfc, 0xe9, 0xf4, 0x61, 0xa0, 0xe3, 0x7d, 0x7c, 0xc0, 0xfd, 0xa2, 0x91, 0xfa,
-	0xef, 0x10, 0x3c, 0xb3, 0xea, 0x1c, 0x56, 0x57, 0x27, 0x8a, 0xaa, 0x30, 0xe2, 0xb2, 0x79, 0x25,
-	0x3f, 0x8f, 0x06, 0x69, 0x5d, 0x63, 0xcf, 0x1e, 0x4e, 0x98, 0x73, 0x56, 0x74, 0xfc, 0xac, 0xdd,
-	0x46, 0xa3, 0xe9, 0x7e, 0x14, 0x7b, 0xbb, 0xec, 0x01, 0x37, 0xd1, 0xe0, 0xa9, 0x9c, 0x56, 0xa4,
-	0x12, 0x1d, 0xb0, 0x93, 0x34, 0xca, 0x80, 0xa7, 0xf9, 0x7e, 0x4e, 0xe7, 0x7d, 0xf2, 0x3b, 0xfe,
-	0x10, 0x46, 0x9c, 0xde, 0xba, 0xed, 0x7d, 0x17, 0xbc, 0xeb, 0xf7, 0xeb, 0xbd, 0x1f, 0xec, 0x84,
-	0x68, 0x19, 0x86, 0x1d, 0xc7, 0xa6, 0xbe, 0x27, 0x7a, 0xc5, 0x7b, 0x5b, 0xa8, 0xd8, 0x41, 0x97,
-	0x72, 0xea, 0x2f, 0x00, 0x46, 0x5d, 0x09, 0xa9, 0xdb, 0xf9, 0xae, 0xc1, 0x71, 0x47, 0xd1, 0x96,
-	0xab, 0x1f, 0xde, 0x8e, 0x07, 0x79, 0x3c, 0x37, 0x23, 0x6d, 0x18, 0x0f, 0xc5, 0x8c, 0x77, 0x86,
-	0xe1, 0xe9, 0xab, 0x56, 0xbb, 0xdc, 0x94, 0x70, 0x45, 0xb5, 0x88, 0xd9, 0x40, 0x7f, 0x04, 0x30,
-	0xb0, 0x80, 0x09, 0x7a, 0xba, 0xcf, 0x06, 0x2e, 0x69, 0x67, 0x87, 0x2f, 0xed, 0x5b, 0xdc, 0x8a,
-	0xd5, 0x3b, 0x7f, 0xff, 0xf7, 0x2f, 0xfd, 0x18, 0x15, 0x93, 0x37, 0xad, 0xa4, 0x2b, 0x3d, 0x5b,
-	0xc9, 0xb7, 0xba, 0xeb, 0xe4, 0x84, 0xe7, 0x12, 0xf0, 0x7c, 0xdf, 0x4a, 0xf2, 0x62, 0xac, 0x67,
-	0x5d, 0x7b, 0x78, 0x0b, 0xfd, 0xc4, 0x0f, 0x03, 0x85, 0x7e, 0x4a, 0x17, 0x06, 0x53, 0xfa, 0xcf,
-	0x80, 0x69, 0xfd, 0x27, 0x10, 0x3b, 0x50, 0xed, 0xc4, 0x11, 0xd5, 0x4e, 0x74, 0xab, 0x3d, 0x07,
-	0x66, 0xae, 0xad, 0x88, 0x8b, 0x0f, 0x6b, 0xa7, 0x39, 0x30, 0x83, 0x7e, 0x07, 0x60, 0xb8, 0xdd,
-	0x39, 0xa1, 0x99, 0xc3, 0x37, 0x55, 0x07, 0xb1, 0xf2, 0x1d, 0x46, 0xca, 0x62, 0x6c, 0xbe, 0x57,
-	0xd3, 0x07, 0xa9, 0xd6, 0xee, 0x50, 0x67, 0x3b, 0x4a, 0xde, 0xf5, 0x83, 0xe7, 0x00, 0x7a, 0x07,
-	0xc0, 0x61, 0xa7, 0x58, 0x40, 0x87, 0x6a, 0x91, 0x62, 0x8f, 0xf7, 0x94, 0x22, 0xb9, 0x4d, 0x83,
-	0x34, 0xc4, 0x15, 0xa6, 0xdd, 0xc2, 0x4c, 0x6e, 0x70, 0xed, 0xda, 0x47, 0xd4, 0x39, 0x93, 0xd4,
-	0x07, 0x43, 0x70, 0xfa, 0xa0, 0xf2, 0xa5, 0x1d, 0x28, 0x7f, 0xe0, 0x81, 0xf2, 0x42, 0x9f, 0x40,
-	0x39, 0x4c, 0xfd, 0x13, 0x1b, 0xac, 0x64, 0x13, 0x33, 0xcc, 0xce, 0x6f, 0xa2, 0xb9, 0xc1, 0xed,
-	0x6c, 0x97, 0x78, 0x1f, 0x00, 0x27, 0x4e, 0x5e, 0xe8, 0x13, 0x27, 0x27, 0xa1, 0x73, 0x8e, 0xe9,
-	0x7c, 0x59, 0x3c, 0x86, 0xce, 0xd4, 0xab, 0xdf, 0xed, 0xf8, 0xca, 0x5c, 0x6f, 0x3e, 0x3d, 0x6c,
-	0xc1, 0xb9, 0xaf, 0x07, 0x71, 0x66, 0x67, 0x8e, 0xa1, 0x65, 0xca, 0x84, 0xfe, 0xab, 0x16, 0xaa,
-	0xb1, 0x17, 0x39, 0x6f, 0xad, 0xb7, 0xcf, 0xbe, 0xbd, 0x19, 0xdf, 0xb3, 0x50, 0x7c, 0x92, 0x29,
-	0xf6, 0x04, 0x7a, 0x8c, 0x2a, 0xd6, 0x2a, 0x5f, 0xe5, 0x56, 0x09, 0x98, 0xf9, 0x2d, 0xb8, 0xb7,
-	0x23, 0x80, 0xfb, 0x3b, 0x02, 0xf8, 0x78, 0x47, 0xf0, 0x7d, 0xb2, 0x23, 0xf8, 0x3e, 0xdd, 0x11,
-	0x7c, 0x9f, 0xed, 0x08, 0xbe, 0xcf, 0x77, 0x04, 0x70, 0xdb, 0x16, 0xc0, 0x5d, 0x5b, 0xf0, 0xbd,
-	0x67, 0x0b, 0xe0, 0x7d, 0x5b, 0xf0, 0x7d, 0x68, 0x0b, 0xbe, 0x8f, 0x6c, 0xc1, 0x77, 0xcf, 0x16,
-	0xc0, 0x7d, 0x5b, 0x00, 0x1f, 0xdb, 0x82, 0xef, 0x13, 0x5b, 0x00, 0x9f, 0xda, 0x82, 0xef, 0x33,
-	0x5b, 0x00, 0x9f, 0xdb, 0x82, 0xef, 0xf6, 0xae, 0xe0, 0xbb, 0xbb, 0x2b, 0x80, 0x5f, 0xec, 0x0a,
-	0xbe, 0x5f, 0xed, 0x0a, 0xe0, 0xdd, 0x5d, 0xc1, 0xf7, 0xde, 0xae, 0xe0, 0x7b, 0x7f, 0x57, 0x00,
-	0x1f, 0xee, 0x0a, 0xe0, 0xa3, 0x5d, 0x01, 0x5c, 0x4b, 0x0e, 0x50, 0x30, 0x13, 0xcd, 0x58, 0x5f,
-	0x1f, 0x66, 0x76, 0x5f, 0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0x93, 0x1e, 0x2c, 0x81,
-	0x1e, 0x00, 0x00,
+	// 2188 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xe6, 0x90, 0xfa, 0x21, 0x47, 0xbf, 0x1e, 0x3b, 0x09, 0x4b, 0xdb, 0x4b, 0x65, 0xa3, 0xd6,
+	0xae, 0x12, 0x91, 0x29, 0xdd, 0x26, 0x8e, 0x52, 0xc4, 0x21, 0x4d, 0x5a, 0xa2, 0x29, 0xa9, 0xea,
+	0xb2, 0x69, 0x53, 0xa7, 0xce, 0x7a, 0x45, 0x0e, 0xa9, 0x35, 0xa9, 0xdd, 0xed, 0xee, 0x88, 0x36,
+	0x93, 0x1a, 0x30, 0x7c, 0x68, 0x8d, 0x22, 0x40, 0x0b, 0x14, 0x05, 0x8a, 0x9c, 0x0c, 0x14, 0x45,
+	0x73, 0x6b, 0x5a, 0x04, 0x68, 0x4e, 0x45, 0x0e, 0x6d, 0xe1, 0xa3, 0x81, 0x36, 0x40, 0xd0, 0x03,
+	0x11, 0xad, 0x5a, 0x20, 0xc7, 0x9c, 0x8a, 0x40, 0xa7, 0x62, 0x66, 0x87, 0xe4, 0x72, 0x49, 0xca,
+	0xa2, 0x2c, 0x39, 0xe8, 0x6d, 0x86, 0xf3, 0xe6, 0xbd, 0xef, 0x7d, 0xf3, 0xe6, 0xcd, 0x7b, 0x4b,
+	0x28, 0x56, 0x75, 0x53, 0xb9, 0xa1, 0x68, 0xf3, 0x16, 0x51, 0x0a, 0x95, 0xb8, 0x62, 0xa8, 0xf1,
+	0xeb, 0xba, 0xaa, 0x59, 0xd8, 0xac, 0x61, 0x33, 0x66, 0x98, 0x3a, 0xd1, 0xd1, 0x24, 0x21, 0x5a,
+	0x8c, 0xcb, 0xc5, 0x6a, 0xe7, 0x22, 0xc9, 0xb2, 0x4a, 0x36, 0xb6, 0xd6, 0x63, 0x05, 0x7d, 0x33,
+	0x8e, 0xb5, 0x9a, 0x5e, 0x37, 0x4c, 0xfd, 0x66, 0x3d, 0xce, 0x84, 0x0b, 0xf3, 0x65, 0xac, 0xcd,
+	0xd7, 0x94, 0xaa, 0x5a, 0x54, 0x08, 0x8e, 0x77, 0x0d, 0x1c, 0x95, 0x91, 0x79, 0x97, 0x8a, 0xb2,
+	0x5e, 0xd6, 0x9d, 0xcd, 0xeb, 0x5b, 0x25, 0x36, 0x63, 0x13, 0x36, 0xe2, 0xe2, 0xa7, 0xca, 0xba,
+	0x5e, 0xae, 0x62, 0x06, 0x4f, 0xd1, 0x34, 0x9d, 0x28, 0x44, 0xd5, 0x35, 0x8b, 0xaf, 0x9e, 0xe4,
+	0xab, 0x2d, 0x1d, 0x78, 0xd3, 0x20, 0x75, 0xbe, 0x38, 0xe3, 0x5d, 0x2c, 0xa9, 0xb8, 0x5a, 0x94,
+	0x37, 0x15, 0xab, 0xe2, 0x51, 0xde, 0x92, 0xb0, 0x88, 0xb9, 0x55, 0x20, 0x7c, 0xb5, 0x07, 0x41,
+	0x58, 0x2b, 0xca, 0x45, 0x5c, 0x53, 0x0b, 0x4d, 0x6f, 0x9e, 0xe9, 0x96, 0x51, 0x8b, 0x58, 0x23,
+	0x6a, 0x49, 0xc5, 0x66, 0x13, 0xe5, 0xa9, 0xde, 0x4c, 0xf7, 0x5f, 0xad, 0xe0, 0x7a, 0x73, 0x6f,
+	0xb4, 0x7b, 0xb5, 0x79, 0x1e, 0x4c, 0x40, 0x7c, 0xd7, 0x0f, 0x8f, 0xe5, 0xb1, 0x65, 0xa9, 0xba,
+	0x96, 0xc3, 0x75, 0x09, 0xff, 0x78, 0x0b, 0x5b, 0x04, 0xbd, 0x02, 0x27, 0x2d, 0xe7, 0x47, 0xb9,
+	0x82, 0xeb, 0xb2, 0x5a, 0x0c, 0x83, 0x19, 0x70, 0x76, 0x3c, 0x15, 0xde, 0x4d, 0x0d, 0xbf, 0x15,
+	0x08, 0xdf, 0x9e, 0xb6, 0x1b, 0xd1, 0xf1, 0xf6, 0xb6, 0x6c, 0x5a, 0x1a, 0xb7, 0xda, 0xb3, 0x22,
+	0xba, 0x06, 0x47, 0x8b, 0xb8, 0x26, 0xe3, 0x2d, 0x35, 0xec, 0x67, 0x1b, 0x17, 0xef, 0x37, 0xa2,
+	0xbe, 0x7f, 0x35, 0xa2, 0xdf, 0x2a, 0xeb, 0x31, 0xb2, 0x81, 0xc9, 0x86, 0xaa, 0x95, 0xad, 0x98,
+	0x86, 0xc9, 0x0d, 0xdd, 0xac, 0xc4, 0x3b, 0x41, 0xd6, 0xce, 0xc5, 0x8d, 0x4a, 0x39, 0x4e, 0xea,
+	0x06, 0xb6, 0x62, 0x99, 0xd7, 0xb2, 0x2f, 0x7c, 0xd3, 0x6e, 0x44, 0x47, 0xd2, 0xb8, 0x96, 0x79,
+	0x2d, 0x2b, 0x8d, 0x14, 0x71, 0x2d, 0xb3, 0xa5, 0xa2, 0x02, 0x0c, 0x52, 0x12, 0x98, 0x89, 0x00,
+	0x33, 0xb1, 0xf4, 0xa8, 0x26, 0x46, 0x2f, 0xeb, 0xaa, 0x46, 0x6d, 0x8c, 0x52, 0xcd, 0x99, 0x2d,
+	0x55, 0xbc, 0xe3, 0x87, 0xd3, 0xab, 0x37, 0x2a, 0xf9, 0x1c, 0xae, 0x5b, 0x12, 0xb6, 0x0c, 0x5d,
+	0xb3, 0x30, 0xfa, 0x0e, 0x9c, 0x2a, 0xc9, 0xda, 0x8d, 0x8a, 0x6c, 0xc9, 0xaa, 0x46, 0x28, 0x3f,
+	0x8c, 0x9c, 0xb1, 0xc4, 0xc9, 0x58, 0x67, 0xb8, 0xc7, 0x72, 0xb8, 0x9e, 0xd1, 0x6a, 0xb8, 0xaa,
+	0x1b, 0x38, 0x35, 0xbe, 0x9b, 0x1a, 0xfe, 0x39, 0xf0, 0x4f, 0x03, 0x8a, 0x52, 0x1a, 0x2b, 0x51,
+	0xb5, 0x59, 0x8d, 0xe4, 0x70, 0x9d, 0x2a, 0xb4, 0x3c, 0x0a, 0xfd, 0x03, 0x2b, 0xb4, 0x5c, 0x0a,
+	0x97, 0xe1, 0x84, 0xa3, 0x0e, 0x6b, 0x05, 0xa6, 0x2e, 0x30, 0xa8, 0x3a, 0xa8, 0xdd, 0xa8, 0xe4,
+	0x33, 0x5a, 0x21, 0x87, 0xeb, 0xe2, 0xeb, 0x70, 0x2a, 0x69, 0x18, 0x79, 0x16, 0x1d, 0x9c, 0x82,
+	0x0c, 0x0c, 0x29, 0x86, 0x21, 0x5b, 0x07, 0x73, 0x7e, 0x54, 0x71, 0xd4, 0x89, 0xef, 0x04, 0xe0,
+	0xc9, 0x8b, 0x66, 0xdd, 0x20, 0x7a, 0x1e, 0x9b, 0xf4, 0x56, 0xac, 0x29, 0xf5, 0xaa, 0xae, 0x14,
+	0x9b, 0x51, 0xb8, 0x04, 0x03, 0x6a, 0xd1, 0xe2, 0x06, 0x66, 0xbd, 0x06, 0x32, 0x5a, 0x31, 0xcd,
+	0xee, 0x52, 0xb6, 0x7d, 0x63, 0x52, 0xd3, 0x6e, 0x4b, 0x0f, 0x1a, 0x51, 0x20, 0x51, 0x15, 0x48,
+	0x86, 0x53, 0x7c, 0xa7, 0x5c, 0xc3, 0x26, 0x8d, 0x53, 0x46, 0xf1, 0x64, 0x22, 0xe2, 0xd5, 0xba,
+	0x92, 0xbc, 0xf8, 0x7d, 0x47, 0x22, 0x15, 0xd9, 0x4d, 0x0d, 0xdf, 0xa1, 0xba, 0xec, 0x46, 0x74,
+	0x72, 0x59, 0x97, 0x94, 0x1f, 0x24, 0x57, 0xf9, 0x9a, 0x34, 0xc9, 0xb7, 0xf0, 0x39, 0x0a, 0xc3,
+	0x51, 0xc3, 0x01, 0xef, 0x44, 0xa3, 0xd4, 0x9c, 0xa2, 0x75, 0x38, 0x69, 0x98, 0x7a, 0x4d, 0xa5,
+	0x62, 0xd8, 0xa4, 0x57, 0x69, 0x68, 0x06, 0x9c, 0x0d, 0xa5, 0x5e, 0xde, 0x4d, 0x9d, 0x31, 0xbf,
+	0x1a, 0x9e, 0x4d, 0x3c, 0xfd, 0xe6, 0x1b, 0xca, 0xfc, 0x5b, 0xcf, 0xcf, 0xbf, 0x74, 0xf5, 0xec,
+	0x85, 0x85, 0x37, 0xe6, 0xaf, 0x5e, 0x68, 0x4e, 0xbf, 0xfe, 0x76, 0xe2, 0xb9, 0x5b, 0xb3, 0x3f,
+	0x79, 0x73, 0xd6, 0x6e, 0x44, 0x27, 0xd6, 0xda, 0x3a, 0xb2, 0x69, 0x69, 0xc2, 0xa5, 0x32, 0x5b,
+	0x44, 0x69, 0x78, 0xac, 0xf5, 0x83, 0xaa, 0x95, 0xe5, 0xa2, 0x42, 0x94, 0xf0, 0x30, 0xa3, 0xed,
+	0xa9, 0x98, 0x93, 0xa4, 0x62, 0xcd, 0x24, 0x15, 0xcb, 0xb3, 0x24, 0x25, 0x4d, 0xbb, 0x77, 0xa4,
+	0x15, 0xa2, 0x88, 0xe7, 0xe1, 0xa9, 0xde, 0xa7, 0xc1, 0x4f, 0xdd, 0xe5, 0x23, 0xe8, 0xf0, 0x51,
+	0xfc, 0x83, 0x1f, 0x9e, 0xa0, 0x97, 0x27, 0x59, 0x28, 0x60, 0x83, 0xac, 0x64, 0x2f, 0x36, 0x4f,
+	0xb0, 0x04, 0xa7, 0xb8, 0x8c, 0x6c, 0x3a, 0x3f, 0xf1, 0xd3, 0x7c, 0xd6, 0xcb, 0xfb, 0x1e, 0x71,
+	0xd0, 0xe3, 0x50, 0x27, 0x8d, 0xce, 0x48, 0x59, 0x83, 0xc7, 0x58, 0x36, 0xe0, 0x46, 0x64, 0x7a,
+	0xb1, 0xfb, 0x9d, 0xb0, 0x84, 0xa9, 0xe8, 0xf7, 0xea, 0x06, 0x4e, 0x05, 0x9b, 0x27, 0x2c, 0x4d,
+	0xd1, 0xdf, 0xb8, 0x36, 0xba, 0x84, 0xae, 0xc2, 0x10, 0xcd, 0x60, 0x9a, 0xae, 0x15, 0x30, 0x4f,
+	0x30, 0xaf, 0xf2, 0x04, 0x73, 0x7e, 0xd0, 0x04, 0x93, 0xc6, 0xb5, 0x55, 0xaa, 0x47, 0x0a, 0x16,
+	0xf9, 0x48, 0xfc, 0xc5, 0x30, 0x0c, 0xa7, 0xb1, 0xa9, 0xd6, 0x70, 0x3b, 0x8b, 0x5a, 0xff, 0x87,
+	0x71, 0x7f, 0x0d, 0x42, 0x46, 0xbc, 0x9b, 0xa7, 0x24, 0xe7, 0xe9, 0xa5, 0x41, 0x79, 0xa2, 0x21,
+	0xe4, 0x10, 0x15, 0xba, 0xde, 0x1c, 0x76, 0x1e, 0xc4, 0xd0, 0x61, 0x1f, 0x04, 0xba, 0x0a, 0x47,
+	0x34, 0x4c, 0xe8, 0xb5, 0x1c, 0x66, 0xba, 0x2f, 0x1d, 0xf4, 0x15, 0x59, 0xc5, 0x24, 0x9b, 0xb6,
+	0x1b, 0xd1, 0x61, 0x36, 0x90, 0x86, 0x35, 0x4c, 0xb2, 0xbd, 0x6e, 0xff, 0xc8, 0xe3, 0xb9, 0xfd,
+	0xa3, 0x83, 0xde, 0xfe, 0xbb, 0x7e, 0x88, 0x16, 0x31, 0x91, 0x74, 0x9d, 0x1c, 0x4d, 0x2c, 0x76,
+	0x53, 0xe1, 0x7f, 0x3c, 0x54, 0x04, 0x06, 0xa5, 0xe2, 0xe3, 0x20, 0x8c, 0xb4, 0xcc, 0xb4, 0x5c,
+	0x6c, 0x51, 0xf2, 0x43, 0x38, 0xa5, 0x18, 0x46, 0x55, 0x2d, 0xb0, 0x5a, 0x52, 0x6e, 0xd3, 0xf3,
+	0x35, 0x2f, 0x3d, 0xc9, 0xb6, 0x98, 0x9b, 0xa0, 0x60, 0x3b, 0x8f, 0x29, 0x6e, 0x09, 0x7a, 0x5f,
+	0x7b, 0x73, 0x74, 0x7e, 0x37, 0x35, 0x6b, 0x8a, 0xe1, 0xd9, 0x84, 0xb0, 0x37, 0x47, 0x0f, 0x25,
+	0xe8, 0xd9, 0x7e, 0x04, 0x8d, 0x77, 0xf3, 0x80, 0xd6, 0xe0, 0x50, 0x55, 0xb5, 0x08, 0xbb, 0x75,
+	0x63, 0x89, 0x05, 0xaf, 0x77, 0xfd, 0x29, 0x8a, 0xb9, 0xbc, 0x5d, 0x56, 0x2d, 0xb2, 0xe4, 0x93,
+	0x98, 0x26, 0x94, 0x87, 0xc3, 0xa6, 0xa2, 0x95, 0x31, 0x7f, 0x9c, 0x5e, 0x3e, 0x98, 0x4a, 0x89,
+	0xaa, 0x58, 0xf2, 0x49, 0x8e, 0x2e, 0x9a, 0x21, 0x4a, 0xa6, 0xbe, 0xe9, 0xf8, 0x32, 0xc2, 0x14,
+	0xbf, 0x72, 0x30, 0xc5, 0x97, 0x4c, 0x7d, 0x93, 0x7a, 0xbe, 0xe4, 0x93, 0x82, 0x25, 0x3e, 0x8e,
+	0xfc, 0x13, 0xc0, 0x29, 0x8f, 0x3f, 0x48, 0x76, 0x55, 0x9f, 0x4e, 0x65, 0x9c, 0x3e, 0xd4, 0xca,
+	0x13, 0x5d, 0x83, 0x93, 0xed, 0x66, 0x81, 0x85, 0x98, 0x7f, 0x26, 0xb0, 0xef, 0x1b, 0x78, 0x82,
+	0x06, 0x18, 0x2d, 0xd1, 0xdb, 0xab, 0x69, 0x4b, 0x1a, 0xc7, 0x6d, 0x59, 0x2b, 0xf2, 0x1f, 0x00,
+	0xa7, 0xbd, 0x9c, 0x1e, 0xbd, 0x5f, 0x06, 0x9c, 0xb0, 0x88, 0x62, 0x12, 0xb9, 0xb3, 0x3d, 0x58,
+	0x7e, 0xd4, 0xda, 0x7d, 0x2c, 0x4f, 0xb5, 0xf2, 0x1e, 0x61, 0xcc, 0x6a, 0x4e, 0xb6, 0xd4, 0x48,
+	0x0d, 0x1e, 0xef, 0x71, 0xc2, 0x47, 0xee, 0xe9, 0x82, 0x3f, 0x0c, 0x52, 0x13, 0x70, 0xac, 0x7d,
+	0x8a, 0x96, 0xf8, 0x77, 0x3f, 0x3c, 0xed, 0x4a, 0x09, 0xc9, 0x02, 0x51, 0x6b, 0x6c, 0x94, 0xc7,
+	0x84, 0x50, 0x8b, 0xe8, 0x1b, 0x30, 0x54, 0xc1, 0x15, 0xb9, 0xaa, 0xac, 0xe3, 0x2a, 0x83, 0x15,
+	0x4a, 0x9d, 0xd8, 0x4d, 0x0d, 0x9b, 0xbc, 0xe5, 0x0a, 0xe6, 0x32, 0xb9, 0x65, 0xba, 0x26, 0x05,
+	0x2b, 0xb8, 0xc2, 0x46, 0xe8, 0x05, 0x18, 0xa8, 0xe0, 0xca, 0x7e, 0x3a, 0x86, 0x51, 0xbb, 0x11,
+	0x0d, 0xe4, 0x32, 0x39, 0x89, 0x6e, 0x40, 0x25, 0x38, 0xb6, 0xa1, 0x6f, 0x62, 0x99, 0xbf, 0x7e,
+	0xce, 0xd3, 0x7d, 0xe9, 0x51, 0x5e, 0xbe, 0xd0, 0x92, 0xbe, 0x89, 0xd9, 0x44, 0x0a, 0x6d, 0xf0,
+	0x61, 0x11, 0x49, 0xf0, 0x09, 0x77, 0xb6, 0x74, 0xbe, 0x0f, 0xb4, 0xcb, 0x60, 0x61, 0x37, 0x35,
+	0x64, 0xfa, 0xc3, 0x45, 0xbb, 0x11, 0x3d, 0xee, 0xe2, 0x26, 0xcf, 0xc4, 0xb2, 0x69, 0xe9, 0xb8,
+	0xd2, 0xf5, 0x63, 0x51, 0xfc, 0x1b, 0x80, 0x67, 0x16, 0x31, 0xd9, 0x93, 0xcb, 0xc7, 0x90, 0xad,
+	0x2f, 0x40, 0xd8, 0xfe, 0x26, 0xc0, 0x4f, 0x20, 0xd2, 0xf5, 0xcc, 0x5c, 0xa2, 0x22, 0x2b, 0x8a,
+	0x55, 0x49, 0x0d, 0xb1, 0xf6, 0x27, 0x54, 0x6a, 0xfe, 0x40, 0x9b, 0xef, 0x33, 0xf9, 0x2f, 0xdf,
+	0x8f, 0x3c, 0x0c, 0x5a, 0xdc, 0x1a, 0xf7, 0x62, 0x7e, 0x0f, 0x9d, 0xdd, 0x10, 0x5d, 0xaa, 0x5b,
+	0x8a, 0x3c, 0xe4, 0x04, 0x06, 0x27, 0xe7, 0x67, 0x00, 0xce, 0xa5, 0x71, 0x15, 0x13, 0xfc, 0x25,
+	0xf3, 0x23, 0xbe, 0x03, 0xe0, 0x04, 0xbf, 0xdf, 0x6b, 0x26, 0x2e, 0xa9, 0x37, 0x3b, 0xbe, 0x3e,
+	0x80, 0x23, 0xfa, 0xfa, 0x80, 0x9e, 0x84, 0x23, 0x55, 0xac, 0x95, 0xc9, 0x06, 0x3b, 0x94, 0x09,
+	0x89, 0xcf, 0x44, 0x09, 0x4e, 0x75, 0xa0, 0xc1, 0x94, 0xec, 0xa0, 0xc1, 0xc7, 0x61, 0xc0, 0x1e,
+	0x8a, 0xd3, 0x5e, 0xaf, 0x3b, 0xb6, 0x70, 0xb6, 0x5b, 0x9b, 0x12, 0xbf, 0x05, 0x70, 0x68, 0xd5,
+	0xba, 0x6c, 0xa1, 0x45, 0x08, 0x97, 0x14, 0xad, 0x58, 0xc5, 0x54, 0x1e, 0x9d, 0xec, 0xa5, 0x85,
+	0x33, 0x1e, 0x39, 0xd5, 0x7b, 0x91, 0x77, 0x8b, 0x12, 0x1c, 0x5b, 0xc4, 0xa4, 0xf9, 0xf5, 0x04,
+	0x3d, 0xed, 0x15, 0xee, 0xfa, 0xe8, 0x14, 0x99, 0xf1, 0x8a, 0x78, 0x3f, 0xbd, 0x24, 0x5e, 0x87,
+	0x43, 0x49, 0x0a, 0x72, 0x0d, 0x42, 0xe7, 0xfa, 0xd3, 0xe5, 0xfd, 0xa8, 0x8e, 0xf6, 0x88, 0x01,
+	0xf7, 0x17, 0x8d, 0xc4, 0x7f, 0x87, 0xe0, 0x89, 0x55, 0xe7, 0xb0, 0x3a, 0x3a, 0x51, 0x54, 0x81,
+	0x93, 0x2e, 0x9f, 0x57, 0xb2, 0x17, 0xd1, 0x20, 0xad, 0x6b, 0xe4, 0xb9, 0xfd, 0x09, 0x73, 0xce,
+	0x0a, 0x70, 0xa2, 0xa3, 0x8d, 0x46, 0xb3, 0xbd, 0x28, 0xf6, 0x76, 0xd9, 0x03, 0x1a, 0xd1, 0xe0,
+	0xb1, 0x8c, 0x56, 0xa0, 0x12, 0x6d, 0x65, 0x47, 0xe9, 0x94, 0x01, 0x8f, 0x73, 0x7b, 0x4e, 0xe7,
+	0x7d, 0xf4, 0x16, 0x7f, 0x04, 0x27, 0x9d, 0xde, 0xba, 0x15, 0x7d, 0x67, 0xbd, 0xfb, 0xfb, 0xf5,
+	0xde, 0x0f, 0x0f, 0x42, 0xb4, 0x0c, 0x43, 0x4e, 0x60, 0xd3, 0xd8, 0x13, 0xbd, 0xe2, 0xdd, 0x2d,
+	0x54, 0x64, 0xaf, 0x47, 0x39, 0xf1, 0x57, 0x00, 0xc3, 0xae, 0x84, 0xd4, 0x19, 0x7c, 0x57, 0xe0,
+	0x84, 0x03, 0xb4, 0x19, 0xea, 0xfb, 0xf7, 0xe3, 0x61, 0x11, 0xcf, 0xdd, 0x48, 0x1a, 0xc6, 0xa1,
+	0xb8, 0xf1, 0x71, 0x10, 0x1e, 0xbf, 0x6c, 0xb5, 0xca, 0x4d, 0x09, 0x97, 0x55, 0x8b, 0x98, 0x75,
+	0xf4, 0x27, 0x00, 0x03, 0x8b, 0x98, 0xa0, 0x67, 0x7a, 0x18, 0x70, 0x49, 0x3b, 0x16, 0xbe, 0xd2,
+	0xb7, 0xb8, 0x15, 0x2b, 0x77, 0xfe, 0xf1, 0xef, 0x5f, 0xf9, 0x31, 0x2a, 0xc4, 0xaf, 0x5b, 0x71,
+	0x57, 0x7a, 0xb6, 0xe2, 0x6f, 0x77, 0xd6, 0xc9, 0x31, 0xcf, 0x23, 0xe0, 0x99, 0xdf, 0x8a, 0x3b,
+	0xa2, 0xdd, 0xfb, 0x5a, 0xc3, 0x5b, 0xe8, 0xa7, 0x7e, 0x18, 0xc8, 0xf7, 0x02, 0x9d, 0x1f, 0x0c,
+	0xf4, 0x5f, 0x00, 0x43, 0xfd, 0x67, 0x10, 0xd9, 0x13, 0x76, 0xec, 0x80, 0xb0, 0x63, 0x9d, 0xb0,
+	0x17, 0xc0, 0xdc, 0x95, 0x15, 0x71, 0xe9, 0xb0, 0x2c, 0x2d, 0x80, 0x39, 0xf4, 0x7b, 0x00, 0x43,
+	0xad, 0xce, 0x09, 0xcd, 0xed, 0xbf, 0xa9, 0xda, 0x8b, 0x95, 0xef, 0xde, 0xf5, 0x3b, 0xbc, 0x2c,
+	0x45, 0x2e, 0x76, 0x83, 0x7d, 0x18, 0xba, 0x56, 0x93, 0x3a, 0xdf, 0xc6, 0xf9, 0x3c, 0x40, 0xbf,
+	0x06, 0x70, 0xc4, 0x29, 0x16, 0xd0, 0xbe, 0x5a, 0xa4, 0xc8, 0x93, 0x5d, 0xa5, 0x48, 0x86, 0xfe,
+	0xf7, 0x23, 0xae, 0x30, 0x68, 0x8b, 0x73, 0x99, 0xc1, 0xa1, 0xb5, 0x8e, 0xc8, 0x15, 0x4a, 0xf7,
+	0x00, 0x1c, 0xc9, 0xdc, 0x34, 0x74, 0x93, 0xa0, 0x33, 0x5d, 0xb8, 0xd8, 0xef, 0xdd, 0xdc, 0xcd,
+	0xf4, 0x75, 0x20, 0x69, 0x16, 0x36, 0xd4, 0x1a, 0x16, 0x73, 0x0c, 0x64, 0x46, 0x7c, 0xf5, 0xe0,
+	0x20, 0x31, 0x33, 0x4e, 0x0f, 0xf9, 0x5d, 0x00, 0x47, 0xb2, 0x9b, 0xbd, 0x21, 0x3a, 0xbf, 0x77,
+	0x43, 0x8c, 0xf4, 0x85, 0x68, 0x1d, 0x06, 0x38, 0x75, 0x93, 0x83, 0x4b, 0x7c, 0x30, 0x04, 0x67,
+	0xf7, 0x2a, 0xff, 0x5a, 0x89, 0xe6, 0x8f, 0x3c, 0xd1, 0xbc, 0xd8, 0x23, 0xd1, 0xec, 0xa7, 0x7e,
+	0x8c, 0x0c, 0x56, 0xf2, 0x8a, 0x29, 0xe6, 0xe5, 0xb7, 0xd1, 0xc2, 0xe0, 0x5e, 0xb6, 0x4a, 0xe4,
+	0x0f, 0x80, 0x93, 0x67, 0x5e, 0xec, 0x91, 0x67, 0x8e, 0x02, 0x73, 0x86, 0x61, 0xbe, 0x20, 0x3e,
+	0x02, 0x66, 0x1a, 0x30, 0xf7, 0xda, 0x77, 0x6d, 0xa1, 0xfb, 0x3d, 0xda, 0x6f, 0xc1, 0xde, 0xf7,
+	0x06, 0x72, 0x66, 0xe7, 0x1e, 0x01, 0x65, 0xc2, 0x84, 0xfe, 0xcb, 0x16, 0xaa, 0xb2, 0x2f, 0x9a,
+	0xde, 0x5a, 0xb9, 0x8f, 0xdd, 0xee, 0x17, 0xd3, 0xb3, 0x51, 0x3c, 0xcd, 0x80, 0x3d, 0x85, 0x9e,
+	0xa0, 0xc0, 0x9a, 0xe5, 0xbf, 0xdc, 0x2c, 0xa1, 0x53, 0xbf, 0x03, 0x57, 0xe2, 0x03, 0x14, 0xff,
+	0x44, 0x33, 0xd6, 0xef, 0x6f, 0x0b, 0xe0, 0xc1, 0xb6, 0x00, 0x3e, 0xd9, 0x16, 0x7c, 0x9f, 0x6e,
+	0x0b, 0xbe, 0xcf, 0xb6, 0x05, 0xdf, 0xe7, 0xdb, 0x82, 0xef, 0x8b, 0x6d, 0x01, 0xdc, 0xb6, 0x05,
+	0x70, 0xd7, 0x16, 0x7c, 0xef, 0xd9, 0x02, 0x78, 0xdf, 0x16, 0x7c, 0x1f, 0xda, 0x82, 0xef, 0x23,
+	0x5b, 0xf0, 0xdd, 0xb7, 0x05, 0xf0, 0xc0, 0x16, 0xc0, 0x27, 0xb6, 0xe0, 0xfb, 0xd4, 0x16, 0xc0,
+	0x67, 0xb6, 0xe0, 0xfb, 0xdc, 0x16, 0xc0, 0x17, 0xb6, 0xe0, 0xbb, 0xbd, 0x23, 0xf8, 0xee, 0xee,
+	0x08, 0xe0, 0x97, 0x3b, 0x82, 0xef, 0x37, 0x3b, 0x02, 0xb8, 0xb7, 0x23, 0xf8, 0xde, 0xdb, 0x11,
+	0x7c, 0xef, 0xef, 0x08, 0xe0, 0xc3, 0x1d, 0x01, 0x7c, 0xb4, 0x23, 0x80, 0xf5, 0x11, 0xe6, 0xf7,
+	0xb9, 0xff, 0x0d, 0x00, 0xa4, 0x91, 0x22, 0x28, 0xc1, 0x1f, 0x00, 0x00,
 }
 
 func (this *SessionKeyRequest) Equal(that interface{}) bool {
@@ -2400,6 +2403,13 @@ type JsEndDeviceRegistryClient interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Export returns an archive of the stored state of the given end devices,
+	// including their MAC state, sessions and queues.
+	Export(ctx context.Context, in *ExportEndDevicesRequest, opts ...grpc.CallOption) (*EndDeviceArchive, error)
+	// Import restores the end devices in the given archive.
+	// Existing end devices are replaced by the archived ones.
+	// The identifiers of the imported end devices are returned.
+	Import(ctx context.Context, in *ImportEndDevicesRequest, opts ...grpc.CallOption) (*EndDevices, error)
 }
 
 type jsEndDeviceRegistryClient struct {
@@ -2470,6 +2480,24 @@ func (c *jsEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIde
 	return out, nil
 }
 
+func (c *jsEndDeviceRegistryClient) Export(ctx context.Context, in *ExportEndDevicesRequest, opts ...grpc.CallOption) (*EndDeviceArchive, error) {
+	out := new(EndDeviceArchive)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsEndDeviceRegistry/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsEndDeviceRegistryClient) Import(ctx context.Context, in *ImportEndDevicesRequest, opts ...grpc.CallOption) (*EndDevices, error) {
+	out := new(EndDevices)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsEndDeviceRegistry/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JsEndDeviceRegistryServer is the server API for JsEndDeviceRegistry service.
 type JsEndDeviceRegistryServer interface {
 	// Get returns the device that matches the given identifiers.
@@ -2483,6 +2511,13 @@ type JsEndDeviceRegistryServer interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// Export returns an archive of the stored state of the given end devices,
+	// including their MAC state, sessions and queues.
+	Export(context.Context, *ExportEndDevicesRequest) (*EndDeviceArchive, error)
+	// Import restores the end devices in the given archive.
+	// Existing end devices are replaced by the archived ones.
+	// The identifiers of the imported end devices are returned.
+	Import(context.Context, *ImportEndDevicesRequest) (*EndDevices, error)
 }
 
 // UnimplementedJsEndDeviceRegistryServer can be embedded to have forward compatible implementations.
@@ -2501,6 +2536,12 @@ func (*UnimplementedJsEndDeviceRegistryServer) Provision(req *ProvisionEndDevice
 func (*UnimplementedJsEndDeviceRegistryServer) Delete(ctx context.Context, req *EndDeviceIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedJsEndDeviceRegistryServer) Export(ctx context.Context, req *ExportEndDevicesRequest) (*EndDeviceArchive, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedJsEndDeviceRegistryServer) Import(ctx context.Context, req *ImportEndDevicesRequest) (*EndDevices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
 
 func RegisterJsEndDeviceRegistryServer(s *grpc.Server, srv JsEndDeviceRegistryServer) {
 	s.RegisterService(&_JsEndDeviceRegistry_serviceDesc, srv)
@@ -2581,6 +2622,42 @@ func _JsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JsEndDeviceRegistry_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsEndDeviceRegistryServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsEndDeviceRegistry/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsEndDeviceRegistryServer).Export(ctx, req.(*ExportEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsEndDeviceRegistry_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsEndDeviceRegistryServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsEndDeviceRegistry/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsEndDeviceRegistryServer).Import(ctx, req.(*ImportEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _JsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.JsEndDeviceRegistry",
 	HandlerType: (*JsEndDeviceRegistryServer)(nil),
@@ -2597,6 +2674,14 @@ var _JsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _JsEndDeviceRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _JsEndDeviceRegistry_Export_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _JsEndDeviceRegistry_Import_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
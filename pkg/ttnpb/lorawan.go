@@ -848,3 +848,11 @@ func (v FSKDataRate) DataRate() DataRate {
 		},
 	}
 }
+
+func (v LRFHSSDataRate) DataRate() DataRate {
+	return DataRate{
+		Modulation: &DataRate_LRFHSS{
+			LRFHSS: &v,
+		},
+	}
+}
@@ -1771,10 +1771,74 @@ func (m *FSKDataRate) GetBitRate() uint32 {
 	return 0
 }
 
+type LRFHSSDataRate struct {
+	ModulationType uint32 `protobuf:"varint,1,opt,name=modulation_type,json=modulationType,proto3" json:"modulation_type,omitempty"`
+	// Operating Channel Width (Hz).
+	OperatingChannelWidth uint32 `protobuf:"varint,2,opt,name=operating_channel_width,json=operatingChannelWidth,proto3" json:"operating_channel_width,omitempty"`
+	// Coding rate.
+	CodingRate           string   `protobuf:"bytes,3,opt,name=coding_rate,json=codingRate,proto3" json:"coding_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LRFHSSDataRate) Reset()      { *m = LRFHSSDataRate{} }
+func (*LRFHSSDataRate) ProtoMessage() {}
+func (*LRFHSSDataRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{12}
+}
+func (m *LRFHSSDataRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LRFHSSDataRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LRFHSSDataRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LRFHSSDataRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LRFHSSDataRate.Merge(m, src)
+}
+func (m *LRFHSSDataRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *LRFHSSDataRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_LRFHSSDataRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LRFHSSDataRate proto.InternalMessageInfo
+
+func (m *LRFHSSDataRate) GetModulationType() uint32 {
+	if m != nil {
+		return m.ModulationType
+	}
+	return 0
+}
+
+func (m *LRFHSSDataRate) GetOperatingChannelWidth() uint32 {
+	if m != nil {
+		return m.OperatingChannelWidth
+	}
+	return 0
+}
+
+func (m *LRFHSSDataRate) GetCodingRate() string {
+	if m != nil {
+		return m.CodingRate
+	}
+	return ""
+}
+
 type DataRate struct {
 	// Types that are valid to be assigned to Modulation:
 	//	*DataRate_LoRa
 	//	*DataRate_FSK
+	//	*DataRate_LRFHSS
 	Modulation           isDataRate_Modulation `protobuf_oneof:"modulation"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1783,7 +1847,7 @@ type DataRate struct {
 func (m *DataRate) Reset()      { *m = DataRate{} }
 func (*DataRate) ProtoMessage() {}
 func (*DataRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{12}
+	return fileDescriptor_2084d1d5a227b67e, []int{13}
 }
 func (m *DataRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1825,9 +1889,13 @@ type DataRate_LoRa struct {
 type DataRate_FSK struct {
 	FSK *FSKDataRate `protobuf:"bytes,2,opt,name=fsk,proto3,oneof" json:"fsk,omitempty"`
 }
+type DataRate_LRFHSS struct {
+	LRFHSS *LRFHSSDataRate `protobuf:"bytes,3,opt,name=lrfhss,proto3,oneof" json:"lrfhss,omitempty"`
+}
 
-func (*DataRate_LoRa) isDataRate_Modulation() {}
-func (*DataRate_FSK) isDataRate_Modulation()  {}
+func (*DataRate_LoRa) isDataRate_Modulation()   {}
+func (*DataRate_FSK) isDataRate_Modulation()    {}
+func (*DataRate_LRFHSS) isDataRate_Modulation() {}
 
 func (m *DataRate) GetModulation() isDataRate_Modulation {
 	if m != nil {
@@ -1850,11 +1918,19 @@ func (m *DataRate) GetFSK() *FSKDataRate {
 	return nil
 }
 
+func (m *DataRate) GetLRFHSS() *LRFHSSDataRate {
+	if x, ok := m.GetModulation().(*DataRate_LRFHSS); ok {
+		return x.LRFHSS
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*DataRate) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*DataRate_LoRa)(nil),
 		(*DataRate_FSK)(nil),
+		(*DataRate_LRFHSS)(nil),
 	}
 }
 
@@ -1887,7 +1963,7 @@ type TxSettings struct {
 func (m *TxSettings) Reset()      { *m = TxSettings{} }
 func (*TxSettings) ProtoMessage() {}
 func (*TxSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{13}
+	return fileDescriptor_2084d1d5a227b67e, []int{14}
 }
 func (m *TxSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1987,7 +2063,7 @@ type TxSettings_Downlink struct {
 func (m *TxSettings_Downlink) Reset()      { *m = TxSettings_Downlink{} }
 func (*TxSettings_Downlink) ProtoMessage() {}
 func (*TxSettings_Downlink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{13, 0}
+	return fileDescriptor_2084d1d5a227b67e, []int{14, 0}
 }
 func (m *TxSettings_Downlink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2047,7 +2123,7 @@ type GatewayAntennaIdentifiers struct {
 func (m *GatewayAntennaIdentifiers) Reset()      { *m = GatewayAntennaIdentifiers{} }
 func (*GatewayAntennaIdentifiers) ProtoMessage() {}
 func (*GatewayAntennaIdentifiers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{14}
+	return fileDescriptor_2084d1d5a227b67e, []int{15}
 }
 func (m *GatewayAntennaIdentifiers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2097,7 +2173,7 @@ type UplinkToken struct {
 func (m *UplinkToken) Reset()      { *m = UplinkToken{} }
 func (*UplinkToken) ProtoMessage() {}
 func (*UplinkToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{15}
+	return fileDescriptor_2084d1d5a227b67e, []int{16}
 }
 func (m *UplinkToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2162,7 +2238,7 @@ type DownlinkPath struct {
 func (m *DownlinkPath) Reset()      { *m = DownlinkPath{} }
 func (*DownlinkPath) ProtoMessage() {}
 func (*DownlinkPath) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{16}
+	return fileDescriptor_2084d1d5a227b67e, []int{17}
 }
 func (m *DownlinkPath) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2355,7 @@ type TxRequest struct {
 func (m *TxRequest) Reset()      { *m = TxRequest{} }
 func (*TxRequest) ProtoMessage() {}
 func (*TxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{17}
+	return fileDescriptor_2084d1d5a227b67e, []int{18}
 }
 func (m *TxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2427,7 +2503,7 @@ type MACCommand struct {
 func (m *MACCommand) Reset()      { *m = MACCommand{} }
 func (*MACCommand) ProtoMessage() {}
 func (*MACCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18}
+	return fileDescriptor_2084d1d5a227b67e, []int{19}
 }
 func (m *MACCommand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2866,7 +2942,7 @@ type MACCommand_ResetInd struct {
 func (m *MACCommand_ResetInd) Reset()      { *m = MACCommand_ResetInd{} }
 func (*MACCommand_ResetInd) ProtoMessage() {}
 func (*MACCommand_ResetInd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 0}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 0}
 }
 func (m *MACCommand_ResetInd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2911,7 +2987,7 @@ type MACCommand_ResetConf struct {
 func (m *MACCommand_ResetConf) Reset()      { *m = MACCommand_ResetConf{} }
 func (*MACCommand_ResetConf) ProtoMessage() {}
 func (*MACCommand_ResetConf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 1}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 1}
 }
 func (m *MACCommand_ResetConf) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2958,7 +3034,7 @@ type MACCommand_LinkCheckAns struct {
 func (m *MACCommand_LinkCheckAns) Reset()      { *m = MACCommand_LinkCheckAns{} }
 func (*MACCommand_LinkCheckAns) ProtoMessage() {}
 func (*MACCommand_LinkCheckAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 2}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 2}
 }
 func (m *MACCommand_LinkCheckAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3014,7 +3090,7 @@ type MACCommand_LinkADRReq struct {
 func (m *MACCommand_LinkADRReq) Reset()      { *m = MACCommand_LinkADRReq{} }
 func (*MACCommand_LinkADRReq) ProtoMessage() {}
 func (*MACCommand_LinkADRReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 3}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 3}
 }
 func (m *MACCommand_LinkADRReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3089,7 +3165,7 @@ type MACCommand_LinkADRAns struct {
 func (m *MACCommand_LinkADRAns) Reset()      { *m = MACCommand_LinkADRAns{} }
 func (*MACCommand_LinkADRAns) ProtoMessage() {}
 func (*MACCommand_LinkADRAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 4}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 4}
 }
 func (m *MACCommand_LinkADRAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3148,7 +3224,7 @@ type MACCommand_DutyCycleReq struct {
 func (m *MACCommand_DutyCycleReq) Reset()      { *m = MACCommand_DutyCycleReq{} }
 func (*MACCommand_DutyCycleReq) ProtoMessage() {}
 func (*MACCommand_DutyCycleReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 5}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 5}
 }
 func (m *MACCommand_DutyCycleReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3195,7 +3271,7 @@ type MACCommand_RxParamSetupReq struct {
 func (m *MACCommand_RxParamSetupReq) Reset()      { *m = MACCommand_RxParamSetupReq{} }
 func (*MACCommand_RxParamSetupReq) ProtoMessage() {}
 func (*MACCommand_RxParamSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 6}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 6}
 }
 func (m *MACCommand_RxParamSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3256,7 +3332,7 @@ type MACCommand_RxParamSetupAns struct {
 func (m *MACCommand_RxParamSetupAns) Reset()      { *m = MACCommand_RxParamSetupAns{} }
 func (*MACCommand_RxParamSetupAns) ProtoMessage() {}
 func (*MACCommand_RxParamSetupAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 7}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 7}
 }
 func (m *MACCommand_RxParamSetupAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3321,7 +3397,7 @@ type MACCommand_DevStatusAns struct {
 func (m *MACCommand_DevStatusAns) Reset()      { *m = MACCommand_DevStatusAns{} }
 func (*MACCommand_DevStatusAns) ProtoMessage() {}
 func (*MACCommand_DevStatusAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 8}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 8}
 }
 func (m *MACCommand_DevStatusAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3452,7 @@ type MACCommand_NewChannelReq struct {
 func (m *MACCommand_NewChannelReq) Reset()      { *m = MACCommand_NewChannelReq{} }
 func (*MACCommand_NewChannelReq) ProtoMessage() {}
 func (*MACCommand_NewChannelReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 9}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 9}
 }
 func (m *MACCommand_NewChannelReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3443,7 +3519,7 @@ type MACCommand_NewChannelAns struct {
 func (m *MACCommand_NewChannelAns) Reset()      { *m = MACCommand_NewChannelAns{} }
 func (*MACCommand_NewChannelAns) ProtoMessage() {}
 func (*MACCommand_NewChannelAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 10}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 10}
 }
 func (m *MACCommand_NewChannelAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3496,7 +3572,7 @@ type MACCommand_DLChannelReq struct {
 func (m *MACCommand_DLChannelReq) Reset()      { *m = MACCommand_DLChannelReq{} }
 func (*MACCommand_DLChannelReq) ProtoMessage() {}
 func (*MACCommand_DLChannelReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 11}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 11}
 }
 func (m *MACCommand_DLChannelReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3549,7 +3625,7 @@ type MACCommand_DLChannelAns struct {
 func (m *MACCommand_DLChannelAns) Reset()      { *m = MACCommand_DLChannelAns{} }
 func (*MACCommand_DLChannelAns) ProtoMessage() {}
 func (*MACCommand_DLChannelAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 12}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 12}
 }
 func (m *MACCommand_DLChannelAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3601,7 +3677,7 @@ type MACCommand_RxTimingSetupReq struct {
 func (m *MACCommand_RxTimingSetupReq) Reset()      { *m = MACCommand_RxTimingSetupReq{} }
 func (*MACCommand_RxTimingSetupReq) ProtoMessage() {}
 func (*MACCommand_RxTimingSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 13}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 13}
 }
 func (m *MACCommand_RxTimingSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3650,7 +3726,7 @@ type MACCommand_TxParamSetupReq struct {
 func (m *MACCommand_TxParamSetupReq) Reset()      { *m = MACCommand_TxParamSetupReq{} }
 func (*MACCommand_TxParamSetupReq) ProtoMessage() {}
 func (*MACCommand_TxParamSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 14}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 14}
 }
 func (m *MACCommand_TxParamSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3709,7 +3785,7 @@ type MACCommand_RekeyInd struct {
 func (m *MACCommand_RekeyInd) Reset()      { *m = MACCommand_RekeyInd{} }
 func (*MACCommand_RekeyInd) ProtoMessage() {}
 func (*MACCommand_RekeyInd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 15}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 15}
 }
 func (m *MACCommand_RekeyInd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3754,7 +3830,7 @@ type MACCommand_RekeyConf struct {
 func (m *MACCommand_RekeyConf) Reset()      { *m = MACCommand_RekeyConf{} }
 func (*MACCommand_RekeyConf) ProtoMessage() {}
 func (*MACCommand_RekeyConf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 16}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 16}
 }
 func (m *MACCommand_RekeyConf) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3802,7 +3878,7 @@ type MACCommand_ADRParamSetupReq struct {
 func (m *MACCommand_ADRParamSetupReq) Reset()      { *m = MACCommand_ADRParamSetupReq{} }
 func (*MACCommand_ADRParamSetupReq) ProtoMessage() {}
 func (*MACCommand_ADRParamSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 17}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 17}
 }
 func (m *MACCommand_ADRParamSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3854,7 +3930,7 @@ type MACCommand_DeviceTimeAns struct {
 func (m *MACCommand_DeviceTimeAns) Reset()      { *m = MACCommand_DeviceTimeAns{} }
 func (*MACCommand_DeviceTimeAns) ProtoMessage() {}
 func (*MACCommand_DeviceTimeAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 18}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 18}
 }
 func (m *MACCommand_DeviceTimeAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3903,7 +3979,7 @@ type MACCommand_ForceRejoinReq struct {
 func (m *MACCommand_ForceRejoinReq) Reset()      { *m = MACCommand_ForceRejoinReq{} }
 func (*MACCommand_ForceRejoinReq) ProtoMessage() {}
 func (*MACCommand_ForceRejoinReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 19}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 19}
 }
 func (m *MACCommand_ForceRejoinReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3972,7 +4048,7 @@ type MACCommand_RejoinParamSetupReq struct {
 func (m *MACCommand_RejoinParamSetupReq) Reset()      { *m = MACCommand_RejoinParamSetupReq{} }
 func (*MACCommand_RejoinParamSetupReq) ProtoMessage() {}
 func (*MACCommand_RejoinParamSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 20}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 20}
 }
 func (m *MACCommand_RejoinParamSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4024,7 +4100,7 @@ type MACCommand_RejoinParamSetupAns struct {
 func (m *MACCommand_RejoinParamSetupAns) Reset()      { *m = MACCommand_RejoinParamSetupAns{} }
 func (*MACCommand_RejoinParamSetupAns) ProtoMessage() {}
 func (*MACCommand_RejoinParamSetupAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 21}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 21}
 }
 func (m *MACCommand_RejoinParamSetupAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4069,7 +4145,7 @@ type MACCommand_PingSlotInfoReq struct {
 func (m *MACCommand_PingSlotInfoReq) Reset()      { *m = MACCommand_PingSlotInfoReq{} }
 func (*MACCommand_PingSlotInfoReq) ProtoMessage() {}
 func (*MACCommand_PingSlotInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 22}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 22}
 }
 func (m *MACCommand_PingSlotInfoReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4115,7 +4191,7 @@ type MACCommand_PingSlotChannelReq struct {
 func (m *MACCommand_PingSlotChannelReq) Reset()      { *m = MACCommand_PingSlotChannelReq{} }
 func (*MACCommand_PingSlotChannelReq) ProtoMessage() {}
 func (*MACCommand_PingSlotChannelReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 23}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 23}
 }
 func (m *MACCommand_PingSlotChannelReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4168,7 +4244,7 @@ type MACCommand_PingSlotChannelAns struct {
 func (m *MACCommand_PingSlotChannelAns) Reset()      { *m = MACCommand_PingSlotChannelAns{} }
 func (*MACCommand_PingSlotChannelAns) ProtoMessage() {}
 func (*MACCommand_PingSlotChannelAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 24}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 24}
 }
 func (m *MACCommand_PingSlotChannelAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4221,7 +4297,7 @@ type MACCommand_BeaconTimingAns struct {
 func (m *MACCommand_BeaconTimingAns) Reset()      { *m = MACCommand_BeaconTimingAns{} }
 func (*MACCommand_BeaconTimingAns) ProtoMessage() {}
 func (*MACCommand_BeaconTimingAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 25}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 25}
 }
 func (m *MACCommand_BeaconTimingAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4273,7 +4349,7 @@ type MACCommand_BeaconFreqReq struct {
 func (m *MACCommand_BeaconFreqReq) Reset()      { *m = MACCommand_BeaconFreqReq{} }
 func (*MACCommand_BeaconFreqReq) ProtoMessage() {}
 func (*MACCommand_BeaconFreqReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 26}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 26}
 }
 func (m *MACCommand_BeaconFreqReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4318,7 +4394,7 @@ type MACCommand_BeaconFreqAns struct {
 func (m *MACCommand_BeaconFreqAns) Reset()      { *m = MACCommand_BeaconFreqAns{} }
 func (*MACCommand_BeaconFreqAns) ProtoMessage() {}
 func (*MACCommand_BeaconFreqAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 27}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 27}
 }
 func (m *MACCommand_BeaconFreqAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4363,7 +4439,7 @@ type MACCommand_DeviceModeInd struct {
 func (m *MACCommand_DeviceModeInd) Reset()      { *m = MACCommand_DeviceModeInd{} }
 func (*MACCommand_DeviceModeInd) ProtoMessage() {}
 func (*MACCommand_DeviceModeInd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 28}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 28}
 }
 func (m *MACCommand_DeviceModeInd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4408,7 +4484,7 @@ type MACCommand_DeviceModeConf struct {
 func (m *MACCommand_DeviceModeConf) Reset()      { *m = MACCommand_DeviceModeConf{} }
 func (*MACCommand_DeviceModeConf) ProtoMessage() {}
 func (*MACCommand_DeviceModeConf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 29}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 29}
 }
 func (m *MACCommand_DeviceModeConf) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4453,7 +4529,7 @@ type DataRateIndexValue struct {
 func (m *DataRateIndexValue) Reset()      { *m = DataRateIndexValue{} }
 func (*DataRateIndexValue) ProtoMessage() {}
 func (*DataRateIndexValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{19}
+	return fileDescriptor_2084d1d5a227b67e, []int{20}
 }
 func (m *DataRateIndexValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4498,7 +4574,7 @@ type PingSlotPeriodValue struct {
 func (m *PingSlotPeriodValue) Reset()      { *m = PingSlotPeriodValue{} }
 func (*PingSlotPeriodValue) ProtoMessage() {}
 func (*PingSlotPeriodValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{20}
+	return fileDescriptor_2084d1d5a227b67e, []int{21}
 }
 func (m *PingSlotPeriodValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4543,7 +4619,7 @@ type AggregatedDutyCycleValue struct {
 func (m *AggregatedDutyCycleValue) Reset()      { *m = AggregatedDutyCycleValue{} }
 func (*AggregatedDutyCycleValue) ProtoMessage() {}
 func (*AggregatedDutyCycleValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{21}
+	return fileDescriptor_2084d1d5a227b67e, []int{22}
 }
 func (m *AggregatedDutyCycleValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4588,7 +4664,7 @@ type RxDelayValue struct {
 func (m *RxDelayValue) Reset()      { *m = RxDelayValue{} }
 func (*RxDelayValue) ProtoMessage() {}
 func (*RxDelayValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{22}
+	return fileDescriptor_2084d1d5a227b67e, []int{23}
 }
 func (m *RxDelayValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4633,7 +4709,7 @@ type ADRAckLimitExponentValue struct {
 func (m *ADRAckLimitExponentValue) Reset()      { *m = ADRAckLimitExponentValue{} }
 func (*ADRAckLimitExponentValue) ProtoMessage() {}
 func (*ADRAckLimitExponentValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{23}
+	return fileDescriptor_2084d1d5a227b67e, []int{24}
 }
 func (m *ADRAckLimitExponentValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4678,7 +4754,7 @@ type ADRAckDelayExponentValue struct {
 func (m *ADRAckDelayExponentValue) Reset()      { *m = ADRAckDelayExponentValue{} }
 func (*ADRAckDelayExponentValue) ProtoMessage() {}
 func (*ADRAckDelayExponentValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{24}
+	return fileDescriptor_2084d1d5a227b67e, []int{25}
 }
 func (m *ADRAckDelayExponentValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4779,6 +4855,8 @@ func init() {
 	golang_proto.RegisterType((*LoRaDataRate)(nil), "ttn.lorawan.v3.LoRaDataRate")
 	proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
 	golang_proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
+	proto.RegisterType((*LRFHSSDataRate)(nil), "ttn.lorawan.v3.LRFHSSDataRate")
+	golang_proto.RegisterType((*LRFHSSDataRate)(nil), "ttn.lorawan.v3.LRFHSSDataRate")
 	proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
 	golang_proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
 	proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
@@ -4875,359 +4953,361 @@ func init() {
 }
 
 var fileDescriptor_2084d1d5a227b67e = []byte{
-	// 5618 bytes of a gzipped FileDescriptorProto
+	// 5652 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7b, 0x4d, 0x70, 0x1b, 0xc9,
-	0x75, 0x3f, 0x06, 0x1f, 0x04, 0xf8, 0x00, 0x12, 0xc3, 0xa6, 0x56, 0xa2, 0xe0, 0x35, 0x28, 0x53,
-	0xfe, 0x97, 0x65, 0xee, 0x5f, 0x94, 0x08, 0x7e, 0x88, 0xb2, 0x1d, 0x7b, 0xf1, 0x45, 0x11, 0x2b,
-	0x7e, 0x79, 0x40, 0x4a, 0x2b, 0xc7, 0xae, 0xf1, 0x10, 0x33, 0xa0, 0xb0, 0x04, 0x06, 0xd8, 0xc1,
-	0x90, 0x22, 0x9d, 0x8b, 0x63, 0x5f, 0xb6, 0x92, 0x4a, 0xc5, 0xe5, 0xaa, 0x7c, 0xf8, 0x12, 0xbb,
-	0x2a, 0x4e, 0xc5, 0x55, 0x39, 0xc4, 0x49, 0x2e, 0x3e, 0xe4, 0xe0, 0x43, 0x0e, 0x4e, 0x25, 0x87,
-	0xcd, 0xcd, 0x49, 0x55, 0x94, 0x15, 0x75, 0xf1, 0x29, 0xe5, 0xa3, 0x4b, 0x87, 0x6c, 0xea, 0x75,
-	0xf7, 0x60, 0xba, 0x67, 0x20, 0x91, 0xdc, 0x5d, 0x47, 0x17, 0x4d, 0xff, 0xba, 0xfb, 0xbd, 0xd7,
-	0xef, 0xbd, 0x7e, 0xaf, 0x5f, 0x37, 0x08, 0xd3, 0xed, 0xae, 0x63, 0x3c, 0x31, 0xec, 0x9b, 0x7d,
-	0xd7, 0x68, 0x1c, 0xdc, 0x32, 0x7a, 0xad, 0x5b, 0x1c, 0x99, 0xeb, 0x39, 0x5d, 0xb7, 0x4b, 0xc6,
-	0x5d, 0xd7, 0x9e, 0xf3, 0xa0, 0xa3, 0x85, 0x5c, 0x71, 0xbf, 0xe5, 0x3e, 0x3e, 0xdc, 0x9b, 0x6b,
-	0x74, 0x3b, 0xb7, 0x2c, 0xfb, 0xa8, 0x7b, 0xd2, 0x73, 0xba, 0xc7, 0x27, 0xb7, 0xe8, 0xe0, 0xc6,
-	0xcd, 0x7d, 0xcb, 0xbe, 0x79, 0x64, 0xb4, 0x5b, 0xa6, 0xe1, 0x5a, 0xb7, 0x42, 0x1f, 0x8c, 0x64,
-	0xee, 0xa6, 0x40, 0x62, 0xbf, 0xbb, 0xdf, 0x65, 0x93, 0xf7, 0x0e, 0x9b, 0xb4, 0x45, 0x1b, 0xf4,
-	0x8b, 0x0f, 0x7f, 0x7d, 0xbf, 0xdb, 0xdd, 0x6f, 0x5b, 0xfe, 0xa8, 0xbe, 0xeb, 0x1c, 0x36, 0x5c,
-	0xde, 0x3b, 0x1d, 0xec, 0x75, 0x5b, 0x1d, 0xab, 0xef, 0x1a, 0x9d, 0x1e, 0x1f, 0x70, 0x3d, 0xbc,
-	0xc2, 0x96, 0x69, 0xd9, 0x6e, 0xab, 0xd9, 0xb2, 0x9c, 0x3e, 0x1b, 0x34, 0xf3, 0x41, 0x0c, 0x92,
-	0x1b, 0x56, 0xbf, 0x6f, 0xec, 0x5b, 0xe4, 0x8b, 0x90, 0xe8, 0xe8, 0x8f, 0x4d, 0x67, 0x4a, 0xb9,
-	0xa6, 0xdc, 0x48, 0x17, 0x2e, 0xcd, 0xc9, 0x1a, 0x98, 0xdb, 0x58, 0xab, 0x68, 0x25, 0xf5, 0x45,
-	0x29, 0xf1, 0x07, 0x4a, 0x54, 0x55, 0x7e, 0xf1, 0x74, 0x3a, 0xf2, 0xfe, 0xd3, 0x69, 0x45, 0x8b,
-	0x77, 0xd6, 0x4c, 0x87, 0xcc, 0x40, 0xac, 0xd3, 0x6a, 0x4c, 0x45, 0xaf, 0x29, 0x37, 0x32, 0x38,
-	0x68, 0xe4, 0x5b, 0x71, 0x35, 0x32, 0x15, 0x3f, 0x7d, 0x3a, 0x1d, 0xdb, 0xa8, 0x95, 0x35, 0xec,
-	0x24, 0x1b, 0x90, 0xee, 0x18, 0x0d, 0xbd, 0x67, 0x9c, 0xb4, 0xbb, 0x86, 0x39, 0x15, 0xa3, 0x6c,
-	0x72, 0x21, 0x36, 0xc5, 0xf2, 0x36, 0x1b, 0x51, 0x1a, 0x3f, 0x7d, 0x3a, 0x0d, 0x7e, 0x7b, 0x2d,
-	0xa2, 0x41, 0xc7, 0x68, 0xf0, 0x16, 0x79, 0x00, 0x97, 0xde, 0xe9, 0xb6, 0x6c, 0xdd, 0xb1, 0xde,
-	0x3d, 0xb4, 0xfa, 0xee, 0x80, 0x6e, 0x9c, 0xd2, 0x9d, 0x09, 0xd2, 0x7d, 0xab, 0xdb, 0xb2, 0x35,
-	0x36, 0xd4, 0xa7, 0x47, 0xde, 0x09, 0xa1, 0xa4, 0x0e, 0x93, 0x94, 0xae, 0xd1, 0x68, 0x58, 0x3d,
-	0x9f, 0x6c, 0x82, 0x92, 0xfd, 0xcc, 0x30, 0xb2, 0x45, 0x3a, 0xd2, 0xa7, 0x3a, 0xf1, 0x4e, 0x10,
-	0x24, 0x5f, 0x87, 0xcb, 0x8e, 0x35, 0x54, 0xdc, 0x11, 0x4a, 0xf7, 0xb3, 0x41, 0xba, 0x9a, 0xf5,
-	0xce, 0x30, 0x81, 0x2f, 0x39, 0x43, 0xf0, 0x2f, 0xc4, 0x7f, 0xf6, 0xa3, 0xe9, 0x48, 0x69, 0x1c,
-	0x92, 0x1e, 0xbb, 0xd8, 0x6f, 0x4a, 0xca, 0x5b, 0xf1, 0x54, 0x52, 0x4d, 0xcd, 0x1c, 0x42, 0x1c,
-	0x2d, 0x47, 0x96, 0x61, 0xa4, 0xa3, 0xbb, 0x27, 0x3d, 0x8b, 0xda, 0x77, 0xbc, 0xf0, 0x5a, 0x48,
-	0xf1, 0x3b, 0x27, 0x3d, 0xab, 0x94, 0x7a, 0x51, 0x4a, 0x7c, 0x07, 0x0d, 0xac, 0x25, 0x3a, 0x08,
-	0x90, 0x25, 0x48, 0x74, 0x8c, 0x77, 0xba, 0x0e, 0xb5, 0xed, 0xb0, 0x69, 0xd8, 0x29, 0x4d, 0x43,
-	0x60, 0xe6, 0xf7, 0xa3, 0x20, 0x98, 0x0e, 0x9d, 0xab, 0xf9, 0x2a, 0xe7, 0x5a, 0x7d, 0x89, 0x73,
-	0x35, 0xd1, 0xb9, 0xa6, 0x61, 0xa4, 0xa9, 0xf7, 0xba, 0x8e, 0x4b, 0x65, 0x18, 0xa3, 0xcc, 0x66,
-	0x63, 0x53, 0x1f, 0x2a, 0x5a, 0xa2, 0xb9, 0xdd, 0x75, 0x5c, 0x72, 0x0b, 0xd2, 0x4d, 0xa7, 0x23,
-	0x79, 0x56, 0x86, 0x79, 0xcf, 0xaa, 0xb6, 0xc1, 0x45, 0xd0, 0xa0, 0xe9, 0x74, 0x3c, 0x71, 0xde,
-	0x84, 0xac, 0x69, 0x35, 0xba, 0xa6, 0x65, 0x06, 0xdc, 0xe6, 0xca, 0x1c, 0xdb, 0x57, 0x73, 0xde,
-	0xbe, 0x9a, 0xab, 0xd3, 0x5d, 0xa7, 0x8d, 0xf3, 0xf1, 0x1e, 0x85, 0xd7, 0x01, 0x9a, 0x87, 0xed,
-	0xb6, 0xde, 0xd4, 0x1b, 0xb6, 0x4b, 0x9d, 0x63, 0x4c, 0x4b, 0x21, 0xb2, 0x5a, 0xb6, 0x5d, 0x66,
-	0x90, 0x99, 0x5f, 0x29, 0x10, 0xc7, 0x85, 0x91, 0xaf, 0x41, 0xca, 0xb4, 0x8e, 0x74, 0xc3, 0xe4,
-	0x0a, 0xc8, 0x94, 0xbe, 0x82, 0x4b, 0xfc, 0x8f, 0xa7, 0xd3, 0x77, 0xf6, 0xbb, 0x73, 0xee, 0x63,
-	0xcb, 0x7d, 0xdc, 0xb2, 0xf7, 0xfb, 0x73, 0xb6, 0xe5, 0x3e, 0xe9, 0x3a, 0x07, 0xb7, 0xe4, 0xad,
-	0x7b, 0xb4, 0x70, 0xab, 0x77, 0xb0, 0x7f, 0x0b, 0x6d, 0xd7, 0x9f, 0xab, 0x58, 0x47, 0x45, 0xd3,
-	0x74, 0xb4, 0xa4, 0xc9, 0x3e, 0xc8, 0x97, 0x51, 0x39, 0x0d, 0xd7, 0x69, 0x53, 0xe5, 0xa4, 0xc3,
-	0x06, 0x5a, 0x2d, 0xbb, 0x4e, 0x7b, 0x88, 0x6e, 0x13, 0x4d, 0xec, 0x20, 0x79, 0xb4, 0x0c, 0xae,
-	0x21, 0x46, 0x75, 0x3b, 0xfa, 0xa2, 0x34, 0x32, 0x1b, 0x9f, 0xfa, 0xf0, 0xc3, 0x98, 0x16, 0x6f,
-	0x96, 0x6d, 0x97, 0xe4, 0x91, 0x7e, 0xb7, 0xe7, 0xf6, 0xa9, 0x86, 0x32, 0xa5, 0xe4, 0x8b, 0x52,
-	0xfc, 0x5b, 0xd1, 0xa9, 0xac, 0x96, 0x68, 0x6e, 0xf5, 0xdc, 0x3e, 0x5f, 0xea, 0x0f, 0x14, 0x48,
-	0x50, 0x46, 0xe4, 0x2a, 0xc4, 0x0c, 0xbe, 0xcc, 0x54, 0x29, 0x89, 0x01, 0xa0, 0x58, 0xd1, 0x34,
-	0xc4, 0xc8, 0x4d, 0x48, 0x1b, 0xa6, 0xa3, 0x1b, 0x8d, 0x03, 0xdc, 0x05, 0x54, 0xde, 0x54, 0x69,
-	0xec, 0xf4, 0xe9, 0xf4, 0x68, 0xb1, 0xa2, 0x15, 0x1b, 0x07, 0x9a, 0xf5, 0xae, 0x36, 0x6a, 0x98,
-	0x0e, 0xfb, 0x24, 0x2a, 0xc4, 0x8c, 0xc6, 0x01, 0x95, 0x2b, 0xa5, 0xe1, 0x27, 0xf9, 0x14, 0x8c,
-	0x36, 0xf5, 0x9e, 0x65, 0x9b, 0x2d, 0x7b, 0x9f, 0x8a, 0x93, 0xd2, 0x52, 0xcd, 0x6d, 0xd6, 0x26,
-	0x57, 0x20, 0xd9, 0x68, 0x1b, 0xfd, 0xbe, 0xbe, 0x47, 0xcd, 0x91, 0xd2, 0x46, 0x68, 0xb3, 0x34,
-	0xf3, 0xf3, 0x28, 0x90, 0xf0, 0xee, 0x27, 0x0d, 0x48, 0xd1, 0x0d, 0x69, 0x1d, 0xb6, 0xb8, 0x51,
-	0xd6, 0xb8, 0x51, 0x96, 0x2e, 0x6a, 0x94, 0xea, 0x6e, 0x6d, 0x79, 0xf1, 0xf4, 0xe9, 0x74, 0x12,
-	0xd9, 0x54, 0x77, 0x6b, 0x5a, 0x12, 0x29, 0x57, 0x0f, 0x5b, 0xe4, 0x9b, 0x80, 0x86, 0xa2, 0x3c,
-	0x58, 0x6c, 0xbc, 0xf7, 0x71, 0x79, 0x8c, 0x54, 0xac, 0x23, 0x64, 0x31, 0x62, 0x5a, 0x47, 0xc8,
-	0xe1, 0x1b, 0x30, 0x8a, 0x1c, 0xec, 0xae, 0xdd, 0xb0, 0xb8, 0xe7, 0xbf, 0xc9, 0x79, 0xac, 0x7c,
-	0x04, 0xe7, 0xda, 0x44, 0x3a, 0x1a, 0xba, 0x2b, 0xfd, 0xe2, 0xe6, 0xfd, 0x49, 0x0c, 0x2e, 0x0d,
-	0x8b, 0x48, 0xa4, 0x0a, 0x69, 0x1e, 0xd7, 0x84, 0xd0, 0x92, 0x1b, 0x1e, 0xcc, 0x02, 0xf1, 0x05,
-	0x9c, 0x01, 0x4a, 0xbe, 0x01, 0x23, 0xb6, 0xe5, 0xea, 0x2d, 0x93, 0x6b, 0x69, 0xf5, 0xa3, 0x6a,
-	0x69, 0xd3, 0x72, 0x6b, 0x95, 0xd3, 0xa7, 0xd3, 0x09, 0xfa, 0xa1, 0x25, 0x6c, 0xcb, 0xad, 0xc9,
-	0xa6, 0x8e, 0xfd, 0x1f, 0x98, 0x3a, 0xfe, 0xdb, 0x31, 0xf5, 0xa7, 0x81, 0xeb, 0x4c, 0x88, 0x39,
-	0xa3, 0x0c, 0xf1, 0x83, 0xce, 0x5f, 0xc4, 0x61, 0x22, 0x94, 0x94, 0xc8, 0xeb, 0x30, 0x6a, 0xd9,
-	0x0d, 0xe7, 0xa4, 0xe7, 0x5a, 0x26, 0xf3, 0x76, 0xcd, 0x07, 0xc8, 0x37, 0x01, 0x28, 0x59, 0xe6,
-	0x44, 0xcc, 0x04, 0x45, 0x2e, 0xfd, 0xdd, 0x8b, 0x4a, 0x8f, 0xcc, 0x99, 0x17, 0x8d, 0xbe, 0xe3,
-	0x7d, 0x0a, 0x06, 0x8e, 0xfd, 0x36, 0x0c, 0x2c, 0x06, 0xd8, 0xf8, 0x27, 0x1c, 0x60, 0x37, 0x20,
-	0x6d, 0xb6, 0xf5, 0xbe, 0xe5, 0xba, 0x48, 0x82, 0x9f, 0x03, 0x42, 0x2e, 0x5e, 0x59, 0xaf, 0xf3,
-	0x11, 0x43, 0x42, 0x2d, 0x98, 0x6d, 0xaf, 0x97, 0x7c, 0x09, 0x52, 0xce, 0xb1, 0x6e, 0x5a, 0x6d,
-	0xe3, 0x84, 0xe6, 0xfe, 0xf1, 0xc2, 0x95, 0xd0, 0x76, 0x39, 0xae, 0x60, 0xb7, 0xb0, 0x57, 0x92,
-	0x0e, 0x83, 0xc8, 0x17, 0x21, 0xd9, 0x68, 0xea, 0xed, 0x56, 0xdf, 0x9d, 0x4a, 0x52, 0x41, 0x2e,
-	0x07, 0x27, 0x97, 0x57, 0xd7, 0x5b, 0x7d, 0xb7, 0x04, 0xe8, 0x3f, 0xec, 0x5b, 0x1b, 0x69, 0x34,
-	0xf1, 0x7f, 0xee, 0x20, 0xff, 0xa8, 0x00, 0xf8, 0xd2, 0x92, 0x2f, 0xc0, 0x98, 0x73, 0x3c, 0xaf,
-	0x9b, 0x8e, 0xde, 0x6d, 0x36, 0xfb, 0x96, 0x4b, 0xbd, 0x63, 0xac, 0x74, 0xf9, 0x45, 0x29, 0x3e,
-	0x1b, 0x9d, 0xc2, 0x00, 0x9e, 0xd6, 0x8e, 0xe7, 0x2b, 0xda, 0x16, 0xed, 0xd5, 0xd2, 0xce, 0xf1,
-	0x7c, 0xc5, 0x61, 0x0d, 0x72, 0x0f, 0x46, 0x9c, 0xe3, 0x82, 0x6e, 0x7a, 0x87, 0x83, 0x4f, 0x87,
-	0xb4, 0x62, 0xb8, 0x86, 0x66, 0xb8, 0x56, 0xcd, 0x36, 0xad, 0xe3, 0xd2, 0x84, 0xb7, 0x1e, 0xb4,
-	0x9f, 0x76, 0x5c, 0xa8, 0x68, 0x5a, 0xc2, 0x39, 0x2e, 0x54, 0x1c, 0x72, 0x1d, 0x92, 0xdd, 0x9e,
-	0xab, 0xdb, 0xd6, 0x3e, 0x0b, 0xf7, 0x4c, 0xfc, 0xad, 0x9e, 0xbb, 0x69, 0xed, 0x6b, 0x23, 0x5d,
-	0xfa, 0x3f, 0x17, 0xff, 0x09, 0xf0, 0x65, 0x91, 0x15, 0x88, 0xbf, 0x2a, 0xe8, 0xb0, 0x51, 0x81,
-	0xa0, 0x43, 0x67, 0x10, 0x02, 0xf1, 0x26, 0xcb, 0x40, 0xb1, 0x1b, 0x63, 0x1a, 0xfd, 0x26, 0x57,
-	0x21, 0xd5, 0x78, 0xac, 0x77, 0x8c, 0xfe, 0x41, 0x7f, 0x2a, 0x76, 0x2d, 0x76, 0x23, 0xa5, 0x25,
-	0x1b, 0x8f, 0x37, 0xb0, 0xc9, 0x19, 0x3f, 0x84, 0xcc, 0x7a, 0x57, 0x33, 0xbc, 0x25, 0xe1, 0x96,
-	0xda, 0x33, 0x6c, 0xf3, 0x49, 0xcb, 0x74, 0x1f, 0x33, 0xa5, 0x69, 0x3e, 0x40, 0x3e, 0x0f, 0x6a,
-	0xbf, 0xe7, 0x58, 0x06, 0xa6, 0x26, 0xbd, 0x69, 0x34, 0x5c, 0x7e, 0x82, 0x1a, 0xd3, 0xb2, 0x03,
-	0x7c, 0x95, 0xc2, 0x33, 0x37, 0x20, 0xbd, 0x5a, 0xbf, 0x3f, 0xa0, 0x7b, 0x15, 0x52, 0x7b, 0x2d,
-	0x57, 0x77, 0x0c, 0xd7, 0xe2, 0x64, 0x93, 0x7b, 0x2d, 0x17, 0xbb, 0x66, 0xfe, 0x44, 0x81, 0xd4,
-	0x60, 0xdc, 0x97, 0x20, 0x8e, 0xab, 0xe5, 0x27, 0xaa, 0xd7, 0x83, 0xcb, 0x17, 0x65, 0x2d, 0xa5,
-	0x4e, 0x9f, 0x4e, 0xc7, 0x11, 0x59, 0x8b, 0x68, 0x74, 0x16, 0x59, 0x81, 0x58, 0xb3, 0x7f, 0xc0,
-	0xcf, 0x0c, 0x9f, 0x0a, 0x9d, 0x19, 0x7c, 0x79, 0x58, 0x0e, 0x5f, 0xad, 0xdf, 0x5f, 0x8b, 0x68,
-	0x38, 0xa5, 0x34, 0x01, 0xd0, 0xe9, 0x9a, 0x87, 0x6d, 0xc3, 0x6d, 0x75, 0x6d, 0x7a, 0xd2, 0x9c,
-	0xf9, 0xeb, 0x38, 0xc0, 0xce, 0xf1, 0xc0, 0xa5, 0xca, 0x30, 0x6a, 0x1a, 0xae, 0xe1, 0x2f, 0x21,
-	0x5d, 0x98, 0x7a, 0x99, 0x67, 0x94, 0x32, 0xe2, 0x6e, 0xd1, 0x52, 0xa6, 0xb7, 0xbc, 0x2d, 0xc8,
-	0x0e, 0x88, 0xe8, 0x2d, 0xf4, 0x9f, 0xf3, 0x39, 0x99, 0x6f, 0xeb, 0x31, 0x53, 0xec, 0x20, 0xd3,
-	0x90, 0x6e, 0x74, 0xa9, 0x39, 0xa8, 0x5c, 0xe8, 0x67, 0xa3, 0x1a, 0x30, 0xc8, 0x33, 0x68, 0x93,
-	0x9e, 0xce, 0xed, 0xc6, 0x09, 0x8d, 0x22, 0x71, 0xcd, 0x07, 0xc8, 0xff, 0x07, 0xb0, 0x6c, 0x63,
-	0xaf, 0x6d, 0xe9, 0x0d, 0xa7, 0xc1, 0x4e, 0x18, 0xec, 0xec, 0x52, 0xa5, 0x68, 0x59, 0x2b, 0x63,
-	0x44, 0xa5, 0x9f, 0x4e, 0x03, 0x69, 0x0d, 0x0a, 0x32, 0xba, 0xcd, 0xc7, 0x34, 0x1f, 0x20, 0x8b,
-	0x10, 0xc7, 0x06, 0xdf, 0xc2, 0xb9, 0xd0, 0x99, 0x73, 0xc7, 0x1b, 0x59, 0x8a, 0x7f, 0xef, 0xbf,
-	0xf0, 0x18, 0x8c, 0xa3, 0xc9, 0x57, 0x20, 0x65, 0x76, 0x9f, 0xd8, 0xed, 0x96, 0x7d, 0x30, 0x95,
-	0xa2, 0x33, 0xaf, 0x07, 0x55, 0xe1, 0x1b, 0x61, 0xae, 0xc2, 0x87, 0x6a, 0x83, 0x49, 0xb9, 0xdf,
-	0x83, 0x94, 0x87, 0x92, 0xeb, 0x30, 0x66, 0xd8, 0xae, 0x65, 0xdb, 0x06, 0x57, 0x2e, 0x73, 0xb5,
-	0x0c, 0x07, 0x99, 0xca, 0xae, 0x42, 0xca, 0x3d, 0xd6, 0x7b, 0xdd, 0x27, 0x16, 0x73, 0xde, 0xa8,
-	0x96, 0x74, 0x8f, 0xb7, 0xb1, 0x49, 0x6e, 0xc1, 0x64, 0xcb, 0x3e, 0xb2, 0x1c, 0x57, 0xef, 0x75,
-	0xdb, 0x86, 0xd3, 0xfa, 0x16, 0x75, 0x07, 0x7e, 0x58, 0x23, 0xac, 0x6b, 0x5b, 0xe8, 0xe1, 0x9b,
-	0xe8, 0xcf, 0x14, 0xb8, 0x7a, 0xcf, 0x70, 0xad, 0x27, 0xc6, 0x49, 0x91, 0x73, 0xf2, 0x8b, 0x52,
-	0xb2, 0x0b, 0xe9, 0x7d, 0xd6, 0xa9, 0xb7, 0xcc, 0x3e, 0x77, 0x9d, 0x50, 0x25, 0xc7, 0xe7, 0x0b,
-	0x13, 0x87, 0x85, 0xdc, 0x7d, 0x6f, 0x54, 0x3f, 0xbc, 0xd6, 0x68, 0x78, 0xad, 0x33, 0xff, 0xad,
-	0x40, 0x7a, 0xb7, 0x87, 0xba, 0xd9, 0xe9, 0x1e, 0x58, 0x36, 0xd9, 0x80, 0x98, 0x2f, 0xc3, 0xe7,
-	0x5f, 0x22, 0x43, 0x78, 0x0d, 0x43, 0x44, 0x41, 0x3a, 0xb2, 0x43, 0x44, 0x83, 0x0e, 0x51, 0x85,
-	0x74, 0xdf, 0x72, 0x8e, 0x2c, 0x47, 0xa7, 0x7e, 0x11, 0x3b, 0xd3, 0x2f, 0x52, 0x48, 0x9d, 0xfa,
-	0x06, 0xb0, 0x89, 0xd8, 0x45, 0xde, 0x80, 0x89, 0x06, 0xa6, 0x5b, 0xdb, 0x75, 0x0c, 0xb7, 0xcb,
-	0x89, 0xa1, 0x27, 0xc7, 0x34, 0x55, 0xec, 0xc0, 0xc1, 0x33, 0xdf, 0x55, 0x20, 0xe3, 0xb9, 0xc3,
-	0xb6, 0xe1, 0x3e, 0x26, 0xd7, 0x21, 0x73, 0x48, 0x15, 0xa0, 0xbb, 0xa8, 0x01, 0x76, 0x4c, 0x58,
-	0x8b, 0x68, 0xe9, 0x43, 0x41, 0x2d, 0x45, 0x48, 0x34, 0x5b, 0xc7, 0x96, 0xc9, 0x23, 0xc7, 0xf9,
-	0x15, 0xb3, 0x16, 0xd1, 0xd8, 0xcc, 0x52, 0x1a, 0xe2, 0x3d, 0xe4, 0x47, 0x43, 0xc7, 0xbf, 0x24,
-	0x60, 0x74, 0xe7, 0x98, 0x9f, 0x2a, 0xc9, 0x1b, 0x90, 0xa0, 0x87, 0xf6, 0x97, 0xd5, 0xa8, 0x65,
-	0xec, 0xd4, 0xd8, 0x18, 0x52, 0x86, 0x71, 0xcf, 0xb5, 0x75, 0x24, 0xd8, 0xa7, 0xf1, 0x7c, 0x48,
-	0x28, 0x14, 0x57, 0xa9, 0x8d, 0x99, 0x42, 0xab, 0x4f, 0xbe, 0x0c, 0xa3, 0x34, 0xfd, 0xd1, 0x7c,
-	0x1c, 0x3b, 0x6f, 0x3e, 0x4e, 0x61, 0x16, 0xa4, 0x09, 0xf9, 0x01, 0x4c, 0xd2, 0xf9, 0x81, 0x50,
-	0x15, 0xbf, 0x58, 0xa8, 0x52, 0x91, 0x9e, 0x14, 0xad, 0xae, 0xb3, 0xb4, 0xec, 0x07, 0xa4, 0x04,
-	0x0d, 0x48, 0x19, 0xe7, 0x78, 0x7e, 0x75, 0x10, 0x93, 0x28, 0xf3, 0x42, 0x88, 0xf9, 0xc8, 0x85,
-	0x99, 0x17, 0x86, 0x30, 0x2f, 0x08, 0xcc, 0x93, 0x1e, 0xf3, 0x82, 0xcf, 0x7c, 0x0d, 0x52, 0x3d,
-	0xa7, 0xd5, 0x75, 0x5a, 0xee, 0x09, 0x0d, 0x47, 0xe3, 0xe1, 0x9d, 0xba, 0x73, 0x5c, 0x6f, 0x3c,
-	0xb6, 0xcc, 0xc3, 0xb6, 0xb5, 0xcd, 0x47, 0x8a, 0x3a, 0xf4, 0x66, 0x93, 0x2a, 0x8c, 0x19, 0x7b,
-	0xfd, 0x6e, 0xfb, 0xd0, 0xb5, 0x98, 0xcb, 0x8e, 0x9e, 0x33, 0x2e, 0x66, 0xbc, 0x69, 0xd4, 0xfb,
-	0x57, 0x61, 0x62, 0x20, 0xb1, 0xde, 0x6b, 0x1b, 0x36, 0x1e, 0x37, 0x01, 0xc3, 0x7c, 0x29, 0xf7,
-	0xa2, 0x14, 0x77, 0xa2, 0x53, 0x6f, 0x9e, 0x3e, 0x9d, 0xce, 0x0e, 0x56, 0xb0, 0xdd, 0x36, 0xec,
-	0x5a, 0x45, 0xcb, 0x36, 0x25, 0xc0, 0x24, 0x0b, 0x90, 0x32, 0xcc, 0x23, 0xc3, 0x6e, 0x58, 0xe6,
-	0x54, 0xe3, 0xd5, 0xb7, 0x02, 0x83, 0x81, 0x3c, 0xbc, 0xfd, 0xf1, 0x12, 0xbd, 0xf5, 0x28, 0x77,
-	0x3b, 0x1d, 0xc3, 0x36, 0x49, 0x0d, 0x62, 0x8d, 0x96, 0xc9, 0x9d, 0xf9, 0xb3, 0x43, 0x6e, 0xba,
-	0xf8, 0x40, 0x7f, 0x9b, 0xe0, 0x19, 0x29, 0xf9, 0x1d, 0x25, 0xae, 0x2a, 0xd7, 0x22, 0x98, 0x77,
-	0xcb, 0xb5, 0x8a, 0x86, 0x34, 0xc8, 0x67, 0x20, 0xed, 0x18, 0x4f, 0x06, 0xb7, 0x15, 0x51, 0xbe,
-	0x37, 0xc1, 0x31, 0x9e, 0x78, 0x67, 0xfc, 0x12, 0x8c, 0x3a, 0x56, 0x1f, 0x4f, 0xd9, 0xb6, 0x77,
-	0xbb, 0x76, 0xfd, 0xe5, 0x3c, 0xe7, 0x34, 0x1c, 0x5b, 0xb3, 0xcd, 0xb5, 0x88, 0x96, 0x72, 0xf8,
-	0x37, 0xa9, 0x62, 0x89, 0x81, 0x34, 0x1a, 0x5d, 0xbb, 0xc9, 0xef, 0x44, 0x3e, 0x7b, 0x16, 0x91,
-	0x72, 0xd7, 0x6e, 0xae, 0x45, 0x34, 0xc6, 0x1d, 0x1b, 0x64, 0x0b, 0xc6, 0xe9, 0xb6, 0x6c, 0x3c,
-	0xb6, 0x1a, 0x07, 0xba, 0x61, 0x7b, 0xc7, 0xe6, 0xcf, 0xbd, 0x82, 0xd4, 0x7a, 0xcb, 0x3e, 0x28,
-	0xe3, 0xf8, 0xa2, 0x8d, 0xc1, 0x22, 0xd3, 0x16, 0xda, 0xe4, 0x11, 0xd0, 0xb6, 0x6e, 0x98, 0x0e,
-	0xbd, 0x3b, 0x60, 0xb7, 0x66, 0xff, 0xef, 0x0c, 0x72, 0xc5, 0x8a, 0xa6, 0x59, 0xef, 0xb2, 0x9b,
-	0x20, 0xbf, 0x8d, 0x6a, 0x43, 0x62, 0x45, 0xd3, 0xd1, 0xac, 0x77, 0x25, 0xd2, 0x28, 0x69, 0xf2,
-	0xbc, 0xa4, 0x8b, 0x76, 0x5f, 0x22, 0xcd, 0xe4, 0xf6, 0x48, 0xa3, 0xd4, 0x5b, 0x30, 0x6e, 0x1e,
-	0xba, 0x27, 0x7a, 0xe3, 0xa4, 0xd1, 0xb6, 0xa8, 0xdc, 0xa9, 0x33, 0xd5, 0x50, 0x39, 0x74, 0x4f,
-	0xca, 0x38, 0x9e, 0x49, 0x9a, 0x31, 0x85, 0x36, 0x79, 0x04, 0xc4, 0x39, 0xd6, 0x7b, 0x86, 0x63,
-	0x74, 0xb0, 0x22, 0x39, 0xec, 0x51, 0xa2, 0x6c, 0xbb, 0xcc, 0xbe, 0xca, 0x4c, 0xc7, 0xdb, 0x38,
-	0xa7, 0x8e, 0x53, 0x18, 0xdd, 0xac, 0x23, 0x43, 0x43, 0x48, 0xa3, 0x32, 0xe0, 0x42, 0xa4, 0x99,
-	0x06, 0x24, 0xd2, 0x9e, 0x1a, 0xac, 0x23, 0xbd, 0xef, 0x1a, 0xee, 0x61, 0x9f, 0x92, 0x4d, 0x9f,
-	0xad, 0x06, 0xeb, 0xa8, 0x4e, 0xc7, 0x73, 0x6f, 0x30, 0x85, 0x36, 0xd1, 0x20, 0x6b, 0x5b, 0x4f,
-	0xf4, 0xc6, 0x63, 0xc3, 0xb6, 0xad, 0x36, 0xd5, 0x41, 0x86, 0x52, 0xbc, 0xf1, 0x0a, 0x8a, 0x9b,
-	0xd6, 0x93, 0x32, 0x9b, 0xc0, 0x34, 0x30, 0x66, 0x8b, 0x40, 0x90, 0x26, 0x4a, 0x39, 0x76, 0x01,
-	0x9a, 0x4c, 0x4c, 0x81, 0x26, 0xca, 0x69, 0xc0, 0xb8, 0xd9, 0x96, 0xc4, 0x1c, 0x3f, 0x7b, 0xe1,
-	0xeb, 0xbe, 0x50, 0x25, 0xf5, 0xf4, 0xe9, 0x74, 0x46, 0x44, 0xa8, 0x2a, 0xda, 0x82, 0xd8, 0x32,
-	0x0b, 0x94, 0x3a, 0x7b, 0x7e, 0x16, 0xe8, 0xc1, 0x32, 0x0b, 0x4f, 0xdb, 0x6d, 0x61, 0x15, 0x5f,
-	0xc7, 0x2c, 0x83, 0x81, 0x19, 0xcf, 0xce, 0xbe, 0xd7, 0xa9, 0x94, 0xcf, 0x1b, 0xaf, 0x74, 0x8d,
-	0x1d, 0x3a, 0x49, 0x70, 0x3b, 0xd5, 0x09, 0x60, 0xe8, 0x77, 0x6e, 0xd8, 0xa5, 0x27, 0xce, 0xf4,
-	0xbb, 0x9d, 0xb0, 0x4b, 0xbb, 0x01, 0x97, 0xa6, 0x01, 0xf1, 0xc0, 0x3a, 0xa1, 0x01, 0x91, 0x9c,
-	0x23, 0x20, 0x1e, 0x58, 0x27, 0x83, 0x80, 0xc8, 0xbe, 0x59, 0x40, 0x44, 0x1a, 0x34, 0x20, 0x4e,
-	0x9e, 0x23, 0x20, 0x1e, 0x58, 0x27, 0x7e, 0x40, 0xe4, 0x0d, 0xe2, 0xc0, 0x24, 0xc6, 0x97, 0xe0,
-	0x32, 0x2f, 0x9d, 0xa9, 0xc3, 0x62, 0x45, 0x93, 0x16, 0x55, 0xba, 0x74, 0xfa, 0x74, 0x5a, 0x0d,
-	0xa2, 0xa8, 0x59, 0xc3, 0x74, 0xe4, 0xe5, 0x6b, 0x90, 0x35, 0xad, 0xa3, 0x56, 0x83, 0x25, 0x55,
-	0xea, 0x1b, 0xaf, 0x9d, 0xe9, 0xd1, 0x15, 0x3a, 0x03, 0xf3, 0x29, 0xf7, 0x68, 0x53, 0x04, 0xc8,
-	0x2e, 0xa8, 0xcd, 0xae, 0xd3, 0xc0, 0x60, 0xe6, 0xbd, 0x66, 0x4c, 0x5d, 0x1e, 0x7e, 0x12, 0x14,
-	0x88, 0xae, 0xe2, 0x94, 0xc1, 0xfd, 0xe1, 0x5a, 0x44, 0x1b, 0x6f, 0x4a, 0x08, 0xb1, 0x06, 0xcf,
-	0x23, 0x41, 0x0d, 0x5d, 0xa1, 0xc4, 0xe7, 0x5e, 0xa9, 0x71, 0x9c, 0x18, 0x54, 0xc7, 0xa4, 0x13,
-	0x86, 0x5f, 0xc2, 0x06, 0x15, 0x33, 0x75, 0x61, 0x36, 0x4c, 0x3d, 0x21, 0x36, 0x2c, 0x59, 0x91,
-	0x1e, 0xdd, 0x2b, 0xed, 0x2e, 0x26, 0xe3, 0x66, 0x97, 0xae, 0xe4, 0xea, 0x99, 0x2e, 0xbd, 0x8d,
-	0xfb, 0xa2, 0xdd, 0x75, 0x6b, 0x76, 0xb3, 0xcb, 0x5d, 0xba, 0x27, 0x43, 0x64, 0x0f, 0x5e, 0xf3,
-	0x49, 0x8b, 0x81, 0x25, 0x47, 0xa9, 0xdf, 0x3c, 0x07, 0x75, 0x29, 0x98, 0x90, 0x5e, 0x08, 0x1d,
-	0xce, 0x03, 0x95, 0xf4, 0xa9, 0x8b, 0xf2, 0x60, 0x3a, 0x0a, 0xf2, 0x40, 0x15, 0xbd, 0x0d, 0x13,
-	0x7b, 0x96, 0xd1, 0xe8, 0xda, 0x5e, 0x5c, 0x41, 0xfa, 0xaf, 0x9f, 0xa9, 0xa1, 0x12, 0x9d, 0xc3,
-	0x22, 0x08, 0x4f, 0x36, 0x7b, 0x32, 0x84, 0x5e, 0xcf, 0x29, 0xe3, 0xb9, 0x8e, 0xea, 0xe6, 0xd3,
-	0x67, 0x7a, 0x3d, 0xa3, 0x8b, 0x27, 0x43, 0x9e, 0x1b, 0xf6, 0x44, 0x20, 0x48, 0x13, 0x65, 0xcd,
-	0x5f, 0x80, 0x26, 0xdf, 0x49, 0x7b, 0x22, 0x20, 0xec, 0xce, 0x4e, 0xd7, 0xa4, 0x27, 0xf7, 0xa9,
-	0xe9, 0x73, 0xee, 0xce, 0x8d, 0xae, 0x69, 0xb1, 0x38, 0xc5, 0x77, 0x27, 0x07, 0x70, 0x77, 0x8a,
-	0x34, 0x69, 0xc8, 0xba, 0x76, 0xe6, 0xee, 0xf4, 0x89, 0xf2, 0xb8, 0x35, 0x6e, 0x4a, 0x48, 0xee,
-	0x6d, 0x48, 0x79, 0x87, 0x45, 0xb2, 0x0a, 0x63, 0x9d, 0x96, 0xdd, 0x75, 0xf4, 0x23, 0xcb, 0xe9,
-	0x63, 0xc5, 0xff, 0xb2, 0xd7, 0x44, 0x1c, 0x54, 0x02, 0xef, 0x34, 0x3b, 0xa5, 0x68, 0x19, 0x3a,
-	0xef, 0x01, 0x9b, 0xc6, 0xce, 0xcb, 0xb9, 0x47, 0x30, 0x3a, 0x38, 0x41, 0x7e, 0xc2, 0xa4, 0x2d,
-	0xc8, 0x88, 0x27, 0x4a, 0x72, 0x0d, 0x46, 0x3a, 0x86, 0xb3, 0xdf, 0xb2, 0xf9, 0x05, 0x27, 0x7f,
-	0x44, 0xfc, 0x1f, 0x45, 0xe3, 0x38, 0xb9, 0x09, 0x63, 0xde, 0xed, 0x43, 0xa3, 0x7b, 0x68, 0x87,
-	0x5f, 0x1b, 0x33, 0xbc, 0xbb, 0x8c, 0xbd, 0x9c, 0xcd, 0x8f, 0xa3, 0x20, 0x1c, 0x2d, 0x87, 0xdd,
-	0x5a, 0x29, 0x1f, 0xeb, 0xd6, 0xea, 0x26, 0x8c, 0x7b, 0x57, 0x30, 0xe2, 0xe5, 0x05, 0x7d, 0x86,
-	0x9b, 0x8d, 0x4e, 0x65, 0xb5, 0x0c, 0xbf, 0x91, 0x61, 0xc3, 0xdf, 0x80, 0x8c, 0xb7, 0x63, 0x3b,
-	0x46, 0xff, 0x80, 0xdd, 0x64, 0x52, 0xea, 0xdf, 0x57, 0xa2, 0xaa, 0xaa, 0xa5, 0x79, 0xef, 0x86,
-	0xd1, 0x3f, 0x20, 0x77, 0xe1, 0x92, 0x38, 0x18, 0xfd, 0xc5, 0x75, 0xba, 0x6d, 0xf6, 0xb2, 0xe0,
-	0x71, 0x48, 0x6a, 0x44, 0x98, 0x53, 0x66, 0x43, 0xc8, 0x0c, 0xa4, 0xec, 0x3d, 0xdd, 0x75, 0x70,
-	0x2b, 0x8c, 0xc8, 0x02, 0x25, 0xed, 0xbd, 0x1d, 0xc4, 0x99, 0x82, 0xde, 0x8a, 0xa7, 0xe2, 0x6a,
-	0x22, 0xf7, 0x7d, 0x05, 0x84, 0x63, 0x32, 0xb9, 0x01, 0xaa, 0xc4, 0xd9, 0x68, 0x1c, 0xb0, 0x17,
-	0x43, 0x6d, 0x5c, 0x60, 0x56, 0x6c, 0x1c, 0x90, 0x9b, 0x30, 0x19, 0x50, 0x28, 0x1d, 0x4c, 0xdf,
-	0x0e, 0x35, 0x55, 0xd2, 0x15, 0x0e, 0x7f, 0x83, 0x9d, 0x26, 0x7c, 0x75, 0xe9, 0xfe, 0x13, 0x62,
-	0x56, 0xd4, 0x54, 0xb1, 0x71, 0x90, 0x6b, 0x40, 0x46, 0x3c, 0x6d, 0x93, 0x3a, 0x8c, 0x77, 0x8c,
-	0x63, 0xdd, 0x3f, 0xb2, 0x73, 0xdb, 0x85, 0x0e, 0x0d, 0xc5, 0xfd, 0x7d, 0xc7, 0x42, 0x67, 0x30,
-	0x07, 0xf3, 0x05, 0x0b, 0x66, 0x3a, 0xc6, 0xf1, 0x00, 0xcf, 0xfd, 0xbb, 0x02, 0xd9, 0xc0, 0xf1,
-	0xfb, 0x65, 0x75, 0xbb, 0xf2, 0x71, 0xeb, 0xf6, 0x15, 0xb8, 0x24, 0x5f, 0x46, 0xf0, 0x2b, 0xfd,
-	0xa8, 0x6c, 0xd0, 0x09, 0xe1, 0xb6, 0x81, 0xdf, 0xe4, 0xcf, 0x05, 0x2b, 0x7e, 0x54, 0x59, 0x9c,
-	0xbe, 0x06, 0x17, 0xe2, 0x37, 0x7e, 0xf4, 0x47, 0x23, 0x72, 0xf1, 0xcf, 0x9d, 0xff, 0x6f, 0x02,
-	0x6b, 0x43, 0xd3, 0x2e, 0xc2, 0x95, 0x21, 0x6b, 0x13, 0x2c, 0x3c, 0x19, 0x14, 0x1b, 0xed, 0xb6,
-	0x0c, 0x53, 0xc3, 0x24, 0x17, 0x6c, 0x7d, 0x29, 0x24, 0x34, 0xce, 0x9b, 0x85, 0x09, 0x49, 0x6e,
-	0xd1, 0xdc, 0xa2, 0xc0, 0x68, 0x6e, 0x13, 0x32, 0x62, 0x55, 0x41, 0x66, 0x20, 0xb9, 0x67, 0xb8,
-	0xae, 0xe5, 0x9c, 0xc8, 0x21, 0xe1, 0x43, 0x45, 0xf3, 0x3a, 0xc8, 0xec, 0x20, 0x6a, 0xa0, 0x14,
-	0x89, 0x12, 0x79, 0x51, 0xca, 0xe6, 0xc6, 0xa6, 0xa6, 0x6f, 0x7c, 0xf0, 0x21, 0xff, 0x37, 0x88,
-	0x1f, 0x5c, 0x27, 0x3f, 0x8c, 0xc2, 0x98, 0x54, 0x6a, 0x60, 0x5c, 0xf1, 0x9c, 0x5d, 0xb8, 0x6a,
-	0x15, 0xe3, 0x0a, 0xef, 0x66, 0x46, 0xfc, 0xbc, 0x78, 0x0d, 0x1d, 0xa5, 0x66, 0x48, 0xbf, 0x28,
-	0xa5, 0x0a, 0x23, 0x53, 0x11, 0x6a, 0x08, 0xe1, 0x4e, 0xfa, 0x01, 0x4c, 0x76, 0x5a, 0x76, 0xc8,
-	0x8f, 0x62, 0x17, 0xf4, 0xa3, 0x4e, 0xcb, 0x96, 0xfd, 0x08, 0xe9, 0xe2, 0x46, 0xf8, 0x98, 0x97,
-	0x5a, 0xb8, 0x0f, 0xc4, 0x3e, 0xae, 0xa1, 0xb7, 0x45, 0x05, 0xa1, 0x21, 0xae, 0xc3, 0x98, 0x6c,
-	0x40, 0xe6, 0x28, 0x99, 0xa6, 0x60, 0x3d, 0x32, 0x03, 0x63, 0xbe, 0x3c, 0xbe, 0x5b, 0xa4, 0xbd,
-	0x10, 0x80, 0x16, 0x6e, 0x83, 0x54, 0x2c, 0x5d, 0x54, 0xf3, 0x9f, 0x0b, 0x6b, 0x5e, 0xd8, 0x00,
-	0x7e, 0x1f, 0x5f, 0x87, 0x0e, 0x52, 0xdd, 0x84, 0xbe, 0x28, 0x71, 0x13, 0x96, 0x92, 0x15, 0xf9,
-	0xe0, 0x6a, 0x42, 0x4b, 0x8e, 0x86, 0x97, 0x9c, 0xbb, 0x0f, 0x6a, 0xb0, 0x84, 0x22, 0x77, 0x20,
-	0xc1, 0xee, 0x2a, 0x95, 0xf3, 0xde, 0x55, 0xb2, 0xf1, 0xb9, 0x7f, 0x56, 0x20, 0x1b, 0xa8, 0x99,
-	0xc8, 0xd7, 0x58, 0xc0, 0xb3, 0x5a, 0x4e, 0x4f, 0x0a, 0x41, 0xe1, 0xd7, 0x4d, 0x7a, 0x20, 0xa8,
-	0xd6, 0xb4, 0xed, 0xd2, 0x94, 0xf0, 0x88, 0x97, 0xd9, 0x30, 0x8e, 0x11, 0xa4, 0xcb, 0xa2, 0x71,
-	0xaf, 0xda, 0x72, 0x7a, 0x4c, 0x99, 0xb3, 0x30, 0xc1, 0x6f, 0x93, 0xcd, 0x27, 0x56, 0xbb, 0xcd,
-	0x2e, 0xf6, 0xd8, 0x2a, 0xb3, 0xac, 0xa3, 0x82, 0x38, 0xbd, 0xb9, 0x9b, 0x83, 0xc9, 0xc1, 0x4d,
-	0xae, 0x30, 0x9a, 0xed, 0xe3, 0x09, 0xaf, 0x6b, 0x30, 0x3e, 0xb7, 0x8d, 0x07, 0x12, 0x5e, 0xa0,
-	0x55, 0x2e, 0x74, 0x6a, 0x10, 0xa3, 0xb4, 0x70, 0x66, 0xc8, 0x7d, 0x15, 0x0f, 0x22, 0x5e, 0xb1,
-	0xf6, 0xc9, 0x90, 0x7c, 0x2f, 0x0a, 0xa1, 0x3a, 0x8d, 0x9c, 0xc0, 0x65, 0xef, 0x27, 0x30, 0xed,
-	0x56, 0xa7, 0xe5, 0xea, 0xd6, 0x71, 0xaf, 0x6b, 0x5b, 0xb6, 0xfb, 0xd2, 0x54, 0x43, 0x7f, 0x19,
-	0xb3, 0x8e, 0x63, 0xab, 0x7c, 0x68, 0x69, 0x5a, 0x30, 0xc1, 0xe4, 0x90, 0x01, 0xda, 0x24, 0xfb,
-	0x11, 0x8d, 0x04, 0x8a, 0xac, 0xa9, 0x47, 0xf8, 0xac, 0xa3, 0xaf, 0x62, 0x4d, 0xdd, 0xe9, 0x55,
-	0xac, 0xa5, 0x01, 0x1e, 0x6b, 0x09, 0xcc, 0x7d, 0x15, 0xc6, 0xa4, 0xba, 0x92, 0xbc, 0x79, 0xee,
-	0x07, 0x30, 0xf5, 0x45, 0x29, 0xf1, 0xf7, 0x4a, 0x34, 0xa5, 0x0c, 0x1e, 0x3c, 0xe8, 0xcc, 0xdc,
-	0xdf, 0x45, 0x61, 0x5c, 0x2e, 0x2b, 0x3f, 0xa9, 0xdf, 0xa2, 0x7c, 0xe2, 0x0f, 0x8f, 0x37, 0x20,
-	0x8d, 0xbb, 0xcc, 0xb1, 0x5c, 0xa7, 0x65, 0xf5, 0xf9, 0xef, 0xac, 0x06, 0xc9, 0x18, 0x3a, 0xc6,
-	0xb1, 0xc6, 0xba, 0xc8, 0x43, 0xc8, 0xf6, 0x2c, 0xa7, 0xd5, 0x35, 0x7d, 0xdb, 0xc4, 0x87, 0xdf,
-	0x1d, 0xf3, 0x6a, 0x94, 0x0e, 0x1e, 0x18, 0xc7, 0x97, 0x60, 0xbc, 0x27, 0xf5, 0xf0, 0x80, 0xf5,
-	0xaf, 0x0a, 0x4c, 0x0e, 0xa9, 0x96, 0xc9, 0xef, 0x02, 0x41, 0x01, 0xe9, 0xa1, 0xf7, 0x4c, 0x87,
-	0x64, 0x04, 0xe8, 0x11, 0x78, 0x08, 0x63, 0x8c, 0xf9, 0x52, 0x1f, 0x56, 0x7a, 0x48, 0x9c, 0x5e,
-	0x41, 0x04, 0x3c, 0x6e, 0xe6, 0x25, 0xb6, 0x69, 0x75, 0xac, 0x21, 0xa4, 0xb3, 0x1d, 0xe3, 0x58,
-	0xec, 0xca, 0xad, 0x85, 0x57, 0x83, 0xbe, 0x35, 0x0f, 0xaf, 0x85, 0x18, 0x0a, 0xa1, 0x98, 0x04,
-	0xc8, 0x60, 0xa0, 0xad, 0x43, 0x36, 0x50, 0x7b, 0x93, 0x37, 0x61, 0x84, 0xe9, 0x90, 0xeb, 0x21,
-	0x1f, 0x94, 0xd5, 0x9b, 0xc0, 0x6c, 0x20, 0xc8, 0xc9, 0xe7, 0xe5, 0xfe, 0x54, 0x01, 0x12, 0xae,
-	0xb9, 0xe5, 0xf4, 0xae, 0xbc, 0x32, 0xbd, 0x7f, 0xd2, 0x9e, 0xc8, 0xdd, 0xe0, 0x71, 0x48, 0xae,
-	0x73, 0x27, 0xe1, 0x8b, 0x9d, 0xc6, 0x73, 0xfb, 0x90, 0x0d, 0x54, 0xec, 0x64, 0x5a, 0xcc, 0x5f,
-	0xd2, 0xcf, 0x0d, 0x19, 0x1e, 0xce, 0xd9, 0xd1, 0x57, 0xe5, 0x6c, 0xbe, 0xa4, 0x37, 0x61, 0x4c,
-	0x2a, 0xe1, 0x2f, 0xa0, 0x65, 0x4e, 0x61, 0x51, 0xa4, 0x70, 0x5e, 0x7d, 0xe4, 0x56, 0xbd, 0xc0,
-	0xe6, 0x55, 0xe0, 0x4b, 0xe7, 0x79, 0xc0, 0x14, 0x93, 0x33, 0x1d, 0x9d, 0xbb, 0x07, 0xe3, 0x72,
-	0x15, 0xfe, 0x11, 0x09, 0xf1, 0x5f, 0x02, 0x8f, 0x42, 0x92, 0x3f, 0x14, 0xcd, 0xd4, 0x81, 0x48,
-	0xce, 0xf1, 0xc0, 0x68, 0x1f, 0x5a, 0xe4, 0x77, 0x20, 0x71, 0x84, 0x1f, 0x17, 0x2d, 0x39, 0xd8,
-	0xac, 0x99, 0x5d, 0x98, 0x94, 0xdd, 0x9f, 0x51, 0xfd, 0xb2, 0x4c, 0xf5, 0xfc, 0x5b, 0x86, 0x93,
-	0xd5, 0x61, 0x6a, 0x48, 0x65, 0xc5, 0x68, 0x97, 0x65, 0xda, 0x17, 0x2c, 0xc9, 0x38, 0x83, 0x7b,
-	0x90, 0xe1, 0xe7, 0x23, 0x46, 0xf4, 0x8e, 0x4c, 0xf4, 0x3c, 0x87, 0x29, 0x5f, 0xd2, 0x70, 0xde,
-	0x3d, 0xa7, 0xa4, 0x43, 0x32, 0xfa, 0xcb, 0x19, 0x48, 0x89, 0xf4, 0x22, 0x0c, 0xe4, 0xbc, 0x1d,
-	0x64, 0x30, 0xfb, 0x43, 0x05, 0x12, 0xf4, 0x17, 0xdf, 0x44, 0x85, 0xcc, 0x5b, 0x5b, 0xb5, 0x4d,
-	0x5d, 0xab, 0x7e, 0x75, 0xb7, 0x5a, 0xdf, 0x51, 0x23, 0x24, 0x0b, 0x69, 0x8a, 0x14, 0xcb, 0xe5,
-	0xea, 0xf6, 0x8e, 0xaa, 0x10, 0x02, 0xe3, 0xbb, 0x9b, 0xe5, 0xad, 0xcd, 0xd5, 0x9a, 0xb6, 0x51,
-	0xad, 0xe8, 0xbb, 0xdb, 0x6a, 0x94, 0x5c, 0x02, 0x55, 0xc4, 0x2a, 0x5b, 0x0f, 0x37, 0xd5, 0x18,
-	0x12, 0x93, 0xc6, 0xc5, 0x71, 0x6e, 0x60, 0x54, 0x02, 0x31, 0xad, 0x2a, 0x31, 0x1d, 0x41, 0xa6,
-	0xdb, 0xda, 0xd6, 0xb6, 0x56, 0xab, 0xee, 0x14, 0xb5, 0x47, 0x6a, 0x72, 0xf6, 0x0a, 0x24, 0xe8,
-	0x6f, 0xcb, 0xc9, 0x38, 0xc0, 0xfa, 0x96, 0x56, 0x7c, 0x58, 0xdc, 0xd4, 0xb5, 0x79, 0x35, 0x32,
-	0xfb, 0x5d, 0x85, 0x3e, 0xb2, 0xf2, 0x73, 0x16, 0x4e, 0xdc, 0x28, 0x96, 0xf5, 0xdd, 0xcd, 0xfb,
-	0x9b, 0x48, 0x3d, 0x42, 0x32, 0x90, 0x42, 0xe0, 0xc1, 0xbc, 0x7e, 0x5b, 0x55, 0x70, 0xb6, 0xd7,
-	0xd2, 0xe7, 0xd5, 0xa8, 0xd4, 0x2e, 0xa8, 0x31, 0x61, 0xf4, 0xbc, 0x1a, 0x97, 0x7a, 0x17, 0xd4,
-	0x84, 0xd4, 0x5e, 0x54, 0x47, 0x72, 0xa9, 0xf7, 0xfe, 0x32, 0x1f, 0xf9, 0xe9, 0x8f, 0xf3, 0x91,
-	0xd9, 0xbf, 0x55, 0x00, 0xb6, 0xd7, 0x1e, 0x09, 0x52, 0x6c, 0xaf, 0x3d, 0x92, 0xa5, 0x40, 0xc0,
-	0x97, 0xc2, 0x6b, 0x51, 0x29, 0x2e, 0x81, 0x3a, 0x68, 0x17, 0x74, 0xad, 0xfa, 0x40, 0x2f, 0xaa,
-	0xb1, 0x21, 0x68, 0x89, 0x69, 0x90, 0xa3, 0xf3, 0x7c, 0x64, 0x22, 0x84, 0x95, 0xd4, 0x11, 0x69,
-	0xf6, 0x02, 0x1f, 0x99, 0x14, 0x25, 0x8e, 0xc2, 0x98, 0x5c, 0xe7, 0x65, 0x21, 0x5d, 0x29, 0xee,
-	0x14, 0x75, 0xad, 0xb8, 0x53, 0xd5, 0x6f, 0x33, 0xcb, 0xfb, 0xc0, 0xbc, 0xaa, 0xc8, 0x40, 0x41,
-	0x8d, 0xca, 0xc0, 0x82, 0x1a, 0x93, 0x81, 0x45, 0x35, 0x2e, 0x03, 0x4b, 0x6a, 0x42, 0x06, 0x96,
-	0x99, 0xa9, 0x7d, 0xe0, 0x8e, 0x9a, 0x94, 0x81, 0x15, 0x35, 0x25, 0x03, 0x77, 0xd5, 0x51, 0xf4,
-	0x2b, 0x41, 0xb0, 0xdb, 0x2a, 0x04, 0x90, 0x79, 0x35, 0x1d, 0x40, 0x0a, 0x6a, 0x26, 0x80, 0x2c,
-	0xa8, 0x63, 0x01, 0x64, 0x51, 0x1d, 0x0f, 0x20, 0x4b, 0x6a, 0x56, 0xd0, 0xd8, 0x6d, 0x00, 0xff,
-	0xb8, 0x48, 0xd2, 0x90, 0x2c, 0x6f, 0x6d, 0xee, 0x54, 0xdf, 0xc6, 0x3d, 0x92, 0x86, 0x64, 0xbd,
-	0x5a, 0xaf, 0xd7, 0xb6, 0x36, 0x55, 0x85, 0xa4, 0x20, 0x7e, 0xbf, 0xfa, 0xa8, 0xae, 0x46, 0x71,
-	0x86, 0xff, 0xbb, 0x43, 0x5c, 0xc6, 0x2a, 0xf5, 0xf0, 0xcd, 0x72, 0xad, 0x5a, 0x57, 0x23, 0x64,
-	0x02, 0xc6, 0xca, 0x6b, 0xc5, 0xcd, 0xcd, 0xea, 0xba, 0xbe, 0x51, 0xac, 0xdf, 0xaf, 0xab, 0xca,
-	0xec, 0x22, 0x24, 0x68, 0x2c, 0xa7, 0xe4, 0xd7, 0x8b, 0xf5, 0xba, 0x5e, 0x64, 0xe4, 0x59, 0xa3,
-	0xa4, 0x2a, 0x7e, 0xa3, 0xac, 0x46, 0x73, 0x71, 0x94, 0x6e, 0xb6, 0x07, 0x24, 0xfc, 0xe3, 0x0a,
-	0x02, 0x30, 0xb2, 0xbe, 0xf5, 0x90, 0x6d, 0xe2, 0x24, 0xc4, 0xd6, 0xb7, 0x1e, 0xaa, 0x0a, 0x2e,
-	0xb0, 0x54, 0x5d, 0xdf, 0x7a, 0xa8, 0x6f, 0x6e, 0x69, 0x1b, 0xc5, 0x75, 0x35, 0x8a, 0xc3, 0xf8,
-	0x37, 0xdd, 0xb0, 0xc5, 0xd2, 0xd6, 0x83, 0xaa, 0xd7, 0x1b, 0xc7, 0xc5, 0xac, 0xd5, 0xee, 0xad,
-	0xa9, 0x09, 0xe4, 0x8b, 0x5f, 0x74, 0x7f, 0xce, 0xfe, 0x67, 0x0c, 0x2e, 0x0d, 0xfb, 0xc5, 0x02,
-	0x19, 0x83, 0xd1, 0x72, 0xad, 0xa2, 0x6b, 0xab, 0xbb, 0xd4, 0x85, 0xbc, 0x66, 0xb5, 0x5e, 0xe5,
-	0xa1, 0x03, 0x9b, 0xeb, 0xb5, 0xcd, 0xfb, 0x7a, 0x79, 0xad, 0x5a, 0xbe, 0xaf, 0x46, 0x69, 0x90,
-	0xf0, 0xb0, 0x62, 0x45, 0x53, 0x63, 0xde, 0xa8, 0xca, 0xee, 0xce, 0x23, 0xbd, 0xfc, 0xa8, 0xbc,
-	0x5e, 0x55, 0xe3, 0xe4, 0x32, 0x10, 0x4a, 0xe8, 0x6d, 0x7d, 0xbb, 0xa8, 0x15, 0x37, 0xf4, 0x7a,
-	0x75, 0x67, 0x77, 0x9b, 0xb9, 0x3e, 0x1d, 0x5b, 0x7d, 0xa0, 0xd7, 0x77, 0x8a, 0x3b, 0xbb, 0x75,
-	0x75, 0x84, 0x4c, 0x42, 0x16, 0xb1, 0xcd, 0xea, 0x43, 0x9d, 0xeb, 0x57, 0x4d, 0x92, 0x2b, 0x30,
-	0xc9, 0x09, 0xec, 0xd4, 0x36, 0x6a, 0x9b, 0xf7, 0x38, 0x85, 0x94, 0x47, 0x79, 0x47, 0xa6, 0x3c,
-	0x3a, 0xa0, 0xbc, 0x3e, 0x20, 0x02, 0xfe, 0x72, 0xee, 0x57, 0x1f, 0xa9, 0x69, 0x8f, 0x66, 0xb1,
-	0xa2, 0x49, 0x73, 0x33, 0x9e, 0x04, 0x95, 0xea, 0x83, 0x5a, 0xb9, 0x8a, 0x0c, 0xab, 0xea, 0x18,
-	0xee, 0x48, 0x04, 0x57, 0xb7, 0xb4, 0x72, 0x55, 0x67, 0x11, 0x4f, 0x1d, 0x27, 0x39, 0xb8, 0xcc,
-	0x48, 0xd2, 0x08, 0x28, 0x92, 0xc9, 0x7a, 0xa2, 0x6d, 0x53, 0x71, 0xd7, 0xb7, 0x76, 0xf4, 0xda,
-	0xe6, 0xea, 0x96, 0xaa, 0x92, 0xab, 0xf0, 0x9a, 0x8c, 0x7b, 0x12, 0x4e, 0x90, 0xd7, 0x60, 0x02,
-	0xbb, 0x4a, 0xd5, 0x62, 0x79, 0x6b, 0x93, 0x2f, 0x55, 0x25, 0x9e, 0x40, 0x1c, 0x46, 0x37, 0x54,
-	0x27, 0x03, 0x52, 0x6e, 0x6c, 0x55, 0xaa, 0xea, 0x35, 0xee, 0x51, 0xbf, 0x8c, 0xc2, 0xe4, 0x90,
-	0x24, 0x4a, 0xf7, 0xc7, 0xc0, 0x2c, 0xfa, 0xbc, 0x1a, 0x09, 0x20, 0x05, 0xe6, 0x62, 0x02, 0xb2,
-	0xc8, 0x4c, 0x2c, 0x20, 0x2b, 0x6a, 0x0c, 0x5d, 0x5f, 0xa4, 0xb3, 0xac, 0xc6, 0x03, 0xd0, 0x42,
-	0x41, 0x4d, 0x04, 0xa0, 0xe5, 0x45, 0x75, 0x04, 0xad, 0x22, 0x4e, 0x2c, 0xac, 0xa8, 0xc9, 0x00,
-	0x56, 0x58, 0x5a, 0x56, 0x53, 0x01, 0x6c, 0x69, 0xbe, 0xa0, 0x8e, 0xe2, 0x7a, 0xc5, 0xb9, 0xb7,
-	0x0b, 0x8b, 0x2a, 0x04, 0xc0, 0xc2, 0xed, 0xc5, 0x15, 0x35, 0x1d, 0x00, 0x17, 0x6f, 0xdf, 0x5d,
-	0x66, 0x46, 0x15, 0x57, 0x31, 0x7f, 0xb7, 0xc0, 0x8c, 0x2a, 0x2d, 0x64, 0x61, 0x05, 0xc3, 0x88,
-	0x8c, 0x2e, 0x14, 0xee, 0x2c, 0xaf, 0xa8, 0x59, 0xae, 0xda, 0x7f, 0x50, 0x60, 0x5c, 0x3e, 0xfb,
-	0xe0, 0x3a, 0xa9, 0x2d, 0xab, 0x0f, 0xaa, 0xda, 0x23, 0x7d, 0x9e, 0xc7, 0x06, 0x01, 0x2a, 0xd4,
-	0x55, 0x25, 0x00, 0x2d, 0xd6, 0xd5, 0x68, 0x00, 0x5a, 0xa9, 0xb3, 0xcd, 0x23, 0xd2, 0x5a, 0xae,
-	0xf3, 0x9c, 0xe1, 0x63, 0x0b, 0x85, 0x3a, 0xcf, 0x19, 0x3e, 0xb6, 0xbc, 0xc8, 0x37, 0x8e, 0x38,
-	0xb7, 0xb0, 0x52, 0x57, 0x93, 0x5c, 0xea, 0x3f, 0x8c, 0x79, 0xf5, 0x95, 0x5c, 0xd0, 0x4d, 0x42,
-	0x96, 0xbb, 0x6e, 0x79, 0x6b, 0x77, 0x73, 0x07, 0x4d, 0x19, 0x09, 0x81, 0x0b, 0xe8, 0x16, 0x41,
-	0x70, 0x79, 0x91, 0x65, 0x3e, 0x79, 0x7a, 0x61, 0x85, 0x65, 0x3e, 0x09, 0x45, 0x93, 0xc6, 0x43,
-	0x28, 0x1a, 0x35, 0x81, 0x0e, 0x2f, 0x53, 0x40, 0xb3, 0x8e, 0x84, 0x60, 0x6a, 0xd8, 0x64, 0x08,
-	0xa6, 0xa6, 0x4d, 0x85, 0x60, 0x6a, 0xdc, 0x51, 0xdc, 0x7f, 0x81, 0xc5, 0xa1, 0x79, 0x21, 0x84,
-	0x33, 0x03, 0xa7, 0x43, 0xf8, 0xf2, 0xd2, 0xd2, 0x02, 0x7a, 0xce, 0x15, 0x98, 0x94, 0xe9, 0x2c,
-	0xcc, 0xdf, 0xbe, 0x83, 0xde, 0x13, 0xec, 0x28, 0x2c, 0x17, 0xe6, 0x17, 0xd1, 0x81, 0x82, 0x1d,
-	0x4b, 0x85, 0xc5, 0xc2, 0x8a, 0xef, 0x43, 0xef, 0x47, 0x81, 0x84, 0xcb, 0x63, 0x74, 0x07, 0x3e,
-	0x0b, 0x43, 0x0e, 0x0d, 0xc0, 0x01, 0x68, 0x9e, 0xf9, 0x91, 0x08, 0x15, 0x98, 0x1f, 0x89, 0xd0,
-	0x02, 0xdb, 0xa1, 0x22, 0xb4, 0xc8, 0x76, 0xa8, 0x08, 0x2d, 0xb1, 0x1d, 0x2a, 0x42, 0x98, 0xcf,
-	0x03, 0x10, 0x66, 0xf4, 0x00, 0x84, 0x39, 0x3d, 0x00, 0xdd, 0x65, 0x01, 0x57, 0x12, 0x15, 0xf3,
-	0x7a, 0x10, 0xc3, 0xcc, 0x1e, 0xc4, 0x30, 0xb7, 0x07, 0x31, 0xcc, 0xee, 0x41, 0x0c, 0xf5, 0x1a,
-	0xc4, 0x96, 0x06, 0x2a, 0xfd, 0x27, 0xc5, 0xfb, 0xa3, 0x26, 0xf9, 0x1e, 0x45, 0xf0, 0xdb, 0xed,
-	0xaa, 0x56, 0xdb, 0xaa, 0x50, 0xb5, 0x86, 0xc0, 0x79, 0xc9, 0xc3, 0x39, 0x88, 0xaa, 0x0d, 0x81,
-	0xa8, 0xdc, 0x10, 0x88, 0xea, 0x0d, 0x81, 0xa8, 0xe0, 0x10, 0xb8, 0xcc, 0xf6, 0xa9, 0x0c, 0xde,
-	0x19, 0xec, 0xd3, 0x7f, 0x8b, 0x02, 0xf8, 0xf7, 0xb3, 0x34, 0x82, 0xb2, 0xf0, 0x8e, 0x4d, 0x7d,
-	0x45, 0x8d, 0xd0, 0xc8, 0x28, 0x40, 0xf3, 0xb7, 0x59, 0x5e, 0x96, 0x30, 0x14, 0x3c, 0x88, 0x2d,
-	0xb0, 0xe0, 0x22, 0x61, 0x8b, 0x2c, 0xb8, 0x48, 0xd8, 0x32, 0x0b, 0x2e, 0x12, 0xb6, 0xc2, 0x23,
-	0xb7, 0x80, 0x15, 0x6e, 0xf3, 0xc8, 0x2d, 0x62, 0xf3, 0x3c, 0x72, 0x8b, 0xd8, 0x22, 0x73, 0x0d,
-	0x09, 0x5b, 0x66, 0xae, 0x21, 0x61, 0x77, 0x98, 0x6b, 0x48, 0xd8, 0x5d, 0xe6, 0x1a, 0x22, 0xb6,
-	0x70, 0x9b, 0xb9, 0x86, 0x84, 0x2d, 0x30, 0xd7, 0x90, 0xb0, 0xe5, 0x81, 0x6b, 0xbc, 0x17, 0x83,
-	0x61, 0x17, 0xab, 0x68, 0x06, 0x4c, 0xfd, 0xc5, 0xf2, 0x7d, 0x7d, 0xbd, 0xb6, 0x51, 0xdb, 0xa1,
-	0xf9, 0x30, 0x04, 0xf2, 0xd8, 0x27, 0x83, 0x8b, 0xcc, 0x33, 0x64, 0x90, 0x87, 0xbe, 0x00, 0x4d,
-	0x1e, 0xfa, 0x64, 0x94, 0xa6, 0xc7, 0x10, 0xba, 0xcc, 0x23, 0x5f, 0x80, 0x42, 0x81, 0x47, 0xbe,
-	0x80, 0x5c, 0x4b, 0x3c, 0xf2, 0xc9, 0x30, 0x4b, 0x95, 0x97, 0x81, 0x04, 0x88, 0xb0, 0x6c, 0x19,
-	0xc2, 0x79, 0xc2, 0x0c, 0xe1, 0x3c, 0x67, 0x86, 0x70, 0x9e, 0x36, 0xaf, 0x50, 0x8d, 0x4a, 0xcb,
-	0x64, 0x99, 0x33, 0xd4, 0x21, 0x27, 0x4f, 0xdf, 0x14, 0x52, 0x45, 0x2b, 0xea, 0xb2, 0x52, 0x5d,
-	0x2f, 0x3e, 0x0a, 0x9a, 0x82, 0x81, 0x01, 0x53, 0x30, 0x30, 0x60, 0x0a, 0x06, 0x06, 0x4c, 0xc1,
-	0x69, 0x06, 0x4c, 0xc1, 0xd0, 0xa0, 0x29, 0x18, 0x1a, 0x34, 0x05, 0xa7, 0x10, 0x34, 0x05, 0x97,
-	0x2b, 0x68, 0x0a, 0x06, 0x87, 0x4c, 0xc1, 0x89, 0x84, 0x4c, 0xc1, 0xa9, 0x84, 0x4c, 0xc1, 0x17,
-	0x18, 0x32, 0x05, 0x5f, 0x63, 0xc8, 0x14, 0xde, 0x32, 0x43, 0xa6, 0xf0, 0x56, 0x2a, 0x9a, 0xe2,
-	0x07, 0x51, 0x48, 0xf2, 0x2b, 0x11, 0x2c, 0x68, 0xb5, 0xb7, 0xf9, 0x28, 0x0c, 0x8f, 0x62, 0x7b,
-	0x9e, 0x15, 0xbc, 0x83, 0x76, 0x81, 0x95, 0xdd, 0x83, 0x36, 0xc6, 0x15, 0xb1, 0xbd, 0xc8, 0x0a,
-	0xef, 0x41, 0x7b, 0x89, 0x15, 0xde, 0x83, 0x36, 0x06, 0x40, 0xb1, 0x8d, 0x09, 0x46, 0x6c, 0x63,
-	0x76, 0x11, 0xdb, 0x98, 0x5a, 0xb2, 0x90, 0xf6, 0xe5, 0xc1, 0xbc, 0x22, 0x01, 0x98, 0x54, 0x24,
-	0x00, 0x33, 0x8a, 0x04, 0x60, 0x3a, 0x91, 0x00, 0xd4, 0x8f, 0x04, 0xc8, 0xa5, 0xe2, 0x0f, 0xa3,
-	0x90, 0xa0, 0xef, 0x41, 0xf4, 0x3e, 0xa2, 0xb6, 0xb9, 0xa5, 0x0d, 0x2a, 0xa2, 0x34, 0x24, 0x19,
-	0xc0, 0x0b, 0x6a, 0xbf, 0x97, 0x17, 0xd4, 0x3e, 0xc0, 0x0b, 0x6a, 0x1f, 0xe0, 0x05, 0xb5, 0x0f,
-	0xf0, 0x82, 0xda, 0x07, 0x78, 0x41, 0xed, 0x03, 0xbc, 0xa0, 0xf6, 0x01, 0x5e, 0x50, 0xfb, 0x00,
-	0x2f, 0xa8, 0x7d, 0xc0, 0x2b, 0xa8, 0x05, 0x84, 0x17, 0xd4, 0x02, 0xc2, 0x0b, 0x6a, 0x01, 0xe1,
-	0x05, 0xb5, 0x80, 0xf0, 0x82, 0x5a, 0x40, 0x06, 0xe9, 0xb6, 0xf4, 0x57, 0xca, 0x2f, 0x9e, 0xe5,
-	0x95, 0xf7, 0x9f, 0xe5, 0x95, 0x5f, 0x3e, 0xcb, 0x47, 0x3e, 0x78, 0x96, 0x8f, 0xfc, 0xea, 0x59,
-	0x3e, 0xf2, 0xeb, 0x67, 0xf9, 0xc8, 0x6f, 0x9e, 0xe5, 0x95, 0x6f, 0x9f, 0xe6, 0x95, 0xf7, 0x4e,
-	0xf3, 0x91, 0x9f, 0x9c, 0xe6, 0x95, 0x9f, 0x9e, 0xe6, 0x23, 0x3f, 0x3b, 0xcd, 0x47, 0x7e, 0x7e,
-	0x9a, 0x8f, 0xfc, 0xe2, 0x34, 0xaf, 0xbc, 0x7f, 0x9a, 0x57, 0x7e, 0x79, 0x9a, 0x8f, 0x7c, 0x70,
-	0x9a, 0x57, 0x7e, 0x75, 0x9a, 0x8f, 0xfc, 0xfa, 0x34, 0xaf, 0xfc, 0xe6, 0x34, 0x1f, 0xf9, 0xf6,
-	0xf3, 0x7c, 0xe4, 0xbd, 0xe7, 0x79, 0xe5, 0x7b, 0xcf, 0xf3, 0x91, 0x3f, 0x7f, 0x9e, 0x57, 0x7e,
-	0xf4, 0x3c, 0x1f, 0xf9, 0xc9, 0xf3, 0x7c, 0xe4, 0xa7, 0xcf, 0xf3, 0xca, 0xcf, 0x9e, 0xe7, 0x95,
-	0x9f, 0x3f, 0xcf, 0x2b, 0x5f, 0xbb, 0x75, 0x81, 0x3f, 0x00, 0x75, 0xed, 0xde, 0xde, 0xde, 0x08,
-	0x7d, 0x85, 0x5a, 0xf8, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x46, 0x79, 0x38, 0xfa, 0x23, 0x44,
-	0x00, 0x00,
+	0x75, 0x3f, 0x06, 0xdf, 0x7c, 0xf8, 0x6a, 0x36, 0xf5, 0x01, 0xc1, 0x6b, 0x50, 0xa6, 0xfc, 0x2f,
+	0xcb, 0xdc, 0xbf, 0x28, 0x11, 0xfc, 0x10, 0x65, 0x3b, 0xf6, 0xe2, 0x8b, 0x22, 0x56, 0xfc, 0xf2,
+	0x80, 0x94, 0x56, 0x8e, 0x5d, 0xe3, 0x21, 0x66, 0x40, 0x61, 0x09, 0x0c, 0xe0, 0xc1, 0x90, 0x22,
+	0x9d, 0x8b, 0x63, 0x5f, 0xb6, 0x92, 0x4a, 0xc5, 0xe5, 0x43, 0x12, 0x5f, 0x62, 0x57, 0xc5, 0xa9,
+	0xb8, 0x2a, 0x87, 0x38, 0xc9, 0xc5, 0x87, 0x1c, 0x7c, 0xc8, 0xc1, 0xa9, 0xe4, 0xb0, 0xb9, 0x39,
+	0x49, 0x45, 0x59, 0x51, 0x17, 0x9f, 0x52, 0x3e, 0xba, 0x74, 0xc8, 0xa6, 0x5e, 0x77, 0x0f, 0xe6,
+	0x0b, 0x12, 0x49, 0xef, 0x3a, 0xba, 0x68, 0xfa, 0xd7, 0xdd, 0xef, 0xbd, 0x7e, 0xef, 0xf5, 0x7b,
+	0xfd, 0xba, 0x41, 0x98, 0xee, 0xf6, 0x4d, 0xf5, 0xa9, 0x6a, 0xdc, 0x1a, 0x5a, 0x6a, 0xeb, 0xe0,
+	0xb6, 0x3a, 0xe8, 0xdc, 0x16, 0xc8, 0xdc, 0xc0, 0xec, 0x5b, 0x7d, 0x9a, 0xb5, 0x2c, 0x63, 0xce,
+	0x86, 0x8e, 0x16, 0x0a, 0xe5, 0xfd, 0x8e, 0xf5, 0xe4, 0x70, 0x6f, 0xae, 0xd5, 0xef, 0xdd, 0xd6,
+	0x8d, 0xa3, 0xfe, 0xc9, 0xc0, 0xec, 0x1f, 0x9f, 0xdc, 0x66, 0x83, 0x5b, 0xb7, 0xf6, 0x75, 0xe3,
+	0xd6, 0x91, 0xda, 0xed, 0x68, 0xaa, 0xa5, 0xdf, 0x0e, 0x7c, 0x70, 0x92, 0x85, 0x5b, 0x2e, 0x12,
+	0xfb, 0xfd, 0xfd, 0x3e, 0x9f, 0xbc, 0x77, 0xd8, 0x66, 0x2d, 0xd6, 0x60, 0x5f, 0x62, 0xf8, 0x1b,
+	0xfb, 0xfd, 0xfe, 0x7e, 0x57, 0x77, 0x46, 0x0d, 0x2d, 0xf3, 0xb0, 0x65, 0x89, 0xde, 0x69, 0x7f,
+	0xaf, 0xd5, 0xe9, 0xe9, 0x43, 0x4b, 0xed, 0x0d, 0xc4, 0x80, 0x1b, 0xc1, 0x15, 0x76, 0x34, 0xdd,
+	0xb0, 0x3a, 0xed, 0x8e, 0x6e, 0x0e, 0xf9, 0xa0, 0x99, 0x0f, 0x22, 0x90, 0xd8, 0xd0, 0x87, 0x43,
+	0x75, 0x5f, 0xa7, 0x9f, 0x87, 0x58, 0x4f, 0x79, 0xa2, 0x99, 0x79, 0xe9, 0xba, 0x74, 0x33, 0x55,
+	0xba, 0x34, 0xe7, 0xd5, 0xc0, 0xdc, 0xc6, 0x5a, 0x4d, 0xae, 0x90, 0x97, 0x95, 0xd8, 0x1f, 0x48,
+	0x61, 0x22, 0xfd, 0xfc, 0xd9, 0x74, 0xe8, 0xfd, 0x67, 0xd3, 0x92, 0x1c, 0xed, 0xad, 0x69, 0x26,
+	0x9d, 0x81, 0x48, 0xaf, 0xd3, 0xca, 0x87, 0xaf, 0x4b, 0x37, 0xd3, 0x38, 0x28, 0xfe, 0xcd, 0x28,
+	0x09, 0xe5, 0xa3, 0xa7, 0xcf, 0xa6, 0x23, 0x1b, 0x8d, 0xaa, 0x8c, 0x9d, 0x74, 0x03, 0x52, 0x3d,
+	0xb5, 0xa5, 0x0c, 0xd4, 0x93, 0x6e, 0x5f, 0xd5, 0xf2, 0x11, 0xc6, 0xa6, 0x10, 0x60, 0x53, 0xae,
+	0x6e, 0xf3, 0x11, 0x95, 0xec, 0xe9, 0xb3, 0x69, 0x70, 0xda, 0x6b, 0x21, 0x19, 0x7a, 0x6a, 0x4b,
+	0xb4, 0xe8, 0x43, 0xb8, 0xf4, 0x6e, 0xbf, 0x63, 0x28, 0xa6, 0xfe, 0x8d, 0x43, 0x7d, 0x68, 0x8d,
+	0xe8, 0x46, 0x19, 0xdd, 0x19, 0x3f, 0xdd, 0xb7, 0xfb, 0x1d, 0x43, 0xe6, 0x43, 0x1d, 0x7a, 0xf4,
+	0xdd, 0x00, 0x4a, 0x9b, 0x30, 0xc5, 0xe8, 0xaa, 0xad, 0x96, 0x3e, 0x70, 0xc8, 0xc6, 0x18, 0xd9,
+	0x4f, 0x8d, 0x23, 0x5b, 0x66, 0x23, 0x1d, 0xaa, 0x93, 0xef, 0xfa, 0x41, 0xfa, 0x55, 0xb8, 0x62,
+	0xea, 0x63, 0xc5, 0x8d, 0x33, 0xba, 0x9f, 0xf6, 0xd3, 0x95, 0xf5, 0x77, 0xc7, 0x09, 0x7c, 0xc9,
+	0x1c, 0x83, 0x7f, 0x2e, 0xfa, 0xd3, 0x1f, 0x4e, 0x87, 0x2a, 0x59, 0x48, 0xd8, 0xec, 0x22, 0xbf,
+	0xae, 0x48, 0x6f, 0x47, 0x93, 0x09, 0x92, 0x9c, 0x39, 0x84, 0x28, 0x5a, 0x8e, 0x2e, 0x43, 0xbc,
+	0xa7, 0x58, 0x27, 0x03, 0x9d, 0xd9, 0x37, 0x5b, 0xba, 0x1c, 0x50, 0xfc, 0xce, 0xc9, 0x40, 0xaf,
+	0x24, 0x5f, 0x56, 0x62, 0xdf, 0x46, 0x03, 0xcb, 0xb1, 0x1e, 0x02, 0x74, 0x09, 0x62, 0x3d, 0xf5,
+	0xdd, 0xbe, 0x99, 0x0f, 0xbf, 0x62, 0x1a, 0x76, 0x7a, 0xa6, 0x21, 0x30, 0xf3, 0xfb, 0x61, 0x70,
+	0x99, 0x0e, 0x9d, 0xab, 0xfd, 0x3a, 0xe7, 0x5a, 0x7d, 0x85, 0x73, 0xb5, 0xd1, 0xb9, 0xa6, 0x21,
+	0xde, 0x56, 0x06, 0x7d, 0xd3, 0x62, 0x32, 0x64, 0x18, 0xb3, 0xd9, 0x48, 0xfe, 0x43, 0x49, 0x8e,
+	0xb5, 0xb7, 0xfb, 0xa6, 0x45, 0x6f, 0x43, 0xaa, 0x6d, 0xf6, 0x3c, 0x9e, 0x95, 0xe6, 0xde, 0xb3,
+	0x2a, 0x6f, 0x08, 0x11, 0x64, 0x68, 0x9b, 0x3d, 0x5b, 0x9c, 0xb7, 0x20, 0xa7, 0xe9, 0xad, 0xbe,
+	0xa6, 0x6b, 0x3e, 0xb7, 0xb9, 0x3a, 0xc7, 0xf7, 0xd5, 0x9c, 0xbd, 0xaf, 0xe6, 0x9a, 0x6c, 0xd7,
+	0xc9, 0x59, 0x31, 0xde, 0xa6, 0xf0, 0x06, 0x40, 0xfb, 0xb0, 0xdb, 0x55, 0xda, 0x4a, 0xcb, 0xb0,
+	0x98, 0x73, 0x64, 0xe4, 0x24, 0x22, 0xab, 0x55, 0xc3, 0xe2, 0x06, 0x99, 0xf9, 0xa5, 0x04, 0x51,
+	0x5c, 0x18, 0xfd, 0x0a, 0x24, 0x35, 0xfd, 0x48, 0x51, 0x35, 0xa1, 0x80, 0x74, 0xe5, 0x4b, 0xb8,
+	0xc4, 0x7f, 0x7f, 0x36, 0x7d, 0x77, 0xbf, 0x3f, 0x67, 0x3d, 0xd1, 0xad, 0x27, 0x1d, 0x63, 0x7f,
+	0x38, 0x67, 0xe8, 0xd6, 0xd3, 0xbe, 0x79, 0x70, 0xdb, 0xbb, 0x75, 0x8f, 0x16, 0x6e, 0x0f, 0x0e,
+	0xf6, 0x6f, 0xa3, 0xed, 0x86, 0x73, 0x35, 0xfd, 0xa8, 0xac, 0x69, 0xa6, 0x9c, 0xd0, 0xf8, 0x07,
+	0xfd, 0x22, 0x2a, 0xa7, 0x65, 0x99, 0x5d, 0xa6, 0x9c, 0x54, 0xd0, 0x40, 0xab, 0x55, 0xcb, 0xec,
+	0x8e, 0xd1, 0x6d, 0xac, 0x8d, 0x1d, 0xb4, 0x08, 0x31, 0xbe, 0x86, 0x08, 0xd3, 0xed, 0xc4, 0xcb,
+	0x4a, 0x7c, 0x36, 0x9a, 0xff, 0xf0, 0xc3, 0x88, 0x1c, 0x6d, 0x57, 0x0d, 0x8b, 0x16, 0x91, 0x7e,
+	0x7f, 0x60, 0x0d, 0x99, 0x86, 0xd2, 0x95, 0xc4, 0xcb, 0x4a, 0xf4, 0x9b, 0xe1, 0x7c, 0x4e, 0x8e,
+	0xb5, 0xb7, 0x06, 0xd6, 0x50, 0x2c, 0xf5, 0xfb, 0x12, 0xc4, 0x18, 0x23, 0x7a, 0x0d, 0x22, 0xaa,
+	0x58, 0x66, 0xb2, 0x92, 0xc0, 0x00, 0x50, 0xae, 0xc9, 0x32, 0x62, 0xf4, 0x16, 0xa4, 0x54, 0xcd,
+	0x54, 0xd4, 0xd6, 0x01, 0xee, 0x02, 0x26, 0x6f, 0xb2, 0x92, 0x39, 0x7d, 0x36, 0x3d, 0x51, 0xae,
+	0xc9, 0xe5, 0xd6, 0x81, 0xac, 0x7f, 0x43, 0x9e, 0x50, 0x35, 0x93, 0x7f, 0x52, 0x02, 0x11, 0xb5,
+	0x75, 0xc0, 0xe4, 0x4a, 0xca, 0xf8, 0x49, 0x3f, 0x01, 0x13, 0x6d, 0x65, 0xa0, 0x1b, 0x5a, 0xc7,
+	0xd8, 0x67, 0xe2, 0x24, 0xe5, 0x64, 0x7b, 0x9b, 0xb7, 0xe9, 0x55, 0x48, 0xb4, 0xba, 0xea, 0x70,
+	0xa8, 0xec, 0x31, 0x73, 0x24, 0xe5, 0x38, 0x6b, 0x56, 0x66, 0x7e, 0x16, 0x06, 0x1a, 0xdc, 0xfd,
+	0xb4, 0x05, 0x49, 0xb6, 0x21, 0xf5, 0xc3, 0x8e, 0x30, 0xca, 0x9a, 0x30, 0xca, 0xd2, 0x45, 0x8d,
+	0x52, 0xdf, 0x6d, 0x2c, 0x2f, 0x9e, 0x3e, 0x9b, 0x4e, 0x20, 0x9b, 0xfa, 0x6e, 0x43, 0x4e, 0x20,
+	0xe5, 0xfa, 0x61, 0x87, 0x7e, 0x1d, 0xd0, 0x50, 0x8c, 0x07, 0x8f, 0x8d, 0xf7, 0x3f, 0x2a, 0x8f,
+	0x78, 0x4d, 0x3f, 0x42, 0x16, 0x71, 0x4d, 0x3f, 0x42, 0x0e, 0x5f, 0x83, 0x09, 0xe4, 0x60, 0xf4,
+	0x8d, 0x96, 0x2e, 0x3c, 0xff, 0x2d, 0xc1, 0x63, 0xe5, 0x37, 0x70, 0xae, 0x4d, 0xa4, 0x23, 0x27,
+	0x35, 0xf1, 0x25, 0xcc, 0xfb, 0xe3, 0x08, 0x5c, 0x1a, 0x17, 0x91, 0x68, 0x1d, 0x52, 0x22, 0xae,
+	0xb9, 0x42, 0x4b, 0x61, 0x7c, 0x30, 0xf3, 0xc5, 0x17, 0x30, 0x47, 0x28, 0xfd, 0x1a, 0xc4, 0x0d,
+	0xdd, 0x52, 0x3a, 0x9a, 0xd0, 0xd2, 0xea, 0x6f, 0xaa, 0xa5, 0x4d, 0xdd, 0x6a, 0xd4, 0x4e, 0x9f,
+	0x4d, 0xc7, 0xd8, 0x87, 0x1c, 0x33, 0x74, 0xab, 0xe1, 0x35, 0x75, 0xe4, 0xff, 0xc0, 0xd4, 0xd1,
+	0xdf, 0x8e, 0xa9, 0x3f, 0x09, 0x42, 0x67, 0xae, 0x98, 0x33, 0xc1, 0x11, 0x27, 0xe8, 0xfc, 0x79,
+	0x14, 0x26, 0x03, 0x49, 0x89, 0xbe, 0x01, 0x13, 0xba, 0xd1, 0x32, 0x4f, 0x06, 0x96, 0xae, 0x71,
+	0x6f, 0x97, 0x1d, 0x80, 0x7e, 0x1d, 0x80, 0x91, 0xe5, 0x4e, 0xc4, 0x4d, 0x50, 0x16, 0xd2, 0xdf,
+	0xbb, 0xa8, 0xf4, 0xc8, 0x9c, 0x7b, 0xd1, 0xc4, 0xbb, 0xf6, 0xa7, 0xcb, 0xc0, 0x91, 0xdf, 0x86,
+	0x81, 0xdd, 0x01, 0x36, 0xfa, 0x31, 0x07, 0xd8, 0x0d, 0x48, 0x69, 0x5d, 0x65, 0xa8, 0x5b, 0x16,
+	0x92, 0x10, 0xe7, 0x80, 0x80, 0x8b, 0xd7, 0xd6, 0x9b, 0x62, 0xc4, 0x98, 0x50, 0x0b, 0x5a, 0xd7,
+	0xee, 0xa5, 0x5f, 0x80, 0xa4, 0x79, 0xac, 0x68, 0x7a, 0x57, 0x3d, 0x61, 0xb9, 0x3f, 0x5b, 0xba,
+	0x1a, 0xd8, 0x2e, 0xc7, 0x35, 0xec, 0x76, 0xed, 0x95, 0x84, 0xc9, 0x21, 0xfa, 0x79, 0x48, 0xb4,
+	0xda, 0x4a, 0xb7, 0x33, 0xb4, 0xf2, 0x09, 0x26, 0xc8, 0x15, 0xff, 0xe4, 0xea, 0xea, 0x7a, 0x67,
+	0x68, 0x55, 0x00, 0xfd, 0x87, 0x7f, 0xcb, 0xf1, 0x56, 0x1b, 0xff, 0x17, 0x0e, 0xf2, 0x0f, 0x12,
+	0x80, 0x23, 0x2d, 0xfd, 0x1c, 0x64, 0xcc, 0xe3, 0x79, 0x45, 0x33, 0x95, 0x7e, 0xbb, 0x3d, 0xd4,
+	0x2d, 0xe6, 0x1d, 0x99, 0xca, 0x95, 0x97, 0x95, 0xe8, 0x6c, 0x38, 0x8f, 0x01, 0x3c, 0x25, 0x1f,
+	0xcf, 0xd7, 0xe4, 0x2d, 0xd6, 0x2b, 0xa7, 0xcc, 0xe3, 0xf9, 0x9a, 0xc9, 0x1b, 0xf4, 0x3e, 0xc4,
+	0xcd, 0xe3, 0x92, 0xa2, 0xd9, 0x87, 0x83, 0x4f, 0x06, 0xb4, 0xa2, 0x5a, 0xaa, 0xac, 0x5a, 0x7a,
+	0xc3, 0xd0, 0xf4, 0xe3, 0xca, 0xa4, 0xbd, 0x1e, 0xb4, 0x9f, 0x7c, 0x5c, 0xaa, 0xc9, 0x72, 0xcc,
+	0x3c, 0x2e, 0xd5, 0x4c, 0x7a, 0x03, 0x12, 0xfd, 0x81, 0xa5, 0x18, 0xfa, 0x3e, 0x0f, 0xf7, 0x5c,
+	0xfc, 0xad, 0x81, 0xb5, 0xa9, 0xef, 0xcb, 0xf1, 0x3e, 0xfb, 0x5f, 0x88, 0xff, 0x14, 0xc4, 0xb2,
+	0xe8, 0x0a, 0x44, 0x5f, 0x17, 0x74, 0xf8, 0x28, 0x5f, 0xd0, 0x61, 0x33, 0x28, 0x85, 0x68, 0x9b,
+	0x67, 0xa0, 0xc8, 0xcd, 0x8c, 0xcc, 0xbe, 0xe9, 0x35, 0x48, 0xb6, 0x9e, 0x28, 0x3d, 0x75, 0x78,
+	0x30, 0xcc, 0x47, 0xae, 0x47, 0x6e, 0x26, 0xe5, 0x44, 0xeb, 0xc9, 0x06, 0x36, 0x05, 0xe3, 0x47,
+	0x90, 0x5e, 0xef, 0xcb, 0xaa, 0xbd, 0x24, 0xdc, 0x52, 0x7b, 0xaa, 0xa1, 0x3d, 0xed, 0x68, 0xd6,
+	0x13, 0xae, 0x34, 0xd9, 0x01, 0xe8, 0x67, 0x81, 0x0c, 0x07, 0xa6, 0xae, 0x62, 0x6a, 0x52, 0xda,
+	0x6a, 0xcb, 0x12, 0x27, 0xa8, 0x8c, 0x9c, 0x1b, 0xe1, 0xab, 0x0c, 0x9e, 0xb9, 0x09, 0xa9, 0xd5,
+	0xe6, 0x83, 0x11, 0xdd, 0x6b, 0x90, 0xdc, 0xeb, 0x58, 0x8a, 0xa9, 0x5a, 0xba, 0x20, 0x9b, 0xd8,
+	0xeb, 0x58, 0xd8, 0x35, 0xf3, 0x3d, 0x09, 0xb2, 0xeb, 0xf2, 0xea, 0x5a, 0xb3, 0x39, 0x1a, 0xfd,
+	0x19, 0xc8, 0xf5, 0xfa, 0xda, 0x61, 0x57, 0xb5, 0x3a, 0x7d, 0x57, 0x10, 0xce, 0xc8, 0x59, 0x07,
+	0x66, 0x21, 0x76, 0x19, 0xae, 0xf6, 0x07, 0xba, 0xa9, 0xa2, 0xd5, 0x95, 0xd6, 0x13, 0xd5, 0x30,
+	0xf4, 0xae, 0xc2, 0x85, 0xe7, 0x72, 0x5d, 0x1e, 0x75, 0x57, 0x79, 0xef, 0x23, 0xb6, 0x90, 0x69,
+	0x48, 0xb5, 0xfa, 0x6c, 0x15, 0x4c, 0x22, 0x34, 0xcf, 0x84, 0x0c, 0x1c, 0x62, 0x42, 0xfd, 0x87,
+	0x04, 0xc9, 0x91, 0x38, 0x5f, 0x80, 0x28, 0x9a, 0x40, 0x1c, 0xf3, 0xde, 0xf0, 0xdb, 0xc4, 0xad,
+	0xc0, 0x4a, 0xf2, 0xf4, 0xd9, 0x74, 0x14, 0x91, 0xb5, 0x90, 0xcc, 0x66, 0xd1, 0x15, 0x88, 0xb4,
+	0x87, 0x07, 0xe2, 0x20, 0xf3, 0x89, 0xc0, 0x41, 0xc6, 0x51, 0x12, 0x3f, 0x58, 0xac, 0x36, 0x1f,
+	0xac, 0x85, 0x64, 0x9c, 0x42, 0x6b, 0x10, 0xef, 0x9a, 0xed, 0x27, 0xc3, 0xa1, 0x28, 0x2b, 0x8a,
+	0x01, 0xce, 0x1e, 0xb5, 0x71, 0xff, 0xe2, 0xd8, 0x5a, 0x48, 0x16, 0x73, 0x2b, 0x93, 0x00, 0x8e,
+	0xd6, 0xd8, 0x21, 0x7a, 0xe6, 0xaf, 0xa2, 0x00, 0x3b, 0xc7, 0xa3, 0xdd, 0x52, 0x85, 0x09, 0x4d,
+	0xb5, 0x54, 0xc7, 0x3a, 0xa9, 0x52, 0xfe, 0x55, 0x4e, 0x5f, 0x49, 0xbb, 0x03, 0x81, 0x9c, 0xd4,
+	0x6c, 0x25, 0x6d, 0x41, 0x6e, 0x44, 0x44, 0xe9, 0xe0, 0xd6, 0x38, 0xdf, 0xfe, 0x71, 0xdc, 0x38,
+	0xa3, 0xb9, 0x3b, 0xce, 0xb4, 0x11, 0xfa, 0x6a, 0x9b, 0x15, 0x1e, 0x46, 0xeb, 0x84, 0x05, 0xc8,
+	0xa8, 0xec, 0x00, 0xf4, 0xff, 0x03, 0xe8, 0x86, 0xba, 0xd7, 0xd5, 0x95, 0x96, 0xd9, 0xca, 0xc7,
+	0x9c, 0x63, 0x59, 0x9d, 0xa1, 0x55, 0xb9, 0x8a, 0xc9, 0x82, 0x7d, 0x9a, 0x2d, 0xa4, 0x35, 0xaa,
+	0x35, 0x59, 0x04, 0xcb, 0xc8, 0x0e, 0x40, 0x17, 0x21, 0x8a, 0x0d, 0x11, 0x9d, 0x0a, 0x81, 0xe3,
+	0xf4, 0x8e, 0x3d, 0xb2, 0x12, 0xfd, 0xee, 0x7f, 0xe1, 0x09, 0x1f, 0x47, 0xd3, 0x2f, 0x41, 0x52,
+	0xeb, 0x3f, 0x35, 0xba, 0x1d, 0xe3, 0x20, 0x9f, 0x64, 0x33, 0x6f, 0xf8, 0x55, 0xe1, 0x18, 0x61,
+	0xae, 0x26, 0x86, 0xca, 0xa3, 0x49, 0x85, 0xdf, 0x83, 0xa4, 0x8d, 0xd2, 0x1b, 0x90, 0x51, 0x0d,
+	0x4b, 0x37, 0x0c, 0x55, 0x28, 0x97, 0x6f, 0x88, 0xb4, 0x00, 0xb9, 0xca, 0xae, 0x41, 0xd2, 0x3a,
+	0x56, 0x06, 0xfd, 0xa7, 0x3a, 0xdf, 0x97, 0x61, 0x39, 0x61, 0x1d, 0x6f, 0x63, 0x93, 0xde, 0x86,
+	0xa9, 0x8e, 0x71, 0xa4, 0x9b, 0x96, 0x32, 0xe8, 0x77, 0x55, 0xb3, 0xf3, 0x4d, 0xe6, 0x0e, 0xe2,
+	0x1c, 0x4a, 0x79, 0xd7, 0xb6, 0xab, 0x47, 0xc4, 0x87, 0x3f, 0x95, 0xe0, 0xda, 0x7d, 0xd5, 0xd2,
+	0x9f, 0xaa, 0x27, 0x65, 0xc1, 0xc9, 0xa9, 0xb7, 0xe9, 0x2e, 0xa4, 0xf6, 0x79, 0xa7, 0xd2, 0xd1,
+	0x86, 0x79, 0x69, 0x7c, 0x91, 0x2a, 0xe6, 0xbb, 0x26, 0x8e, 0xcb, 0x26, 0xfb, 0xf6, 0xa8, 0x61,
+	0x70, 0xad, 0xe1, 0xe0, 0x5a, 0x67, 0xfe, 0x5b, 0x82, 0xd4, 0xee, 0x00, 0x75, 0xb3, 0xd3, 0x3f,
+	0xd0, 0x0d, 0xba, 0x01, 0x11, 0x47, 0x86, 0xcf, 0xbe, 0x42, 0x86, 0xe0, 0x1a, 0xc6, 0x88, 0x82,
+	0x74, 0xbc, 0x0e, 0x11, 0xf6, 0x3b, 0x44, 0x1d, 0x52, 0x43, 0xdd, 0x3c, 0xd2, 0x4d, 0x85, 0xf9,
+	0x45, 0xe4, 0x4c, 0xbf, 0x48, 0x22, 0x75, 0xe6, 0x1b, 0xc0, 0x27, 0x62, 0x17, 0x7d, 0x13, 0x26,
+	0x5b, 0x78, 0x92, 0x30, 0x2c, 0x53, 0xb5, 0xfa, 0x82, 0x18, 0x7a, 0x72, 0x44, 0x26, 0xee, 0x0e,
+	0x1c, 0x3c, 0xf3, 0x1d, 0x09, 0xd2, 0xb6, 0x3b, 0x6c, 0xab, 0xd6, 0x13, 0x7a, 0x03, 0xd2, 0x87,
+	0x4c, 0x01, 0x8a, 0x85, 0x1a, 0xe0, 0x27, 0xa0, 0xb5, 0x90, 0x9c, 0x3a, 0x74, 0xa9, 0xa5, 0x0c,
+	0xb1, 0x76, 0xe7, 0x58, 0xd7, 0xf2, 0xe1, 0x0b, 0x2a, 0x66, 0x2d, 0x24, 0xf3, 0x99, 0x95, 0x14,
+	0x44, 0x07, 0xc8, 0x8f, 0x85, 0x8e, 0x7f, 0x8e, 0xc1, 0xc4, 0xce, 0xb1, 0x38, 0x30, 0xd3, 0x37,
+	0x21, 0xc6, 0xea, 0x91, 0x57, 0x95, 0xdf, 0x55, 0xec, 0x94, 0xf9, 0x18, 0x5a, 0x85, 0xac, 0xed,
+	0xda, 0x0a, 0x12, 0x1c, 0xb2, 0x54, 0x35, 0x26, 0xa0, 0xba, 0x57, 0x29, 0x67, 0x34, 0x57, 0x6b,
+	0x48, 0xbf, 0x08, 0x13, 0x2c, 0xb3, 0xb3, 0xa3, 0x46, 0xe4, 0xbc, 0x47, 0x8d, 0x24, 0x26, 0x78,
+	0xc4, 0xe8, 0x43, 0x98, 0x62, 0xf3, 0x7d, 0xa1, 0x2a, 0x7a, 0xb1, 0x50, 0x45, 0x90, 0x9e, 0xbb,
+	0x0f, 0x7d, 0x16, 0xe9, 0x3a, 0x01, 0x29, 0xc6, 0x02, 0x52, 0xda, 0x3c, 0x9e, 0x5f, 0xb5, 0x31,
+	0xce, 0xbc, 0x14, 0x60, 0x1e, 0xbf, 0x30, 0xf3, 0xd2, 0x18, 0xe6, 0x25, 0x17, 0xf3, 0x84, 0xcd,
+	0xbc, 0xe4, 0x30, 0x5f, 0x83, 0xe4, 0xc0, 0xec, 0xf4, 0xcd, 0x8e, 0x75, 0xc2, 0xc2, 0x51, 0x36,
+	0xb8, 0x53, 0x77, 0x8e, 0x9b, 0xad, 0x27, 0xba, 0x76, 0xd8, 0xd5, 0xb7, 0xc5, 0x48, 0xb7, 0x0e,
+	0xed, 0xd9, 0xb4, 0x0e, 0x19, 0x75, 0x6f, 0xd8, 0xef, 0x1e, 0x5a, 0x3a, 0x77, 0xd9, 0x89, 0x73,
+	0xc6, 0xc5, 0xb4, 0x3d, 0x0d, 0x3b, 0xe8, 0x2a, 0x4c, 0x8e, 0x24, 0x56, 0x06, 0x5d, 0xd5, 0xc0,
+	0x93, 0x34, 0x60, 0x98, 0xaf, 0x14, 0x5e, 0x56, 0xa2, 0x66, 0x38, 0xff, 0xd6, 0xe9, 0xb3, 0xe9,
+	0xdc, 0x68, 0x05, 0xdb, 0x5d, 0xd5, 0x68, 0xd4, 0xe4, 0x5c, 0xdb, 0x03, 0x68, 0x74, 0x01, 0x92,
+	0xaa, 0x76, 0xa4, 0x1a, 0x2d, 0x5d, 0xcb, 0xb7, 0x5e, 0x7f, 0xe1, 0x31, 0x1a, 0x28, 0xc2, 0xdb,
+	0x1f, 0x2f, 0xb1, 0x0b, 0x9d, 0x6a, 0xbf, 0xd7, 0x53, 0x0d, 0x8d, 0x36, 0x20, 0xd2, 0xea, 0x68,
+	0xc2, 0x99, 0x3f, 0x3d, 0xe6, 0x12, 0x4f, 0x0c, 0x74, 0xb6, 0x09, 0x1e, 0xff, 0x12, 0xdf, 0x96,
+	0xa2, 0x44, 0xba, 0x1e, 0xc2, 0xec, 0x5d, 0x6d, 0xd4, 0x64, 0xa4, 0x41, 0x3f, 0x05, 0x29, 0x53,
+	0x7d, 0x3a, 0xba, 0x88, 0x09, 0x8b, 0xbd, 0x09, 0xa6, 0xfa, 0xd4, 0x2e, 0x5f, 0x2a, 0x30, 0x61,
+	0xea, 0x43, 0x2c, 0x20, 0x0c, 0xfb, 0xe2, 0xf0, 0xc6, 0xab, 0x79, 0xce, 0xc9, 0x38, 0xb6, 0x61,
+	0xe0, 0x85, 0x59, 0xd2, 0x14, 0xdf, 0xb4, 0x0e, 0xc0, 0x69, 0xb4, 0xfa, 0x46, 0x5b, 0x5c, 0xf7,
+	0x7c, 0xfa, 0x2c, 0x22, 0xd5, 0xbe, 0xd1, 0x5e, 0x0b, 0xc9, 0x13, 0xa6, 0xdd, 0xa0, 0x5b, 0x90,
+	0x65, 0xdb, 0xb2, 0xf5, 0x44, 0x6f, 0x1d, 0x28, 0xaa, 0x61, 0x57, 0x04, 0x9f, 0x79, 0x0d, 0xa9,
+	0xf5, 0x8e, 0x71, 0x50, 0xc5, 0xf1, 0x65, 0x03, 0x83, 0x45, 0xba, 0xeb, 0x6a, 0xd3, 0xc7, 0xc0,
+	0xda, 0x0a, 0x5e, 0x8d, 0xe0, 0xa1, 0x94, 0x5f, 0x08, 0xfe, 0xbf, 0x33, 0xc8, 0xe1, 0xa5, 0x8a,
+	0xfe, 0x0d, 0x7e, 0xc9, 0xe5, 0xb4, 0x51, 0x6d, 0x48, 0xac, 0xac, 0x99, 0x78, 0x83, 0xe2, 0x26,
+	0x8d, 0x92, 0x26, 0xce, 0x4b, 0xba, 0x6c, 0x0c, 0x3d, 0xa4, 0xb9, 0xdc, 0x36, 0x69, 0x94, 0x7a,
+	0x0b, 0xb2, 0xda, 0xa1, 0x75, 0xa2, 0xb4, 0x4e, 0x5a, 0x5d, 0x9d, 0xc9, 0x9d, 0x3c, 0x53, 0x0d,
+	0xb5, 0x43, 0xeb, 0xa4, 0x8a, 0xe3, 0xb9, 0xa4, 0x69, 0xcd, 0xd5, 0xa6, 0x8f, 0x81, 0x9a, 0xc7,
+	0xca, 0x40, 0x35, 0xd5, 0x1e, 0x16, 0x5b, 0x87, 0x03, 0x46, 0x94, 0x6f, 0x97, 0xd9, 0xd7, 0x99,
+	0xe9, 0x78, 0x1b, 0xe7, 0x34, 0x71, 0x0a, 0xa7, 0x9b, 0x33, 0xbd, 0xd0, 0x18, 0xd2, 0xa8, 0x0c,
+	0xb8, 0x10, 0x69, 0xae, 0x01, 0x0f, 0x69, 0x5b, 0x0d, 0xfa, 0x91, 0x32, 0xb4, 0x54, 0xeb, 0x70,
+	0xc8, 0xc8, 0xa6, 0xce, 0x56, 0x83, 0x7e, 0xd4, 0x64, 0xe3, 0x85, 0x37, 0x68, 0xae, 0x36, 0x95,
+	0x21, 0x67, 0xe8, 0x4f, 0x47, 0x07, 0x74, 0xd4, 0x41, 0x9a, 0x51, 0xbc, 0xf9, 0x1a, 0x8a, 0x9b,
+	0xfa, 0x53, 0x71, 0x66, 0xe7, 0x1a, 0xc8, 0x18, 0x6e, 0xc0, 0x4f, 0x13, 0xa5, 0xcc, 0x5c, 0x80,
+	0x26, 0x17, 0xd3, 0x45, 0x13, 0xe5, 0x54, 0x21, 0xab, 0x75, 0x3d, 0x62, 0x66, 0xcf, 0x5e, 0xf8,
+	0xba, 0x23, 0x54, 0x85, 0x9c, 0x3e, 0x9b, 0x4e, 0xbb, 0x11, 0xa6, 0x8a, 0xae, 0x4b, 0x6c, 0x2f,
+	0x0b, 0x94, 0x3a, 0x77, 0x7e, 0x16, 0xe8, 0xc1, 0x5e, 0x16, 0xb6, 0xb6, 0xbb, 0xae, 0x55, 0x7c,
+	0x15, 0xb3, 0x0c, 0x06, 0x66, 0x3c, 0x3b, 0x3b, 0x5e, 0x47, 0x18, 0x9f, 0x37, 0x5f, 0xeb, 0x1a,
+	0x3b, 0x6c, 0x92, 0xcb, 0xed, 0x88, 0xe9, 0xc3, 0xd0, 0xef, 0xac, 0xa0, 0x4b, 0x4f, 0x9e, 0xe9,
+	0x77, 0x3b, 0x41, 0x97, 0xb6, 0xbc, 0x10, 0x0f, 0x88, 0x07, 0xfa, 0x09, 0x0b, 0x88, 0xf4, 0x1c,
+	0x01, 0xf1, 0x40, 0x3f, 0x19, 0x05, 0x44, 0xfe, 0xcd, 0x03, 0x22, 0xd2, 0x60, 0x01, 0x71, 0xea,
+	0x1c, 0x01, 0xf1, 0x40, 0x3f, 0x71, 0x02, 0xa2, 0x68, 0x50, 0x13, 0xa6, 0x30, 0xbe, 0xf8, 0x97,
+	0x79, 0xe9, 0x4c, 0x1d, 0x96, 0x6b, 0xb2, 0x67, 0x51, 0x95, 0x4b, 0xa7, 0xcf, 0xa6, 0x89, 0x1f,
+	0x45, 0xcd, 0xaa, 0x9a, 0xe9, 0x5d, 0xbe, 0x8c, 0xf7, 0xf7, 0x47, 0x9d, 0x16, 0x4f, 0xaa, 0xcc,
+	0x37, 0x2e, 0x9f, 0xe9, 0xd1, 0x35, 0x36, 0x03, 0xf3, 0xa9, 0xf0, 0x68, 0xcd, 0x0d, 0xd0, 0x5d,
+	0x20, 0xed, 0xbe, 0xd9, 0xc2, 0x60, 0x66, 0x3f, 0xd4, 0xe4, 0xaf, 0x8c, 0x3f, 0x09, 0xba, 0x88,
+	0xae, 0xe2, 0x94, 0xd1, 0xd5, 0xe8, 0x5a, 0x48, 0xce, 0xb6, 0x3d, 0x08, 0xd5, 0x47, 0x2f, 0x3f,
+	0x7e, 0x0d, 0x5d, 0x65, 0xc4, 0xe7, 0x5e, 0xab, 0x71, 0x9c, 0xe8, 0x57, 0xc7, 0x94, 0x19, 0x84,
+	0x5f, 0xc1, 0x06, 0x15, 0x93, 0xbf, 0x30, 0x1b, 0xae, 0x9e, 0x00, 0x1b, 0x9e, 0xac, 0xe8, 0x80,
+	0xed, 0x95, 0x6e, 0x1f, 0x93, 0x71, 0xbb, 0xcf, 0x56, 0x72, 0xed, 0x4c, 0x97, 0xde, 0xc6, 0x7d,
+	0xd1, 0xed, 0x5b, 0x0d, 0xa3, 0xdd, 0x17, 0x2e, 0x3d, 0xf0, 0x42, 0x74, 0x0f, 0x2e, 0x3b, 0xa4,
+	0xdd, 0x81, 0xa5, 0xc0, 0xa8, 0xdf, 0x3a, 0x07, 0x75, 0x4f, 0x30, 0xa1, 0x83, 0x00, 0x3a, 0x9e,
+	0x07, 0x2a, 0xe9, 0x13, 0x17, 0xe5, 0xc1, 0x75, 0xe4, 0xe7, 0x81, 0x2a, 0x7a, 0x07, 0x26, 0xf7,
+	0x74, 0xb5, 0x85, 0xb7, 0x31, 0x3c, 0xae, 0x20, 0xfd, 0x37, 0xce, 0xd4, 0x50, 0x85, 0xcd, 0xe1,
+	0x11, 0x44, 0x24, 0x9b, 0x3d, 0x2f, 0x84, 0x5e, 0x2f, 0x28, 0xe3, 0xb9, 0x8e, 0xe9, 0xe6, 0x93,
+	0x67, 0x7a, 0x3d, 0xa7, 0x8b, 0x27, 0x43, 0x91, 0x1b, 0xf6, 0xdc, 0x80, 0x9f, 0x26, 0xca, 0x5a,
+	0xbc, 0x00, 0x4d, 0xb1, 0x93, 0xf6, 0xdc, 0x80, 0x6b, 0x77, 0xf6, 0xfa, 0x1a, 0x3b, 0xb9, 0xe7,
+	0xa7, 0xcf, 0xb9, 0x3b, 0x37, 0xfa, 0x9a, 0xce, 0xe3, 0x54, 0x46, 0x73, 0x03, 0xb8, 0x3b, 0xdd,
+	0x34, 0x59, 0xc8, 0xba, 0x7e, 0xe6, 0xee, 0x74, 0x88, 0x8a, 0xb8, 0x95, 0xd5, 0x3c, 0x48, 0xe1,
+	0x1d, 0x48, 0xda, 0x87, 0x45, 0xba, 0x0a, 0x99, 0x5e, 0xc7, 0xe8, 0x9b, 0xca, 0x91, 0x6e, 0x0e,
+	0xb1, 0xe2, 0x7f, 0xd5, 0x43, 0x29, 0x0e, 0xaa, 0x80, 0x7d, 0x9a, 0xcd, 0x4b, 0x72, 0x9a, 0xcd,
+	0x7b, 0xc8, 0xa7, 0xf1, 0xf3, 0x72, 0xe1, 0x31, 0x4c, 0x8c, 0x4e, 0x90, 0x1f, 0x33, 0x69, 0x1d,
+	0xd2, 0xee, 0x13, 0x25, 0xbd, 0x0e, 0xf1, 0x9e, 0x6a, 0xee, 0x77, 0x0c, 0x71, 0x77, 0x2b, 0xde,
+	0x47, 0xff, 0x47, 0x92, 0x05, 0x4e, 0x6f, 0x41, 0xc6, 0xbe, 0x7d, 0x68, 0xf5, 0x0f, 0x8d, 0xe0,
+	0x43, 0x6a, 0x5a, 0x74, 0x57, 0xb1, 0x57, 0xb0, 0xf9, 0x51, 0x18, 0x5c, 0x47, 0xcb, 0x71, 0xb7,
+	0x56, 0xd2, 0x47, 0xba, 0xb5, 0xba, 0x05, 0x59, 0xfb, 0x0a, 0xc6, 0x7d, 0x79, 0xc1, 0x5e, 0x18,
+	0x67, 0xf1, 0x85, 0x31, 0x2d, 0x6e, 0x64, 0xf8, 0xf0, 0x37, 0x21, 0x6d, 0xef, 0x58, 0xbc, 0xa5,
+	0xe5, 0x97, 0xb4, 0x8c, 0xfa, 0xf7, 0xa4, 0x30, 0x21, 0x72, 0x4a, 0xf4, 0xe2, 0x9d, 0x2d, 0xbd,
+	0x07, 0x97, 0xdc, 0x83, 0xd1, 0x5f, 0x2c, 0xb3, 0xdf, 0xcd, 0xc7, 0xdc, 0x1c, 0x12, 0x32, 0x75,
+	0xcd, 0xa9, 0xf2, 0x21, 0x74, 0x06, 0x92, 0xc6, 0x9e, 0x62, 0x99, 0xb8, 0x15, 0xe2, 0x5e, 0x81,
+	0x12, 0xc6, 0xde, 0x0e, 0xe2, 0x5c, 0x41, 0x6f, 0x47, 0x93, 0x51, 0x12, 0x2b, 0x7c, 0x4f, 0x02,
+	0xd7, 0x31, 0x99, 0xde, 0x04, 0xe2, 0xe1, 0x8c, 0x4f, 0x98, 0xec, 0x31, 0x54, 0xce, 0xba, 0x98,
+	0x95, 0x5b, 0x07, 0xf4, 0x16, 0x4c, 0xf9, 0x14, 0xca, 0x06, 0xb3, 0x67, 0x51, 0x99, 0x78, 0x74,
+	0x85, 0xc3, 0xdf, 0xe4, 0xa7, 0x09, 0x47, 0x5d, 0x8a, 0xf3, 0x3a, 0x9a, 0x73, 0x6b, 0xaa, 0xdc,
+	0x3a, 0x28, 0xb4, 0x20, 0xed, 0x3e, 0x6d, 0xd3, 0x26, 0x64, 0x7b, 0xea, 0xb1, 0xe2, 0x1c, 0xd9,
+	0x85, 0xed, 0x02, 0x87, 0x86, 0xf2, 0xfe, 0xbe, 0xa9, 0xa3, 0x33, 0x68, 0xa3, 0xf9, 0x2e, 0x0b,
+	0xa6, 0x7b, 0xea, 0xf1, 0x08, 0x2f, 0xfc, 0x9b, 0x04, 0x39, 0xdf, 0xf1, 0xfb, 0x55, 0x75, 0xbb,
+	0xf4, 0x51, 0xeb, 0xf6, 0x15, 0xb8, 0xe4, 0xbd, 0x8c, 0x10, 0xaf, 0x15, 0x61, 0xaf, 0x41, 0x27,
+	0x5d, 0xb7, 0x0d, 0xe2, 0x91, 0x62, 0xce, 0x5f, 0xf1, 0xa3, 0xca, 0xa2, 0xec, 0xa1, 0xbb, 0x14,
+	0xbd, 0xf9, 0xc3, 0x3f, 0x8a, 0x7b, 0x8b, 0x7f, 0xe1, 0xfc, 0x7f, 0xed, 0x5b, 0x1b, 0x9a, 0x76,
+	0x11, 0xae, 0x8e, 0x59, 0x9b, 0xcb, 0xc2, 0x53, 0x7e, 0xb1, 0xd1, 0x6e, 0xcb, 0x90, 0x1f, 0x27,
+	0xb9, 0xcb, 0xd6, 0x97, 0x02, 0x42, 0xe3, 0xbc, 0x59, 0x98, 0xf4, 0xc8, 0xed, 0x36, 0xb7, 0x5b,
+	0x60, 0x34, 0xb7, 0x06, 0x69, 0x77, 0x55, 0x41, 0x67, 0x20, 0xb1, 0xa7, 0x5a, 0x96, 0x6e, 0x9e,
+	0x78, 0x43, 0xc2, 0x87, 0x92, 0x6c, 0x77, 0xd0, 0xd9, 0x51, 0xd4, 0x40, 0x29, 0x62, 0x15, 0xfa,
+	0xb2, 0x92, 0x2b, 0x64, 0xf2, 0xd3, 0x37, 0x3f, 0xf8, 0x50, 0xfc, 0x1b, 0xc5, 0x0f, 0xa1, 0x93,
+	0x1f, 0x84, 0x21, 0xe3, 0x29, 0x35, 0x30, 0xae, 0xd8, 0xce, 0xee, 0xba, 0x6a, 0x75, 0xc7, 0x15,
+	0xd1, 0xcd, 0x8d, 0xf8, 0x59, 0xf7, 0x35, 0x74, 0x98, 0x99, 0x21, 0xf5, 0xb2, 0x92, 0x2c, 0xc5,
+	0xf3, 0x21, 0x66, 0x08, 0xa7, 0x17, 0xfd, 0xa8, 0xd7, 0x31, 0x02, 0x7e, 0x14, 0xb9, 0xa0, 0x1f,
+	0xf5, 0x3a, 0x86, 0xa7, 0x8f, 0xd1, 0x55, 0x8f, 0x03, 0x74, 0x2f, 0x7a, 0xa9, 0x85, 0xfb, 0xc0,
+	0xdd, 0x27, 0x34, 0xf4, 0x8e, 0x5b, 0x41, 0x68, 0x88, 0x1b, 0x90, 0xf1, 0x1a, 0x90, 0x3b, 0x4a,
+	0xba, 0xed, 0xb2, 0x1e, 0x9d, 0x81, 0x8c, 0x23, 0x8f, 0xe3, 0x16, 0x29, 0x3b, 0x04, 0xa0, 0x85,
+	0xbb, 0xe0, 0x29, 0x96, 0x2e, 0xaa, 0xf9, 0xcf, 0x04, 0x35, 0xef, 0xda, 0x00, 0x4e, 0x9f, 0x58,
+	0x87, 0x02, 0x9e, 0xba, 0x09, 0x7d, 0xd1, 0xc3, 0xcd, 0xb5, 0x94, 0x9c, 0x9b, 0x0f, 0xae, 0x26,
+	0xb0, 0xe4, 0x70, 0x70, 0xc9, 0x85, 0x07, 0x40, 0xfc, 0x25, 0x14, 0xbd, 0x0b, 0x31, 0x7e, 0x57,
+	0x29, 0x9d, 0xf7, 0xae, 0x92, 0x8f, 0x2f, 0xfc, 0x93, 0x04, 0x39, 0x5f, 0xcd, 0x44, 0xbf, 0xc2,
+	0x03, 0x9e, 0xde, 0x31, 0x07, 0x9e, 0x10, 0x14, 0x7c, 0xb8, 0x65, 0x07, 0x82, 0x7a, 0x43, 0xde,
+	0xae, 0xe4, 0x5d, 0xef, 0x93, 0xe9, 0x0d, 0xf5, 0x18, 0x41, 0xb6, 0x2c, 0x16, 0xf7, 0xea, 0x1d,
+	0x73, 0xc0, 0x5a, 0xa8, 0x0d, 0x71, 0x9b, 0xac, 0x3d, 0xd5, 0xbb, 0x5d, 0x7e, 0xb1, 0xc7, 0x57,
+	0x99, 0xe3, 0x1d, 0x35, 0xc4, 0xd9, 0xcd, 0xdd, 0x1c, 0x4c, 0x8d, 0x6e, 0x72, 0x5d, 0xa3, 0xf9,
+	0x3e, 0x9e, 0xb4, 0xbb, 0x46, 0xe3, 0x0b, 0xdb, 0x78, 0x20, 0x11, 0x05, 0x5a, 0xed, 0x42, 0xa7,
+	0x06, 0x77, 0x94, 0x76, 0x9d, 0x19, 0x0a, 0x5f, 0xc6, 0x83, 0x88, 0x5d, 0xac, 0x7d, 0x3c, 0x24,
+	0xdf, 0x0b, 0x43, 0xa0, 0x4e, 0xa3, 0x27, 0x70, 0xc5, 0xfe, 0x75, 0x4f, 0xb7, 0xd3, 0xeb, 0x58,
+	0x8a, 0x7e, 0x3c, 0xe8, 0x1b, 0xba, 0x61, 0xbd, 0x32, 0xd5, 0xb0, 0x1f, 0xfd, 0xac, 0xe3, 0xd8,
+	0xba, 0x18, 0x5a, 0x99, 0x76, 0x99, 0x60, 0x6a, 0xcc, 0x00, 0x79, 0x8a, 0xff, 0x3e, 0xc8, 0x03,
+	0xba, 0x59, 0x33, 0x8f, 0x70, 0x58, 0x87, 0x5f, 0xc7, 0x9a, 0xb9, 0xd3, 0xeb, 0x58, 0x7b, 0x06,
+	0xd8, 0xac, 0x3d, 0x60, 0xe1, 0xcb, 0x90, 0xf1, 0xd4, 0x95, 0xf4, 0xad, 0x73, 0x3f, 0x80, 0xe1,
+	0x73, 0xca, 0xdf, 0x49, 0xe1, 0xa4, 0x34, 0x7a, 0xf0, 0x60, 0x33, 0x0b, 0x7f, 0x1b, 0x86, 0xac,
+	0xb7, 0xac, 0xfc, 0xb8, 0x7e, 0x66, 0xf3, 0xb1, 0x3f, 0x3c, 0xde, 0xc4, 0x9f, 0x74, 0x1e, 0x2b,
+	0xa6, 0x6e, 0x99, 0x1d, 0x7d, 0x98, 0x8f, 0x78, 0x93, 0x31, 0xf4, 0xd4, 0x63, 0x99, 0x77, 0xd1,
+	0x47, 0x90, 0x1b, 0xe8, 0x66, 0xa7, 0xaf, 0x39, 0xb6, 0x89, 0x8e, 0xbf, 0x3b, 0x16, 0xd5, 0x28,
+	0x1b, 0x3c, 0x32, 0x8e, 0x23, 0x41, 0x76, 0xe0, 0xe9, 0x11, 0x01, 0xeb, 0x5f, 0x24, 0x98, 0x1a,
+	0x53, 0x2d, 0xd3, 0xdf, 0x05, 0x8a, 0x02, 0xb2, 0x43, 0xef, 0x99, 0x0e, 0xc9, 0x09, 0xb0, 0x23,
+	0xf0, 0x18, 0xc6, 0x18, 0xf3, 0x3d, 0x7d, 0x58, 0xe9, 0x21, 0x71, 0x76, 0x05, 0xe1, 0xf3, 0xb8,
+	0x99, 0x57, 0xd8, 0xa6, 0xd3, 0xd3, 0xc7, 0x90, 0xce, 0xf5, 0xd4, 0x63, 0x77, 0x57, 0x61, 0x2d,
+	0xb8, 0x1a, 0xf4, 0xad, 0x79, 0xb8, 0x1c, 0x60, 0xe8, 0x0a, 0xc5, 0xd4, 0x47, 0x06, 0x03, 0x6d,
+	0x13, 0x72, 0xbe, 0xda, 0x9b, 0xbe, 0x05, 0x71, 0xae, 0x43, 0xa1, 0x87, 0xc0, 0x5b, 0xb9, 0x3d,
+	0x81, 0xdb, 0xc0, 0x25, 0xa7, 0x98, 0x57, 0xf8, 0x13, 0x09, 0x68, 0xb0, 0xe6, 0xf6, 0xa6, 0x77,
+	0xe9, 0xb5, 0xe9, 0xfd, 0xe3, 0xf6, 0x44, 0xe1, 0x06, 0x4f, 0x02, 0x72, 0x9d, 0x3b, 0x09, 0x5f,
+	0xec, 0x34, 0x5e, 0xd8, 0x87, 0x9c, 0xaf, 0x62, 0xa7, 0xd3, 0xee, 0xfc, 0xe5, 0xf9, 0x25, 0x25,
+	0xc7, 0x83, 0x39, 0x3b, 0xfc, 0xba, 0x9c, 0x2d, 0x96, 0xf4, 0x16, 0x64, 0x3c, 0x25, 0xfc, 0x05,
+	0xb4, 0x2c, 0x28, 0x2c, 0xba, 0x29, 0x9c, 0x57, 0x1f, 0x85, 0x55, 0x3b, 0xb0, 0xd9, 0x15, 0xf8,
+	0xd2, 0x79, 0x1e, 0x30, 0xdd, 0xc9, 0x99, 0x8d, 0x2e, 0xdc, 0x87, 0xac, 0xb7, 0x0a, 0xff, 0x0d,
+	0x09, 0x89, 0x1f, 0x39, 0x4f, 0x40, 0x42, 0x3c, 0x14, 0xcd, 0x34, 0x81, 0x7a, 0x9c, 0xe3, 0xa1,
+	0xda, 0x3d, 0xd4, 0xe9, 0xef, 0x40, 0xec, 0x08, 0x3f, 0x2e, 0x5a, 0x72, 0xf0, 0x59, 0x33, 0xbb,
+	0x30, 0xe5, 0x75, 0x7f, 0x4e, 0xf5, 0x8b, 0x5e, 0xaa, 0xe7, 0xdf, 0x32, 0x82, 0xac, 0x02, 0xf9,
+	0x31, 0x95, 0x15, 0xa7, 0x5d, 0xf5, 0xd2, 0xbe, 0x60, 0x49, 0x26, 0x18, 0xdc, 0x87, 0xb4, 0x38,
+	0x1f, 0x71, 0xa2, 0x77, 0xbd, 0x44, 0xcf, 0x73, 0x98, 0x72, 0x24, 0x0d, 0xe6, 0xdd, 0x73, 0x4a,
+	0x3a, 0x26, 0xa3, 0xbf, 0x9a, 0x81, 0x27, 0x91, 0x5e, 0x84, 0x81, 0x37, 0x6f, 0xfb, 0x19, 0xcc,
+	0xfe, 0x40, 0x82, 0x18, 0xfb, 0x31, 0x3b, 0x25, 0x90, 0x7e, 0x7b, 0xab, 0xb1, 0xa9, 0xc8, 0xf5,
+	0x2f, 0xef, 0xd6, 0x9b, 0x3b, 0x24, 0x44, 0x73, 0x90, 0x62, 0x48, 0xb9, 0x5a, 0xad, 0x6f, 0xef,
+	0x10, 0x89, 0x52, 0xc8, 0xee, 0x6e, 0x56, 0xb7, 0x36, 0x57, 0x1b, 0xf2, 0x46, 0xbd, 0xa6, 0xec,
+	0x6e, 0x93, 0x30, 0xbd, 0x04, 0xc4, 0x8d, 0xd5, 0xb6, 0x1e, 0x6d, 0x92, 0x08, 0x12, 0xf3, 0x8c,
+	0x8b, 0xe2, 0x5c, 0xdf, 0xa8, 0x18, 0x62, 0x72, 0xdd, 0xc3, 0x34, 0x8e, 0x4c, 0xb7, 0xe5, 0xad,
+	0x6d, 0xb9, 0x51, 0xdf, 0x29, 0xcb, 0x8f, 0x49, 0x62, 0xf6, 0x2a, 0xc4, 0xd8, 0xcf, 0xe6, 0x69,
+	0x16, 0x60, 0x7d, 0x4b, 0x2e, 0x3f, 0x2a, 0x6f, 0x2a, 0xf2, 0x3c, 0x09, 0xcd, 0x7e, 0x47, 0x62,
+	0x8f, 0xac, 0xe2, 0x9c, 0x85, 0x13, 0x37, 0xca, 0x55, 0x65, 0x77, 0xf3, 0xc1, 0x26, 0x52, 0x0f,
+	0xd1, 0x34, 0x24, 0x11, 0x78, 0x38, 0xaf, 0xdc, 0x21, 0x12, 0xce, 0xb6, 0x5b, 0xca, 0x3c, 0x09,
+	0x7b, 0xda, 0x25, 0x12, 0x71, 0x8d, 0x9e, 0x27, 0x51, 0x4f, 0xef, 0x02, 0x89, 0x79, 0xda, 0x8b,
+	0x24, 0x5e, 0x48, 0xbe, 0xf7, 0x17, 0xc5, 0xd0, 0x4f, 0x7e, 0x54, 0x0c, 0xcd, 0xfe, 0x8d, 0x04,
+	0xb0, 0xbd, 0xf6, 0xd8, 0x25, 0xc5, 0xf6, 0xda, 0x63, 0xaf, 0x14, 0x08, 0x38, 0x52, 0xd8, 0x2d,
+	0x26, 0xc5, 0x25, 0x20, 0xa3, 0x76, 0x49, 0x91, 0xeb, 0x0f, 0x95, 0x32, 0x89, 0x8c, 0x41, 0x2b,
+	0x5c, 0x83, 0x02, 0x9d, 0x17, 0x23, 0x63, 0x01, 0xac, 0x42, 0xe2, 0x9e, 0xd9, 0x0b, 0x62, 0x64,
+	0xc2, 0x2d, 0x71, 0x18, 0x32, 0xde, 0x3a, 0x2f, 0x07, 0xa9, 0x5a, 0x79, 0xa7, 0xac, 0xc8, 0xe5,
+	0x9d, 0xba, 0x72, 0x87, 0x84, 0xbc, 0xc0, 0x3c, 0x91, 0xbc, 0x40, 0x89, 0x84, 0xbd, 0xc0, 0x02,
+	0x89, 0x78, 0x81, 0x45, 0x12, 0xf5, 0x02, 0x4b, 0x24, 0xe6, 0x05, 0x96, 0x49, 0xdc, 0x0b, 0xdc,
+	0x25, 0x09, 0x2f, 0xb0, 0x42, 0x92, 0x5e, 0xe0, 0x1e, 0x99, 0x40, 0xbf, 0x72, 0x09, 0x76, 0x87,
+	0x80, 0x0f, 0x99, 0x27, 0x29, 0x1f, 0x52, 0x22, 0x69, 0x1f, 0xb2, 0x40, 0x32, 0x3e, 0x64, 0x91,
+	0x64, 0x7d, 0xc8, 0x12, 0xc9, 0xb9, 0x34, 0x76, 0x07, 0xc0, 0x39, 0x2e, 0xd2, 0x14, 0x24, 0xaa,
+	0x5b, 0x9b, 0x3b, 0xf5, 0x77, 0x70, 0x8f, 0xa4, 0x20, 0xd1, 0xac, 0x37, 0x9b, 0x8d, 0xad, 0x4d,
+	0x22, 0xd1, 0x24, 0x44, 0x1f, 0xd4, 0x1f, 0x37, 0x49, 0x18, 0x67, 0x38, 0x3f, 0xa9, 0xc4, 0x65,
+	0xac, 0x32, 0x0f, 0xdf, 0xac, 0x36, 0xea, 0x4d, 0x12, 0xa2, 0x93, 0x90, 0xa9, 0xae, 0x95, 0x37,
+	0x37, 0xeb, 0xeb, 0xca, 0x46, 0xb9, 0xf9, 0xa0, 0x49, 0xa4, 0xd9, 0x45, 0x88, 0xb1, 0x58, 0xce,
+	0xc8, 0xaf, 0x97, 0x9b, 0x4d, 0xa5, 0x4c, 0x42, 0x4e, 0xa3, 0x42, 0x24, 0xa7, 0x51, 0x25, 0xe1,
+	0x42, 0x14, 0xa5, 0x9b, 0x1d, 0x00, 0x0d, 0xfe, 0xb8, 0x82, 0x02, 0xc4, 0xd7, 0xb7, 0x1e, 0xf1,
+	0x4d, 0x9c, 0x80, 0xc8, 0xfa, 0xd6, 0x23, 0x22, 0xe1, 0x02, 0x2b, 0xf5, 0xf5, 0xad, 0x47, 0xca,
+	0xe6, 0x96, 0xbc, 0x51, 0x5e, 0x27, 0x61, 0x1c, 0x26, 0xbe, 0xd9, 0x86, 0x2d, 0x57, 0xb6, 0x1e,
+	0xd6, 0xed, 0xde, 0x28, 0x2e, 0x66, 0xad, 0x71, 0x7f, 0x8d, 0xc4, 0x90, 0x2f, 0x7e, 0xb1, 0xfd,
+	0x39, 0xfb, 0x9f, 0x11, 0xb8, 0x34, 0xee, 0x17, 0x0b, 0x34, 0x03, 0x13, 0xd5, 0x46, 0x4d, 0x91,
+	0x57, 0x77, 0x99, 0x0b, 0xd9, 0xcd, 0x7a, 0xb3, 0x2e, 0x42, 0x07, 0x36, 0xd7, 0x1b, 0x9b, 0x0f,
+	0x94, 0xea, 0x5a, 0xbd, 0xfa, 0x80, 0x84, 0x59, 0x90, 0xb0, 0xb1, 0x72, 0x4d, 0x26, 0x11, 0x7b,
+	0x54, 0x6d, 0x77, 0xe7, 0xb1, 0x52, 0x7d, 0x5c, 0x5d, 0xaf, 0x93, 0x28, 0xbd, 0x02, 0x94, 0x11,
+	0x7a, 0x47, 0xd9, 0x2e, 0xcb, 0xe5, 0x0d, 0xa5, 0x59, 0xdf, 0xd9, 0xdd, 0xe6, 0xae, 0xcf, 0xc6,
+	0xd6, 0x1f, 0x2a, 0xcd, 0x9d, 0xf2, 0xce, 0x6e, 0x93, 0xc4, 0xe9, 0x14, 0xe4, 0x10, 0xdb, 0xac,
+	0x3f, 0x52, 0x84, 0x7e, 0x49, 0x82, 0x5e, 0x85, 0x29, 0x41, 0x60, 0xa7, 0xb1, 0xd1, 0xd8, 0xbc,
+	0x2f, 0x28, 0x24, 0x6d, 0xca, 0x3b, 0x5e, 0xca, 0x13, 0x23, 0xca, 0xeb, 0x23, 0x22, 0xe0, 0x2c,
+	0xe7, 0x41, 0xfd, 0x31, 0x49, 0xd9, 0x34, 0xcb, 0x35, 0xd9, 0x33, 0x37, 0x6d, 0x4b, 0x50, 0xab,
+	0x3f, 0x6c, 0x54, 0xeb, 0xc8, 0xb0, 0x4e, 0x32, 0xb8, 0x23, 0x11, 0x5c, 0xdd, 0x92, 0xab, 0x75,
+	0x85, 0x47, 0x3c, 0x92, 0xa5, 0x05, 0xb8, 0xc2, 0x49, 0x62, 0xdb, 0x43, 0x26, 0x67, 0x8b, 0xb6,
+	0xcd, 0xc4, 0x5d, 0xdf, 0xda, 0x51, 0x1a, 0x9b, 0xab, 0x5b, 0x84, 0xd0, 0x6b, 0x70, 0xd9, 0x8b,
+	0xdb, 0x12, 0x4e, 0xd2, 0xcb, 0x30, 0x89, 0x5d, 0x95, 0x7a, 0xb9, 0xba, 0xb5, 0x29, 0x96, 0x4a,
+	0xa8, 0x2d, 0x90, 0x80, 0xd1, 0x0d, 0xc9, 0x94, 0x4f, 0xca, 0x8d, 0xad, 0x5a, 0x9d, 0x5c, 0x17,
+	0x1e, 0xf5, 0x8b, 0x30, 0x4c, 0x8d, 0x49, 0xa2, 0x6c, 0x7f, 0x8c, 0xcc, 0xa2, 0xcc, 0x93, 0x90,
+	0x0f, 0x29, 0x11, 0xc9, 0x87, 0x2c, 0x92, 0xb0, 0x0f, 0x59, 0x21, 0x11, 0x74, 0x7d, 0x37, 0x9d,
+	0x65, 0x12, 0xf5, 0x41, 0x0b, 0x25, 0x12, 0xf3, 0x41, 0xcb, 0x8b, 0x24, 0x8e, 0x56, 0x71, 0x4f,
+	0x2c, 0xad, 0x90, 0x84, 0x0f, 0x2b, 0x2d, 0x2d, 0x93, 0xa4, 0x0f, 0x5b, 0x9a, 0x2f, 0x91, 0x09,
+	0x5c, 0xaf, 0x7b, 0xee, 0x9d, 0xd2, 0x22, 0x01, 0x1f, 0x58, 0xba, 0xb3, 0xb8, 0x42, 0x52, 0x3e,
+	0x70, 0xf1, 0xce, 0xbd, 0x65, 0x92, 0xf6, 0x81, 0x2b, 0xf3, 0xf7, 0x4a, 0xdc, 0xa8, 0x9e, 0x85,
+	0x2c, 0xac, 0x60, 0x18, 0xf1, 0xa2, 0x0b, 0xa5, 0xbb, 0xcb, 0x2b, 0x24, 0x27, 0x54, 0xfb, 0xf7,
+	0x12, 0x64, 0xbd, 0x67, 0x1f, 0x5c, 0x27, 0xb3, 0x65, 0xfd, 0x61, 0x5d, 0x7e, 0xac, 0xcc, 0x8b,
+	0xd8, 0xe0, 0x82, 0x4a, 0x4d, 0x22, 0xf9, 0xa0, 0xc5, 0x26, 0x09, 0xfb, 0xa0, 0x95, 0x26, 0xdf,
+	0x3c, 0x6e, 0x5a, 0xcb, 0x4d, 0x12, 0xf5, 0x61, 0x0b, 0xa5, 0x26, 0x89, 0xf9, 0xb0, 0xe5, 0x45,
+	0xb1, 0x71, 0xdc, 0x73, 0x4b, 0x2b, 0x4d, 0x92, 0x10, 0x52, 0xff, 0x61, 0xc4, 0xae, 0xaf, 0xbc,
+	0x05, 0xdd, 0x14, 0xe4, 0x84, 0xeb, 0x56, 0xb7, 0x76, 0x37, 0x77, 0xd0, 0x94, 0xa1, 0x00, 0xb8,
+	0x80, 0x6e, 0xe1, 0x07, 0x97, 0x17, 0x79, 0xe6, 0xf3, 0x4e, 0x2f, 0xad, 0x90, 0x48, 0x00, 0x45,
+	0x93, 0x46, 0x03, 0x28, 0x1a, 0x35, 0x86, 0x0e, 0xef, 0xa5, 0x80, 0x66, 0x8d, 0x07, 0x60, 0x66,
+	0xd8, 0x44, 0x00, 0x66, 0xa6, 0x4d, 0x06, 0x60, 0x66, 0xdc, 0x09, 0xdc, 0x7f, 0xbe, 0xc5, 0xa1,
+	0x79, 0x21, 0x80, 0x73, 0x03, 0xa7, 0x02, 0xf8, 0xf2, 0xd2, 0xd2, 0x02, 0x7a, 0xce, 0x55, 0x98,
+	0xf2, 0xd2, 0x59, 0x98, 0xbf, 0x73, 0x17, 0xbd, 0xc7, 0xdf, 0x51, 0x5a, 0x2e, 0xcd, 0x2f, 0xa2,
+	0x03, 0xf9, 0x3b, 0x96, 0x4a, 0x8b, 0xa5, 0x15, 0xc7, 0x87, 0xde, 0x0f, 0x03, 0x0d, 0x96, 0xc7,
+	0xe8, 0x0e, 0x62, 0x16, 0x86, 0x1c, 0x16, 0x80, 0x7d, 0xd0, 0x3c, 0x91, 0xfc, 0x50, 0x89, 0x84,
+	0xfd, 0xd0, 0x02, 0x89, 0xf8, 0xa1, 0x45, 0x12, 0xf5, 0x43, 0x4b, 0x24, 0xe6, 0x87, 0x30, 0x9f,
+	0xfb, 0x20, 0xcc, 0xe8, 0x3e, 0x08, 0x73, 0xba, 0x0f, 0xba, 0xc7, 0x03, 0xae, 0x47, 0x54, 0xcc,
+	0xeb, 0x7e, 0x0c, 0x33, 0xbb, 0x1f, 0xc3, 0xdc, 0xee, 0xc7, 0x30, 0xbb, 0xfb, 0x31, 0xd4, 0xab,
+	0x1f, 0x5b, 0x1a, 0xa9, 0xf4, 0x1f, 0x25, 0xfb, 0xef, 0xb5, 0xbc, 0xf7, 0x28, 0x2e, 0xbf, 0xdd,
+	0xae, 0xcb, 0x8d, 0xad, 0x1a, 0x53, 0x6b, 0x00, 0x9c, 0x27, 0x52, 0x10, 0x44, 0xd5, 0x06, 0x40,
+	0x54, 0x6e, 0x00, 0x44, 0xf5, 0x06, 0x40, 0x54, 0x70, 0x00, 0x5c, 0x26, 0xf1, 0x20, 0x78, 0x77,
+	0xb4, 0x4f, 0xff, 0x35, 0x0c, 0xe0, 0xdc, 0xcf, 0xb2, 0x08, 0xca, 0xc3, 0x3b, 0x36, 0x95, 0x15,
+	0x12, 0x62, 0x91, 0xd1, 0x05, 0xcd, 0xdf, 0x21, 0x52, 0x00, 0x43, 0xc1, 0xfd, 0xd8, 0x02, 0x89,
+	0x04, 0xb0, 0x45, 0x12, 0x0d, 0x60, 0xcb, 0x24, 0x16, 0xc0, 0x56, 0x48, 0xdc, 0x8f, 0x95, 0xee,
+	0x90, 0x44, 0x00, 0x9b, 0x27, 0xc9, 0x00, 0xb6, 0x48, 0x26, 0x02, 0xd8, 0x32, 0x81, 0x00, 0x76,
+	0x97, 0xa4, 0x02, 0xd8, 0x3d, 0x92, 0xf6, 0x63, 0x0b, 0x77, 0x48, 0x26, 0x80, 0x2d, 0x90, 0x6c,
+	0x00, 0x5b, 0x1e, 0xb9, 0xc6, 0x7b, 0x11, 0x18, 0x77, 0xb1, 0x8a, 0x66, 0xc0, 0xd4, 0x5f, 0xae,
+	0x3e, 0x50, 0xd6, 0x1b, 0x1b, 0x8d, 0x1d, 0x96, 0x0f, 0x03, 0xa0, 0x88, 0x7d, 0x5e, 0x70, 0x91,
+	0x84, 0x83, 0xa0, 0x08, 0x7d, 0x3e, 0x9a, 0x22, 0xf4, 0x79, 0x51, 0x96, 0x1e, 0x03, 0xe8, 0xb2,
+	0x88, 0x7c, 0x3e, 0x0a, 0x25, 0x11, 0xf9, 0x7c, 0x72, 0x2d, 0x89, 0xc8, 0xe7, 0x85, 0x79, 0xaa,
+	0xbc, 0x02, 0xd4, 0x47, 0x84, 0x67, 0xcb, 0x00, 0x2e, 0x12, 0x66, 0x00, 0x17, 0x39, 0x33, 0x80,
+	0x8b, 0xb4, 0x79, 0x15, 0xa6, 0xbc, 0xb8, 0x9d, 0x39, 0x03, 0x1d, 0xde, 0xe4, 0xe9, 0x98, 0xc2,
+	0x53, 0xd1, 0xba, 0x75, 0x59, 0xab, 0xaf, 0x97, 0x1f, 0xfb, 0x4d, 0xc1, 0x41, 0x9f, 0x29, 0x38,
+	0xe8, 0x33, 0x05, 0x07, 0x7d, 0xa6, 0x10, 0x34, 0x7d, 0xa6, 0xe0, 0xa8, 0xdf, 0x14, 0x1c, 0xf5,
+	0x9b, 0x42, 0x50, 0xf0, 0x9b, 0x42, 0xc8, 0xe5, 0x37, 0x05, 0x87, 0x03, 0xa6, 0x10, 0x44, 0x02,
+	0xa6, 0x10, 0x54, 0x02, 0xa6, 0x10, 0x0b, 0x0c, 0x98, 0x42, 0xac, 0x31, 0x60, 0x0a, 0x7b, 0x99,
+	0x01, 0x53, 0xd8, 0x2b, 0x75, 0x9b, 0xe2, 0xfb, 0x61, 0x48, 0x88, 0x2b, 0x11, 0x2c, 0x68, 0xe5,
+	0x77, 0xc4, 0x28, 0x0c, 0x8f, 0xee, 0xf6, 0x3c, 0x91, 0x3c, 0xed, 0x12, 0x09, 0x7b, 0xda, 0x18,
+	0x57, 0xdc, 0xed, 0x45, 0x12, 0xf5, 0xb4, 0x97, 0x48, 0xcc, 0xd3, 0xc6, 0x00, 0xe8, 0x6e, 0x63,
+	0x82, 0x71, 0xb7, 0x31, 0xbb, 0xb8, 0xdb, 0x98, 0x5a, 0x72, 0x90, 0x72, 0xe4, 0xc1, 0xbc, 0xe2,
+	0x01, 0x30, 0xa9, 0x78, 0x00, 0xcc, 0x28, 0x1e, 0x00, 0xd3, 0x89, 0x07, 0x40, 0xfd, 0x78, 0x00,
+	0x6f, 0xa9, 0xf8, 0x83, 0x30, 0xc4, 0xd8, 0x7b, 0x10, 0x0e, 0xda, 0x68, 0x6c, 0x6e, 0xc9, 0xa3,
+	0x8a, 0x28, 0x05, 0x09, 0x0e, 0x88, 0x82, 0xda, 0xe9, 0x15, 0x05, 0xb5, 0x03, 0x88, 0x82, 0xda,
+	0x01, 0x44, 0x41, 0xed, 0x00, 0xa2, 0xa0, 0x76, 0x00, 0x51, 0x50, 0x3b, 0x80, 0x28, 0xa8, 0x1d,
+	0x40, 0x14, 0xd4, 0x0e, 0x20, 0x0a, 0x6a, 0x07, 0xb0, 0x0b, 0x6a, 0x17, 0x22, 0x0a, 0x6a, 0x17,
+	0x22, 0x0a, 0x6a, 0x17, 0x22, 0x0a, 0x6a, 0x17, 0x22, 0x0a, 0x6a, 0x17, 0x32, 0x4a, 0xb7, 0x95,
+	0xbf, 0x94, 0xbe, 0x72, 0xfb, 0x02, 0x7f, 0x67, 0x6a, 0x19, 0x83, 0xbd, 0x9f, 0x3f, 0x2f, 0x4a,
+	0xef, 0x3f, 0x2f, 0x4a, 0xbf, 0x78, 0x5e, 0x0c, 0x7d, 0xf0, 0xbc, 0x18, 0xfa, 0xe5, 0xf3, 0x62,
+	0xe8, 0x57, 0xcf, 0x8b, 0xa1, 0x5f, 0x3f, 0x2f, 0x4a, 0xdf, 0x3a, 0x2d, 0x4a, 0xef, 0x9d, 0x16,
+	0x43, 0x3f, 0x3e, 0x2d, 0x4a, 0x3f, 0x39, 0x2d, 0x86, 0x7e, 0x7a, 0x5a, 0x0c, 0xfd, 0xec, 0xb4,
+	0x18, 0xfa, 0xf9, 0x69, 0x51, 0x7a, 0xff, 0xb4, 0x28, 0xfd, 0xe2, 0xb4, 0x18, 0xfa, 0xe0, 0xb4,
+	0x28, 0xfd, 0xf2, 0xb4, 0x18, 0xfa, 0xd5, 0x69, 0x51, 0xfa, 0xf5, 0x69, 0x31, 0xf4, 0xad, 0x17,
+	0xc5, 0xd0, 0x7b, 0x2f, 0x8a, 0xd2, 0x77, 0x5f, 0x14, 0x43, 0x7f, 0xf6, 0xa2, 0x28, 0xfd, 0xf0,
+	0x45, 0x31, 0xf4, 0xe3, 0x17, 0xc5, 0xd0, 0x4f, 0x5e, 0x14, 0xa5, 0x9f, 0xbe, 0x28, 0x4a, 0x3f,
+	0x7b, 0x51, 0x94, 0xf6, 0xe2, 0xec, 0x15, 0x6a, 0xe1, 0x7f, 0x07, 0x00, 0xc7, 0xba, 0x08, 0x84,
+	0xfe, 0x44, 0x00, 0x00,
 }
 
 func (x MType) String() string {
@@ -5835,6 +5915,36 @@ func (this *FSKDataRate) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LRFHSSDataRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LRFHSSDataRate)
+	if !ok {
+		that2, ok := that.(LRFHSSDataRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModulationType != that1.ModulationType {
+		return false
+	}
+	if this.OperatingChannelWidth != that1.OperatingChannelWidth {
+		return false
+	}
+	if this.CodingRate != that1.CodingRate {
+		return false
+	}
+	return true
+}
 func (this *DataRate) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -5913,6 +6023,30 @@ func (this *DataRate_FSK) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DataRate_LRFHSS) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataRate_LRFHSS)
+	if !ok {
+		that2, ok := that.(DataRate_LRFHSS)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LRFHSS.Equal(that1.LRFHSS) {
+		return false
+	}
+	return true
+}
 func (this *TxSettings) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -8645,6 +8779,46 @@ func (m *FSKDataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LRFHSSDataRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LRFHSSDataRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LRFHSSDataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodingRate) > 0 {
+		i -= len(m.CodingRate)
+		copy(dAtA[i:], m.CodingRate)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.CodingRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OperatingChannelWidth != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.OperatingChannelWidth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ModulationType != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ModulationType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DataRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8719,6 +8893,27 @@ func (m *DataRate_FSK) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *DataRate_LRFHSS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataRate_LRFHSS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LRFHSS != nil {
+		{
+			size, err := m.LRFHSS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *TxSettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11116,14 +11311,26 @@ func NewPopulatedFSKDataRate(r randyLorawan, easy bool) *FSKDataRate {
 	return this
 }
 
+func NewPopulatedLRFHSSDataRate(r randyLorawan, easy bool) *LRFHSSDataRate {
+	this := &LRFHSSDataRate{}
+	this.ModulationType = r.Uint32()
+	this.OperatingChannelWidth = r.Uint32()
+	this.CodingRate = randStringLorawan(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedDataRate(r randyLorawan, easy bool) *DataRate {
 	this := &DataRate{}
-	oneofNumber_Modulation := []int32{1, 2}[r.Intn(2)]
+	oneofNumber_Modulation := []int32{1, 2, 3}[r.Intn(3)]
 	switch oneofNumber_Modulation {
 	case 1:
 		this.Modulation = NewPopulatedDataRate_LoRa(r, easy)
 	case 2:
 		this.Modulation = NewPopulatedDataRate_FSK(r, easy)
+	case 3:
+		this.Modulation = NewPopulatedDataRate_LRFHSS(r, easy)
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -11140,6 +11347,11 @@ func NewPopulatedDataRate_FSK(r randyLorawan, easy bool) *DataRate_FSK {
 	this.FSK = NewPopulatedFSKDataRate(r, easy)
 	return this
 }
+func NewPopulatedDataRate_LRFHSS(r randyLorawan, easy bool) *DataRate_LRFHSS {
+	this := &DataRate_LRFHSS{}
+	this.LRFHSS = NewPopulatedLRFHSSDataRate(r, easy)
+	return this
+}
 func NewPopulatedTxSettings_Downlink(r randyLorawan, easy bool) *TxSettings_Downlink {
 	this := &TxSettings_Downlink{}
 	this.AntennaIndex = r.Uint32()
@@ -11764,6 +11976,25 @@ func (m *FSKDataRate) Size() (n int) {
 	return n
 }
 
+func (m *LRFHSSDataRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModulationType != 0 {
+		n += 1 + sovLorawan(uint64(m.ModulationType))
+	}
+	if m.OperatingChannelWidth != 0 {
+		n += 1 + sovLorawan(uint64(m.OperatingChannelWidth))
+	}
+	l = len(m.CodingRate)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
 func (m *DataRate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11800,6 +12031,18 @@ func (m *DataRate_FSK) Size() (n int) {
 	}
 	return n
 }
+func (m *DataRate_LRFHSS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LRFHSS != nil {
+		l = m.LRFHSS.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
 func (m *TxSettings) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13069,6 +13312,18 @@ func (this *FSKDataRate) String() string {
 	}, "")
 	return s
 }
+func (this *LRFHSSDataRate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LRFHSSDataRate{`,
+		`ModulationType:` + fmt.Sprintf("%v", this.ModulationType) + `,`,
+		`OperatingChannelWidth:` + fmt.Sprintf("%v", this.OperatingChannelWidth) + `,`,
+		`CodingRate:` + fmt.Sprintf("%v", this.CodingRate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DataRate) String() string {
 	if this == nil {
 		return "nil"
@@ -13099,6 +13354,16 @@ func (this *DataRate_FSK) String() string {
 	}, "")
 	return s
 }
+func (this *DataRate_LRFHSS) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataRate_LRFHSS{`,
+		`LRFHSS:` + strings.Replace(fmt.Sprintf("%v", this.LRFHSS), "LRFHSSDataRate", "LRFHSSDataRate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TxSettings) String() string {
 	if this == nil {
 		return "nil"
@@ -15902,6 +16167,129 @@ func (m *FSKDataRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LRFHSSDataRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LRFHSSDataRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LRFHSSDataRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModulationType", wireType)
+			}
+			m.ModulationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModulationType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatingChannelWidth", wireType)
+			}
+			m.OperatingChannelWidth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatingChannelWidth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodingRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DataRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16001,6 +16389,41 @@ func (m *DataRate) Unmarshal(dAtA []byte) error {
 			}
 			m.Modulation = &DataRate_FSK{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LRFHSS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LRFHSSDataRate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Modulation = &DataRate_LRFHSS{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -260,47 +260,48 @@ func init() {
 }
 
 var fileDescriptor_6fa32647f6f069ac = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0x3f, 0x48, 0x1c, 0x4f,
-	0x18, 0x9d, 0xf1, 0x7e, 0xfe, 0x82, 0x1b, 0x11, 0x59, 0x21, 0xc8, 0x21, 0x9f, 0x17, 0x4d, 0x71,
-	0x24, 0xb9, 0x5d, 0xd0, 0x3e, 0xe0, 0x71, 0x86, 0x48, 0x08, 0xc8, 0x41, 0x52, 0x04, 0xc2, 0x31,
-	0xb7, 0x3b, 0xae, 0xc3, 0xdd, 0xcd, 0x6c, 0x76, 0xc6, 0x33, 0x76, 0x36, 0x01, 0x49, 0x95, 0x2e,
-	0x81, 0x34, 0x69, 0x02, 0x96, 0x96, 0x42, 0x1a, 0x4b, 0x4b, 0x4b, 0x2b, 0x71, 0x67, 0x1b, 0x4b,
-	0x4b, 0x49, 0x15, 0xf6, 0x8f, 0xdc, 0x9f, 0xe5, 0x8c, 0x5d, 0x02, 0x49, 0x37, 0xb3, 0xbc, 0xef,
-	0xbd, 0x79, 0xdf, 0x9b, 0x6f, 0xc7, 0x28, 0xb7, 0x45, 0x40, 0xb6, 0x09, 0xaf, 0x48, 0x45, 0x9c,
-	0x96, 0x4d, 0x7c, 0x66, 0x77, 0xa8, 0x94, 0xc4, 0xa3, 0x0d, 0x49, 0x83, 0x2e, 0x73, 0xa8, 0xb4,
-	0xfc, 0x40, 0x28, 0x61, 0x4e, 0x29, 0xc5, 0xad, 0x0c, 0x6d, 0x75, 0x97, 0x8b, 0x2b, 0x1e, 0x53,
-	0x9b, 0x5b, 0x4d, 0xcb, 0x11, 0x1d, 0x9b, 0xf2, 0xae, 0xd8, 0xf1, 0x03, 0xf1, 0x6e, 0xc7, 0x4e,
-	0xc0, 0x4e, 0xc5, 0xa3, 0xbc, 0xd2, 0x25, 0x6d, 0xe6, 0x12, 0x45, 0xed, 0xdc, 0x22, 0xa5, 0x2c,
-	0x56, 0xfa, 0x28, 0x3c, 0xe1, 0x89, 0xb4, 0xb8, 0xb9, 0xb5, 0x91, 0xec, 0x92, 0x4d, 0xb2, 0xca,
-	0xe0, 0x0b, 0xf9, 0xb3, 0x52, 0xee, 0x36, 0x5c, 0x1a, 0x1f, 0x33, 0xc3, 0x2c, 0xe6, 0x31, 0xcc,
-	0xa5, 0x5c, 0xb1, 0x0d, 0x46, 0x83, 0xcc, 0x4a, 0xb1, 0x34, 0xd2, 0x74, 0x86, 0x58, 0xf8, 0x52,
-	0x30, 0xe6, 0x56, 0xb9, 0x23, 0x5c, 0x5a, 0x13, 0xdb, 0xbc, 0xcd, 0x78, 0xeb, 0x45, 0x0a, 0xa8,
-	0xd3, 0xb7, 0x5b, 0x54, 0x2a, 0xf3, 0x99, 0x51, 0x60, 0xae, 0x9c, 0xc5, 0x25, 0x5c, 0xbe, 0xbb,
-	0xf4, 0xc0, 0x1a, 0xec, 0x8d, 0xb5, 0xca, 0xdd, 0x5a, 0x72, 0xaa, 0xb5, 0x9e, 0x76, 0x75, 0xfa,
-	0x47, 0x75, 0xfc, 0x03, 0x1e, 0x9b, 0xc6, 0xc7, 0x67, 0xf3, 0xe8, 0xe4, 0x6c, 0x1e, 0xd7, 0x63,
-	0x0a, 0xf3, 0x3d, 0x36, 0xee, 0xf5, 0x6c, 0x34, 0xba, 0x34, 0x90, 0x4c, 0xf0, 0x46, 0xcc, 0x3e,
-	0x96, 0xb0, 0x3f, 0x1a, 0xc9, 0xfe, 0x2a, 0xc5, 0xf6, 0x8b, 0x2c, 0xf6, 0x8b, 0xe8, 0xb3, 0xf9,
-	0x99, 0x1c, 0xb8, 0x26, 0xeb, 0x33, 0x34, 0xc7, 0x20, 0xcd, 0xe7, 0xc6, 0x9d, 0xac, 0x09, 0xb3,
-	0x85, 0x44, 0x77, 0x71, 0x58, 0x77, 0xc5, 0xf7, 0xdb, 0xcc, 0x21, 0x8a, 0x09, 0x7e, 0xdd, 0x95,
-	0xea, 0x64, 0xbf, 0x5e, 0xfd, 0x9a, 0xc1, 0x7c, 0x62, 0x4c, 0x6c, 0x88, 0xa0, 0x43, 0x94, 0xa2,
-	0xc1, 0xec, 0x7f, 0x25, 0x5c, 0x9e, 0x5a, 0x2a, 0x0d, 0xd3, 0xad, 0x93, 0x9d, 0xb6, 0x20, 0xee,
-	0xd3, 0x6b, 0x5c, 0xbd, 0x57, 0x62, 0xce, 0x19, 0x13, 0x3e, 0x09, 0x48, 0x87, 0xc6, 0xf5, 0xe3,
-	0x25, 0x5c, 0x9e, 0xa8, 0xf7, 0x3e, 0x2c, 0x7c, 0x2a, 0x18, 0xc5, 0x1a, 0x8d, 0xd3, 0x79, 0xe9,
-	0xff, 0x15, 0xd9, 0xac, 0x0d, 0x67, 0x73, 0xff, 0x86, 0x6c, 0xd2, 0x9e, 0xfc, 0x9e, 0x64, 0xe2,
-	0xb9, 0x49, 0x93, 0xf9, 0x37, 0x37, 0x7f, 0xdc, 0xdc, 0x2c, 0x7d, 0x1f, 0x33, 0xa6, 0xb3, 0x3c,
-	0xd6, 0x03, 0xe1, 0x50, 0x29, 0x45, 0x60, 0x3a, 0xc6, 0xd4, 0xe0, 0x9f, 0xce, 0x7c, 0x9c, 0x6f,
-	0xdc, 0xe8, 0x44, 0x8b, 0xb7, 0xb1, 0x6b, 0xbe, 0x31, 0x26, 0xfb, 0x07, 0xd6, 0x7c, 0x38, 0x5c,
-	0x34, 0x7a, 0x9c, 0x8b, 0xbf, 0xbe, 0xeb, 0xb1, 0x87, 0xc1, 0x5b, 0x97, 0xf7, 0x70, 0xd3, 0xad,
-	0xbc, 0x95, 0x87, 0xea, 0x37, 0x7c, 0x1c, 0x02, 0x3e, 0x09, 0x01, 0x9f, 0x86, 0x80, 0xce, 0x43,
-	0x40, 0x17, 0x21, 0xa0, 0xcb, 0x10, 0xd0, 0x55, 0x08, 0x78, 0x57, 0x03, 0xde, 0xd3, 0x80, 0xf6,
-	0x35, 0xe0, 0x03, 0x0d, 0xe8, 0x50, 0x03, 0x3a, 0xd2, 0x80, 0x8e, 0x35, 0xe0, 0x13, 0x0d, 0xf8,
-	0x54, 0x03, 0x3a, 0xd7, 0x80, 0x2f, 0x34, 0xa0, 0x4b, 0x0d, 0xf8, 0x4a, 0x03, 0xda, 0x8d, 0x00,
-	0xed, 0x45, 0x80, 0x3f, 0x46, 0x80, 0x3e, 0x47, 0x80, 0xbf, 0x46, 0x80, 0xf6, 0x23, 0x40, 0x07,
-	0x11, 0xe0, 0xc3, 0x08, 0xf0, 0x51, 0x04, 0xf8, 0xb5, 0xed, 0x09, 0x4b, 0x6d, 0x52, 0xb5, 0xc9,
-	0xb8, 0x27, 0x2d, 0x4e, 0xd5, 0xb6, 0x08, 0x5a, 0xf6, 0xe0, 0x0b, 0xd6, 0x5d, 0xb6, 0xfd, 0x96,
-	0x67, 0x2b, 0xc5, 0xfd, 0x66, 0xf3, 0xff, 0xe4, 0x09, 0x5b, 0xfe, 0x19, 0x00, 0x00, 0xff, 0xff,
-	0xe3, 0x81, 0x3e, 0xeb, 0xdb, 0x07, 0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x31, 0x48, 0x1c, 0x4d,
+	0x18, 0x9d, 0xb9, 0xfb, 0xfd, 0x83, 0x1b, 0x11, 0x59, 0x21, 0x91, 0x43, 0x3e, 0x2f, 0x9a, 0xe2,
+	0x48, 0x72, 0xbb, 0x70, 0xf6, 0x01, 0x8f, 0x33, 0x44, 0x42, 0x40, 0x2e, 0x98, 0x22, 0x10, 0x8e,
+	0xb9, 0xdd, 0x71, 0x1d, 0xee, 0x6e, 0x66, 0xb3, 0x33, 0x9e, 0xb1, 0xb3, 0x09, 0x48, 0xaa, 0x74,
+	0x09, 0xa4, 0x49, 0x13, 0xb0, 0xb4, 0x14, 0xd2, 0x48, 0x2a, 0x4b, 0x4b, 0x2b, 0x71, 0x67, 0x1b,
+	0x4b, 0x4b, 0x49, 0x15, 0x76, 0x6f, 0xe5, 0xce, 0x3b, 0xce, 0x08, 0x29, 0x12, 0x88, 0xdd, 0xcc,
+	0xf2, 0xbe, 0xf7, 0xcd, 0x7b, 0x6f, 0xbf, 0xdd, 0x31, 0x0a, 0x4d, 0x11, 0x90, 0x0d, 0xc2, 0x8b,
+	0x52, 0x11, 0xa7, 0x61, 0x13, 0x9f, 0xd9, 0x2d, 0x2a, 0x25, 0xf1, 0x68, 0x4d, 0xd2, 0xa0, 0xcd,
+	0x1c, 0x2a, 0x2d, 0x3f, 0x10, 0x4a, 0x98, 0xe3, 0x4a, 0x71, 0x2b, 0x45, 0x5b, 0xed, 0xf9, 0xdc,
+	0x82, 0xc7, 0xd4, 0xda, 0x7a, 0xdd, 0x72, 0x44, 0xcb, 0xa6, 0xbc, 0x2d, 0x36, 0xfd, 0x40, 0xbc,
+	0xdd, 0xb4, 0x13, 0xb0, 0x53, 0xf4, 0x28, 0x2f, 0xb6, 0x49, 0x93, 0xb9, 0x44, 0x51, 0x7b, 0x60,
+	0xd1, 0xa1, 0xcc, 0x15, 0x7b, 0x28, 0x3c, 0xe1, 0x89, 0x4e, 0x71, 0x7d, 0x7d, 0x35, 0xd9, 0x25,
+	0x9b, 0x64, 0x95, 0xc2, 0x67, 0x07, 0xcf, 0x4a, 0xb9, 0x5b, 0x73, 0x69, 0x7c, 0xcc, 0x14, 0x33,
+	0x37, 0x88, 0x61, 0x2e, 0xe5, 0x8a, 0xad, 0x32, 0x1a, 0xa4, 0x52, 0x72, 0xf9, 0xa1, 0xa2, 0x53,
+	0xc4, 0xec, 0xe7, 0xac, 0x31, 0xbd, 0xc8, 0x1d, 0xe1, 0xd2, 0x8a, 0xd8, 0xe0, 0x4d, 0xc6, 0x1b,
+	0xcf, 0x3b, 0x80, 0x2a, 0x7d, 0xb3, 0x4e, 0xa5, 0x32, 0x9f, 0x1a, 0x59, 0xe6, 0xca, 0x29, 0x9c,
+	0xc7, 0x85, 0xdb, 0xa5, 0xfb, 0xd6, 0x65, 0x6f, 0xac, 0x45, 0xee, 0x56, 0x92, 0x53, 0x2d, 0x75,
+	0x7b, 0x97, 0x27, 0x7e, 0x94, 0x47, 0xde, 0xe3, 0xcc, 0x04, 0x3e, 0x38, 0x9e, 0x41, 0x87, 0xc7,
+	0x33, 0xb8, 0x1a, 0x53, 0x98, 0xef, 0xb0, 0x71, 0xa7, 0x2b, 0xa3, 0xd6, 0xa6, 0x81, 0x64, 0x82,
+	0xd7, 0x62, 0xf6, 0x4c, 0xc2, 0xfe, 0x70, 0x28, 0xfb, 0xcb, 0x0e, 0xb6, 0xb7, 0xc9, 0x5c, 0x6f,
+	0x13, 0x7d, 0x3c, 0x33, 0x39, 0x00, 0xae, 0xc8, 0xea, 0x24, 0x1d, 0x60, 0x90, 0xe6, 0x33, 0xe3,
+	0x56, 0x6a, 0xc2, 0x54, 0x36, 0xe9, 0x3b, 0xd7, 0xdf, 0x77, 0xc1, 0xf7, 0x9b, 0xcc, 0x21, 0x8a,
+	0x09, 0x7e, 0xe1, 0x4a, 0x79, 0xac, 0xb7, 0x5f, 0xf5, 0x82, 0xc1, 0x7c, 0x6c, 0x8c, 0xae, 0x8a,
+	0xa0, 0x45, 0x94, 0xa2, 0xc1, 0xd4, 0x7f, 0x79, 0x5c, 0x18, 0x2f, 0xe5, 0xfb, 0xe9, 0x96, 0xc9,
+	0x66, 0x53, 0x10, 0xf7, 0xc9, 0x05, 0xae, 0xda, 0x2d, 0x31, 0xa7, 0x8d, 0x51, 0x9f, 0x04, 0xa4,
+	0x45, 0xe3, 0xfa, 0x91, 0x3c, 0x2e, 0x8c, 0x56, 0xbb, 0x0f, 0x66, 0x3f, 0x66, 0x8d, 0x5c, 0x85,
+	0xc6, 0xe9, 0xac, 0xf8, 0xff, 0x44, 0x36, 0x4b, 0xfd, 0xd9, 0xdc, 0xbb, 0x22, 0x9b, 0x15, 0xff,
+	0xcf, 0x25, 0x13, 0xcf, 0x4d, 0x27, 0x99, 0x9b, 0xb9, 0xf9, 0xeb, 0xe6, 0xa6, 0xf4, 0x2d, 0x63,
+	0x4c, 0xa4, 0x79, 0x2c, 0x07, 0xc2, 0xa1, 0x52, 0x8a, 0xc0, 0x74, 0x8c, 0xf1, 0xcb, 0x5f, 0x3a,
+	0xf3, 0xd1, 0xa0, 0x71, 0xc3, 0x13, 0xcd, 0x5d, 0x47, 0xae, 0xf9, 0xda, 0x18, 0xeb, 0x1d, 0x58,
+	0xf3, 0x41, 0x7f, 0xd1, 0xf0, 0x71, 0xce, 0xfd, 0xfa, 0x5d, 0x8f, 0x35, 0x54, 0xe8, 0xd5, 0x1a,
+	0x2a, 0xf4, 0x37, 0x35, 0x94, 0xbe, 0x67, 0x8c, 0xbb, 0xfd, 0xde, 0xbf, 0xe8, 0xfc, 0x23, 0x6f,
+	0x4c, 0xbc, 0xa6, 0x86, 0xf2, 0x57, 0x7c, 0x10, 0x02, 0x3e, 0x0c, 0x01, 0x1f, 0x85, 0x80, 0x4e,
+	0x42, 0x40, 0xa7, 0x21, 0xa0, 0xb3, 0x10, 0xd0, 0x79, 0x08, 0x78, 0x4b, 0x03, 0xde, 0xd6, 0x80,
+	0x76, 0x34, 0xe0, 0x5d, 0x0d, 0x68, 0x4f, 0x03, 0xda, 0xd7, 0x80, 0x0e, 0x34, 0xe0, 0x43, 0x0d,
+	0xf8, 0x48, 0x03, 0x3a, 0xd1, 0x80, 0x4f, 0x35, 0xa0, 0x33, 0x0d, 0xf8, 0x5c, 0x03, 0xda, 0x8a,
+	0x00, 0x6d, 0x47, 0x80, 0x3f, 0x44, 0x80, 0x3e, 0x45, 0x80, 0xbf, 0x44, 0x80, 0x76, 0x22, 0x40,
+	0xbb, 0x11, 0xe0, 0xbd, 0x08, 0xf0, 0x7e, 0x04, 0xf8, 0x95, 0xed, 0x09, 0x4b, 0xad, 0x51, 0xb5,
+	0xc6, 0xb8, 0x27, 0x2d, 0x4e, 0xd5, 0x86, 0x08, 0x1a, 0xf6, 0xe5, 0x6b, 0x40, 0x7b, 0xde, 0xf6,
+	0x1b, 0x9e, 0xad, 0x14, 0xf7, 0xeb, 0xf5, 0xff, 0x93, 0x7b, 0xc0, 0xfc, 0xcf, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x0e, 0x9e, 0xd0, 0x58, 0x20, 0x09, 0x00, 0x00,
 }
 
 func (this *EncodeDownlinkMessageRequest) Equal(that interface{}) bool {
@@ -564,6 +565,156 @@ var _MessageProcessor_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/message_services.proto",
 }
 
+// PayloadFormatterServiceClient is the client API for PayloadFormatterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PayloadFormatterServiceClient interface {
+	// Encodes the decoded payload of the downlink message to the FRMPayload.
+	EncodeDownlink(ctx context.Context, in *EncodeDownlinkMessageRequest, opts ...grpc.CallOption) (*ApplicationDownlink, error)
+	// Decodes the FRMPayload of the uplink message to the decoded payload.
+	DecodeUplink(ctx context.Context, in *DecodeUplinkMessageRequest, opts ...grpc.CallOption) (*ApplicationUplink, error)
+	// Decodes the FRMPayload of the downlink message to the decoded payload.
+	DecodeDownlink(ctx context.Context, in *DecodeDownlinkMessageRequest, opts ...grpc.CallOption) (*ApplicationDownlink, error)
+}
+
+type payloadFormatterServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPayloadFormatterServiceClient(cc *grpc.ClientConn) PayloadFormatterServiceClient {
+	return &payloadFormatterServiceClient{cc}
+}
+
+func (c *payloadFormatterServiceClient) EncodeDownlink(ctx context.Context, in *EncodeDownlinkMessageRequest, opts ...grpc.CallOption) (*ApplicationDownlink, error) {
+	out := new(ApplicationDownlink)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.PayloadFormatterService/EncodeDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payloadFormatterServiceClient) DecodeUplink(ctx context.Context, in *DecodeUplinkMessageRequest, opts ...grpc.CallOption) (*ApplicationUplink, error) {
+	out := new(ApplicationUplink)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.PayloadFormatterService/DecodeUplink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payloadFormatterServiceClient) DecodeDownlink(ctx context.Context, in *DecodeDownlinkMessageRequest, opts ...grpc.CallOption) (*ApplicationDownlink, error) {
+	out := new(ApplicationDownlink)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.PayloadFormatterService/DecodeDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PayloadFormatterServiceServer is the server API for PayloadFormatterService service.
+type PayloadFormatterServiceServer interface {
+	// Encodes the decoded payload of the downlink message to the FRMPayload.
+	EncodeDownlink(context.Context, *EncodeDownlinkMessageRequest) (*ApplicationDownlink, error)
+	// Decodes the FRMPayload of the uplink message to the decoded payload.
+	DecodeUplink(context.Context, *DecodeUplinkMessageRequest) (*ApplicationUplink, error)
+	// Decodes the FRMPayload of the downlink message to the decoded payload.
+	DecodeDownlink(context.Context, *DecodeDownlinkMessageRequest) (*ApplicationDownlink, error)
+}
+
+// UnimplementedPayloadFormatterServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPayloadFormatterServiceServer struct {
+}
+
+func (*UnimplementedPayloadFormatterServiceServer) EncodeDownlink(ctx context.Context, req *EncodeDownlinkMessageRequest) (*ApplicationDownlink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncodeDownlink not implemented")
+}
+func (*UnimplementedPayloadFormatterServiceServer) DecodeUplink(ctx context.Context, req *DecodeUplinkMessageRequest) (*ApplicationUplink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeUplink not implemented")
+}
+func (*UnimplementedPayloadFormatterServiceServer) DecodeDownlink(ctx context.Context, req *DecodeDownlinkMessageRequest) (*ApplicationDownlink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeDownlink not implemented")
+}
+
+func RegisterPayloadFormatterServiceServer(s *grpc.Server, srv PayloadFormatterServiceServer) {
+	s.RegisterService(&_PayloadFormatterService_serviceDesc, srv)
+}
+
+func _PayloadFormatterService_EncodeDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodeDownlinkMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayloadFormatterServiceServer).EncodeDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.PayloadFormatterService/EncodeDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayloadFormatterServiceServer).EncodeDownlink(ctx, req.(*EncodeDownlinkMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayloadFormatterService_DecodeUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeUplinkMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayloadFormatterServiceServer).DecodeUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.PayloadFormatterService/DecodeUplink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayloadFormatterServiceServer).DecodeUplink(ctx, req.(*DecodeUplinkMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayloadFormatterService_DecodeDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeDownlinkMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayloadFormatterServiceServer).DecodeDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.PayloadFormatterService/DecodeDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayloadFormatterServiceServer).DecodeDownlink(ctx, req.(*DecodeDownlinkMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PayloadFormatterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.PayloadFormatterService",
+	HandlerType: (*PayloadFormatterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EncodeDownlink",
+			Handler:    _PayloadFormatterService_EncodeDownlink_Handler,
+		},
+		{
+			MethodName: "DecodeUplink",
+			Handler:    _PayloadFormatterService_DecodeUplink_Handler,
+		},
+		{
+			MethodName: "DecodeDownlink",
+			Handler:    _PayloadFormatterService_DecodeDownlink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/message_services.proto",
+}
+
 func (m *EncodeDownlinkMessageRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
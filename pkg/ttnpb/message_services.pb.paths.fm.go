@@ -4,6 +4,7 @@ package ttnpb
 
 var EncodeDownlinkMessageRequestFieldPathsNested = []string{
 	"end_device_version_ids",
+	"end_device_version_ids.band_id",
 	"end_device_version_ids.brand_id",
 	"end_device_version_ids.firmware_version",
 	"end_device_version_ids.hardware_version",
@@ -41,6 +42,7 @@ var EncodeDownlinkMessageRequestFieldPathsTopLevel = []string{
 }
 var DecodeUplinkMessageRequestFieldPathsNested = []string{
 	"end_device_version_ids",
+	"end_device_version_ids.band_id",
 	"end_device_version_ids.brand_id",
 	"end_device_version_ids.firmware_version",
 	"end_device_version_ids.hardware_version",
@@ -79,6 +81,10 @@ var DecodeUplinkMessageRequestFieldPathsNested = []string{
 	"message.settings.data_rate.modulation.lora",
 	"message.settings.data_rate.modulation.lora.bandwidth",
 	"message.settings.data_rate.modulation.lora.spreading_factor",
+	"message.settings.data_rate.modulation.lrfhss",
+	"message.settings.data_rate.modulation.lrfhss.coding_rate",
+	"message.settings.data_rate.modulation.lrfhss.modulation_type",
+	"message.settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"message.settings.data_rate_index",
 	"message.settings.downlink",
 	"message.settings.downlink.antenna_index",
@@ -100,6 +106,7 @@ var DecodeUplinkMessageRequestFieldPathsTopLevel = []string{
 }
 var DecodeDownlinkMessageRequestFieldPathsNested = []string{
 	"end_device_version_ids",
+	"end_device_version_ids.band_id",
 	"end_device_version_ids.brand_id",
 	"end_device_version_ids.firmware_version",
 	"end_device_version_ids.hardware_version",
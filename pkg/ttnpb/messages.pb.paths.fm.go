@@ -63,6 +63,10 @@ var UplinkMessageFieldPathsNested = []string{
 	"settings.data_rate.modulation.lora",
 	"settings.data_rate.modulation.lora.bandwidth",
 	"settings.data_rate.modulation.lora.spreading_factor",
+	"settings.data_rate.modulation.lrfhss",
+	"settings.data_rate.modulation.lrfhss.coding_rate",
+	"settings.data_rate.modulation.lrfhss.modulation_type",
+	"settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"settings.data_rate_index",
 	"settings.downlink",
 	"settings.downlink.antenna_index",
@@ -161,6 +165,10 @@ var DownlinkMessageFieldPathsNested = []string{
 	"settings.scheduled.data_rate.modulation.lora",
 	"settings.scheduled.data_rate.modulation.lora.bandwidth",
 	"settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"settings.scheduled.data_rate.modulation.lrfhss",
+	"settings.scheduled.data_rate.modulation.lrfhss.coding_rate",
+	"settings.scheduled.data_rate.modulation.lrfhss.modulation_type",
+	"settings.scheduled.data_rate.modulation.lrfhss.operating_channel_width",
 	"settings.scheduled.data_rate_index",
 	"settings.scheduled.downlink",
 	"settings.scheduled.downlink.antenna_index",
@@ -251,6 +259,10 @@ var GatewayUplinkMessageFieldPathsNested = []string{
 	"message.settings.data_rate.modulation.lora",
 	"message.settings.data_rate.modulation.lora.bandwidth",
 	"message.settings.data_rate.modulation.lora.spreading_factor",
+	"message.settings.data_rate.modulation.lrfhss",
+	"message.settings.data_rate.modulation.lrfhss.coding_rate",
+	"message.settings.data_rate.modulation.lrfhss.modulation_type",
+	"message.settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"message.settings.data_rate_index",
 	"message.settings.downlink",
 	"message.settings.downlink.antenna_index",
@@ -292,6 +304,10 @@ var ApplicationUplinkFieldPathsNested = []string{
 	"settings.data_rate.modulation.lora",
 	"settings.data_rate.modulation.lora.bandwidth",
 	"settings.data_rate.modulation.lora.spreading_factor",
+	"settings.data_rate.modulation.lrfhss",
+	"settings.data_rate.modulation.lrfhss.coding_rate",
+	"settings.data_rate.modulation.lrfhss.modulation_type",
+	"settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"settings.data_rate_index",
 	"settings.downlink",
 	"settings.downlink.antenna_index",
@@ -581,6 +597,10 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.uplink_message.settings.data_rate.modulation.lora",
 	"up.uplink_message.settings.data_rate.modulation.lora.bandwidth",
 	"up.uplink_message.settings.data_rate.modulation.lora.spreading_factor",
+	"up.uplink_message.settings.data_rate.modulation.lrfhss",
+	"up.uplink_message.settings.data_rate.modulation.lrfhss.coding_rate",
+	"up.uplink_message.settings.data_rate.modulation.lrfhss.modulation_type",
+	"up.uplink_message.settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"up.uplink_message.settings.data_rate_index",
 	"up.uplink_message.settings.downlink",
 	"up.uplink_message.settings.downlink.antenna_index",
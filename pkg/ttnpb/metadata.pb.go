@@ -123,6 +123,8 @@ type RxMetadata struct {
 	UplinkToken []byte `protobuf:"bytes,15,opt,name=uplink_token,json=uplinkToken,proto3" json:"uplink_token,omitempty"`
 	// Index of the gateway channel that received the message.
 	ChannelIndex uint32 `protobuf:"varint,17,opt,name=channel_index,json=channelIndex,proto3" json:"channel_index,omitempty"`
+	// Downlink load of the gateway when the message was received; injected by the Gateway Server.
+	DownlinkLoad *GatewayDownlinkLoad `protobuf:"bytes,19,opt,name=downlink_load,json=downlinkLoad,proto3" json:"downlink_load,omitempty"`
 	// Advanced metadata fields
 	// - can be used for advanced information or experimental features that are not yet formally defined in the API
 	// - field names are written in snake_case
@@ -282,6 +284,13 @@ func (m *RxMetadata) GetChannelIndex() uint32 {
 	return 0
 }
 
+func (m *RxMetadata) GetDownlinkLoad() *GatewayDownlinkLoad {
+	if m != nil {
+		return m.DownlinkLoad
+	}
+	return nil
+}
+
 func (m *RxMetadata) GetAdvanced() *types.Struct {
 	if m != nil {
 		return m.Advanced
@@ -540,6 +549,71 @@ func (m *PacketBrokerRouteHop) GetReceiverAgent() string {
 	return ""
 }
 
+// Downlink load of a gateway, used to rank gateways for downlink.
+type GatewayDownlinkLoad struct {
+	// Highest downlink utilization of the gateway sub-bands, as a fraction of the available duty-cycle.
+	Utilization float32 `protobuf:"fixed32,1,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	// Number of scheduled downlink messages that are not yet transmitted.
+	QueueDepth uint32 `protobuf:"varint,2,opt,name=queue_depth,json=queueDepth,proto3" json:"queue_depth,omitempty"`
+	// Fraction of recent downlink transmissions that the gateway failed to transmit.
+	TxFailureRate        float32  `protobuf:"fixed32,3,opt,name=tx_failure_rate,json=txFailureRate,proto3" json:"tx_failure_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayDownlinkLoad) Reset()      { *m = GatewayDownlinkLoad{} }
+func (*GatewayDownlinkLoad) ProtoMessage() {}
+func (*GatewayDownlinkLoad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1123b3e8fd87092, []int{4}
+}
+func (m *GatewayDownlinkLoad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayDownlinkLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayDownlinkLoad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayDownlinkLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayDownlinkLoad.Merge(m, src)
+}
+func (m *GatewayDownlinkLoad) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayDownlinkLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayDownlinkLoad.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayDownlinkLoad proto.InternalMessageInfo
+
+func (m *GatewayDownlinkLoad) GetUtilization() float32 {
+	if m != nil {
+		return m.Utilization
+	}
+	return 0
+}
+
+func (m *GatewayDownlinkLoad) GetQueueDepth() uint32 {
+	if m != nil {
+		return m.QueueDepth
+	}
+	return 0
+}
+
+func (m *GatewayDownlinkLoad) GetTxFailureRate() float32 {
+	if m != nil {
+		return m.TxFailureRate
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ttn.lorawan.v3.LocationSource", LocationSource_name, LocationSource_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.LocationSource", LocationSource_name, LocationSource_value)
@@ -551,6 +625,8 @@ func init() {
 	golang_proto.RegisterType((*PacketBrokerMetadata)(nil), "ttn.lorawan.v3.PacketBrokerMetadata")
 	proto.RegisterType((*PacketBrokerRouteHop)(nil), "ttn.lorawan.v3.PacketBrokerRouteHop")
 	golang_proto.RegisterType((*PacketBrokerRouteHop)(nil), "ttn.lorawan.v3.PacketBrokerRouteHop")
+	proto.RegisterType((*GatewayDownlinkLoad)(nil), "ttn.lorawan.v3.GatewayDownlinkLoad")
+	golang_proto.RegisterType((*GatewayDownlinkLoad)(nil), "ttn.lorawan.v3.GatewayDownlinkLoad")
 }
 
 func init() { proto.RegisterFile("lorawan-stack/api/metadata.proto", fileDescriptor_e1123b3e8fd87092) }
@@ -559,99 +635,105 @@ func init() {
 }
 
 var fileDescriptor_e1123b3e8fd87092 = []byte{
-	// 1466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x3d, 0x8c, 0x1b, 0xc7,
-	0x15, 0xc7, 0x77, 0x8e, 0xbc, 0x13, 0x39, 0xfc, 0x10, 0x35, 0x67, 0x49, 0xab, 0x93, 0x32, 0xcb,
-	0x9c, 0x93, 0x80, 0x36, 0x72, 0x24, 0x20, 0xd9, 0x80, 0x91, 0xca, 0xc7, 0xe3, 0x49, 0x5e, 0xf8,
-	0x44, 0xca, 0xc3, 0x93, 0x8d, 0xa4, 0x59, 0xcc, 0xed, 0x0e, 0xc9, 0x0d, 0xc9, 0xd9, 0xcd, 0xee,
-	0xf0, 0x4e, 0xec, 0x84, 0x54, 0x42, 0x2a, 0xa7, 0x4b, 0x69, 0x20, 0x08, 0xe0, 0xd2, 0xa5, 0xca,
-	0x6b, 0x02, 0xa8, 0x54, 0x17, 0x23, 0x05, 0xe3, 0x5b, 0x36, 0x2e, 0x95, 0x4e, 0x50, 0x93, 0x60,
-	0x67, 0x3f, 0x78, 0x24, 0xa5, 0x04, 0x41, 0x58, 0x71, 0xfe, 0xef, 0xf7, 0xfe, 0xb3, 0xf3, 0x66,
-	0xde, 0x0c, 0xac, 0x8e, 0x1c, 0x8f, 0x9e, 0x51, 0xbe, 0xe7, 0x0b, 0x6a, 0x0e, 0x1b, 0xd4, 0xb5,
-	0x1b, 0x63, 0x26, 0xa8, 0x45, 0x05, 0xad, 0xbb, 0x9e, 0x23, 0x1c, 0x54, 0x16, 0x82, 0xd7, 0x63,
-	0xaa, 0x7e, 0x7a, 0x6f, 0x67, 0xbf, 0x6f, 0x8b, 0xc1, 0xe4, 0xa4, 0x6e, 0x3a, 0xe3, 0x06, 0xe3,
-	0xa7, 0xce, 0xd4, 0xf5, 0x9c, 0x27, 0xd3, 0x86, 0x84, 0xcd, 0xbd, 0x3e, 0xe3, 0x7b, 0xa7, 0x74,
-	0x64, 0x5b, 0x54, 0xb0, 0xc6, 0xda, 0x9f, 0xc8, 0x72, 0x67, 0xef, 0x92, 0x45, 0xdf, 0xe9, 0x3b,
-	0x51, 0xf2, 0xc9, 0xa4, 0x27, 0x47, 0x72, 0x20, 0xff, 0xc5, 0xf8, 0x9d, 0xbe, 0xe3, 0xf4, 0x47,
-	0x6c, 0x41, 0xf9, 0xc2, 0x9b, 0x98, 0x22, 0x8e, 0x6a, 0xab, 0x51, 0x61, 0x8f, 0x99, 0x2f, 0xe8,
-	0xd8, 0x8d, 0x01, 0xbc, 0x0a, 0x9c, 0x79, 0xd4, 0x75, 0x99, 0xe7, 0xc7, 0xf1, 0x9f, 0xac, 0x97,
-	0x80, 0xf1, 0xc9, 0x38, 0x09, 0xbf, 0xbf, 0x1e, 0xb6, 0x2d, 0xc6, 0x85, 0xdd, 0xb3, 0x53, 0x8f,
-	0xdd, 0xbf, 0xe5, 0x20, 0x24, 0x4f, 0x1e, 0xc6, 0x95, 0x43, 0x8f, 0x61, 0xa1, 0x4f, 0x05, 0x3b,
-	0xa3, 0x53, 0xc3, 0xb6, 0x7c, 0x15, 0x54, 0x41, 0xad, 0x70, 0x77, 0xb7, 0xbe, 0x5c, 0xc9, 0xfa,
-	0x83, 0x08, 0xd1, 0x17, 0x6e, 0xcd, 0xca, 0x9b, 0xe6, 0xe6, 0x1f, 0xc0, 0x46, 0x05, 0xbc, 0x98,
-	0x69, 0xca, 0xcb, 0x99, 0x06, 0x08, 0xec, 0x27, 0x94, 0x8f, 0x74, 0x58, 0x72, 0xa9, 0x39, 0x64,
-	0xc2, 0x38, 0xf1, 0x9c, 0x21, 0xf3, 0x54, 0x24, 0x8d, 0x7f, 0xb6, 0x6a, 0xfc, 0x48, 0x42, 0x4d,
-	0xc9, 0x24, 0xdf, 0x44, 0x8a, 0xee, 0x25, 0x15, 0xbd, 0x0f, 0x4b, 0x94, 0x0b, 0xc6, 0x39, 0x35,
-	0x6c, 0x6e, 0xb1, 0x27, 0xea, 0x46, 0x15, 0xd4, 0x4a, 0xa4, 0x18, 0x8b, 0x7a, 0xa8, 0xa1, 0x8f,
-	0x60, 0x36, 0x2c, 0xa6, 0x9a, 0x91, 0xd3, 0xec, 0xd4, 0xa3, 0x42, 0xd6, 0x93, 0x42, 0xd6, 0x8f,
-	0x93, 0x4a, 0x37, 0xb3, 0x5f, 0xff, 0x43, 0x03, 0x44, 0xd2, 0xe8, 0x0e, 0xcc, 0xa7, 0x5b, 0xa0,
-	0x66, 0xa5, 0xed, 0x42, 0x40, 0x3f, 0x87, 0xe5, 0x9e, 0xcd, 0x99, 0xb1, 0x40, 0x36, 0xab, 0xa0,
-	0x96, 0x25, 0xa5, 0x50, 0x4d, 0x0d, 0xd1, 0x27, 0x50, 0x65, 0xdc, 0xf4, 0xa6, 0xae, 0x60, 0x96,
-	0xb1, 0x92, 0xb0, 0x55, 0x05, 0xb5, 0x22, 0xb9, 0x91, 0xc6, 0xef, 0x2f, 0x65, 0x32, 0xa8, 0xbd,
-	0x2b, 0xd3, 0x18, 0xb2, 0x70, 0x43, 0xd4, 0x2b, 0x55, 0x50, 0xcb, 0x37, 0xb5, 0x60, 0xa6, 0xdd,
-	0x3e, 0x7c, 0xab, 0xc9, 0xe7, 0x6c, 0xaa, 0xb7, 0xc8, 0x6d, 0xf6, 0xce, 0xa0, 0x85, 0xee, 0xc0,
-	0xac, 0xe7, 0xfb, 0xb6, 0x9a, 0xab, 0x82, 0xda, 0x46, 0x33, 0x17, 0xcc, 0xb4, 0x2c, 0xe9, 0x76,
-	0x75, 0x22, 0x55, 0x74, 0x04, 0x0b, 0xbe, 0xdd, 0xe7, 0x74, 0x64, 0x48, 0xa8, 0x22, 0x0b, 0x78,
-	0x7b, 0xad, 0x80, 0xf7, 0x47, 0x0e, 0x15, 0x5f, 0xd2, 0xd1, 0x84, 0x35, 0xcb, 0xc1, 0x4c, 0x83,
-	0x5d, 0x99, 0x23, 0x7d, 0x60, 0x94, 0x4f, 0x42, 0xb7, 0xbb, 0xb0, 0x68, 0x0e, 0x28, 0xe7, 0x2c,
-	0xb6, 0xcb, 0xcb, 0x39, 0xaf, 0x06, 0x33, 0xad, 0x70, 0x10, 0xe9, 0x32, 0xa5, 0x10, 0x43, 0x32,
-	0xe7, 0x0b, 0x78, 0x33, 0x64, 0x0d, 0x5f, 0x50, 0x6e, 0x51, 0xcf, 0x32, 0x2c, 0x76, 0x6a, 0x53,
-	0x61, 0x3b, 0x5c, 0x85, 0x32, 0xfd, 0x56, 0x30, 0xd3, 0xae, 0x87, 0x79, 0xdd, 0x98, 0x68, 0x25,
-	0x00, 0xb9, 0x1e, 0x66, 0xae, 0xc9, 0xe8, 0x16, 0xcc, 0xf8, 0xdc, 0x53, 0x0b, 0x32, 0xfd, 0x4a,
-	0x30, 0xd3, 0x32, 0xdd, 0x36, 0x21, 0xa1, 0x86, 0x3e, 0x80, 0x95, 0x9e, 0xc7, 0x7e, 0x37, 0x61,
-	0xdc, 0x9c, 0x1a, 0x4e, 0xaf, 0xe7, 0x33, 0xa1, 0x16, 0xab, 0xa0, 0x96, 0x21, 0x57, 0x53, 0xbd,
-	0x23, 0x65, 0xf4, 0x11, 0xcc, 0x8d, 0x1c, 0x33, 0xfa, 0x92, 0x92, 0xac, 0x8b, 0xba, 0x7a, 0x7e,
-	0x8f, 0xe2, 0x38, 0x49, 0x49, 0xf4, 0x5b, 0xa8, 0x5a, 0xce, 0x19, 0x1f, 0xd9, 0x7c, 0x68, 0xb8,
-	0x54, 0x0c, 0x0c, 0xd3, 0xe1, 0xbe, 0xf0, 0xa8, 0xcd, 0x85, 0x5a, 0xae, 0x82, 0x5a, 0xf9, 0xee,
-	0x2f, 0x56, 0x5d, 0x5a, 0x31, 0xff, 0x88, 0x8a, 0xc1, 0x41, 0x4a, 0x37, 0x73, 0x6f, 0x9a, 0x9b,
-	0xbf, 0x0f, 0x5b, 0x8c, 0xdc, 0xb0, 0xde, 0x4a, 0xa0, 0x9f, 0xc2, 0xe2, 0xc4, 0x95, 0x33, 0x09,
-	0x67, 0xc8, 0xb8, 0x7a, 0x55, 0x9e, 0xb7, 0x42, 0xa4, 0x1d, 0x87, 0x12, 0xda, 0x83, 0xa5, 0x64,
-	0x47, 0xa2, 0xf6, 0xb9, 0x16, 0x9e, 0x73, 0xe9, 0xfd, 0x61, 0x46, 0xfd, 0x17, 0x20, 0xc9, 0x86,
-	0x45, 0x8d, 0x74, 0x0f, 0xe6, 0xa8, 0x75, 0x4a, 0xb9, 0xc9, 0x2c, 0xd5, 0x94, 0x6b, 0xbe, 0xb9,
-	0x76, 0x16, 0xba, 0xf2, 0x52, 0x23, 0x29, 0xf8, 0xab, 0xec, 0xf3, 0x6f, 0x34, 0x65, 0xf7, 0x15,
-	0x80, 0xb9, 0xa4, 0x1e, 0xa1, 0xcf, 0x88, 0x0a, 0x5b, 0x4c, 0x2c, 0x26, 0x2f, 0x15, 0xd0, 0xbc,
-	0xf9, 0xa6, 0xf9, 0x1e, 0x42, 0xb7, 0x94, 0xf0, 0xf7, 0xf4, 0xcb, 0x4f, 0x3f, 0x88, 0xff, 0x9c,
-	0x93, 0x14, 0x44, 0x1f, 0xc3, 0xfc, 0xc8, 0xe1, 0xfd, 0x28, 0x6b, 0x63, 0x3d, 0xab, 0x97, 0x64,
-	0xf5, 0xce, 0xc9, 0x82, 0x44, 0x3b, 0x30, 0x47, 0x47, 0xf1, 0x5c, 0xe1, 0x05, 0xb0, 0x49, 0xd2,
-	0xb1, 0x8c, 0x99, 0xe6, 0xc4, 0xa3, 0xe6, 0x54, 0x76, 0x78, 0x18, 0x8b, 0xc7, 0xe8, 0x53, 0xb8,
-	0xe5, 0x3b, 0x13, 0xcf, 0x64, 0xb2, 0xb1, 0xcb, 0x77, 0xf1, 0xbb, 0x76, 0xb7, 0x2b, 0xa9, 0x4b,
-	0xfb, 0x11, 0xe7, 0xed, 0xfe, 0x35, 0x0b, 0xdf, 0x7b, 0xdb, 0x15, 0x86, 0x7e, 0x09, 0xe1, 0x98,
-	0xf9, 0x3e, 0xed, 0xb3, 0xb0, 0x8b, 0x81, 0xec, 0xe2, 0x52, 0x30, 0xd3, 0xf2, 0x0f, 0x23, 0x55,
-	0x6f, 0x91, 0x7c, 0x0c, 0xe8, 0x16, 0x9a, 0xc2, 0x4a, 0xcf, 0xf1, 0xce, 0xa8, 0x67, 0x31, 0xcf,
-	0xe0, 0x4c, 0x84, 0x39, 0xe1, 0xf2, 0x8b, 0xcd, 0x4e, 0x78, 0xbb, 0xfe, 0x7d, 0xa6, 0x7d, 0xdc,
-	0x77, 0xea, 0x62, 0xc0, 0xc4, 0xc0, 0xe6, 0x7d, 0xbf, 0xce, 0x99, 0x38, 0x73, 0xbc, 0x61, 0x63,
-	0xf9, 0xbe, 0x3f, 0xbd, 0xd7, 0x70, 0x87, 0xfd, 0x86, 0x98, 0xba, 0xcc, 0xaf, 0xb7, 0x99, 0xd0,
-	0x5b, 0xc1, 0x4c, 0x2b, 0xdf, 0x4f, 0x8c, 0xa5, 0x42, 0xca, 0xbd, 0xcb, 0x63, 0x0b, 0x1d, 0xc2,
-	0xed, 0xc5, 0xd4, 0x82, 0x71, 0xca, 0xe5, 0xec, 0x19, 0xf9, 0xc5, 0xd7, 0x83, 0x99, 0x76, 0x2d,
-	0x35, 0x38, 0x96, 0x51, 0xbd, 0x45, 0xae, 0xf5, 0x56, 0x24, 0x2b, 0xec, 0xfb, 0x85, 0x8d, 0x6d,
-	0xc9, 0x52, 0xe7, 0xa3, 0xbe, 0x4f, 0xf3, 0xf5, 0x16, 0x29, 0xa4, 0x90, 0x6e, 0xa1, 0xa7, 0x00,
-	0x6e, 0x0f, 0x9c, 0x31, 0x33, 0xe2, 0xe5, 0x24, 0x2b, 0xdf, 0x94, 0x2b, 0xff, 0xe2, 0xff, 0x5d,
-	0x79, 0xe5, 0x33, 0x67, 0xcc, 0xda, 0x11, 0x1f, 0xad, 0xbd, 0x32, 0x58, 0x56, 0x2c, 0x74, 0x04,
-	0x6f, 0x2c, 0x7d, 0xc1, 0xa2, 0x00, 0x5b, 0x72, 0x01, 0x37, 0x83, 0x99, 0xb6, 0x7d, 0xc9, 0x27,
-	0x2d, 0xc1, 0xf6, 0x60, 0x4d, 0xb4, 0xd0, 0x27, 0x30, 0x3b, 0x70, 0x5c, 0x5f, 0xbd, 0x52, 0xcd,
-	0xfc, 0xb7, 0xb7, 0x8e, 0x38, 0x13, 0xc1, 0x3e, 0x73, 0x5c, 0x22, 0x33, 0x76, 0xff, 0x09, 0x96,
-	0xcf, 0x51, 0x12, 0x46, 0x87, 0xb0, 0xe0, 0x31, 0x93, 0xd9, 0xa7, 0xcc, 0x32, 0xa8, 0x88, 0x9f,
-	0xe7, 0xff, 0xf4, 0xbc, 0xe5, 0xc2, 0xb2, 0xc9, 0x27, 0x0e, 0x26, 0x89, 0xfb, 0x02, 0x69, 0xb0,
-	0xe0, 0x33, 0x2e, 0x4f, 0x17, 0x1d, 0x47, 0xad, 0x95, 0x27, 0x30, 0x92, 0xda, 0x74, 0xcc, 0xc2,
-	0xb7, 0x2e, 0x06, 0xa8, 0x65, 0x79, 0xcc, 0xf7, 0xa3, 0x13, 0x40, 0x4a, 0x91, 0xba, 0x1f, 0x89,
-	0xe1, 0x5b, 0x1c, 0xbb, 0xc6, 0x4e, 0x72, 0x9f, 0x49, 0x31, 0x11, 0x13, 0xaf, 0x14, 0xa2, 0x7d,
-	0xc6, 0x85, 0xdc, 0xd1, 0x3c, 0x49, 0x53, 0xf7, 0x43, 0xf1, 0xc3, 0x3f, 0x6e, 0xc0, 0xf2, 0x72,
-	0x83, 0x21, 0x04, 0xcb, 0xdd, 0xce, 0x63, 0x72, 0x70, 0x68, 0x3c, 0x6e, 0x7f, 0xde, 0xee, 0x7c,
-	0xd5, 0xae, 0x28, 0xa8, 0x0c, 0x61, 0xac, 0x3d, 0x78, 0xd4, 0xad, 0x00, 0xb4, 0x0d, 0xaf, 0xc6,
-	0x63, 0x72, 0xf8, 0x40, 0xef, 0x1e, 0x93, 0x5f, 0x57, 0x32, 0xe8, 0x16, 0xbc, 0x1e, 0x8b, 0xfa,
-	0x23, 0xe3, 0xc1, 0x61, 0xe7, 0xa8, 0x73, 0xb0, 0x7f, 0xac, 0x77, 0xda, 0x95, 0x2c, 0xaa, 0xc2,
-	0x3b, 0x71, 0xe8, 0x2b, 0xfd, 0xbe, 0x6e, 0x84, 0xcf, 0xc8, 0x12, 0xb1, 0x89, 0x30, 0xdc, 0x89,
-	0x89, 0xe6, 0xf1, 0x7a, 0x7c, 0xeb, 0x92, 0xc3, 0x51, 0x87, 0xec, 0xaf, 0x13, 0x57, 0x56, 0x89,
-	0xe3, 0x56, 0x67, 0x7f, 0x89, 0xc8, 0x21, 0x0d, 0xde, 0x8e, 0x89, 0x83, 0xce, 0xc3, 0xa6, 0xde,
-	0x3e, 0x6c, 0x2d, 0x01, 0xf9, 0x9d, 0xec, 0xb3, 0x3f, 0x63, 0xa5, 0xf9, 0x17, 0xf0, 0xe2, 0x02,
-	0x83, 0x97, 0x17, 0x18, 0x7c, 0x7f, 0x81, 0x95, 0x1f, 0x2e, 0xb0, 0xf2, 0xe3, 0x05, 0x56, 0x5e,
-	0x5d, 0x60, 0xe5, 0xf5, 0x05, 0x06, 0x4f, 0x03, 0x0c, 0x9e, 0x05, 0x58, 0xf9, 0x36, 0xc0, 0xe0,
-	0xbb, 0x00, 0x2b, 0xcf, 0x03, 0xac, 0x9c, 0x07, 0x58, 0x79, 0x11, 0x60, 0xf0, 0x32, 0xc0, 0xe0,
-	0xfb, 0x00, 0x2b, 0x3f, 0x04, 0x18, 0xfc, 0x18, 0x60, 0xe5, 0x55, 0x80, 0xc1, 0xeb, 0x00, 0x2b,
-	0x4f, 0xe7, 0x58, 0x79, 0x36, 0xc7, 0xe0, 0xeb, 0x39, 0x56, 0xfe, 0x34, 0xc7, 0xe0, 0x9b, 0x39,
-	0x56, 0xbe, 0x9d, 0x63, 0xe5, 0xbb, 0x39, 0x06, 0xcf, 0xe7, 0x18, 0x9c, 0xcf, 0x31, 0xf8, 0x4d,
-	0xe3, 0x7f, 0xe8, 0x31, 0xc1, 0xdd, 0x93, 0x93, 0x2d, 0x79, 0xf2, 0xee, 0xfd, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0xcb, 0x4c, 0xf6, 0x53, 0x94, 0x0b, 0x00, 0x00,
+	// 1553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4b, 0x6c, 0x1b, 0xc7,
+	0x19, 0xde, 0x91, 0x28, 0x99, 0x1c, 0x3e, 0x44, 0x8f, 0xfc, 0x58, 0xcb, 0xee, 0x2c, 0x2b, 0xb7,
+	0x81, 0x12, 0x54, 0x24, 0x60, 0x27, 0x40, 0xd0, 0x53, 0x44, 0x51, 0xb2, 0x17, 0x91, 0x49, 0x67,
+	0x28, 0x27, 0x68, 0x2f, 0x8b, 0xd1, 0xee, 0x90, 0xdc, 0x8a, 0x9c, 0xdd, 0xec, 0x0e, 0x25, 0xb1,
+	0x27, 0xa3, 0x27, 0xa3, 0xa7, 0xf4, 0xd6, 0x63, 0x8a, 0xa2, 0x40, 0x8e, 0x39, 0xfa, 0xe8, 0x4b,
+	0x01, 0x1f, 0x7d, 0x0c, 0x7a, 0x60, 0xa3, 0xe5, 0x25, 0x47, 0xf7, 0x16, 0xf8, 0xd2, 0x62, 0x67,
+	0x1f, 0xe2, 0x43, 0x42, 0x50, 0x84, 0xa7, 0x9d, 0xef, 0xff, 0xbe, 0x6f, 0xe6, 0xff, 0x67, 0xe6,
+	0x1f, 0xc2, 0x4a, 0xdf, 0xf1, 0xe8, 0x29, 0xe5, 0xdb, 0xbe, 0xa0, 0xe6, 0x71, 0x8d, 0xba, 0x76,
+	0x6d, 0xc0, 0x04, 0xb5, 0xa8, 0xa0, 0x55, 0xd7, 0x73, 0x84, 0x83, 0x4a, 0x42, 0xf0, 0x6a, 0xcc,
+	0xaa, 0x9e, 0x3c, 0xdc, 0xd8, 0xe9, 0xda, 0xa2, 0x37, 0x3c, 0xaa, 0x9a, 0xce, 0xa0, 0xc6, 0xf8,
+	0x89, 0x33, 0x72, 0x3d, 0xe7, 0x6c, 0x54, 0x93, 0x64, 0x73, 0xbb, 0xcb, 0xf8, 0xf6, 0x09, 0xed,
+	0xdb, 0x16, 0x15, 0xac, 0xb6, 0xf0, 0x11, 0x59, 0x6e, 0x6c, 0x4f, 0x59, 0x74, 0x9d, 0xae, 0x13,
+	0x89, 0x8f, 0x86, 0x1d, 0x39, 0x92, 0x03, 0xf9, 0x15, 0xd3, 0xef, 0x75, 0x1d, 0xa7, 0xdb, 0x67,
+	0x17, 0x2c, 0x5f, 0x78, 0x43, 0x53, 0xc4, 0x51, 0x6d, 0x3e, 0x2a, 0xec, 0x01, 0xf3, 0x05, 0x1d,
+	0xb8, 0x31, 0x01, 0xcf, 0x13, 0x4e, 0x3d, 0xea, 0xba, 0xcc, 0xf3, 0xe3, 0xf8, 0x2f, 0x16, 0x4b,
+	0xc0, 0xf8, 0x70, 0x90, 0x84, 0xef, 0x2f, 0x86, 0x6d, 0x8b, 0x71, 0x61, 0x77, 0xec, 0xd4, 0x63,
+	0xf3, 0x6f, 0x39, 0x08, 0xc9, 0xd9, 0x93, 0xb8, 0x72, 0xe8, 0x19, 0xcc, 0x77, 0xa9, 0x60, 0xa7,
+	0x74, 0x64, 0xd8, 0x96, 0xaf, 0x82, 0x0a, 0xd8, 0xca, 0x3f, 0xd8, 0xac, 0xce, 0x56, 0xb2, 0xfa,
+	0x28, 0xa2, 0xe8, 0x17, 0x6e, 0xf5, 0xf2, 0xbb, 0xfa, 0xca, 0x9f, 0xc1, 0x52, 0x19, 0xbc, 0x1e,
+	0x6b, 0xca, 0x9b, 0xb1, 0x06, 0x08, 0xec, 0x26, 0x2c, 0x1f, 0xe9, 0xb0, 0xe8, 0x52, 0xf3, 0x98,
+	0x09, 0xe3, 0xc8, 0x73, 0x8e, 0x99, 0xa7, 0x22, 0x69, 0xfc, 0xab, 0x79, 0xe3, 0xa7, 0x92, 0x54,
+	0x97, 0x9c, 0x64, 0x4d, 0xa4, 0xe0, 0x4e, 0xa1, 0xe8, 0x3e, 0x2c, 0x52, 0x2e, 0x18, 0xe7, 0xd4,
+	0xb0, 0xb9, 0xc5, 0xce, 0xd4, 0xa5, 0x0a, 0xd8, 0x2a, 0x92, 0x42, 0x0c, 0xea, 0x21, 0x86, 0x3e,
+	0x84, 0x99, 0xb0, 0x98, 0xea, 0xb2, 0x9c, 0x66, 0xa3, 0x1a, 0x15, 0xb2, 0x9a, 0x14, 0xb2, 0x7a,
+	0x98, 0x54, 0xba, 0x9e, 0xf9, 0xea, 0xdf, 0x1a, 0x20, 0x92, 0x8d, 0xee, 0xc1, 0x5c, 0xba, 0x05,
+	0x6a, 0x46, 0xda, 0x5e, 0x00, 0xe8, 0xd7, 0xb0, 0xd4, 0xb1, 0x39, 0x33, 0x2e, 0x28, 0x2b, 0x15,
+	0xb0, 0x95, 0x21, 0xc5, 0x10, 0x4d, 0x0d, 0xd1, 0xc7, 0x50, 0x65, 0xdc, 0xf4, 0x46, 0xae, 0x60,
+	0x96, 0x31, 0x27, 0x58, 0xad, 0x80, 0xad, 0x02, 0xb9, 0x95, 0xc6, 0xf7, 0x67, 0x94, 0x0c, 0x6a,
+	0x57, 0x29, 0x8d, 0x63, 0x16, 0x6e, 0x88, 0x7a, 0xad, 0x02, 0xb6, 0x72, 0x75, 0x2d, 0x18, 0x6b,
+	0x77, 0xf7, 0x2e, 0x35, 0xf9, 0x94, 0x8d, 0xf4, 0x06, 0xb9, 0xcb, 0xae, 0x0c, 0x5a, 0xe8, 0x1e,
+	0xcc, 0x78, 0xbe, 0x6f, 0xab, 0xd9, 0x0a, 0xd8, 0x5a, 0xaa, 0x67, 0x83, 0xb1, 0x96, 0x21, 0xed,
+	0xb6, 0x4e, 0x24, 0x8a, 0x0e, 0x60, 0xde, 0xb7, 0xbb, 0x9c, 0xf6, 0x0d, 0x49, 0x2a, 0xcb, 0x02,
+	0xde, 0x5d, 0x28, 0xe0, 0x7e, 0xdf, 0xa1, 0xe2, 0x73, 0xda, 0x1f, 0xb2, 0x7a, 0x29, 0x18, 0x6b,
+	0xb0, 0x2d, 0x35, 0xd2, 0x07, 0x46, 0x7a, 0x12, 0xba, 0x3d, 0x80, 0x05, 0xb3, 0x47, 0x39, 0x67,
+	0xb1, 0x5d, 0x4e, 0xce, 0xb9, 0x16, 0x8c, 0xb5, 0xfc, 0x6e, 0x84, 0x4b, 0x49, 0x3e, 0x26, 0x49,
+	0xcd, 0x67, 0xf0, 0x76, 0xc8, 0x35, 0x7c, 0x41, 0xb9, 0x45, 0x3d, 0xcb, 0xb0, 0xd8, 0x89, 0x4d,
+	0x85, 0xed, 0x70, 0x15, 0x4a, 0xf9, 0x9d, 0x60, 0xac, 0xdd, 0x0c, 0x75, 0xed, 0x98, 0xd1, 0x48,
+	0x08, 0xe4, 0x66, 0xa8, 0x5c, 0x80, 0xd1, 0x1d, 0xb8, 0xec, 0x73, 0x4f, 0xcd, 0x4b, 0xf9, 0xb5,
+	0x60, 0xac, 0x2d, 0xb7, 0x9b, 0x84, 0x84, 0x18, 0x7a, 0x1f, 0x96, 0x3b, 0x1e, 0xfb, 0x72, 0xc8,
+	0xb8, 0x39, 0x32, 0x9c, 0x4e, 0xc7, 0x67, 0x42, 0x2d, 0x54, 0xc0, 0xd6, 0x32, 0x59, 0x4b, 0xf1,
+	0x96, 0x84, 0xd1, 0x87, 0x30, 0xdb, 0x77, 0xcc, 0x68, 0x25, 0x45, 0x59, 0x17, 0x75, 0xfe, 0xfc,
+	0x1e, 0xc4, 0x71, 0x92, 0x32, 0xd1, 0x1f, 0xa0, 0x6a, 0x39, 0xa7, 0xbc, 0x6f, 0xf3, 0x63, 0xc3,
+	0xa5, 0xa2, 0x67, 0x98, 0x0e, 0xf7, 0x85, 0x47, 0x6d, 0x2e, 0xd4, 0x52, 0x05, 0x6c, 0x95, 0x1e,
+	0xbc, 0x37, 0xef, 0xd2, 0x88, 0xf9, 0x4f, 0xa9, 0xe8, 0xed, 0xa6, 0xec, 0x7a, 0xf6, 0x5d, 0x7d,
+	0xe5, 0x4f, 0xe1, 0x15, 0x23, 0xb7, 0xac, 0x4b, 0x19, 0xe8, 0x97, 0xb0, 0x30, 0x74, 0xe5, 0x4c,
+	0xc2, 0x39, 0x66, 0x5c, 0x5d, 0x93, 0xe7, 0x2d, 0x1f, 0x61, 0x87, 0x21, 0x84, 0xb6, 0x61, 0x31,
+	0xd9, 0x91, 0xe8, 0xfa, 0x5c, 0x0f, 0xcf, 0xb9, 0xf4, 0xfe, 0x60, 0x59, 0xfd, 0x2f, 0x20, 0xc9,
+	0x86, 0x45, 0x17, 0xe9, 0x31, 0x2c, 0xa6, 0xab, 0xef, 0x3b, 0xd4, 0x52, 0xd7, 0x65, 0xe2, 0xf7,
+	0xaf, 0xe8, 0x08, 0xc9, 0xca, 0x0f, 0x1c, 0x6a, 0x91, 0x82, 0x35, 0x35, 0x42, 0x0f, 0x61, 0x96,
+	0x5a, 0x27, 0x94, 0x9b, 0xcc, 0x52, 0x4d, 0x69, 0x72, 0x7b, 0xe1, 0x54, 0xb5, 0x65, 0x7b, 0x24,
+	0x29, 0xf1, 0xb7, 0x99, 0x97, 0x5f, 0x6b, 0xca, 0xe6, 0x5b, 0x00, 0xb3, 0x49, 0x65, 0x43, 0x9f,
+	0x3e, 0x15, 0xb6, 0x18, 0x5a, 0x4c, 0xb6, 0x27, 0x50, 0xbf, 0xfd, 0xae, 0x7e, 0x03, 0xa1, 0x3b,
+	0x4a, 0xf8, 0x7b, 0xfe, 0xf9, 0x27, 0xef, 0xc7, 0x1f, 0xaf, 0x48, 0x4a, 0x44, 0x1f, 0xc1, 0x5c,
+	0xdf, 0xe1, 0xdd, 0x48, 0xb5, 0xb4, 0xa8, 0xea, 0x24, 0xaa, 0xce, 0x2b, 0x72, 0xc1, 0x44, 0x1b,
+	0x30, 0x4b, 0xfb, 0xf1, 0x5c, 0x61, 0x2b, 0x59, 0x21, 0xe9, 0x58, 0xc6, 0x4c, 0x73, 0xe8, 0x51,
+	0x73, 0xa4, 0x66, 0xe2, 0x58, 0x3c, 0x46, 0x9f, 0xc0, 0x55, 0xdf, 0x19, 0x7a, 0x26, 0x93, 0x2d,
+	0xa2, 0xf4, 0x00, 0x5f, 0x75, 0x4e, 0xda, 0x92, 0x35, 0xb5, 0xb3, 0xb1, 0x6e, 0xf3, 0x9f, 0x19,
+	0x78, 0xe3, 0xb2, 0x66, 0x88, 0x7e, 0x03, 0xe1, 0x80, 0xf9, 0x3e, 0xed, 0xb2, 0xb0, 0x1f, 0x00,
+	0xd9, 0x0f, 0x8a, 0xc1, 0x58, 0xcb, 0x3d, 0x89, 0x50, 0xbd, 0x41, 0x72, 0x31, 0x41, 0xb7, 0xd0,
+	0x08, 0x96, 0x3b, 0x8e, 0x77, 0x4a, 0x3d, 0x8b, 0x79, 0x06, 0x67, 0x22, 0xd4, 0x84, 0xe9, 0x17,
+	0xea, 0xad, 0xb0, 0x4f, 0xff, 0x6b, 0xac, 0x7d, 0xd4, 0x75, 0xaa, 0xa2, 0xc7, 0x44, 0xcf, 0xe6,
+	0x5d, 0xbf, 0xca, 0x99, 0x38, 0x75, 0xbc, 0xe3, 0xda, 0xec, 0xcb, 0x71, 0xf2, 0xb0, 0xe6, 0x1e,
+	0x77, 0x6b, 0x62, 0xe4, 0x32, 0xbf, 0xda, 0x64, 0x42, 0x6f, 0x04, 0x63, 0xad, 0xb4, 0x9f, 0x18,
+	0x4b, 0x84, 0x94, 0x3a, 0xd3, 0x63, 0x0b, 0xed, 0xc1, 0xf5, 0x8b, 0xa9, 0x05, 0xe3, 0x94, 0xcb,
+	0xd9, 0x97, 0xe5, 0x8a, 0x6f, 0x06, 0x63, 0xed, 0x7a, 0x6a, 0x70, 0x28, 0xa3, 0x7a, 0x83, 0x5c,
+	0xef, 0xcc, 0x41, 0x56, 0xd8, 0x41, 0x2e, 0x6c, 0x6c, 0x4b, 0x96, 0x3a, 0x17, 0x75, 0x90, 0x54,
+	0xaf, 0x37, 0x48, 0x3e, 0x25, 0xe9, 0x16, 0x7a, 0x0e, 0xe0, 0x7a, 0xcf, 0x19, 0x30, 0x23, 0x4e,
+	0x27, 0xc9, 0x7c, 0x45, 0x66, 0xfe, 0xd9, 0xcf, 0xcd, 0xbc, 0xfc, 0xd8, 0x19, 0xb0, 0x66, 0xc4,
+	0x8f, 0x72, 0x2f, 0xf7, 0x66, 0x11, 0x0b, 0x1d, 0xc0, 0x5b, 0x33, 0x2b, 0xb8, 0x28, 0xc0, 0xaa,
+	0x4c, 0xe0, 0x76, 0x30, 0xd6, 0xd6, 0xa7, 0x7c, 0xd2, 0x12, 0xac, 0xf7, 0x16, 0x40, 0x0b, 0x7d,
+	0x0c, 0x33, 0x3d, 0xc7, 0xf5, 0xd5, 0x6b, 0x95, 0xe5, 0x9f, 0x7a, 0x35, 0x89, 0x33, 0x14, 0xec,
+	0xb1, 0xe3, 0x12, 0xa9, 0xd8, 0xfc, 0x0f, 0x80, 0x37, 0x2e, 0x0b, 0xa3, 0x3d, 0x98, 0xf7, 0x98,
+	0xc9, 0xec, 0x13, 0x66, 0x19, 0x54, 0xa8, 0xe0, 0x27, 0x1f, 0xca, 0x6c, 0x58, 0x36, 0xf9, 0x58,
+	0xc2, 0x44, 0xb8, 0x23, 0x90, 0x06, 0xf3, 0x3e, 0xe3, 0xf2, 0x74, 0xd1, 0x41, 0x74, 0xb5, 0x72,
+	0x04, 0x46, 0x50, 0x93, 0x0e, 0x58, 0xf8, 0x6a, 0xc6, 0x04, 0x6a, 0x59, 0x1e, 0xf3, 0xfd, 0xe8,
+	0x04, 0x90, 0x62, 0x84, 0xee, 0x44, 0x60, 0xf8, 0xaa, 0xc7, 0xae, 0xb1, 0x93, 0xdc, 0x67, 0x52,
+	0x48, 0xc0, 0xc4, 0x2b, 0x25, 0xd1, 0x2e, 0xe3, 0x42, 0xee, 0x68, 0x8e, 0xa4, 0xd2, 0x9d, 0x10,
+	0xdc, 0x0c, 0xb7, 0xff, 0x92, 0x7e, 0x84, 0x2a, 0x30, 0x3f, 0x14, 0x76, 0xdf, 0xfe, 0x63, 0xd4,
+	0xc2, 0xc3, 0x94, 0x97, 0xc8, 0x34, 0x14, 0x66, 0xf3, 0xe5, 0x90, 0x0d, 0x99, 0x61, 0x31, 0x57,
+	0xf4, 0xe2, 0x7f, 0x16, 0x50, 0x42, 0x8d, 0x10, 0x41, 0xef, 0xc1, 0x35, 0x71, 0x66, 0x74, 0xa8,
+	0xdd, 0x1f, 0x7a, 0xcc, 0xf0, 0xa8, 0x88, 0xfa, 0xc2, 0x12, 0x29, 0x8a, 0xb3, 0xfd, 0x08, 0x25,
+	0x54, 0xb0, 0x0f, 0xfe, 0xb2, 0x04, 0x4b, 0xb3, 0x77, 0x1c, 0x21, 0x58, 0x6a, 0xb7, 0x9e, 0x91,
+	0xdd, 0x3d, 0xe3, 0x59, 0xf3, 0xd3, 0x66, 0xeb, 0x8b, 0x66, 0x59, 0x41, 0x25, 0x08, 0x63, 0xec,
+	0xd1, 0xd3, 0x76, 0x19, 0xa0, 0x75, 0xb8, 0x16, 0x8f, 0xc9, 0xde, 0x23, 0xbd, 0x7d, 0x48, 0x7e,
+	0x57, 0x5e, 0x46, 0x77, 0xe0, 0xcd, 0x18, 0xd4, 0x9f, 0x1a, 0x8f, 0xf6, 0x5a, 0x07, 0xad, 0xdd,
+	0x9d, 0x43, 0xbd, 0xd5, 0x2c, 0x67, 0x50, 0x05, 0xde, 0x8b, 0x43, 0x5f, 0xe8, 0xfb, 0xba, 0x11,
+	0xbe, 0x89, 0x33, 0x8c, 0x15, 0x84, 0xe1, 0x46, 0xcc, 0xa8, 0x1f, 0x2e, 0xc6, 0x57, 0xa7, 0x1c,
+	0x0e, 0x5a, 0x64, 0x67, 0x91, 0x71, 0x6d, 0x9e, 0x71, 0xd8, 0x68, 0xed, 0xcc, 0x30, 0xb2, 0x48,
+	0x83, 0x77, 0x63, 0xc6, 0x6e, 0xeb, 0x49, 0x5d, 0x6f, 0xee, 0x35, 0x66, 0x08, 0xb9, 0x8d, 0xcc,
+	0x8b, 0xbf, 0x63, 0xa5, 0xfe, 0x0f, 0xf0, 0xfb, 0xda, 0xff, 0x71, 0xe5, 0x04, 0x77, 0x8f, 0x5e,
+	0x9f, 0x63, 0xf0, 0xe6, 0x1c, 0x83, 0xef, 0xce, 0xb1, 0xf2, 0xfd, 0x39, 0x56, 0x7e, 0x38, 0xc7,
+	0xca, 0xdb, 0x73, 0xac, 0xfc, 0x78, 0x8e, 0xc1, 0xf3, 0x00, 0x83, 0x17, 0x01, 0x56, 0xbe, 0x09,
+	0x30, 0xf8, 0x36, 0xc0, 0xca, 0xcb, 0x00, 0x2b, 0xaf, 0x02, 0xac, 0xbc, 0x0e, 0x30, 0x78, 0x13,
+	0x60, 0xf0, 0x5d, 0x80, 0x95, 0xef, 0x03, 0x0c, 0x7e, 0x08, 0xb0, 0xf2, 0x36, 0xc0, 0xe0, 0xc7,
+	0x00, 0x2b, 0xcf, 0x27, 0x58, 0x79, 0x31, 0xc1, 0xe0, 0xab, 0x09, 0x56, 0xfe, 0x3a, 0xc1, 0xe0,
+	0xeb, 0x09, 0x56, 0xbe, 0x99, 0x60, 0xe5, 0xdb, 0x09, 0x06, 0x2f, 0x27, 0x18, 0xbc, 0x9a, 0x60,
+	0x70, 0xb4, 0x2a, 0x0f, 0xff, 0xc3, 0xff, 0x0d, 0x00, 0x77, 0xe2, 0x3c, 0xce, 0x61, 0x0c, 0x00,
+	0x00,
 }
 
 func (x LocationSource) String() string {
@@ -738,6 +820,9 @@ func (this *RxMetadata) Equal(that interface{}) bool {
 	if this.ChannelIndex != that1.ChannelIndex {
 		return false
 	}
+	if !this.DownlinkLoad.Equal(that1.DownlinkLoad) {
+		return false
+	}
 	if !this.Advanced.Equal(that1.Advanced) {
 		return false
 	}
@@ -862,6 +947,36 @@ func (this *PacketBrokerRouteHop) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GatewayDownlinkLoad) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayDownlinkLoad)
+	if !ok {
+		that2, ok := that.(GatewayDownlinkLoad)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Utilization != that1.Utilization {
+		return false
+	}
+	if this.QueueDepth != that1.QueueDepth {
+		return false
+	}
+	if this.TxFailureRate != that1.TxFailureRate {
+		return false
+	}
+	return true
+}
 func (m *RxMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -896,6 +1011,20 @@ func (m *RxMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x9a
 	}
+	if m.DownlinkLoad != nil {
+		{
+			size, err := m.DownlinkLoad.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.PacketBroker != nil {
 		{
 			size, err := m.PacketBroker.MarshalToSizedBuffer(dAtA[:i])
@@ -1230,6 +1359,46 @@ func (m *PacketBrokerRouteHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GatewayDownlinkLoad) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayDownlinkLoad) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayDownlinkLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxFailureRate != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.TxFailureRate)))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.QueueDepth != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.QueueDepth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Utilization != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Utilization)))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMetadata(v)
 	base := offset
@@ -1300,6 +1469,22 @@ func NewPopulatedPacketBrokerRouteHop(r randyMetadata, easy bool) *PacketBrokerR
 	return this
 }
 
+func NewPopulatedGatewayDownlinkLoad(r randyMetadata, easy bool) *GatewayDownlinkLoad {
+	this := &GatewayDownlinkLoad{}
+	this.Utilization = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Utilization *= -1
+	}
+	this.QueueDepth = r.Uint32()
+	this.TxFailureRate = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.TxFailureRate *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyMetadata interface {
 	Float32() float32
 	Float64() float64
@@ -1438,6 +1623,10 @@ func (m *RxMetadata) Size() (n int) {
 		l = m.PacketBroker.Size()
 		n += 2 + l + sovMetadata(uint64(l))
 	}
+	if m.DownlinkLoad != nil {
+		l = m.DownlinkLoad.Size()
+		n += 2 + l + sovMetadata(uint64(l))
+	}
 	if m.Advanced != nil {
 		l = m.Advanced.Size()
 		n += 2 + l + sovMetadata(uint64(l))
@@ -1531,6 +1720,24 @@ func (m *PacketBrokerRouteHop) Size() (n int) {
 	return n
 }
 
+func (m *GatewayDownlinkLoad) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Utilization != 0 {
+		n += 5
+	}
+	if m.QueueDepth != 0 {
+		n += 1 + sovMetadata(uint64(m.QueueDepth))
+	}
+	if m.TxFailureRate != 0 {
+		n += 5
+	}
+	return n
+}
+
 func sovMetadata(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1560,6 +1767,7 @@ func (this *RxMetadata) String() string {
 		`SignalRSSI:` + strings.Replace(fmt.Sprintf("%v", this.SignalRSSI), "FloatValue", "types.FloatValue", 1) + `,`,
 		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
 		`PacketBroker:` + strings.Replace(this.PacketBroker.String(), "PacketBrokerMetadata", "PacketBrokerMetadata", 1) + `,`,
+		`DownlinkLoad:` + strings.Replace(this.DownlinkLoad.String(), "GatewayDownlinkLoad", "GatewayDownlinkLoad", 1) + `,`,
 		`Advanced:` + strings.Replace(fmt.Sprintf("%v", this.Advanced), "Struct", "types.Struct", 1) + `,`,
 		`}`,
 	}, "")
@@ -1614,6 +1822,18 @@ func (this *PacketBrokerRouteHop) String() string {
 	}, "")
 	return s
 }
+func (this *GatewayDownlinkLoad) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayDownlinkLoad{`,
+		`Utilization:` + fmt.Sprintf("%v", this.Utilization) + `,`,
+		`QueueDepth:` + fmt.Sprintf("%v", this.QueueDepth) + `,`,
+		`TxFailureRate:` + fmt.Sprintf("%v", this.TxFailureRate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMetadata(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2086,6 +2306,42 @@ func (m *RxMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkLoad", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkLoad == nil {
+				m.DownlinkLoad = &GatewayDownlinkLoad{}
+			}
+			if err := m.DownlinkLoad.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Advanced", wireType)
@@ -2773,6 +3029,100 @@ func (m *PacketBrokerRouteHop) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GatewayDownlinkLoad) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayDownlinkLoad: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayDownlinkLoad: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.Utilization = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueDepth", wireType)
+			}
+			m.QueueDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFailureRate", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.TxFailureRate = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMetadata(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,10 @@ var RxMetadataFieldPathsNested = []string{
 	"antenna_index",
 	"channel_index",
 	"channel_rssi",
+	"downlink_load",
+	"downlink_load.queue_depth",
+	"downlink_load.tx_failure_rate",
+	"downlink_load.utilization",
 	"downlink_path_constraint",
 	"encrypted_fine_timestamp",
 	"encrypted_fine_timestamp_key_id",
@@ -43,6 +47,7 @@ var RxMetadataFieldPathsTopLevel = []string{
 	"antenna_index",
 	"channel_index",
 	"channel_rssi",
+	"downlink_load",
 	"downlink_path_constraint",
 	"encrypted_fine_timestamp",
 	"encrypted_fine_timestamp_key_id",
@@ -108,3 +113,14 @@ var PacketBrokerRouteHopFieldPathsTopLevel = []string{
 	"sender_address",
 	"sender_name",
 }
+var GatewayDownlinkLoadFieldPathsNested = []string{
+	"queue_depth",
+	"tx_failure_rate",
+	"utilization",
+}
+
+var GatewayDownlinkLoadFieldPathsTopLevel = []string{
+	"queue_depth",
+	"tx_failure_rate",
+	"utilization",
+}
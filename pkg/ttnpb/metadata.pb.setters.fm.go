@@ -226,6 +226,31 @@ func (dst *RxMetadata) SetFields(src *RxMetadata, paths ...string) error {
 				var zero uint32
 				dst.ChannelIndex = zero
 			}
+		case "downlink_load":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayDownlinkLoad
+				if (src == nil || src.DownlinkLoad == nil) && dst.DownlinkLoad == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DownlinkLoad
+				}
+				if dst.DownlinkLoad != nil {
+					newDst = dst.DownlinkLoad
+				} else {
+					newDst = &GatewayDownlinkLoad{}
+					dst.DownlinkLoad = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkLoad = src.DownlinkLoad
+				} else {
+					dst.DownlinkLoad = nil
+				}
+			}
 		case "advanced":
 			if len(subs) > 0 {
 				return fmt.Errorf("'advanced' has no subfields, but %s were specified", subs)
@@ -444,3 +469,44 @@ func (dst *PacketBrokerRouteHop) SetFields(src *PacketBrokerRouteHop, paths ...s
 	}
 	return nil
 }
+
+func (dst *GatewayDownlinkLoad) SetFields(src *GatewayDownlinkLoad, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "utilization":
+			if len(subs) > 0 {
+				return fmt.Errorf("'utilization' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Utilization = src.Utilization
+			} else {
+				var zero float32
+				dst.Utilization = zero
+			}
+		case "queue_depth":
+			if len(subs) > 0 {
+				return fmt.Errorf("'queue_depth' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.QueueDepth = src.QueueDepth
+			} else {
+				var zero uint32
+				dst.QueueDepth = zero
+			}
+		case "tx_failure_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_failure_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxFailureRate = src.TxFailureRate
+			} else {
+				var zero float32
+				dst.TxFailureRate = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
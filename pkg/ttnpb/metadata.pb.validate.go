@@ -150,6 +150,18 @@ func (m *RxMetadata) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "downlink_load":
+
+			if v, ok := interface{}(m.GetDownlinkLoad()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RxMetadataValidationError{
+						field:  "downlink_load",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		case "advanced":
 
 			if v, ok := interface{}(m.GetAdvanced()).(interface{ ValidateFields(...string) error }); ok {
@@ -546,3 +558,90 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = PacketBrokerRouteHopValidationError{}
+
+// ValidateFields checks the field values on GatewayDownlinkLoad with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *GatewayDownlinkLoad) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayDownlinkLoadFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "utilization":
+			// no validation rules for Utilization
+		case "queue_depth":
+			// no validation rules for QueueDepth
+		case "tx_failure_rate":
+			// no validation rules for TxFailureRate
+		default:
+			return GatewayDownlinkLoadValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayDownlinkLoadValidationError is the validation error returned by
+// GatewayDownlinkLoad.ValidateFields if the designated constraints aren't met.
+type GatewayDownlinkLoadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayDownlinkLoadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayDownlinkLoadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayDownlinkLoadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayDownlinkLoadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayDownlinkLoadValidationError) ErrorName() string {
+	return "GatewayDownlinkLoadValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayDownlinkLoadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayDownlinkLoad.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayDownlinkLoadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayDownlinkLoadValidationError{}
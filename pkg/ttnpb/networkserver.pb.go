@@ -11,9 +11,12 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	go_thethings_network_lorawan_stack_v3_pkg_types "go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -28,6 +31,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -73,9 +77,115 @@ func (m *GenerateDevAddrResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenerateDevAddrResponse proto.InternalMessageInfo
 
+type ListNsEndDevicesRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	// The names of the end device fields that should be returned.
+	// See the API reference for which fields can be returned by the Network Server.
+	FieldMask types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	// Limit the number of results per page. 0 is interpreted as 100.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page uint32 `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	// Only return end devices with this LoRaWAN MAC version. MAC_UNKNOWN matches any version.
+	LoRaWANVersion MACVersion `protobuf:"varint,5,opt,name=lorawan_version,json=lorawanVersion,proto3,enum=ttn.lorawan.v3.MACVersion" json:"lorawan_version,omitempty"`
+	// Only return end devices that currently operate in one of these classes. Empty matches any class.
+	DeviceClasses []Class `protobuf:"varint,6,rep,packed,name=device_classes,json=deviceClasses,proto3,enum=ttn.lorawan.v3.Class" json:"device_classes,omitempty"`
+	// Only return end devices with a current or pending session DevAddr matching this prefix.
+	DevAddrPrefix *go_thethings_network_lorawan_stack_v3_pkg_types.DevAddrPrefix `protobuf:"bytes,7,opt,name=dev_addr_prefix,json=devAddrPrefix,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.DevAddrPrefix" json:"dev_addr_prefix,omitempty"`
+	// Only return end devices that were last seen at or after this time.
+	LastSeenAfter *time.Time `protobuf:"bytes,8,opt,name=last_seen_after,json=lastSeenAfter,proto3,stdtime" json:"last_seen_after,omitempty"`
+	// Only return end devices that were last seen before this time.
+	LastSeenBefore       *time.Time `protobuf:"bytes,9,opt,name=last_seen_before,json=lastSeenBefore,proto3,stdtime" json:"last_seen_before,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListNsEndDevicesRequest) Reset()      { *m = ListNsEndDevicesRequest{} }
+func (*ListNsEndDevicesRequest) ProtoMessage() {}
+func (*ListNsEndDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{1}
+}
+func (m *ListNsEndDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListNsEndDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListNsEndDevicesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListNsEndDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNsEndDevicesRequest.Merge(m, src)
+}
+func (m *ListNsEndDevicesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListNsEndDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNsEndDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNsEndDevicesRequest proto.InternalMessageInfo
+
+func (m *ListNsEndDevicesRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
+func (m *ListNsEndDevicesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListNsEndDevicesRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListNsEndDevicesRequest) GetLoRaWANVersion() MACVersion {
+	if m != nil {
+		return m.LoRaWANVersion
+	}
+	return MAC_UNKNOWN
+}
+
+func (m *ListNsEndDevicesRequest) GetDeviceClasses() []Class {
+	if m != nil {
+		return m.DeviceClasses
+	}
+	return nil
+}
+
+func (m *ListNsEndDevicesRequest) GetLastSeenAfter() *time.Time {
+	if m != nil {
+		return m.LastSeenAfter
+	}
+	return nil
+}
+
+func (m *ListNsEndDevicesRequest) GetLastSeenBefore() *time.Time {
+	if m != nil {
+		return m.LastSeenBefore
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
 	golang_proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
+	proto.RegisterType((*ListNsEndDevicesRequest)(nil), "ttn.lorawan.v3.ListNsEndDevicesRequest")
+	golang_proto.RegisterType((*ListNsEndDevicesRequest)(nil), "ttn.lorawan.v3.ListNsEndDevicesRequest")
 }
 
 func init() {
@@ -86,52 +196,81 @@ func init() {
 }
 
 var fileDescriptor_c77e7504ad1081b8 = []byte{
-	// 717 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x48, 0x1b, 0x4b,
-	0x18, 0x9e, 0x89, 0xe2, 0x7b, 0x0c, 0xa2, 0xbc, 0x79, 0xf2, 0x5e, 0x9b, 0xb6, 0x53, 0x89, 0x96,
-	0x8a, 0xe0, 0x6c, 0x89, 0x87, 0x82, 0x3d, 0x29, 0x09, 0xb1, 0x60, 0xa4, 0x6a, 0xed, 0xc1, 0x4b,
-	0xd8, 0x64, 0x7f, 0x37, 0x4b, 0xe2, 0xec, 0xba, 0x33, 0x89, 0x48, 0x11, 0xa4, 0x87, 0xe2, 0xb1,
-	0x50, 0x0a, 0x3d, 0xf6, 0x52, 0xf0, 0x28, 0xbd, 0xd4, 0x53, 0xf1, 0xe8, 0x51, 0xe8, 0x45, 0x7a,
-	0x10, 0xb3, 0xdb, 0x83, 0xd0, 0x8b, 0x47, 0x8f, 0x25, 0x9b, 0xc4, 0x98, 0xac, 0x11, 0x6d, 0x7b,
-	0x9b, 0xc9, 0xff, 0xfd, 0xff, 0xf7, 0xfd, 0xdf, 0x7c, 0x59, 0xf2, 0xa0, 0x68, 0xbb, 0xfa, 0x9a,
-	0x2e, 0xc6, 0xa4, 0xd2, 0x73, 0x05, 0x4d, 0x77, 0x2c, 0x4d, 0x80, 0x5a, 0xb3, 0xdd, 0x82, 0x04,
-	0xb7, 0x0c, 0x2e, 0x77, 0x5c, 0x5b, 0xd9, 0xb4, 0x4f, 0x29, 0xc1, 0xeb, 0x50, 0x5e, 0x1e, 0x8f,
-	0x8e, 0x99, 0x96, 0xca, 0x97, 0xb2, 0x3c, 0x67, 0xaf, 0x68, 0xa6, 0x6d, 0xda, 0x5a, 0x00, 0xcb,
-	0x96, 0x96, 0x83, 0x5b, 0x70, 0x09, 0x4e, 0xb5, 0xf6, 0xe8, 0x5d, 0xd3, 0xb6, 0xcd, 0x22, 0x04,
-	0xe3, 0x75, 0x21, 0x6c, 0xa5, 0x2b, 0xcb, 0x16, 0xb2, 0x5e, 0xbd, 0x53, 0xaf, 0x9e, 0xcf, 0x80,
-	0x15, 0x47, 0xad, 0xd7, 0x8b, 0xb1, 0xb0, 0x40, 0x10, 0x46, 0xc6, 0x80, 0xb2, 0x95, 0x83, 0x3a,
-	0x66, 0x28, 0x8c, 0xb1, 0x0c, 0x10, 0xca, 0x5a, 0xb6, 0xc0, 0x6d, 0xb0, 0x0c, 0x86, 0x41, 0x2b,
-	0x20, 0xa5, 0x6e, 0x42, 0x1d, 0x11, 0x5b, 0x25, 0xff, 0xa7, 0x40, 0x80, 0xab, 0x2b, 0x48, 0x40,
-	0x79, 0xd2, 0x30, 0xdc, 0x79, 0x90, 0x8e, 0x2d, 0x24, 0xd0, 0x17, 0xe4, 0x6f, 0x03, 0xca, 0x19,
-	0xdd, 0x30, 0xdc, 0x5b, 0x78, 0x10, 0x8f, 0xf4, 0x4e, 0x3d, 0xf9, 0x76, 0x74, 0xff, 0xb1, 0x69,
-	0x73, 0x95, 0x07, 0x95, 0xb7, 0x84, 0x29, 0x79, 0xdd, 0x37, 0xad, 0x95, 0xa7, 0x3c, 0xae, 0x39,
-	0x05, 0x53, 0x53, 0xeb, 0x0e, 0x48, 0xde, 0x18, 0xfb, 0x97, 0x51, 0x3b, 0xc4, 0x05, 0x89, 0xcc,
-	0x4a, 0x9a, 0x27, 0xfd, 0x6d, 0xc4, 0xf4, 0x3f, 0x5e, 0x33, 0x85, 0x37, 0x4c, 0xe1, 0xc9, 0xaa,
-	0x29, 0xd1, 0x87, 0xbc, 0xf5, 0x25, 0x78, 0x07, 0xc5, 0xb1, 0x81, 0x57, 0x5f, 0xbf, 0xbf, 0x8d,
-	0xf4, 0xd1, 0x5e, 0x4d, 0x48, 0xad, 0xa1, 0x3d, 0x7e, 0x14, 0x21, 0xdd, 0x93, 0x72, 0x56, 0xd2,
-	0x19, 0xd2, 0x3f, 0x63, 0x89, 0xc2, 0xa4, 0xe3, 0x14, 0xad, 0x5c, 0xf0, 0x1a, 0x1d, 0x29, 0xef,
-	0xb5, 0x53, 0x5e, 0x68, 0x5a, 0x74, 0x46, 0xf0, 0x23, 0x4c, 0x9f, 0x93, 0x81, 0x84, 0xbd, 0x26,
-	0x8a, 0x96, 0x28, 0xcc, 0x95, 0xa0, 0x04, 0xf3, 0xe0, 0x14, 0xf5, 0x1c, 0xd0, 0xe1, 0xf6, 0xd6,
-	0x36, 0xd4, 0x6a, 0x09, 0xa4, 0x8a, 0x76, 0x20, 0xa6, 0x73, 0xe4, 0x9f, 0x16, 0xfc, 0xb3, 0x92,
-	0xcc, 0xff, 0xe6, 0xc8, 0x4c, 0xdb, 0xc8, 0x19, 0x4b, 0xaa, 0xf0, 0xc8, 0xa4, 0x30, 0x12, 0x41,
-	0xbe, 0x9e, 0x36, 0x53, 0x14, 0x1d, 0xbe, 0xc2, 0x86, 0xc6, 0x4c, 0x19, 0x4f, 0x93, 0xee, 0x54,
-	0xd5, 0xdf, 0x24, 0xe9, 0x9d, 0xd6, 0x85, 0x51, 0x84, 0x45, 0xa7, 0x5a, 0xa0, 0x21, 0x13, 0x6b,
-	0xbf, 0xa7, 0x6b, 0x09, 0xec, 0xa4, 0x37, 0xfe, 0xa3, 0x9b, 0xfc, 0x3b, 0x2b, 0xcf, 0xf5, 0xcc,
-	0x83, 0x69, 0x49, 0xe5, 0xae, 0xd3, 0x4f, 0x98, 0x74, 0xa5, 0x40, 0xd1, 0xa1, 0x70, 0x1c, 0xd4,
-	0x05, 0x74, 0xcd, 0x8c, 0xdb, 0x1d, 0xf7, 0x8b, 0x15, 0x82, 0x94, 0x00, 0xcd, 0x55, 0x53, 0xa2,
-	0x37, 0x17, 0x92, 0xda, 0xcb, 0xe6, 0xbf, 0x2c, 0x63, 0x19, 0x92, 0x5f, 0x28, 0x5e, 0x72, 0xdf,
-	0xd0, 0x6a, 0xd0, 0x70, 0xdf, 0xf9, 0x71, 0x83, 0xbe, 0x8e, 0x90, 0xae, 0x85, 0xcb, 0x44, 0x2f,
-	0xdc, 0x4c, 0xf4, 0x17, 0x1c, 0xa8, 0xfe, 0x8c, 0xa3, 0x57, 0xca, 0xe6, 0xbf, 0x28, 0x9b, 0xb7,
-	0xca, 0x9e, 0xc0, 0xa3, 0x4b, 0xe9, 0xd8, 0xf4, 0x9f, 0x62, 0x9a, 0xc0, 0xa3, 0xf4, 0x1d, 0x26,
-	0x3d, 0x09, 0x28, 0x82, 0x82, 0x6b, 0x66, 0xaf, 0x43, 0x3c, 0x62, 0xe9, 0xc0, 0x88, 0xd4, 0x68,
-	0x32, 0xac, 0xee, 0xda, 0x8b, 0x37, 0x37, 0x9d, 0xfa, 0x88, 0xf7, 0x2b, 0x0c, 0x1f, 0x54, 0x18,
-	0x3e, 0xac, 0x30, 0x74, 0x5c, 0x61, 0xe8, 0xa4, 0xc2, 0xd0, 0x69, 0x85, 0xa1, 0xb3, 0x0a, 0xc3,
-	0x9b, 0x1e, 0xc3, 0x5b, 0x1e, 0x43, 0xdb, 0x1e, 0xc3, 0x3b, 0x1e, 0x43, 0xbb, 0x1e, 0x43, 0x7b,
-	0x1e, 0x43, 0xfb, 0x1e, 0xc3, 0x07, 0x1e, 0xc3, 0x87, 0x1e, 0x43, 0xc7, 0x1e, 0xc3, 0x27, 0x1e,
-	0x43, 0xa7, 0x1e, 0xc3, 0x67, 0x1e, 0x43, 0x9b, 0x3e, 0x43, 0x5b, 0x3e, 0xc3, 0x6f, 0x7c, 0x86,
-	0xde, 0xfb, 0x0c, 0x7f, 0xf0, 0x19, 0xda, 0xf6, 0x19, 0xda, 0xf1, 0x19, 0xde, 0xf5, 0x19, 0xde,
-	0xf3, 0x19, 0x5e, 0xd2, 0x6e, 0xf0, 0x19, 0x55, 0xc2, 0xc9, 0x66, 0x7b, 0x02, 0x1b, 0xc6, 0x7f,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x59, 0x26, 0x71, 0xbd, 0x06, 0x00, 0x00,
+	// 1170 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6c, 0x13, 0xc7,
+	0x17, 0xde, 0x49, 0x9c, 0x3f, 0xcc, 0x8f, 0x38, 0xfc, 0xa6, 0xb4, 0xb8, 0x6e, 0x3b, 0x8e, 0x0c,
+	0x6d, 0xa3, 0x48, 0xd9, 0xad, 0xcc, 0xa1, 0x2a, 0xa8, 0x6a, 0xbd, 0x24, 0x0d, 0x94, 0x24, 0x02,
+	0xf3, 0xa7, 0x12, 0x17, 0x6b, 0xe2, 0x7d, 0xde, 0x8c, 0xbc, 0x9e, 0x5d, 0x76, 0x26, 0x86, 0x08,
+	0x21, 0x21, 0x0e, 0x15, 0xea, 0xa1, 0x42, 0xad, 0x2a, 0x55, 0x3d, 0x71, 0xa9, 0xc4, 0x11, 0xf5,
+	0x52, 0x0e, 0x55, 0xc5, 0x91, 0x23, 0x52, 0x2f, 0x88, 0x43, 0x8a, 0xd7, 0x3d, 0x70, 0xe4, 0x88,
+	0x72, 0xaa, 0xf6, 0x8f, 0xb1, 0xe3, 0xc5, 0x08, 0x52, 0x6e, 0x3b, 0xf3, 0xbe, 0xf7, 0xcd, 0x9b,
+	0xef, 0x7d, 0xf3, 0x16, 0x7f, 0xe8, 0xb8, 0x3e, 0xbb, 0xc4, 0xc4, 0xbc, 0x54, 0xac, 0xd6, 0x30,
+	0x98, 0xc7, 0x0d, 0x01, 0xea, 0x92, 0xeb, 0x37, 0x24, 0xf8, 0x2d, 0xf0, 0x75, 0xcf, 0x77, 0x95,
+	0x4b, 0xb2, 0x4a, 0x09, 0x3d, 0x81, 0xea, 0xad, 0xc3, 0xf9, 0xb2, 0xcd, 0xd5, 0xfa, 0xc6, 0x9a,
+	0x5e, 0x73, 0x9b, 0x06, 0x88, 0x96, 0xbb, 0xe9, 0xf9, 0xee, 0xe5, 0x4d, 0x23, 0x02, 0xd7, 0xe6,
+	0x6d, 0x10, 0xf3, 0x2d, 0xe6, 0x70, 0x8b, 0x29, 0x30, 0x52, 0x1f, 0x31, 0x65, 0x7e, 0xbe, 0x8f,
+	0xc2, 0x76, 0x6d, 0x37, 0x4e, 0x5e, 0xdb, 0xa8, 0x47, 0xab, 0x68, 0x11, 0x7d, 0x25, 0xf0, 0xf7,
+	0x6d, 0xd7, 0xb5, 0x1d, 0x88, 0x2a, 0x64, 0x42, 0xb8, 0x8a, 0x29, 0xee, 0x0a, 0x99, 0x44, 0xdf,
+	0x4b, 0xa2, 0xcf, 0x39, 0xa0, 0xe9, 0xa9, 0xcd, 0x24, 0x38, 0x33, 0x18, 0xac, 0x73, 0x70, 0xac,
+	0x6a, 0x93, 0xc9, 0x46, 0x82, 0x28, 0x0c, 0x22, 0x14, 0x6f, 0x82, 0x54, 0xac, 0xe9, 0x25, 0x80,
+	0x62, 0x5a, 0x26, 0x10, 0x56, 0xd5, 0x82, 0x16, 0xaf, 0x75, 0x2f, 0x74, 0x30, 0x8d, 0xe1, 0x16,
+	0x08, 0xc5, 0xeb, 0x1c, 0xfc, 0x6e, 0xa1, 0x85, 0x34, 0x28, 0xd9, 0xe9, 0x16, 0x9b, 0x06, 0x34,
+	0x41, 0x4a, 0x66, 0x43, 0x42, 0x51, 0xbc, 0x88, 0x0f, 0x2c, 0x81, 0x00, 0x9f, 0x29, 0x58, 0x80,
+	0x56, 0xd9, 0xb2, 0xfc, 0x0a, 0x48, 0xcf, 0x15, 0x12, 0xc8, 0x79, 0x3c, 0x69, 0x41, 0xab, 0xca,
+	0x2c, 0xcb, 0xcf, 0xa1, 0x19, 0x34, 0xbb, 0xd7, 0x3c, 0xfa, 0x68, 0xab, 0xf0, 0xa9, 0xed, 0xea,
+	0x6a, 0x1d, 0xd4, 0x3a, 0x17, 0xb6, 0xd4, 0x93, 0xf6, 0x1a, 0x3b, 0xcf, 0x69, 0x1d, 0x36, 0xbc,
+	0x86, 0x6d, 0xa8, 0x4d, 0x0f, 0xa4, 0xde, 0xa5, 0x9d, 0xb0, 0xe2, 0x8f, 0xe2, 0xad, 0x31, 0x7c,
+	0x60, 0x99, 0x4b, 0xb5, 0x2a, 0x17, 0x85, 0xb5, 0x10, 0x5d, 0x5a, 0x56, 0xe0, 0xe2, 0x06, 0x48,
+	0x45, 0x18, 0x9e, 0x66, 0x9e, 0xe7, 0xf0, 0x5a, 0xd4, 0x90, 0x2a, 0xb7, 0x64, 0x74, 0xf4, 0xff,
+	0x4a, 0x1f, 0xe9, 0x3b, 0x4d, 0xa3, 0x97, 0x7b, 0xb0, 0x13, 0x3d, 0x61, 0xcc, 0x7d, 0xdb, 0xe6,
+	0xd8, 0x77, 0x68, 0x64, 0x1f, 0xba, 0xbf, 0x55, 0xd0, 0x1e, 0x6c, 0x15, 0x50, 0x25, 0xcb, 0xfa,
+	0x91, 0x92, 0x7c, 0x81, 0x71, 0xaf, 0x65, 0xb9, 0x91, 0x88, 0x3d, 0xaf, 0xc7, 0x3d, 0xd3, 0xbb,
+	0x3d, 0xd3, 0xbf, 0x0a, 0x21, 0x2b, 0x4c, 0x36, 0xcc, 0x4c, 0xc8, 0x54, 0xd9, 0x53, 0xef, 0x6e,
+	0x10, 0x8a, 0xc7, 0x1c, 0xde, 0xe4, 0x2a, 0x37, 0x3a, 0x83, 0x66, 0xa7, 0xcc, 0xc9, 0x6d, 0x73,
+	0x6c, 0x6e, 0x34, 0xf7, 0x64, 0xa2, 0x12, 0x6f, 0x13, 0x82, 0x33, 0x1e, 0xb3, 0x21, 0x97, 0x09,
+	0xc3, 0x95, 0xe8, 0x9b, 0x54, 0xf1, 0x74, 0x52, 0x7b, 0xb5, 0x05, 0xbe, 0xe4, 0xae, 0xc8, 0x8d,
+	0xcd, 0xa0, 0xd9, 0x6c, 0x29, 0x3f, 0x78, 0xaf, 0x95, 0xf2, 0xb1, 0xf3, 0x31, 0xc2, 0xcc, 0x6f,
+	0x9b, 0x63, 0xd7, 0xc3, 0xbb, 0x04, 0x5b, 0x85, 0xec, 0xb2, 0x5b, 0x61, 0xdf, 0x94, 0x57, 0x93,
+	0x58, 0x25, 0x9b, 0xa4, 0x24, 0x6b, 0x72, 0x12, 0x67, 0x63, 0xff, 0x54, 0x6b, 0x0e, 0x93, 0x12,
+	0x64, 0x6e, 0x7c, 0x66, 0x74, 0x36, 0x5b, 0x7a, 0x7b, 0x90, 0xff, 0x58, 0x18, 0x36, 0xa7, 0xb6,
+	0x4d, 0xfc, 0x03, 0x9a, 0x28, 0xc6, 0xfc, 0x95, 0xa9, 0x38, 0xf7, 0x58, 0x9c, 0x4a, 0x38, 0x9e,
+	0xee, 0x76, 0xbe, 0xea, 0xf9, 0x50, 0xe7, 0x97, 0x73, 0x13, 0x91, 0x01, 0xca, 0x8f, 0xb6, 0x0a,
+	0x9f, 0xef, 0xd2, 0x00, 0xa7, 0x22, 0xa2, 0xe8, 0xa8, 0xde, 0x92, 0x1c, 0xc7, 0xd3, 0x0e, 0x93,
+	0xaa, 0x2a, 0x01, 0x44, 0x95, 0xd5, 0x15, 0xf8, 0xb9, 0xc9, 0x21, 0x2d, 0x39, 0xdb, 0x7d, 0x46,
+	0x66, 0xe6, 0xe6, 0xdf, 0x05, 0x54, 0x99, 0x0a, 0x13, 0xcf, 0x00, 0x88, 0x72, 0x98, 0x46, 0xbe,
+	0xc6, 0xfb, 0x7a, 0x4c, 0x6b, 0x50, 0x77, 0x7d, 0xc8, 0xed, 0x79, 0x45, 0xaa, 0x6c, 0x97, 0xca,
+	0x8c, 0xf2, 0x4a, 0x02, 0x8f, 0xac, 0x4a, 0xb2, 0x8e, 0xa7, 0x07, 0xde, 0x06, 0x79, 0x27, 0x45,
+	0xb5, 0x18, 0xce, 0x86, 0xfc, 0xc7, 0x83, 0x32, 0x0f, 0x79, 0x54, 0xc5, 0xfd, 0xd7, 0xff, 0xfa,
+	0xe7, 0xc7, 0x91, 0x2c, 0xd9, 0x6b, 0x08, 0x69, 0x74, 0x45, 0x2e, 0x6d, 0x8d, 0xe0, 0x4c, 0x59,
+	0xae, 0x4a, 0xb2, 0x8c, 0xa7, 0x97, 0xb9, 0x68, 0xf4, 0x99, 0x7b, 0xe8, 0x91, 0x1f, 0xbc, 0xe4,
+	0x45, 0x9c, 0xf3, 0x66, 0xd1, 0x27, 0x88, 0x9c, 0xc5, 0xfb, 0x17, 0xdc, 0x4b, 0xc2, 0xe1, 0xa2,
+	0x71, 0x7a, 0x03, 0x36, 0xa0, 0x02, 0x9e, 0xc3, 0x6a, 0x40, 0x0e, 0x0d, 0xa6, 0x0e, 0xa0, 0xa2,
+	0xb7, 0x98, 0x1f, 0x72, 0x30, 0x39, 0x8d, 0xff, 0xbf, 0x03, 0x7f, 0x6a, 0x43, 0xae, 0xff, 0x47,
+	0xca, 0xea, 0x00, 0x65, 0x38, 0x1e, 0xd2, 0x94, 0xcf, 0xc7, 0x45, 0xdf, 0x83, 0xcf, 0x1f, 0x7a,
+	0x89, 0x0c, 0x5d, 0x4e, 0x59, 0x5a, 0xc1, 0x99, 0xa5, 0x50, 0xdf, 0x45, 0xbc, 0xf7, 0x38, 0x13,
+	0x96, 0x03, 0xe7, 0xbc, 0x30, 0x40, 0x52, 0x22, 0xc6, 0xfb, 0x2b, 0xf1, 0x90, 0x1c, 0x56, 0x6f,
+	0xe9, 0x8f, 0x49, 0xfc, 0x56, 0xdf, 0xf8, 0xaa, 0x80, 0xcd, 0xa5, 0xf2, 0x37, 0xc9, 0x6f, 0x08,
+	0x8f, 0x2e, 0x81, 0x22, 0x07, 0xd3, 0x76, 0x50, 0x7d, 0xe8, 0x58, 0x8c, 0x77, 0x87, 0xde, 0xaf,
+	0xd8, 0x88, 0x5c, 0x02, 0xa4, 0x16, 0xba, 0xa4, 0x6f, 0x7e, 0x49, 0xe3, 0x4a, 0xef, 0x4f, 0x11,
+	0x4e, 0x47, 0x7d, 0x60, 0x5a, 0x0e, 0xac, 0xaf, 0x1a, 0x31, 0x34, 0x9d, 0xf7, 0xfc, 0xf3, 0x2a,
+	0xf9, 0x1e, 0xe1, 0x4c, 0x24, 0x78, 0xca, 0xc4, 0x43, 0xa6, 0x74, 0x3e, 0x3f, 0xb4, 0x72, 0x59,
+	0x2c, 0x47, 0xa5, 0x1f, 0x25, 0x9f, 0xa5, 0x4b, 0x7f, 0xc5, 0x5a, 0xc9, 0xb7, 0x23, 0x78, 0xf4,
+	0xcc, 0x8b, 0x54, 0x3c, 0xf3, 0x7a, 0x2a, 0xfe, 0x89, 0xa2, 0x5a, 0x7e, 0x47, 0xf9, 0x97, 0xea,
+	0xa8, 0xef, 0x52, 0x47, 0x7d, 0xa7, 0x8e, 0x47, 0xd0, 0xdc, 0x85, 0x95, 0xe2, 0xf1, 0x37, 0x75,
+	0xd2, 0x11, 0x34, 0x47, 0x7e, 0x42, 0x78, 0x7c, 0x01, 0x1c, 0x50, 0xf0, 0x8a, 0x8f, 0x61, 0x88,
+	0x5f, 0x8b, 0x2b, 0x91, 0x10, 0x4b, 0x73, 0x8b, 0xbb, 0x6e, 0x8a, 0x71, 0xa5, 0xcf, 0x31, 0xb7,
+	0x10, 0x1e, 0x5f, 0xbc, 0xec, 0xb9, 0xfe, 0x0b, 0x3c, 0x13, 0xef, 0xa7, 0x3d, 0x33, 0x33, 0xf4,
+	0x02, 0x65, 0xbf, 0xb6, 0xce, 0x5b, 0x50, 0x3c, 0x19, 0x15, 0xb9, 0x58, 0xfc, 0x72, 0xf7, 0x45,
+	0x42, 0x74, 0x78, 0x28, 0xdd, 0x2f, 0x08, 0x8f, 0x9f, 0x68, 0xbe, 0xb8, 0xc4, 0x78, 0xff, 0xf5,
+	0x6c, 0xfd, 0x06, 0x8a, 0xe3, 0xcd, 0xa4, 0x38, 0xf3, 0x57, 0x74, 0xc1, 0x78, 0x8d, 0xbf, 0xa8,
+	0x12, 0xde, 0xda, 0xfd, 0x36, 0x45, 0x0f, 0xda, 0x14, 0x3d, 0x6c, 0x53, 0xed, 0x71, 0x9b, 0x6a,
+	0x4f, 0xda, 0x54, 0x7b, 0xda, 0xa6, 0xda, 0xb3, 0x36, 0x45, 0xd7, 0x02, 0x8a, 0x6e, 0x04, 0x54,
+	0xbb, 0x1d, 0x50, 0x74, 0x27, 0xa0, 0xda, 0xdd, 0x80, 0x6a, 0xf7, 0x02, 0xaa, 0xdd, 0x0f, 0x28,
+	0x7a, 0x10, 0x50, 0xf4, 0x30, 0xa0, 0xda, 0xe3, 0x80, 0xa2, 0x27, 0x01, 0xd5, 0x9e, 0x06, 0x14,
+	0x3d, 0x0b, 0xa8, 0x76, 0xad, 0x43, 0xb5, 0x1b, 0x1d, 0x8a, 0x6e, 0x76, 0xa8, 0xf6, 0x73, 0x87,
+	0xa2, 0x5b, 0x1d, 0xaa, 0xdd, 0xee, 0x50, 0xed, 0x4e, 0x87, 0xa2, 0xbb, 0x1d, 0x8a, 0xee, 0x75,
+	0x28, 0x5a, 0x1b, 0x8f, 0x6c, 0x74, 0xf8, 0xdf, 0x01, 0x00, 0x1c, 0x11, 0xab, 0x3d, 0xd8, 0x0b,
+	0x00, 0x00,
 }
 
 func (this *GenerateDevAddrResponse) Equal(that interface{}) bool {
@@ -162,6 +301,71 @@ func (this *GenerateDevAddrResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ListNsEndDevicesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListNsEndDevicesRequest)
+	if !ok {
+		that2, ok := that.(ListNsEndDevicesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Page != that1.Page {
+		return false
+	}
+	if this.LoRaWANVersion != that1.LoRaWANVersion {
+		return false
+	}
+	if len(this.DeviceClasses) != len(that1.DeviceClasses) {
+		return false
+	}
+	for i := range this.DeviceClasses {
+		if this.DeviceClasses[i] != that1.DeviceClasses[i] {
+			return false
+		}
+	}
+	if that1.DevAddrPrefix == nil {
+		if this.DevAddrPrefix != nil {
+			return false
+		}
+	} else if !this.DevAddrPrefix.Equal(*that1.DevAddrPrefix) {
+		return false
+	}
+	if that1.LastSeenAfter == nil {
+		if this.LastSeenAfter != nil {
+			return false
+		}
+	} else if !this.LastSeenAfter.Equal(*that1.LastSeenAfter) {
+		return false
+	}
+	if that1.LastSeenBefore == nil {
+		if this.LastSeenBefore != nil {
+			return false
+		}
+	} else if !this.LastSeenBefore.Equal(*that1.LastSeenBefore) {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -537,11 +741,21 @@ type NsEndDeviceRegistryClient interface {
 	// Get returns the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Get(ctx context.Context, in *GetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
+	// List returns the end devices of the application that match the given filters.
+	// The devices are ordered by device ID.
+	List(ctx context.Context, in *ListNsEndDevicesRequest, opts ...grpc.CallOption) (*EndDevices, error)
 	// Set creates or updates the device.
 	Set(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Export returns an archive of the stored state of the given end devices,
+	// including their MAC state, sessions and queues.
+	Export(ctx context.Context, in *ExportEndDevicesRequest, opts ...grpc.CallOption) (*EndDeviceArchive, error)
+	// Import restores the end devices in the given archive.
+	// Existing end devices are replaced by the archived ones.
+	// The identifiers of the imported end devices are returned.
+	Import(ctx context.Context, in *ImportEndDevicesRequest, opts ...grpc.CallOption) (*EndDevices, error)
 }
 
 type nsEndDeviceRegistryClient struct {
@@ -561,6 +775,15 @@ func (c *nsEndDeviceRegistryClient) Get(ctx context.Context, in *GetEndDeviceReq
 	return out, nil
 }
 
+func (c *nsEndDeviceRegistryClient) List(ctx context.Context, in *ListNsEndDevicesRequest, opts ...grpc.CallOption) (*EndDevices, error) {
+	out := new(EndDevices)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nsEndDeviceRegistryClient) Set(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
 	out := new(EndDevice)
 	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Set", in, out, opts...)
@@ -579,16 +802,44 @@ func (c *nsEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIde
 	return out, nil
 }
 
+func (c *nsEndDeviceRegistryClient) Export(ctx context.Context, in *ExportEndDevicesRequest, opts ...grpc.CallOption) (*EndDeviceArchive, error) {
+	out := new(EndDeviceArchive)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) Import(ctx context.Context, in *ImportEndDevicesRequest, opts ...grpc.CallOption) (*EndDevices, error) {
+	out := new(EndDevices)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NsEndDeviceRegistryServer is the server API for NsEndDeviceRegistry service.
 type NsEndDeviceRegistryServer interface {
 	// Get returns the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Get(context.Context, *GetEndDeviceRequest) (*EndDevice, error)
+	// List returns the end devices of the application that match the given filters.
+	// The devices are ordered by device ID.
+	List(context.Context, *ListNsEndDevicesRequest) (*EndDevices, error)
 	// Set creates or updates the device.
 	Set(context.Context, *SetEndDeviceRequest) (*EndDevice, error)
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// Export returns an archive of the stored state of the given end devices,
+	// including their MAC state, sessions and queues.
+	Export(context.Context, *ExportEndDevicesRequest) (*EndDeviceArchive, error)
+	// Import restores the end devices in the given archive.
+	// Existing end devices are replaced by the archived ones.
+	// The identifiers of the imported end devices are returned.
+	Import(context.Context, *ImportEndDevicesRequest) (*EndDevices, error)
 }
 
 // UnimplementedNsEndDeviceRegistryServer can be embedded to have forward compatible implementations.
@@ -598,12 +849,21 @@ type UnimplementedNsEndDeviceRegistryServer struct {
 func (*UnimplementedNsEndDeviceRegistryServer) Get(ctx context.Context, req *GetEndDeviceRequest) (*EndDevice, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (*UnimplementedNsEndDeviceRegistryServer) List(ctx context.Context, req *ListNsEndDevicesRequest) (*EndDevices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
 func (*UnimplementedNsEndDeviceRegistryServer) Set(ctx context.Context, req *SetEndDeviceRequest) (*EndDevice, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
 }
 func (*UnimplementedNsEndDeviceRegistryServer) Delete(ctx context.Context, req *EndDeviceIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedNsEndDeviceRegistryServer) Export(ctx context.Context, req *ExportEndDevicesRequest) (*EndDeviceArchive, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) Import(ctx context.Context, req *ImportEndDevicesRequest) (*EndDevices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
 
 func RegisterNsEndDeviceRegistryServer(s *grpc.Server, srv NsEndDeviceRegistryServer) {
 	s.RegisterService(&_NsEndDeviceRegistry_serviceDesc, srv)
@@ -627,6 +887,24 @@ func _NsEndDeviceRegistry_Get_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NsEndDeviceRegistry_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNsEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).List(ctx, req.(*ListNsEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NsEndDeviceRegistry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetEndDeviceRequest)
 	if err := dec(in); err != nil {
@@ -663,6 +941,42 @@ func _NsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NsEndDeviceRegistry_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).Export(ctx, req.(*ExportEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).Import(ctx, req.(*ImportEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.NsEndDeviceRegistry",
 	HandlerType: (*NsEndDeviceRegistryServer)(nil),
@@ -671,6 +985,10 @@ var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _NsEndDeviceRegistry_Get_Handler,
 		},
+		{
+			MethodName: "List",
+			Handler:    _NsEndDeviceRegistry_List_Handler,
+		},
 		{
 			MethodName: "Set",
 			Handler:    _NsEndDeviceRegistry_Set_Handler,
@@ -679,6 +997,14 @@ var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _NsEndDeviceRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _NsEndDeviceRegistry_Export_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _NsEndDeviceRegistry_Import_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/networkserver.proto",
@@ -719,6 +1045,114 @@ func (m *GenerateDevAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ListNsEndDevicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListNsEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListNsEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastSeenBefore != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSeenBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenBefore):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintNetworkserver(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LastSeenAfter != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSeenAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenAfter):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintNetworkserver(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DevAddrPrefix != nil {
+		{
+			size := m.DevAddrPrefix.Size()
+			i -= size
+			if _, err := m.DevAddrPrefix.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DeviceClasses) > 0 {
+		dAtA4 := make([]byte, len(m.DeviceClasses)*10)
+		var j3 int
+		for _, num := range m.DeviceClasses {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintNetworkserver(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LoRaWANVersion != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.LoRaWANVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Page != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Limit != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNetworkserver(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNetworkserver(v)
 	base := offset
@@ -738,6 +1172,32 @@ func NewPopulatedGenerateDevAddrResponse(r randyNetworkserver, easy bool) *Gener
 	return this
 }
 
+func NewPopulatedListNsEndDevicesRequest(r randyNetworkserver, easy bool) *ListNsEndDevicesRequest {
+	this := &ListNsEndDevicesRequest{}
+	v1 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v1
+	v2 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v2
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	this.LoRaWANVersion = MACVersion([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
+	v3 := r.Intn(10)
+	this.DeviceClasses = make([]Class, v3)
+	for i := 0; i < v3; i++ {
+		this.DeviceClasses[i] = Class([]int32{0, 1, 2}[r.Intn(3)])
+	}
+	this.DevAddrPrefix = go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddrPrefix(r)
+	if r.Intn(5) != 0 {
+		this.LastSeenAfter = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.LastSeenBefore = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyNetworkserver interface {
 	Float32() float32
 	Float64() float64
@@ -823,6 +1283,47 @@ func (m *GenerateDevAddrResponse) Size() (n int) {
 	return n
 }
 
+func (m *ListNsEndDevicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovNetworkserver(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovNetworkserver(uint64(m.Page))
+	}
+	if m.LoRaWANVersion != 0 {
+		n += 1 + sovNetworkserver(uint64(m.LoRaWANVersion))
+	}
+	if len(m.DeviceClasses) > 0 {
+		l = 0
+		for _, e := range m.DeviceClasses {
+			l += sovNetworkserver(uint64(e))
+		}
+		n += 1 + sovNetworkserver(uint64(l)) + l
+	}
+	if m.DevAddrPrefix != nil {
+		l = m.DevAddrPrefix.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.LastSeenAfter != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenAfter)
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.LastSeenBefore != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenBefore)
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
 func sovNetworkserver(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -839,6 +1340,24 @@ func (this *GenerateDevAddrResponse) String() string {
 	}, "")
 	return s
 }
+func (this *ListNsEndDevicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListNsEndDevicesRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
+		`DeviceClasses:` + fmt.Sprintf("%v", this.DeviceClasses) + `,`,
+		`DevAddrPrefix:` + fmt.Sprintf("%v", this.DevAddrPrefix) + `,`,
+		`LastSeenAfter:` + strings.Replace(fmt.Sprintf("%v", this.LastSeenAfter), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastSeenBefore:` + strings.Replace(fmt.Sprintf("%v", this.LastSeenBefore), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringNetworkserver(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -935,6 +1454,358 @@ func (m *GenerateDevAddrResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ListNsEndDevicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListNsEndDevicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListNsEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
+			}
+			m.LoRaWANVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType == 0 {
+				var v Class
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNetworkserver
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Class(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DeviceClasses = append(m.DeviceClasses, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNetworkserver
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNetworkserver
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNetworkserver
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.DeviceClasses) == 0 {
+					m.DeviceClasses = make([]Class, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Class
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNetworkserver
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Class(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DeviceClasses = append(m.DeviceClasses, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceClasses", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddrPrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v go_thethings_network_lorawan_stack_v3_pkg_types.DevAddrPrefix
+			m.DevAddrPrefix = &v
+			if err := m.DevAddrPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSeenAfter == nil {
+				m.LastSeenAfter = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastSeenAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSeenBefore == nil {
+				m.LastSeenBefore = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastSeenBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNetworkserver(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
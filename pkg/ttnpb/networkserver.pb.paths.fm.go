@@ -9,3 +9,28 @@ var GenerateDevAddrResponseFieldPathsNested = []string{
 var GenerateDevAddrResponseFieldPathsTopLevel = []string{
 	"dev_addr",
 }
+
+var ListNsEndDevicesRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"dev_addr_prefix",
+	"device_classes",
+	"field_mask",
+	"last_seen_after",
+	"last_seen_before",
+	"limit",
+	"lorawan_version",
+	"page",
+}
+
+var ListNsEndDevicesRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"dev_addr_prefix",
+	"device_classes",
+	"field_mask",
+	"last_seen_after",
+	"last_seen_before",
+	"limit",
+	"lorawan_version",
+	"page",
+}
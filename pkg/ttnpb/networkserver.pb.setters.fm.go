@@ -2,7 +2,11 @@
 
 package ttnpb
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+
+	types "github.com/gogo/protobuf/types"
+)
 
 func (dst *GenerateDevAddrResponse) SetFields(src *GenerateDevAddrResponse, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
@@ -23,3 +27,108 @@ func (dst *GenerateDevAddrResponse) SetFields(src *GenerateDevAddrResponse, path
 	}
 	return nil
 }
+
+func (dst *ListNsEndDevicesRequest) SetFields(src *ListNsEndDevicesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIdentifiers
+				}
+				newDst = &dst.ApplicationIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIdentifiers = src.ApplicationIdentifiers
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIdentifiers = zero
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+		case "lorawan_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'lorawan_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LoRaWANVersion = src.LoRaWANVersion
+			} else {
+				var zero MACVersion
+				dst.LoRaWANVersion = zero
+			}
+		case "device_classes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_classes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceClasses = src.DeviceClasses
+			} else {
+				dst.DeviceClasses = nil
+			}
+		case "dev_addr_prefix":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_addr_prefix' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevAddrPrefix = src.DevAddrPrefix
+			} else {
+				dst.DevAddrPrefix = nil
+			}
+		case "last_seen_after":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_seen_after' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastSeenAfter = src.LastSeenAfter
+			} else {
+				dst.LastSeenAfter = nil
+			}
+		case "last_seen_before":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_seen_before' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastSeenBefore = src.LastSeenBefore
+			} else {
+				dst.LastSeenBefore = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
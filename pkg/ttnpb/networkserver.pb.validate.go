@@ -117,3 +117,169 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GenerateDevAddrResponseValidationError{}
+
+// ValidateFields checks the field values on ListNsEndDevicesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListNsEndDevicesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListNsEndDevicesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListNsEndDevicesRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListNsEndDevicesRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListNsEndDevicesRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		case "lorawan_version":
+
+			if _, ok := MACVersion_name[int32(m.GetLoRaWANVersion())]; !ok {
+				return ListNsEndDevicesRequestValidationError{
+					field:  "lorawan_version",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "device_classes":
+
+			for idx, item := range m.GetDeviceClasses() {
+				_, _ = idx, item
+
+				if _, ok := Class_name[int32(item)]; !ok {
+					return ListNsEndDevicesRequestValidationError{
+						field:  fmt.Sprintf("device_classes[%v]", idx),
+						reason: "value must be one of the defined enum values",
+					}
+				}
+
+			}
+
+		case "dev_addr_prefix":
+			// no validation rules for DevAddrPrefix
+		case "last_seen_after":
+
+			if v, ok := interface{}(m.GetLastSeenAfter()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListNsEndDevicesRequestValidationError{
+						field:  "last_seen_after",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_seen_before":
+
+			if v, ok := interface{}(m.GetLastSeenBefore()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListNsEndDevicesRequestValidationError{
+						field:  "last_seen_before",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ListNsEndDevicesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListNsEndDevicesRequestValidationError is the validation error returned by
+// ListNsEndDevicesRequest.ValidateFields if the designated constraints aren't
+// met.
+type ListNsEndDevicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNsEndDevicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNsEndDevicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNsEndDevicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNsEndDevicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNsEndDevicesRequestValidationError) ErrorName() string {
+	return "ListNsEndDevicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNsEndDevicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNsEndDevicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNsEndDevicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNsEndDevicesRequestValidationError{}
@@ -64,6 +64,8 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
+	"end_device.mac_settings.adr_algorithm.value",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.class_b_timeout",
@@ -646,6 +648,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.updated_at",
 	"end_device.used_dev_nonces",
 	"end_device.version_ids",
+	"end_device.version_ids.band_id",
 	"end_device.version_ids.brand_id",
 	"end_device.version_ids.firmware_version",
 	"end_device.version_ids.hardware_version",
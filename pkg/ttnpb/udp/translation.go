@@ -28,9 +28,10 @@ import (
 )
 
 const (
-	delta = 0.001 // For GPS comparisons
-	lora  = "LORA"
-	fsk   = "FSK"
+	delta  = 0.001 // For GPS comparisons
+	lora   = "LORA"
+	fsk    = "FSK"
+	lrfhss = "LR-FHSS"
 
 	// eirpDelta is the delta between EIRP and ERP.
 	eirpDelta = 2.15
@@ -197,7 +198,12 @@ func convertUplink(rx RxPacket, md UpstreamMetadata) (ttnpb.UplinkMessage, error
 	}
 
 	up.Settings.DataRate = rx.DatR.DataRate
-	if lora := up.Settings.DataRate.GetLoRa(); lora != nil {
+	switch dr := up.Settings.DataRate.Modulation.(type) {
+	case *ttnpb.DataRate_LoRa:
+		up.Settings.CodingRate = rx.CodR
+	case *ttnpb.DataRate_LRFHSS:
+		// The coding rate is part of the LR-FHSS data rate definition.
+		dr.LRFHSS.CodingRate = rx.CodR
 		up.Settings.CodingRate = rx.CodR
 	}
 
@@ -277,18 +283,28 @@ func FromGatewayUp(up *ttnpb.GatewayUp) (rxs []*RxPacket, stat *Stat, ack *TxPac
 	rxs = make([]*RxPacket, 0, len(up.UplinkMessages))
 	var modulation, codr string
 	for _, msg := range up.UplinkMessages {
-		switch msg.Settings.DataRate.Modulation.(type) {
+		dataRate := msg.Settings.DataRate
+		switch dr := dataRate.Modulation.(type) {
 		case *ttnpb.DataRate_LoRa:
 			modulation = lora
 			codr = msg.Settings.CodingRate
+		case *ttnpb.DataRate_LRFHSS:
+			modulation = lrfhss
+			codr = msg.Settings.CodingRate
+			// The UDP protocol carries the coding rate in codr, not in datr.
+			dataRate = ttnpb.LRFHSSDataRate{
+				ModulationType:        dr.LRFHSS.ModulationType,
+				OperatingChannelWidth: dr.LRFHSS.OperatingChannelWidth,
+			}.DataRate()
 		case *ttnpb.DataRate_FSK:
 			modulation = fsk
+			codr = ""
 		}
 		rxs = append(rxs, &RxPacket{
 			Freq: float64(msg.Settings.Frequency) / 1000000,
 			Chan: uint8(msg.RxMetadata[0].ChannelIndex),
 			Modu: modulation,
-			DatR: datarate.DR{DataRate: msg.Settings.DataRate},
+			DatR: datarate.DR{DataRate: dataRate},
 			CodR: codr,
 			Size: uint16(len(msg.RawPayload)),
 			Data: base64.StdEncoding.EncodeToString(msg.RawPayload),
@@ -23,14 +23,14 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
-// DR encodes a LoRa data rate or an FSK data rate, and implements marshalling and unmarshalling between JSON.
+// DR encodes a LoRa, LR-FHSS or FSK data rate, and implements marshalling and unmarshalling between JSON.
 type DR struct {
 	ttnpb.DataRate
 }
 
 // MarshalJSON implements the json.Marshaler interface.
 func (dr DR) MarshalJSON() ([]byte, error) {
-	if dr.GetLoRa() != nil {
+	if dr.GetLoRa() != nil || dr.GetLRFHSS() != nil {
 		return []byte(strconv.Quote(dr.String())), nil
 	}
 	if dr.GetFSK() != nil {
@@ -42,9 +42,12 @@ func (dr DR) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements the json.Unmarshaler interface.
 func (dr *DR) UnmarshalJSON(data []byte) error {
 	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
-		datarate, err := ParseLoRa(string(data[1 : len(data)-1]))
+		s := string(data[1 : len(data)-1])
+		datarate, err := ParseLoRa(s)
 		if err != nil {
-			return err
+			if datarate, err = ParseLRFHSS(s); err != nil {
+				return err
+			}
 		}
 		*dr = datarate
 		return nil
@@ -69,6 +72,7 @@ var (
 	errDataRate = errors.DefineInvalidArgument("data_rate", "invalid data rate")
 	sfRegexp    = regexp.MustCompile(`^SF([1-9]|10|11|12)BW`)
 	bwRegexp    = regexp.MustCompile(`BW(\d+(?:\.\d+)?)$`)
+	ocwRegexp   = regexp.MustCompile(`^M(\d+)CW(\d+)(?:CR(\d+/\d+))?$`)
 )
 
 // String implements the Stringer interface.
@@ -76,6 +80,12 @@ func (dr DR) String() string {
 	if lora := dr.GetLoRa(); lora != nil {
 		return fmt.Sprintf("SF%dBW%v", lora.SpreadingFactor, float32(lora.Bandwidth)/1000)
 	}
+	if lrfhss := dr.GetLRFHSS(); lrfhss != nil {
+		if lrfhss.CodingRate != "" {
+			return fmt.Sprintf("M%dCW%dCR%s", lrfhss.ModulationType, lrfhss.OperatingChannelWidth/1000, lrfhss.CodingRate)
+		}
+		return fmt.Sprintf("M%dCW%d", lrfhss.ModulationType, lrfhss.OperatingChannelWidth/1000)
+	}
 	if fsk := dr.GetFSK(); fsk != nil {
 		return fmt.Sprintf("%d", fsk.BitRate)
 	}
@@ -111,3 +121,31 @@ func ParseLoRa(dr string) (DR, error) {
 		},
 	}, nil
 }
+
+// ParseLRFHSS converts a string of format "MxxCWxxx" or "MxxCWxxxCRx/x" to a LRFHSSDataRate.
+// The operating channel width is expressed in kHz.
+func ParseLRFHSS(dr string) (DR, error) {
+	matches := ocwRegexp.FindStringSubmatch(dr)
+	if len(matches) != 4 {
+		return DR{}, errDataRate.New()
+	}
+	modulationType, err := strconv.ParseUint(matches[1], 10, 32)
+	if err != nil {
+		return DR{}, errDataRate.New()
+	}
+	ocw, err := strconv.ParseUint(matches[2], 10, 32)
+	if err != nil {
+		return DR{}, errDataRate.New()
+	}
+	return DR{
+		DataRate: ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_LRFHSS{
+				LRFHSS: &ttnpb.LRFHSSDataRate{
+					ModulationType:        uint32(modulationType),
+					OperatingChannelWidth: uint32(ocw * 1000),
+					CodingRate:            matches[3],
+				},
+			},
+		},
+	}, nil
+}
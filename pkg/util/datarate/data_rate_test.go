@@ -27,8 +27,10 @@ func TestDataRate(t *testing.T) {
 	a := assertions.New(t)
 
 	table := map[string]datarate.DR{
-		`"SF7BW125"`: {DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 7, Bandwidth: 125000}}}},
-		`50000`:      {DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_FSK{FSK: &ttnpb.FSKDataRate{BitRate: 50000}}}},
+		`"SF7BW125"`:     {DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 7, Bandwidth: 125000}}}},
+		`50000`:          {DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_FSK{FSK: &ttnpb.FSKDataRate{BitRate: 50000}}}},
+		`"M0CW137"`:      {DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{ModulationType: 0, OperatingChannelWidth: 137000}}}},
+		`"M0CW137CR2/3"`: {DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{ModulationType: 0, OperatingChannelWidth: 137000, CodingRate: "2/3"}}}},
 	}
 
 	for s, dr := range table {
@@ -75,17 +77,61 @@ func TestInvalidLoRaDataRateParsing(t *testing.T) {
 	}
 }
 
+func TestLRFHSSDataRateParsing(t *testing.T) {
+	a := assertions.New(t)
+
+	table := map[string]datarate.DR{
+		"M0CW137":      {DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{ModulationType: 0, OperatingChannelWidth: 137000}}}},
+		"M0CW1523":     {DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{ModulationType: 0, OperatingChannelWidth: 1523000}}}},
+		"M0CW336CR1/3": {DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{ModulationType: 0, OperatingChannelWidth: 336000, CodingRate: "1/3"}}}},
+	}
+	for dr, expected := range table {
+		actual, err := datarate.ParseLRFHSS(dr)
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, expected)
+	}
+
+	for _, dr := range []string{
+		"SF7BW125",
+		"M0CW",
+		"CW137",
+		"M0CW137CR",
+		"M0CW137CR2",
+	} {
+		_, err := datarate.ParseLRFHSS(dr)
+		a.So(err, should.NotBeNil)
+	}
+}
+
 func TestStringer(t *testing.T) {
 	a := assertions.New(t)
 
 	table := map[datarate.DR]string{
-		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 6, Bandwidth: 125000}}}}: "SF6BW125",
-		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 9, Bandwidth: 500000}}}}: "SF9BW500",
-		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 5, Bandwidth: 31250}}}}:  "SF5BW31.25",
-		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_FSK{FSK: &ttnpb.FSKDataRate{BitRate: 50000}}}}:                           "50000",
+		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 6, Bandwidth: 125000}}}}:                   "SF6BW125",
+		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 9, Bandwidth: 500000}}}}:                   "SF9BW500",
+		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 5, Bandwidth: 31250}}}}:                    "SF5BW31.25",
+		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_FSK{FSK: &ttnpb.FSKDataRate{BitRate: 50000}}}}:                                             "50000",
+		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{OperatingChannelWidth: 336000}}}}:                     "M0CW336",
+		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{OperatingChannelWidth: 1523000, CodingRate: "2/3"}}}}: "M0CW1523CR2/3",
 	}
 
 	for dr, expected := range table {
 		a.So(dr.String(), should.Equal, expected)
 	}
 }
+
+func TestLRFHSSStringRoundTrip(t *testing.T) {
+	a := assertions.New(t)
+
+	for _, lrfhss := range []ttnpb.LRFHSSDataRate{
+		{OperatingChannelWidth: 137000},
+		{OperatingChannelWidth: 137000, CodingRate: "1/3"},
+		{OperatingChannelWidth: 336000, CodingRate: "2/3"},
+		{ModulationType: 1, OperatingChannelWidth: 1523000, CodingRate: "1/2"},
+	} {
+		dr := datarate.DR{DataRate: lrfhss.DataRate()}
+		parsed, err := datarate.ParseLRFHSS(dr.String())
+		a.So(err, should.BeNil)
+		a.So(parsed, should.Resemble, dr)
+	}
+}